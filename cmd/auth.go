@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
 	"time"
 
 	"github.com/fatih/color"
@@ -15,30 +18,74 @@ import (
 // authCmd represents the auth command
 var authCmd = &cobra.Command{
 	Use:   "auth",
-	Short: "Authenticate with Jira using API token",
+	Short: "Authenticate with Jira using API token or OAuth",
 	Long: `Authenticate with Jira using API token (recommended for CLI usage).
 
 Create an API token:
 1. Go to https://id.atlassian.com/manage-profile/security/api-tokens
 2. Click "Create API token"
 3. Give it a name and copy the generated token
-4. Use this command: my-day auth --email your-email@example.com --token your-api-token`,
+4. Use this command: my-day auth --email your-email@example.com --token your-api-token
+
+Jira Server and Data Center:
+- Personal access token: my-day auth --token your-pat --pat
+- Basic auth: my-day auth --email your-username --token your-password
+
+To log in to Jira Cloud with OAuth 2.0 instead of an API token, use
+'my-day auth login'.
+
+Add --keychain to keep the token in the macOS Keychain, the Windows Credential
+Manager or the Secret Service (libsecret) instead of ~/.my-day/auth.json.
+
+The deployment is detected automatically; set jira.deployment to cloud or
+server in the config file to skip detection.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := authenticateWithJira(cmd); err != nil {
-			color.Red("Authentication failed: %v", err)
-			os.Exit(1)
+			exitWithError("Authentication failed", withExitCode(exitAuth, err))
+		}
+	},
+}
+
+// authLoginCmd represents the auth login command
+var authLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Log in to Jira Cloud with OAuth 2.0",
+	Long: `Log in to Jira Cloud with the Atlassian OAuth 2.0 (3LO) flow instead of a
+long-lived API token.
+
+Create an OAuth 2.0 integration at https://developer.atlassian.com/console/myapps/
+with the Jira API scopes read:jira-work, write:jira-work and read:jira-user, and
+the callback URL http://localhost:8765/callback. Put its client ID and secret in
+jira.oauth in the config file (or MY_DAY_JIRA_OAUTH_CLIENT_ID and
+MY_DAY_JIRA_OAUTH_CLIENT_SECRET), then run this command and approve access in
+the browser.
+
+The access token is refreshed automatically. Tokens are kept in the OS keychain
+(macOS Keychain, Windows Credential Manager or libsecret) when available, and in
+~/.my-day/auth.json otherwise.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := loginWithOAuth(cmd); err != nil {
+			exitWithError("Login failed", withExitCode(exitAuth, err))
 		}
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(authCmd)
+	authCmd.AddCommand(authLoginCmd)
+
+	// Login flags
+	authLoginCmd.Flags().Bool("no-browser", false, "Print the authorization URL instead of opening a browser")
+	authLoginCmd.Flags().Int("port", 0, "Local port for the OAuth callback (default: jira.oauth.callback_port)")
+	authLoginCmd.Flags().Duration("timeout", 5*time.Minute, "How long to wait for access to be approved")
 	
 	// Auth-specific flags
 	authCmd.Flags().Bool("clear", false, "Clear existing authentication")
 	authCmd.Flags().Bool("test", false, "Test existing authentication")
 	authCmd.Flags().String("email", "", "Email address for API token authentication (can be set in config)")
 	authCmd.Flags().String("token", "", "API token for authentication (can be set in config)")
+	authCmd.Flags().Bool("pat", false, "Treat --token as a Jira Server/Data Center personal access token")
+	authCmd.Flags().Bool("keychain", false, "Store the token in the OS keychain instead of ~/.my-day/auth.json")
 }
 
 func authenticateWithJira(cmd *cobra.Command) error {
@@ -65,19 +112,11 @@ func authenticateWithJira(cmd *cobra.Command) error {
 
 	// Handle test flag
 	if test, _ := cmd.Flags().GetBool("test"); test {
-		// Create a temporary auth manager to test auth
-		authManager := jira.NewAuthManager("", "")
-		if !authManager.IsAuthenticated() {
-			return fmt.Errorf("no authentication found. Run 'my-day auth --email your-email --token your-token' first")
-		}
-		
 		// Test with a real client
-		apiToken, err := authManager.LoadAPIToken()
+		client, err := newJiraClient(cfg)
 		if err != nil {
-			return fmt.Errorf("failed to load API token: %w", err)
+			return err
 		}
-		
-		client := jira.NewClient(cfg.Jira.BaseURL, apiToken.Email, apiToken.Token)
 		return testAuthentication(client)
 	}
 
@@ -93,16 +132,35 @@ func authenticateWithJira(cmd *cobra.Command) error {
 		token = cfg.Jira.Token
 	}
 
-	if email == "" || token == "" {
+	pat, _ := cmd.Flags().GetBool("pat")
+	if pat && token == "" {
+		return fmt.Errorf("token is required. Use the --token flag or set it in config file")
+	}
+	if !pat && (email == "" || token == "") {
 		return fmt.Errorf("email and token are required. Use --email and --token flags or set them in config file")
 	}
 
 	// Create client and save credentials
-	color.Cyan("🔑 Configuring API token authentication...")
-	client := jira.NewClient(cfg.Jira.BaseURL, email, token)
+	var client *jira.Client
+	if pat {
+		color.Cyan("🔑 Configuring personal access token authentication...")
+		client = jira.NewClientWithBearerToken(cfg.Jira.BaseURL, token)
+	} else {
+		color.Cyan("🔑 Configuring API token authentication...")
+		client = jira.NewClient(cfg.Jira.BaseURL, email, token)
+	}
+	client.SetDeployment(cfg.Jira.Deployment)
 
 	// Save the API token
-	if err := client.GetAuthManager().SaveAPIToken(); err != nil {
+	if useKeychain, _ := cmd.Flags().GetBool("keychain"); useKeychain {
+		if err := client.GetAuthManager().SaveAPITokenToKeychain(); err != nil {
+			return err
+		}
+		color.Green("✓ API token stored in the keychain")
+		if cfg.Jira.Token != "" {
+			color.Yellow("The token no longer needs to be in the config file or environment: remove jira.token and MY_DAY_JIRA_TOKEN")
+		}
+	} else if err := client.GetAuthManager().SaveAPIToken(); err != nil {
 		return fmt.Errorf("failed to save API token: %w", err)
 	}
 
@@ -127,5 +185,98 @@ func testAuthentication(client *jira.Client) error {
 		return fmt.Errorf("connection test failed: %w", err)
 	}
 
+	if client.Deployment(ctx) == jira.DeploymentServer {
+		color.Cyan("Connected to Jira Server/Data Center")
+	}
+
+	return nil
+}
+
+func loginWithOAuth(cmd *cobra.Command) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	if cfg.Jira.BaseURL == "" {
+		return fmt.Errorf("Jira base URL not configured. Run 'my-day init' first")
+	}
+	if cfg.Jira.OAuth.ClientID == "" || cfg.Jira.OAuth.ClientSecret == "" {
+		return fmt.Errorf("OAuth app not configured. Set jira.oauth.client_id and jira.oauth.client_secret in the config file")
+	}
+
+	oauthConfig := jiraOAuthConfig(cfg)
+	if port, _ := cmd.Flags().GetInt("port"); port > 0 {
+		oauthConfig.CallbackPort = port
+	}
+	noBrowser, _ := cmd.Flags().GetBool("no-browser")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	color.Cyan("🔑 Logging in to %s with OAuth...", cfg.Jira.BaseURL)
+	token, err := jira.OAuthLogin(ctx, oauthConfig, cfg.Jira.BaseURL, func(authURL string) {
+		if !noBrowser {
+			if err := openBrowser(authURL); err == nil {
+				color.White("Approve access in the browser window that opened. If it did not open, visit:")
+				fmt.Println(authURL)
+				return
+			}
+		}
+		color.White("Open this URL in a browser and approve access:")
+		fmt.Println(authURL)
+	})
+	if err != nil {
+		return err
+	}
+
+	authManager := jira.NewAuthManager("", "")
+	if err := authManager.SaveOAuthToken(token); err != nil {
+		return fmt.Errorf("failed to save OAuth token: %w", err)
+	}
+
+	if saved, err := authManager.LoadOAuthToken(); err == nil && saved.Keychain {
+		color.Green("✓ Logged in to %s (tokens stored in the keychain)", token.SiteURL)
+	} else {
+		color.Green("✓ Logged in to %s", token.SiteURL)
+	}
+
+	client := jira.NewOAuthClient(cfg.Jira.BaseURL, oauthConfig)
+	if err := testAuthentication(client); err != nil {
+		color.Yellow("Warning: Login saved but connection test failed: %v", err)
+	} else {
+		color.Green("✓ Connection to Jira verified")
+	}
+
 	return nil
-}
\ No newline at end of file
+}
+
+// jiraOAuthConfig returns the OAuth app settings from the configuration
+func jiraOAuthConfig(cfg *config.Config) jira.OAuthConfig {
+	port := cfg.Jira.OAuth.CallbackPort
+	if port == 0 {
+		port = jira.DefaultOAuthCallbackPort
+	}
+	return jira.OAuthConfig{
+		ClientID:     cfg.Jira.OAuth.ClientID,
+		ClientSecret: cfg.Jira.OAuth.ClientSecret,
+		CallbackPort: port,
+	}
+}
+
+// openBrowser opens a URL in the default browser
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
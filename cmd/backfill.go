@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/jira"
+	"my-day/internal/store"
+)
+
+// backfillStateKey is the store key of how far back the history has been backfilled
+const backfillStateKey = "backfill"
+
+// backfillState records the oldest day backfilled, so later runs only fetch older windows
+type backfillState struct {
+	From time.Time `json:"from"`
+}
+
+// backfillCmd represents the backfill-sync command
+var backfillCmd = &cobra.Command{
+	Use:   "backfill-sync",
+	Short: "Import past Jira activity into the local store",
+	Long: `Backfill-sync walks back through Jira history and imports the comments you wrote
+and the time you logged into the local store, so history, digest and retro have
+data from day one instead of only from the first sync on.
+
+Issues are searched one window of --window days at a time, newest first, and each
+window is saved as soon as it is done. Comment requests are spaced by --delay and,
+like sync, throttled requests (HTTP 429) are retried after the wait Jira asks for.
+The oldest day backfilled is remembered, so running it again with more --days
+only fetches the older windows; use --force to fetch everything again.`,
+	Example: `  my-day backfill-sync --days 90
+  my-day backfill-sync --days 180 --window 14 --delay 500ms`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := backfillSync(cmd); err != nil {
+			exitWithError("Backfill failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(backfillCmd)
+
+	// Backfill flags
+	backfillCmd.Flags().Int("days", 90, "Number of days of history to import")
+	backfillCmd.Flags().Int("window", 7, "Days of issue updates searched per request window")
+	backfillCmd.Flags().Duration("delay", 250*time.Millisecond, "Pause between comment requests to stay under Jira rate limits")
+	backfillCmd.Flags().Bool("worklog", true, "Include worklog entries")
+	backfillCmd.Flags().Bool("force", false, "Fetch windows that were already backfilled again")
+}
+
+func backfillSync(cmd *cobra.Command) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	days, _ := cmd.Flags().GetInt("days")
+	windowDays, _ := cmd.Flags().GetInt("window")
+	if days < 1 || windowDays < 1 {
+		return fmt.Errorf("--days and --window must be at least 1")
+	}
+	delay, _ := cmd.Flags().GetDuration("delay")
+	force, _ := cmd.Flags().GetBool("force")
+
+	if len(cfg.Jira.Projects) == 0 {
+		return fmt.Errorf("no project keys configured. Add projects to your config file")
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	storePath, err := getCacheFilePath()
+	if err != nil {
+		return fmt.Errorf("failed to get store path: %w", err)
+	}
+	db, err := store.Open(storePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var state backfillState
+	if _, err := db.State(backfillStateKey, &state); err != nil {
+		return err
+	}
+
+	tempo, err := newTempoClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	now := time.Now()
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local).AddDate(0, 0, -days)
+	color.Cyan("⏪ Backfilling Jira activity since %s from projects: %v", start.Format("2006-01-02"), cfg.Jira.Projects)
+
+	var issueCount, commentCount int
+	for end := now; end.After(start); {
+		windowStart := end.AddDate(0, 0, -windowDays)
+		if windowStart.Before(start) {
+			windowStart = start
+		}
+		if !force && !state.From.IsZero() && !windowStart.Before(state.From) {
+			color.White("%s – %s already backfilled", windowStart.Format("2006-01-02"), end.Format("2006-01-02"))
+			end = windowStart
+			continue
+		}
+
+		// The newest window is open-ended so issues updated while backfilling are not missed
+		var until time.Time
+		if end.Before(now) {
+			until = end
+		}
+		searchResponse, err := client.SearchIssues(ctx, backfillJQL(cfg.Jira.Projects, windowStart, until), cfg.Jira.MaxResults)
+		if err != nil {
+			return fmt.Errorf("failed to fetch issues updated %s – %s: %w", windowStart.Format("2006-01-02"), end.Format("2006-01-02"), err)
+		}
+		if searchResponse.Total > len(searchResponse.Issues) {
+			color.Yellow("Warning: Only %d of %d issues updated %s – %s were fetched. Use a smaller --window or raise jira.max_results", len(searchResponse.Issues), searchResponse.Total, windowStart.Format("2006-01-02"), end.Format("2006-01-02"))
+		}
+
+		windowIssues, windowComments := 0, 0
+		for _, issue := range searchResponse.Issues {
+			mine, err := client.GetMyComments(ctx, issue.Key, start)
+			if err != nil {
+				color.Yellow("Warning: Failed to fetch comments for %s: %v", issue.Key, err)
+				continue
+			}
+			if len(mine) > 0 {
+				if err := db.SaveIssues([]jira.Issue{issue}); err != nil {
+					return err
+				}
+				if err := db.SaveComments(issue.Key, mine); err != nil {
+					return err
+				}
+				windowIssues++
+				windowComments += len(mine)
+			}
+
+			if delay > 0 {
+				time.Sleep(delay)
+			}
+		}
+
+		issueCount += windowIssues
+		commentCount += windowComments
+		color.Green("✓ %s – %s: %d issues checked, %d comments on %d issues", windowStart.Format("2006-01-02"), end.Format("2006-01-02"), len(searchResponse.Issues), windowComments, windowIssues)
+
+		// Windows are done newest first, so the backfilled range grows back from today
+		if state.From.IsZero() || windowStart.Before(state.From) {
+			state.From = windowStart
+		}
+		if err := db.SetState(backfillStateKey, state); err != nil {
+			return err
+		}
+		end = windowStart
+	}
+
+	worklogCount := 0
+	if includeWorklog, _ := cmd.Flags().GetBool("worklog"); includeWorklog {
+		color.White("Fetching worklog entries since %s...", start.Format("2006-01-02"))
+		var worklogs []jira.WorklogEntry
+		if tempo != nil {
+			worklogs, err = tempo.GetWorklogs(ctx, user.AccountID, start)
+		} else {
+			worklogs, err = client.GetMyWorklog(ctx, start)
+		}
+		if err != nil {
+			color.Yellow("Warning: Failed to fetch worklog: %v", err)
+		} else {
+			if err := db.SaveWorklogs(worklogs); err != nil {
+				return err
+			}
+			worklogCount = len(worklogs)
+		}
+	}
+
+	showRateLimitStats(client.RateLimitStats())
+	color.Green("✓ Backfill completed: %d comments on %d issues, %d worklog entries", commentCount, issueCount, worklogCount)
+	color.White("Saved to local store: %s", storePath)
+	color.White("See the imported days with 'my-day history --days %d'", days)
+	return nil
+}
+
+// backfillJQL searches the projects' issues last updated from start until until. A zero
+// until leaves the window open-ended.
+func backfillJQL(projects []string, start, until time.Time) string {
+	jql := fmt.Sprintf(`project in (%s) AND updated >= "%s"`, strings.Join(projects, ","), start.Format("2006-01-02 15:04"))
+	if !until.IsZero() {
+		jql += fmt.Sprintf(` AND updated < "%s"`, until.Format("2006-01-02 15:04"))
+	}
+	return jql + " ORDER BY updated DESC"
+}
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"my-day/internal/config"
+	"my-day/internal/jira"
+)
+
+// bootstrapCmd represents the bootstrap command
+var bootstrapCmd = &cobra.Command{
+	Use:   "bootstrap",
+	Short: "Non-interactive init, auth and first sync",
+	Long: `Bootstrap performs init, auth and the first sync in one non-interactive command.
+
+It is meant for scripted developer-machine provisioning (MDM, dotfiles,
+Homebrew/Scoop post-install hooks). The API token is read from stdin with
+--token-stdin or from MY_DAY_JIRA_TOKEN, and is never written to the config file.
+
+Example:
+  echo "$JIRA_TOKEN" | my-day bootstrap --jira-url https://company.atlassian.net \
+    --email you@company.com --token-stdin --projects OPS,PLAT`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := bootstrap(cmd); err != nil {
+			exitWithError("Bootstrap failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(bootstrapCmd)
+
+	// Bootstrap-specific flags (--jira-url and --projects are global flags)
+	bootstrapCmd.Flags().String("email", "", "Email address for API token authentication")
+	bootstrapCmd.Flags().Bool("token-stdin", false, "Read the Jira API token from stdin")
+	bootstrapCmd.Flags().Bool("force", false, "Overwrite an existing configuration file")
+	bootstrapCmd.Flags().Bool("skip-sync", false, "Skip the initial sync")
+}
+
+func bootstrap(cmd *cobra.Command) error {
+	jiraURL, _ := cmd.Flags().GetString("jira-url")
+	projects, _ := cmd.Flags().GetStringSlice("projects")
+
+	email, _ := cmd.Flags().GetString("email")
+	if email == "" {
+		email = viper.GetString("jira.email")
+	}
+
+	var token string
+	if tokenStdin, _ := cmd.Flags().GetBool("token-stdin"); tokenStdin {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("failed to read token from stdin: %w", err)
+		}
+		token = strings.TrimSpace(string(data))
+	} else {
+		token = viper.GetString("jira.token")
+	}
+
+	// Step 1: configuration
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	configDir := filepath.Join(homeDir, ".my-day")
+	configFile := filepath.Join(configDir, "config.yaml")
+	if cfgFile != "" {
+		configFile = cfgFile
+	}
+
+	color.Cyan("🚀 Bootstrapping my-day...")
+
+	force, _ := cmd.Flags().GetBool("force")
+	if _, err := os.Stat(configFile); err == nil && !force {
+		color.White("Using existing configuration at %s (use --force to overwrite)", configFile)
+	} else {
+		if jiraURL == "" {
+			return fmt.Errorf("--jira-url is required")
+		}
+		if len(projects) == 0 {
+			return fmt.Errorf("--projects is required")
+		}
+
+		llmMode := ""
+		if flag := cmd.Flag("llm-mode"); flag != nil && flag.Changed {
+			llmMode = flag.Value.String()
+		}
+
+		content, err := renderBootstrapConfig(jiraURL, email, projects, llmMode)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(configFile), 0755); err != nil {
+			return fmt.Errorf("failed to create config directory: %w", err)
+		}
+		if err := os.WriteFile(configFile, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write configuration file: %w", err)
+		}
+		color.Green("✓ Configuration file created at: %s", configFile)
+	}
+
+	viper.SetConfigFile(configFile)
+	if err := viper.ReadInConfig(); err != nil {
+		return fmt.Errorf("failed to read configuration: %w", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	if cfg.Jira.BaseURL == "" {
+		return fmt.Errorf("Jira base URL not configured. Use --jira-url")
+	}
+
+	// Step 2: authentication
+	if email == "" || token == "" {
+		return fmt.Errorf("email and token are required. Use --email and --token-stdin (or MY_DAY_JIRA_EMAIL and MY_DAY_JIRA_TOKEN)")
+	}
+
+	client := jira.NewClient(cfg.Jira.BaseURL, email, token)
+	client.SetDeployment(cfg.Jira.Deployment)
+	if err := client.GetAuthManager().SaveAPIToken(); err != nil {
+		return fmt.Errorf("failed to save API token: %w", err)
+	}
+	color.Green("✓ API token authentication configured")
+
+	if err := testAuthentication(client); err != nil {
+		return fmt.Errorf("Jira connection test failed: %w", err)
+	}
+	color.Green("✓ Connection to Jira verified")
+
+	// Step 3: first sync
+	if skipSync, _ := cmd.Flags().GetBool("skip-sync"); skipSync {
+		color.White("Skipping initial sync")
+	} else {
+		syncCmd.Flags().Set("force", "true")
+		if err := syncTickets(syncCmd); err != nil {
+			return fmt.Errorf("initial sync failed: %w", err)
+		}
+	}
+
+	fmt.Println()
+	color.Green("✓ my-day is ready. Run 'my-day report' to generate your first report.")
+	return nil
+}
+
+// renderBootstrapConfig fills the standard config template with bootstrap values. It fails
+// when the template no longer has a placeholder it fills, rather than leave the example value.
+func renderBootstrapConfig(jiraURL, email string, projects []string, llmMode string) (string, error) {
+	var projectLines strings.Builder
+	for _, project := range projects {
+		projectLines.WriteString(fmt.Sprintf("    - %q\n", strings.TrimSpace(project)))
+	}
+
+	type replacement struct{ placeholder, value string }
+	replacements := []replacement{
+		{`base_url: "https://your-instance.atlassian.net"`, fmt.Sprintf("base_url: %q", jiraURL)},
+		{"    - \"DAT\"\n    - \"IO\"\n", projectLines.String()},
+	}
+	if email != "" {
+		replacements = append(replacements, replacement{`email: ""    #`, fmt.Sprintf("email: %q    #", email)})
+	}
+	if llmMode != "" {
+		replacements = append(replacements, replacement{`  mode: "ollama"`, fmt.Sprintf("  mode: %q", llmMode)})
+	}
+
+	content := generateConfigTemplate()
+	for _, r := range replacements {
+		if !strings.Contains(content, r.placeholder) {
+			return "", fmt.Errorf("config template has no %q placeholder to fill", r.placeholder)
+		}
+		content = strings.Replace(content, r.placeholder, r.value, 1)
+	}
+	return content, nil
+}
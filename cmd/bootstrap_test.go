@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestRenderBootstrapConfig(t *testing.T) {
+	content, err := renderBootstrapConfig("https://acme.atlassian.net", "dev@acme.com", []string{"OPS", " PLAT"}, "embedded")
+	if err != nil {
+		t.Fatalf("renderBootstrapConfig() error = %v", err)
+	}
+
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(strings.NewReader(content)); err != nil {
+		t.Fatalf("rendered config is not valid YAML: %v", err)
+	}
+	if got := v.GetString("jira.base_url"); got != "https://acme.atlassian.net" {
+		t.Errorf("jira.base_url = %q", got)
+	}
+	if got := v.GetString("jira.email"); got != "dev@acme.com" {
+		t.Errorf("jira.email = %q", got)
+	}
+	if got := v.GetStringSlice("jira.projects"); !reflect.DeepEqual(got, []string{"OPS", "PLAT"}) {
+		t.Errorf("jira.projects = %v", got)
+	}
+	if got := v.GetString("llm.mode"); got != "embedded" {
+		t.Errorf("llm.mode = %q", got)
+	}
+
+	// Without an email or LLM mode, the template defaults stay
+	content, err = renderBootstrapConfig("https://acme.atlassian.net", "", []string{"OPS"}, "")
+	if err != nil {
+		t.Fatalf("renderBootstrapConfig() error = %v", err)
+	}
+	if !strings.Contains(content, `mode: "ollama"`) {
+		t.Error("expected the default LLM mode to be kept")
+	}
+}
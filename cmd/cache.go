@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -31,8 +30,7 @@ Shows information about each cached report including date, format, generation ti
 LLM usage, and export paths.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := listCache(cmd); err != nil {
-			color.Red("Failed to list cache: %v", err)
-			os.Exit(1)
+			exitWithError("Failed to list cache", err)
 		}
 	},
 }
@@ -46,8 +44,7 @@ var cacheClearCmd = &cobra.Command{
 You can clear all reports or specify criteria to clear specific reports.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := clearCache(cmd); err != nil {
-			color.Red("Failed to clear cache: %v", err)
-			os.Exit(1)
+			exitWithError("Failed to clear cache", err)
 		}
 	},
 }
@@ -62,8 +59,7 @@ Shows information about cache size, number of reports, LLM usage patterns,
 and storage details.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := showCacheStats(cmd); err != nil {
-			color.Red("Failed to show cache stats: %v", err)
-			os.Exit(1)
+			exitWithError("Failed to show cache stats", err)
 		}
 	},
 }
@@ -79,8 +75,7 @@ to see available report IDs.`,
 	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := deleteCache(cmd, args); err != nil {
-			color.Red("Failed to delete cache: %v", err)
-			os.Exit(1)
+			exitWithError("Failed to delete cache", err)
 		}
 	},
 }
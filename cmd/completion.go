@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/llm"
+)
+
+// completionCmd generates the shell completion scripts
+var completionCmd = &cobra.Command{
+	Use:   "completion [bash|zsh|fish|powershell]",
+	Short: "Generate the shell completion script",
+	Long: `Generate the completion script of my-day for your shell.
+
+Besides commands and flags, the completion suggests values read when you press Tab: the
+project keys of jira.projects for --projects, the models installed in Ollama for
+--llm-model, --ollama-model and 'my-day llm switch', and the fields of jira.custom_fields
+for --field and --group-by.
+
+Bash (requires the bash-completion package):
+  source <(my-day completion bash)
+  # Every session: my-day completion bash > /etc/bash_completion.d/my-day
+
+Zsh:
+  my-day completion zsh > "${fpath[1]}/_my-day"
+  # If completion is not enabled yet: echo "autoload -U compinit; compinit" >> ~/.zshrc
+
+Fish:
+  my-day completion fish > ~/.config/fish/completions/my-day.fish
+
+PowerShell:
+  my-day completion powershell | Out-String | Invoke-Expression
+  # Every session: add the line above to your $PROFILE`,
+	DisableFlagsInUseLine: true,
+	ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+	Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		switch args[0] {
+		case "bash":
+			return rootCmd.GenBashCompletionV2(os.Stdout, true)
+		case "zsh":
+			return rootCmd.GenZshCompletion(os.Stdout)
+		case "fish":
+			return rootCmd.GenFishCompletion(os.Stdout, true)
+		case "powershell":
+			return rootCmd.GenPowerShellCompletionWithDesc(os.Stdout)
+		}
+		return fmt.Errorf("unsupported shell %q", args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(completionCmd)
+}
+
+// standardFieldNames are the issue fields --field and --group-by accept besides custom fields
+var standardFieldNames = []string{"project", "priority", "status", "issuetype", "assignee", "reporter", "parent", "epic"}
+
+// completeProjectKeys completes the comma-separated values of --projects with the project keys
+// of the configuration and its Jira profiles
+func completeProjectKeys(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	keys := append([]string{}, cfg.Jira.Projects...)
+	for _, profile := range cfg.Jira.Profiles {
+		keys = append(keys, profile.Projects...)
+	}
+	return completeList(toComplete, keys), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeOllamaModels completes a model name with the models installed in the configured
+// Ollama server
+func completeOllamaModels(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	models, err := llm.ListOllamaModels(cfg.LLM.Ollama.BaseURL)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var names []string
+	for _, model := range models {
+		names = append(names, model.Name)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeModelArg completes the single model name argument of 'my-day llm switch'
+func completeModelArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeOllamaModels(cmd, args, toComplete)
+}
+
+// completeFieldNames completes --field with the names of jira.custom_fields and the standard
+// issue fields
+func completeFieldNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return fieldNames(), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeGroupByFields completes the comma-separated fields of --group-by, which also
+// accepts the board column
+func completeGroupByFields(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeList(toComplete, append(fieldNames(), "column")), cobra.ShellCompDirectiveNoFileComp
+}
+
+// fieldNames returns the names of jira.custom_fields, sorted, followed by the standard issue
+// fields
+func fieldNames() []string {
+	var names []string
+	if cfg, err := config.Load(); err == nil {
+		for name := range cfg.Jira.CustomFields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	return append(names, standardFieldNames...)
+}
+
+// completeList completes the last item of a comma-separated list with values, leaving out
+// duplicates and the items already in the list
+func completeList(toComplete string, values []string) []string {
+	prefix := ""
+	if i := strings.LastIndex(toComplete, ","); i >= 0 {
+		prefix = toComplete[:i+1]
+	}
+	used := make(map[string]bool)
+	for _, item := range strings.Split(prefix, ",") {
+		used[strings.ToLower(item)] = true
+	}
+
+	var completions []string
+	for _, value := range values {
+		if used[strings.ToLower(value)] {
+			continue
+		}
+		used[strings.ToLower(value)] = true
+		completions = append(completions, prefix+value)
+	}
+	return completions
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/fatih/color"
 	"encoding/json"
@@ -30,8 +31,7 @@ var configShowCmd = &cobra.Command{
 	Long:  "Display the current configuration values from all sources (file, flags, env vars).",
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := showConfiguration(cmd); err != nil {
-			color.Red("Error showing configuration: %v", err)
-			os.Exit(1)
+			exitWithError("Error showing configuration", err)
 		}
 	},
 }
@@ -42,8 +42,7 @@ var configEditCmd = &cobra.Command{
 	Long:  "Open the configuration file in your default editor.",
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := editConfiguration(); err != nil {
-			color.Red("Error editing configuration: %v", err)
-			os.Exit(1)
+			exitWithError("Error editing configuration", err)
 		}
 	},
 }
@@ -54,8 +53,7 @@ var configPathCmd = &cobra.Command{
 	Long:  "Display the path to the configuration file being used.",
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := showConfigPath(); err != nil {
-			color.Red("Error showing config path: %v", err)
-			os.Exit(1)
+			exitWithError("Error showing config path", err)
 		}
 	},
 }
@@ -94,6 +92,16 @@ func showConfiguration(cmd *cobra.Command) error {
 	// Jira section
 	color.Yellow("Jira:")
 	color.White("  Base URL: %s", cfg.Jira.BaseURL)
+	if activeProfile != "" {
+		color.White("  Profile: %s", activeProfile)
+	}
+	if names := config.ProfileNames(); len(names) > 0 {
+		color.White("  Profiles: %s", strings.Join(names, ", "))
+	}
+	if cfg.Jira.OAuth.ClientID != "" {
+		color.White("  OAuth Client ID: %s", cfg.Jira.OAuth.ClientID)
+		color.White("  OAuth Client Secret: %s", maskSensitive(cfg.Jira.OAuth.ClientSecret))
+	}
 	color.White("  Projects:")
 	for _, projectKey := range cfg.Jira.Projects {
 		color.White("    - %s", projectKey)
@@ -109,6 +117,15 @@ func showConfiguration(cmd *cobra.Command) error {
 		color.White("  Ollama URL: %s", cfg.LLM.Ollama.BaseURL)
 		color.White("  Ollama Model: %s", cfg.LLM.Ollama.Model)
 	}
+	if cfg.LLM.Mode == "openai" {
+		color.White("  OpenAI URL: %s", cfg.LLM.OpenAI.BaseURL)
+		color.White("  OpenAI Model: %s", cfg.LLM.OpenAI.Model)
+		color.White("  OpenAI API Key: %s", maskSensitive(cfg.LLM.OpenAI.APIKey))
+	}
+	if cfg.LLM.Mode == "local-openai" {
+		color.White("  Local Server URL: %s", cfg.LLM.LocalOpenAI.BaseURL)
+		color.White("  Local Server Model: %s", localOpenAIModelName(cfg))
+	}
 	fmt.Println()
 
 	// Report section
@@ -118,12 +135,91 @@ func showConfiguration(cmd *cobra.Command) error {
 	color.White("  Include Today: %t", cfg.Report.IncludeToday)
 	color.White("  Include In Progress: %t", cfg.Report.IncludeInProgress)
 
+	// Sync state section
+	if cfg.SyncState.Backend != "" {
+		fmt.Println()
+		color.Yellow("Sync State:")
+		color.White("  Backend: %s", cfg.SyncState.Backend)
+		color.White("  Passphrase: %s", maskSensitive(cfg.SyncState.Passphrase))
+	}
+
+	// GitLab section
+	if cfg.GitLab.Enabled {
+		fmt.Println()
+		color.Yellow("GitLab:")
+		color.White("  Base URL: %s", cfg.GitLab.BaseURL)
+		color.White("  Token: %s", maskSensitive(cfg.GitLab.Token))
+		if len(cfg.GitLab.Projects) > 0 {
+			color.White("  Projects: %s", strings.Join(cfg.GitLab.Projects, ", "))
+		}
+	}
+
+	// Trello and Asana sections
+	if cfg.Trello.Enabled {
+		fmt.Println()
+		color.Yellow("Trello:")
+		color.White("  API Key: %s", maskSensitive(cfg.Trello.APIKey))
+		color.White("  Token: %s", maskSensitive(cfg.Trello.Token))
+		if len(cfg.Trello.Boards) > 0 {
+			color.White("  Boards: %s", strings.Join(cfg.Trello.Boards, ", "))
+		}
+	}
+	if cfg.Asana.Enabled {
+		fmt.Println()
+		color.Yellow("Asana:")
+		color.White("  Token: %s", maskSensitive(cfg.Asana.Token))
+		if cfg.Asana.Workspace != "" {
+			color.White("  Workspace: %s", cfg.Asana.Workspace)
+		}
+		if len(cfg.Asana.Projects) > 0 {
+			color.White("  Projects: %s", strings.Join(cfg.Asana.Projects, ", "))
+		}
+	}
+
+	// Time tracking section
+	if cfg.TimeTracking.Provider != "" {
+		fmt.Println()
+		color.Yellow("Time Tracking:")
+		color.White("  Provider: %s", cfg.TimeTracking.Provider)
+		color.White("  Token: %s", maskSensitive(cfg.TimeTracking.Token))
+		if cfg.TimeTracking.AccountID != "" {
+			color.White("  Account ID: %s", cfg.TimeTracking.AccountID)
+		}
+	}
+
+	// Tempo section
+	if cfg.Tempo.Enabled {
+		fmt.Println()
+		color.Yellow("Tempo:")
+		color.White("  Base URL: %s", cfg.Tempo.BaseURL)
+		color.White("  Token: %s", maskSensitive(cfg.Tempo.Token))
+	}
+
+	// Notion export section
+	if cfg.Report.Export.Target == "notion" || cfg.Report.Export.Targets.Notion {
+		fmt.Println()
+		color.Yellow("Notion Export:")
+		color.White("  Database ID: %s", cfg.Report.Export.Notion.DatabaseID)
+		color.White("  Token: %s", maskSensitive(cfg.Report.Export.Notion.Token))
+	}
+
+	// Slack section
+	if cfg.Slack.WebhookURL != "" || cfg.Slack.BotToken != "" {
+		fmt.Println()
+		color.Yellow("Slack:")
+		if cfg.Slack.WebhookURL != "" {
+			color.White("  Webhook URL: %s", maskSensitive(cfg.Slack.WebhookURL))
+		} else {
+			color.White("  Bot Token: %s", maskSensitive(cfg.Slack.BotToken))
+			color.White("  Channel: %s", cfg.Slack.Channel)
+		}
+	}
+
 	return nil
 }
 
 func showConfigurationJSON(cfg *config.Config) error {
-	// Marshal the configuration directly (no sensitive values to mask)
-	data, err := json.MarshalIndent(cfg, "", "  ")
+	data, err := json.MarshalIndent(maskedConfig(cfg), "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal configuration: %w", err)
 	}
@@ -132,6 +228,34 @@ func showConfigurationJSON(cfg *config.Config) error {
 	return nil
 }
 
+// maskedConfig returns a copy of the configuration with API keys, tokens and passwords masked
+func maskedConfig(cfg *config.Config) config.Config {
+	masked := *cfg
+	if masked.LLM.OpenAI.APIKey != "" {
+		masked.LLM.OpenAI.APIKey = maskSensitive(masked.LLM.OpenAI.APIKey)
+	}
+	if masked.LLM.LocalOpenAI.APIKey != "" {
+		masked.LLM.LocalOpenAI.APIKey = maskSensitive(masked.LLM.LocalOpenAI.APIKey)
+	}
+	for _, secret := range []*string{&masked.SyncState.Passphrase, &masked.SyncState.Password, &masked.SyncState.AccessKeyID, &masked.SyncState.SecretAccessKey, &masked.Slack.WebhookURL, &masked.Slack.BotToken, &masked.Handoff.WebhookURL, &masked.GitLab.Token, &masked.Trello.APIKey, &masked.Trello.Token, &masked.Asana.Token, &masked.TimeTracking.Token, &masked.Tempo.Token, &masked.Report.Export.Notion.Token, &masked.Report.Email.Password, &masked.Jira.Token, &masked.Jira.OAuth.ClientSecret, &masked.GitHub.Token, &masked.Calendar.Source} {
+		if *secret != "" {
+			*secret = maskSensitive(*secret)
+		}
+	}
+	if len(cfg.Jira.Profiles) > 0 {
+		masked.Jira.Profiles = make(map[string]config.JiraConfig, len(cfg.Jira.Profiles))
+		for name, profile := range cfg.Jira.Profiles {
+			for _, secret := range []*string{&profile.Token, &profile.OAuth.ClientSecret} {
+				if *secret != "" {
+					*secret = maskSensitive(*secret)
+				}
+			}
+			masked.Jira.Profiles[name] = profile
+		}
+	}
+	return masked
+}
+
 func showConfigurationSources() error {
 	color.Cyan("📋 Configuration Sources")
 	fmt.Println()
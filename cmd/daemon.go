@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/daemon"
+	"my-day/internal/metrics"
+	"my-day/internal/store"
+)
+
+// daemonStateKey is the store key of when the daemon last generated the report
+const daemonStateKey = "daemon"
+
+// daemonState is what the daemon remembers across restarts
+type daemonState struct {
+	LastReport time.Time `json:"last_report"`
+}
+
+// daemonCmd represents the daemon command
+var daemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "Sync in the background and deliver the report on schedule",
+	Long: `Daemon keeps running, syncs your activity every daemon.sync_interval and generates
+the report once a day at daemon.report_time (local time). The report is delivered like
+'my-day report' does: exported when report.export is enabled, and posted to Slack when
+slack is configured and daemon.post_slack is true.
+
+The schedule is checked against the clock every minute, so it survives a laptop
+sleeping: a report missed while asleep is generated once on wake, later the same day.
+Reports are not caught up for earlier days. Restarting the daemon does not send the
+day's report twice.
+
+With --metrics (or metrics.enabled), Prometheus metrics are served at
+http://127.0.0.1:9464/metrics (metrics.addr): sync duration, Jira and LLM requests and
+their latency, report generation time and how often reports fell back without the AI
+summary.
+
+Run it in the background with your service manager, or simply:
+
+  nohup my-day daemon > ~/.my-day/daemon.log 2>&1 &`,
+	Example: `  my-day daemon
+  my-day daemon --report-time 09:30 --sync-interval 1h
+  my-day daemon --report-now
+  my-day daemon --metrics --metrics-addr :9464`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runDaemon(cmd); err != nil {
+			exitWithError("Daemon failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(daemonCmd)
+
+	// Daemon flags
+	daemonCmd.Flags().String("report-time", "", "Local time of the daily report, HH:MM (default: daemon.report_time)")
+	daemonCmd.Flags().Duration("sync-interval", 0, "Time between syncs (default: daemon.sync_interval)")
+	daemonCmd.Flags().Bool("report-now", false, "Generate and deliver the report on start, even if already done today")
+	daemonCmd.Flags().Bool("metrics", false, "Serve Prometheus metrics at /metrics (default: metrics.enabled)")
+	daemonCmd.Flags().String("metrics-addr", "", "Address to serve the metrics on (default: metrics.addr)")
+}
+
+func runDaemon(cmd *cobra.Command) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	if cmd.Flags().Changed("report-time") {
+		cfg.Daemon.ReportTime, _ = cmd.Flags().GetString("report-time")
+	}
+	if cmd.Flags().Changed("sync-interval") {
+		cfg.Daemon.SyncInterval, _ = cmd.Flags().GetDuration("sync-interval")
+	}
+	hour, minute, err := daemon.ParseClock(cfg.Daemon.ReportTime)
+	if err != nil {
+		return err
+	}
+	if cfg.Daemon.SyncInterval < 0 {
+		return fmt.Errorf("sync interval must not be negative")
+	}
+
+	storePath, err := getCacheFilePath()
+	if err != nil {
+		return fmt.Errorf("failed to get store path: %w", err)
+	}
+	var state daemonState
+	if err := withStore(storePath, func(db *store.Store) error {
+		_, err := db.State(daemonStateKey, &state)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// The daemon owns the schedule, so it syncs regardless of how recent the last sync was
+	syncCmd.Flags().Set("force", "true")
+	postSlack := cfg.Daemon.PostSlack && (cfg.Slack.WebhookURL != "" || cfg.Slack.BotToken != "")
+	if postSlack {
+		reportCmd.Flags().Set("post-slack", "true")
+	}
+
+	runner := &daemon.Runner{
+		Schedule: daemon.Schedule{
+			SyncInterval: cfg.Daemon.SyncInterval,
+			ReportHour:   hour,
+			ReportMinute: minute,
+			WeekdaysOnly: cfg.Daemon.WeekdaysOnly,
+		},
+		Sync: func(ctx context.Context) error {
+			start := time.Now()
+			err := syncTickets(syncCmd)
+			metrics.ObserveSync(time.Since(start), err)
+			return err
+		},
+		Report: func(ctx context.Context) error {
+			if err := generateReport(reportCmd); err != nil {
+				return err
+			}
+			return withStore(storePath, func(db *store.Store) error {
+				return db.SetState(daemonStateKey, daemonState{LastReport: time.Now()})
+			})
+		},
+		LastReport: state.LastReport,
+		Logf: func(format string, args ...interface{}) {
+			color.Cyan("[%s] %s", time.Now().Format("15:04"), fmt.Sprintf(format, args...))
+		},
+	}
+	if cache, err := loadCache(storePath); err == nil {
+		runner.LastSync = cache.LastSync
+	}
+	if reportNow, _ := cmd.Flags().GetBool("report-now"); reportNow {
+		if err := runner.ReportNow(context.Background()); err != nil {
+			return fmt.Errorf("failed to generate the report: %w", err)
+		}
+	}
+
+	deliveries := "printed"
+	if targets := exportTargets(cfg); len(targets) > 0 {
+		deliveries += ", exported to " + strings.Join(targets, ", ")
+	}
+	if postSlack {
+		deliveries += ", posted to Slack"
+	}
+	color.Green("✓ my-day daemon started: syncing every %v, report at %s (%s). Press Ctrl+C to stop.", cfg.Daemon.SyncInterval, cfg.Daemon.ReportTime, deliveries)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if metricsEnabled(cmd, cfg) {
+		if cmd.Flags().Changed("metrics-addr") {
+			cfg.Metrics.Addr, _ = cmd.Flags().GetString("metrics-addr")
+		}
+		if err := serveMetrics(ctx, cfg.Metrics.Addr); err != nil {
+			return err
+		}
+		color.Cyan("📈 Prometheus metrics at http://%s/metrics", displayAddr(cfg.Metrics.Addr))
+	}
+	if err := runner.Run(ctx); err != nil && ctx.Err() == nil {
+		return err
+	}
+	color.White("my-day daemon stopped")
+	return nil
+}
+
+// withStore opens the local store for the duration of fn, so the daemon does not hold it
+// open between runs
+func withStore(path string, fn func(db *store.Store) error) error {
+	db, err := store.Open(path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return fn(db)
+}
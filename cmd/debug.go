@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/debugbundle"
+	"my-day/internal/llm"
+)
+
+// debugCmd groups the troubleshooting commands
+var debugCmd = &cobra.Command{
+	Use:   "debug",
+	Short: "Troubleshoot my-day",
+	Long:  `Debug commands help troubleshoot my-day and report bugs.`,
+}
+
+// debugBundleCmd packages diagnostics for bug reports
+var debugBundleCmd = &cobra.Command{
+	Use:   "bundle",
+	Short: "Package diagnostics into an archive to attach to a bug report",
+	Long: `Bundle packages what is needed to reproduce a problem into a single tar.gz archive
+you can attach to a GitHub issue:
+
+  manifest.json           Bundle ID and the SHA-256 of every file
+  version.json            my-day version, commit, build date, Go version and platform
+  config.json             Your configuration with tokens, passwords and emails masked
+  prompts/<hash>.json     The last prompt sent to the LLM and its response or error
+  debug_report.json       The LLM debug report of the last 'my-day report --debug' run
+
+The prompt is stored under the SHA-256 of its mode, model and text, and the bundle ID is
+derived from the files' contents, so the same problem always gives the same bundle. The
+prompt contains your comments; leave it out with --no-prompt, and review the archive
+before sharing it.`,
+	Example: `  my-day report --debug && my-day debug bundle
+  my-day debug bundle --output ~/Desktop/my-day-debug.tar.gz
+  my-day debug bundle --no-prompt`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := createDebugBundle(cmd); err != nil {
+			exitWithError("Debug bundle failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(debugCmd)
+	debugCmd.AddCommand(debugBundleCmd)
+
+	// Debug bundle flags
+	debugBundleCmd.Flags().StringP("output", "o", "", "Archive path (default: my-day-debug-<id>.tar.gz in the current directory)")
+	debugBundleCmd.Flags().Bool("no-prompt", false, "Leave out the last LLM prompt and response")
+}
+
+func createDebugBundle(cmd *cobra.Command) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	versionData, err := json.MarshalIndent(map[string]string{
+		"version": version,
+		"commit":  commit,
+		"date":    date,
+		"go":      runtime.Version(),
+		"os":      runtime.GOOS,
+		"arch":    runtime.GOARCH,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal version: %w", err)
+	}
+	configData, err := json.MarshalIndent(sanitizedConfig(cfg), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+	files := []debugbundle.File{
+		{Name: "version.json", Data: versionData},
+		{Name: "config.json", Data: configData},
+	}
+
+	if noPrompt, _ := cmd.Flags().GetBool("no-prompt"); !noPrompt {
+		record, err := llm.LoadLastPrompt()
+		if err != nil {
+			return fmt.Errorf("failed to load the last prompt: %w", err)
+		}
+		if record != nil {
+			data, err := json.MarshalIndent(record, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal the last prompt: %w", err)
+			}
+			files = append(files, debugbundle.File{Name: "prompts/" + record.Hash() + ".json", Data: data})
+		} else {
+			color.Yellow("No LLM prompt recorded yet. Run 'my-day report' with the LLM enabled to include one.")
+		}
+	}
+
+	debugDir, err := llm.DebugDir()
+	if err != nil {
+		return err
+	}
+	if data, err := os.ReadFile(filepath.Join(debugDir, llm.LastDebugReportFile)); err == nil {
+		files = append(files, debugbundle.File{Name: "debug_report.json", Data: data})
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read the debug report: %w", err)
+	}
+
+	archive, manifest, err := debugbundle.Build(files, time.Now())
+	if err != nil {
+		return err
+	}
+
+	output, _ := cmd.Flags().GetString("output")
+	if output == "" {
+		output = fmt.Sprintf("my-day-debug-%s.tar.gz", manifest.ID)
+	}
+	if err := os.WriteFile(output, archive, 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	color.Green("✓ Debug bundle %s written to %s", manifest.ID, output)
+	for _, file := range files {
+		color.White("  %s", file.Name)
+	}
+	if len(files) > 2 {
+		color.Yellow("Review the archive before attaching it to an issue: the prompt and debug report contain your comments.")
+	}
+	return nil
+}
+
+// sanitizedConfig returns the configuration with secrets and email addresses masked
+func sanitizedConfig(cfg *config.Config) config.Config {
+	sanitized := maskedConfig(cfg)
+	if sanitized.Jira.Email != "" {
+		sanitized.Jira.Email = maskSensitive(sanitized.Jira.Email)
+	}
+	if sanitized.Calendar.Email != "" {
+		sanitized.Calendar.Email = maskSensitive(sanitized.Calendar.Email)
+	}
+	for name, profile := range sanitized.Jira.Profiles {
+		if profile.Email != "" {
+			profile.Email = maskSensitive(profile.Email)
+			sanitized.Jira.Profiles[name] = profile
+		}
+	}
+	return sanitized
+}
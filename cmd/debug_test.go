@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"my-day/internal/config"
+)
+
+// secretField matches the yaml keys of configuration values that must never leave the machine
+var secretField = regexp.MustCompile(`^(token|bot_token|api_key|client_secret|password|passphrase|access_key_id|secret_access_key|webhook_url|email|source)$`)
+
+// fillSecrets sets every secret string field in v to a unique value, recording it under the
+// field's yaml path in secrets
+func fillSecrets(v reflect.Value, path string, secrets map[string]string) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		tag := strings.Split(v.Type().Field(i).Tag.Get("yaml"), ",")[0]
+		switch field.Kind() {
+		case reflect.Struct:
+			fillSecrets(field, path+tag+".", secrets)
+		case reflect.String:
+			if secretField.MatchString(tag) {
+				value := fmt.Sprintf("configured-secret-%d-value", len(secrets))
+				field.SetString(value)
+				secrets[path+tag] = value
+			}
+		}
+	}
+}
+
+func TestSanitizedConfigHidesSecrets(t *testing.T) {
+	var cfg config.Config
+	secrets := make(map[string]string)
+	fillSecrets(reflect.ValueOf(&cfg).Elem(), "", secrets)
+	profile := cfg.Jira
+	profile.Profiles = nil
+	cfg.Jira.Profiles = map[string]config.JiraConfig{"work": profile}
+
+	data, err := json.Marshal(sanitizedConfig(&cfg))
+	if err != nil {
+		t.Fatalf("failed to marshal the sanitized configuration: %v", err)
+	}
+	for path, secret := range secrets {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("debug bundle configuration contains %s", path)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/demo"
+	"my-day/internal/report"
+)
+
+// demoCmd represents the demo command
+var demoCmd = &cobra.Command{
+	Use:   "demo",
+	Short: "Generate sample reports without connecting to Jira",
+	Long: `Demo runs the full report pipeline on bundled sample issues, comments,
+worklogs and GitHub activity so you can see what my-day produces before
+configuring Jira.
+
+By default it prints the console and markdown reports with an AI summary from
+the embedded LLM, which works offline. Pass --llm-mode to try your ollama or
+openai setup instead. No configuration, network access or cached data is
+required, and nothing is written to your cache.`,
+	Example: `  my-day demo
+  my-day demo --format html --output demo.html
+  my-day demo --llm-mode ollama`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runDemo(cmd); err != nil {
+			exitWithError("Demo failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(demoCmd)
+
+	// Demo-specific flags
+	demoCmd.Flags().String("format", "", "Report format (console, markdown, html; default: console and markdown)")
+	demoCmd.Flags().String("output", "", "Output file path (requires --format)")
+	demoCmd.Flags().Bool("no-llm", false, "Disable the AI summary")
+	demoCmd.Flags().Bool("detailed", false, "Show detailed comment excerpts")
+}
+
+func runDemo(cmd *cobra.Command) error {
+	format, _ := cmd.Flags().GetString("format")
+	outputFile, _ := cmd.Flags().GetString("output")
+	if outputFile != "" && format == "" {
+		return fmt.Errorf("--output requires --format")
+	}
+
+	formats := []string{"console", "markdown"}
+	if format != "" {
+		switch format {
+		case "console", "markdown", "html":
+			formats = []string{format}
+		default:
+			return fmt.Errorf("unsupported format %q (use console, markdown or html)", format)
+		}
+	}
+
+	// The embedded LLM needs no setup; other modes come from the configuration when asked for
+	llmConfig := config.LLMConfig{Enabled: true, Mode: "embedded"}
+	if cmd.Flags().Changed("llm-mode") {
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load configuration: %w", err)
+		}
+		llmConfig = cfg.LLM
+		llmConfig.Enabled = true
+	}
+	if noLLM, _ := cmd.Flags().GetBool("no-llm"); noLLM {
+		llmConfig.Enabled = false
+	}
+	detailed, _ := cmd.Flags().GetBool("detailed")
+
+	data := demo.SampleData(time.Now())
+
+	for i, format := range formats {
+		generator := report.NewGenerator(&report.Config{
+			Format:              format,
+			LLMEnabled:          llmConfig.Enabled,
+			LLMMode:             llmConfig.Mode,
+			LLMModel:            llmConfig.Model,
+			LLMLanguage:         llmConfig.Language,
+			OllamaURL:           llmConfig.Ollama.BaseURL,
+			OllamaModel:         llmConfig.Ollama.Model,
+			OllamaIdleStop:      llmConfig.Ollama.IdleStopMinutes,
+			OpenAIURL:           llmConfig.OpenAI.BaseURL,
+			OpenAIAPIKey:        llmConfig.OpenAI.APIKey,
+			OpenAIModel:         llmConfig.OpenAI.Model,
+			OpenAIAPIVersion:    llmConfig.OpenAI.APIVersion,
+			LocalOpenAIURL:      llmConfig.LocalOpenAI.BaseURL,
+			LocalOpenAIAPIKey:   llmConfig.LocalOpenAI.APIKey,
+			LocalOpenAIModel:    llmConfig.LocalOpenAI.Model,
+			IncludeYesterday:    true,
+			IncludeToday:        true,
+			IncludeInProgress:   true,
+			Detailed:            detailed,
+			MaxCommentExcerpt:   500,
+			VarianceThreshold:   20,
+			DuplicateSimilarity: 60,
+			RiskWeights:         report.RiskWeights{Priority: 30, DaysInStatus: 30, Blockers: 25, Sentiment: 15},
+			RiskMinScore:        40,
+			WorkdayHours:        8,
+			GitHubActivity:      data.GitHubActivity,
+		})
+
+		// Generate directly rather than through the report cache so demo output never mixes with real reports
+		content, err := generator.GenerateWithComments(data.IssuesWithComments, data.Worklogs, data.TargetDate)
+		if err != nil {
+			return fmt.Errorf("failed to generate %s report: %w", format, err)
+		}
+
+		if outputFile != "" {
+			if err := os.WriteFile(outputFile, []byte(content), 0644); err != nil {
+				return fmt.Errorf("failed to write report to file: %w", err)
+			}
+			color.Green("✓ Demo %s report saved to: %s", format, outputFile)
+			continue
+		}
+
+		if len(formats) > 1 {
+			if i > 0 {
+				fmt.Println()
+			}
+			color.Cyan("━━━ %s output ━━━", format)
+			fmt.Println()
+		}
+		fmt.Print(content)
+	}
+
+	if outputFile == "" {
+		fmt.Println()
+		color.White("This was sample data from %s. Run 'my-day init' to set up your own Jira connection.", demo.JiraURL)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/integrations/email"
+	"my-day/internal/jira"
+	"my-day/internal/report"
+	"my-day/internal/store"
+)
+
+// teamDigestHistory is how far back the stored team issues are read, so issues in progress
+// without updates for a while are still flagged as risks
+const teamDigestHistory = 28 * 24 * time.Hour
+
+// digestCmd represents the digest command
+var digestCmd = &cobra.Command{
+	Use:   "digest",
+	Short: "Generate a weekly manager digest",
+	Long: `Digest generates a weekly, business-style summary of your work with
+Accomplishments, In Flight, Risks and Next Week sections.
+
+It covers the seven days ending on --week-ending (default: today) using the
+local cache, so run 'my-day sync --since 168h' first for a full week of data.
+
+Issues are flagged as risks when their status is blocked or on hold, when they
+are in progress without updates for more than three days, or when they are
+highest/critical priority and not yet completed.
+
+With --team it summarizes instead the completed work, blockers and risks of each
+member of your team, from the team issues stored by 'my-day report team'.
+
+Formats:
+- markdown: a markdown document
+- html:     a self-contained HTML page
+- email:    an .eml message with plain text and HTML parts, ready to open in a mail client
+
+With --send the digest is emailed through the SMTP server in report.email instead
+of being printed.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := generateDigest(cmd); err != nil {
+			exitWithError("Digest generation failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(digestCmd)
+
+	// Digest-specific flags
+	digestCmd.Flags().String("week-ending", "", "Last day of the week to summarize (YYYY-MM-DD, default: today)")
+	digestCmd.Flags().String("format", "markdown", "Digest format (markdown, html, email)")
+	digestCmd.Flags().String("output", "", "Output file path (default: stdout)")
+	digestCmd.Flags().String("from", "", "Sender address for email format (default: report.email.from, then jira.email)")
+	digestCmd.Flags().StringSlice("to", []string{}, "Recipient addresses for email format (default: report.email.to)")
+	digestCmd.Flags().Bool("team", false, "Summarize each member of your team from the issues stored by 'my-day report team'")
+	digestCmd.Flags().Bool("send", false, "Email the digest through the SMTP server in report.email")
+}
+
+func generateDigest(cmd *cobra.Command) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	cacheFile, err := getCacheFilePath()
+	if err != nil {
+		return fmt.Errorf("failed to get cache file path: %w", err)
+	}
+
+	weekEnd := time.Now()
+	if dateStr, _ := cmd.Flags().GetString("week-ending"); dateStr != "" {
+		weekEnd, err = time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			return fmt.Errorf("invalid date format. Use YYYY-MM-DD: %w", err)
+		}
+	}
+
+	var digest interface {
+		Markdown() string
+		HTML() string
+		Email(from string, to []string) string
+	}
+	if team, _ := cmd.Flags().GetBool("team"); team {
+		members, err := storedTeamMembers(cacheFile, weekEnd)
+		if err != nil {
+			return err
+		}
+		digest = report.BuildTeamDigest(members, weekEnd)
+	} else {
+		cache, err := loadCache(cacheFile)
+		if err != nil {
+			color.Yellow("No cached data found. Run 'my-day sync' first.")
+			return fmt.Errorf("failed to load cache: %w", err)
+		}
+		applyStatusCategories(cache)
+
+		var issuesWithComments []report.IssueWithComments
+		for _, iwc := range cache.IssuesWithComments {
+			issuesWithComments = append(issuesWithComments, report.IssueWithComments{
+				Issue:    iwc.Issue,
+				Comments: iwc.Comments,
+			})
+		}
+		digest = report.BuildDigest(issuesWithComments, cache.Worklogs, weekEnd)
+	}
+
+	from, _ := cmd.Flags().GetString("from")
+	if from == "" {
+		from = cfg.Report.Email.From
+	}
+	if from == "" {
+		from = cfg.Jira.Email
+	}
+	to := cfg.Report.Email.To
+	if cmd.Flags().Changed("to") {
+		to, _ = cmd.Flags().GetStringSlice("to")
+	}
+
+	if send, _ := cmd.Flags().GetBool("send"); send {
+		smtpConfig := email.Config{
+			Host:     cfg.Report.Email.SMTPHost,
+			Port:     cfg.Report.Email.SMTPPort,
+			Username: cfg.Report.Email.Username,
+			Password: cfg.Report.Email.Password,
+		}
+		if err := email.Send(smtpConfig, from, to, []byte(digest.Email(from, to))); err != nil {
+			return fmt.Errorf("failed to email digest: %w", err)
+		}
+		color.Green("✓ Digest emailed to: %s", strings.Join(to, ", "))
+		return nil
+	}
+
+	var content string
+	format, _ := cmd.Flags().GetString("format")
+	switch format {
+	case "markdown":
+		content = digest.Markdown()
+	case "html":
+		content = digest.HTML()
+	case "email":
+		content = digest.Email(from, to)
+	default:
+		return fmt.Errorf("unsupported digest format %q (use markdown, html or email)", format)
+	}
+
+	if outputFile, _ := cmd.Flags().GetString("output"); outputFile != "" {
+		if err := os.WriteFile(outputFile, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write digest to file: %w", err)
+		}
+		color.Green("✓ Digest saved to: %s", outputFile)
+	} else {
+		fmt.Print(content)
+	}
+
+	return nil
+}
+
+// storedTeamMembers returns the team members with the issues assigned to them that are stored by
+// 'my-day report team', as of the week ending on weekEnd
+func storedTeamMembers(storePath string, weekEnd time.Time) ([]report.TeamMember, error) {
+	db, err := store.Open(storePath)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	issues, err := db.TeamIssues(weekEnd.Add(-teamDigestHistory))
+	if err != nil {
+		return nil, err
+	}
+	if len(issues) == 0 {
+		return nil, fmt.Errorf("no team issues stored. Run 'my-day report team --since 168h' first")
+	}
+
+	var members []report.TeamMember
+	index := make(map[string]int)
+	for _, issue := range issues {
+		if issue.Fields.Assignee == nil {
+			continue
+		}
+		id := jira.TeamMemberID(*issue.Fields.Assignee)
+		i, found := index[id]
+		if !found {
+			i = len(members)
+			index[id] = i
+			members = append(members, report.TeamMember{User: *issue.Fields.Assignee})
+		}
+		members[i].Issues = append(members[i].Issues, report.IssueWithComments{Issue: issue})
+	}
+	return members, nil
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+
+	"github.com/fatih/color"
+	"my-day/internal/jira"
+	"my-day/internal/report"
+)
+
+// Exit codes of my-day, so wrapper scripts and CI jobs can tell what went wrong
+const (
+	exitOK             = 0
+	exitError          = 1 // Any other failure, including invalid flags and configuration
+	exitAuth           = 2 // Not authenticated, or the credentials were rejected
+	exitSync           = 3 // Fetching tickets or activity failed
+	exitLLMUnavailable = 4 // The report was generated, but without the AI summary
+	exitExport         = 5 // The report was generated, but an export target failed
+)
+
+// exitCodeError is an error with the exit code it ends the command with
+type exitCodeError struct {
+	code int
+	err  error
+}
+
+func (e *exitCodeError) Error() string { return e.err.Error() }
+func (e *exitCodeError) Unwrap() error { return e.err }
+
+// withExitCode returns err ending the command with code, unless an error it wraps has a more
+// specific code, such as exitAuth for a sync that was not authenticated
+func withExitCode(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &exitCodeError{code: code, err: err}
+}
+
+// exitCode returns the exit code of a failed command's error: exitAuth when Jira rejected the
+// credentials, or else the innermost code given with withExitCode
+func exitCode(err error) int {
+	if errors.Is(err, jira.ErrUnauthorized) {
+		return exitAuth
+	}
+	code := exitError
+	for ; err != nil; err = errors.Unwrap(err) {
+		if coded, ok := err.(*exitCodeError); ok {
+			code = coded.code
+		}
+	}
+	return code
+}
+
+// exitWithError prints the error of a failed command to stderr, where it shows even with
+// --quiet, and exits with its exit code
+func exitWithError(message string, err error) {
+	color.New(color.FgRed).Fprintf(os.Stderr, "%s: %v\n", message, err)
+	os.Exit(exitCode(err))
+}
+
+// exitStatus is the exit code of a command that finished with a problem that did not stop it,
+// such as a report generated without its AI summary
+var exitStatus = exitOK
+
+// llmExitStatus returns exitLLMUnavailable when the last report of generator was written
+// without the configured LLM, including when the embedded model stood in for it
+func llmExitStatus(generator *report.Generator) int {
+	if _, fellBack := generator.LLMFallback(); fellBack {
+		return exitLLMUnavailable
+	}
+	return exitOK
+}
+
+// setExitStatus records a problem that did not stop the command; the highest code is kept
+func setExitStatus(code int) {
+	exitStatus = max(exitStatus, code)
+}
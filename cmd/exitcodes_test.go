@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+	"my-day/internal/report"
+)
+
+// generateWithOllamaDown generates a report with Ollama unreachable, so the embedded model
+// writes the summaries in its place
+func generateWithOllamaDown(t *testing.T) *report.Generator {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	config := &report.Config{Format: "markdown", LLMEnabled: true, LLMMode: "ollama", OllamaURL: server.URL, OllamaModel: "qwen2.5:3b", IncludeToday: true}
+	generator := report.NewGeneratorWithSummarizer(config, llm.NewOllamaSummarizer(llm.LLMConfig{Enabled: true, Mode: "ollama", OllamaURL: server.URL, OllamaModel: "qwen2.5:3b"}))
+
+	targetDate := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+	issues := []report.IssueWithComments{{
+		Issue: jira.Issue{Key: "OPS-1", Fields: jira.Fields{Summary: "Rotate certificates", Status: jira.Status{Name: "In Progress"}, Updated: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)}}},
+		Comments: []jira.Comment{{
+			ID:      "1",
+			Body:    jira.JiraDescription{Text: "Rotated the staging certificates and updated the load balancer listeners"},
+			Created: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)},
+		}},
+	}}
+	if _, err := generator.GenerateWithCommentsAndCache(issues, nil, targetDate, false); err != nil {
+		t.Fatalf("GenerateWithCommentsAndCache() error = %v", err)
+	}
+	return generator
+}
+
+func TestLLMExitStatusWithOllamaDown(t *testing.T) {
+	if code := llmExitStatus(generateWithOllamaDown(t)); code != exitLLMUnavailable {
+		t.Errorf("expected exit code %d when the embedded model stood in for Ollama, got %d", exitLLMUnavailable, code)
+	}
+
+	t.Setenv("HOME", t.TempDir())
+	generator := report.NewGeneratorWithSummarizer(&report.Config{Format: "markdown"}, llm.NewDisabledSummarizer())
+	if _, err := generator.GenerateWithCommentsAndCache(nil, nil, time.Now(), false); err != nil {
+		t.Fatalf("GenerateWithCommentsAndCache() error = %v", err)
+	}
+	if code := llmExitStatus(generator); code != exitOK {
+		t.Errorf("expected exit code %d without the LLM, got %d", exitOK, code)
+	}
+}
@@ -1,15 +1,19 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"my-day/internal/qrcode"
 	"my-day/internal/report"
+	"my-day/internal/share"
 )
 
 // exportCmd represents the export command
@@ -20,11 +24,13 @@ var exportCmd = &cobra.Command{
 
 This command allows you to export previously generated reports to various formats
 and locations without needing to call the LLM again. You can export specific
-dates, date ranges, or all cached reports.`,
+dates, date ranges, or all cached reports.
+
+With --serve, the newest HTML report is also served on the local network behind a
+short-lived link, printed together with a QR code so you can open it on your phone.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := exportReports(cmd); err != nil {
-			color.Red("Export failed: %v", err)
-			os.Exit(1)
+			exitWithError("Export failed", err)
 		}
 	},
 }
@@ -36,11 +42,15 @@ func init() {
 	exportCmd.Flags().String("date", "", "Export report for specific date (YYYY-MM-DD)")
 	exportCmd.Flags().String("from", "", "Export reports from this date (YYYY-MM-DD)")
 	exportCmd.Flags().String("to", "", "Export reports to this date (YYYY-MM-DD)")
-	exportCmd.Flags().StringSlice("format", []string{"markdown"}, "Export formats (markdown, console)")
+	exportCmd.Flags().StringSlice("format", []string{"markdown"}, "Export formats (markdown, console, html)")
 	exportCmd.Flags().String("output-dir", "", "Output directory (default: current directory)")
 	exportCmd.Flags().Bool("list", false, "List available cached reports")
 	exportCmd.Flags().Bool("force", false, "Overwrite existing files")
 	exportCmd.Flags().String("filename-template", "{{.Date}}_{{.Format}}", "Filename template (supports {{.Date}}, {{.Format}}, {{.ID}})")
+	exportCmd.Flags().Bool("serve", false, "Serve the newest HTML report on the local network and print a share link and QR code")
+	exportCmd.Flags().Duration("serve-for", share.DefaultTTL, "How long the share link stays valid")
+	exportCmd.Flags().Int("serve-port", 0, "Port to serve the report on (default: a free port)")
+	exportCmd.Flags().String("serve-host", "", "Host name or address used in the share link (default: this machine's LAN address)")
 }
 
 func exportReports(cmd *cobra.Command) error {
@@ -90,6 +100,10 @@ func exportReports(cmd *cobra.Command) error {
 	}
 	force, _ := cmd.Flags().GetBool("force")
 	filenameTemplate, _ := cmd.Flags().GetString("filename-template")
+	serve, _ := cmd.Flags().GetBool("serve")
+	if serve && !containsString(formats, "html") {
+		return fmt.Errorf("--serve requires --format html")
+	}
 
 	// Ensure output directory exists
 	if err := os.MkdirAll(outputDir, 0755); err != nil {
@@ -110,6 +124,7 @@ func exportReports(cmd *cobra.Command) error {
 	color.Cyan("📋 Exporting %d cached reports...", len(reports))
 
 	exportedCount := 0
+	var served *report.ReportCache
 	for _, reportEntry := range reports {
 		// Load the full report
 		cachedReport, err := cacheManager.LoadReport(reportEntry.ID)
@@ -126,6 +141,10 @@ func exportReports(cmd *cobra.Command) error {
 					color.Yellow("Warning: Cannot convert console format to markdown for report %s", reportEntry.ID)
 					continue
 				}
+				if format == "html" || cachedReport.Format == "html" {
+					color.Yellow("Warning: Cannot convert %s format to %s for report %s", cachedReport.Format, format, reportEntry.ID)
+					continue
+				}
 			}
 
 			// Generate filename
@@ -137,6 +156,11 @@ func exportReports(cmd *cobra.Command) error {
 
 			outputPath := filepath.Join(outputDir, filename)
 
+			// Reports are listed newest first, so the first HTML report is the one to share
+			if serve && format == "html" && served == nil {
+				served = cachedReport
+			}
+
 			// Check if file exists and force flag
 			if _, err := os.Stat(outputPath); err == nil && !force {
 				color.Yellow("Skipping %s (file exists, use --force to overwrite)", outputPath)
@@ -145,6 +169,13 @@ func exportReports(cmd *cobra.Command) error {
 
 			// Export the report
 			content := cachedReport.Content
+			if format == "markdown" {
+				var problems []report.MarkdownProblem
+				content, problems = report.RepairMarkdown(content)
+				if len(problems) > 0 {
+					color.Yellow("Warning: Repaired %d markdown problems in report %s", len(problems), reportEntry.ID)
+				}
+			}
 			if err := os.WriteFile(outputPath, []byte(content), 0644); err != nil {
 				color.Yellow("Warning: Failed to write report to %s: %v", outputPath, err)
 				continue
@@ -161,6 +192,43 @@ func exportReports(cmd *cobra.Command) error {
 	}
 
 	color.Green("✓ Export completed. %d files exported to %s", exportedCount, outputDir)
+
+	if serve {
+		if served == nil {
+			return fmt.Errorf("no HTML report to serve; generate one with 'my-day report --report-format html'")
+		}
+		return serveReport(cmd, served)
+	}
+	return nil
+}
+
+// serveReport serves an HTML report on the local network until the share link expires or
+// the command is interrupted, printing the link and a QR code for it
+func serveReport(cmd *cobra.Command, cachedReport *report.ReportCache) error {
+	ttl, _ := cmd.Flags().GetDuration("serve-for")
+	port, _ := cmd.Flags().GetInt("serve-port")
+	host, _ := cmd.Flags().GetString("serve-host")
+
+	server, err := share.Serve([]byte(cachedReport.Content), host, port, ttl)
+	if err != nil {
+		return fmt.Errorf("failed to serve report: %w", err)
+	}
+
+	color.Cyan("\n📱 Sharing the %s report until %s", cachedReport.Date.Format("2006-01-02"), server.Expires.Format("15:04"))
+	color.White("Open on a device on the same network: %s", server.URL)
+	if code, err := qrcode.Encode(server.URL); err == nil {
+		fmt.Print(code.Terminal())
+	} else {
+		color.Yellow("Warning: Failed to draw QR code: %v", err)
+	}
+	color.White("Press Ctrl+C to stop sharing")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := server.Wait(ctx); err != nil {
+		return err
+	}
+	color.Green("✓ Stopped sharing the report")
 	return nil
 }
 
@@ -234,6 +302,10 @@ func generateFilename(template string, report report.ReportCacheEntry, format st
 		if !strings.HasSuffix(filename, ".txt") {
 			filename += ".txt"
 		}
+	case "html":
+		if !strings.HasSuffix(filename, ".html") {
+			filename += ".html"
+		}
 	}
 	
 	// Ensure filename is safe
@@ -36,8 +36,7 @@ Example:
   my-day github connect --token ghp_xxxxxxxxxxxxxxxxxxxx`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := connectGitHub(cmd); err != nil {
-			color.Red("Failed to connect to GitHub: %v", err)
-			os.Exit(1)
+			exitWithError("Failed to connect to GitHub", err)
 		}
 	},
 }
@@ -49,8 +48,7 @@ var githubStatusCmd = &cobra.Command{
 	Long:  `Show the current GitHub connection status and user information.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := showGitHubStatus(cmd); err != nil {
-			color.Red("Failed to get GitHub status: %v", err)
-			os.Exit(1)
+			exitWithError("Failed to get GitHub status", err)
 		}
 	},
 }
@@ -62,8 +60,7 @@ var githubTestCmd = &cobra.Command{
 	Long:  `Test the GitHub API connection and display user information.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := testGitHubConnection(cmd); err != nil {
-			color.Red("GitHub connection test failed: %v", err)
-			os.Exit(1)
+			exitWithError("GitHub connection test failed", err)
 		}
 	},
 }
@@ -75,8 +72,7 @@ var githubDisconnectCmd = &cobra.Command{
 	Long:  `Remove GitHub authentication and disconnect from the service.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := disconnectGitHub(cmd); err != nil {
-			color.Red("Failed to disconnect from GitHub: %v", err)
-			os.Exit(1)
+			exitWithError("Failed to disconnect from GitHub", err)
 		}
 	},
 }
@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/integrations/slack"
+	"my-day/internal/report"
+)
+
+// handoffCmd represents the handoff command
+var handoffCmd = &cobra.Command{
+	Use:   "handoff",
+	Short: "Generate an end-of-day handoff for a team in another timezone",
+	Long: `Handoff generates a concise "state of play" for the team picking up your
+work in another timezone: what's in flight, what's blocked and what was done
+today, each with the next step from your latest comment and the links
+mentioned in your comments.
+
+The next step is the line of the latest comment starting with "Next:",
+"TODO:", "Tomorrow:" or "Handoff:", or else the latest comment itself.
+Passwords, tokens and keys pasted into comments are left out, as are links
+carrying credentials.
+
+Run it at the end of your day, after 'my-day sync'. --post-slack posts it to
+the receiving team's channel (handoff.webhook_url, or handoff.channel with
+slack.bot_token) and --export saves it next to the exported reports.`,
+	Example: `  my-day handoff
+  my-day handoff --post-slack
+  my-day handoff --post-slack --channel "#apac-ops"
+  my-day handoff --export --output handoff.md`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := generateHandoff(cmd); err != nil {
+			exitWithError("Handoff generation failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(handoffCmd)
+
+	// Handoff-specific flags
+	handoffCmd.Flags().String("date", "", "Day to hand off (YYYY-MM-DD, default: today)")
+	handoffCmd.Flags().String("output", "", "Output file path (default: stdout)")
+	handoffCmd.Flags().Bool("post-slack", false, "Post the handoff to the receiving team's Slack channel (config: handoff.*)")
+	handoffCmd.Flags().String("channel", "", "Slack channel to post to with the bot token (overrides handoff.channel)")
+	handoffCmd.Flags().Bool("export", false, "Save the handoff in the export folder (config: report.export.folder_path)")
+}
+
+func generateHandoff(cmd *cobra.Command) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	cacheFile, err := getCacheFilePath()
+	if err != nil {
+		return fmt.Errorf("failed to get cache file path: %w", err)
+	}
+
+	cache, err := loadCache(cacheFile)
+	if err != nil {
+		color.Yellow("No cached data found. Run 'my-day sync' first.")
+		return fmt.Errorf("failed to load cache: %w", err)
+	}
+	applyStatusCategories(cache)
+
+	date := time.Now()
+	if dateStr, _ := cmd.Flags().GetString("date"); dateStr != "" {
+		date, err = time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			return fmt.Errorf("invalid date format. Use YYYY-MM-DD: %w", err)
+		}
+	}
+
+	var issuesWithComments []report.IssueWithComments
+	for _, iwc := range cache.IssuesWithComments {
+		issuesWithComments = append(issuesWithComments, report.IssueWithComments{
+			Issue:    iwc.Issue,
+			Comments: iwc.Comments,
+		})
+	}
+
+	// GitHub issue keys have no Jira browse link
+	jiraURL := cfg.Jira.BaseURL
+	if cfg.Tracker == "github" {
+		jiraURL = ""
+	}
+	handoff := report.BuildHandoff(issuesWithComments, date, jiraURL)
+	if cache.User != nil {
+		handoff.From = cache.User.DisplayName
+	}
+	content := handoff.Markdown()
+
+	if postSlack, _ := cmd.Flags().GetBool("post-slack"); postSlack {
+		channel := cfg.Handoff.Channel
+		if flagChannel, _ := cmd.Flags().GetString("channel"); flagChannel != "" {
+			channel = flagChannel
+		}
+		if cfg.Handoff.WebhookURL == "" && channel == "" {
+			return fmt.Errorf("no channel to post the handoff to. Set handoff.webhook_url, or handoff.channel (or --channel) with slack.bot_token")
+		}
+		client := slack.NewClient(cfg.Handoff.WebhookURL, cfg.Slack.BotToken, channel)
+		if err := client.Post(context.Background(), buildHandoffSlackMessage(handoff, jiraURL)); err != nil {
+			return fmt.Errorf("failed to post handoff to Slack: %w", err)
+		}
+		color.Green("✓ Handoff posted to Slack (%s)", client.Destination())
+	}
+
+	if export, _ := cmd.Flags().GetBool("export"); export {
+		path, err := report.ExportFilePath(cfg.Report.Export.FolderPath, cfg.Report.Export.FileNameDate, date)
+		if err != nil {
+			return err
+		}
+		path = strings.TrimSuffix(path, ".md") + " handoff.md"
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("failed to create export folder: %w", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write export file: %w", err)
+		}
+		color.Green("✓ Handoff exported to: %s", path)
+	}
+
+	if outputFile, _ := cmd.Flags().GetString("output"); outputFile != "" {
+		if err := os.WriteFile(outputFile, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write handoff to file: %w", err)
+		}
+		color.Green("✓ Handoff saved to: %s", outputFile)
+	} else {
+		fmt.Print(content)
+	}
+
+	return nil
+}
+
+// buildHandoffSlackMessage maps the handoff sections to Slack sections, with the next step,
+// what blocks the issue and the links under each issue
+func buildHandoffSlackMessage(handoff *report.Handoff, jiraURL string) slack.Message {
+	section := func(title string, items []report.HandoffItem) slack.Section {
+		section := slack.Section{Title: title, Notes: make(map[string][]string)}
+		for _, item := range items {
+			section.Issues = append(section.Issues, item.Issue)
+			var notes []string
+			if item.Note != "" {
+				notes = append(notes, item.Note)
+			}
+			if item.NextStep != "" {
+				notes = append(notes, "Next: "+item.NextStep)
+			}
+			section.Notes[item.Issue.Key] = append(notes, item.Links...)
+		}
+		return section
+	}
+
+	summary := fmt.Sprintf("%d in flight · %d blocked · %d done today · generated by my-day", len(handoff.InFlight), len(handoff.Blocked), len(handoff.Done))
+	if handoff.From != "" {
+		summary = "From " + handoff.From + " · " + summary
+	}
+	return slack.BuildTitledMessage("🌏", handoff.Title(), summary, jiraURL, []slack.Section{
+		section("🔄 In Flight", handoff.InFlight),
+		section("🚧 Blocked", handoff.Blocked),
+		section("✅ Done Today", handoff.Done),
+	})
+}
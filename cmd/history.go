@@ -0,0 +1,380 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/report"
+	"my-day/internal/store"
+)
+
+// historyCmd represents the history command
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show your day-by-day activity from the local store",
+	Long: `History shows, for each day, how many comments you wrote, on how many issues,
+the time you logged and whether a report was generated.
+
+It reads the local store that 'my-day sync' fills, so it works offline and covers
+every day synced so far, not only the latest --since window.
+
+Use 'my-day history list' to list the reports generated so far, 'my-day history show DATE'
+to print one, and 'my-day history diff DATE DATE' to see what was completed in between.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := showHistory(cmd); err != nil {
+			exitWithError("History failed", err)
+		}
+	},
+}
+
+// historyListCmd lists generated reports
+var historyListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List previously generated reports",
+	Long: `List shows the reports generated over the last days, from the local store and from
+the Obsidian export folder (report.export.folder_path), with the issues, comments and
+worklogs each covered.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := listHistory(cmd); err != nil {
+			exitWithError("History failed", err)
+		}
+	},
+}
+
+// historyShowCmd prints a generated report
+var historyShowCmd = &cobra.Command{
+	Use:   "show DATE",
+	Short: "Print the report generated on a date",
+	Long: `Show prints the report generated on a date (YYYY-MM-DD) from the local store, the
+latest one when several formats were generated. Reports only found in the Obsidian export
+folder are printed from there.`,
+	Example: `  my-day history show 2024-05-12
+  my-day history show 2024-05-12 --format markdown`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := showHistoryReport(cmd, args[0]); err != nil {
+			exitWithError("History failed", err)
+		}
+	},
+}
+
+// historyDiffCmd compares the reports of two days
+var historyDiffCmd = &cobra.Command{
+	Use:   "diff DATE DATE",
+	Short: "Show the items completed between two reports",
+	Long: `Diff compares the reports generated on two dates (YYYY-MM-DD) and shows the issues
+completed in the later report that were not done in the earlier one, and the issues that
+are new in the later report.
+
+Reports keep the issues they covered since this command was added; regenerate an older
+report with 'my-day report --date DATE' to compare it.`,
+	Example: `  my-day history diff 2024-05-10 2024-05-12`,
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := diffHistory(args[0], args[1]); err != nil {
+			exitWithError("History failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+	historyCmd.AddCommand(historyListCmd)
+	historyCmd.AddCommand(historyShowCmd)
+	historyCmd.AddCommand(historyDiffCmd)
+
+	historyCmd.Flags().Int("days", 14, "Number of days to show, ending today")
+	historyCmd.Flags().String("show", "", "Print the report generated on this date (YYYY-MM-DD)")
+
+	historyListCmd.Flags().Int("days", 30, "Number of days to list, ending today")
+	historyShowCmd.Flags().String("format", "", "Print the report generated in this format (console, markdown, html)")
+}
+
+// openHistory opens the local store holding the report history
+func openHistory() (*store.Store, error) {
+	storePath, err := getCacheFilePath()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store path: %w", err)
+	}
+	return store.Open(storePath)
+}
+
+func showHistory(cmd *cobra.Command) error {
+	if date, _ := cmd.Flags().GetString("show"); date != "" {
+		return showHistoryReport(historyShowCmd, date)
+	}
+
+	db, err := openHistory()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	days, _ := cmd.Flags().GetInt("days")
+	if days < 1 {
+		return fmt.Errorf("--days must be at least 1")
+	}
+
+	today := time.Now()
+	activity, err := db.DailyActivity(today.AddDate(0, 0, -(days-1)), today)
+	if err != nil {
+		return err
+	}
+
+	color.Cyan("📈 Activity over the last %d days", days)
+	fmt.Println()
+	color.White("%-12s %-4s %9s %7s %7s  %s", "Date", "", "Comments", "Issues", "Hours", "Report")
+
+	var totalComments int
+	var totalHours float64
+	activeDays := 0
+	for _, day := range activity {
+		weekday := ""
+		if date, err := time.ParseInLocation("2006-01-02", day.Date, time.Local); err == nil {
+			weekday = date.Weekday().String()[:3]
+		}
+		reported := ""
+		if day.Reported {
+			reported = "✓"
+		}
+		line := fmt.Sprintf("%-12s %-4s %9d %7d %7.1f  %s %s", day.Date, weekday, day.Comments, day.Issues, day.HoursLogged, reported, activityBar(day.Comments))
+		if day.Comments == 0 && day.Worklogs == 0 {
+			color.HiBlack(line)
+			continue
+		}
+		color.White(line)
+		activeDays++
+		totalComments += day.Comments
+		totalHours += day.HoursLogged
+	}
+
+	fmt.Println()
+	color.White("Active days: %d of %d", activeDays, days)
+	color.White("Comments: %d, hours logged: %.1f", totalComments, totalHours)
+	if activeDays > 0 {
+		color.White("Average per active day: %.1f comments, %.1fh logged", float64(totalComments)/float64(activeDays), totalHours/float64(activeDays))
+	}
+
+	return nil
+}
+
+func listHistory(cmd *cobra.Command) error {
+	days, _ := cmd.Flags().GetInt("days")
+	if days < 1 {
+		return fmt.Errorf("--days must be at least 1")
+	}
+
+	db, err := openHistory()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	today := time.Now()
+	from := today.AddDate(0, 0, -(days - 1))
+	records, err := db.Reports(from.Format("2006-01-02"), today.Format("2006-01-02"))
+	if err != nil {
+		return err
+	}
+	cfg, _ := config.Load()
+	byDate := make(map[string][]store.ReportRecord)
+	for _, record := range records {
+		byDate[record.Date] = append(byDate[record.Date], record)
+	}
+
+	color.Cyan("📚 Reports over the last %d days", days)
+	fmt.Println()
+	color.White("%-12s %-4s %-22s %7s %9s %9s  %s", "Date", "", "Formats", "Issues", "Comments", "Worklogs", "Exported")
+
+	listed := 0
+	for day := from; !day.After(today); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		exported := exportedReportPath(cfg, day)
+		dayRecords := byDate[date]
+		if len(dayRecords) == 0 && exported == "" {
+			continue
+		}
+		listed++
+
+		var formats []string
+		var latest store.ReportRecord
+		for _, record := range dayRecords {
+			formats = append(formats, record.Format)
+			if record.GeneratedAt.After(latest.GeneratedAt) {
+				latest = record
+			}
+		}
+		if len(formats) == 0 {
+			color.White("%-12s %-4s %-22s %7s %9s %9s  %s", date, day.Weekday().String()[:3], "-", "", "", "", exported)
+			continue
+		}
+		color.White("%-12s %-4s %-22s %7d %9d %9d  %s", date, day.Weekday().String()[:3], strings.Join(formats, ", "),
+			latest.IssueCount, latest.CommentCount, latest.WorklogCount, exported)
+	}
+
+	fmt.Println()
+	if listed == 0 {
+		color.Yellow("No reports generated in the last %d days", days)
+		return nil
+	}
+	color.White("Use 'my-day history show DATE' to print a report")
+	return nil
+}
+
+func showHistoryReport(cmd *cobra.Command, date string) error {
+	targetDate, err := time.ParseInLocation("2006-01-02", date, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid date format. Use YYYY-MM-DD: %w", err)
+	}
+	format, _ := cmd.Flags().GetString("format")
+
+	db, err := openHistory()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	reports, err := db.Reports(date, date)
+	if err != nil {
+		return err
+	}
+	// Several formats may have been generated; show the latest
+	var latest *store.ReportRecord
+	for i, record := range reports {
+		if format != "" && record.Format != format {
+			continue
+		}
+		if latest == nil || record.GeneratedAt.After(latest.GeneratedAt) {
+			latest = &reports[i]
+		}
+	}
+	if latest != nil {
+		fmt.Print(latest.Content)
+		return nil
+	}
+
+	cfg, _ := config.Load()
+	if path := exportedReportPath(cfg, targetDate); path != "" && (format == "" || format == "markdown") {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read exported report: %w", err)
+		}
+		fmt.Print(string(content))
+		return nil
+	}
+
+	if format != "" {
+		return fmt.Errorf("no %s report generated on %s", format, date)
+	}
+	return fmt.Errorf("no report generated on %s", date)
+}
+
+func diffHistory(fromDate, toDate string) error {
+	for _, date := range []string{fromDate, toDate} {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return fmt.Errorf("invalid date format. Use YYYY-MM-DD: %w", err)
+		}
+	}
+	if fromDate > toDate {
+		fromDate, toDate = toDate, fromDate
+	}
+
+	db, err := openHistory()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	earlier, err := reportWithIssues(db, fromDate)
+	if err != nil {
+		return err
+	}
+	later, err := reportWithIssues(db, toDate)
+	if err != nil {
+		return err
+	}
+
+	covered := make(map[string]bool)
+	for _, issue := range earlier.Issues {
+		covered[issue.Key] = true
+	}
+	var added []store.ReportIssue
+	for _, issue := range later.Issues {
+		if !covered[issue.Key] && !issue.Done {
+			added = append(added, issue)
+		}
+	}
+	completed := store.NewlyCompleted(*earlier, *later)
+
+	color.Cyan("📊 Changes from %s to %s", fromDate, toDate)
+	fmt.Println()
+	if len(completed) == 0 && len(added) == 0 {
+		color.White("No newly completed or new issues")
+		return nil
+	}
+	if len(completed) > 0 {
+		color.Green("✅ Newly completed (%d)", len(completed))
+		for _, issue := range completed {
+			color.White("  %s %s (%s)", issue.Key, truncateString(issue.Summary, 60), issue.Status)
+		}
+		fmt.Println()
+	}
+	if len(added) > 0 {
+		color.Yellow("🆕 New (%d)", len(added))
+		for _, issue := range added {
+			color.White("  %s %s (%s)", issue.Key, truncateString(issue.Summary, 60), issue.Status)
+		}
+		fmt.Println()
+	}
+	return nil
+}
+
+// reportWithIssues returns the latest report of a date that kept the issues it covered
+func reportWithIssues(db *store.Store, date string) (*store.ReportRecord, error) {
+	reports, err := db.Reports(date, date)
+	if err != nil {
+		return nil, err
+	}
+	var latest *store.ReportRecord
+	for i, record := range reports {
+		if record.Issues != nil && (latest == nil || record.GeneratedAt.After(latest.GeneratedAt)) {
+			latest = &reports[i]
+		}
+	}
+	if latest == nil {
+		if len(reports) > 0 {
+			return nil, fmt.Errorf("the report of %s has no issue details, run 'my-day report --date %s' to regenerate it", date, date)
+		}
+		return nil, fmt.Errorf("no report generated on %s", date)
+	}
+	return latest, nil
+}
+
+// exportedReportPath returns the Obsidian export of a date, or "" when there is none.
+// The configuration is optional: history works offline without it.
+func exportedReportPath(cfg *config.Config, date time.Time) string {
+	if cfg == nil || cfg.Report.Export.FolderPath == "" || cfg.Report.Export.FileNameDate == "" {
+		return ""
+	}
+	path, err := report.ExportFilePath(cfg.Report.Export.FolderPath, cfg.Report.Export.FileNameDate, date)
+	if err != nil {
+		return ""
+	}
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
+
+// activityBar draws a small bar proportional to the comment count
+func activityBar(comments int) string {
+	if comments > 20 {
+		comments = 20
+	}
+	return strings.Repeat("▇", comments)
+}
@@ -19,8 +19,7 @@ This will create a config.yaml file in ~/.my-day/ with default settings
 that you can customize for your Jira instance and team projects.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := initializeConfig(cmd); err != nil {
-			color.Red("Error initializing configuration: %v", err)
-			os.Exit(1)
+			exitWithError("Error initializing configuration", err)
 		}
 	},
 }
@@ -88,6 +87,11 @@ func generateConfigTemplate() string {
 # This file contains all settings for the my-day CLI tool
 # Only the Jira base_url needs to be changed for basic usage
 
+# Issue tracker tickets are synced from (CLI: --tracker, env: MY_DAY_TRACKER)
+# Use "github" to report on GitHub Issues assigned to you instead of Jira tickets
+# (connect first with 'my-day github connect')
+tracker: "jira"
+
 # =============================================================================
 # JIRA CONFIGURATION
 # =============================================================================
@@ -101,6 +105,7 @@ jira:
   # export MY_DAY_JIRA_TOKEN="your-api-token"
   email: ""    # Your Jira email address (env: MY_DAY_JIRA_EMAIL)
   token: ""    # Your Jira API token (env: MY_DAY_JIRA_TOKEN)
+  deployment: "auto"   # cloud, server (Jira Server/Data Center) or auto to detect (env: MY_DAY_JIRA_DEPLOYMENT)
   
   # Projects to track (customize for your organization)
   projects:    # env: MY_DAY_JIRA_PROJECTS (comma-separated)
@@ -109,8 +114,37 @@ jira:
     # Add your own projects:
     # - "PROJ"
   
+  # Agile board whose columns are used by 'my-day report --group-by column'
+  # Find the ID in the board URL: .../boards/42
+  board_id: 0  # env: MY_DAY_JIRA_BOARD_ID (0 to disable)
+  
+  # Search results are fetched page by page up to this many issues (0 for no limit)
+  max_results: 1000  # env: MY_DAY_JIRA_MAX_RESULTS (CLI: my-day sync --max-results)
+  
+  # Low-bandwidth mode for hotel Wi-Fi or tethering (CLI: --low-bandwidth)
+  low_bandwidth: false       # env: MY_DAY_JIRA_LOW_BANDWIDTH
+  max_comment_length: 2000   # env: MY_DAY_JIRA_MAX_COMMENT_LENGTH (longer comments skipped in low-bandwidth mode)
+  
+  # Only your comments are synced, matched by your Jira account ID
+  comment_authors: []        # env: MY_DAY_JIRA_COMMENT_AUTHORS (account IDs of others whose comments are synced too)
+  all_comments: false        # env: MY_DAY_JIRA_ALL_COMMENTS (sync everyone's comments; CLI: my-day sync --all-comments)
+  
+  # Team reported on by 'my-day report team', by account ID and/or Jira group
+  team:
+    members: []              # env: MY_DAY_JIRA_TEAM_MEMBERS (account IDs, or usernames on Jira Server/Data Center)
+    group: ""                # env: MY_DAY_JIRA_TEAM_GROUP (Jira group whose members join the team)
+  
+  # Named profiles for other Jira instances or accounts (CLI: --profile, env: MY_DAY_PROFILE)
+  # Each profile overrides only the settings it sets; 'my-day report --profile all' combines them
+  # profiles:
+  #   client-a:
+  #     base_url: "https://client-a.atlassian.net"
+  #     email: "you@client-a.com"
+  #     projects: ["CA"]
+  
   # Custom Fields Configuration (for report grouping)
   # Find field IDs in Jira: Admin > Issues > Custom Fields
+  # --field only accepts the names listed here, custom field IDs and standard fields
   custom_fields:
     squad:
       field_id: "customfield_12944"
@@ -138,21 +172,42 @@ jira:
 # =============================================================================
 llm:
   enabled: true                                      # env: MY_DAY_LLM_ENABLED
-  mode: "ollama"                                     # env: MY_DAY_LLM_MODE (ollama, embedded, disabled)
+  mode: "ollama"                                     # env: MY_DAY_LLM_MODE (ollama, embedded, openai, local-openai, disabled)
   model: "qwen2.5:3b"                                # env: MY_DAY_LLM_MODEL
   
   # LLM Behavior Settings
   debug: false                                       # env: MY_DAY_LLM_DEBUG
   summary_style: "technical"                         # env: MY_DAY_LLM_SUMMARY_STYLE (technical, business, brief)
+  language: "auto"                                   # env: MY_DAY_LLM_LANGUAGE (auto detects from today's comments, or en, es, ...)
   max_summary_length: 0                             # env: MY_DAY_LLM_MAX_SUMMARY_LENGTH (0 = no limit)
   include_technical_details: true                    # env: MY_DAY_LLM_INCLUDE_TECHNICAL_DETAILS
   prioritize_recent_work: true                       # env: MY_DAY_LLM_PRIORITIZE_RECENT_WORK
-  fallback_strategy: "graceful"                      # env: MY_DAY_LLM_FALLBACK_STRATEGY (graceful, strict)
+  fallback_strategy: "graceful"                      # env: MY_DAY_LLM_FALLBACK_STRATEGY (graceful, strict: fail the report without an AI summary)
+  concurrency: 4                                     # env: MY_DAY_LLM_CONCURRENCY (issues summarized at once in detailed reports)
   
   # Ollama Configuration (Docker-based LLM)
   ollama:
     base_url: "http://localhost:11434"               # env: MY_DAY_LLM_OLLAMA_BASE_URL
     model: "qwen2.5:3b"                              # env: MY_DAY_LLM_OLLAMA_MODEL
+    idle_stop_minutes: 0                             # env: MY_DAY_LLM_OLLAMA_IDLE_STOP_MINUTES (stop the Docker container when idle, 0 = never)
+
+  # OpenAI-compatible API (mode: openai) - works with OpenAI, Azure OpenAI and OpenRouter
+  openai:
+    base_url: "https://api.openai.com/v1"            # env: MY_DAY_LLM_OPENAI_BASE_URL
+    model: "gpt-4o-mini"                             # env: MY_DAY_LLM_OPENAI_MODEL
+    api_version: ""                                  # env: MY_DAY_LLM_OPENAI_API_VERSION (Azure only)
+    # api_key: prefer the MY_DAY_LLM_OPENAI_API_KEY environment variable
+
+  # Local OpenAI-compatible server (mode: local-openai) - LM Studio or llama.cpp's llama-server
+  local_openai:
+    base_url: "http://localhost:1234/v1"             # env: MY_DAY_LLM_LOCAL_OPENAI_BASE_URL (llama-server: http://localhost:8080/v1)
+    model: ""                                        # env: MY_DAY_LLM_LOCAL_OPENAI_MODEL (empty for the model loaded in the server)
+
+  # Privacy of remote backends (OpenAI, or Ollama on another machine)
+  privacy:
+    redact: true                                     # env: MY_DAY_LLM_PRIVACY_REDACT (remove emails, hostnames, IPs and secrets)
+    patterns: []                                     # env: MY_DAY_LLM_PRIVACY_PATTERNS (extra regular expressions to remove)
+    allowed_projects: []                             # env: MY_DAY_LLM_PRIVACY_ALLOWED_PROJECTS (empty = all, others use the embedded LLM)
     
   # Model Recommendations:
   # - qwen2.5:3b (1.9GB) - Fast, good balance (default)
@@ -160,14 +215,60 @@ llm:
   # - codellama:7b (3.8GB) - Best for technical/DevOps content
   # - phi3:3.8b (2.3GB) - Good for Microsoft tech stacks
 
+# =============================================================================
+# PROJECT OVERRIDES
+# =============================================================================
+# Summary style and extra LLM instructions for the issues of a project, by key
+# projects:
+#   DAT:
+#     llm:
+#       summary_style: "business"                    # technical, business or brief (empty = llm.summary_style)
+#       prompt: "Mention the customer each change is for"
+#   OPS:
+#     llm:
+#       summary_style: "technical"
+
 # =============================================================================
 # REPORT CONFIGURATION
 # =============================================================================
 report:
-  format: "console"                                  # env: MY_DAY_REPORT_FORMAT (console, markdown)
+  format: "console"                                  # env: MY_DAY_REPORT_FORMAT (console, markdown, html)
   include_yesterday: true                            # env: MY_DAY_REPORT_INCLUDE_YESTERDAY
   include_today: true                                # env: MY_DAY_REPORT_INCLUDE_TODAY
   include_in_progress: true                          # env: MY_DAY_REPORT_INCLUDE_IN_PROGRESS
+  max_comment_excerpt: 500                           # env: MY_DAY_REPORT_MAX_COMMENT_EXCERPT (0 for no limit)
+  variance_threshold: 20                             # env: MY_DAY_REPORT_VARIANCE_THRESHOLD (percent over estimate before flagging)
+  duplicate_similarity: 60                           # env: MY_DAY_REPORT_DUPLICATE_SIMILARITY (percent of shared words to flag possible duplicates, 0 = off)
+  workday_hours: 8                                   # env: MY_DAY_REPORT_WORKDAY_HOURS (time logged is shown as a share of it, 0 = off)
+  template_path: ""                                  # env: MY_DAY_REPORT_TEMPLATE_PATH (Go text/template file replacing the built-in layout)
+  themes: false                                      # env: MY_DAY_REPORT_THEMES (group the AI summary into 2-4 themes of the day's work)
+  group_summaries: false                             # env: MY_DAY_REPORT_GROUP_SUMMARIES (AI mini-summary of each group with --group-by)
+  structured: false                                  # env: MY_DAY_REPORT_STRUCTURED (AI summary as accomplishments, in progress, blockers and next steps)
+  next_steps: false                                  # env: MY_DAY_REPORT_NEXT_STEPS (3-5 next steps for tomorrow proposed by the LLM)
+  layout: "default"                                  # env: MY_DAY_REPORT_LAYOUT (default or standup: Yesterday, Today and Blockers)
+  
+  # Parts of the report to show (CLI: --no-ai-summary, --no-summary, --no-worklog, --no-todo, --no-quality, --no-footer)
+  sections:
+    ai_summary: true                                 # env: MY_DAY_REPORT_SECTIONS_AI_SUMMARY
+    summary: true                                    # env: MY_DAY_REPORT_SECTIONS_SUMMARY (issue, comment and worklog counts)
+    worklog: true                                    # env: MY_DAY_REPORT_SECTIONS_WORKLOG
+    todo: true                                       # env: MY_DAY_REPORT_SECTIONS_TODO
+    quality: true                                    # env: MY_DAY_REPORT_SECTIONS_QUALITY (indicators of --show-quality)
+    footer: true                                     # env: MY_DAY_REPORT_SECTIONS_FOOTER
+  
+  # Icons and labels of Jira statuses, e.g. for non-English workflows (empty fields keep the defaults)
+  # statuses:
+  #   "En curso": { icon: "🔄", label: "In Progress" }
+  #   "Bloqueado": { icon: "🚫", label: "Blocked" }
+  
+  # At-risk list of in-progress issues (set every weight to 0 to turn it off)
+  risk:
+    min_score: 40                                    # env: MY_DAY_REPORT_RISK_MIN_SCORE (0-100)
+    weights:
+      priority: 30                                   # env: MY_DAY_REPORT_RISK_WEIGHTS_PRIORITY
+      days_in_status: 30                             # env: MY_DAY_REPORT_RISK_WEIGHTS_DAYS_IN_STATUS (full weight after 14 days)
+      blockers: 25                                   # env: MY_DAY_REPORT_RISK_WEIGHTS_BLOCKERS (unresolved "is blocked by" links)
+      sentiment: 15                                  # env: MY_DAY_REPORT_RISK_WEIGHTS_SENTIMENT (share of negative comments)
   
   # Obsidian Export Settings
   export:
@@ -175,6 +276,168 @@ report:
     folder_path: "~/Documents/my-day-reports"        # env: MY_DAY_REPORT_EXPORT_FOLDER_PATH
     filename_date: "2006-01-02"                      # env: MY_DAY_REPORT_EXPORT_FILENAME_DATE
     tags: ["report", "my-day", "standup"]            # env: MY_DAY_REPORT_EXPORT_TAGS (comma-separated)
+    target: "obsidian"                               # env: MY_DAY_REPORT_EXPORT_TARGET (obsidian, confluence, notion)
+    confluence:                                      # Used when target is confluence (credentials from 'my-day auth')
+      base_url: ""                                   # env: MY_DAY_REPORT_EXPORT_CONFLUENCE_BASE_URL (default: Jira URL + /wiki)
+      space_key: ""                                  # env: MY_DAY_REPORT_EXPORT_CONFLUENCE_SPACE_KEY
+      parent_id: ""                                  # env: MY_DAY_REPORT_EXPORT_CONFLUENCE_PARENT_ID (empty for space root)
+      title_prefix: "Daily Standup Report"           # env: MY_DAY_REPORT_EXPORT_CONFLUENCE_TITLE_PREFIX
+      mode: "page"                                   # env: MY_DAY_REPORT_EXPORT_CONFLUENCE_MODE (page, team: your section of a shared page)
+      member: ""                                     # env: MY_DAY_REPORT_EXPORT_CONFLUENCE_MEMBER (team page heading, default: your Atlassian name)
+    notion:                                          # Used when target is notion
+      database_id: ""                                # env: MY_DAY_REPORT_EXPORT_NOTION_DATABASE_ID
+      token: ""                                      # env: MY_DAY_REPORT_EXPORT_NOTION_TOKEN (integration secret)
+    daily_note:                                      # Write the report into your Obsidian daily note instead of a note of its own
+      enabled: false                                 # env: MY_DAY_REPORT_EXPORT_DAILY_NOTE_ENABLED
+      folder: ""                                     # env: MY_DAY_REPORT_EXPORT_DAILY_NOTE_FOLDER (default: folder_path)
+      format: "YYYY-MM-DD"                           # env: MY_DAY_REPORT_EXPORT_DAILY_NOTE_FORMAT (the vault's daily note format)
+      heading: "## my-day"                           # env: MY_DAY_REPORT_EXPORT_DAILY_NOTE_HEADING (the report replaces this section only)
+    wiki_links: false                                # env: MY_DAY_REPORT_EXPORT_WIKI_LINKS (issue keys as [[KEY]] wiki-links)
+    issue_notes:                                     # A note per issue with its status, project and URL, for the graph view
+      enabled: false                                 # env: MY_DAY_REPORT_EXPORT_ISSUE_NOTES_ENABLED
+      folder: ""                                     # env: MY_DAY_REPORT_EXPORT_ISSUE_NOTES_FOLDER (default: folder_path/issues)
+    targets:                                         # Export targets run together after the report, in addition to target
+      obsidian: false                                # env: MY_DAY_REPORT_EXPORT_TARGETS_OBSIDIAN
+      confluence: false                              # env: MY_DAY_REPORT_EXPORT_TARGETS_CONFLUENCE
+      notion: false                                  # env: MY_DAY_REPORT_EXPORT_TARGETS_NOTION
+      slack: false                                   # env: MY_DAY_REPORT_EXPORT_TARGETS_SLACK (posts with the slack settings)
+      clipboard: false                               # env: MY_DAY_REPORT_EXPORT_TARGETS_CLIPBOARD
+  
+  # Weekly digest email, sent with 'my-day digest --send' (add --team for the team digest)
+  email:
+    smtp_host: ""                                    # env: MY_DAY_REPORT_EMAIL_SMTP_HOST
+    smtp_port: 587                                   # env: MY_DAY_REPORT_EMAIL_SMTP_PORT (465 for implicit TLS)
+    username: ""                                     # env: MY_DAY_REPORT_EMAIL_USERNAME (empty to send without authentication)
+    password: ""                                     # env: MY_DAY_REPORT_EMAIL_PASSWORD
+    from: ""                                         # env: MY_DAY_REPORT_EMAIL_FROM (default: jira.email)
+    to: []                                           # env: MY_DAY_REPORT_EMAIL_TO (comma-separated)
+
+# =============================================================================
+# CALENDAR INTEGRATION
+# =============================================================================
+# iCalendar (.ics) file path or URL used by 'my-day log --from-calendar' and
+# for the meetings you attended in the report summary
+calendar:
+  source: ""                                         # env: MY_DAY_CALENDAR_SOURCE
+  email: ""                                          # env: MY_DAY_CALENDAR_EMAIL (your address on invitations, empty = jira.email)
+
+# =============================================================================
+# STATE SYNC BETWEEN MACHINES
+# =============================================================================
+# Encrypted ticket cache and report history for 'my-day sync-state push/pull'
+# Keep the passphrase and backend credentials in environment variables.
+sync_state:
+  backend: ""                                        # env: MY_DAY_SYNC_STATE_BACKEND (dir, webdav, git, s3)
+  # passphrase: ""                                   # env: MY_DAY_SYNC_STATE_PASSPHRASE
+  # path: "~/Dropbox/my-day"                         # dir backend
+  # url: "https://cloud.example.com/remote.php/dav/files/me/my-day"  # webdav or git repository URL
+  # username: "me"                                   # webdav; password via MY_DAY_SYNC_STATE_PASSWORD
+  # bucket: "my-day-state"                           # s3; keys via AWS_ACCESS_KEY_ID / AWS_SECRET_ACCESS_KEY
+  # region: "us-east-1"
+  # endpoint: ""                                     # s3-compatible endpoint (MinIO, R2)
+
+# =============================================================================
+# GITLAB INTEGRATION
+# =============================================================================
+# Merge requests, commits and pipelines from GitLab.com or a self-hosted
+# instance. Create a personal access token with the read_api scope.
+gitlab:
+  enabled: false                                     # env: MY_DAY_GITLAB_ENABLED
+  base_url: "https://gitlab.com"                     # env: MY_DAY_GITLAB_BASE_URL
+  # token: ""                                        # env: MY_DAY_GITLAB_TOKEN
+  projects: []                                       # env: MY_DAY_GITLAB_PROJECTS (empty = all member projects)
+  include_merge_requests: true
+  include_commits: true
+  include_pipelines: true
+
+# =============================================================================
+# TRELLO AND ASANA (READ-ONLY)
+# =============================================================================
+# Cards you created, moved or commented on and Asana tasks you completed, moved
+# or commented on, reported as extra sections for side work tracked there.
+# Trello: get an API key and token at https://trello.com/power-ups/admin
+trello:
+  enabled: false                                     # env: MY_DAY_TRELLO_ENABLED
+  # api_key: ""                                      # env: MY_DAY_TRELLO_API_KEY
+  # token: ""                                        # env: MY_DAY_TRELLO_TOKEN
+  boards: []                                         # env: MY_DAY_TRELLO_BOARDS (IDs or names, empty = all boards)
+
+# Asana: create a personal access token in My Settings > Apps > Developer apps
+asana:
+  enabled: false                                     # env: MY_DAY_ASANA_ENABLED
+  # token: ""                                        # env: MY_DAY_ASANA_TOKEN
+  workspace: ""                                      # env: MY_DAY_ASANA_WORKSPACE (GID, empty = all workspaces)
+  projects: []                                       # env: MY_DAY_ASANA_PROJECTS (GIDs or names, empty = all)
+
+# =============================================================================
+# TIME TRACKING
+# =============================================================================
+# Time entries from Toggl or Harvest are added to the Work Log section and the
+# LLM summary. Put issue keys such as PROJ-123 in entry descriptions to link them.
+# Toggl: API token from Profile settings. Harvest: personal access token and
+# account ID from https://id.getharvest.com/developers
+time_tracking:
+  provider: ""                                       # env: MY_DAY_TIME_TRACKING_PROVIDER (toggl or harvest, empty = disabled)
+  # token: ""                                        # env: MY_DAY_TIME_TRACKING_TOKEN
+  # account_id: ""                                   # env: MY_DAY_TIME_TRACKING_ACCOUNT_ID (Harvest only)
+
+# Tempo Timesheets: worklogs come from Tempo instead of native Jira worklogs.
+# API token from Tempo > Settings > API integration
+tempo:
+  enabled: false                                     # env: MY_DAY_TEMPO_ENABLED
+  # token: ""                                        # env: MY_DAY_TEMPO_TOKEN
+  base_url: "https://api.tempo.io/4"                 # env: MY_DAY_TEMPO_BASE_URL
+
+# =============================================================================
+# SLACK
+# =============================================================================
+# Destination for 'my-day report --post-slack'. Use an incoming webhook, or a
+# bot token with the chat:write scope and a channel.
+slack:
+  webhook_url: ""                                    # env: MY_DAY_SLACK_WEBHOOK_URL
+  # bot_token: ""                                    # env: MY_DAY_SLACK_BOT_TOKEN
+  # channel: "#standup"                              # env: MY_DAY_SLACK_CHANNEL
+
+# =============================================================================
+# HANDOFF
+# =============================================================================
+# Channel of the team picking up your work in another timezone, for
+# 'my-day handoff --post-slack'. Use its incoming webhook, or a channel with
+# the slack bot token.
+handoff:
+  webhook_url: ""                                    # env: MY_DAY_HANDOFF_WEBHOOK_URL
+  # channel: "#emea-ops"                             # env: MY_DAY_HANDOFF_CHANNEL
+
+# =============================================================================
+# VOICE NOTES
+# =============================================================================
+# Speech engine for 'my-day report --speak' and --speak-output. The local engine
+# uses say (macOS), espeak-ng (Linux) or System.Speech (Windows); openai uses the
+# llm.openai base_url and api_key.
+tts:
+  engine: "local"                                    # env: MY_DAY_TTS_ENGINE (local, openai)
+  voice: ""                                          # env: MY_DAY_TTS_VOICE (e.g. Samantha, en-us, alloy)
+  model: "tts-1"                                     # env: MY_DAY_TTS_MODEL (openai engine only)
+
+# =============================================================================
+# DAEMON
+# =============================================================================
+# Schedule of 'my-day daemon': it syncs in the background and generates the
+# report once a day, then exports it (report.export) and posts it to Slack.
+daemon:
+  sync_interval: "30m"                               # env: MY_DAY_DAEMON_SYNC_INTERVAL (0 to only sync before the report)
+  report_time: "08:45"                               # env: MY_DAY_DAEMON_REPORT_TIME (local time, HH:MM)
+  weekdays_only: true                                # env: MY_DAY_DAEMON_WEEKDAYS_ONLY
+  post_slack: true                                   # env: MY_DAY_DAEMON_POST_SLACK (when slack is configured)
+
+# =============================================================================
+# METRICS
+# =============================================================================
+# Prometheus metrics of 'my-day daemon' and 'my-day serve' at /metrics: syncs,
+# Jira and LLM requests, report generation time and LLM fallbacks.
+metrics:
+  enabled: false                                     # env: MY_DAY_METRICS_ENABLED
+  addr: "127.0.0.1:9464"                             # env: MY_DAY_METRICS_ADDR (daemon only, serve uses --addr)
 
 # =============================================================================
 # ADVANCED SETTINGS
@@ -183,7 +446,10 @@ report:
 
 # Global settings
 verbose: false                                       # env: MY_DAY_VERBOSE
-quiet: false                                         # env: MY_DAY_QUIET
+quiet: false                                         # env: MY_DAY_QUIET (print only results and errors)
+trace: false                                         # env: MY_DAY_TRACE (log Jira and LLM requests with their request IDs)
+log_level: "auto"                                    # env: MY_DAY_LOG_LEVEL (debug, info, warn, error; auto is warn, debug with --verbose)
+log_file: ""                                         # env: MY_DAY_LOG_FILE (log also written here as JSON lines)
 
 # =============================================================================
 # USAGE EXAMPLES
@@ -236,6 +502,7 @@ jira:
   # Set these via environment or use 'my-day auth' command
   email: ""    # Your Jira email (env: MY_DAY_JIRA_EMAIL)
   token: ""    # Your Jira API token (env: MY_DAY_JIRA_TOKEN)
+  deployment: "auto"   # cloud, server (Jira Server/Data Center) or auto (env: MY_DAY_JIRA_DEPLOYMENT)
   
   # TODO: Update these project keys to match your organization
   projects:    # env: MY_DAY_JIRA_PROJECTS (comma-separated)
@@ -247,32 +514,110 @@ jira:
 # =============================================================================
 llm:
   enabled: true                                      # env: MY_DAY_LLM_ENABLED
-  mode: "ollama"                                     # env: MY_DAY_LLM_MODE (ollama, embedded, disabled)
+  mode: "ollama"                                     # env: MY_DAY_LLM_MODE (ollama, embedded, openai, local-openai, disabled)
   model: "qwen2.5:3b"                                # env: MY_DAY_LLM_MODEL
   
   # AI Behavior
   summary_style: "technical"                         # env: MY_DAY_LLM_SUMMARY_STYLE (technical, business, brief)
+  language: "auto"                                   # env: MY_DAY_LLM_LANGUAGE (auto detects from today's comments, or en, es, ...)
   include_technical_details: true                    # env: MY_DAY_LLM_INCLUDE_TECHNICAL_DETAILS
+  concurrency: 4                                     # env: MY_DAY_LLM_CONCURRENCY (issues summarized at once in detailed reports)
   
   # Docker LLM Settings
   ollama:
     base_url: "http://localhost:11434"               # env: MY_DAY_LLM_OLLAMA_BASE_URL
     model: "qwen2.5:3b"                              # env: MY_DAY_LLM_OLLAMA_MODEL
+    idle_stop_minutes: 0                             # env: MY_DAY_LLM_OLLAMA_IDLE_STOP_MINUTES (stop the Docker container when idle, 0 = never)
+
+  # Privacy of remote backends
+  privacy:
+    redact: true                                     # env: MY_DAY_LLM_PRIVACY_REDACT (remove emails, hostnames, IPs and secrets)
+    allowed_projects: []                             # env: MY_DAY_LLM_PRIVACY_ALLOWED_PROJECTS (empty = all)
+
+# =============================================================================
+# PROJECT OVERRIDES
+# =============================================================================
+# Summary style and extra LLM instructions for the issues of a project, by key
+# projects:
+#   DAT:
+#     llm:
+#       summary_style: "business"                    # technical, business or brief (empty = llm.summary_style)
+#       prompt: "Mention the customer each change is for"
+#   OPS:
+#     llm:
+#       summary_style: "technical"
 
 # =============================================================================
 # REPORT CONFIGURATION
 # =============================================================================
 report:
-  format: "console"                                  # env: MY_DAY_REPORT_FORMAT (console, markdown)
+  format: "console"                                  # env: MY_DAY_REPORT_FORMAT (console, markdown, html)
   include_yesterday: true                            # env: MY_DAY_REPORT_INCLUDE_YESTERDAY
   include_today: true                                # env: MY_DAY_REPORT_INCLUDE_TODAY
   include_in_progress: true                          # env: MY_DAY_REPORT_INCLUDE_IN_PROGRESS
+  max_comment_excerpt: 500                           # env: MY_DAY_REPORT_MAX_COMMENT_EXCERPT (0 for no limit)
+  variance_threshold: 20                             # env: MY_DAY_REPORT_VARIANCE_THRESHOLD (percent over estimate before flagging)
+  duplicate_similarity: 60                           # env: MY_DAY_REPORT_DUPLICATE_SIMILARITY (percent of shared words to flag possible duplicates, 0 = off)
+  workday_hours: 8                                   # env: MY_DAY_REPORT_WORKDAY_HOURS (time logged is shown as a share of it, 0 = off)
+  template_path: ""                                  # env: MY_DAY_REPORT_TEMPLATE_PATH (Go text/template file replacing the built-in layout)
+  themes: false                                      # env: MY_DAY_REPORT_THEMES (group the AI summary into 2-4 themes of the day's work)
+  group_summaries: false                             # env: MY_DAY_REPORT_GROUP_SUMMARIES (AI mini-summary of each group with --group-by)
+  structured: false                                  # env: MY_DAY_REPORT_STRUCTURED (AI summary as accomplishments, in progress, blockers and next steps)
+  next_steps: false                                  # env: MY_DAY_REPORT_NEXT_STEPS (3-5 next steps for tomorrow proposed by the LLM)
+  layout: "default"                                  # env: MY_DAY_REPORT_LAYOUT (default or standup: Yesterday, Today and Blockers)
+  
+  # Parts of the report to show (CLI: --no-ai-summary, --no-summary, --no-worklog, --no-todo, --no-quality, --no-footer)
+  sections:
+    ai_summary: true                                 # env: MY_DAY_REPORT_SECTIONS_AI_SUMMARY
+    summary: true                                    # env: MY_DAY_REPORT_SECTIONS_SUMMARY (issue, comment and worklog counts)
+    worklog: true                                    # env: MY_DAY_REPORT_SECTIONS_WORKLOG
+    todo: true                                       # env: MY_DAY_REPORT_SECTIONS_TODO
+    quality: true                                    # env: MY_DAY_REPORT_SECTIONS_QUALITY (indicators of --show-quality)
+    footer: true                                     # env: MY_DAY_REPORT_SECTIONS_FOOTER
+  
+  # Icons and labels of Jira statuses, e.g. for non-English workflows (empty fields keep the defaults)
+  # statuses:
+  #   "En curso": { icon: "🔄", label: "In Progress" }
+  #   "Bloqueado": { icon: "🚫", label: "Blocked" }
+  
+  # At-risk list of in-progress issues (set every weight to 0 to turn it off)
+  risk:
+    min_score: 40                                    # env: MY_DAY_REPORT_RISK_MIN_SCORE (0-100)
+    weights:
+      priority: 30                                   # env: MY_DAY_REPORT_RISK_WEIGHTS_PRIORITY
+      days_in_status: 30                             # env: MY_DAY_REPORT_RISK_WEIGHTS_DAYS_IN_STATUS (full weight after 14 days)
+      blockers: 25                                   # env: MY_DAY_REPORT_RISK_WEIGHTS_BLOCKERS (unresolved "is blocked by" links)
+      sentiment: 15                                  # env: MY_DAY_REPORT_RISK_WEIGHTS_SENTIMENT (share of negative comments)
   
   # Obsidian Export (optional)
   export:
     enabled: false                                   # env: MY_DAY_REPORT_EXPORT_ENABLED
     folder_path: "~/Documents/my-day-reports"        # env: MY_DAY_REPORT_EXPORT_FOLDER_PATH
     tags: ["standup", "my-day"]                      # env: MY_DAY_REPORT_EXPORT_TAGS (comma-separated)
+    daily_note:                                      # Write the report into your Obsidian daily note instead of a note of its own
+      enabled: false                                 # env: MY_DAY_REPORT_EXPORT_DAILY_NOTE_ENABLED
+      folder: ""                                     # env: MY_DAY_REPORT_EXPORT_DAILY_NOTE_FOLDER (default: folder_path)
+      format: "YYYY-MM-DD"                           # env: MY_DAY_REPORT_EXPORT_DAILY_NOTE_FORMAT (the vault's daily note format)
+      heading: "## my-day"                           # env: MY_DAY_REPORT_EXPORT_DAILY_NOTE_HEADING (the report replaces this section only)
+    wiki_links: false                                # env: MY_DAY_REPORT_EXPORT_WIKI_LINKS (issue keys as [[KEY]] wiki-links)
+    issue_notes:                                     # A note per issue with its status, project and URL, for the graph view
+      enabled: false                                 # env: MY_DAY_REPORT_EXPORT_ISSUE_NOTES_ENABLED
+      folder: ""                                     # env: MY_DAY_REPORT_EXPORT_ISSUE_NOTES_FOLDER (default: folder_path/issues)
+    targets:                                         # Export targets run together after the report, in addition to target
+      obsidian: false                                # env: MY_DAY_REPORT_EXPORT_TARGETS_OBSIDIAN
+      confluence: false                              # env: MY_DAY_REPORT_EXPORT_TARGETS_CONFLUENCE
+      notion: false                                  # env: MY_DAY_REPORT_EXPORT_TARGETS_NOTION
+      slack: false                                   # env: MY_DAY_REPORT_EXPORT_TARGETS_SLACK (posts with the slack settings)
+      clipboard: false                               # env: MY_DAY_REPORT_EXPORT_TARGETS_CLIPBOARD
+  
+  # Weekly digest email, sent with 'my-day digest --send' (add --team for the team digest)
+  email:
+    smtp_host: ""                                    # env: MY_DAY_REPORT_EMAIL_SMTP_HOST
+    smtp_port: 587                                   # env: MY_DAY_REPORT_EMAIL_SMTP_PORT (465 for implicit TLS)
+    username: ""                                     # env: MY_DAY_REPORT_EMAIL_USERNAME (empty to send without authentication)
+    password: ""                                     # env: MY_DAY_REPORT_EMAIL_PASSWORD
+    from: ""                                         # env: MY_DAY_REPORT_EMAIL_FROM (default: jira.email)
+    to: []                                           # env: MY_DAY_REPORT_EMAIL_TO (comma-separated)
 
 # =============================================================================
 # Ready to use! Try these commands after setting your Jira URL:
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/llm"
+)
+
+// suggestLabelsCmd represents the suggest-labels command
+var suggestLabelsCmd = &cobra.Command{
+	Use:   "suggest-labels",
+	Short: "Suggest Jira labels from the technologies in your comments",
+	Long: `Suggest-labels proposes Jira labels for your issues from the technologies mentioned
+in their comments (terraform, kubernetes, kafka, ...), as detected by the comment
+processor. Generic terms such as "api" or "database" are not proposed, nor are labels
+an issue already has.
+
+With --apply, each issue's labels are shown for confirmation before they are added
+in Jira. Existing labels are kept.
+
+Run 'my-day sync' first so there are comments to read.`,
+	Example: `  my-day suggest-labels
+  my-day suggest-labels --issue OPS-123 --min-mentions 1
+  my-day suggest-labels --apply`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := suggestLabels(cmd); err != nil {
+			exitWithError("Suggest labels failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(suggestLabelsCmd)
+
+	// Suggest-labels flags
+	suggestLabelsCmd.Flags().String("issue", "", "Only suggest labels for this issue key")
+	suggestLabelsCmd.Flags().Int("min-mentions", 2, "Comments that must mention a technology before it is suggested")
+	suggestLabelsCmd.Flags().Bool("apply", false, "Add the suggested labels in Jira after confirmation")
+	suggestLabelsCmd.Flags().Bool("yes", false, "Add labels without asking for confirmation (with --apply)")
+}
+
+// issueLabels is an issue with its suggested labels
+type issueLabels struct {
+	key         string
+	summary     string
+	suggestions []llm.LabelSuggestion
+}
+
+func suggestLabels(cmd *cobra.Command) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	cacheFile, err := getCacheFilePath()
+	if err != nil {
+		return fmt.Errorf("failed to get cache file path: %w", err)
+	}
+	cache, err := loadCache(cacheFile)
+	if err != nil {
+		color.Yellow("No cached data found. Run 'my-day sync' first.")
+		return fmt.Errorf("failed to load cache: %w", err)
+	}
+
+	issueKey, _ := cmd.Flags().GetString("issue")
+	minMentions, _ := cmd.Flags().GetInt("min-mentions")
+	if minMentions < 1 {
+		minMentions = 1
+	}
+
+	var proposals []issueLabels
+	seen := make(map[string]bool)
+	for _, iwc := range cache.IssuesWithComments {
+		if seen[iwc.Issue.Key] || (issueKey != "" && !strings.EqualFold(iwc.Issue.Key, issueKey)) {
+			continue
+		}
+		seen[iwc.Issue.Key] = true
+
+		if suggestions := llm.SuggestLabels(iwc.Issue, iwc.Comments, minMentions); len(suggestions) > 0 {
+			proposals = append(proposals, issueLabels{key: iwc.Issue.Key, summary: iwc.Issue.Fields.Summary, suggestions: suggestions})
+		}
+	}
+
+	if issueKey != "" && len(seen) == 0 {
+		color.Yellow("No cached comments for %s", issueKey)
+		return nil
+	}
+	if len(proposals) == 0 {
+		color.Yellow("No labels to suggest")
+		return nil
+	}
+
+	fmt.Println()
+	for _, proposal := range proposals {
+		var labels []string
+		for _, suggestion := range proposal.suggestions {
+			labels = append(labels, fmt.Sprintf("%s (%d)", suggestion.Label, suggestion.Mentions))
+		}
+		color.Cyan("🏷️  %s %s", proposal.key, truncateString(proposal.summary, 50))
+		color.White("    %s", strings.Join(labels, ", "))
+	}
+	fmt.Println()
+
+	if apply, _ := cmd.Flags().GetBool("apply"); !apply {
+		color.White("Run with --apply to add them in Jira")
+		return nil
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	skipConfirm, _ := cmd.Flags().GetBool("yes")
+	labeled := 0
+	for _, proposal := range proposals {
+		var labels []string
+		for _, suggestion := range proposal.suggestions {
+			labels = append(labels, suggestion.Label)
+		}
+
+		if !skipConfirm {
+			fmt.Printf("Add %s to %s? (y/N): ", strings.Join(labels, ", "), proposal.key)
+			var response string
+			fmt.Scanln(&response)
+			if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
+				continue
+			}
+		}
+
+		if err := client.AddLabels(ctx, proposal.key, labels); err != nil {
+			color.Yellow("Warning: Failed to label %s: %v", proposal.key, err)
+			continue
+		}
+		labeled++
+	}
+
+	color.Green("✓ Labeled %d of %d issues", labeled, len(proposals))
+	if labeled > 0 {
+		color.White("Run 'my-day sync --force' to refresh the cached labels")
+	}
+
+	return nil
+}
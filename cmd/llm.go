@@ -3,9 +3,12 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"my-day/internal/config"
 	"my-day/internal/jira"
 	"my-day/internal/llm"
@@ -26,8 +29,7 @@ var llmTestCmd = &cobra.Command{
 	Long:  "Test if the configured LLM service is available and working.",
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := testLLMConnection(); err != nil {
-			color.Red("LLM test failed: %v", err)
-			os.Exit(1)
+			exitWithError("LLM test failed", err)
 		}
 	},
 }
@@ -38,8 +40,7 @@ var llmStatusCmd = &cobra.Command{
 	Long:  "Display current LLM configuration and status.",
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := showLLMStatus(); err != nil {
-			color.Red("Error showing LLM status: %v", err)
-			os.Exit(1)
+			exitWithError("Error showing LLM status", err)
 		}
 	},
 }
@@ -50,8 +51,7 @@ var llmStartCmd = &cobra.Command{
 	Long:  "Start the Docker LLM container for better summarization.",
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := startDockerLLM(); err != nil {
-			color.Red("Failed to start Docker LLM: %v", err)
-			os.Exit(1)
+			exitWithError("Failed to start Docker LLM", err)
 		}
 	},
 }
@@ -62,8 +62,31 @@ var llmStopCmd = &cobra.Command{
 	Long:  "Stop the Docker LLM container to free up resources.",
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := stopDockerLLM(); err != nil {
-			color.Red("Failed to stop Docker LLM: %v", err)
-			os.Exit(1)
+			exitWithError("Failed to stop Docker LLM", err)
+		}
+	},
+}
+
+var llmPsCmd = &cobra.Command{
+	Use:   "ps",
+	Short: "Show the Docker LLM container",
+	Long:  "Show the status of the Docker LLM container, its idle stop, and the models loaded in Ollama.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := showDockerLLM(); err != nil {
+			exitWithError("Failed to inspect Docker LLM", err)
+		}
+	},
+}
+
+var llmLogsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Show the Docker LLM container logs",
+	Long:  "Show the latest logs of the Docker LLM container, or follow them with --follow.",
+	Run: func(cmd *cobra.Command, args []string) {
+		tail, _ := cmd.Flags().GetInt("tail")
+		follow, _ := cmd.Flags().GetBool("follow")
+		if err := llm.NewDockerLLMManager().Logs(tail, follow, os.Stdout); err != nil {
+			exitWithError("Failed to show Docker LLM logs", err)
 		}
 	},
 }
@@ -74,8 +97,19 @@ var llmModelsCmd = &cobra.Command{
 	Long:  "List available LLM models for the current LLM mode.",
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := listAvailableModels(); err != nil {
-			color.Red("Failed to list models: %v", err)
-			os.Exit(1)
+			exitWithError("Failed to list models", err)
+		}
+	},
+}
+
+var llmPullCmd = &cobra.Command{
+	Use:   "pull [model-name]",
+	Short: "Download an Ollama model",
+	Long:  "Download a model into the configured Ollama server, showing its progress. Use 'my-day llm models' to see installed models.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := pullOllamaModel(args[0]); err != nil {
+			exitWithError("Failed to pull model", err)
 		}
 	},
 }
@@ -83,13 +117,33 @@ var llmModelsCmd = &cobra.Command{
 var llmSwitchCmd = &cobra.Command{
 	Use:   "switch [model-name]",
 	Short: "Switch LLM model",
-	Long:  "Switch to a different LLM model. Use 'my-day llm models' to see available models.",
-	Args:  cobra.ExactArgs(1),
+	Long: `Switch to a different LLM model. Use 'my-day llm models' to see available models.
+
+The model is written into the config file, keeping its comments. Use --dry-run to preview the
+change, or --temporary to only print the environment variables and flags that select it.`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		modelName := args[0]
-		if err := switchLLMModel(modelName); err != nil {
-			color.Red("Failed to switch model: %v", err)
-			os.Exit(1)
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		temporary, _ := cmd.Flags().GetBool("temporary")
+		if err := switchLLMModel(modelName, dryRun, temporary); err != nil {
+			exitWithError("Failed to switch model", err)
+		}
+	},
+}
+
+var llmEvalCmd = &cobra.Command{
+	Use:   "eval",
+	Short: "Evaluate summary quality across models",
+	Long: `Summarize a bundled corpus of anonymized issues and comments with the active LLM backend and
+score the summaries: the quality heuristics of --show-quality, the confidence of the technical
+patterns recognized, and ROUGE-1 and ROUGE-L overlap with reference summaries. Compare models
+with --models, e.g. --models qwen2.5:3b,llama3.1:8b; the embedded LLM is scored as a baseline.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		models, _ := cmd.Flags().GetStringSlice("models")
+		corpus, _ := cmd.Flags().GetString("corpus")
+		if err := evaluateLLM(models, corpus); err != nil {
+			exitWithError("Failed to evaluate LLM", err)
 		}
 	},
 }
@@ -100,29 +154,94 @@ func init() {
 	llmCmd.AddCommand(llmStatusCmd)
 	llmCmd.AddCommand(llmStartCmd)
 	llmCmd.AddCommand(llmStopCmd)
+	llmCmd.AddCommand(llmPsCmd)
+	llmCmd.AddCommand(llmLogsCmd)
 	llmCmd.AddCommand(llmModelsCmd)
+	llmCmd.AddCommand(llmPullCmd)
 	llmCmd.AddCommand(llmSwitchCmd)
-}
+	llmCmd.AddCommand(llmEvalCmd)
 
-func testLLMConnection() error {
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load configuration: %w", err)
-	}
+	llmLogsCmd.Flags().Int("tail", 100, "Number of lines to show from the end of the logs")
+	llmLogsCmd.Flags().BoolP("follow", "f", false, "Keep following the logs")
 
-	llmConfig := llm.LLMConfig{
+	llmSwitchCmd.Flags().Bool("dry-run", false, "Show the config file change without writing it")
+	llmSwitchCmd.Flags().Bool("temporary", false, "Don't change the config file, only show how to use the model for a session")
+
+	llmEvalCmd.Flags().StringSlice("models", nil, "Models of the active mode to compare (default: the configured model)")
+	llmEvalCmd.RegisterFlagCompletionFunc("models", completeOllamaModels)
+	llmSwitchCmd.ValidArgsFunction = completeModelArg
+	llmEvalCmd.Flags().String("corpus", "", "JSON file of cases to use instead of the bundled corpus")
+}
+
+// newLLMConfig builds the LLM package configuration from the loaded application config
+func newLLMConfig(cfg *config.Config) llm.LLMConfig {
+	return llm.LLMConfig{
 		Enabled:                  cfg.LLM.Enabled,
 		Mode:                     cfg.LLM.Mode,
 		Model:                    cfg.LLM.Model,
 		Debug:                    cfg.LLM.Debug,
 		SummaryStyle:             cfg.LLM.SummaryStyle,
+		Language:                 cfg.LLM.Language,
 		MaxSummaryLength:         cfg.LLM.MaxSummaryLength,
 		IncludeTechnicalDetails:  cfg.LLM.IncludeTechnicalDetails,
 		PrioritizeRecentWork:     cfg.LLM.PrioritizeRecentWork,
 		FallbackStrategy:         cfg.LLM.FallbackStrategy,
 		OllamaURL:                cfg.LLM.Ollama.BaseURL,
 		OllamaModel:              cfg.LLM.Ollama.Model,
+		OllamaIdleStop:           cfg.LLM.Ollama.IdleStopMinutes,
+		OpenAIURL:                cfg.LLM.OpenAI.BaseURL,
+		OpenAIAPIKey:             cfg.LLM.OpenAI.APIKey,
+		OpenAIModel:              cfg.LLM.OpenAI.Model,
+		OpenAIAPIVersion:         cfg.LLM.OpenAI.APIVersion,
+		LocalOpenAIURL:           cfg.LLM.LocalOpenAI.BaseURL,
+		LocalOpenAIAPIKey:        cfg.LLM.LocalOpenAI.APIKey,
+		LocalOpenAIModel:         cfg.LLM.LocalOpenAI.Model,
+		Concurrency:              cfg.LLM.Concurrency,
+		Privacy:                  newLLMPrivacy(cfg),
+		Projects:                 newLLMProjects(cfg),
+	}
+}
+
+// localOpenAIModelName returns the model of the local-openai mode for display
+func localOpenAIModelName(cfg *config.Config) string {
+	if cfg.LLM.LocalOpenAI.Model == "" {
+		return "(the model loaded in the server)"
 	}
+	return cfg.LLM.LocalOpenAI.Model
+}
+
+// newLLMPrivacy returns the privacy settings of remote LLM backends
+func newLLMPrivacy(cfg *config.Config) llm.PrivacyConfig {
+	return llm.PrivacyConfig{
+		Redact:          cfg.LLM.Privacy.Redact,
+		Patterns:        cfg.LLM.Privacy.Patterns,
+		AllowedProjects: cfg.LLM.Privacy.AllowedProjects,
+	}
+}
+
+// newLLMProjects returns the style and prompt overrides of the projects, by upper-case project
+// key since config keys are read lowercased
+func newLLMProjects(cfg *config.Config) map[string]llm.ProjectPrompt {
+	if len(cfg.Projects) == 0 {
+		return nil
+	}
+	projects := make(map[string]llm.ProjectPrompt, len(cfg.Projects))
+	for key, project := range cfg.Projects {
+		projects[strings.ToUpper(key)] = llm.ProjectPrompt{
+			SummaryStyle: project.LLM.SummaryStyle,
+			Prompt:       project.LLM.Prompt,
+		}
+	}
+	return projects
+}
+
+func testLLMConnection() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	llmConfig := newLLMConfig(cfg)
 
 	color.Cyan("🧠 Testing LLM connectivity...")
 	color.White("Mode: %s", llmConfig.Mode)
@@ -174,6 +293,7 @@ func showLLMStatus() error {
 	color.White("  Model: %s", cfg.LLM.Model)
 	color.White("  Debug: %t", cfg.LLM.Debug)
 	color.White("  Summary Style: %s", cfg.LLM.SummaryStyle)
+	color.White("  Language: %s", cfg.LLM.Language)
 	color.White("  Max Summary Length: %d", cfg.LLM.MaxSummaryLength)
 	color.White("  Include Technical Details: %t", cfg.LLM.IncludeTechnicalDetails)
 	color.White("  Prioritize Recent Work: %t", cfg.LLM.PrioritizeRecentWork)
@@ -183,6 +303,15 @@ func showLLMStatus() error {
 		color.White("  Ollama URL: %s", cfg.LLM.Ollama.BaseURL)
 		color.White("  Ollama Model: %s", cfg.LLM.Ollama.Model)
 	}
+	if cfg.LLM.Mode == "openai" {
+		color.White("  OpenAI URL: %s", cfg.LLM.OpenAI.BaseURL)
+		color.White("  OpenAI Model: %s", cfg.LLM.OpenAI.Model)
+		color.White("  OpenAI API Key: %s", maskSensitive(cfg.LLM.OpenAI.APIKey))
+	}
+	if cfg.LLM.Mode == "local-openai" {
+		color.White("  Local Server URL: %s", cfg.LLM.LocalOpenAI.BaseURL)
+		color.White("  Local Server Model: %s", localOpenAIModelName(cfg))
+	}
 
 	fmt.Println()
 
@@ -199,19 +328,7 @@ func showLLMStatus() error {
 		color.White("Using built-in lightweight summarization.")
 	case "ollama":
 		color.White("Status: Testing Ollama connection...")
-		llmConfig := llm.LLMConfig{
-			Enabled:                  cfg.LLM.Enabled,
-			Mode:                     cfg.LLM.Mode,
-			Model:                    cfg.LLM.Model,
-			Debug:                    cfg.LLM.Debug,
-			SummaryStyle:             cfg.LLM.SummaryStyle,
-			MaxSummaryLength:         cfg.LLM.MaxSummaryLength,
-			IncludeTechnicalDetails:  cfg.LLM.IncludeTechnicalDetails,
-			PrioritizeRecentWork:     cfg.LLM.PrioritizeRecentWork,
-			FallbackStrategy:         cfg.LLM.FallbackStrategy,
-			OllamaURL:                cfg.LLM.Ollama.BaseURL,
-			OllamaModel:              cfg.LLM.Ollama.Model,
-		}
+		llmConfig := newLLMConfig(cfg)
 		
 		if err := llm.TestLLMConnection(llmConfig); err != nil {
 			color.Red("Status: ❌ Ollama connection failed")
@@ -220,6 +337,37 @@ func showLLMStatus() error {
 		} else {
 			color.Green("Status: ✅ Ollama connected")
 		}
+
+		dockerManager := llm.NewDockerLLMManager()
+		dockerManager.SetModel(cfg.LLM.Ollama.Model)
+		resources := dockerManager.DetectResources()
+		if resources.MemoryBytes > 0 {
+			color.White("Memory: %.1fGB (%s)", float64(resources.MemoryBytes)/1e9, resources.MemorySource)
+		}
+		if resources.GPUName != "" {
+			color.White("GPU: %s, %.1fGB free", resources.GPUName, float64(resources.GPUMemoryBytes)/1e9)
+		}
+		if warning := dockerManager.CheckResources(); warning != "" {
+			color.Yellow("⚠️  %s", warning)
+		}
+	case "openai":
+		color.White("Status: Testing OpenAI-compatible API...")
+		if err := llm.TestLLMConnection(newLLMConfig(cfg)); err != nil {
+			color.Red("Status: ❌ OpenAI-compatible API connection failed")
+			color.White("Error: %v", err)
+			color.White("Check llm.openai.base_url and MY_DAY_LLM_OPENAI_API_KEY.")
+		} else {
+			color.Green("Status: ✅ OpenAI-compatible API connected")
+		}
+	case "local-openai":
+		color.White("Status: Testing local OpenAI-compatible server...")
+		if err := llm.TestLLMConnection(newLLMConfig(cfg)); err != nil {
+			color.Red("Status: ❌ Local server connection failed")
+			color.White("Error: %v", err)
+			color.White("Start the server in LM Studio (Developer tab) or run llama-server, and check llm.local_openai.base_url.")
+		} else {
+			color.Green("Status: ✅ Local server connected")
+		}
 	case "disabled":
 		color.Yellow("Status: ⚠️  Explicitly disabled")
 	default:
@@ -230,8 +378,8 @@ func showLLMStatus() error {
 	color.White("💡 Tips:")
 	if cfg.LLM.Mode == "ollama" {
 		color.White("  • Test connection: my-day llm test")
-		color.White("  • Check Ollama status: ollama list")
-		color.White("  • Pull model: ollama pull %s", cfg.LLM.Ollama.Model)
+		color.White("  • List installed models: my-day llm models")
+		color.White("  • Pull model: my-day llm pull %s", cfg.LLM.Ollama.Model)
 	}
 	color.White("  • Disable LLM: my-day report --no-llm")
 	color.White("  • Change mode: edit config file or use --llm-mode flag")
@@ -273,9 +421,58 @@ func startDockerLLM() error {
 	color.Cyan("🐳 Starting Docker LLM...")
 	
 	dockerManager := llm.NewDockerLLMManager()
+	if cfg, err := config.Load(); err == nil {
+		dockerManager.SetModel(cfg.LLM.Ollama.Model)
+		dockerManager.SetIdleStop(cfg.LLM.Ollama.IdleStopMinutes)
+	}
 	return dockerManager.EnsureReady()
 }
 
+// showDockerLLM prints the status of the Docker LLM container and the models Ollama has loaded
+func showDockerLLM() error {
+	dockerManager := llm.NewDockerLLMManager()
+	if !dockerManager.IsDockerAvailable() {
+		return fmt.Errorf("Docker is not available")
+	}
+
+	status, err := dockerManager.ContainerStatus()
+	if err != nil {
+		return err
+	}
+	color.Cyan("🐳 Docker LLM container")
+	if status == "" {
+		color.Yellow("   Not created yet. Start it with: my-day llm start")
+		return nil
+	}
+	color.White("   Status: %s", status)
+	if minutes := dockerManager.ContainerIdleStop(); minutes > 0 {
+		color.White("   Idle stop: after %d minutes without requests", minutes)
+	} else {
+		color.White("   Idle stop: off (llm.ollama.idle_stop_minutes)")
+	}
+	if !dockerManager.IsContainerRunning() {
+		return nil
+	}
+
+	fmt.Println()
+	color.Cyan("🧠 Loaded models")
+	running, err := llm.ListRunningOllamaModels(dockerManager.GetBaseURL())
+	if err != nil {
+		return err
+	}
+	if len(running) == 0 {
+		color.White("   None; the model is loaded on the next request")
+	}
+	for _, model := range running {
+		processor := "CPU"
+		if model.SizeVRAM > 0 {
+			processor = fmt.Sprintf("%d%% GPU", model.SizeVRAM*100/max(model.Size, 1))
+		}
+		color.White("   %s (%s, %s) - unloads in %s", model.Name, formatModelSize(model.Size), processor, time.Until(model.ExpiresAt).Round(time.Second))
+	}
+	return nil
+}
+
 func stopDockerLLM() error {
 	color.Cyan("🛑 Stopping Docker LLM...")
 	
@@ -307,6 +504,16 @@ func listAvailableModels() error {
 
 	switch cfg.LLM.Mode {
 	case "ollama":
+		color.Yellow("🔍 Installed models:")
+		installed, err := llm.ListOllamaModels(cfg.LLM.Ollama.BaseURL)
+		if err != nil {
+			color.Yellow("⚠️  Could not check installed models: %v", err)
+			color.White("   Make sure Ollama is running: my-day llm start")
+		} else {
+			showInstalledOllamaModels(installed, cfg.LLM.Ollama.Model)
+		}
+
+		fmt.Println()
 		color.Yellow("📦 Recommended Ollama Models:")
 		fmt.Println()
 		
@@ -327,25 +534,43 @@ func listAvailableModels() error {
 		}
 
 		for _, model := range models {
+			installedNote := ""
+			if llm.HasOllamaModel(installed, model.Name) {
+				installedNote = " [installed]"
+			}
 			if model.Name == cfg.LLM.Ollama.Model {
-				color.Green("✅ %s (%s) - %s - %s", model.Name, model.Size, model.Performance, model.Description)
+				color.Green("✅ %s (%s) - %s - %s%s", model.Name, model.Size, model.Performance, model.Description, installedNote)
 			} else {
-				color.White("   %s (%s) - %s - %s", model.Name, model.Size, model.Performance, model.Description)
+				color.White("   %s (%s) - %s - %s%s", model.Name, model.Size, model.Performance, model.Description, installedNote)
 			}
 		}
 
 		fmt.Println()
 		color.Yellow("💡 Usage:")
 		color.White("  • Switch model: my-day llm switch qwen2.5:7b")
-		color.White("  • Pull new model: ollama pull mistral:7b")
-		color.White("  • List installed: ollama list")
-		
+		color.White("  • Pull new model: my-day llm pull mistral:7b")
+
+	case "openai":
+		color.Yellow("☁️  OpenAI-compatible Models:")
 		fmt.Println()
-		color.Yellow("🔍 Checking installed models...")
-		if err := showInstalledOllamaModels(); err != nil {
-			color.Yellow("⚠️  Could not check installed models: %v", err)
-			color.White("   Make sure Ollama is running: ollama serve")
-		}
+		color.White("  Endpoint: %s", cfg.LLM.OpenAI.BaseURL)
+		color.Green("✅ %s", cfg.LLM.OpenAI.Model)
+		fmt.Println()
+		color.Yellow("💡 Usage:")
+		color.White("  • Any chat completion model served by the endpoint can be used")
+		color.White("  • Switch model: my-day llm switch gpt-4o")
+		color.White("  • OpenRouter: --openai-url https://openrouter.ai/api/v1 --openai-model meta-llama/llama-3.1-8b-instruct")
+
+	case "local-openai":
+		color.Yellow("🖥️  Local OpenAI-compatible Server:")
+		fmt.Println()
+		color.White("  Endpoint: %s", cfg.LLM.LocalOpenAI.BaseURL)
+		color.Green("✅ %s", localOpenAIModelName(cfg))
+		fmt.Println()
+		color.Yellow("💡 Usage:")
+		color.White("  • LM Studio: load a model and start the server (http://localhost:1234/v1)")
+		color.White("  • llama-server: llama-server -m model.gguf --port 8080, then set llm.local_openai.base_url to http://localhost:8080/v1")
+		color.White("  • Switch model (LM Studio): my-day llm switch qwen2.5-7b-instruct")
 
 	case "embedded":
 		color.Yellow("🔧 Embedded Mode Models:")
@@ -385,28 +610,87 @@ func listAvailableModels() error {
 	return nil
 }
 
-func showInstalledOllamaModels() error {
-	// Try to connect to Ollama and list installed models
-	llmConfig := llm.LLMConfig{
-		Mode:        "ollama",
-		OllamaURL:   "http://localhost:11434",
-		OllamaModel: "qwen2.5:3b", // dummy for connection test
+// showInstalledOllamaModels prints the models installed in Ollama, marking the configured one
+func showInstalledOllamaModels(installed []llm.OllamaModel, current string) {
+	if len(installed) == 0 {
+		color.White("   No models installed yet. Install one with: my-day llm pull %s", current)
+		return
 	}
-	
-	if err := llm.TestLLMConnection(llmConfig); err != nil {
-		return fmt.Errorf("Ollama not available: %w", err)
+
+	for _, model := range installed {
+		details := formatModelSize(model.Size)
+		if model.Details.ParameterSize != "" {
+			details += ", " + model.Details.ParameterSize
+		}
+		if model.Details.QuantizationLevel != "" {
+			details += ", " + model.Details.QuantizationLevel
+		}
+		if llm.HasOllamaModel([]llm.OllamaModel{model}, current) {
+			color.Green("✅ %s (%s)", model.Name, details)
+		} else {
+			color.White("   %s (%s)", model.Name, details)
+		}
+	}
+	if !llm.HasOllamaModel(installed, current) {
+		color.Yellow("⚠️  The configured model %s is not installed. Install it with: my-day llm pull %s", current, current)
+	}
+}
+
+// formatModelSize formats a size in bytes the way Ollama does, e.g. 1.9GB
+func formatModelSize(size int64) string {
+	switch {
+	case size >= 1e9:
+		return fmt.Sprintf("%.1fGB", float64(size)/1e9)
+	case size >= 1e6:
+		return fmt.Sprintf("%.0fMB", float64(size)/1e6)
+	default:
+		return fmt.Sprintf("%.0fKB", float64(size)/1e3)
 	}
+}
 
-	// If we can connect, show a simple message
-	// In a real implementation, you'd call Ollama's API to list models
-	color.Green("✅ Ollama is running")
-	color.White("   Use 'ollama list' to see installed models")
-	color.White("   Use 'ollama pull <model>' to install new models")
-	
+// pullOllamaModel downloads a model into the configured Ollama server, printing its progress
+func pullOllamaModel(modelName string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	color.Cyan("📥 Pulling %s into Ollama at %s", modelName, cfg.LLM.Ollama.BaseURL)
+
+	lastStatus := ""
+	downloading := false
+	err = llm.PullOllamaModel(cfg.LLM.Ollama.BaseURL, modelName, func(progress llm.PullProgress) {
+		if percent := progress.Percent(); percent >= 0 {
+			fmt.Printf("\r   %s: %3d%% of %s", progress.Status, percent, formatModelSize(progress.Total))
+			downloading = true
+			lastStatus = progress.Status
+			return
+		}
+		if downloading {
+			fmt.Println()
+			downloading = false
+		}
+		if progress.Status != lastStatus {
+			color.White("   %s", progress.Status)
+			lastStatus = progress.Status
+		}
+	})
+	if downloading {
+		fmt.Println()
+	}
+	if err != nil {
+		return err
+	}
+
+	color.Green("✅ Model %s is ready", modelName)
+	if modelName != cfg.LLM.Ollama.Model {
+		color.White("💡 Use it: my-day llm switch %s", modelName)
+	}
 	return nil
 }
 
-func switchLLMModel(modelName string) error {
+// switchLLMModel writes a model into the config file, or shows how to use it for a session
+func switchLLMModel(modelName string, dryRun, temporary bool) error {
 	cfg, err := config.Load()
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w", err)
@@ -429,6 +713,18 @@ func switchLLMModel(modelName string) error {
 		}
 		color.White("✓ Model validated for embedded mode")
 		
+	case "openai":
+		if modelName == "" {
+			return fmt.Errorf("model name cannot be empty")
+		}
+		color.White("✓ Model accepted for OpenAI-compatible endpoint %s", cfg.LLM.OpenAI.BaseURL)
+
+	case "local-openai":
+		if modelName == "" {
+			return fmt.Errorf("model name cannot be empty")
+		}
+		color.White("✓ Model accepted for the local server at %s", cfg.LLM.LocalOpenAI.BaseURL)
+		
 	case "disabled":
 		return fmt.Errorf("LLM is disabled. Enable it first with --llm-enabled")
 		
@@ -441,37 +737,101 @@ func switchLLMModel(modelName string) error {
 	color.White("  Mode: %s (unchanged)", cfg.LLM.Mode)
 	color.White("  Current Model: %s", cfg.LLM.Model)
 	color.White("  New Model: %s", modelName)
-	
 	fmt.Println()
-	color.Yellow("💡 Model Switch Options:")
-	color.White("Option 1 - Via CLI flag (temporary):")
+
+	if temporary {
+		showTemporaryModelSwitch(cfg.LLM.Mode, modelName)
+		return nil
+	}
+
+	configFile := viper.ConfigFileUsed()
+	if configFile == "" {
+		if configFile, err = config.DefaultPath(); err != nil {
+			return err
+		}
+	}
+	info, err := os.Stat(configFile)
+	if err != nil {
+		return fmt.Errorf("no config file at %s (run 'my-day init' first, or use --temporary): %w", configFile, err)
+	}
+	content, err := os.ReadFile(configFile)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	updated, err := config.UpdateYAML(content, modelSettings(cfg.LLM.Mode, modelName))
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		color.Yellow("📝 Changes to %s (dry run, nothing written):", configFile)
+		showConfigChanges(string(content), string(updated))
+		return nil
+	}
+	if err := os.WriteFile(configFile, updated, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	color.Green("✅ Switched to %s in %s", modelName, configFile)
+	for _, setting := range modelSettings(cfg.LLM.Mode, modelName) {
+		env := "MY_DAY_" + strings.ToUpper(strings.ReplaceAll(setting.Key, ".", "_"))
+		if value, set := os.LookupEnv(env); set && value != modelName {
+			color.Yellow("⚠️  %s=%s is set and overrides %s", env, value, setting.Key)
+		}
+	}
+	color.White("💡 Test the new model: my-day llm test")
+
+	return nil
+}
+
+// modelSettings returns the config file settings that select a model in an LLM mode
+func modelSettings(mode, modelName string) []config.Setting {
+	settings := []config.Setting{{Key: "llm.model", Value: modelName}}
+	switch mode {
+	case "ollama":
+		settings = append(settings, config.Setting{Key: "llm.ollama.model", Value: modelName})
+	case "openai":
+		settings = append(settings, config.Setting{Key: "llm.openai.model", Value: modelName})
+	case "local-openai":
+		settings = append(settings, config.Setting{Key: "llm.local_openai.model", Value: modelName})
+	}
+	return settings
+}
+
+// showTemporaryModelSwitch prints the flags and environment variables that use a model without
+// changing the config file
+func showTemporaryModelSwitch(mode, modelName string) {
+	color.Yellow("💡 Use the model without changing the config file:")
+	color.White("Via CLI flag:")
 	color.White("  my-day report --llm-model %s", modelName)
-	if cfg.LLM.Mode == "ollama" {
+	if mode == "ollama" {
 		color.White("  my-day report --ollama-model %s", modelName)
 	}
-	
-	fmt.Println()
-	color.White("Option 2 - Via environment variable:")
-	color.White("  export MY_DAY_LLM_MODEL=%s", modelName)
-	if cfg.LLM.Mode == "ollama" {
-		color.White("  export MY_DAY_LLM_OLLAMA_MODEL=%s", modelName)
-	}
-	
-	fmt.Println()
-	color.White("Option 3 - Update config file:")
-	color.White("  Edit ~/.my-day/config.yaml:")
-	color.White("  llm:")
-	color.White("    model: %s", modelName)
-	if cfg.LLM.Mode == "ollama" {
-		color.White("    ollama:")
-		color.White("      model: %s", modelName)
+	if mode == "openai" {
+		color.White("  my-day report --openai-model %s", modelName)
 	}
 
 	fmt.Println()
-	color.Green("✅ Model switch information provided!")
-	color.White("💡 Test the new model: my-day llm test --llm-model %s", modelName)
+	color.White("Via environment variable:")
+	for _, setting := range modelSettings(mode, modelName) {
+		color.White("  export MY_DAY_%s=%s", strings.ToUpper(strings.ReplaceAll(setting.Key, ".", "_")), modelName)
+	}
+}
 
-	return nil
+// showConfigChanges prints the lines of the config file that change
+func showConfigChanges(before, after string) {
+	oldLines, newLines := strings.Split(before, "\n"), strings.Split(after, "\n")
+	if len(oldLines) != len(newLines) {
+		color.White("The file is rewritten as:")
+		fmt.Println(after)
+		return
+	}
+	for i := range oldLines {
+		if oldLines[i] != newLines[i] {
+			color.Red("  - %s", oldLines[i])
+			color.Green("  + %s", newLines[i])
+		}
+	}
 }
 
 func validateOllamaModel(modelName string) error {
@@ -493,7 +853,7 @@ func validateOllamaModel(modelName string) error {
 	
 	// If it doesn't match common patterns, still allow it but warn
 	color.Yellow("⚠️  Warning: '%s' doesn't match common Ollama model patterns", modelName)
-	color.White("   Make sure the model is available: ollama pull %s", modelName)
+	color.White("   Make sure the model is available: my-day llm pull %s", modelName)
 	
 	return nil
 }
@@ -505,4 +865,90 @@ func contains(slice []string, item string) bool {
 		}
 	}
 	return false
-}
\ No newline at end of file
+}
+
+// evaluateLLM scores the summaries of each model of the active mode, and of the embedded LLM as a
+// baseline, on the cases of the corpus and prints them side by side
+func evaluateLLM(models []string, corpusPath string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	cases, err := llm.LoadEvalCorpus(corpusPath)
+	if err != nil {
+		return err
+	}
+
+	llmConfig := newLLMConfig(cfg)
+	llmConfig.Enabled = true
+	if len(models) == 0 {
+		models = []string{configuredModel(llmConfig)}
+	}
+
+	type row struct {
+		label  string
+		result llm.EvalResult
+	}
+	var rows []row
+	color.Cyan("🧪 Evaluating %d cases in %s mode...", len(cases), llmConfig.Mode)
+	for _, model := range models {
+		summarizer, err := llm.NewSummarizer(withModel(llmConfig, model))
+		if err != nil {
+			return fmt.Errorf("failed to create summarizer for %s: %w", model, err)
+		}
+		rows = append(rows, row{llmConfig.Mode + "/" + model, llm.Evaluate(summarizer, cases)})
+	}
+	if llmConfig.Mode != "embedded" {
+		baseline := llmConfig
+		baseline.Mode = "embedded"
+		rows = append(rows, row{"embedded (baseline)", llm.Evaluate(llm.NewEmbeddedLLMWithConfig(baseline), cases)})
+	}
+
+	fmt.Println()
+	color.Yellow("%-32s %8s %11s %8s %8s %9s %7s", "MODEL", "QUALITY", "CONFIDENCE", "ROUGE-1", "ROUGE-L", "TIME", "FAILED")
+	for _, r := range rows {
+		failed := fmt.Sprintf("%d", r.result.Errors)
+		if r.result.Fallbacks > 0 {
+			failed += fmt.Sprintf("+%d", r.result.Fallbacks)
+		}
+		color.White("%-32s %8.0f %10.0f%% %8.2f %8.2f %9s %7s", r.label, r.result.Quality, r.result.Confidence*100,
+			r.result.Rouge1, r.result.RougeL, r.result.Duration.Round(10*time.Millisecond), failed)
+	}
+	fmt.Println()
+	color.White("Quality is out of 100 and ROUGE scores go from 0 to 1; higher is better. TIME is per case.")
+	color.White("FAILED counts cases without a summary, plus those answered by the embedded fallback.")
+	return nil
+}
+
+// configuredModel returns the model the active mode summarizes with
+func configuredModel(llmConfig llm.LLMConfig) string {
+	switch llmConfig.Mode {
+	case "ollama", "docker":
+		if llmConfig.OllamaModel != "" {
+			return llmConfig.OllamaModel
+		}
+	case "openai":
+		if llmConfig.OpenAIModel != "" {
+			return llmConfig.OpenAIModel
+		}
+	case "local-openai":
+		if llmConfig.LocalOpenAIModel != "" {
+			return llmConfig.LocalOpenAIModel
+		}
+	}
+	return llmConfig.Model
+}
+
+// withModel returns llmConfig with model selected for its mode
+func withModel(llmConfig llm.LLMConfig, model string) llm.LLMConfig {
+	llmConfig.Model = model
+	switch llmConfig.Mode {
+	case "ollama", "docker":
+		llmConfig.OllamaModel = model
+	case "openai":
+		llmConfig.OpenAIModel = model
+	case "local-openai":
+		llmConfig.LocalOpenAIModel = model
+	}
+	return llmConfig
+}
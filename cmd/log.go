@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/calendar"
+	"my-day/internal/config"
+	"my-day/internal/jira"
+)
+
+// logCmd represents the log command
+var logCmd = &cobra.Command{
+	Use:   "log",
+	Short: "Log work to Jira",
+	Long: `Log creates Jira worklogs for your day.
+
+With --from-calendar, today's meetings are read from your iCalendar feed
+(calendar.source in config, or --calendar) and matched to cached issues by
+issue key or title keywords. Each proposed worklog is shown for confirmation
+before it is pushed to Jira. Tempo Timesheets picks up native Jira worklogs.
+
+Run 'my-day sync' first so there are issues to match against.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := logWork(cmd); err != nil {
+			exitWithError("Log failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(logCmd)
+
+	// Log-specific flags
+	logCmd.Flags().Bool("from-calendar", false, "Propose worklogs from calendar events")
+	logCmd.Flags().String("calendar", "", "iCalendar file path or URL (overrides calendar.source)")
+	logCmd.Flags().String("date", "", "Log work for specific date (YYYY-MM-DD)")
+	logCmd.Flags().Bool("yes", false, "Log all matched events without asking for confirmation")
+	logCmd.Flags().Bool("dry-run", false, "Show proposed worklogs without pushing them to Jira")
+}
+
+func logWork(cmd *cobra.Command) error {
+	if fromCalendar, _ := cmd.Flags().GetBool("from-calendar"); !fromCalendar {
+		return fmt.Errorf("no worklog source specified. Use --from-calendar")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	source := cfg.Calendar.Source
+	if flagSource, _ := cmd.Flags().GetString("calendar"); flagSource != "" {
+		source = flagSource
+	}
+	if source == "" {
+		return fmt.Errorf("calendar source not configured. Set calendar.source in config or use --calendar")
+	}
+
+	targetDate := time.Now()
+	if dateStr, _ := cmd.Flags().GetString("date"); dateStr != "" {
+		targetDate, err = time.ParseInLocation("2006-01-02", dateStr, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid date format. Use YYYY-MM-DD: %w", err)
+		}
+	}
+
+	cacheFile, err := getCacheFilePath()
+	if err != nil {
+		return fmt.Errorf("failed to get cache file path: %w", err)
+	}
+
+	cache, err := loadCache(cacheFile)
+	if err != nil {
+		color.Yellow("No cached data found. Run 'my-day sync' first.")
+		return fmt.Errorf("failed to load cache: %w", err)
+	}
+
+	color.Cyan("📅 Reading calendar events for %s...", targetDate.Format("January 2, 2006"))
+
+	events, err := calendar.Load(source)
+	if err != nil {
+		return fmt.Errorf("failed to load calendar: %w", err)
+	}
+
+	todaysEvents := calendar.EventsOn(events, targetDate)
+	if len(todaysEvents) == 0 {
+		color.Yellow("No calendar events found for %s", targetDate.Format("2006-01-02"))
+		return nil
+	}
+
+	proposals := calendar.ProposeWorklogs(todaysEvents, cachedIssues(cache))
+
+	var pending []calendar.Proposal
+	fmt.Println()
+	for _, proposal := range proposals {
+		event := proposal.Event
+		timeRange := fmt.Sprintf("%s-%s", event.Start.Local().Format("15:04"), event.End.Local().Format("15:04"))
+
+		switch {
+		case proposal.Issue == nil:
+			color.White("  ⏭️  %s %s (no matching issue)", timeRange, event.Summary)
+		case event.Duration() < time.Minute:
+			color.White("  ⏭️  %s %s (no duration)", timeRange, event.Summary)
+		case hasWorklogAt(cache.Worklogs, proposal.Issue, event.Start):
+			color.White("  ✓  %s %s (already logged on %s)", timeRange, event.Summary, proposal.Issue.Key)
+		default:
+			color.Green("  ⏱️  %s %s → %s %s (%s)", timeRange, event.Summary, proposal.Issue.Key,
+				truncateString(proposal.Issue.Fields.Summary, 40), formatWorklogDuration(event.Duration()))
+			pending = append(pending, proposal)
+		}
+	}
+	fmt.Println()
+
+	if len(pending) == 0 {
+		color.Yellow("No worklogs to create")
+		return nil
+	}
+
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+		color.Yellow("Dry run: %d worklogs would be created", len(pending))
+		return nil
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	skipConfirm, _ := cmd.Flags().GetBool("yes")
+	logged := 0
+	for _, proposal := range pending {
+		event := proposal.Event
+
+		if !skipConfirm {
+			fmt.Printf("Log %s on %s for '%s'? (y/N): ", formatWorklogDuration(event.Duration()), proposal.Issue.Key, event.Summary)
+			var response string
+			fmt.Scanln(&response)
+			if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
+				continue
+			}
+		}
+
+		if err := client.AddWorklog(ctx, proposal.Issue.Key, event.Start, event.Duration(), event.Summary); err != nil {
+			color.Yellow("Warning: Failed to log work on %s: %v", proposal.Issue.Key, err)
+			continue
+		}
+		logged++
+	}
+
+	color.Green("✓ Logged %d of %d proposed worklogs", logged, len(pending))
+	if logged > 0 {
+		color.White("Run 'my-day sync --force' to include them in your next report")
+	}
+
+	return nil
+}
+
+// cachedIssues returns every issue in the cache without duplicates
+func cachedIssues(cache *TicketCache) []jira.Issue {
+	seen := make(map[string]bool)
+	var issues []jira.Issue
+
+	for _, iwc := range cache.IssuesWithComments {
+		if !seen[iwc.Issue.Key] {
+			seen[iwc.Issue.Key] = true
+			issues = append(issues, iwc.Issue)
+		}
+	}
+	for _, issue := range cache.Issues {
+		if !seen[issue.Key] {
+			seen[issue.Key] = true
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues
+}
+
+// hasWorklogAt reports whether a worklog for the issue already starts at the given time
+func hasWorklogAt(worklogs []jira.WorklogEntry, issue *jira.Issue, started time.Time) bool {
+	for _, worklog := range worklogs {
+		if (worklog.IssueID == issue.ID || worklog.IssueID == issue.Key) && worklog.Started.Time.Equal(started) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatWorklogDuration formats a duration in Jira's "1h 30m" style
+func formatWorklogDuration(d time.Duration) string {
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+
+	switch {
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/metrics"
+	"my-day/internal/report"
+)
+
+// metricsEnabled returns whether the command serves /metrics, from --metrics or metrics.enabled
+func metricsEnabled(cmd *cobra.Command, cfg *config.Config) bool {
+	if cmd.Flags().Changed("metrics") {
+		enabled, _ := cmd.Flags().GetBool("metrics")
+		return enabled
+	}
+	return cfg.Metrics.Enabled
+}
+
+// serveMetrics serves /metrics on addr in the background until ctx is done. It only returns an
+// error when it cannot listen on addr.
+func serveMetrics(ctx context.Context, addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to serve metrics: %w", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	server := &http.Server{Handler: mux}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Warn("Metrics endpoint stopped", "addr", addr, "error", err)
+		}
+	}()
+	return nil
+}
+
+// observeReport records in the metrics a report generated since start, with how its summary
+// was written
+func observeReport(generator *report.Generator, start time.Time) {
+	metrics.ObserveReport(time.Since(start), reportSummary(generator))
+}
+
+// reportSummary returns how the summary of the last report of generator was written. Summaries
+// the embedded model wrote in place of the configured LLM count as a fallback, as they do for
+// the exit code.
+func reportSummary(generator *report.Generator) string {
+	if !generator.GetConfig().LLMEnabled {
+		return metrics.SummaryNone
+	}
+	if llmExitStatus(generator) == exitLLMUnavailable {
+		return metrics.SummaryFallback
+	}
+	return metrics.SummaryAI
+}
@@ -0,0 +1,13 @@
+package cmd
+
+import (
+	"testing"
+
+	"my-day/internal/metrics"
+)
+
+func TestReportSummaryWithOllamaDown(t *testing.T) {
+	if summary := reportSummary(generateWithOllamaDown(t)); summary != metrics.SummaryFallback {
+		t.Errorf("expected the embedded model standing in for Ollama to count as %q, got %q", metrics.SummaryFallback, summary)
+	}
+}
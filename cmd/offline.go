@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"strings"
+
+	"my-day/internal/config"
+)
+
+// onlineExportTargets are the export targets that post the report to a web service
+var onlineExportTargets = []string{"confluence", "notion", "slack"}
+
+// applyOffline turns off what an --offline report would reach over the network: the exports
+// to web services, a calendar fetched from a URL, and OpenAI summaries and speech; --speak
+// uses the local voice instead. It returns what was turned off, to tell the user.
+func applyOffline(cfg *config.Config) []string {
+	var skipped []string
+	for _, target := range onlineExportTargets {
+		if !exportTargetEnabled(cfg, target) {
+			continue
+		}
+		switch target {
+		case "confluence":
+			cfg.Report.Export.Targets.Confluence = false
+		case "notion":
+			cfg.Report.Export.Targets.Notion = false
+		case "slack":
+			cfg.Report.Export.Targets.Slack = false
+		}
+		if cfg.Report.Export.Target == target {
+			cfg.Report.Export.Enabled = false
+		}
+		skipped = append(skipped, target+" export")
+	}
+
+	source := strings.ToLower(cfg.Calendar.Source)
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") || strings.HasPrefix(source, "webcal://") {
+		cfg.Calendar.Source = ""
+		skipped = append(skipped, "calendar")
+	}
+
+	if cfg.LLM.Enabled && cfg.LLM.Mode == "openai" {
+		cfg.LLM.Enabled = false
+		skipped = append(skipped, "OpenAI summaries")
+	}
+
+	if strings.EqualFold(cfg.TTS.Engine, "openai") {
+		// OpenAI voice names don't exist locally, so the local default voice is used
+		cfg.TTS.Engine = "local"
+		cfg.TTS.Voice = ""
+		skipped = append(skipped, "OpenAI speech")
+	}
+	return skipped
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/report"
+)
+
+// releaseNotesCmd represents the release-notes command
+var releaseNotesCmd = &cobra.Command{
+	Use:   "release-notes",
+	Short: "Draft release notes for a version",
+	Long: `Release-notes collects the completed issues of a Jira fix version from the
+local cache, groups them by issue type, and asks the LLM to draft user-facing
+release notes from them.
+
+Only synced issues are included, so run 'my-day sync' first. The draft is a
+starting point: review it before publishing.`,
+	Example: `  my-day release-notes --fixversion 2.14
+  my-day release-notes --fixversion 2.14 --report-format markdown --output RELEASE_NOTES.md`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := generateReleaseNotes(cmd); err != nil {
+			exitWithError("Release notes generation failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(releaseNotesCmd)
+
+	// Release notes flags
+	releaseNotesCmd.Flags().String("fixversion", "", "Jira fix version to draft release notes for (e.g. 2.14)")
+	releaseNotesCmd.Flags().String("output", "", "Output file path (default: stdout)")
+	releaseNotesCmd.Flags().Bool("no-llm", false, "Only list the completed issues, without an LLM draft")
+}
+
+func generateReleaseNotes(cmd *cobra.Command) error {
+	version, _ := cmd.Flags().GetString("fixversion")
+	if version == "" {
+		return fmt.Errorf("--fixversion is required")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	cacheFile, err := getCacheFilePath()
+	if err != nil {
+		return fmt.Errorf("failed to get cache file path: %w", err)
+	}
+
+	cache, err := loadCache(cacheFile)
+	if err != nil {
+		color.Yellow("No cached data found. Run 'my-day sync' first.")
+		return fmt.Errorf("failed to load cache: %w", err)
+	}
+	applyStatusCategories(cache)
+
+	issues := cache.Issues
+	for _, iwc := range cache.IssuesWithComments {
+		issues = append(issues, iwc.Issue)
+	}
+	releaseIssues := report.ReleaseIssues(issues, version)
+
+	llmEnabled := cfg.LLM.Enabled
+	if noLLM, _ := cmd.Flags().GetBool("no-llm"); noLLM {
+		llmEnabled = false
+	}
+
+	generator := report.NewGenerator(&report.Config{
+		Format:            cfg.Report.Format,
+		LLMEnabled:        llmEnabled,
+		LLMMode:           cfg.LLM.Mode,
+		LLMModel:          cfg.LLM.Model,
+		LLMLanguage:       cfg.LLM.Language,
+		OllamaURL:         cfg.LLM.Ollama.BaseURL,
+		OllamaModel:       cfg.LLM.Ollama.Model,
+		OllamaIdleStop:    cfg.LLM.Ollama.IdleStopMinutes,
+		OpenAIURL:         cfg.LLM.OpenAI.BaseURL,
+		OpenAIAPIKey:      cfg.LLM.OpenAI.APIKey,
+		OpenAIModel:       cfg.LLM.OpenAI.Model,
+		OpenAIAPIVersion:  cfg.LLM.OpenAI.APIVersion,
+		LocalOpenAIURL:    cfg.LLM.LocalOpenAI.BaseURL,
+		LocalOpenAIAPIKey: cfg.LLM.LocalOpenAI.APIKey,
+		LocalOpenAIModel:  cfg.LLM.LocalOpenAI.Model,
+		LLMPrivacy:        newLLMPrivacy(cfg),
+		LLMProjects:       newLLMProjects(cfg),
+	})
+
+	color.Cyan("📦 Drafting release notes for %s from %d completed issues...", version, len(releaseIssues))
+	if len(releaseIssues) == 0 {
+		color.Yellow("No completed issues with fix version %s in the cache. Only issues you commented on are synced; run 'my-day sync' to refresh them.", version)
+	}
+
+	content, err := generator.GenerateReleaseNotes(version, releaseIssues)
+	if err != nil {
+		return fmt.Errorf("failed to generate release notes: %w", err)
+	}
+
+	if outputFile, _ := cmd.Flags().GetString("output"); outputFile != "" {
+		if err := os.WriteFile(outputFile, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write release notes to file: %w", err)
+		}
+		color.Green("✓ Release notes saved to: %s", outputFile)
+	} else {
+		fmt.Print(content)
+	}
+
+	return nil
+}
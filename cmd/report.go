@@ -1,15 +1,30 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"my-day/internal/calendar"
+	"my-day/internal/clipboard"
 	"my-day/internal/config"
+	"my-day/internal/integrations/slack"
+	"my-day/internal/tts"
 	"my-day/internal/jira"
+	"my-day/internal/llm"
+	"my-day/internal/logging"
 	"my-day/internal/report"
+	"my-day/internal/store"
+	"my-day/internal/timetracking"
 )
 
 // reportCmd represents the report command
@@ -24,12 +39,16 @@ optionally AI-generated summaries of your progress.
 Data comes from the local cache populated by 'my-day sync'. Use --since to 
 filter which tickets are included based on their last update time.
 
+With --offline, for flights or VPN outages, nothing is fetched or posted: exports to
+Confluence, Notion and Slack, a calendar URL and OpenAI summaries are skipped, --speak uses
+the local voice instead of OpenAI, and the header says when the data was synced, e.g.
+"offline, data as of 2024-06-05 17:42".
+
 Reports are automatically cached to improve performance and reduce LLM API calls.
 Use --no-cache to disable caching or --cache-only to use only cached reports.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := generateReport(cmd); err != nil {
-			color.Red("Report generation failed: %v", err)
-			os.Exit(1)
+			exitWithError("Report generation failed", err)
 		}
 	},
 }
@@ -39,30 +58,75 @@ func init() {
 	
 	// Report-specific flags
 	reportCmd.Flags().String("date", "", "Generate report for specific date (YYYY-MM-DD)")
+	reportCmd.Flags().String("from", "", "First day of a multi-day report, e.g. after a vacation (YYYY-MM-DD)")
+	reportCmd.Flags().String("to", "", "Last day of a multi-day report (YYYY-MM-DD, default: today)")
 	reportCmd.Flags().String("output", "", "Output file path (default: stdout)")
 	reportCmd.Flags().Bool("no-llm", false, "Disable LLM summarization for this report")
 	reportCmd.Flags().Bool("detailed", false, "Include detailed ticket information")
+	reportCmd.Flags().Duration("time-budget", 0, "Scale the summary and issue details to what can be read in this time, e.g. 60s")
 	reportCmd.Flags().Bool("debug", false, "Enable debug output for LLM processing")
 	reportCmd.Flags().Bool("show-quality", false, "Show summary quality indicators")
 	reportCmd.Flags().Bool("verbose", false, "Show verbose LLM processing information")
+	reportCmd.Flags().String("template", "", "Render the report with this Go text/template file (overrides config)")
+	reportCmd.Flags().Bool("themes", false, "Group the AI summary into 2-4 themes of the day's work (config: report.themes)")
+	reportCmd.Flags().Bool("structured", false, "Render the AI summary from structured JSON: accomplishments, in progress, blockers and next steps (config: report.structured)")
+	reportCmd.Flags().Bool("next-steps", false, "Add 3-5 next steps for tomorrow proposed by the LLM (config: report.next_steps)")
+	reportCmd.Flags().String("layout", "", "Report layout: default, or standup for Yesterday, Today and Blockers (config: report.layout)")
+	reportCmd.Flags().Bool("summary-only", false, "Print only the summary table of issues, comments and time by status and project")
+	reportCmd.Flags().Bool("explain", false, "Explain why each issue was included in or excluded from the report")
+	
+	// Section flags
+	reportCmd.Flags().Bool("no-ai-summary", false, "Leave out the AI summary of the day (config: report.sections.ai_summary)")
+	reportCmd.Flags().Bool("no-summary", false, "Leave out the summary counts (config: report.sections.summary)")
+	reportCmd.Flags().Bool("no-worklog", false, "Leave out the work log (config: report.sections.worklog)")
+	reportCmd.Flags().Bool("no-todo", false, "Leave out issues still to do (config: report.sections.todo)")
+	reportCmd.Flags().Bool("no-quality", false, "Leave out the summary quality indicators (config: report.sections.quality)")
+	reportCmd.Flags().Bool("no-footer", false, "Leave out the footer (config: report.sections.footer)")
 	
 	// Cache-specific flags
 	reportCmd.Flags().Bool("no-cache", false, "Disable report caching (always generate fresh report)")
 	reportCmd.Flags().Bool("cache-only", false, "Only use cached reports (fail if no cache exists)")
+	reportCmd.Flags().Bool("no-llm-cache", false, "Ask the LLM again for issue and comment summaries instead of reusing the ones of unchanged issues")
 	
 	// Data filtering flags
 	reportCmd.Flags().Duration("since", 7*24*time.Hour, "Include tickets and worklogs updated since this duration ago")
+	reportCmd.Flags().String("jql", "", "Custom JQL query selecting the Jira issues to report on, fetched live instead of from the local store")
+	reportCmd.Flags().Bool("offline", false, "Report from the local store only, without reaching Jira or other online services, labeled with when the data was synced")
 	
 	// Field grouping flags
 	reportCmd.Flags().String("field", "", "Group report by specified Jira custom field (e.g., 'squad', 'team', 'component')")
+	reportCmd.Flags().String("group-by", "", "Group report by board column ('column', requires jira.board_id) or any field accepted by --field; comma-separated fields nest, e.g. 'squad,status'")
+	reportCmd.RegisterFlagCompletionFunc("field", completeFieldNames)
+	reportCmd.RegisterFlagCompletionFunc("group-by", completeGroupByFields)
+	reportCmd.Flags().Bool("group-summaries", false, "Add an AI mini-summary of each group's comments to grouped reports (config: report.group_summaries)")
 	
 	// Export-specific flags
 	reportCmd.Flags().Bool("export", false, "Export report to markdown file")
 	reportCmd.Flags().String("export-folder", "", "Folder path for exported reports (overrides config)")
 	reportCmd.Flags().StringSlice("export-tags", []string{}, "Additional tags for exported report (overrides config)")
+	reportCmd.Flags().String("export-target", "", "Export target: obsidian, confluence or notion (overrides config)")
+	reportCmd.Flags().StringSlice("export-to", []string{}, "Also export to these targets in this run: obsidian, confluence, notion, slack, clipboard (config: report.export.targets)")
+	reportCmd.Flags().Bool("dry-run", false, "Show the files the exports and --output would write and the services they would call, without writing or calling anything (AI summaries are skipped)")
+	reportCmd.Flags().Bool("copy", false, "Copy the report to the system clipboard, ready to paste into Slack or Teams (config: report.export.targets.clipboard)")
+	
+	// Slack flags
+	reportCmd.Flags().Bool("post-slack", false, "Post the report to Slack (webhook or bot token from config)")
+	reportCmd.Flags().Bool("slack-json", false, "Output the report as Slack Block Kit JSON")
+
+	// Voice note flags
+	reportCmd.Flags().Bool("speak", false, "Read a brief summary of the report aloud (config: tts.engine)")
+	reportCmd.Flags().String("speak-output", "", "Save the brief summary as an audio file, e.g. standup.mp3, instead of reading it aloud")
 }
 
 func generateReport(cmd *cobra.Command) error {
+	profiles := config.ActiveProfiles()
+	if len(profiles) > 1 {
+		if jql, _ := cmd.Flags().GetString("jql"); jql != "" {
+			return fmt.Errorf("--jql queries a single Jira instance; select one profile with --profile")
+		}
+		return generateReportFromProfiles(cmd, profiles)
+	}
+
 	cfg, err := config.Load()
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w", err)
@@ -81,31 +145,113 @@ func generateReport(cmd *cobra.Command) error {
 		return fmt.Errorf("failed to load cache: %w", err)
 	}
 
-	// Check cache age
-	if time.Since(cache.LastSync) > 24*time.Hour {
-		color.Yellow("Cache is older than 24 hours. Consider running 'my-day sync' for fresh data.")
+	return generateReportFromCache(cmd, cfg, cache, cacheFile)
+}
+
+// generateReportFromProfiles generates one report combining the synced issues of several
+// Jira profiles. The settings and the activity of the other platforms come from the first profile.
+func generateReportFromProfiles(cmd *cobra.Command, profiles []string) error {
+	cfg, err := config.LoadProfile(profiles[0])
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
 	}
 
-	// Parse date flag
-	var targetDate time.Time
-	if dateStr, _ := cmd.Flags().GetString("date"); dateStr != "" {
-		targetDate, err = time.Parse("2006-01-02", dateStr)
+	var merged *TicketCache
+	var historyFile string
+	for _, name := range profiles {
+		useProfile(name)
+		cacheFile, err := getCacheFilePath()
 		if err != nil {
-			return fmt.Errorf("invalid date format. Use YYYY-MM-DD: %w", err)
+			return fmt.Errorf("failed to get cache file path: %w", err)
 		}
-	} else {
-		targetDate = time.Now()
+
+		cache, err := loadCache(cacheFile)
+		if err != nil {
+			color.Yellow("No cached data found for profile %s. Run 'my-day sync --profile %s' first.", name, name)
+			return fmt.Errorf("failed to load cache of profile %s: %w", name, err)
+		}
+		// Each instance has its own custom statuses, so map them before merging
+		applyStatusCategories(cache)
+
+		if merged == nil {
+			merged = cache
+			historyFile = cacheFile
+			continue
+		}
+		mergeProfileCache(merged, cache)
+	}
+
+	return generateReportFromCache(cmd, cfg, merged, historyFile)
+}
+
+// mergeProfileCache adds the Jira data synced for another profile to merged
+func mergeProfileCache(merged, cache *TicketCache) {
+	merged.Issues = append(merged.Issues, cache.Issues...)
+	merged.IssuesWithComments = append(merged.IssuesWithComments, cache.IssuesWithComments...)
+	merged.Worklogs = append(merged.Worklogs, cache.Worklogs...)
+	merged.Mentions = append(merged.Mentions, cache.Mentions...)
+	merged.Warnings.Merge(cache.Warnings)
+	merged.StatusCategories = merged.StatusCategories.Merge(cache.StatusCategories)
+	merged.BoardColumns = merged.BoardColumns.Merge(cache.BoardColumns)
+	if cache.LastSync.Before(merged.LastSync) {
+		merged.LastSync = cache.LastSync
+	}
+}
+
+// generateReportFromCache generates the report from synced data, keeping it in the report
+// history of the store at cacheFile
+func generateReportFromCache(cmd *cobra.Command, cfg *config.Config, cache *TicketCache, cacheFile string) error {
+	var err error
+	start := time.Now()
+	offline, _ := cmd.Flags().GetBool("offline")
+
+	// A custom JQL query replaces the synced Jira issues with the ones it matches right now
+	if jql, _ := cmd.Flags().GetString("jql"); jql != "" {
+		if offline {
+			return fmt.Errorf("--jql queries Jira live and cannot be used with --offline")
+		}
+		if err := applyJQLQuery(cmd, cfg, cache, jql); err != nil {
+			return err
+		}
+	}
+
+	// Map custom statuses to their real category using the mapping cached at sync time
+	applyStatusCategories(cache)
+
+	// Check cache age
+	if offline {
+		color.Cyan("📴 Offline: reporting from the data synced at %s", cache.LastSync.Local().Format("2006-01-02 15:04"))
+	} else if time.Since(cache.LastSync) > 24*time.Hour {
+		color.Yellow("Cache is older than 24 hours. Consider running 'my-day sync' for fresh data.")
+	}
+
+	// Parse the date flags: a past day with --date, or several days with --from/--to
+	targetDate, fromDate, err := reportDates(cmd)
+	if err != nil {
+		return err
 	}
 	
 	// Get flags for feedback
 	debug, _ := cmd.Flags().GetBool("debug")
 	verbose, _ := cmd.Flags().GetBool("verbose")
+	if debug || verbose {
+		logging.Verbose()
+	}
 	
 	// Filter cached data based on --since flag
 	since, _ := cmd.Flags().GetDuration("since")
 	sinceTime := time.Now().Add(-since)
+	if cmd.Flags().Changed("date") || cmd.Flags().Changed("from") {
+		// Count back from the end of the report instead of now, so past reports keep their data
+		sinceTime = targetDate.Truncate(24 * time.Hour).Add(24 * time.Hour).Add(-since)
+		if !fromDate.IsZero() && fromDate.Before(sinceTime) {
+			sinceTime = fromDate
+		}
+	}
 	originalIssueCount := len(cache.IssuesWithComments)
+	unfilteredCache := cache
 	cache = filterCacheDataBySince(cache, sinceTime, targetDate)
+	applyTimeEntries(cache)
 	
 	if verbose || debug {
 		color.White("Filtered from %d to %d issues using --since %v", originalIssueCount, len(cache.IssuesWithComments), since)
@@ -118,8 +264,26 @@ func generateReport(cmd *cobra.Command) error {
 	}
 
 	detailed, _ := cmd.Flags().GetBool("detailed")
+	timeBudget, _ := cmd.Flags().GetDuration("time-budget")
+	if timeBudget < 0 {
+		return fmt.Errorf("--time-budget must not be negative")
+	}
 	showQuality, _ := cmd.Flags().GetBool("show-quality")
 	groupByField, _ := cmd.Flags().GetString("field")
+	if groupBy, _ := cmd.Flags().GetString("group-by"); groupBy != "" {
+		groupByField = groupBy
+	}
+	if groupByField != "" {
+		for _, field := range strings.Split(groupByField, ",") {
+			field = strings.TrimSpace(field)
+			if err := report.CheckGroupField(field, customFieldIDs(cfg)); err != nil {
+				return err
+			}
+			if strings.EqualFold(field, "column") && cache.BoardColumns == nil {
+				return fmt.Errorf("no board columns cached. Set jira.board_id (or MY_DAY_JIRA_BOARD_ID) and run 'my-day sync'")
+			}
+		}
+	}
 	
 	// Cache flags
 	noCache, _ := cmd.Flags().GetBool("no-cache")
@@ -131,6 +295,8 @@ func generateReport(cmd *cobra.Command) error {
 	exportEnabled, _ := cmd.Flags().GetBool("export")
 	exportFolder, _ := cmd.Flags().GetString("export-folder")
 	exportTags, _ := cmd.Flags().GetStringSlice("export-tags")
+	exportTarget, _ := cmd.Flags().GetString("export-target")
+	exportTo, _ := cmd.Flags().GetStringSlice("export-to")
 	
 	// Override export settings if flags are provided
 	if exportEnabled {
@@ -142,37 +308,120 @@ func generateReport(cmd *cobra.Command) error {
 	if len(exportTags) > 0 {
 		cfg.Report.Export.Tags = exportTags
 	}
+	if exportTarget != "" {
+		cfg.Report.Export.Target = exportTarget
+	}
+	if err := enableExportTargets(cfg, exportTo); err != nil {
+		return err
+	}
+	if postSlack, _ := cmd.Flags().GetBool("post-slack"); postSlack {
+		cfg.Report.Export.Targets.Slack = true
+	}
+	if copyReport, _ := cmd.Flags().GetBool("copy"); copyReport {
+		cfg.Report.Export.Targets.Clipboard = true
+	}
+
+	// Offline reports leave out everything that needs the network
+	if offline {
+		if skipped := applyOffline(cfg); len(skipped) > 0 {
+			color.Yellow("📴 Offline: skipping %s", strings.Join(skipped, ", "))
+		}
+		llmEnabled = llmEnabled && cfg.LLM.Enabled
+	}
+
+	// A dry run neither calls the LLM nor reads or writes the report and summary caches
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	if dryRun {
+		useCache = false
+		if llmEnabled {
+			llmEnabled = false
+			color.Yellow("🔍 Dry run: skipping the AI summaries, so no LLM is called")
+		}
+	}
+
+	// Meetings attended on the report date, from the configured calendar
+	meetings := loadMeetings(cfg, targetDate)
 
 	// Create report generator
-	generator := report.NewGenerator(&report.Config{
-		Format:            cfg.Report.Format,
-		LLMEnabled:        llmEnabled,
-		LLMMode:           cfg.LLM.Mode,
-		LLMModel:          cfg.LLM.Model,
-		OllamaURL:         cfg.LLM.Ollama.BaseURL,
-		OllamaModel:       cfg.LLM.Ollama.Model,
-		IncludeYesterday:  cfg.Report.IncludeYesterday,
-		IncludeToday:      cfg.Report.IncludeToday,
-		IncludeInProgress: cfg.Report.IncludeInProgress,
-		Detailed:          detailed,
-		Debug:             debug,
-		ShowQuality:       showQuality,
-		Verbose:           verbose,
-		GroupByField:      groupByField,
-		ExportEnabled:     cfg.Report.Export.Enabled,
-		ExportFolderPath:  cfg.Report.Export.FolderPath,
-		ExportFileDate:    cfg.Report.Export.FileNameDate,
-		ExportTags:        cfg.Report.Export.Tags,
-	})
+	reportConfig := newReportConfig(cfg, cache, llmEnabled, meetings)
+	reportConfig.Detailed = detailed
+	reportConfig.Debug = debug
+	reportConfig.ShowQuality = showQuality
+	reportConfig.Verbose = verbose
+	reportConfig.GroupByField = groupByField
+	reportConfig.TimeBudget = timeBudget
+	if offline {
+		reportConfig.DataAsOf = cache.LastSync
+	}
+	if !fromDate.IsZero() {
+		reportConfig.From, reportConfig.To = fromDate, targetDate
+	}
+	if templatePath, _ := cmd.Flags().GetString("template"); templatePath != "" {
+		reportConfig.TemplatePath = templatePath
+	}
+	if themes, _ := cmd.Flags().GetBool("themes"); themes {
+		reportConfig.Themes = true
+	}
+	if groupSummaries, _ := cmd.Flags().GetBool("group-summaries"); groupSummaries {
+		reportConfig.GroupSummaries = true
+	}
+	if structured, _ := cmd.Flags().GetBool("structured"); structured {
+		reportConfig.Structured = true
+	}
+	if nextSteps, _ := cmd.Flags().GetBool("next-steps"); nextSteps {
+		reportConfig.NextSteps = true
+	}
+	if layout, _ := cmd.Flags().GetString("layout"); layout != "" {
+		reportConfig.Layout = layout
+	}
+	if reportConfig.Layout != "" && reportConfig.Layout != report.LayoutDefault && reportConfig.Layout != report.LayoutStandup {
+		return fmt.Errorf("unknown report layout %q: use %s or %s", reportConfig.Layout, report.LayoutDefault, report.LayoutStandup)
+	}
+	hideSections(cmd, &reportConfig.Hide)
+	if noLLMCache, _ := cmd.Flags().GetBool("no-llm-cache"); !noLLMCache && llmEnabled && cacheFile != "" {
+		if db, err := store.Open(cacheFile); err == nil {
+			defer db.Close()
+			reportConfig.LLMCache = storeSummaryCache{db: db}
+		}
+	}
+	generator := report.NewGenerator(reportConfig)
+
+	if summaryOnly, _ := cmd.Flags().GetBool("summary-only"); summaryOnly {
+		issuesWithComments := make([]report.IssueWithComments, 0, len(cache.Issues))
+		if len(cache.IssuesWithComments) > 0 {
+			for _, iwc := range cache.IssuesWithComments {
+				issuesWithComments = append(issuesWithComments, report.IssueWithComments{Issue: iwc.Issue, Comments: iwc.Comments})
+			}
+		} else {
+			for _, issue := range cache.Issues {
+				issuesWithComments = append(issuesWithComments, report.IssueWithComments{Issue: issue})
+			}
+		}
+		fmt.Printf("📊 Summary - %s\n", reportDateLabel(targetDate, fromDate))
+		fmt.Print(generator.SummaryTable(issuesWithComments, cache.Worklogs, targetDate))
+		return nil
+	}
 
 	color.Cyan("📋 Generating daily standup report...")
-	color.White("Showing tickets with your comments today")
-	if dateStr, _ := cmd.Flags().GetString("date"); dateStr != "" {
-		color.White("Report date: %s", targetDate.Format("2006-01-02"))
+	if !fromDate.IsZero() {
+		color.White("Showing tickets with your comments from %s to %s", fromDate.Format("2006-01-02"), targetDate.Format("2006-01-02"))
 	} else {
-		color.White("Report date: %s (today)", targetDate.Format("2006-01-02"))
+		color.White("Showing tickets with your comments today")
+		if dateStr, _ := cmd.Flags().GetString("date"); dateStr != "" {
+			color.White("Report date: %s", targetDate.Format("2006-01-02"))
+		} else {
+			color.White("Report date: %s (today)", targetDate.Format("2006-01-02"))
+		}
 	}
 	color.White("Including tickets updated since: %s (last %v)", sinceTime.Format("2006-01-02 15:04"), since)
+	if timeBudget > 0 {
+		color.White("Detail scaled to a %v read", timeBudget)
+	}
+
+	if explain, _ := cmd.Flags().GetBool("explain"); explain {
+		fmt.Println()
+		fmt.Print(buildReportExplanation(cmd, generator, unfilteredCache, cache, sinceTime, targetDate, llmEnabled))
+	}
 
 	// Generate report with comments if available, using caching
 	var reportContent string
@@ -233,21 +482,58 @@ func generateReport(cmd *cobra.Command) error {
 	if err != nil {
 		return fmt.Errorf("failed to generate report: %w", err)
 	}
+	observeReport(generator, start)
+
+	// Keep the report in the local history for 'my-day history'
+	if !dryRun {
+		recordReportHistory(cacheFile, generator, cache, reportContent, cfg.Report.Format, targetDate)
+	}
 
-	// Handle export to Obsidian if enabled
-	if err := generator.ExportToObsidian(reportContent, targetDate); err != nil {
-		color.Yellow("⚠️  Export to Obsidian failed: %v", err)
-	} else if cfg.Report.Export.Enabled || exportEnabled {
-		exportPath := cfg.Report.Export.FolderPath
-		if exportFolder != "" {
-			exportPath = exportFolder
+	// Keep the LLM debug report for 'my-day debug bundle'
+	if debug {
+		if debugReport, err := generator.DebugReport(); err == nil && debugReport != nil {
+			if err := llm.SaveLastDebugReport(debugReport); err != nil {
+				color.Yellow("Warning: Failed to save debug report: %v", err)
+			}
 		}
-		filename := targetDate.Format(cfg.Report.Export.FileNameDate) + ".md"
-		color.Green("✓ Report exported to Obsidian: %s/%s", exportPath, filename)
+	}
+
+	// Run every enabled export target; a failing target does not stop the others
+	if dryRun {
+		color.Cyan("🔍 Dry run: nothing is written, posted or copied")
+		previews, err := previewExports(cfg, generator, cache, reportContent, targetDate)
+		for _, preview := range previews {
+			color.White("  %s", preview)
+		}
+		if err != nil {
+			color.Yellow("⚠️  %v", err)
+		}
+	} else {
+		destinations, err := exportReport(cfg, generator, cache, meetings, reportContent, targetDate)
+		for _, destination := range destinations {
+			color.Green("✓ %s", destination)
+		}
+		if err != nil {
+			color.Yellow("⚠️  %v", err)
+			setExitStatus(exitExport)
+		}
+	}
+	setExitStatus(llmExitStatus(generator))
+
+	// Output the Slack Block Kit message if requested
+	if slackJSON, _ := cmd.Flags().GetBool("slack-json"); slackJSON {
+		data, err := json.MarshalIndent(reportSlackMessage(cfg, generator, cache, targetDate), "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal Slack message: %w", err)
+		}
+		reportContent = string(data) + "\n"
 	}
 
 	// Handle output
-	if outputFile, _ := cmd.Flags().GetString("output"); outputFile != "" {
+	if outputFile, _ := cmd.Flags().GetString("output"); outputFile != "" && dryRun {
+		color.White("  %s", previewFile("Would write the report to", "Would overwrite", outputFile))
+		fmt.Print(reportContent)
+	} else if outputFile != "" {
 		if err := os.WriteFile(outputFile, []byte(reportContent), 0644); err != nil {
 			return fmt.Errorf("failed to write report to file: %w", err)
 		}
@@ -256,14 +542,672 @@ func generateReport(cmd *cobra.Command) error {
 		fmt.Print(reportContent)
 	}
 
+	// Voice note of the brief summary
+	speak, _ := cmd.Flags().GetBool("speak")
+	speakOutput, _ := cmd.Flags().GetString("speak-output")
+	if dryRun && speakOutput != "" {
+		color.White("  %s", previewFile("Would save the voice note to", "Would overwrite", speakOutput))
+	} else if speak || speakOutput != "" {
+		if err := speakReport(cfg, generator, cache, targetDate, speakOutput); err != nil {
+			return fmt.Errorf("failed to create voice note: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// reportDates returns the date of the report from --date, or the last and first days of a
+// multi-day report from --to (default today) and --from. fromDate is zero for a daily report.
+func reportDates(cmd *cobra.Command) (targetDate, fromDate time.Time, err error) {
+	dateStr, _ := cmd.Flags().GetString("date")
+	fromStr, _ := cmd.Flags().GetString("from")
+	toStr, _ := cmd.Flags().GetString("to")
+	if dateStr != "" && (fromStr != "" || toStr != "") {
+		return time.Time{}, time.Time{}, fmt.Errorf("--date cannot be combined with --from/--to")
+	}
+	if toStr != "" && fromStr == "" {
+		return time.Time{}, time.Time{}, fmt.Errorf("--to requires --from")
+	}
+
+	parse := func(flag, value string) (time.Time, error) {
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid %s date format. Use YYYY-MM-DD: %w", flag, err)
+		}
+		return date, nil
+	}
+
+	targetDate = time.Now()
+	switch {
+	case dateStr != "":
+		targetDate, err = parse("--date", dateStr)
+		return targetDate, time.Time{}, err
+	case fromStr == "":
+		return targetDate, time.Time{}, nil
+	}
+
+	if fromDate, err = parse("--from", fromStr); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if toStr != "" {
+		if targetDate, err = parse("--to", toStr); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	if fromDate.Truncate(24 * time.Hour).After(targetDate.Truncate(24 * time.Hour)) {
+		return time.Time{}, time.Time{}, fmt.Errorf("--from %s is after --to %s", fromDate.Format("2006-01-02"), targetDate.Format("2006-01-02"))
+	}
+	return targetDate, fromDate, nil
+}
+
+// reportDateLabel returns the day of a report, or the days of a multi-day report
+func reportDateLabel(targetDate, fromDate time.Time) string {
+	if fromDate.IsZero() {
+		return targetDate.Format("January 2, 2006")
+	}
+	return fromDate.Format("January 2, 2006") + " to " + targetDate.Format("January 2, 2006")
+}
+
+// newReportConfig returns the report generator settings from the configuration and the synced
+// data; options set by report flags, such as --detailed, are left for the caller
+func newReportConfig(cfg *config.Config, cache *TicketCache, llmEnabled bool, meetings []calendar.Meeting) *report.Config {
+	return &report.Config{
+		Format:            cfg.Report.Format,
+		LLMEnabled:        llmEnabled,
+		LLMMode:           cfg.LLM.Mode,
+		LLMModel:          cfg.LLM.Model,
+		LLMLanguage:       cfg.LLM.Language,
+		OllamaURL:         cfg.LLM.Ollama.BaseURL,
+		OllamaModel:       cfg.LLM.Ollama.Model,
+		OllamaIdleStop:    cfg.LLM.Ollama.IdleStopMinutes,
+		OpenAIURL:         cfg.LLM.OpenAI.BaseURL,
+		OpenAIAPIKey:      cfg.LLM.OpenAI.APIKey,
+		OpenAIModel:       cfg.LLM.OpenAI.Model,
+		OpenAIAPIVersion:  cfg.LLM.OpenAI.APIVersion,
+		LocalOpenAIURL:    cfg.LLM.LocalOpenAI.BaseURL,
+		LocalOpenAIAPIKey: cfg.LLM.LocalOpenAI.APIKey,
+		LocalOpenAIModel:  cfg.LLM.LocalOpenAI.Model,
+		StrictLLM:         cfg.LLM.FallbackStrategy == "strict",
+		LLMConcurrency:    cfg.LLM.Concurrency,
+		LLMPrivacy:        newLLMPrivacy(cfg),
+		LLMProjects:       newLLMProjects(cfg),
+		IncludeYesterday:  cfg.Report.IncludeYesterday,
+		IncludeToday:      cfg.Report.IncludeToday,
+		IncludeInProgress: cfg.Report.IncludeInProgress,
+		MaxCommentExcerpt: cfg.Report.MaxCommentExcerpt,
+		VarianceThreshold: cfg.Report.VarianceThreshold,
+		DuplicateSimilarity: cfg.Report.DuplicateSimilarity,
+		RiskWeights:       report.RiskWeights{
+			Priority:     cfg.Report.Risk.Weights.Priority,
+			DaysInStatus: cfg.Report.Risk.Weights.DaysInStatus,
+			Blockers:     cfg.Report.Risk.Weights.Blockers,
+			Sentiment:    cfg.Report.Risk.Weights.Sentiment,
+		},
+		RiskMinScore:      cfg.Report.Risk.MinScore,
+		StatusSince:       loadStatusSince(),
+		WorkdayHours:      cfg.Report.WorkdayHours,
+		TemplatePath:      cfg.Report.TemplatePath,
+		Themes:            cfg.Report.Themes,
+		GroupSummaries:    cfg.Report.GroupSummaries,
+		Structured:        cfg.Report.Structured,
+		NextSteps:         cfg.Report.NextSteps,
+		Layout:            cfg.Report.Layout,
+		AccountID:         cacheAccountID(cache),
+		StatusStyles:      newStatusStyles(cfg),
+		Hide: report.HiddenSections{
+			AISummary: !cfg.Report.Sections.AISummary,
+			Summary:   !cfg.Report.Sections.Summary,
+			Worklog:   !cfg.Report.Sections.Worklog,
+			ToDo:      !cfg.Report.Sections.ToDo,
+			Quality:   !cfg.Report.Sections.Quality,
+			Footer:    !cfg.Report.Sections.Footer,
+		},
+		BoardColumns:      cache.BoardColumns,
+		ExportEnabled:     len(exportTargets(cfg)) > 0,
+		ExportFolderPath:  cfg.Report.Export.FolderPath,
+		ExportFileDate:    cfg.Report.Export.FileNameDate,
+		ExportTags:        cfg.Report.Export.Tags,
+		Confluence:        newConfluenceTarget(cfg),
+		Notion:            newNotionTarget(cfg),
+		DailyNote:         newDailyNoteTarget(cfg),
+		ExportWikiLinks:   cfg.Report.Export.WikiLinks,
+		IssueNotes:        newIssueNotesTarget(cfg),
+		GitHubActivity:    cache.GitHubActivity,
+		GitLabActivity:    cache.GitLabActivity,
+		TrelloActivity:    cache.TrelloActivity,
+		AsanaActivity:     cache.AsanaActivity,
+		MeetingsSummary:   calendar.SummarizeAttendance(meetings),
+		Mentions:          cache.Mentions,
+		CustomFields:      customFieldIDs(cfg),
+		Warnings:          cache.Warnings,
+	}
+}
+
+// cacheAccountID returns the Jira account of the user recorded by the last sync, or "" when
+// the sync didn't record it
+func cacheAccountID(cache *TicketCache) string {
+	if cache.User == nil {
+		return ""
+	}
+	return cache.User.AccountID
+}
+
+// hideSections leaves out the parts of the report turned off with the --no-<section> flags, on
+// top of those turned off in report.sections
+func hideSections(cmd *cobra.Command, hide *report.HiddenSections) {
+	for flag, hidden := range map[string]*bool{
+		"no-ai-summary": &hide.AISummary,
+		"no-summary":    &hide.Summary,
+		"no-worklog":    &hide.Worklog,
+		"no-todo":       &hide.ToDo,
+		"no-quality":    &hide.Quality,
+		"no-footer":     &hide.Footer,
+	} {
+		if off, _ := cmd.Flags().GetBool(flag); off {
+			*hidden = true
+		}
+	}
+}
+
+// newStatusStyles returns the icons and labels of Jira statuses set in report.statuses
+func newStatusStyles(cfg *config.Config) map[string]report.StatusStyle {
+	if len(cfg.Report.Statuses) == 0 {
+		return nil
+	}
+	styles := make(map[string]report.StatusStyle, len(cfg.Report.Statuses))
+	for name, status := range cfg.Report.Statuses {
+		styles[name] = report.StatusStyle{Icon: status.Icon, Label: status.Label}
+	}
+	return styles
+}
+
+// exportReport sends the report to the configured export target, returning where it went, or
+// "" when export is off
+func exportReport(cfg *config.Config, generator *report.Generator, cache *TicketCache, meetings []calendar.Meeting, reportContent string, targetDate time.Time) ([]string, error) {
+	var destinations []string
+	var errs []error
+	for _, target := range exportTargets(cfg) {
+		destination, err := exportReportTo(target, cfg, generator, cache, meetings, reportContent, targetDate)
+		if err != nil {
+			errs = append(errs, err)
+		} else if destination != "" {
+			destinations = append(destinations, destination)
+		}
+	}
+	return destinations, errors.Join(errs...)
+}
+
+// exportTargetNames are the export targets, in the order they run
+var exportTargetNames = []string{"obsidian", "confluence", "notion", "slack", "clipboard"}
+
+// exportTargets returns the export targets enabled in the configuration, in the order they run:
+// report.export.target when export is enabled, and every target enabled in
+// report.export.targets
+func exportTargets(cfg *config.Config) []string {
+	var targets []string
+	for _, target := range exportTargetNames {
+		if exportTargetEnabled(cfg, target) {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+// exportTargetEnabled reports whether the report is exported to target
+func exportTargetEnabled(cfg *config.Config, target string) bool {
+	export := cfg.Report.Export
+	switch target {
+	case "obsidian":
+		return export.Targets.Obsidian || (export.Enabled && export.Target != "confluence" && export.Target != "notion")
+	case "confluence":
+		return export.Targets.Confluence || (export.Enabled && export.Target == "confluence")
+	case "notion":
+		return export.Targets.Notion || (export.Enabled && export.Target == "notion")
+	case "slack":
+		return export.Targets.Slack
+	case "clipboard":
+		return export.Targets.Clipboard
+	}
+	return false
+}
+
+// enableExportTargets enables the named export targets in the configuration
+func enableExportTargets(cfg *config.Config, targets []string) error {
+	for _, target := range targets {
+		switch strings.ToLower(strings.TrimSpace(target)) {
+		case "obsidian":
+			cfg.Report.Export.Targets.Obsidian = true
+		case "confluence":
+			cfg.Report.Export.Targets.Confluence = true
+		case "notion":
+			cfg.Report.Export.Targets.Notion = true
+		case "slack":
+			cfg.Report.Export.Targets.Slack = true
+		case "clipboard":
+			cfg.Report.Export.Targets.Clipboard = true
+		default:
+			return fmt.Errorf("unknown export target %q (use %s)", target, strings.Join(exportTargetNames, ", "))
+		}
+	}
+	return nil
+}
+
+// exportReportTo exports the report to one target and returns where it went
+func exportReportTo(target string, cfg *config.Config, generator *report.Generator, cache *TicketCache, meetings []calendar.Meeting, reportContent string, targetDate time.Time) (string, error) {
+	switch target {
+	case "confluence":
+		pageURL, err := generator.ExportToConfluence(context.Background(), reportContent, targetDate)
+		if err != nil {
+			return "", fmt.Errorf("Export to Confluence failed: %w", err)
+		}
+		if pageURL == "" {
+			return "", nil
+		}
+		return "Report published to Confluence: " + pageURL, nil
+	case "notion":
+		issueCount := len(generator.FilterIssues(cache.Issues, targetDate))
+		pageURL, err := generator.ExportToNotion(context.Background(), reportContent, issueCount, targetDate)
+		if err != nil {
+			return "", fmt.Errorf("Export to Notion failed: %w", err)
+		}
+		if pageURL == "" {
+			return "", nil
+		}
+		return "Report published to Notion: " + pageURL, nil
+	case "slack":
+		client := slack.NewClient(cfg.Slack.WebhookURL, cfg.Slack.BotToken, cfg.Slack.Channel)
+		if err := client.Post(context.Background(), reportSlackMessage(cfg, generator, cache, targetDate)); err != nil {
+			return "", fmt.Errorf("failed to post report to Slack: %w", err)
+		}
+		return fmt.Sprintf("Report posted to Slack (%s)", client.Destination()), nil
+	case "clipboard":
+		if err := clipboard.Copy(reportContent); err != nil {
+			return "", fmt.Errorf("failed to copy report to the clipboard: %w", err)
+		}
+		return "Report copied to the clipboard", nil
+	default:
+		generator.SetExportMetrics(buildExportMetrics(cache, meetings, targetDate))
+		generator.SetExportIssues(exportIssues(cache))
+		if err := generator.ExportToObsidian(reportContent, targetDate); err != nil {
+			return "", fmt.Errorf("Export to Obsidian failed: %w", err)
+		}
+		path, err := generator.ObsidianExportPath(targetDate)
+		if err != nil {
+			return "", err
+		}
+		if cfg.Report.Export.DailyNote.Enabled {
+			return fmt.Sprintf("Report added to the Obsidian daily note: %s", path), nil
+		}
+		return fmt.Sprintf("Report exported to Obsidian: %s", path), nil
+	}
+}
+
+// speakReport reads the brief summary of the report aloud, or saves it as an audio file
+// when outputFile is set
+func speakReport(cfg *config.Config, generator *report.Generator, cache *TicketCache, targetDate time.Time, outputFile string) error {
+	engine, err := tts.New(tts.Config{
+		Engine:           cfg.TTS.Engine,
+		Voice:            cfg.TTS.Voice,
+		Model:            cfg.TTS.Model,
+		OpenAIURL:        cfg.LLM.OpenAI.BaseURL,
+		OpenAIAPIKey:     cfg.LLM.OpenAI.APIKey,
+		OpenAIAPIVersion: cfg.LLM.OpenAI.APIVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	var issuesWithComments []report.IssueWithComments
+	for _, iwc := range cache.IssuesWithComments {
+		issuesWithComments = append(issuesWithComments, report.IssueWithComments{
+			Issue:    iwc.Issue,
+			Comments: iwc.Comments,
+		})
+	}
+	text := generator.SpokenSummary(issuesWithComments, cache.Worklogs, targetDate)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	if outputFile != "" {
+		if err := engine.Save(ctx, text, outputFile); err != nil {
+			return err
+		}
+		color.Green("✓ Voice note saved to: %s", outputFile)
+		return nil
+	}
+
+	color.Cyan("🔊 Reading the summary aloud...")
+	return engine.Speak(ctx, text)
+}
+
+// buildExportMetrics computes the daily metrics written to exported Obsidian notes,
+// including the hours of the meetings attended
+func buildExportMetrics(cache *TicketCache, meetings []calendar.Meeting, targetDate time.Time) report.ExportMetrics {
+	var issuesWithComments []report.IssueWithComments
+	for _, iwc := range cache.IssuesWithComments {
+		issuesWithComments = append(issuesWithComments, report.IssueWithComments{
+			Issue:    iwc.Issue,
+			Comments: iwc.Comments,
+		})
+	}
+
+	metrics := report.ComputeExportMetrics(issuesWithComments, cache.Worklogs, targetDate)
+
+	var meetingTime time.Duration
+	for _, meeting := range meetings {
+		if meeting.Attended {
+			meetingTime += meeting.Duration()
+		}
+	}
+	metrics.MeetingsHours = math.Round(meetingTime.Hours()*100) / 100
+
+	return metrics
+}
+
+// storeSummaryCache keeps the LLM summaries of issues and comments in the local store
+type storeSummaryCache struct {
+	db *store.Store
+}
+
+// Summary returns the summary stored under key, treating a store that cannot be read as a miss
+func (c storeSummaryCache) Summary(key string) (string, bool) {
+	summary, found, err := c.db.LLMSummary(key)
+	return summary, found && err == nil
+}
+
+// SaveSummary stores a summary under key
+func (c storeSummaryCache) SaveSummary(key, summary string) {
+	if err := c.db.SaveLLMSummary(key, summary); err != nil {
+		color.Yellow("Warning: Failed to cache LLM summary: %v", err)
+	}
+}
+
+// loadStatusSince returns when issues entered their current status from the local store, or nil
+// when it cannot be read
+func loadStatusSince() map[string]time.Time {
+	storePath, err := getCacheFilePath()
+	if err != nil {
+		return nil
+	}
+	db, err := store.Open(storePath)
+	if err != nil {
+		return nil
+	}
+	defer db.Close()
+	since, err := db.StatusSince()
+	if err != nil {
+		return nil
+	}
+	return since
+}
+
+// loadMeetings returns the meetings on the report date from the configured calendar, marking
+// the ones you attended. It returns nil when no calendar is configured or it cannot be read.
+func loadMeetings(cfg *config.Config, targetDate time.Time) []calendar.Meeting {
+	if cfg.Calendar.Source == "" {
+		return nil
+	}
+
+	events, err := calendar.Load(cfg.Calendar.Source)
+	if err != nil {
+		color.Yellow("Warning: Failed to load calendar for meetings: %v", err)
+		return nil
+	}
+
+	email := cfg.Calendar.Email
+	if email == "" {
+		email = cfg.Jira.Email
+	}
+	return calendar.Meetings(calendar.EventsOn(events, targetDate), email, time.Now())
+}
+
+// recordReportHistory stores a generated report in the local store's report history
+func recordReportHistory(storePath string, generator *report.Generator, cache *TicketCache, content, format string, targetDate time.Time) {
+	db, err := store.Open(storePath)
+	if err != nil {
+		color.Yellow("Warning: Failed to record report history: %v", err)
+		return
+	}
+	defer db.Close()
+
+	date := targetDate.Format("2006-01-02")
+	comments := 0
+	for _, iwc := range cache.IssuesWithComments {
+		for _, comment := range iwc.Comments {
+			if comment.Created.Time.Local().Format("2006-01-02") == date {
+				comments++
+			}
+		}
+	}
+
+	issues := generator.FilterIssues(cache.Issues, targetDate)
+	reportIssues := make([]store.ReportIssue, 0, len(issues))
+	for _, issue := range issues {
+		reportIssues = append(reportIssues, store.ReportIssue{
+			Key:     issue.Key,
+			Summary: issue.Fields.Summary,
+			Status:  issue.Fields.Status.Name,
+			Done:    strings.EqualFold(issue.Fields.Status.Category.Key, "done"),
+		})
+	}
+
+	record := store.ReportRecord{
+		Date:         date,
+		Format:       format,
+		GeneratedAt:  time.Now(),
+		IssueCount:   len(issues),
+		CommentCount: comments,
+		WorklogCount: len(generator.FilterWorklogs(cache.Worklogs, targetDate)),
+		Content:      content,
+		Issues:       reportIssues,
+	}
+	if err := db.SaveReport(record); err != nil {
+		color.Yellow("Warning: Failed to record report history: %v", err)
+	}
+}
+
+// exportIssues returns the cached issues whose keys exported reports link to their notes
+func exportIssues(cache *TicketCache) []jira.Issue {
+	issues := append([]jira.Issue{}, cache.Issues...)
+	for _, iwc := range cache.IssuesWithComments {
+		issues = append(issues, iwc.Issue)
+	}
+	return issues
+}
+
+// previewExports describes what every enabled export target would write or call, without
+// exporting anything
+func previewExports(cfg *config.Config, generator *report.Generator, cache *TicketCache, reportContent string, targetDate time.Time) ([]string, error) {
+	var previews []string
+	var errs []error
+	for _, target := range exportTargets(cfg) {
+		targetPreviews, err := previewExport(target, cfg, generator, cache, reportContent, targetDate)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		previews = append(previews, targetPreviews...)
+	}
+	if len(previews) == 0 && len(errs) == 0 {
+		previews = append(previews, "No export targets enabled")
+	}
+	return previews, errors.Join(errs...)
+}
+
+// previewExport describes what one export target would write or call
+func previewExport(target string, cfg *config.Config, generator *report.Generator, cache *TicketCache, reportContent string, targetDate time.Time) ([]string, error) {
+	switch target {
+	case "confluence":
+		confluence := newConfluenceTarget(cfg)
+		if confluence.BaseURL == "" || confluence.SpaceKey == "" {
+			return nil, fmt.Errorf("Confluence export requires report.export.confluence.base_url and space_key")
+		}
+		title := generator.ConfluencePageTitle(targetDate)
+		if confluence.Mode == "team" {
+			return []string{fmt.Sprintf("Would update your section of the Confluence page %q in space %s (%s)", title, confluence.SpaceKey, confluence.BaseURL)}, nil
+		}
+		return []string{fmt.Sprintf("Would create or update the Confluence page %q in space %s (%s)", title, confluence.SpaceKey, confluence.BaseURL)}, nil
+	case "notion":
+		if cfg.Report.Export.Notion.DatabaseID == "" {
+			return nil, fmt.Errorf("Notion export requires report.export.notion.database_id")
+		}
+		return []string{fmt.Sprintf("Would create the Notion page %q in database %s, archiving the page already exported for %s",
+			generator.NotionPageTitle(targetDate), cfg.Report.Export.Notion.DatabaseID, targetDate.Format("2006-01-02"))}, nil
+	case "slack":
+		if cfg.Slack.WebhookURL != "" {
+			host := "(invalid URL)"
+			if webhook, err := url.Parse(cfg.Slack.WebhookURL); err == nil {
+				host = webhook.Host
+			}
+			return []string{fmt.Sprintf("Would post the report to the Slack incoming webhook at %s", host)}, nil
+		}
+		if cfg.Slack.BotToken == "" || cfg.Slack.Channel == "" {
+			return nil, fmt.Errorf("slack not configured. Set slack.webhook_url, or slack.bot_token and slack.channel")
+		}
+		return []string{fmt.Sprintf("Would post the report to Slack channel %s", cfg.Slack.Channel)}, nil
+	case "clipboard":
+		return []string{"Would copy the report to the clipboard"}, nil
+	default:
+		generator.SetExportIssues(exportIssues(cache))
+		files, err := generator.ObsidianExportFiles(reportContent, targetDate)
+		if err != nil {
+			return nil, fmt.Errorf("Export to Obsidian failed: %w", err)
+		}
+		var previews []string
+		if cfg.Report.Export.DailyNote.Enabled {
+			heading := cfg.Report.Export.DailyNote.Heading
+			previews = append(previews, previewFile("Would create the daily note", fmt.Sprintf("Would update the %q section of the daily note", heading), files[0]))
+		} else {
+			previews = append(previews, previewFile("Would write", "Would overwrite", files[0]))
+		}
+		for _, file := range files[1:] {
+			previews = append(previews, previewFile("Would create the issue note", "Would update the frontmatter of the issue note", file))
+		}
+		return previews, nil
+	}
+}
+
+// previewFile describes writing the file at path, with create when it is new and update when
+// it exists
+func previewFile(create, update, path string) string {
+	if _, err := os.Stat(path); err == nil {
+		return update + " " + path
+	}
+	return create + " " + path
+}
+
+// reportSlackMessage builds the Slack message of the report's issues
+func reportSlackMessage(cfg *config.Config, generator *report.Generator, cache *TicketCache, targetDate time.Time) slack.Message {
+	issues := cache.Issues
+	if len(cache.IssuesWithComments) > 0 {
+		issues = nil
+		for _, iwc := range cache.IssuesWithComments {
+			issues = append(issues, iwc.Issue)
+		}
+	}
+	// GitHub issue keys have no Jira browse link
+	jiraURL := cfg.Jira.BaseURL
+	if cfg.Tracker == "github" {
+		jiraURL = ""
+	}
+	return buildSlackMessage(generator, jiraURL, issues, cache.Worklogs, targetDate)
+}
+
+// buildSlackMessage maps the report's In Progress / Completed / To Do groups to Slack sections
+func buildSlackMessage(generator *report.Generator, jiraURL string, issues []jira.Issue, worklogs []jira.WorklogEntry, targetDate time.Time) slack.Message {
+	statusGroups := report.GroupIssuesByStatus(generator.FilterIssues(issues, targetDate))
+	for _, group := range statusGroups {
+		// The groups hold copies of the issues, so statuses can be shown by their configured labels
+		for i := range group {
+			group[i].Fields.Status.Name = generator.StatusLabel(group[i].Fields.Status.Name)
+		}
+	}
+
+	sections := []slack.Section{
+		{Title: "🔄 In Progress", Issues: statusGroups["In Progress"]},
+		{Title: "✅ Completed", Issues: statusGroups["Done"]},
+		{Title: "📋 To Do", Issues: statusGroups["To Do"]},
+	}
+
+	return slack.BuildMessage(targetDate, jiraURL, sections, len(generator.FilterWorklogs(worklogs, targetDate)))
+}
+
+// newConfluenceTarget builds the Confluence export destination. Confluence Cloud shares the
+// Atlassian account with Jira, so the Jira API token from 'my-day auth' is reused.
+func newConfluenceTarget(cfg *config.Config) *report.ConfluenceTarget {
+	if !exportTargetEnabled(cfg, "confluence") {
+		return nil
+	}
+
+	target := &report.ConfluenceTarget{
+		BaseURL:     cfg.Report.Export.Confluence.BaseURL,
+		SpaceKey:    cfg.Report.Export.Confluence.SpaceKey,
+		ParentID:    cfg.Report.Export.Confluence.ParentID,
+		TitlePrefix: cfg.Report.Export.Confluence.TitlePrefix,
+		Mode:        cfg.Report.Export.Confluence.Mode,
+		Member:      cfg.Report.Export.Confluence.Member,
+	}
+	if target.BaseURL == "" && cfg.Jira.BaseURL != "" {
+		target.BaseURL = strings.TrimSuffix(cfg.Jira.BaseURL, "/") + "/wiki"
+	}
+	if apiToken, err := jira.NewAuthManager("", "").LoadAPIToken(); err == nil {
+		target.Email = apiToken.Email
+		target.Token = apiToken.Token
+	}
+	return target
+}
+
+// newNotionTarget builds the Notion export destination
+func newNotionTarget(cfg *config.Config) *report.NotionTarget {
+	if !exportTargetEnabled(cfg, "notion") {
+		return nil
+	}
+	return &report.NotionTarget{
+		Token:      cfg.Report.Export.Notion.Token,
+		DatabaseID: cfg.Report.Export.Notion.DatabaseID,
+	}
+}
+
+// newDailyNoteTarget builds the Obsidian daily note the report is written into, or nil when the
+// report gets a note of its own
+func newDailyNoteTarget(cfg *config.Config) *report.DailyNoteTarget {
+	if !cfg.Report.Export.DailyNote.Enabled {
+		return nil
+	}
+	return &report.DailyNoteTarget{
+		Folder:  cfg.Report.Export.DailyNote.Folder,
+		Format:  cfg.Report.Export.DailyNote.Format,
+		Heading: cfg.Report.Export.DailyNote.Heading,
+	}
+}
+
+// newIssueNotesTarget builds the Obsidian folder of the notes per issue, or nil when issues get
+// no notes
+func newIssueNotesTarget(cfg *config.Config) *report.IssueNotesTarget {
+	if !cfg.Report.Export.IssueNotes.Enabled {
+		return nil
+	}
+	folder := cfg.Report.Export.IssueNotes.Folder
+	if folder == "" {
+		folder = filepath.Join(cfg.Report.Export.FolderPath, "issues")
+	}
+	return &report.IssueNotesTarget{Folder: folder, JiraURL: cfg.Jira.BaseURL}
+}
+
 // filterCacheDataBySince filters cached data based on the since duration
 func filterCacheDataBySince(cache *TicketCache, sinceTime time.Time, targetDate time.Time) *TicketCache {
 	// Create a new cache with filtered data
 	filteredCache := &TicketCache{
 		LastSync:           cache.LastSync,
+		User:               cache.User,
+		StatusCategories:   cache.StatusCategories,
+		BoardColumns:       cache.BoardColumns,
+		GitHubActivity:     cache.GitHubActivity,
+		LastGitHubSync:     cache.LastGitHubSync,
+		GitLabActivity:     cache.GitLabActivity,
+		LastGitLabSync:     cache.LastGitLabSync,
+		TrelloActivity:     cache.TrelloActivity,
+		AsanaActivity:      cache.AsanaActivity,
 		Issues:             []jira.Issue{},
 		IssuesWithComments: []IssueWithComments{},
 		Worklogs:           []jira.WorklogEntry{},
@@ -286,6 +1230,10 @@ func filterCacheDataBySince(cache *TicketCache, sinceTime time.Time, targetDate
 			todayEnd := todayStart.Add(24 * time.Hour)
 			
 			for _, comment := range iwc.Comments {
+				// Comments after the report date, e.g. when regenerating a past report, are left out
+				if !comment.Created.Time.Before(todayEnd) {
+					continue
+				}
 				// Include comments from target date or within since period
 				if (comment.Created.Time.After(todayStart) && comment.Created.Time.Before(todayEnd)) ||
 				   comment.Created.Time.After(sinceTime) {
@@ -293,10 +1241,19 @@ func filterCacheDataBySince(cache *TicketCache, sinceTime time.Time, targetDate
 				}
 			}
 			
+			// Status changes are kept on the same terms as comments
+			issue := iwc.Issue
+			issue.Transitions = nil
+			for _, transition := range iwc.Issue.Transitions {
+				if transition.At.Time.Before(todayEnd) && transition.At.Time.After(sinceTime) {
+					issue.Transitions = append(issue.Transitions, transition)
+				}
+			}
+			
 			// Only include the issue if it has filtered comments or was recently updated
 			if len(filteredComments) > 0 || iwc.Issue.Fields.Updated.Time.After(sinceTime) {
 				filteredCache.IssuesWithComments = append(filteredCache.IssuesWithComments, IssueWithComments{
-					Issue:    iwc.Issue,
+					Issue:    issue,
 					Comments: filteredComments,
 				})
 			}
@@ -309,6 +1266,139 @@ func filterCacheDataBySince(cache *TicketCache, sinceTime time.Time, targetDate
 			filteredCache.Worklogs = append(filteredCache.Worklogs, worklog)
 		}
 	}
+
+	// Filter imported time entries the same way
+	for _, entry := range cache.TimeEntries {
+		if entry.Start.After(sinceTime) {
+			filteredCache.TimeEntries = append(filteredCache.TimeEntries, entry)
+		}
+	}
+
+	// Mentions are kept on the same terms as status changes
+	todayEnd := targetDate.Truncate(24 * time.Hour).Add(24 * time.Hour)
+	for _, mention := range cache.Mentions {
+		if mention.Comment.Created.Time.Before(todayEnd) && mention.Comment.Created.Time.After(sinceTime) {
+			filteredCache.Mentions = append(filteredCache.Mentions, mention)
+		}
+	}
 	
 	return filteredCache
 }
+
+// applyJQLQuery replaces the cached Jira issues, comments and worklogs with the issues a custom
+// JQL query matches, fetched live from Jira within the --since window. The local store is left
+// untouched.
+func applyJQLQuery(cmd *cobra.Command, cfg *config.Config, cache *TicketCache, jql string) error {
+	if cfg.Tracker != "" && cfg.Tracker != "jira" {
+		return fmt.Errorf("--jql requires the Jira tracker (tracker is %q)", cfg.Tracker)
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	tempo, err := newTempoClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	since, _ := cmd.Flags().GetDuration("since")
+	verbose, _ := cmd.Flags().GetBool("verbose")
+	tickets, err := fetchJiraIssues(context.Background(), client, jiraQuery{
+		JQL:           jql,
+		Since:         since,
+		CommentsSince: since,
+		MaxResults:    cfg.Jira.MaxResults,
+		Worklog:       true,
+		Changelog:     !cfg.Jira.LowBandwidth,
+		Tempo:         tempo,
+		Verbose:       verbose,
+	})
+	if err != nil {
+		return err
+	}
+
+	cache.Issues = nil
+	for _, iwc := range tickets.IssuesWithComments {
+		cache.Issues = append(cache.Issues, iwc.Issue)
+	}
+	cache.IssuesWithComments = tickets.IssuesWithComments
+	cache.Worklogs = tickets.Worklogs
+	cache.User = tickets.User
+	cache.Warnings = tickets.Warnings
+	return nil
+}
+
+// applyTimeEntries adds the imported Toggl or Harvest time entries to the worklogs, so they
+// appear in the Work Log section and the LLM knows where the time went
+func applyTimeEntries(cache *TicketCache) {
+	if len(cache.TimeEntries) == 0 {
+		return
+	}
+
+	// Jira worklogs refer to issues by ID; map them to keys to spot duplicates
+	issueKeys := make(map[string]string)
+	for _, issue := range cache.Issues {
+		issueKeys[issue.ID] = issue.Key
+	}
+	cache.Worklogs = timetracking.MergeWorklogs(cache.Worklogs, cache.TimeEntries, issueKeys)
+}
+
+// buildReportExplanation explains, per cached issue, why it was included in or excluded from the report
+func buildReportExplanation(cmd *cobra.Command, generator *report.Generator, unfilteredCache, filteredCache *TicketCache, sinceTime time.Time, targetDate time.Time, llmEnabled bool) string {
+	reportConfig := generator.GetConfig()
+
+	// Describe the filters and flags that shaped this report
+	since, _ := cmd.Flags().GetDuration("since")
+	filters := []string{
+		fmt.Sprintf("--since %v (updated after %s)", since, sinceTime.Format("2006-01-02 15:04")),
+		fmt.Sprintf("report date %s", targetDate.Format("2006-01-02")),
+		fmt.Sprintf("include_today=%t, include_yesterday=%t, include_in_progress=%t",
+			reportConfig.IncludeToday, reportConfig.IncludeYesterday, reportConfig.IncludeInProgress),
+	}
+	if reportConfig.GroupByField != "" {
+		filters = append(filters, fmt.Sprintf("--field %s", reportConfig.GroupByField))
+	}
+	if !llmEnabled {
+		filters = append(filters, "LLM summaries disabled")
+	}
+
+	accountID := ""
+	if unfilteredCache.User != nil {
+		accountID = unfilteredCache.User.AccountID
+	}
+
+	// Issues that survived the --since filter, keyed for lookup
+	kept := make(map[string]bool)
+	for _, iwc := range filteredCache.IssuesWithComments {
+		kept[iwc.Issue.Key] = true
+	}
+	for _, issue := range filteredCache.Issues {
+		kept[issue.Key] = true
+	}
+
+	// Mirror the report's data source: issues with comments when available
+	candidates := unfilteredCache.IssuesWithComments
+	if len(candidates) == 0 {
+		for _, issue := range unfilteredCache.Issues {
+			candidates = append(candidates, IssueWithComments{Issue: issue})
+		}
+	}
+
+	var explanations []report.IssueExplanation
+	for _, iwc := range candidates {
+		if !kept[iwc.Issue.Key] {
+			explanations = append(explanations, report.IssueExplanation{
+				Issue:    iwc.Issue,
+				Included: false,
+				Reasons: []string{fmt.Sprintf("last updated %s, before the --since window",
+					iwc.Issue.Fields.Updated.Time.Format("Jan 2, 15:04"))},
+			})
+			continue
+		}
+		explanations = append(explanations, generator.ExplainIssue(iwc.Issue, iwc.Comments, targetDate, accountID))
+	}
+
+	return report.FormatExplanation(explanations, filters)
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/report"
+)
+
+func TestMergeProfileCache(t *testing.T) {
+	synced := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	merged := &TicketCache{
+		LastSync:         synced,
+		Issues:           []jira.Issue{{Key: "OPS-1"}},
+		Mentions:         []jira.Mention{{IssueKey: "OPS-2"}},
+		Warnings:         report.Warnings{{Severity: report.SeverityWarning, Source: "GitHub", Message: "rate limited"}},
+		StatusCategories: jira.NewStatusCategoryMap([]jira.Status{{ID: "1", Name: "Doing", Category: jira.StatusCategory{Key: "indeterminate"}}}),
+		BoardColumns:     &jira.BoardColumnMap{Columns: []jira.BoardColumn{{Name: "In Progress", StatusIDs: []string{"1"}}}},
+	}
+	other := &TicketCache{
+		LastSync:         synced.Add(-time.Hour),
+		Issues:           []jira.Issue{{Key: "CORE-1"}},
+		Mentions:         []jira.Mention{{IssueKey: "CORE-2"}},
+		Warnings:         report.Warnings{{Severity: report.SeverityWarning, Source: "GitHub", Message: "rate limited"}, {Severity: report.SeverityInfo, Source: "Jira", Message: "skipped 2 restricted issues"}},
+		StatusCategories: jira.NewStatusCategoryMap([]jira.Status{{ID: "1", Name: "Open", Category: jira.StatusCategory{Key: "new"}}, {ID: "2", Name: "Shipped", Category: jira.StatusCategory{Key: "done"}}}),
+		BoardColumns:     &jira.BoardColumnMap{Columns: []jira.BoardColumn{{Name: "In progress", StatusIDs: []string{"1", "3"}}, {Name: "Released", StatusIDs: []string{"2"}}}},
+	}
+
+	mergeProfileCache(merged, other)
+
+	if len(merged.Issues) != 2 || len(merged.Mentions) != 2 || merged.Mentions[1].IssueKey != "CORE-2" {
+		t.Errorf("expected the issues and mentions of both profiles, got %+v and %+v", merged.Issues, merged.Mentions)
+	}
+	if len(merged.Warnings) != 2 || merged.Warnings[1].Source != "Jira" {
+		t.Errorf("expected the sync warnings of both profiles once, got %v", merged.Warnings)
+	}
+	if !merged.LastSync.Equal(other.LastSync) {
+		t.Errorf("expected the oldest sync time, got %v", merged.LastSync)
+	}
+	if category, ok := merged.StatusCategories.Resolve(jira.Status{Name: "Shipped"}); !ok || category.Key != "done" {
+		t.Errorf("expected the second profile's statuses to be mapped, got %+v", category)
+	}
+	if category, _ := merged.StatusCategories.Resolve(jira.Status{ID: "1"}); category.Key != "indeterminate" {
+		t.Errorf("expected the first profile's mapping to win, got %+v", category)
+	}
+	if names := merged.BoardColumns.ColumnNames(); len(names) != 2 || names[1] != "Released" {
+		t.Errorf("expected the columns of both boards, got %v", names)
+	}
+	if column := merged.BoardColumns.Column(jira.Status{ID: "3"}); column != "In Progress" {
+		t.Errorf("expected status 3 on the shared column, got %q", column)
+	}
+}
@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/jira"
+	"my-day/internal/report"
+	"my-day/internal/store"
+)
+
+// reportTeamCmd represents the report team command
+var reportTeamCmd = &cobra.Command{
+	Use:   "team",
+	Short: "Generate a report of your team's activity",
+	Long: `Team fetches the issues assigned to each member of your team and their comments,
+and reports them per person with an AI summary of each person's work.
+
+The team is configured with jira.team.members (account IDs, or usernames on Jira
+Server/Data Center) and/or jira.team.group (a Jira group whose members join the
+team), or given with --members and --group. Unlike the other reports it reads
+from Jira directly rather than from the local cache.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := generateTeamReport(cmd); err != nil {
+			exitWithError("Team report generation failed", err)
+		}
+	},
+}
+
+func init() {
+	reportCmd.AddCommand(reportTeamCmd)
+
+	// Team report flags
+	reportTeamCmd.Flags().Duration("since", 24*time.Hour, "Include issues and comments updated since this duration ago")
+	reportTeamCmd.Flags().StringSlice("members", nil, "Account IDs of the team members (default: jira.team.members)")
+	reportTeamCmd.Flags().String("group", "", "Jira group whose members join the team (default: jira.team.group)")
+	reportTeamCmd.Flags().String("output", "", "Output file path (default: stdout)")
+	reportTeamCmd.Flags().Bool("no-llm", false, "Disable the LLM summary of each person")
+}
+
+func generateTeamReport(cmd *cobra.Command) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	memberIDs := cfg.Jira.Team.Members
+	if cmd.Flags().Changed("members") {
+		memberIDs, _ = cmd.Flags().GetStringSlice("members")
+	}
+	group := cfg.Jira.Team.Group
+	if cmd.Flags().Changed("group") {
+		group, _ = cmd.Flags().GetString("group")
+	}
+	if len(memberIDs) == 0 && group == "" {
+		return fmt.Errorf("no team configured. Set jira.team.members or jira.team.group, or use --members or --group")
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	members, err := teamMembers(ctx, client, memberIDs, group)
+	if err != nil {
+		return err
+	}
+	if len(members) == 0 {
+		return fmt.Errorf("the team has no members")
+	}
+
+	since, _ := cmd.Flags().GetDuration("since")
+	sinceTime := time.Now().Add(-since)
+	ids := make([]string, len(members))
+	for i, member := range members {
+		ids[i] = jira.TeamMemberID(member.User)
+	}
+
+	color.Cyan("👥 Fetching the issues of %d team members...", len(members))
+	searchResponse, err := client.GetTeamIssues(ctx, ids, cfg.Jira.Projects, sinceTime, cfg.Jira.MaxResults)
+	if err != nil {
+		return fmt.Errorf("failed to fetch team issues: %w", err)
+	}
+
+	for _, issue := range searchResponse.Issues {
+		if issue.Fields.Assignee == nil {
+			continue
+		}
+		comments, err := client.GetTeamComments(ctx, issue.Key, ids, sinceTime)
+		if err != nil && !errors.Is(err, jira.ErrRestricted) {
+			color.Yellow("⚠️  Failed to fetch comments for %s: %v", issue.Key, err)
+		}
+		for i := range members {
+			if !jira.IsTeamMember(*issue.Fields.Assignee, []string{ids[i]}) {
+				continue
+			}
+			if members[i].User.DisplayName == "" {
+				members[i].User.DisplayName = issue.Fields.Assignee.DisplayName
+			}
+			members[i].Issues = append(members[i].Issues, report.IssueWithComments{Issue: issue, Comments: comments})
+			break
+		}
+	}
+
+	// 'my-day digest --team' builds the weekly team digest from the stored team issues
+	if storePath, err := getCacheFilePath(); err == nil {
+		if err := withStore(storePath, func(db *store.Store) error {
+			return db.SaveTeamIssues(searchResponse.Issues)
+		}); err != nil {
+			color.Yellow("⚠️  Failed to store team issues: %v", err)
+		}
+	}
+
+	llmEnabled := cfg.LLM.Enabled
+	if noLLM, _ := cmd.Flags().GetBool("no-llm"); noLLM {
+		llmEnabled = false
+	}
+
+	generator := report.NewGenerator(&report.Config{
+		Format:            cfg.Report.Format,
+		LLMEnabled:        llmEnabled,
+		LLMMode:           cfg.LLM.Mode,
+		LLMModel:          cfg.LLM.Model,
+		LLMLanguage:       cfg.LLM.Language,
+		OllamaURL:         cfg.LLM.Ollama.BaseURL,
+		OllamaModel:       cfg.LLM.Ollama.Model,
+		OllamaIdleStop:    cfg.LLM.Ollama.IdleStopMinutes,
+		OpenAIURL:         cfg.LLM.OpenAI.BaseURL,
+		OpenAIAPIKey:      cfg.LLM.OpenAI.APIKey,
+		OpenAIModel:       cfg.LLM.OpenAI.Model,
+		OpenAIAPIVersion:  cfg.LLM.OpenAI.APIVersion,
+		LocalOpenAIURL:    cfg.LLM.LocalOpenAI.BaseURL,
+		LocalOpenAIAPIKey: cfg.LLM.LocalOpenAI.APIKey,
+		LocalOpenAIModel:  cfg.LLM.LocalOpenAI.Model,
+		LLMPrivacy:        newLLMPrivacy(cfg),
+		LLMProjects:       newLLMProjects(cfg),
+		Hide: report.HiddenSections{
+			ToDo:   !cfg.Report.Sections.ToDo,
+			Footer: !cfg.Report.Sections.Footer,
+		},
+	})
+
+	content, err := generator.GenerateTeam(members, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to generate team report: %w", err)
+	}
+	for _, warning := range generator.Warnings() {
+		color.Yellow("⚠️  %s", warning.Message)
+	}
+
+	if outputFile, _ := cmd.Flags().GetString("output"); outputFile != "" {
+		if err := os.WriteFile(outputFile, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write report to file: %w", err)
+		}
+		color.Green("✓ Team report saved to: %s", outputFile)
+	} else {
+		fmt.Print(content)
+	}
+
+	return nil
+}
+
+// teamMembers returns the configured team members followed by the members of group, once each
+func teamMembers(ctx context.Context, client *jira.Client, memberIDs []string, group string) ([]report.TeamMember, error) {
+	var members []report.TeamMember
+	seen := make(map[string]bool)
+	for _, id := range memberIDs {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			members = append(members, report.TeamMember{User: jira.User{AccountID: id}})
+		}
+	}
+	if group == "" {
+		return members, nil
+	}
+
+	groupMembers, err := client.GetGroupMembers(ctx, group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the members of %s: %w", group, err)
+	}
+	for _, user := range groupMembers {
+		if id := jira.TeamMemberID(user); !seen[id] && !seen[user.AccountID] {
+			seen[id] = true
+			members = append(members, report.TeamMember{User: user})
+		}
+	}
+	return members, nil
+}
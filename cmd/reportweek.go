@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/report"
+)
+
+// reportWeekCmd represents the report week command
+var reportWeekCmd = &cobra.Command{
+	Use:   "week",
+	Short: "Generate a weekly rollup report",
+	Long: `Week generates a Monday–Friday rollup of your issues, comments and worklogs,
+grouped by day, with an AI narrative summary of the whole week.
+
+By default it covers the current week. Use --date to pick any day in another
+week, or --last-week for the previous one. Data comes from the local cache, so
+run 'my-day sync --since 168h' first to cover a full week.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := generateWeeklyReport(cmd); err != nil {
+			exitWithError("Weekly report generation failed", err)
+		}
+	},
+}
+
+func init() {
+	reportCmd.AddCommand(reportWeekCmd)
+
+	// Weekly report flags
+	reportWeekCmd.Flags().String("date", "", "Any date in the week to report on (YYYY-MM-DD, default: this week)")
+	reportWeekCmd.Flags().Bool("last-week", false, "Report on the previous week")
+	reportWeekCmd.Flags().String("output", "", "Output file path (default: stdout)")
+	reportWeekCmd.Flags().Bool("no-llm", false, "Disable the LLM weekly narrative")
+}
+
+func generateWeeklyReport(cmd *cobra.Command) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	cacheFile, err := getCacheFilePath()
+	if err != nil {
+		return fmt.Errorf("failed to get cache file path: %w", err)
+	}
+
+	cache, err := loadCache(cacheFile)
+	if err != nil {
+		color.Yellow("No cached data found. Run 'my-day sync' first.")
+		return fmt.Errorf("failed to load cache: %w", err)
+	}
+	applyStatusCategories(cache)
+
+	targetDate := time.Now()
+	if dateStr, _ := cmd.Flags().GetString("date"); dateStr != "" {
+		targetDate, err = time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			return fmt.Errorf("invalid date format. Use YYYY-MM-DD: %w", err)
+		}
+	}
+	if lastWeek, _ := cmd.Flags().GetBool("last-week"); lastWeek {
+		targetDate = targetDate.AddDate(0, 0, -7)
+	}
+	weekStart := report.WeekStart(targetDate)
+
+	llmEnabled := cfg.LLM.Enabled
+	if noLLM, _ := cmd.Flags().GetBool("no-llm"); noLLM {
+		llmEnabled = false
+	}
+
+	generator := report.NewGenerator(&report.Config{
+		Format:            cfg.Report.Format,
+		LLMEnabled:        llmEnabled,
+		LLMMode:           cfg.LLM.Mode,
+		LLMModel:          cfg.LLM.Model,
+		LLMLanguage:       cfg.LLM.Language,
+		OllamaURL:         cfg.LLM.Ollama.BaseURL,
+		OllamaModel:       cfg.LLM.Ollama.Model,
+		OllamaIdleStop:    cfg.LLM.Ollama.IdleStopMinutes,
+		OpenAIURL:         cfg.LLM.OpenAI.BaseURL,
+		OpenAIAPIKey:      cfg.LLM.OpenAI.APIKey,
+		OpenAIModel:       cfg.LLM.OpenAI.Model,
+		OpenAIAPIVersion:  cfg.LLM.OpenAI.APIVersion,
+		LocalOpenAIURL:    cfg.LLM.LocalOpenAI.BaseURL,
+		LocalOpenAIAPIKey: cfg.LLM.LocalOpenAI.APIKey,
+		LocalOpenAIModel:  cfg.LLM.LocalOpenAI.Model,
+		LLMPrivacy:        newLLMPrivacy(cfg),
+		LLMProjects:       newLLMProjects(cfg),
+	})
+
+	color.Cyan("📅 Generating weekly report for %s – %s...",
+		weekStart.Format("2006-01-02"), weekStart.AddDate(0, 0, 4).Format("2006-01-02"))
+
+	var issuesWithComments []report.IssueWithComments
+	for _, iwc := range cache.IssuesWithComments {
+		issuesWithComments = append(issuesWithComments, report.IssueWithComments{
+			Issue:    iwc.Issue,
+			Comments: iwc.Comments,
+		})
+	}
+
+	content, err := generator.GenerateWeekly(issuesWithComments, cache.Worklogs, weekStart)
+	if err != nil {
+		return fmt.Errorf("failed to generate weekly report: %w", err)
+	}
+
+	if outputFile, _ := cmd.Flags().GetString("output"); outputFile != "" {
+		if err := os.WriteFile(outputFile, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write report to file: %w", err)
+		}
+		color.Green("✓ Weekly report saved to: %s", outputFile)
+	} else {
+		fmt.Print(content)
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/jira"
+	"my-day/internal/report"
+	"my-day/internal/store"
+)
+
+// retroCmd represents the retro command
+var retroCmd = &cobra.Command{
+	Use:   "retro",
+	Short: "Gather retrospective input for a sprint",
+	Long: `Retro collects input for a retrospective meeting from the comments synced
+during a sprint: recurring blockers, clusters of negative comments grouped by the
+kind of work they are about, and notable wins such as completed issues.
+
+The sprint is looked up on the Agile board set in jira.board_id (or --board):
+--sprint last is the most recently closed sprint, --sprint current the active
+one, and any other value is matched against sprint names. Use --from and --to
+instead to cover a date range without asking Jira.
+
+It reads the local store that 'my-day sync' fills, so it covers every day synced
+so far. Blockers and sentiment are detected from keywords; review the document
+before sharing it.`,
+	Example: `  my-day retro --sprint last
+  my-day retro --sprint "Sprint 42" --output retro.md
+  my-day retro --from 2024-07-01 --to 2024-07-12`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := generateRetro(cmd); err != nil {
+			exitWithError("Retro generation failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(retroCmd)
+
+	// Retro flags
+	retroCmd.Flags().String("sprint", "last", "Sprint to cover: last, current or a sprint name")
+	retroCmd.Flags().Int("board", 0, "Agile board to look the sprint up on (default: jira.board_id)")
+	retroCmd.Flags().String("from", "", "First day to cover instead of a sprint (YYYY-MM-DD)")
+	retroCmd.Flags().String("to", "", "Last day to cover with --from (YYYY-MM-DD, default: today)")
+	retroCmd.Flags().String("output", "", "Output file path (default: stdout)")
+}
+
+func generateRetro(cmd *cobra.Command) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	name, start, end, err := retroPeriod(cmd, cfg)
+	if err != nil {
+		return err
+	}
+
+	storePath, err := getCacheFilePath()
+	if err != nil {
+		return fmt.Errorf("failed to get store path: %w", err)
+	}
+
+	db, err := store.Open(storePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	issues, err := db.Issues(start)
+	if err != nil {
+		return err
+	}
+	comments, err := db.Comments(start)
+	if err != nil {
+		return err
+	}
+	if len(issues) == 0 {
+		color.Yellow("No synced activity since %s. Run 'my-day sync --since %s' to fill the local store.", start.Format("2006-01-02"), retroSyncWindow(start))
+	}
+
+	var issuesWithComments []report.IssueWithComments
+	for _, issue := range issues {
+		issuesWithComments = append(issuesWithComments, report.IssueWithComments{
+			Issue:    issue,
+			Comments: comments[issue.Key],
+		})
+	}
+
+	content := report.BuildRetro(name, issuesWithComments, start, end).Markdown()
+
+	if outputFile, _ := cmd.Flags().GetString("output"); outputFile != "" {
+		if err := os.WriteFile(outputFile, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write retro to file: %w", err)
+		}
+		color.Green("✓ Retro saved to: %s", outputFile)
+	} else {
+		fmt.Print(content)
+	}
+
+	return nil
+}
+
+// retroPeriod returns the name, start and end of the period the retro covers: the
+// --from/--to dates, or the sprint looked up on the Agile board
+func retroPeriod(cmd *cobra.Command, cfg *config.Config) (string, time.Time, time.Time, error) {
+	if from, _ := cmd.Flags().GetString("from"); from != "" {
+		start, err := time.ParseInLocation("2006-01-02", from, time.Local)
+		if err != nil {
+			return "", time.Time{}, time.Time{}, fmt.Errorf("invalid --from date. Use YYYY-MM-DD: %w", err)
+		}
+		end := time.Now()
+		if to, _ := cmd.Flags().GetString("to"); to != "" {
+			if end, err = time.ParseInLocation("2006-01-02", to, time.Local); err != nil {
+				return "", time.Time{}, time.Time{}, fmt.Errorf("invalid --to date. Use YYYY-MM-DD: %w", err)
+			}
+		}
+		end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.Local).AddDate(0, 0, 1)
+		if !end.After(start) {
+			return "", time.Time{}, time.Time{}, fmt.Errorf("--to must not be before --from")
+		}
+		return "", start, end, nil
+	}
+
+	boardID, _ := cmd.Flags().GetInt("board")
+	if boardID == 0 {
+		boardID = cfg.Jira.BoardID
+	}
+	if boardID == 0 {
+		return "", time.Time{}, time.Time{}, fmt.Errorf("no Agile board configured. Set jira.board_id, use --board, or give a date range with --from and --to")
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return "", time.Time{}, time.Time{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	sprints, err := client.GetSprints(ctx, boardID, "active,closed")
+	if err != nil {
+		return "", time.Time{}, time.Time{}, fmt.Errorf("failed to get sprints of board %d: %w", boardID, err)
+	}
+
+	selector, _ := cmd.Flags().GetString("sprint")
+	sprint := findSprint(sprints, selector)
+	if sprint == nil {
+		return "", time.Time{}, time.Time{}, fmt.Errorf("no %s sprint found on board %d", selector, boardID)
+	}
+
+	end := sprint.End()
+	if sprint.State == "active" || end.IsZero() || end.After(time.Now()) {
+		end = time.Now()
+	}
+	return sprint.Name, sprint.StartDate.Time, end, nil
+}
+
+// findSprint returns the last closed sprint for "last", the active sprint for "current",
+// or the sprint with the given name
+func findSprint(sprints []jira.Sprint, selector string) *jira.Sprint {
+	var found *jira.Sprint
+	for i, sprint := range sprints {
+		switch strings.ToLower(selector) {
+		case "last":
+			if sprint.State == "closed" && (found == nil || sprint.End().After(found.End())) {
+				found = &sprints[i]
+			}
+		case "current":
+			if sprint.State == "active" {
+				return &sprints[i]
+			}
+		default:
+			if strings.EqualFold(sprint.Name, selector) {
+				return &sprints[i]
+			}
+		}
+	}
+	return found
+}
+
+// retroSyncWindow returns a --since duration reaching back to start
+func retroSyncWindow(start time.Time) string {
+	hours := int(time.Since(start).Hours()) + 24
+	return fmt.Sprintf("%dh", hours)
+}
@@ -2,11 +2,15 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
 
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"my-day/internal/config"
+	"my-day/internal/logging"
+	"my-day/internal/trace"
 )
 
 var cfgFile string
@@ -25,7 +29,10 @@ It integrates with Jira Cloud and optionally uses embedded LLM for ticket summar
 func Execute() {
 	err := rootCmd.Execute()
 	if err != nil {
-		os.Exit(1)
+		os.Exit(exitError)
+	}
+	if exitStatus != exitOK {
+		os.Exit(exitStatus)
 	}
 }
 
@@ -38,44 +45,71 @@ func init() {
 	rootCmd.PersistentFlags().String("jira-email", "", "Jira email address for API token authentication")
 	rootCmd.PersistentFlags().String("jira-token", "", "Jira API token")
 	rootCmd.PersistentFlags().StringSlice("projects", []string{}, "Jira project keys to track")
-	rootCmd.PersistentFlags().String("llm-mode", "ollama", "LLM mode: embedded, ollama, disabled")
+	rootCmd.PersistentFlags().String("llm-mode", "ollama", "LLM mode: embedded, ollama, openai, local-openai, disabled")
 	rootCmd.PersistentFlags().String("llm-model", "qwen2.5:3b", "LLM model name")
 	rootCmd.PersistentFlags().Bool("llm-enabled", true, "Enable LLM features")
 	rootCmd.PersistentFlags().String("ollama-url", "http://localhost:11434", "Ollama base URL")
 	rootCmd.PersistentFlags().String("ollama-model", "qwen2.5:3b", "Ollama model name")
+	rootCmd.PersistentFlags().String("openai-url", "https://api.openai.com/v1", "OpenAI-compatible API base URL")
+	rootCmd.PersistentFlags().String("openai-model", "gpt-4o-mini", "OpenAI-compatible model name")
 	rootCmd.PersistentFlags().Bool("llm-debug", false, "Enable LLM debug mode")
 	rootCmd.PersistentFlags().String("llm-style", "technical", "LLM summary style: technical, business, brief")
+	rootCmd.PersistentFlags().String("llm-language", "auto", "Language of LLM summaries: auto (detect from today's comments) or a language such as en, es, Spanish")
 	rootCmd.PersistentFlags().Int("llm-max-length", 0, "Maximum LLM summary length (0 for no limit)")
 	rootCmd.PersistentFlags().Bool("llm-technical-details", true, "Include technical details in summaries")
-	rootCmd.PersistentFlags().String("llm-fallback", "graceful", "LLM fallback strategy: graceful, strict")
-	rootCmd.PersistentFlags().String("report-format", "console", "Report format: console, markdown")
+	rootCmd.PersistentFlags().String("llm-fallback", "graceful", "LLM fallback strategy: graceful, strict (fail the report when the AI summary is unavailable)")
+	rootCmd.PersistentFlags().String("report-format", "console", "Report format: console, markdown, html")
 	rootCmd.PersistentFlags().Bool("include-yesterday", true, "Include yesterday's work in report")
 	rootCmd.PersistentFlags().Bool("include-today", true, "Include today's work in report")
 	rootCmd.PersistentFlags().Bool("include-in-progress", true, "Include in-progress tickets in report")
+	rootCmd.PersistentFlags().Int("max-comment-excerpt", 500, "Maximum characters of the latest comment in detailed reports (0 for no limit)")
+	rootCmd.PersistentFlags().Int("variance-threshold", 20, "Percent time spent may exceed the original estimate before an issue is flagged in detailed reports")
+	rootCmd.PersistentFlags().StringSlice("profile", []string{}, "Jira profile from jira.profiles to use; sync and report accept several (comma-separated) or \"all\" to combine instances")
+	rootCmd.PersistentFlags().String("tracker", "jira", "Issue tracker to sync tickets from: jira, github")
+	rootCmd.PersistentFlags().Bool("low-bandwidth", false, "Fetch as little as possible from Jira and prefer cached data (for slow or metered connections)")
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Enable verbose output")
-	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "Enable quiet output")
+	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "Print only results, such as the report, and errors")
+	rootCmd.PersistentFlags().Bool("trace", false, "Log every Jira and LLM request with its request ID to stderr")
+	rootCmd.PersistentFlags().String("log-level", "auto", "Level of the log written to stderr and --log-file: debug, info, warn, error, or auto (warn, debug with --verbose or --debug)")
+	rootCmd.PersistentFlags().String("log-file", "", "Also write the log to this file as JSON lines")
+
+	// Dynamic values for 'my-day completion'
+	rootCmd.RegisterFlagCompletionFunc("projects", completeProjectKeys)
+	rootCmd.RegisterFlagCompletionFunc("llm-model", completeOllamaModels)
+	rootCmd.RegisterFlagCompletionFunc("ollama-model", completeOllamaModels)
 
 	// Bind flags to viper
+	viper.BindPFlag("profile", rootCmd.PersistentFlags().Lookup("profile"))
+	viper.BindPFlag("tracker", rootCmd.PersistentFlags().Lookup("tracker"))
 	viper.BindPFlag("jira.base_url", rootCmd.PersistentFlags().Lookup("jira-url"))
 	viper.BindPFlag("jira.email", rootCmd.PersistentFlags().Lookup("jira-email"))
 	viper.BindPFlag("jira.token", rootCmd.PersistentFlags().Lookup("jira-token"))
 	viper.BindPFlag("jira.projects", rootCmd.PersistentFlags().Lookup("projects"))
+	viper.BindPFlag("jira.low_bandwidth", rootCmd.PersistentFlags().Lookup("low-bandwidth"))
 	viper.BindPFlag("llm.mode", rootCmd.PersistentFlags().Lookup("llm-mode"))
 	viper.BindPFlag("llm.model", rootCmd.PersistentFlags().Lookup("llm-model"))
 	viper.BindPFlag("llm.enabled", rootCmd.PersistentFlags().Lookup("llm-enabled"))
 	viper.BindPFlag("llm.debug", rootCmd.PersistentFlags().Lookup("llm-debug"))
 	viper.BindPFlag("llm.summary_style", rootCmd.PersistentFlags().Lookup("llm-style"))
+	viper.BindPFlag("llm.language", rootCmd.PersistentFlags().Lookup("llm-language"))
 	viper.BindPFlag("llm.max_summary_length", rootCmd.PersistentFlags().Lookup("llm-max-length"))
 	viper.BindPFlag("llm.include_technical_details", rootCmd.PersistentFlags().Lookup("llm-technical-details"))
 	viper.BindPFlag("llm.fallback_strategy", rootCmd.PersistentFlags().Lookup("llm-fallback"))
 	viper.BindPFlag("llm.ollama.base_url", rootCmd.PersistentFlags().Lookup("ollama-url"))
 	viper.BindPFlag("llm.ollama.model", rootCmd.PersistentFlags().Lookup("ollama-model"))
+	viper.BindPFlag("llm.openai.base_url", rootCmd.PersistentFlags().Lookup("openai-url"))
+	viper.BindPFlag("llm.openai.model", rootCmd.PersistentFlags().Lookup("openai-model"))
 	viper.BindPFlag("report.format", rootCmd.PersistentFlags().Lookup("report-format"))
 	viper.BindPFlag("report.include_yesterday", rootCmd.PersistentFlags().Lookup("include-yesterday"))
 	viper.BindPFlag("report.include_today", rootCmd.PersistentFlags().Lookup("include-today"))
 	viper.BindPFlag("report.include_in_progress", rootCmd.PersistentFlags().Lookup("include-in-progress"))
+	viper.BindPFlag("report.max_comment_excerpt", rootCmd.PersistentFlags().Lookup("max-comment-excerpt"))
+	viper.BindPFlag("report.variance_threshold", rootCmd.PersistentFlags().Lookup("variance-threshold"))
 	viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
 	viper.BindPFlag("quiet", rootCmd.PersistentFlags().Lookup("quiet"))
+	viper.BindPFlag("trace", rootCmd.PersistentFlags().Lookup("trace"))
+	viper.BindPFlag("log_level", rootCmd.PersistentFlags().Lookup("log-level"))
+	viper.BindPFlag("log_file", rootCmd.PersistentFlags().Lookup("log-file"))
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -100,11 +134,50 @@ func initConfig() {
 	viper.AutomaticEnv()
 	
 	// Bind environment variables explicitly for nested keys
+	viper.BindEnv("tracker", "MY_DAY_TRACKER")
+	viper.BindEnv("profile", "MY_DAY_PROFILE")
+
 	// Jira configuration
 	viper.BindEnv("jira.email", "MY_DAY_JIRA_EMAIL")
 	viper.BindEnv("jira.token", "MY_DAY_JIRA_TOKEN")
 	viper.BindEnv("jira.base_url", "MY_DAY_JIRA_BASE_URL")
+	viper.BindEnv("jira.deployment", "MY_DAY_JIRA_DEPLOYMENT")
 	viper.BindEnv("jira.projects", "MY_DAY_JIRA_PROJECTS")
+	viper.BindEnv("jira.board_id", "MY_DAY_JIRA_BOARD_ID")
+	viper.BindEnv("jira.max_results", "MY_DAY_JIRA_MAX_RESULTS")
+	viper.BindEnv("jira.low_bandwidth", "MY_DAY_JIRA_LOW_BANDWIDTH")
+	viper.BindEnv("jira.max_comment_length", "MY_DAY_JIRA_MAX_COMMENT_LENGTH")
+	viper.BindEnv("jira.comment_authors", "MY_DAY_JIRA_COMMENT_AUTHORS")
+	viper.BindEnv("jira.all_comments", "MY_DAY_JIRA_ALL_COMMENTS")
+	viper.BindEnv("jira.team.members", "MY_DAY_JIRA_TEAM_MEMBERS")
+	viper.BindEnv("jira.team.group", "MY_DAY_JIRA_TEAM_GROUP")
+	viper.BindEnv("jira.oauth.client_id", "MY_DAY_JIRA_OAUTH_CLIENT_ID")
+	viper.BindEnv("jira.oauth.client_secret", "MY_DAY_JIRA_OAUTH_CLIENT_SECRET")
+	viper.BindEnv("jira.oauth.callback_port", "MY_DAY_JIRA_OAUTH_CALLBACK_PORT")
+	
+	// GitLab configuration
+	viper.BindEnv("gitlab.enabled", "MY_DAY_GITLAB_ENABLED")
+	viper.BindEnv("gitlab.base_url", "MY_DAY_GITLAB_BASE_URL")
+	viper.BindEnv("gitlab.token", "MY_DAY_GITLAB_TOKEN")
+	viper.BindEnv("gitlab.projects", "MY_DAY_GITLAB_PROJECTS")
+
+	// Trello and Asana configuration
+	viper.BindEnv("trello.enabled", "MY_DAY_TRELLO_ENABLED")
+	viper.BindEnv("trello.api_key", "MY_DAY_TRELLO_API_KEY")
+	viper.BindEnv("trello.token", "MY_DAY_TRELLO_TOKEN")
+	viper.BindEnv("trello.boards", "MY_DAY_TRELLO_BOARDS")
+	viper.BindEnv("asana.enabled", "MY_DAY_ASANA_ENABLED")
+	viper.BindEnv("asana.token", "MY_DAY_ASANA_TOKEN")
+	viper.BindEnv("asana.workspace", "MY_DAY_ASANA_WORKSPACE")
+	viper.BindEnv("asana.projects", "MY_DAY_ASANA_PROJECTS")
+
+	// Time tracking configuration
+	viper.BindEnv("time_tracking.provider", "MY_DAY_TIME_TRACKING_PROVIDER")
+	viper.BindEnv("time_tracking.token", "MY_DAY_TIME_TRACKING_TOKEN")
+	viper.BindEnv("time_tracking.account_id", "MY_DAY_TIME_TRACKING_ACCOUNT_ID")
+	viper.BindEnv("tempo.enabled", "MY_DAY_TEMPO_ENABLED")
+	viper.BindEnv("tempo.token", "MY_DAY_TEMPO_TOKEN")
+	viper.BindEnv("tempo.base_url", "MY_DAY_TEMPO_BASE_URL")
 	
 	// LLM configuration
 	viper.BindEnv("llm.mode", "MY_DAY_LLM_MODE")
@@ -112,22 +185,118 @@ func initConfig() {
 	viper.BindEnv("llm.enabled", "MY_DAY_LLM_ENABLED")
 	viper.BindEnv("llm.debug", "MY_DAY_LLM_DEBUG")
 	viper.BindEnv("llm.summary_style", "MY_DAY_LLM_SUMMARY_STYLE")
+	viper.BindEnv("llm.language", "MY_DAY_LLM_LANGUAGE")
 	viper.BindEnv("llm.max_summary_length", "MY_DAY_LLM_MAX_SUMMARY_LENGTH")
 	viper.BindEnv("llm.include_technical_details", "MY_DAY_LLM_INCLUDE_TECHNICAL_DETAILS")
 	viper.BindEnv("llm.prioritize_recent_work", "MY_DAY_LLM_PRIORITIZE_RECENT_WORK")
 	viper.BindEnv("llm.fallback_strategy", "MY_DAY_LLM_FALLBACK_STRATEGY")
+	viper.BindEnv("llm.concurrency", "MY_DAY_LLM_CONCURRENCY")
 	viper.BindEnv("llm.ollama.base_url", "MY_DAY_LLM_OLLAMA_BASE_URL")
 	viper.BindEnv("llm.ollama.model", "MY_DAY_LLM_OLLAMA_MODEL")
+	viper.BindEnv("llm.ollama.idle_stop_minutes", "MY_DAY_LLM_OLLAMA_IDLE_STOP_MINUTES")
+	viper.BindEnv("llm.openai.base_url", "MY_DAY_LLM_OPENAI_BASE_URL")
+	viper.BindEnv("llm.openai.api_key", "MY_DAY_LLM_OPENAI_API_KEY")
+	viper.BindEnv("llm.openai.model", "MY_DAY_LLM_OPENAI_MODEL")
+	viper.BindEnv("llm.openai.api_version", "MY_DAY_LLM_OPENAI_API_VERSION")
+	viper.BindEnv("llm.local_openai.base_url", "MY_DAY_LLM_LOCAL_OPENAI_BASE_URL")
+	viper.BindEnv("llm.local_openai.api_key", "MY_DAY_LLM_LOCAL_OPENAI_API_KEY")
+	viper.BindEnv("llm.local_openai.model", "MY_DAY_LLM_LOCAL_OPENAI_MODEL")
+	viper.BindEnv("llm.privacy.redact", "MY_DAY_LLM_PRIVACY_REDACT")
+	viper.BindEnv("llm.privacy.patterns", "MY_DAY_LLM_PRIVACY_PATTERNS")
+	viper.BindEnv("llm.privacy.allowed_projects", "MY_DAY_LLM_PRIVACY_ALLOWED_PROJECTS")
 	
 	// Report configuration
 	viper.BindEnv("report.format", "MY_DAY_REPORT_FORMAT")
 	viper.BindEnv("report.include_yesterday", "MY_DAY_REPORT_INCLUDE_YESTERDAY")
 	viper.BindEnv("report.include_today", "MY_DAY_REPORT_INCLUDE_TODAY")
 	viper.BindEnv("report.include_in_progress", "MY_DAY_REPORT_INCLUDE_IN_PROGRESS")
+	viper.BindEnv("report.max_comment_excerpt", "MY_DAY_REPORT_MAX_COMMENT_EXCERPT")
+	viper.BindEnv("report.variance_threshold", "MY_DAY_REPORT_VARIANCE_THRESHOLD")
+	viper.BindEnv("report.duplicate_similarity", "MY_DAY_REPORT_DUPLICATE_SIMILARITY")
+	viper.BindEnv("report.workday_hours", "MY_DAY_REPORT_WORKDAY_HOURS")
+	viper.BindEnv("report.risk.min_score", "MY_DAY_REPORT_RISK_MIN_SCORE")
+	viper.BindEnv("report.risk.weights.priority", "MY_DAY_REPORT_RISK_WEIGHTS_PRIORITY")
+	viper.BindEnv("report.risk.weights.days_in_status", "MY_DAY_REPORT_RISK_WEIGHTS_DAYS_IN_STATUS")
+	viper.BindEnv("report.risk.weights.blockers", "MY_DAY_REPORT_RISK_WEIGHTS_BLOCKERS")
+	viper.BindEnv("report.risk.weights.sentiment", "MY_DAY_REPORT_RISK_WEIGHTS_SENTIMENT")
+	viper.BindEnv("report.template_path", "MY_DAY_REPORT_TEMPLATE_PATH")
+	viper.BindEnv("report.themes", "MY_DAY_REPORT_THEMES")
+	viper.BindEnv("report.group_summaries", "MY_DAY_REPORT_GROUP_SUMMARIES")
+	viper.BindEnv("report.structured", "MY_DAY_REPORT_STRUCTURED")
+	viper.BindEnv("report.next_steps", "MY_DAY_REPORT_NEXT_STEPS")
+	viper.BindEnv("report.layout", "MY_DAY_REPORT_LAYOUT")
+	viper.BindEnv("report.sections.ai_summary", "MY_DAY_REPORT_SECTIONS_AI_SUMMARY")
+	viper.BindEnv("report.sections.summary", "MY_DAY_REPORT_SECTIONS_SUMMARY")
+	viper.BindEnv("report.sections.worklog", "MY_DAY_REPORT_SECTIONS_WORKLOG")
+	viper.BindEnv("report.sections.todo", "MY_DAY_REPORT_SECTIONS_TODO")
+	viper.BindEnv("report.sections.quality", "MY_DAY_REPORT_SECTIONS_QUALITY")
+	viper.BindEnv("report.sections.footer", "MY_DAY_REPORT_SECTIONS_FOOTER")
 	viper.BindEnv("report.export.enabled", "MY_DAY_REPORT_EXPORT_ENABLED")
 	viper.BindEnv("report.export.folder_path", "MY_DAY_REPORT_EXPORT_FOLDER_PATH")
 	viper.BindEnv("report.export.filename_date", "MY_DAY_REPORT_EXPORT_FILENAME_DATE")
 	viper.BindEnv("report.export.tags", "MY_DAY_REPORT_EXPORT_TAGS")
+	viper.BindEnv("report.export.target", "MY_DAY_REPORT_EXPORT_TARGET")
+	viper.BindEnv("report.export.confluence.base_url", "MY_DAY_REPORT_EXPORT_CONFLUENCE_BASE_URL")
+	viper.BindEnv("report.export.confluence.space_key", "MY_DAY_REPORT_EXPORT_CONFLUENCE_SPACE_KEY")
+	viper.BindEnv("report.export.confluence.parent_id", "MY_DAY_REPORT_EXPORT_CONFLUENCE_PARENT_ID")
+	viper.BindEnv("report.export.confluence.title_prefix", "MY_DAY_REPORT_EXPORT_CONFLUENCE_TITLE_PREFIX")
+	viper.BindEnv("report.export.confluence.mode", "MY_DAY_REPORT_EXPORT_CONFLUENCE_MODE")
+	viper.BindEnv("report.export.confluence.member", "MY_DAY_REPORT_EXPORT_CONFLUENCE_MEMBER")
+	viper.BindEnv("report.export.notion.database_id", "MY_DAY_REPORT_EXPORT_NOTION_DATABASE_ID")
+	viper.BindEnv("report.export.notion.token", "MY_DAY_REPORT_EXPORT_NOTION_TOKEN")
+	viper.BindEnv("report.export.daily_note.enabled", "MY_DAY_REPORT_EXPORT_DAILY_NOTE_ENABLED")
+	viper.BindEnv("report.export.daily_note.folder", "MY_DAY_REPORT_EXPORT_DAILY_NOTE_FOLDER")
+	viper.BindEnv("report.export.daily_note.format", "MY_DAY_REPORT_EXPORT_DAILY_NOTE_FORMAT")
+	viper.BindEnv("report.export.daily_note.heading", "MY_DAY_REPORT_EXPORT_DAILY_NOTE_HEADING")
+	viper.BindEnv("report.export.wiki_links", "MY_DAY_REPORT_EXPORT_WIKI_LINKS")
+	viper.BindEnv("report.export.issue_notes.enabled", "MY_DAY_REPORT_EXPORT_ISSUE_NOTES_ENABLED")
+	viper.BindEnv("report.export.issue_notes.folder", "MY_DAY_REPORT_EXPORT_ISSUE_NOTES_FOLDER")
+	viper.BindEnv("report.export.targets.obsidian", "MY_DAY_REPORT_EXPORT_TARGETS_OBSIDIAN")
+	viper.BindEnv("report.export.targets.confluence", "MY_DAY_REPORT_EXPORT_TARGETS_CONFLUENCE")
+	viper.BindEnv("report.export.targets.notion", "MY_DAY_REPORT_EXPORT_TARGETS_NOTION")
+	viper.BindEnv("report.export.targets.slack", "MY_DAY_REPORT_EXPORT_TARGETS_SLACK")
+	viper.BindEnv("report.export.targets.clipboard", "MY_DAY_REPORT_EXPORT_TARGETS_CLIPBOARD")
+	viper.BindEnv("report.email.smtp_host", "MY_DAY_REPORT_EMAIL_SMTP_HOST")
+	viper.BindEnv("report.email.smtp_port", "MY_DAY_REPORT_EMAIL_SMTP_PORT")
+	viper.BindEnv("report.email.username", "MY_DAY_REPORT_EMAIL_USERNAME")
+	viper.BindEnv("report.email.password", "MY_DAY_REPORT_EMAIL_PASSWORD")
+	viper.BindEnv("report.email.from", "MY_DAY_REPORT_EMAIL_FROM")
+	viper.BindEnv("report.email.to", "MY_DAY_REPORT_EMAIL_TO")
+
+	// Calendar configuration
+	viper.BindEnv("calendar.source", "MY_DAY_CALENDAR_SOURCE")
+	viper.BindEnv("calendar.email", "MY_DAY_CALENDAR_EMAIL")
+
+	// Sync state configuration
+	viper.BindEnv("sync_state.backend", "MY_DAY_SYNC_STATE_BACKEND")
+	viper.BindEnv("sync_state.passphrase", "MY_DAY_SYNC_STATE_PASSPHRASE")
+	viper.BindEnv("sync_state.password", "MY_DAY_SYNC_STATE_PASSWORD")
+	viper.BindEnv("sync_state.access_key_id", "MY_DAY_SYNC_STATE_ACCESS_KEY_ID", "AWS_ACCESS_KEY_ID")
+	viper.BindEnv("sync_state.secret_access_key", "MY_DAY_SYNC_STATE_SECRET_ACCESS_KEY", "AWS_SECRET_ACCESS_KEY")
+
+	// Slack configuration
+	viper.BindEnv("slack.webhook_url", "MY_DAY_SLACK_WEBHOOK_URL")
+	viper.BindEnv("slack.bot_token", "MY_DAY_SLACK_BOT_TOKEN")
+	viper.BindEnv("slack.channel", "MY_DAY_SLACK_CHANNEL")
+
+	// Handoff configuration
+	viper.BindEnv("handoff.webhook_url", "MY_DAY_HANDOFF_WEBHOOK_URL")
+	viper.BindEnv("handoff.channel", "MY_DAY_HANDOFF_CHANNEL")
+
+	// Text-to-speech configuration
+	viper.BindEnv("tts.engine", "MY_DAY_TTS_ENGINE")
+	viper.BindEnv("tts.voice", "MY_DAY_TTS_VOICE")
+	viper.BindEnv("tts.model", "MY_DAY_TTS_MODEL")
+
+	// Daemon configuration
+	viper.BindEnv("daemon.sync_interval", "MY_DAY_DAEMON_SYNC_INTERVAL")
+	viper.BindEnv("daemon.report_time", "MY_DAY_DAEMON_REPORT_TIME")
+	viper.BindEnv("daemon.weekdays_only", "MY_DAY_DAEMON_WEEKDAYS_ONLY")
+	viper.BindEnv("daemon.post_slack", "MY_DAY_DAEMON_POST_SLACK")
+
+	// Metrics configuration
+	viper.BindEnv("metrics.enabled", "MY_DAY_METRICS_ENABLED")
+	viper.BindEnv("metrics.addr", "MY_DAY_METRICS_ADDR")
 
 	// Set defaults
 	config.SetDefaults()
@@ -138,4 +307,30 @@ func initConfig() {
 			fmt.Fprintln(os.Stderr, "Using config file:", viper.ConfigFileUsed())
 		}
 	}
+
+	// Quiet runs print only their results, such as the report, and errors
+	logLevel := viper.GetString("log_level")
+	if viper.GetBool("quiet") {
+		color.Output = io.Discard
+		if logLevel == "" || logLevel == "auto" {
+			logLevel = "error"
+		}
+	}
+
+	if err := logging.Setup(logLevel, viper.GetString("log_file")); err != nil {
+		fmt.Fprintln(os.Stderr, "Warning:", err)
+	}
+	if viper.GetBool("verbose") || viper.GetBool("llm.debug") {
+		logging.Verbose()
+	}
+
+	if viper.GetBool("trace") {
+		trace.SetOutput(os.Stderr)
+		trace.Logf("run %s", trace.RunID())
+	}
+
+	// Keep the credentials and local store of a selected Jira profile apart
+	if profiles := config.ActiveProfiles(); len(profiles) == 1 {
+		useProfile(profiles[0])
+	}
 }
\ No newline at end of file
@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/jira"
+	"my-day/internal/metrics"
+	"my-day/internal/report"
+	"my-day/internal/webui"
+)
+
+// serveCmd represents the serve command
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve today's and past reports to a browser",
+	Long: `Serve runs a small web server rendering your reports as HTML pages, today's by default
+and any past day with ?date=YYYY-MM-DD or the links at the top of the page.
+
+The same reports are available as JSON for scripts and dashboards:
+
+  GET /api/report?date=2024-07-15
+
+Reports are generated from the local store that 'my-day sync' (or 'my-day daemon') fills,
+through the report cache, so opening a report again does not run the LLM again. The server
+listens on localhost only; use --addr :8080 to let team members on your network open it,
+keeping in mind the pages have no authentication.
+
+With --metrics (or metrics.enabled), Prometheus metrics are served at /metrics too: report
+generation time, LLM fallbacks and the requests sent to Jira and the LLM.`,
+	Example: `  my-day serve
+  my-day serve --addr :8080 --no-llm
+  my-day serve --metrics
+  curl http://localhost:8080/api/report?date=2024-07-15`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := serveReports(cmd); err != nil {
+			exitWithError("Serve failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+
+	// Serve flags
+	serveCmd.Flags().String("addr", "127.0.0.1:8080", "Address to listen on (use :8080 to serve your network)")
+	serveCmd.Flags().Duration("since", 7*24*time.Hour, "Include tickets and worklogs updated this long before each report's date")
+	serveCmd.Flags().Bool("no-llm", false, "Disable AI summaries")
+	serveCmd.Flags().Bool("metrics", false, "Serve Prometheus metrics at /metrics (default: metrics.enabled)")
+}
+
+func serveReports(cmd *cobra.Command) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	addr, _ := cmd.Flags().GetString("addr")
+	since, _ := cmd.Flags().GetDuration("since")
+	noLLM, _ := cmd.Flags().GetBool("no-llm")
+
+	var handler http.Handler = webui.NewHandler(&storeReports{since: since, noLLM: noLLM})
+	if metricsEnabled(cmd, cfg) {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+		mux.Handle("/", handler)
+		handler = mux
+	}
+	server := &http.Server{Addr: addr, Handler: handler}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	color.Green("✓ Serving reports on http://%s (JSON at /api/report?date=YYYY-MM-DD). Press Ctrl+C to stop.", displayAddr(addr))
+	if metricsEnabled(cmd, cfg) {
+		color.Cyan("📈 Prometheus metrics at http://%s/metrics", displayAddr(addr))
+	}
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// displayAddr returns a browsable form of a listen address, e.g. localhost:8080 for :8080
+func displayAddr(addr string) string {
+	if len(addr) > 0 && addr[0] == ':' {
+		return "localhost" + addr
+	}
+	return addr
+}
+
+// storeReports renders reports from the local store. The configuration and the store are read
+// again for every report, so the pages follow syncs made while serving.
+type storeReports struct {
+	since time.Duration
+	noLLM bool
+}
+
+// generate renders the report of a date in a format and returns it with the generator that
+// rendered it and the data it covers
+func (s *storeReports) generate(date time.Time, format string) (string, *report.Generator, *TicketCache, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+	cacheFile, err := getCacheFilePath()
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to get cache file path: %w", err)
+	}
+	cache, err := loadCache(cacheFile)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("no synced data, run 'my-day sync' first: %w", err)
+	}
+	applyStatusCategories(cache)
+
+	// Past reports look back from the end of their own day rather than from now
+	cache = filterCacheDataBySince(cache, date.AddDate(0, 0, 1).Add(-s.since), date)
+	applyTimeEntries(cache)
+
+	reportConfig := newReportConfig(cfg, cache, cfg.LLM.Enabled && !s.noLLM, loadMeetings(cfg, date))
+	reportConfig.Format = format
+	if format == "html" {
+		// Custom templates write text, the page keeps the built-in HTML layout
+		reportConfig.TemplatePath = ""
+	}
+	generator := report.NewGenerator(reportConfig)
+
+	var issuesWithComments []report.IssueWithComments
+	for _, iwc := range cache.IssuesWithComments {
+		issuesWithComments = append(issuesWithComments, report.IssueWithComments{Issue: iwc.Issue, Comments: iwc.Comments})
+	}
+	start := time.Now()
+	content, err := generator.GenerateWithCommentsAndCache(issuesWithComments, cache.Worklogs, date, true)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	observeReport(generator, start)
+	return content, generator, cache, nil
+}
+
+// HTML returns the HTML report of a date
+func (s *storeReports) HTML(date time.Time) (string, error) {
+	content, _, _, err := s.generate(date, "html")
+	return content, err
+}
+
+// Report returns the markdown report of a date with the issues it covers
+func (s *storeReports) Report(date time.Time) (*webui.Report, error) {
+	content, generator, cache, err := s.generate(date, "markdown")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &webui.Report{Date: date.Format("2006-01-02"), GeneratedAt: time.Now(), Markdown: content, Issues: []webui.Issue{}}
+	dayStart := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+	comments := make(map[string]int)
+	for _, iwc := range cache.IssuesWithComments {
+		for _, comment := range iwc.Comments {
+			if !comment.Created.Time.Before(dayStart) && comment.Created.Time.Before(dayEnd) {
+				comments[iwc.Issue.Key]++
+			}
+		}
+	}
+	var issues []jira.Issue
+	for _, iwc := range cache.IssuesWithComments {
+		issues = append(issues, iwc.Issue)
+	}
+	for _, issue := range generator.FilterIssues(issues, date) {
+		result.Issues = append(result.Issues, webui.Issue{
+			Key:      issue.Key,
+			Summary:  issue.Fields.Summary,
+			Status:   issue.Fields.Status.Name,
+			Comments: comments[issue.Key],
+		})
+	}
+	for _, worklog := range generator.FilterWorklogs(cache.Worklogs, date) {
+		result.TimeLogged += worklog.TimeSpentSeconds
+	}
+	for _, warning := range generator.Warnings() {
+		result.Notes = append(result.Notes, warning.String())
+	}
+	return result, nil
+}
@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+	"my-day/internal/store"
+)
+
+// statsCmd represents the stats command
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show trends over your stored history",
+	Long: `Stats charts your activity over the local store that 'my-day sync' fills:
+
+  - issues touched and comments written per day
+  - worklog hours per week, and their weekly average
+  - time spent in each status
+  - technologies mentioned in your comments, as detected by the pattern matcher
+
+Time in status comes from the status changes seen by syncs, so it covers the time since
+you started syncing and is as precise as your sync interval.`,
+	Example: `  my-day stats
+  my-day stats --days 90`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := showStats(cmd); err != nil {
+			exitWithError("Stats failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+
+	statsCmd.Flags().Int("days", 30, "Number of days to cover, ending today")
+	statsCmd.Flags().Int("top", 10, "Number of technologies to show")
+}
+
+// weekHours is the worklog time of a week
+type weekHours struct {
+	start time.Time // Monday
+	hours float64
+}
+
+func showStats(cmd *cobra.Command) error {
+	days, _ := cmd.Flags().GetInt("days")
+	if days < 1 {
+		return fmt.Errorf("--days must be at least 1")
+	}
+	top, _ := cmd.Flags().GetInt("top")
+
+	db, err := openHistory()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	now := time.Now()
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local).AddDate(0, 0, -(days - 1))
+	activity, err := db.DailyActivity(from, now)
+	if err != nil {
+		return err
+	}
+
+	color.Cyan("📊 Trends over the last %d days", days)
+	fmt.Println()
+
+	// Issues touched and comments per day
+	color.Cyan("📅 Issues touched and comments per day")
+	maxComments := 0
+	for _, day := range activity {
+		if day.Comments > maxComments {
+			maxComments = day.Comments
+		}
+	}
+	color.White("  %-12s %-4s %7s %9s", "Date", "", "Issues", "Comments")
+	for _, day := range activity {
+		weekday := ""
+		if date, err := time.ParseInLocation("2006-01-02", day.Date, time.Local); err == nil {
+			weekday = date.Weekday().String()[:3]
+		}
+		line := fmt.Sprintf("  %-12s %-4s %7d %9d  %s", day.Date, weekday, day.Issues, day.Comments, statsBar(day.Comments, maxComments, 30))
+		if day.Comments == 0 {
+			color.HiBlack(line)
+			continue
+		}
+		color.White(line)
+	}
+	fmt.Println()
+
+	// Worklog hours per week
+	color.Cyan("⏱️  Worklog hours per week")
+	weeks := weeklyHours(activity)
+	maxHours, totalHours := 0.0, 0.0
+	for _, week := range weeks {
+		totalHours += week.hours
+		if week.hours > maxHours {
+			maxHours = week.hours
+		}
+	}
+	for _, week := range weeks {
+		color.White("  Week of %-8s %6.1fh  %s", week.start.Format("Jan 2"), week.hours, statsBar(int(week.hours*10), int(maxHours*10), 30))
+	}
+	if len(weeks) > 0 {
+		color.White("  Average: %.1fh per week", totalHours/float64(len(weeks)))
+	}
+	fmt.Println()
+
+	// Time in status
+	color.Cyan("🔄 Time in status")
+	times, err := db.TimeInStatus(from, now)
+	if err != nil {
+		return err
+	}
+	if len(times) == 0 {
+		color.White("  No status changes recorded yet; they are recorded from your next syncs")
+	} else {
+		color.White("  %-20s %10s %7s %12s", "Status", "Total", "Issues", "Per issue")
+		for _, t := range times {
+			color.White("  %-20s %10s %7d %12s", truncateString(t.Status, 20), formatStatusTime(t.Total), t.Issues, formatStatusTime(t.Total/time.Duration(t.Issues)))
+		}
+	}
+	fmt.Println()
+
+	// Technologies mentioned
+	color.Cyan("🛠️  Technologies mentioned")
+	comments, err := db.Comments(from.Add(-time.Nanosecond))
+	if err != nil {
+		return err
+	}
+	technologies := technologyMentions(comments)
+	if len(technologies) == 0 {
+		color.White("  No technologies mentioned in your comments")
+	}
+	for i, tech := range technologies {
+		if top > 0 && i >= top {
+			break
+		}
+		color.White("  %-12s %5d  %s", tech.name, tech.comments, statsBar(tech.comments, technologies[0].comments, 30))
+	}
+
+	return nil
+}
+
+// weeklyHours sums the hours logged per week, weeks starting on Monday
+func weeklyHours(activity []store.DayActivity) []weekHours {
+	var weeks []weekHours
+	for _, day := range activity {
+		date, err := time.ParseInLocation("2006-01-02", day.Date, time.Local)
+		if err != nil {
+			continue
+		}
+		monday := date.AddDate(0, 0, -((int(date.Weekday()) + 6) % 7))
+		if len(weeks) == 0 || !weeks[len(weeks)-1].start.Equal(monday) {
+			weeks = append(weeks, weekHours{start: monday})
+		}
+		weeks[len(weeks)-1].hours += day.HoursLogged
+	}
+	return weeks
+}
+
+// technologyMention is a technology with the number of comments mentioning it
+type technologyMention struct {
+	name     string
+	comments int
+}
+
+// technologyMentions counts the comments mentioning each technology, most mentioned first
+func technologyMentions(comments map[string][]jira.Comment) []technologyMention {
+	matcher := llm.NewTechnicalPatternMatcher(false)
+	counts := make(map[string]int)
+	for _, issueComments := range comments {
+		for _, comment := range issueComments {
+			for _, technology := range matcher.MatchTechnologies(comment.Body.Text) {
+				counts[technology]++
+			}
+		}
+	}
+
+	var mentions []technologyMention
+	for name, count := range counts {
+		mentions = append(mentions, technologyMention{name: name, comments: count})
+	}
+	sort.Slice(mentions, func(i, j int) bool {
+		if mentions[i].comments != mentions[j].comments {
+			return mentions[i].comments > mentions[j].comments
+		}
+		return mentions[i].name < mentions[j].name
+	})
+	return mentions
+}
+
+// statsBar draws a bar of up to width blocks proportional to value
+func statsBar(value, max, width int) string {
+	if value <= 0 || max <= 0 {
+		return ""
+	}
+	blocks := value * width / max
+	if blocks == 0 {
+		blocks = 1
+	}
+	return strings.Repeat("▇", blocks)
+}
+
+// formatStatusTime formats a duration in days and hours, e.g. "2d 4h"
+func formatStatusTime(d time.Duration) string {
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	switch {
+	case days > 0 && hours > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case days > 0:
+		return fmt.Sprintf("%dd", days)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	}
+}
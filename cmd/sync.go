@@ -3,35 +3,67 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"my-day/internal/asana"
 	"my-day/internal/config"
 	"my-day/internal/github"
+	"my-day/internal/gitlab"
 	"my-day/internal/jira"
+	"my-day/internal/report"
+	"my-day/internal/store"
+	"my-day/internal/syncstate"
+	"my-day/internal/timetracking"
+	"my-day/internal/trello"
 )
 
+// incrementalSyncOverlap is how far before the previous sync an incremental sync starts,
+// to pick up changes made while that sync was running
+const incrementalSyncOverlap = time.Hour
+
+// lowBandwidthRecentSync is how long a sync is reused in low-bandwidth mode before fetching again
+const lowBandwidthRecentSync = time.Hour
+
 // syncCmd represents the sync command
 var syncCmd = &cobra.Command{
 	Use:   "sync",
-	Short: "Sync tickets from Jira and GitHub",
-	Long: `Sync pulls your latest tickets from Jira and GitHub activity and stores them locally.
+	Short: "Sync tickets from Jira, GitHub and GitLab",
+	Long: `Sync pulls your latest tickets from Jira and GitHub/GitLab activity and stores them locally.
 
 This command fetches tickets assigned to you or created by you from
 the configured project keys and GitHub repositories, then caches them for report generation.
 
+With tracker: github (or --tracker github), tickets come from the GitHub Issues assigned
+to you instead of Jira, with the status of their GitHub Projects board.
+
 GitHub integration includes:
 - Pull requests (authored, assigned, or reviewed)
 - Commits (authored by you)
-- Workflow runs and CI/CD status`,
+- Workflow runs and CI/CD status
+
+GitLab integration (gitlab.enabled with a personal access token) includes:
+- Merge requests (authored by you)
+- Commits (authored by you)
+- Pipelines (triggered by you)
+
+Trello (trello.enabled) and Asana (asana.enabled) are read-only: cards you created, moved
+or commented on and Asana tasks you completed, moved or commented on are reported as
+extra sections.
+
+With time_tracking.provider set to toggl or harvest, your time entries are imported too.
+Issue keys in entry descriptions link the time to tickets in the Work Log section.
+With tempo.enabled, worklogs are read from Tempo Timesheets instead of Jira.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := syncTickets(cmd); err != nil {
-			color.Red("Sync failed: %v", err)
-			os.Exit(1)
+			exitWithError("Sync failed", withExitCode(exitSync, err))
 		}
 	},
 }
@@ -50,143 +82,567 @@ type TicketCache struct {
 	Worklogs           []jira.WorklogEntry    `json:"worklogs"`
 	GitHubActivity     []github.Activity      `json:"github_activity"`
 	LastGitHubSync     time.Time              `json:"last_github_sync"`
+	GitLabActivity     []gitlab.Activity      `json:"gitlab_activity,omitempty"`
+	LastGitLabSync     time.Time              `json:"last_gitlab_sync,omitempty"`
+	TrelloActivity     []trello.Activity      `json:"trello_activity,omitempty"`
+	AsanaActivity      []asana.Activity       `json:"asana_activity,omitempty"`
+	TimeEntries        []timetracking.Entry   `json:"time_entries,omitempty"`
+	User               *jira.User             `json:"user,omitempty"`
+	StatusCategories   *jira.StatusCategoryMap `json:"status_categories,omitempty"`
+	BoardColumns       *jira.BoardColumnMap    `json:"board_columns,omitempty"`
+	Mentions           []jira.Mention          `json:"mentions,omitempty"` // Comments mentioning you on issues others own, over the --since window
+	Warnings           report.Warnings         `json:"warnings,omitempty"` // Problems found by the last sync, listed in reports
 }
 
 func init() {
 	rootCmd.AddCommand(syncCmd)
 	
 	// Sync-specific flags
-	syncCmd.Flags().Int("max-results", 100, "Maximum number of tickets to fetch")
+	syncCmd.Flags().Int("max-results", 0, "Maximum number of tickets to fetch across all result pages (default: jira.max_results)")
 	syncCmd.Flags().Bool("force", false, "Force sync even if recently synced")
+	syncCmd.Flags().Bool("full", false, "Refetch the whole --since window instead of only changes since the last sync")
 	syncCmd.Flags().Bool("worklog", true, "Include worklog entries")
 	syncCmd.Flags().Duration("since", 7*24*time.Hour, "Fetch tickets and worklogs updated since this duration ago")
 	syncCmd.Flags().Duration("comments-since", 24*time.Hour, "Look for your comments within this duration (defaults to --since value if not specified)")
-	syncCmd.Flags().StringSlice("platforms", []string{"jira", "github"}, "Platforms to sync (jira, github)")
+	syncCmd.Flags().StringSlice("platforms", []string{"jira", "github", "gitlab", "trello", "asana", "timetracking"}, "Platforms to sync (jira, github, gitlab, trello, asana, timetracking)")
 	syncCmd.Flags().Bool("github", true, "Include GitHub activity (if connected and enabled)")
+	syncCmd.Flags().String("jql", "", "Custom JQL query selecting the Jira issues to sync instead of the configured projects")
+	syncCmd.Flags().Bool("all-comments", false, "Sync everyone's comments on the issues, not only yours (default: jira.all_comments)")
 }
 
 func syncTickets(cmd *cobra.Command) error {
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load configuration: %w", err)
-	}
+	platforms, _ := cmd.Flags().GetStringSlice("platforms")
 
-	// Validate configuration
-	if cfg.Jira.BaseURL == "" {
-		return fmt.Errorf("Jira base URL not configured. Run 'my-day init' first")
+	profiles := config.ActiveProfiles()
+	if len(profiles) <= 1 {
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load configuration: %w", err)
+		}
+		return syncProfile(cmd, cfg, platforms)
 	}
 
-	// Create temporary auth manager to check authentication
-	authManager := jira.NewAuthManager("", "")
-	if !authManager.IsAuthenticated() {
-		return fmt.Errorf("not authenticated with Jira. Run 'my-day auth --email your-email --token your-token' first")
-	}
+	// Sync each Jira profile into its own store. The other platforms are not tied to a
+	// Jira instance, so they are synced with the first profile only.
+	for i, name := range profiles {
+		useProfile(name)
+		cfg, err := config.LoadProfile(name)
+		if err != nil {
+			return fmt.Errorf("failed to load configuration: %w", err)
+		}
+		if i > 0 {
+			platforms = []string{"jira"}
+		}
 
-	// Load API token and create client
-	apiToken, err := authManager.LoadAPIToken()
-	if err != nil {
-		return fmt.Errorf("failed to load API token: %w", err)
+		color.Cyan("🔀 Syncing Jira profile %s (%s)...", name, cfg.Jira.BaseURL)
+		if err := syncProfile(cmd, cfg, platforms); err != nil {
+			return fmt.Errorf("failed to sync profile %s: %w", name, err)
+		}
 	}
+	return nil
+}
 
-	client := jira.NewClient(cfg.Jira.BaseURL, apiToken.Email, apiToken.Token)
-	ctx := context.Background()
-
+// syncProfile syncs the configured issue tracker and the given platforms into the local store
+func syncProfile(cmd *cobra.Command, cfg *config.Config, platforms []string) error {
 	// Get cache file path
 	cacheFile, err := getCacheFilePath()
 	if err != nil {
 		return fmt.Errorf("failed to get cache file path: %w", err)
 	}
 
-	// Check if we need to sync
+	// Check if we need to sync. Low-bandwidth mode keeps using the cache for longer.
 	force, _ := cmd.Flags().GetBool("force")
-	if !force {
-		if cache, err := loadCache(cacheFile); err == nil {
-			if time.Since(cache.LastSync) < 10*time.Minute {
-				color.Yellow("Recently synced (%v ago). Use --force to sync anyway.", 
-					time.Since(cache.LastSync).Round(time.Minute))
-				return nil
+	previous, _ := loadCache(cacheFile)
+	if !force && previous != nil {
+		recentSync := 10 * time.Minute
+		if cfg.Jira.LowBandwidth {
+			recentSync = lowBandwidthRecentSync
+		}
+		if time.Since(previous.LastSync) < recentSync {
+			color.Yellow("Recently synced (%v ago). Use --force to sync anyway.", 
+				time.Since(previous.LastSync).Round(time.Minute))
+			return nil
+		}
+	}
+
+	ctx := context.Background()
+
+	// Fetch tickets from the configured issue tracker
+	var tickets *trackerTickets
+	switch cfg.Tracker {
+	case "github":
+		tickets, err = fetchGitHubTickets(ctx, cmd, cfg, previous)
+	case "", "jira":
+		tickets, err = fetchJiraTickets(ctx, cmd, cfg, previous)
+	default:
+		return fmt.Errorf("unknown tracker %q (use jira or github)", cfg.Tracker)
+	}
+	if errors.Is(err, jira.ErrDegraded) {
+		return keepPreviousSync(cacheFile, previous, err)
+	}
+	if err != nil {
+		return err
+	}
+	if tickets == nil {
+		// Nothing to sync, e.g. no Jira projects configured
+		return nil
+	}
+	issuesWithComments := tickets.IssuesWithComments
+	since, _ := cmd.Flags().GetDuration("since")
+	warnings := tickets.Warnings
+
+	// Extract only the issues that have comments from the current user
+	var filteredIssues []jira.Issue
+	for _, iwc := range issuesWithComments {
+		filteredIssues = append(filteredIssues, iwc.Issue)
+	}
+
+	// Fetch GitHub activity if enabled
+	var githubActivity []github.Activity
+	githubSyncTime := time.Now()
+	includeGitHub, _ := cmd.Flags().GetBool("github")
+	
+	if includeGitHub && containsString(platforms, "github") && cfg.GitHub.Enabled {
+		color.Cyan("🐙 Syncing GitHub activity...")
+		
+		githubAuthManager := github.NewAuthManager("")
+		if githubAuthManager.IsAuthenticated() {
+			authInfo, err := githubAuthManager.LoadToken()
+			if err == nil {
+				githubClient := github.NewClient(authInfo.Token)
+				
+				// Fetch GitHub activity since the specified time
+				githubSinceTime := time.Now().Add(-since)
+				activity, err := githubClient.GetUserActivity(ctx, githubSinceTime, cfg.GitHub.Repositories)
+				if err != nil {
+					warnings.Add(report.SeverityWarning, "GitHub", "Failed to fetch GitHub activity: %v", err)
+					githubActivity = []github.Activity{} // Continue without GitHub
+				} else {
+					githubActivity = filterGitHubActivity(activity, cfg.GitHub)
+					color.Green("✓ Fetched %d GitHub activities", len(githubActivity))
+				}
+			} else {
+				warnings.Add(report.SeverityWarning, "GitHub", "GitHub authentication failed: %v", err)
+			}
+		} else {
+			warnings.Add(report.SeverityWarning, "GitHub", "GitHub not authenticated. Run 'my-day github connect' to include GitHub activity")
+		}
+	} else {
+		color.White("GitHub sync disabled or not configured")
+	}
+
+	// Fetch GitLab activity if enabled
+	var gitlabActivity []gitlab.Activity
+	gitlabSyncTime := time.Now()
+	if containsString(platforms, "gitlab") && cfg.GitLab.Enabled {
+		color.Cyan("🦊 Syncing GitLab activity...")
+
+		if cfg.GitLab.Token == "" {
+			warnings.Add(report.SeverityWarning, "GitLab", "GitLab token not configured. Set gitlab.token or MY_DAY_GITLAB_TOKEN to include GitLab activity")
+		} else {
+			gitlabClient := gitlab.NewClient(cfg.GitLab.BaseURL, cfg.GitLab.Token)
+			activity, err := gitlabClient.GetUserActivity(ctx, time.Now().Add(-since), cfg.GitLab.Projects)
+			if err != nil {
+				warnings.Add(report.SeverityWarning, "GitLab", "Failed to fetch GitLab activity: %v", err)
+			} else {
+				gitlabActivity = filterGitLabActivity(activity, cfg.GitLab)
+				color.Green("✓ Fetched %d GitLab activities", len(gitlabActivity))
 			}
 		}
 	}
 
+	// Fetch Trello card activity if enabled
+	var trelloActivity []trello.Activity
+	if containsString(platforms, "trello") && cfg.Trello.Enabled {
+		color.Cyan("📌 Syncing Trello activity...")
+
+		if cfg.Trello.APIKey == "" || cfg.Trello.Token == "" {
+			warnings.Add(report.SeverityWarning, "Trello", "Trello API key or token not configured. Set trello.api_key and trello.token (or MY_DAY_TRELLO_API_KEY and MY_DAY_TRELLO_TOKEN)")
+		} else {
+			trelloClient := trello.NewClient(cfg.Trello.APIKey, cfg.Trello.Token)
+			activity, err := trelloClient.GetUserActivity(ctx, time.Now().Add(-since), cfg.Trello.Boards)
+			if err != nil {
+				warnings.Add(report.SeverityWarning, "Trello", "Failed to fetch Trello activity: %v", err)
+			} else {
+				trelloActivity = activity
+				color.Green("✓ Fetched %d Trello activities", len(trelloActivity))
+			}
+		}
+	}
+
+	// Fetch Asana task activity if enabled
+	var asanaActivity []asana.Activity
+	if containsString(platforms, "asana") && cfg.Asana.Enabled {
+		color.Cyan("🎯 Syncing Asana activity...")
+
+		if cfg.Asana.Token == "" {
+			warnings.Add(report.SeverityWarning, "Asana", "Asana token not configured. Set asana.token or MY_DAY_ASANA_TOKEN to include Asana activity")
+		} else {
+			asanaClient := asana.NewClient(cfg.Asana.Token)
+			activity, err := asanaClient.GetUserActivity(ctx, time.Now().Add(-since), cfg.Asana.Workspace, cfg.Asana.Projects)
+			if err != nil {
+				warnings.Add(report.SeverityWarning, "Asana", "Failed to fetch Asana activity: %v", err)
+			} else {
+				asanaActivity = activity
+				color.Green("✓ Fetched %d Asana activities", len(asanaActivity))
+			}
+		}
+	}
+
+	// Fetch Toggl or Harvest time entries if configured
+	var timeEntries []timetracking.Entry
+	if containsString(platforms, "timetracking") && cfg.TimeTracking.Provider != "" {
+		color.Cyan("⏱️  Syncing %s time entries...", cfg.TimeTracking.Provider)
+
+		provider, err := timetracking.NewProvider(cfg.TimeTracking.Provider, cfg.TimeTracking.Token, cfg.TimeTracking.AccountID)
+		if err != nil {
+			warnings.Add(report.SeverityWarning, "Time tracking", "%v", err)
+		} else {
+			entries, err := provider.GetTimeEntries(ctx, time.Now().Add(-since))
+			if err != nil {
+				warnings.Add(report.SeverityWarning, "Time tracking", "Failed to fetch %s time entries: %v", cfg.TimeTracking.Provider, err)
+			} else {
+				timeEntries = entries
+				color.Green("✓ Fetched %d time entries", len(timeEntries))
+			}
+		}
+	}
+
+	// Create cache
+	cache := TicketCache{
+		LastSync:           time.Now(),
+		Issues:             filteredIssues,
+		IssuesWithComments: issuesWithComments,
+		Worklogs:           tickets.Worklogs,
+		GitHubActivity:     githubActivity,
+		LastGitHubSync:     githubSyncTime,
+		GitLabActivity:     gitlabActivity,
+		LastGitLabSync:     gitlabSyncTime,
+		TrelloActivity:     trelloActivity,
+		AsanaActivity:      asanaActivity,
+		TimeEntries:        timeEntries,
+		User:               tickets.User,
+		StatusCategories:   tickets.StatusCategories,
+		BoardColumns:       tickets.BoardColumns,
+		Mentions:           tickets.Mentions,
+	}
+
+	if unresolved := applyStatusCategories(&cache); len(unresolved) > 0 {
+		warnings.Add(report.SeverityInfo, "Sync", "Unknown status category for %s; these issues are reported as To Do", strings.Join(unresolved, ", "))
+	}
+	cache.Warnings = warnings
+
+	// Merge into the local store
+	if err := saveCache(cacheFile, &cache); err != nil {
+		return fmt.Errorf("failed to save cache: %w", err)
+	}
+
+	color.Green("✓ Sync completed successfully")
+	color.White("Issues: %d", len(cache.Issues))
+	color.White("Worklog entries: %d", len(cache.Worklogs))
+	color.White("GitHub activities: %d", len(cache.GitHubActivity))
+	if cfg.GitLab.Enabled {
+		color.White("GitLab activities: %d", len(cache.GitLabActivity))
+	}
+	if cfg.Trello.Enabled {
+		color.White("Trello activities: %d", len(cache.TrelloActivity))
+	}
+	if cfg.Asana.Enabled {
+		color.White("Asana activities: %d", len(cache.AsanaActivity))
+	}
+	if cfg.TimeTracking.Provider != "" {
+		color.White("Time entries: %d", len(cache.TimeEntries))
+	}
+	color.White("Saved to local store: %s", cacheFile)
+	showSyncWarnings(cache.Warnings)
+
+	// Show summary of recent activity
+	showSyncSummary(&cache)
+
+	return nil
+}
+
+// fetchJiraTickets fetches the issues you commented on, your worklog and the status and
+// board metadata from Jira. It returns nil when there is nothing to sync.
+func fetchJiraTickets(ctx context.Context, cmd *cobra.Command, cfg *config.Config, previous *TicketCache) (*trackerTickets, error) {
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if allComments, _ := cmd.Flags().GetBool("all-comments"); allComments {
+		client.SetCommentAuthors(cfg.Jira.CommentAuthors, true)
+	}
+
 	color.Cyan("🔄 Syncing tickets from Jira...")
+	if cfg.Jira.LowBandwidth {
+		color.White("Low-bandwidth mode: fetching minimal fields and reusing cached metadata")
+	}
 
-	maxResults, _ := cmd.Flags().GetInt("max-results")
+	maxResults := cfg.Jira.MaxResults
+	if cmd.Flags().Changed("max-results") {
+		maxResults, _ = cmd.Flags().GetInt("max-results")
+	}
 	
-	// Get project keys directly from configuration (already a slice of strings)
+	// A custom JQL query replaces the project filter, so projects are only required without one
+	jql, _ := cmd.Flags().GetString("jql")
 	projectKeys := cfg.Jira.Projects
 
-	if len(projectKeys) == 0 {
+	if jql == "" && len(projectKeys) == 0 {
 		color.Yellow("No project keys configured. Add projects to your config file.")
-		return nil
+		return nil, nil
 	}
 
-	color.White("Fetching tickets from projects: %v", projectKeys)
-
 	// Fetch issues with recent updates (using --since flag)
-	since, _ := cmd.Flags().GetDuration("since")
-	ticketsSinceTime := time.Now().Add(-since)
-	
-	color.White("Searching for tickets updated since %s...", ticketsSinceTime.Format("2006-01-02"))
-	searchResponse, err := client.GetMyIssuesWithTodaysComments(ctx, projectKeys, maxResults, ticketsSinceTime)
-	if err != nil {
-		return fmt.Errorf("failed to fetch issues: %w", err)
-	}
-
-	color.Green("✓ Found %d updated issues to check for your comments", len(searchResponse.Issues))
+	jiraSince := syncWindow(cmd, previous)
 
 	// Fetch comments for each issue (using --comments-since flag)
 	commentsSince, _ := cmd.Flags().GetDuration("comments-since")
 	
 	// If comments-since wasn't explicitly set, use the same duration as --since
 	if !cmd.Flags().Changed("comments-since") {
-		commentsSince = since
+		commentsSince = jiraSince
 	}
+
+	includeWorklog, _ := cmd.Flags().GetBool("worklog")
+	verbose, _ := cmd.Flags().GetBool("verbose")
+
+	tempo, err := newTempoClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	tickets, err := fetchJiraIssues(ctx, client, jiraQuery{
+		JQL:           jql,
+		Projects:      projectKeys,
+		Since:         jiraSince,
+		CommentsSince: commentsSince,
+		MaxResults:    maxResults,
+		Worklog:       includeWorklog,
+		Changelog:     !cfg.Jira.LowBandwidth,
+		Tempo:         tempo,
+		Verbose:       verbose,
+	})
+	if err != nil {
+		if verbose {
+			showRateLimitStats(client.RateLimitStats())
+		}
+		return nil, err
+	}
+
+	// Resolve custom statuses to their real category, falling back to the previously cached mapping.
+	// Status and board metadata rarely change, so low-bandwidth mode reuses the cached copies.
+	var statusCategories *jira.StatusCategoryMap
+	if cfg.Jira.LowBandwidth && previous != nil && previous.StatusCategories != nil {
+		statusCategories = previous.StatusCategories
+	} else if statuses, err := client.GetStatuses(ctx); err == nil {
+		statusCategories = jira.NewStatusCategoryMap(statuses)
+	} else {
+		tickets.Warnings.Add(report.SeverityWarning, "Jira", "Failed to fetch status metadata, using the previously synced statuses: %v", err)
+		if previous != nil {
+			statusCategories = previous.StatusCategories
+		}
+	}
+
+	// Fetch the board column layout for --group-by column, falling back to the previously cached layout
+	var boardColumns *jira.BoardColumnMap
+	if cfg.Jira.BoardID != 0 {
+		if cfg.Jira.LowBandwidth && previous != nil && previous.BoardColumns != nil {
+			boardColumns = previous.BoardColumns
+		} else if columns, err := client.GetBoardColumns(ctx, cfg.Jira.BoardID); err == nil {
+			boardColumns = columns
+			color.Green("✓ Fetched %d columns from board %s", len(columns.Columns), columns.BoardName)
+		} else {
+			tickets.Warnings.Add(report.SeverityWarning, "Jira", "Failed to fetch board columns, using the previously synced layout: %v", err)
+			if previous != nil {
+				boardColumns = previous.BoardColumns
+			}
+		}
+	}
+
+	// Fetch the comments mentioning you on issues others own over the whole --since window, as
+	// they are replaced on every sync. Low-bandwidth mode keeps the previously synced ones.
+	var mentions []jira.Mention
+	if cfg.Jira.LowBandwidth && previous != nil {
+		mentions = previous.Mentions
+	} else {
+		mentionsSince, _ := cmd.Flags().GetDuration("since")
+		if fetched, err := client.GetMentions(ctx, projectKeys, time.Now().Add(-mentionsSince)); err == nil {
+			mentions = fetched
+			if len(mentions) > 0 {
+				color.Green("✓ Found %d comments mentioning you", len(mentions))
+			}
+		} else {
+			tickets.Warnings.Add(report.SeverityWarning, "Jira", "Failed to fetch the comments mentioning you: %v", err)
+			if previous != nil {
+				mentions = previous.Mentions
+			}
+		}
+	}
+
+	if verbose {
+		showRateLimitStats(client.RateLimitStats())
+	}
+
+	tickets.Mentions = mentions
+	tickets.StatusCategories = statusCategories
+	tickets.BoardColumns = boardColumns
+	return tickets, nil
+}
+
+// newTempoClient returns the Tempo client worklogs are read from, or nil when Tempo is disabled
+func newTempoClient(cfg *config.Config) (*timetracking.TempoClient, error) {
+	if !cfg.Tempo.Enabled {
+		return nil, nil
+	}
+	if cfg.Tempo.Token == "" {
+		return nil, fmt.Errorf("Tempo token not configured. Set tempo.token or MY_DAY_TEMPO_TOKEN")
+	}
+	return timetracking.NewTempoClientWithURL(cfg.Tempo.BaseURL, cfg.Tempo.Token), nil
+}
+
+// newJiraClient creates a Jira client from the configuration and the saved API token or OAuth login
+func newJiraClient(cfg *config.Config) (*jira.Client, error) {
+	// Validate configuration
+	if cfg.Jira.BaseURL == "" {
+		return nil, fmt.Errorf("Jira base URL not configured. Run 'my-day init' first")
+	}
+
+	// Create temporary auth manager to check authentication
+	authManager := jira.NewAuthManager("", "")
+	if !authManager.IsAuthenticated() {
+		return nil, withExitCode(exitAuth, fmt.Errorf("not authenticated with Jira. Run 'my-day auth --email your-email --token your-token' or 'my-day auth login' first"))
+	}
+
+	var client *jira.Client
+	if authManager.HasOAuth() {
+		client = jira.NewOAuthClient(cfg.Jira.BaseURL, jiraOAuthConfig(cfg))
+	} else {
+		// Load API token and create client
+		apiToken, err := authManager.LoadAPIToken()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load API token: %w", err)
+		}
+		client = jira.NewClient(cfg.Jira.BaseURL, apiToken.Email, apiToken.Token)
+	}
+	client.SetDeployment(cfg.Jira.Deployment)
+	if cfg.Jira.LowBandwidth {
+		client.SetLowBandwidth(cfg.Jira.MaxCommentLength)
+	}
+	client.SetCommentAuthors(cfg.Jira.CommentAuthors, cfg.Jira.AllComments)
+	var fieldIDs []string
+	for _, fieldID := range customFieldIDs(cfg) {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	sort.Strings(fieldIDs)
+	client.SetCustomFields(fieldIDs)
+	return client, nil
+}
+
+// customFieldIDs returns the Jira field IDs of the names in jira.custom_fields
+func customFieldIDs(cfg *config.Config) map[string]string {
+	fieldIDs := make(map[string]string, len(cfg.Jira.CustomFields))
+	for name, field := range cfg.Jira.CustomFields {
+		if field.FieldID != "" {
+			fieldIDs[name] = field.FieldID
+		}
+	}
+	return fieldIDs
+}
+
+// jiraQuery selects the Jira issues and worklogs to fetch
+type jiraQuery struct {
+	JQL           string                    // Custom JQL query replacing the project and update filter
+	Projects      []string                  // Projects searched without a custom query
+	Since         time.Duration             // How far back to look for updated issues and worklogs
+	CommentsSince time.Duration             // How far back to look for your comments
+	MaxResults    int                       // Maximum number of issues to fetch (0 for no limit)
+	Worklog       bool                      // Whether to fetch your worklog
+	Changelog     bool                      // Whether to read your status changes from the issue changelogs
+	Tempo         *timetracking.TempoClient // Reads the worklog from Tempo instead of Jira when set
+	Verbose       bool
+}
+
+// fetchJiraIssues searches Jira for the query's issues and keeps the ones with your recent
+// comments, along with your worklog. With a custom JQL query, worklogs are limited to the
+// issues the query matched.
+func fetchJiraIssues(ctx context.Context, client *jira.Client, query jiraQuery) (*trackerTickets, error) {
+	ticketsSinceTime := time.Now().Add(-query.Since)
+
+	var searchResponse *jira.SearchResponse
+	var err error
+	if query.JQL != "" {
+		color.White("Searching for tickets matching: %s", query.JQL)
+		searchResponse, err = client.SearchIssues(ctx, query.JQL, query.MaxResults)
+	} else {
+		color.White("Fetching tickets from projects: %v", query.Projects)
+		color.White("Searching for tickets updated since %s...", ticketsSinceTime.Format("2006-01-02"))
+		searchResponse, err = client.GetMyIssuesWithTodaysComments(ctx, query.Projects, query.MaxResults, ticketsSinceTime)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch issues: %w", err)
+	}
+
+	var warnings report.Warnings
+	color.Green("✓ Found %d updated issues to check for your comments", len(searchResponse.Issues))
+	if searchResponse.Total > len(searchResponse.Issues) {
+		warnings.Add(report.SeverityWarning, "Jira", "Only the %d most recently updated of %d issues were fetched. Raise jira.max_results or use --max-results to fetch more", len(searchResponse.Issues), searchResponse.Total)
+	}
+
+	commentsSinceTime := time.Now().Add(-query.CommentsSince)
 	
-	commentsSinceTime := time.Now().Add(-commentsSince)
-	
-	color.White("Fetching your comments from the last %v...", commentsSince)
+	color.White("Fetching your comments from the last %v...", query.CommentsSince)
 	var issuesWithComments []IssueWithComments
 	
 	// Get current user info for comment filtering
 	userInfo, err := client.GetCurrentUser(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get current user: %w", err)
+		return nil, fmt.Errorf("failed to get current user: %w", err)
 	}
 	
-	if verbose, _ := cmd.Flags().GetBool("verbose"); verbose {
+	if query.Verbose {
 		color.White("Looking for comments by user: %s (ID: %s)", userInfo.DisplayName, userInfo.AccountID)
 		color.White("Filtering for comments after: %s", commentsSinceTime.Format("2006-01-02 15:04:05"))
 	}
 	
+	var restricted []string
 	for _, issue := range searchResponse.Issues {
-		allComments, err := client.GetIssueComments(ctx, issue.Key)
+		// The client keeps only the comments since then by you, or the configured authors
+		todaysComments, err := client.GetMyComments(ctx, issue.Key, commentsSinceTime)
+		if errors.Is(err, jira.ErrRestricted) {
+			restricted = append(restricted, issue.Key)
+			continue
+		}
+		if errors.Is(err, jira.ErrDegraded) {
+			return nil, err
+		}
 		if err != nil {
-			color.Yellow("Warning: Failed to fetch comments for %s: %v", issue.Key, err)
-			allComments = []jira.Comment{} // Continue without comments for this issue
+			warnings.Add(report.SeverityWarning, "Jira", "Failed to fetch comments for %s: %v", issue.Key, err)
+			todaysComments = nil // Continue without comments for this issue
 		}
-		
-		// Filter comments to only include today's comments by the current user
-		var todaysComments []jira.Comment
-		for _, comment := range allComments {
-			if verbose, _ := cmd.Flags().GetBool("verbose"); verbose && len(allComments) > 0 {
-				color.White("  Comment by %s (%s) at %s", 
-					comment.Author.DisplayName, 
+		if query.Verbose {
+			for _, comment := range todaysComments {
+				color.White("  %s: comment by %s (%s) at %s", issue.Key,
+					comment.Author.DisplayName,
 					comment.Author.AccountID,
 					comment.Created.Time.Format("2006-01-02 15:04:05"))
 			}
-			
-			if comment.Author.AccountID == userInfo.AccountID && 
-			   comment.Created.Time.After(commentsSinceTime) {
-				todaysComments = append(todaysComments, comment)
-				if verbose, _ := cmd.Flags().GetBool("verbose"); verbose {
-					color.Green("    ✓ This comment matches!")
-				}
+		}
+
+		// Status moves don't leave a comment, so they are read from the changelog of issues updated since
+		if query.Changelog && issue.Fields.Updated.Time.After(commentsSinceTime) {
+			transitions, err := client.GetMyTransitions(ctx, issue.Key, commentsSinceTime)
+			if errors.Is(err, jira.ErrDegraded) {
+				return nil, err
+			}
+			if err != nil && !errors.Is(err, jira.ErrRestricted) {
+				warnings.Add(report.SeverityWarning, "Jira", "Failed to fetch the changelog of %s: %v", issue.Key, err)
 			}
+			issue.Transitions = transitions
 		}
 		
-		// Only include issues that have comments from the current user today
-		if len(todaysComments) > 0 {
+		// Only include issues that have comments or status changes from the current user today
+		if len(todaysComments) > 0 || len(issue.Transitions) > 0 {
 			issuesWithComments = append(issuesWithComments, IssueWithComments{
 				Issue:    issue,
 				Comments: todaysComments,
@@ -194,113 +650,357 @@ func syncTickets(cmd *cobra.Command) error {
 		}
 	}
 	
+	if len(restricted) > 0 {
+		warnings.Add(report.SeverityInfo, "Jira", "Skipped %d restricted issues you can no longer view: %s", len(restricted), strings.Join(restricted, ", "))
+	}
+
 	if len(issuesWithComments) == 0 {
-		color.Yellow("✓ No issues found with your comments in the last %v", commentsSince)
+		color.Yellow("✓ No issues found with your comments in the last %v", query.CommentsSince)
 		color.White("  Try adding a comment to a Jira ticket or use --comments-since to look further back.")
 		color.White("  Example: my-day sync --comments-since 72h")
 	} else {
-		color.Green("✓ Found %d issues with your comments in the last %v", len(issuesWithComments), commentsSince)
+		color.Green("✓ Found %d issues with your comments in the last %v", len(issuesWithComments), query.CommentsSince)
 	}
 
 	// Fetch worklog if enabled
 	var worklogs []jira.WorklogEntry
-	if includeWorklog, _ := cmd.Flags().GetBool("worklog"); includeWorklog {
-		worklogSinceTime := time.Now().Add(-since)
-		
-		color.White("Fetching worklog entries since %s...", worklogSinceTime.Format("2006-01-02"))
+	if query.Worklog {
+		color.White("Fetching worklog entries since %s...", ticketsSinceTime.Format("2006-01-02"))
 		
-		worklogs, err = client.GetMyWorklog(ctx, worklogSinceTime)
+		if query.Tempo != nil {
+			worklogs, err = query.Tempo.GetWorklogs(ctx, userInfo.AccountID, ticketsSinceTime)
+		} else {
+			worklogs, err = client.GetMyWorklog(ctx, ticketsSinceTime)
+		}
+		if errors.Is(err, jira.ErrDegraded) {
+			return nil, err
+		}
 		if err != nil {
-			color.Yellow("Warning: Failed to fetch worklog: %v", err)
+			source := "Jira"
+			if query.Tempo != nil {
+				source = "Tempo"
+			}
+			warnings.Add(report.SeverityWarning, source, "Failed to fetch worklog: %v", err)
 			worklogs = []jira.WorklogEntry{} // Continue without worklog
 		} else {
+			if query.JQL != "" {
+				worklogs = worklogsForIssues(worklogs, searchResponse.Issues)
+			}
 			color.Green("✓ Fetched %d worklog entries", len(worklogs))
 		}
 	}
 
-	// Extract only the issues that have comments from the current user
-	var filteredIssues []jira.Issue
-	for _, iwc := range issuesWithComments {
-		filteredIssues = append(filteredIssues, iwc.Issue)
+	return &trackerTickets{
+		IssuesWithComments: issuesWithComments,
+		Worklogs:           worklogs,
+		User:               userInfo,
+		Warnings:           warnings,
+	}, nil
+}
+
+// worklogsForIssues returns the worklogs logged on the given issues
+func worklogsForIssues(worklogs []jira.WorklogEntry, issues []jira.Issue) []jira.WorklogEntry {
+	issueIDs := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		issueIDs[issue.ID] = true
 	}
 
-	// Fetch GitHub activity if enabled
-	var githubActivity []github.Activity
-	githubSyncTime := time.Now()
-	includeGitHub, _ := cmd.Flags().GetBool("github")
-	platforms, _ := cmd.Flags().GetStringSlice("platforms")
-	
-	if includeGitHub && containsString(platforms, "github") && cfg.GitHub.Enabled {
-		color.Cyan("🐙 Syncing GitHub activity...")
-		
-		githubAuthManager := github.NewAuthManager("")
-		if githubAuthManager.IsAuthenticated() {
-			authInfo, err := githubAuthManager.LoadToken()
-			if err == nil {
-				githubClient := github.NewClient(authInfo.Token)
-				
-				// Fetch GitHub activity since the specified time
-				githubSinceTime := time.Now().Add(-since)
-				activity, err := githubClient.GetUserActivity(ctx, githubSinceTime, cfg.GitHub.Repositories)
-				if err != nil {
-					color.Yellow("Warning: Failed to fetch GitHub activity: %v", err)
-					githubActivity = []github.Activity{} // Continue without GitHub
-				} else {
-					githubActivity = activity
-					color.Green("✓ Fetched %d GitHub activities", len(githubActivity))
-				}
-			} else {
-				color.Yellow("Warning: GitHub authentication failed: %v", err)
+	filtered := []jira.WorklogEntry{}
+	for _, worklog := range worklogs {
+		if issueIDs[worklog.IssueID] {
+			filtered = append(filtered, worklog)
+		}
+	}
+	return filtered
+}
+
+// showSyncWarnings lists the problems found during the sync. They are kept with the synced
+// data and listed again in the notes of the next reports.
+func showSyncWarnings(warnings report.Warnings) {
+	if len(warnings) == 0 {
+		return
+	}
+	color.Yellow("⚠️  Notes about this sync (also listed in your reports):")
+	for _, warning := range warnings {
+		color.Yellow("  %s", warning)
+	}
+}
+
+// degradedSyncNote starts the note added to the previously synced data when Jira kept failing
+const degradedSyncNote = "Jira kept failing, so the last sync stopped early"
+
+// keepPreviousSync keeps the previously synced data when Jira kept failing during the sync, so
+// reports are generated from it with a note saying so. The note replaces the one of an earlier
+// failed sync. The sync fails when nothing was synced before.
+func keepPreviousSync(cacheFile string, previous *TicketCache, err error) error {
+	if previous == nil {
+		return err
+	}
+	warnings := previous.Warnings[:0]
+	for _, warning := range previous.Warnings {
+		if !strings.HasPrefix(warning.Message, degradedSyncNote) {
+			warnings = append(warnings, warning)
+		}
+	}
+	previous.Warnings = warnings
+	previous.Warnings.Add(report.SeverityError, "Jira", "%s; this report uses the data synced at %s",
+		degradedSyncNote, previous.LastSync.Local().Format("2006-01-02 15:04"))
+	if err := saveCache(cacheFile, previous); err != nil {
+		return fmt.Errorf("failed to save cache: %w", err)
+	}
+	color.Yellow("⚠️  %v. Keeping the data synced at %s.", err, previous.LastSync.Local().Format("2006-01-02 15:04"))
+	setExitStatus(exitSync)
+	return nil
+}
+
+// showRateLimitStats reports how often Jira throttled or failed the sync and how long it waited
+func showRateLimitStats(stats jira.RateLimitStats) {
+	if stats.Throttled == 0 && stats.Unavailable == 0 {
+		color.White("Jira rate limiting: no requests throttled")
+		return
+	}
+	color.Yellow("Jira rate limiting: %d requests throttled, %d retried after waiting %v in total",
+		stats.Throttled, stats.Retries, stats.Waited.Round(time.Second))
+	if stats.Failed > 0 {
+		color.Yellow("  %d requests were still throttled after all retries", stats.Failed)
+	}
+	if stats.Unavailable > 0 {
+		color.Yellow("  %d requests still failed after all retries", stats.Unavailable)
+	}
+	if stats.Degraded {
+		color.Yellow("  Jira kept failing, %d remaining requests were skipped", stats.Skipped)
+	}
+}
+
+// fetchGitHubTickets fetches the GitHub issues assigned to you that you commented on, with their
+// Projects board status, mapped onto Jira issues so reports work the same as with Jira.
+// GitHub has no worklog, so no worklog entries are returned.
+func fetchGitHubTickets(ctx context.Context, cmd *cobra.Command, cfg *config.Config, previous *TicketCache) (*trackerTickets, error) {
+	authManager := github.NewAuthManager("")
+	if !authManager.IsAuthenticated() {
+		return nil, withExitCode(exitAuth, fmt.Errorf("not authenticated with GitHub. Run 'my-day github connect' first"))
+	}
+	authInfo, err := authManager.LoadToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load GitHub token: %w", err)
+	}
+	client := github.NewClient(authInfo.Token)
+
+	color.Cyan("🔄 Syncing issues from GitHub...")
+
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	ticketsSince := syncWindow(cmd, previous)
+	ticketsSinceTime := time.Now().Add(-ticketsSince)
+
+	color.White("Searching for issues assigned to %s updated since %s...", user.Login, ticketsSinceTime.Format("2006-01-02"))
+	issues, err := client.GetAssignedIssues(ctx, ticketsSinceTime, cfg.GitHub.Repositories)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch issues: %w", err)
+	}
+
+	color.Green("✓ Found %d updated issues to check for your comments", len(issues))
+
+	// If comments-since wasn't explicitly set, use the same duration as --since
+	commentsSince, _ := cmd.Flags().GetDuration("comments-since")
+	if !cmd.Flags().Changed("comments-since") {
+		commentsSince = ticketsSince
+	}
+	commentsSinceTime := time.Now().Add(-commentsSince)
+
+	// Board status comes from GitHub Projects; issues that aren't on a board are Open or Closed
+	var nodeIDs []string
+	for _, issue := range issues {
+		nodeIDs = append(nodeIDs, issue.NodeID)
+	}
+	var warnings report.Warnings
+	statuses, err := client.GetProjectStatuses(ctx, nodeIDs, cfg.GitHub.ProjectStatusField)
+	if err != nil {
+		warnings.Add(report.SeverityWarning, "GitHub", "Failed to fetch project board status (the token needs the read:project scope): %v", err)
+	}
+
+	color.White("Fetching your comments from the last %v...", commentsSince)
+	var issuesWithComments []IssueWithComments
+	var issueStatuses []jira.Status
+	for _, issue := range issues {
+		comments, err := client.GetIssueComments(ctx, github.IssueRepository(issue), issue.Number, commentsSinceTime)
+		if err != nil {
+			warnings.Add(report.SeverityWarning, "GitHub", "Failed to fetch comments for %s#%d: %v", github.IssueRepository(issue), issue.Number, err)
+			continue
+		}
+
+		// The API filters on the update time, so edited older comments are skipped here
+		var myComments []jira.Comment
+		for _, comment := range comments {
+			if comment.User.Login == user.Login && comment.CreatedAt.Time.After(commentsSinceTime) {
+				myComments = append(myComments, github.ToJiraComment(comment))
 			}
-		} else {
-			color.Yellow("⚠️  GitHub not authenticated. Run 'my-day github connect' to include GitHub activity")
 		}
+
+		if len(myComments) > 0 {
+			jiraIssue := github.ToJiraIssue(issue, statuses[issue.NodeID])
+			issueStatuses = append(issueStatuses, jiraIssue.Fields.Status)
+			issuesWithComments = append(issuesWithComments, IssueWithComments{
+				Issue:    jiraIssue,
+				Comments: myComments,
+			})
+		}
+	}
+
+	if len(issuesWithComments) == 0 {
+		color.Yellow("✓ No issues found with your comments in the last %v", commentsSince)
+		color.White("  Only issues assigned to you are checked. Use --comments-since to look further back.")
 	} else {
-		color.White("GitHub sync disabled or not configured")
+		color.Green("✓ Found %d issues with your comments in the last %v", len(issuesWithComments), commentsSince)
 	}
 
-	// Create cache
-	cache := TicketCache{
-		LastSync:           time.Now(),
-		Issues:             filteredIssues,
-		IssuesWithComments: issuesWithComments,
-		Worklogs:           worklogs,
-		GitHubActivity:     githubActivity,
-		LastGitHubSync:     githubSyncTime,
+	// Use the project board columns for --group-by column, falling back to the previously cached layout
+	boardColumns := github.ProjectBoardColumns(statuses)
+	if boardColumns == nil && previous != nil {
+		boardColumns = previous.BoardColumns
 	}
 
-	// Save to cache file
-	if err := saveCache(cacheFile, &cache); err != nil {
-		return fmt.Errorf("failed to save cache: %w", err)
+	jiraUser := github.ToJiraUser(*user)
+	return &trackerTickets{
+		IssuesWithComments: issuesWithComments,
+		User:               &jiraUser,
+		StatusCategories:   jira.NewStatusCategoryMap(issueStatuses),
+		BoardColumns:       boardColumns,
+		Warnings:           warnings,
+	}, nil
+}
+
+// trackerTickets is what a sync fetched from the issue tracker
+type trackerTickets struct {
+	IssuesWithComments []IssueWithComments
+	Worklogs           []jira.WorklogEntry
+	User               *jira.User
+	StatusCategories   *jira.StatusCategoryMap
+	BoardColumns       *jira.BoardColumnMap
+	Mentions           []jira.Mention // Comments mentioning you on issues others own
+	Warnings           report.Warnings // Non-fatal problems, such as issues whose comments could not be fetched
+}
+
+// syncWindow returns how far back to fetch tickets. Without an explicit --since, only
+// changes since the previous sync are fetched and merged into the local store.
+func syncWindow(cmd *cobra.Command, previous *TicketCache) time.Duration {
+	since, _ := cmd.Flags().GetDuration("since")
+	full, _ := cmd.Flags().GetBool("full")
+	if !full && !cmd.Flags().Changed("since") && previous != nil && !previous.LastSync.IsZero() {
+		if elapsed := time.Since(previous.LastSync) + incrementalSyncOverlap; elapsed < since {
+			color.White("Incremental sync: fetching changes since %s (use --full to refetch)", previous.LastSync.Local().Format("2006-01-02 15:04"))
+			return elapsed
+		}
 	}
+	return since
+}
 
-	color.Green("✓ Sync completed successfully")
-	color.White("Issues: %d", len(cache.Issues))
-	color.White("Worklog entries: %d", len(cache.Worklogs))
-	color.White("GitHub activities: %d", len(cache.GitHubActivity))
-	color.White("Cache saved to: %s", cacheFile)
+// applyStatusCategories fills in missing status categories from the cached status mapping.
+// It returns the sorted names of statuses that could not be resolved.
+func applyStatusCategories(cache *TicketCache) []string {
+	unresolved := make(map[string]bool)
 
-	// Show summary of recent activity
-	showSyncSummary(&cache)
+	for i := range cache.Issues {
+		if !cache.StatusCategories.Apply(&cache.Issues[i]) {
+			unresolved[cache.Issues[i].Fields.Status.Name] = true
+		}
+	}
+	for i := range cache.IssuesWithComments {
+		if !cache.StatusCategories.Apply(&cache.IssuesWithComments[i].Issue) {
+			unresolved[cache.IssuesWithComments[i].Issue.Fields.Status.Name] = true
+		}
+	}
 
-	return nil
+	var names []string
+	for name := range unresolved {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
+// syncStateKey is the store key of the sync metadata: last sync time, user, status and
+// board metadata, and the GitHub/GitLab activity of the latest sync
+const syncStateKey = "sync"
+
+// getCacheFilePath returns the path of the local SQLite store
 func getCacheFilePath() (string, error) {
-	homeDir, err := os.UserHomeDir()
+	if activeProfile != "" {
+		return store.ProfilePath(activeProfile)
+	}
+	return store.DefaultPath()
+}
+
+// activeProfile is the Jira profile whose credentials and local store are in use
+var activeProfile string
+
+// useProfile switches the credentials and local store to those of the named Jira profile
+func useProfile(name string) {
+	activeProfile = name
+	jira.SetProfile(name)
+}
+
+// loadCache reads all synced data from the local store. A JSON cache left by an earlier
+// version is imported on first use.
+func loadCache(filePath string) (*TicketCache, error) {
+	db, err := store.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var cache TicketCache
+	found, err := db.State(syncStateKey, &cache)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		legacy, err := loadLegacyCache(filepath.Join(filepath.Dir(filePath), syncstate.TicketCacheFile))
+		if err != nil {
+			return nil, err
+		}
+		if err := saveToStore(db, legacy); err != nil {
+			return nil, fmt.Errorf("failed to import %s: %w", syncstate.TicketCacheFile, err)
+		}
+		cache = TicketCache{}
+		if _, err := db.State(syncStateKey, &cache); err != nil {
+			return nil, err
+		}
+	}
+
+	issues, err := db.Issues(time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	comments, err := db.Comments(time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	transitions, err := db.Transitions(time.Time{})
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	for _, issue := range issues {
+		issue.Transitions = transitions[issue.Key]
+		cache.Issues = append(cache.Issues, issue)
+		cache.IssuesWithComments = append(cache.IssuesWithComments, IssueWithComments{
+			Issue:    issue,
+			Comments: comments[issue.Key],
+		})
 	}
 
-	cacheDir := filepath.Join(homeDir, ".my-day")
-	if err := os.MkdirAll(cacheDir, 0755); err != nil {
-		return "", err
+	if cache.Worklogs, err = db.Worklogs(time.Time{}); err != nil {
+		return nil, err
 	}
 
-	return filepath.Join(cacheDir, "cache.json"), nil
+	return &cache, nil
 }
 
-func loadCache(filePath string) (*TicketCache, error) {
+// loadLegacyCache reads the JSON cache file used before the SQLite store
+func loadLegacyCache(filePath string) (*TicketCache, error) {
 	data, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, err
@@ -314,17 +1014,47 @@ func loadCache(filePath string) (*TicketCache, error) {
 	return &cache, nil
 }
 
+// saveCache merges synced data into the local store. Issues, comments and worklogs are
+// kept across syncs; the sync metadata is replaced.
 func saveCache(filePath string, cache *TicketCache) error {
-	data, err := json.MarshalIndent(cache, "", "  ")
+	db, err := store.Open(filePath)
 	if err != nil {
 		return err
 	}
+	defer db.Close()
+
+	return saveToStore(db, cache)
+}
+
+func saveToStore(db *store.Store, cache *TicketCache) error {
+	if err := db.SaveIssues(cache.Issues); err != nil {
+		return err
+	}
+	for _, iwc := range cache.IssuesWithComments {
+		if err := db.SaveIssues([]jira.Issue{iwc.Issue}); err != nil {
+			return err
+		}
+		if err := db.SaveComments(iwc.Issue.Key, iwc.Comments); err != nil {
+			return err
+		}
+		if err := db.SaveTransitions(iwc.Issue.Key, iwc.Issue.Transitions); err != nil {
+			return err
+		}
+	}
+	if err := db.SaveWorklogs(cache.Worklogs); err != nil {
+		return err
+	}
 
-	return os.WriteFile(filePath, data, 0644)
+	metadata := *cache
+	metadata.Issues = nil
+	metadata.IssuesWithComments = nil
+	metadata.Worklogs = nil
+	return db.SetState(syncStateKey, metadata)
 }
 
 func showSyncSummary(cache *TicketCache) {
-	if len(cache.Issues) == 0 && len(cache.GitHubActivity) == 0 {
+	if len(cache.Issues) == 0 && len(cache.GitHubActivity) == 0 && len(cache.GitLabActivity) == 0 &&
+		len(cache.TrelloActivity) == 0 && len(cache.AsanaActivity) == 0 && len(cache.TimeEntries) == 0 {
 		return
 	}
 
@@ -391,6 +1121,46 @@ func showSyncSummary(cache *TicketCache) {
 			color.White("  ... and %d more activities", len(cache.GitHubActivity)-5)
 		}
 	}
+
+	// Show GitLab activity if available
+	if len(cache.GitLabActivity) > 0 {
+		color.White("\n🦊 GitLab Activity:")
+
+		typeCounts := make(map[string]int)
+		for _, activity := range cache.GitLabActivity {
+			typeCounts[activity.Type]++
+		}
+
+		for actType, count := range typeCounts {
+			color.White("  %s: %d", actType, count)
+		}
+	}
+
+	// Show Trello and Asana activity if available
+	if len(cache.TrelloActivity) > 0 {
+		color.White("\n📌 Trello Activity:")
+		for _, activity := range cache.TrelloActivity {
+			color.White("  %s %s (%s)", activity.Type, truncateString(activity.Title, 50), activity.Board)
+		}
+	}
+	if len(cache.AsanaActivity) > 0 {
+		color.White("\n🎯 Asana Activity:")
+		for _, activity := range cache.AsanaActivity {
+			color.White("  %s %s", activity.Type, truncateString(activity.Title, 50))
+		}
+	}
+
+	// Show imported time entries if available
+	if len(cache.TimeEntries) > 0 {
+		color.White("\n⏱️  Time Entries:")
+		for _, entry := range cache.TimeEntries {
+			issue := strings.Join(entry.IssueKeys, ", ")
+			if issue == "" {
+				issue = "no issue"
+			}
+			color.White("  %s %s (%s)", (time.Duration(entry.DurationSeconds) * time.Second).Round(time.Minute), truncateString(entry.Description, 50), issue)
+		}
+	}
 }
 
 func truncateString(s string, maxLen int) string {
@@ -411,12 +1181,56 @@ func containsString(slice []string, item string) bool {
 }
 
 // getActivityIcon returns an icon for the activity type
+// filterGitHubActivity drops the activity types disabled in the GitHub configuration
+func filterGitHubActivity(activities []github.Activity, cfg config.GitHubConfig) []github.Activity {
+	var filtered []github.Activity
+	for _, activity := range activities {
+		switch activity.Type {
+		case "pull_request", "review":
+			if !cfg.IncludePRs {
+				continue
+			}
+		case "commit":
+			if !cfg.IncludeCommits {
+				continue
+			}
+		}
+		filtered = append(filtered, activity)
+	}
+	return filtered
+}
+
+// filterGitLabActivity drops the activity types disabled in the GitLab configuration
+func filterGitLabActivity(activities []gitlab.Activity, cfg config.GitLabConfig) []gitlab.Activity {
+	var filtered []gitlab.Activity
+	for _, activity := range activities {
+		switch activity.Type {
+		case "merge_request":
+			if !cfg.IncludeMergeRequests {
+				continue
+			}
+		case "commit":
+			if !cfg.IncludeCommits {
+				continue
+			}
+		case "pipeline":
+			if !cfg.IncludePipelines {
+				continue
+			}
+		}
+		filtered = append(filtered, activity)
+	}
+	return filtered
+}
+
 func getActivityIcon(activityType string) string {
 	switch activityType {
 	case "pull_request":
 		return "🔀"
 	case "commit":
 		return "💾"
+	case "review":
+		return "👀"
 	case "workflow_run":
 		return "⚙️"
 	case "issue":
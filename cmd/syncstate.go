@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/report"
+	"my-day/internal/syncstate"
+)
+
+// syncStateCmd represents the sync-state command
+var syncStateCmd = &cobra.Command{
+	Use:   "sync-state",
+	Short: "Share cache and report history between machines",
+	Long: `Sync-state encrypts your ticket cache and cached report history and stores
+them in a backend you control, so a desktop and a laptop can share report history.
+
+The state is encrypted locally with AES-256-GCM using a key derived from
+sync_state.passphrase (or MY_DAY_SYNC_STATE_PASSPHRASE) before it leaves the machine.
+Credentials and configuration are never synced.
+
+Supported backends (sync_state.backend):
+- dir:    a local folder, e.g. one synced by Dropbox or Syncthing (sync_state.path)
+- webdav: a WebDAV collection such as Nextcloud (sync_state.url, username, password)
+- git:    a private git repository, using your git credentials (sync_state.url)
+- s3:     an S3-compatible bucket (sync_state.bucket, region, endpoint, access keys)`,
+}
+
+// syncStatePushCmd represents the sync-state push command
+var syncStatePushCmd = &cobra.Command{
+	Use:   "push",
+	Short: "Encrypt and upload local state",
+	Long:  `Encrypt the local ticket cache and report history and upload it to the configured backend.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := pushState(cmd); err != nil {
+			exitWithError("Push failed", err)
+		}
+	},
+}
+
+// syncStatePullCmd represents the sync-state pull command
+var syncStatePullCmd = &cobra.Command{
+	Use:   "pull",
+	Short: "Download and merge remote state",
+	Long: `Download and decrypt state from the configured backend and merge it locally.
+
+Cached reports are merged, keeping the most recently generated copy of each report.
+The ticket cache is replaced only when the remote copy was synced more recently,
+unless --force is given.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := pullState(cmd); err != nil {
+			exitWithError("Pull failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(syncStateCmd)
+	syncStateCmd.AddCommand(syncStatePushCmd)
+	syncStateCmd.AddCommand(syncStatePullCmd)
+
+	// Flags for pull command
+	syncStatePullCmd.Flags().Bool("force", false, "Replace the local ticket cache even if it is newer")
+}
+
+// newSyncStateBackend loads configuration and returns the backend and passphrase
+func newSyncStateBackend() (syncstate.Backend, string, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	if cfg.SyncState.Passphrase == "" {
+		return nil, "", fmt.Errorf("sync passphrase not configured. Set MY_DAY_SYNC_STATE_PASSPHRASE or sync_state.passphrase")
+	}
+
+	backend, err := syncstate.NewBackend(syncstate.Config{
+		Backend:   cfg.SyncState.Backend,
+		Path:      cfg.SyncState.Path,
+		URL:       cfg.SyncState.URL,
+		Username:  cfg.SyncState.Username,
+		Password:  cfg.SyncState.Password,
+		Bucket:    cfg.SyncState.Bucket,
+		Region:    cfg.SyncState.Region,
+		Endpoint:  cfg.SyncState.Endpoint,
+		AccessKey: cfg.SyncState.AccessKeyID,
+		SecretKey: cfg.SyncState.SecretAccessKey,
+		Prefix:    cfg.SyncState.Prefix,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return backend, cfg.SyncState.Passphrase, nil
+}
+
+func pushState(cmd *cobra.Command) error {
+	backend, passphrase, err := newSyncStateBackend()
+	if err != nil {
+		return err
+	}
+
+	cacheFile, err := getCacheFilePath()
+	if err != nil {
+		return fmt.Errorf("failed to get cache file path: %w", err)
+	}
+
+	color.Cyan("🔐 Encrypting local state...")
+
+	// The ticket cache travels as JSON so it can be merged into the other machine's store
+	var ticketCache []byte
+	if cache, err := loadCache(cacheFile); err == nil {
+		if ticketCache, err = json.Marshal(cache); err != nil {
+			return fmt.Errorf("failed to encode ticket cache: %w", err)
+		}
+	}
+
+	bundle, err := syncstate.Bundle(filepath.Dir(cacheFile), ticketCache)
+	if err != nil {
+		return fmt.Errorf("failed to bundle state: %w", err)
+	}
+
+	encrypted, err := syncstate.Encrypt(bundle, passphrase)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt state: %w", err)
+	}
+
+	color.White("Uploading %d KB to %s...", (len(encrypted)+1023)/1024, backend.Name())
+	if err := backend.Put(encrypted); err != nil {
+		return err
+	}
+
+	color.Green("✓ State pushed")
+	return nil
+}
+
+func pullState(cmd *cobra.Command) error {
+	backend, passphrase, err := newSyncStateBackend()
+	if err != nil {
+		return err
+	}
+
+	color.Cyan("📥 Downloading state from %s...", backend.Name())
+
+	encrypted, err := backend.Get()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("no remote state found. Run 'my-day sync-state push' on another machine first")
+		}
+		return err
+	}
+
+	bundle, err := syncstate.Decrypt(encrypted, passphrase)
+	if err != nil {
+		return err
+	}
+
+	files, err := syncstate.Unbundle(bundle)
+	if err != nil {
+		return err
+	}
+
+	// Merge cached reports
+	cacheManager, err := report.NewCacheManager()
+	if err != nil {
+		return fmt.Errorf("failed to initialize cache manager: %w", err)
+	}
+
+	imported, skipped := 0, 0
+	for name, data := range files {
+		if name == syncstate.TicketCacheFile {
+			continue
+		}
+		updated, err := cacheManager.ImportReport(data)
+		if err != nil {
+			color.Yellow("Warning: Failed to import %s: %v", name, err)
+			continue
+		}
+		if updated {
+			imported++
+		} else {
+			skipped++
+		}
+	}
+	color.Green("✓ Imported %d reports (%d already up to date)", imported, skipped)
+
+	// Merge the remote ticket cache into the local store if it is newer
+	remoteData, ok := files[syncstate.TicketCacheFile]
+	if !ok {
+		return nil
+	}
+
+	var remoteCache TicketCache
+	if err := json.Unmarshal(remoteData, &remoteCache); err != nil {
+		return fmt.Errorf("failed to parse remote ticket cache: %w", err)
+	}
+
+	cacheFile, err := getCacheFilePath()
+	if err != nil {
+		return fmt.Errorf("failed to get cache file path: %w", err)
+	}
+
+	force, _ := cmd.Flags().GetBool("force")
+	if localCache, err := loadCache(cacheFile); err == nil && !force && !remoteCache.LastSync.After(localCache.LastSync) {
+		color.White("Local ticket cache is up to date (synced %s); keeping it. Use --force to merge the remote one anyway.",
+			localCache.LastSync.Local().Format("2006-01-02 15:04"))
+		return nil
+	}
+
+	if err := saveCache(cacheFile, &remoteCache); err != nil {
+		return fmt.Errorf("failed to merge ticket cache: %w", err)
+	}
+	color.Green("✓ Ticket cache updated (synced %s)", remoteCache.LastSync.Local().Format("2006-01-02 15:04"))
+
+	return nil
+}
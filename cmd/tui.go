@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"my-day/internal/config"
+	"my-day/internal/jira"
+	"my-day/internal/report"
+	"my-day/internal/tui"
+)
+
+// tuiCmd represents the tui command
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Browse the day's issues in an interactive terminal dashboard",
+	Long: `TUI opens a terminal dashboard of the issues the daily report covers, instead of
+printing the report once. It reads the local store that 'my-day sync' fills.
+
+Keys:
+  ↑/↓ or j/k   Move between issues
+  tab, 1-4     Filter by status: all, in progress, done, to do
+  enter        Show every comment of the selected issue
+  p            Turn the latest-comment previews on or off
+  s            AI summary of the selected issue (needs the LLM)
+  r            Regenerate the full report and page through it
+  e            Export the report to the configured target (Obsidian by default)
+  q            Quit, or go back from the report`,
+	Example: `  my-day tui
+  my-day tui --date 2024-07-15 --no-llm`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runTUI(cmd); err != nil {
+			exitWithError("TUI failed", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tuiCmd)
+
+	// TUI flags
+	tuiCmd.Flags().String("date", "", "Show the issues of a specific date (YYYY-MM-DD)")
+	tuiCmd.Flags().Duration("since", 7*24*time.Hour, "Include tickets and worklogs updated since this duration ago")
+	tuiCmd.Flags().Bool("no-llm", false, "Disable AI summaries")
+}
+
+func runTUI(cmd *cobra.Command) error {
+	if err := tui.CheckTerminal(); err != nil {
+		return fmt.Errorf("%w; use 'my-day report' instead", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	cacheFile, err := getCacheFilePath()
+	if err != nil {
+		return fmt.Errorf("failed to get cache file path: %w", err)
+	}
+	cache, err := loadCache(cacheFile)
+	if err != nil {
+		color.Yellow("No cached data found. Run 'my-day sync' first.")
+		return fmt.Errorf("failed to load cache: %w", err)
+	}
+	applyStatusCategories(cache)
+
+	targetDate := time.Now()
+	if dateStr, _ := cmd.Flags().GetString("date"); dateStr != "" {
+		targetDate, err = time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			return fmt.Errorf("invalid date format. Use YYYY-MM-DD: %w", err)
+		}
+	}
+
+	since, _ := cmd.Flags().GetDuration("since")
+	cache = filterCacheDataBySince(cache, time.Now().Add(-since), targetDate)
+	applyTimeEntries(cache)
+
+	llmEnabled := cfg.LLM.Enabled
+	if noLLM, _ := cmd.Flags().GetBool("no-llm"); noLLM {
+		llmEnabled = false
+	}
+
+	meetings := loadMeetings(cfg, targetDate)
+	reportConfig := newReportConfig(cfg, cache, llmEnabled, meetings)
+	generator := report.NewGenerator(reportConfig)
+
+	var issuesWithComments []report.IssueWithComments
+	for _, iwc := range cache.IssuesWithComments {
+		issuesWithComments = append(issuesWithComments, report.IssueWithComments{Issue: iwc.Issue, Comments: iwc.Comments})
+	}
+
+	model := tui.NewModel(fmt.Sprintf("my-day · %s", targetDate.Format("Mon Jan 2, 2006")), dashboardItems(generator, issuesWithComments, targetDate))
+	return tui.Run(model, tui.Handlers{
+		Summarize: func(key string) (string, error) {
+			for _, iwc := range issuesWithComments {
+				if iwc.Issue.Key == key {
+					return generator.SummarizeIssue(iwc, cache.Worklogs)
+				}
+			}
+			return "", fmt.Errorf("issue %s not found", key)
+		},
+		Report: func() (string, error) {
+			// The dashboard pages through the console report whatever report.format is
+			viewConfig := *reportConfig
+			viewConfig.Format = "console"
+			return report.NewGenerator(&viewConfig).GenerateWithComments(issuesWithComments, cache.Worklogs, targetDate)
+		},
+		Export: func() (string, error) {
+			// Pressing e exports like --export, even when export is off in the configuration
+			cfg.Report.Export.Enabled = true
+			reportConfig.ExportEnabled = true
+			content, err := generator.GenerateWithCommentsAndCache(issuesWithComments, cache.Worklogs, targetDate, true)
+			if err != nil {
+				return "", fmt.Errorf("failed to generate report: %w", err)
+			}
+			destinations, err := exportReport(cfg, generator, cache, meetings, content, targetDate)
+			return strings.Join(destinations, "; "), err
+		},
+	})
+}
+
+// dashboardItems returns the report's issues for the dashboard, in report order
+func dashboardItems(generator *report.Generator, issuesWithComments []report.IssueWithComments, targetDate time.Time) []tui.Item {
+	var issues []jira.Issue
+	commentsByKey := make(map[string][]jira.Comment)
+	for _, iwc := range issuesWithComments {
+		issues = append(issues, iwc.Issue)
+		commentsByKey[iwc.Issue.Key] = iwc.Comments
+	}
+
+	filtered := generator.FilterIssues(issues, targetDate)
+	groups := make(map[string]string)
+	for group, groupIssues := range report.GroupIssuesByStatus(filtered) {
+		for _, issue := range groupIssues {
+			groups[issue.Key] = group
+		}
+	}
+
+	var items []tui.Item
+	for _, issue := range filtered {
+		item := tui.Item{
+			Key:     issue.Key,
+			Summary: issue.Fields.Summary,
+			Status:  generator.StatusLabel(issue.Fields.Status.Name),
+			Group:   groups[issue.Key],
+		}
+		for _, comment := range commentsByKey[issue.Key] {
+			item.Comments = append(item.Comments, comment.Body.Text)
+		}
+		items = append(items, item)
+	}
+	return items
+}
@@ -0,0 +1,222 @@
+package asana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"my-day/internal/issuekeys"
+)
+
+const (
+	// DefaultBaseURL is the Asana REST API base URL
+	DefaultBaseURL = "https://app.asana.com/api/1.0"
+
+	// DefaultTimeout is the default HTTP client timeout
+	DefaultTimeout = 30 * time.Second
+)
+
+// Client is a read-only Asana API client authenticated with a personal access token
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	token      string
+}
+
+// NewClient creates a new Asana client using a personal access token
+func NewClient(token string) *Client {
+	return NewClientWithURL(DefaultBaseURL, token)
+}
+
+// NewClientWithURL creates a new Asana client with a custom base URL
+func NewClientWithURL(baseURL, token string) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{Timeout: DefaultTimeout},
+		token:      token,
+	}
+}
+
+// get makes an authenticated GET request to the Asana API and decodes the "data" of the response
+func (c *Client) get(ctx context.Context, endpoint string, params url.Values, result interface{}) error {
+	reqURL := c.baseURL + endpoint
+	if len(params) > 0 {
+		reqURL += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "my-day-cli/1.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && len(errResp.Errors) > 0 {
+			return fmt.Errorf("Asana API error: %s", errResp.Errors[0].Message)
+		}
+		return fmt.Errorf("Asana API error: status %d", resp.StatusCode)
+	}
+
+	envelope := struct {
+		Data interface{} `json:"data"`
+	}{Data: result}
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// GetCurrentUser returns the user the token belongs to, with their workspaces
+func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	var user User
+	if err := c.get(ctx, "/users/me", url.Values{"opt_fields": {"name,email,workspaces.name"}}, &user); err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+	return &user, nil
+}
+
+// GetAssignedTasks returns the tasks in a workspace assigned to the user and modified since the given time
+func (c *Client) GetAssignedTasks(ctx context.Context, workspace string, since time.Time) ([]Task, error) {
+	params := url.Values{
+		"assignee":   {"me"},
+		"workspace":  {workspace},
+		"opt_fields": {"name,completed,completed_at,modified_at,permalink_url,projects.name"},
+		"limit":      {"100"},
+	}
+	if !since.IsZero() {
+		params.Set("modified_since", since.UTC().Format(time.RFC3339))
+	}
+
+	var tasks []Task
+	if err := c.get(ctx, "/tasks", params, &tasks); err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	return tasks, nil
+}
+
+// GetTaskStories returns the history of a task, oldest first
+func (c *Client) GetTaskStories(ctx context.Context, taskGID string) ([]Story, error) {
+	params := url.Values{
+		"opt_fields": {"resource_subtype,text,created_at,created_by.name"},
+		"limit":      {"100"},
+	}
+
+	var stories []Story
+	if err := c.get(ctx, "/tasks/"+url.PathEscape(taskGID)+"/stories", params, &stories); err != nil {
+		return nil, fmt.Errorf("failed to get stories: %w", err)
+	}
+	return stories, nil
+}
+
+// GetUserActivity returns the tasks assigned to the user that they completed, moved between
+// sections or commented on since the given time. Without a workspace every workspace of the
+// user is scanned; with projects listed (by GID or name) only tasks in those projects are kept.
+func (c *Client) GetUserActivity(ctx context.Context, since time.Time, workspace string, projects []string) ([]Activity, error) {
+	user, err := c.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := []string{workspace}
+	if workspace == "" {
+		workspaces = nil
+		for _, w := range user.Workspaces {
+			workspaces = append(workspaces, w.GID)
+		}
+	}
+
+	var activities []Activity
+	for _, workspaceGID := range workspaces {
+		tasks, err := c.GetAssignedTasks(ctx, workspaceGID, since)
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range tasks {
+			if len(projects) > 0 && !inProjects(task, projects) {
+				continue
+			}
+			activities = append(activities, c.getTaskActivity(ctx, task, user, since)...)
+		}
+	}
+	return activities, nil
+}
+
+// getTaskActivity collects the user's activity on one task, skipping stories if they can't be fetched
+func (c *Client) getTaskActivity(ctx context.Context, task Task, user *User, since time.Time) []Activity {
+	base := Activity{
+		Title: task.Name,
+		URL:   task.PermalinkURL,
+	}
+	if len(task.Projects) > 0 {
+		base.Project = task.Projects[0].Name
+	}
+
+	var activities []Activity
+	if task.Completed && task.CompletedAt != nil && task.CompletedAt.After(since) {
+		activity := base
+		activity.Type = "completed"
+		activity.ID = task.GID
+		activity.CreatedAt = *task.CompletedAt
+		activity.JiraTickets = issuekeys.Extract(task.Name)
+		activities = append(activities, activity)
+	}
+
+	stories, err := c.GetTaskStories(ctx, task.GID)
+	if err != nil {
+		return activities
+	}
+	for _, story := range stories {
+		if story.CreatedBy == nil || story.CreatedBy.GID != user.GID || !story.CreatedAt.After(since) {
+			continue
+		}
+
+		activity := base
+		switch story.ResourceSubtype {
+		case "comment_added":
+			activity.Type = "commented"
+		case "section_changed":
+			activity.Type = "moved"
+		default:
+			continue
+		}
+		activity.ID = story.GID
+		activity.Description = story.Text
+		activity.CreatedAt = story.CreatedAt
+		activity.JiraTickets = issuekeys.Extract(task.Name + " " + story.Text)
+		activities = append(activities, activity)
+	}
+	return activities
+}
+
+// inProjects reports whether a task belongs to one of the configured projects
+func inProjects(task Task, projects []string) bool {
+	for _, project := range task.Projects {
+		for _, configured := range projects {
+			if configured == project.GID || strings.EqualFold(configured, project.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TestConnection tests the Asana API connection
+func (c *Client) TestConnection(ctx context.Context) error {
+	if _, err := c.GetCurrentUser(ctx); err != nil {
+		return fmt.Errorf("Asana connection test failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package asana
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetUserActivity(t *testing.T) {
+	since := time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)
+
+	responses := map[string]string{
+		"/users/me": `{"data": {"gid": "u1", "name": "Alex", "workspaces": [{"gid": "w1", "name": "Acme"}]}}`,
+		"/tasks": `{"data": [
+			{"gid": "t1", "name": "Plan offsite", "completed": true, "completed_at": "2024-07-15T16:00:00Z",
+			 "permalink_url": "https://app.asana.com/0/1/t1", "projects": [{"gid": "p1", "name": "Team ops"}]},
+			{"gid": "t2", "name": "Unrelated", "projects": [{"gid": "p2", "name": "Marketing"}]}
+		]}`,
+		"/tasks/t1/stories": `{"data": [
+			{"gid": "s1", "resource_subtype": "comment_added", "text": "Old comment", "created_at": "2024-07-12T09:00:00Z", "created_by": {"gid": "u1"}},
+			{"gid": "s2", "resource_subtype": "section_changed", "text": "moved this task from \"Doing\" to \"Done\"", "created_at": "2024-07-15T15:00:00Z", "created_by": {"gid": "u1"}},
+			{"gid": "s3", "resource_subtype": "comment_added", "text": "Booked the venue", "created_at": "2024-07-15T15:30:00Z", "created_by": {"gid": "u1"}},
+			{"gid": "s4", "resource_subtype": "comment_added", "text": "Thanks!", "created_at": "2024-07-15T17:00:00Z", "created_by": {"gid": "u2"}}
+		]}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"errors": [{"message": "Not Authorized"}]}`))
+			return
+		}
+		if r.URL.Path == "/tasks" && (r.URL.Query().Get("workspace") != "w1" || r.URL.Query().Get("assignee") != "me") {
+			t.Errorf("unexpected tasks query %s", r.URL.RawQuery)
+		}
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	activities, err := NewClientWithURL(server.URL, "token").GetUserActivity(context.Background(), since, "", []string{"team ops"})
+	if err != nil {
+		t.Fatalf("GetUserActivity() error = %v", err)
+	}
+
+	var types []string
+	for _, activity := range activities {
+		types = append(types, activity.Type)
+		if activity.Project != "Team ops" || activity.URL != "https://app.asana.com/0/1/t1" {
+			t.Errorf("unexpected activity: %+v", activity)
+		}
+	}
+	if len(types) != 3 || types[0] != "completed" || types[1] != "moved" || types[2] != "commented" {
+		t.Fatalf("expected completed, moved and commented activity, got %v", types)
+	}
+	if activities[2].Description != "Booked the venue" {
+		t.Errorf("unexpected comment text %q", activities[2].Description)
+	}
+
+	if _, err := NewClientWithURL(server.URL, "wrong").GetUserActivity(context.Background(), since, "", nil); err == nil || err.Error() != "failed to get current user: Asana API error: Not Authorized" {
+		t.Errorf("expected Asana API error, got %v", err)
+	}
+}
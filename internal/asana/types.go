@@ -0,0 +1,62 @@
+package asana
+
+import "time"
+
+// User represents an Asana user
+type User struct {
+	GID        string      `json:"gid"`
+	Name       string      `json:"name"`
+	Email      string      `json:"email"`
+	Workspaces []Workspace `json:"workspaces"`
+}
+
+// Workspace represents an Asana workspace or organization
+type Workspace struct {
+	GID  string `json:"gid"`
+	Name string `json:"name"`
+}
+
+// Project represents an Asana project
+type Project struct {
+	GID  string `json:"gid"`
+	Name string `json:"name"`
+}
+
+// Task represents an Asana task
+type Task struct {
+	GID          string     `json:"gid"`
+	Name         string     `json:"name"`
+	Completed    bool       `json:"completed"`
+	CompletedAt  *time.Time `json:"completed_at"`
+	ModifiedAt   time.Time  `json:"modified_at"`
+	PermalinkURL string     `json:"permalink_url"`
+	Projects     []Project  `json:"projects"`
+}
+
+// Story represents an entry of a task's history, such as a comment or a section change
+type Story struct {
+	GID             string    `json:"gid"`
+	ResourceSubtype string    `json:"resource_subtype"` // comment_added, section_changed, ...
+	Text            string    `json:"text"`
+	CreatedAt       time.Time `json:"created_at"`
+	CreatedBy       *User     `json:"created_by"`
+}
+
+// Activity represents a task the user completed, moved or commented on
+type Activity struct {
+	Type        string    `json:"type"`         // completed, moved, commented
+	ID          string    `json:"id"`           // Story or task GID
+	Title       string    `json:"title"`        // Task name
+	Description string    `json:"description"`  // Comment text or section change
+	URL         string    `json:"url"`          // Link to the task
+	Project     string    `json:"project"`      // First project the task belongs to
+	CreatedAt   time.Time `json:"created_at"`   // When it happened
+	JiraTickets []string  `json:"jira_tickets"` // Linked Jira ticket keys
+}
+
+// ErrorResponse represents an Asana API error response
+type ErrorResponse struct {
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
@@ -0,0 +1,128 @@
+package calendar
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Meeting categories, in the order they are listed in summaries
+const (
+	CategoryIncidentReview = "incident review"
+	CategoryOneOnOne       = "1:1"
+	CategoryRecurring      = "recurring"
+	CategoryAdHoc          = "ad hoc"
+)
+
+var meetingCategories = []string{CategoryRecurring, CategoryIncidentReview, CategoryOneOnOne, CategoryAdHoc}
+
+// incidentKeywords and oneOnOneKeywords identify meeting categories from event titles
+var (
+	incidentKeywords = []string{"incident", "postmortem", "post-mortem", "post mortem", "outage", "sev1", "sev2"}
+	oneOnOneKeywords = []string{"1:1", "1-1", "1on1", "one-on-one", "one on one"}
+)
+
+// Meeting is a calendar event with other people or a video call
+type Meeting struct {
+	Event
+	Response string // The user's response, or empty when the user is not listed as an attendee
+	Attended bool
+	Category string
+}
+
+// Meetings returns the meetings among events, marking the ones the user attended. Events
+// without other attendees or a video call link are personal blocks and are left out.
+// A meeting counts as attended when it has ended by now, lasted a while, and the user
+// organized it, accepted it, or is not on the guest list (their own event); declined,
+// tentative and unanswered invitations do not count. email identifies the user.
+func Meetings(events []Event, email string, now time.Time) []Meeting {
+	email = strings.ToLower(email)
+
+	var meetings []Meeting
+	for _, event := range events {
+		others := 0
+		response := ""
+		for _, attendee := range event.Attendees {
+			if email != "" && attendee.Email == email {
+				response = attendee.Response
+			} else {
+				others++
+			}
+		}
+		if others == 0 && event.Conference == "" {
+			continue
+		}
+
+		organizer := email != "" && event.Organizer == email
+		confirmed := organizer || response == "" || response == "ACCEPTED"
+
+		meetings = append(meetings, Meeting{
+			Event:    event,
+			Response: response,
+			Attended: confirmed && event.Duration() > 0 && !event.End.After(now),
+			Category: meetingCategory(event),
+		})
+	}
+
+	return meetings
+}
+
+// meetingCategory classifies a meeting from its title and recurrence
+func meetingCategory(event Event) string {
+	title := strings.ToLower(event.Summary)
+	switch {
+	case containsAny(title, incidentKeywords):
+		return CategoryIncidentReview
+	case containsAny(title, oneOnOneKeywords):
+		return CategoryOneOnOne
+	case event.Recurring():
+		return CategoryRecurring
+	default:
+		return CategoryAdHoc
+	}
+}
+
+// SummarizeAttendance describes the time spent in attended meetings, e.g.
+// "3h in meetings (2 recurring, 1 incident review)". It returns "" when none were attended.
+func SummarizeAttendance(meetings []Meeting) string {
+	var total time.Duration
+	counts := make(map[string]int)
+	for _, meeting := range meetings {
+		if !meeting.Attended {
+			continue
+		}
+		total += meeting.Duration()
+		counts[meeting.Category]++
+	}
+	if len(counts) == 0 {
+		return ""
+	}
+
+	var parts []string
+	for _, category := range meetingCategories {
+		if counts[category] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[category], category))
+		}
+	}
+
+	return fmt.Sprintf("%s in meetings (%s)", formatHours(total), strings.Join(parts, ", "))
+}
+
+// formatHours formats a duration as hours, e.g. "3h", "1.5h" or "45m"
+func formatHours(d time.Duration) string {
+	if d < time.Hour {
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	}
+	hours := math.Round(d.Hours()*10) / 10
+	return strings.TrimSuffix(fmt.Sprintf("%.1f", hours), ".0") + "h"
+}
+
+func containsAny(value string, substrings []string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(value, substring) {
+			return true
+		}
+	}
+	return false
+}
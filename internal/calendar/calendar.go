@@ -0,0 +1,312 @@
+package calendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Event represents a single calendar event
+type Event struct {
+	UID          string
+	Summary      string
+	Start        time.Time
+	End          time.Time
+	AllDay       bool
+	Recurrence   string      // RRULE of a recurring event
+	RecurrenceID time.Time   // Original start of a changed occurrence of a recurring event
+	ExDates      []time.Time // Occurrences removed from a recurring event
+	Organizer    string      // Organizer email address
+	Attendees    []Attendee
+	Conference   string // Zoom, Google Meet or Microsoft Teams link, if any
+}
+
+// Attendee is a person invited to an event
+type Attendee struct {
+	Email    string
+	Name     string
+	Response string // PARTSTAT: ACCEPTED, DECLINED, TENTATIVE or NEEDS-ACTION
+}
+
+// conferencePattern matches video call links in event locations and descriptions
+var conferencePattern = regexp.MustCompile(`https://[^\s"<>]*(zoom\.us|meet\.google\.com|teams\.microsoft\.com|teams\.live\.com)[^\s"<>]*`)
+
+// Duration returns the length of the event
+func (e Event) Duration() time.Duration {
+	return e.End.Sub(e.Start)
+}
+
+// Load reads events from an iCalendar source, either a local file path or an http(s) URL
+// (e.g. the "secret address in iCal format" exported by Google Calendar or Outlook)
+func Load(source string) ([]Event, error) {
+	if source == "" {
+		return nil, fmt.Errorf("calendar source not configured")
+	}
+
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") || strings.HasPrefix(source, "webcal://") {
+		url := strings.Replace(source, "webcal://", "https://", 1)
+
+		client := &http.Client{Timeout: 30 * time.Second}
+		resp, err := client.Get(url)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch calendar: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to fetch calendar: status %d", resp.StatusCode)
+		}
+
+		return ParseICS(resp.Body)
+	}
+
+	path := source
+	if strings.HasPrefix(path, "~/") {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get home directory: %w", err)
+		}
+		path = filepath.Join(homeDir, path[2:])
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open calendar file: %w", err)
+	}
+	defer file.Close()
+
+	return ParseICS(file)
+}
+
+// ParseICS parses VEVENT entries from iCalendar data.
+// Recurring events are returned once, with their rule; EventsOn expands them.
+// Cancelled events are skipped, and cancelled occurrences are removed from their series.
+func ParseICS(r io.Reader) ([]Event, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read calendar: %w", err)
+	}
+
+	var events []Event
+	var current *Event
+	cancelled := false
+	cancelledOccurrences := make(map[string][]time.Time)
+
+	for _, line := range lines {
+		name, params, value := parseContentLine(line)
+
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			current = &Event{}
+			cancelled = false
+		case name == "END" && value == "VEVENT":
+			if current != nil && cancelled && !current.RecurrenceID.IsZero() {
+				cancelledOccurrences[current.UID] = append(cancelledOccurrences[current.UID], current.RecurrenceID)
+			}
+			if current != nil && !cancelled && !current.Start.IsZero() {
+				if current.End.IsZero() {
+					if current.AllDay {
+						current.End = current.Start.Add(24 * time.Hour)
+					} else {
+						current.End = current.Start
+					}
+				}
+				events = append(events, *current)
+			}
+			current = nil
+		case current == nil:
+			continue
+		case name == "UID":
+			current.UID = value
+		case name == "SUMMARY":
+			current.Summary = unescapeText(value)
+		case name == "STATUS":
+			cancelled = strings.EqualFold(value, "CANCELLED")
+		case name == "DTSTART":
+			start, allDay, err := parseDateTime(value, params)
+			if err != nil {
+				return nil, fmt.Errorf("invalid DTSTART %q: %w", value, err)
+			}
+			current.Start = start
+			current.AllDay = allDay
+		case name == "DTEND":
+			end, _, err := parseDateTime(value, params)
+			if err != nil {
+				return nil, fmt.Errorf("invalid DTEND %q: %w", value, err)
+			}
+			current.End = end
+		case name == "RRULE":
+			current.Recurrence = value
+		case name == "RECURRENCE-ID":
+			recurrenceID, _, err := parseDateTime(value, params)
+			if err != nil {
+				return nil, fmt.Errorf("invalid RECURRENCE-ID %q: %w", value, err)
+			}
+			current.RecurrenceID = recurrenceID
+		case name == "EXDATE":
+			for _, date := range strings.Split(value, ",") {
+				if exDate, _, err := parseDateTime(date, params); err == nil {
+					current.ExDates = append(current.ExDates, exDate)
+				}
+			}
+		case name == "ORGANIZER":
+			current.Organizer = mailAddress(value)
+		case name == "ATTENDEE":
+			response := strings.ToUpper(params["PARTSTAT"])
+			if response == "" {
+				response = "NEEDS-ACTION"
+			}
+			current.Attendees = append(current.Attendees, Attendee{Email: mailAddress(value), Name: params["CN"], Response: response})
+		case name == "LOCATION" || name == "DESCRIPTION" || name == "URL" || name == "X-GOOGLE-CONFERENCE":
+			if current.Conference == "" {
+				current.Conference = conferencePattern.FindString(unescapeText(value))
+			}
+		}
+	}
+
+	for i := range events {
+		if events[i].Recurrence != "" {
+			events[i].ExDates = append(events[i].ExDates, cancelledOccurrences[events[i].UID]...)
+		}
+	}
+
+	return events, nil
+}
+
+// Recurring reports whether the event is, or is an occurrence of, a recurring event
+func (e Event) Recurring() bool {
+	return e.Recurrence != "" || !e.RecurrenceID.IsZero()
+}
+
+// EventsOn returns the timed events that start on the same calendar day as date. Recurring
+// events are expanded: their occurrence on that day is returned with its own start and end.
+func EventsOn(events []Event, date time.Time) []Event {
+	// Changed occurrences are separate events that replace the series' original occurrence
+	changed := make(map[string][]time.Time)
+	for _, event := range events {
+		if !event.RecurrenceID.IsZero() {
+			changed[event.UID] = append(changed[event.UID], event.RecurrenceID)
+		}
+	}
+
+	var result []Event
+	for _, event := range events {
+		if event.AllDay {
+			continue
+		}
+		if event.Recurrence == "" {
+			if sameDay(event.Start, date) {
+				result = append(result, event)
+			}
+			continue
+		}
+
+		start, ok := occurrenceOn(event, date)
+		if !ok || containsTime(changed[event.UID], start) {
+			continue
+		}
+		occurrence := event
+		occurrence.Start = start
+		occurrence.End = start.Add(event.Duration())
+		result = append(result, occurrence)
+	}
+
+	return result
+}
+
+// sameDay reports whether t falls on the calendar day of date, in date's time zone
+func sameDay(t, date time.Time) bool {
+	year, month, day := date.Date()
+	y, m, d := t.In(date.Location()).Date()
+	return y == year && m == month && d == day
+}
+
+func containsTime(times []time.Time, t time.Time) bool {
+	for _, candidate := range times {
+		if candidate.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// mailAddress strips the mailto: prefix from a calendar address
+func mailAddress(value string) string {
+	if len(value) >= len("mailto:") && strings.EqualFold(value[:len("mailto:")], "mailto:") {
+		value = value[len("mailto:"):]
+	}
+	return strings.ToLower(value)
+}
+
+// unfoldLines joins folded iCalendar lines (continuations start with a space or tab)
+func unfoldLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, scanner.Err()
+}
+
+// parseContentLine splits "NAME;PARAM=VALUE:value" into its parts
+func parseContentLine(line string) (string, map[string]string, string) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return strings.ToUpper(line), nil, ""
+	}
+
+	head, value := line[:colon], line[colon+1:]
+	parts := strings.Split(head, ";")
+
+	params := make(map[string]string)
+	for _, param := range parts[1:] {
+		if key, val, ok := strings.Cut(param, "="); ok {
+			params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+		}
+	}
+
+	return strings.ToUpper(parts[0]), params, value
+}
+
+// parseDateTime parses DATE and DATE-TIME values, honouring TZID when present
+func parseDateTime(value string, params map[string]string) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len("20060102") {
+		t, err := time.ParseInLocation("20060102", value, time.Local)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err
+	}
+
+	location := time.Local
+	if tzid := params["TZID"]; tzid != "" {
+		if loc, err := time.LoadLocation(tzid); err == nil {
+			location = loc
+		}
+	}
+
+	t, err := time.ParseInLocation("20060102T150405", value, location)
+	return t, false, err
+}
+
+// unescapeText reverses iCalendar TEXT escaping
+func unescapeText(value string) string {
+	replacer := strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`)
+	return strings.TrimSpace(replacer.Replace(value))
+}
@@ -0,0 +1,236 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+const testICS = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:1\r\n" +
+	"SUMMARY:OPS-101 runner pairing\r\n" +
+	"DTSTART:20240715T090000Z\r\n" +
+	"DTEND:20240715T093000Z\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:2\r\n" +
+	"SUMMARY:Terraform state credentials\r\n" +
+	"  rotation planning\r\n" +
+	"DTSTART;TZID=UTC:20240715T140000\r\n" +
+	"DTEND;TZID=UTC:20240715T150000\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:3\r\n" +
+	"SUMMARY:Cancelled standup\r\n" +
+	"STATUS:CANCELLED\r\n" +
+	"DTSTART:20240715T100000Z\r\n" +
+	"DTEND:20240715T101500Z\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:4\r\n" +
+	"SUMMARY:Company holiday\r\n" +
+	"DTSTART;VALUE=DATE:20240715\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseICS(t *testing.T) {
+	events, err := ParseICS(strings.NewReader(testICS))
+	if err != nil {
+		t.Fatalf("ParseICS() error = %v", err)
+	}
+
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events (cancelled one skipped), got %d", len(events))
+	}
+
+	if events[0].Summary != "OPS-101 runner pairing" || events[0].Duration() != 30*time.Minute {
+		t.Errorf("Unexpected first event: %+v", events[0])
+	}
+
+	if events[1].Summary != "Terraform state credentials rotation planning" {
+		t.Errorf("Expected folded summary to be joined, got %q", events[1].Summary)
+	}
+
+	if !events[2].AllDay {
+		t.Errorf("Expected all-day event, got %+v", events[2])
+	}
+
+	day := time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC)
+	if timed := EventsOn(events, day); len(timed) != 2 {
+		t.Errorf("EventsOn() returned %d events, expected 2 timed events", len(timed))
+	}
+}
+
+func TestMatchIssue(t *testing.T) {
+	issues := []jira.Issue{
+		{Key: "OPS-101", Fields: jira.Fields{Summary: "Migrate CI runners to Kubernetes"}},
+		{Key: "OPS-102", Fields: jira.Fields{Summary: "Rotate Terraform state bucket credentials"}},
+	}
+
+	tests := []struct {
+		name        string
+		summary     string
+		expectedKey string
+	}{
+		{"Explicit issue key", "OPS-101 runner pairing", "OPS-101"},
+		{"Keyword overlap", "Terraform state credentials rotation planning", "OPS-102"},
+		{"Single shared keyword is not enough", "Kubernetes office hours", ""},
+		{"No overlap", "Lunch", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue, _ := MatchIssue(Event{Summary: tt.summary}, issues)
+
+			key := ""
+			if issue != nil {
+				key = issue.Key
+			}
+			if key != tt.expectedKey {
+				t.Errorf("MatchIssue() = %q, expected %q", key, tt.expectedKey)
+			}
+		})
+	}
+}
+
+const meetingsICS = "BEGIN:VCALENDAR\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:standup\r\n" +
+	"SUMMARY:Team standup\r\n" +
+	"DTSTART:20240701T090000Z\r\n" +
+	"DTEND:20240701T091500Z\r\n" +
+	"RRULE:FREQ=WEEKLY;BYDAY=MO,WE,FR\r\n" +
+	"EXDATE:20240717T090000Z\r\n" +
+	"ORGANIZER:mailto:lead@example.com\r\n" +
+	"ATTENDEE;PARTSTAT=ACCEPTED;CN=Alex:mailto:alex@example.com\r\n" +
+	"ATTENDEE;PARTSTAT=ACCEPTED:mailto:lead@example.com\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:planning\r\n" +
+	"SUMMARY:Sprint planning\r\n" +
+	"DTSTART:20240701T130000Z\r\n" +
+	"DTEND:20240701T140000Z\r\n" +
+	"RRULE:FREQ=WEEKLY;INTERVAL=2;UNTIL=20240731T000000Z\r\n" +
+	"ATTENDEE;PARTSTAT=ACCEPTED:mailto:alex@example.com\r\n" +
+	"ATTENDEE;PARTSTAT=ACCEPTED:mailto:lead@example.com\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:planning\r\n" +
+	"RECURRENCE-ID:20240715T130000Z\r\n" +
+	"SUMMARY:Sprint planning (moved)\r\n" +
+	"DTSTART:20240715T150000Z\r\n" +
+	"DTEND:20240715T160000Z\r\n" +
+	"ATTENDEE;PARTSTAT=ACCEPTED:mailto:alex@example.com\r\n" +
+	"ATTENDEE;PARTSTAT=ACCEPTED:mailto:lead@example.com\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:postmortem\r\n" +
+	"SUMMARY:Incident review: runner outage\r\n" +
+	"DTSTART:20240715T110000Z\r\n" +
+	"DTEND:20240715T120000Z\r\n" +
+	"ORGANIZER:mailto:alex@example.com\r\n" +
+	"ATTENDEE;PARTSTAT=NEEDS-ACTION:mailto:alex@example.com\r\n" +
+	"ATTENDEE;PARTSTAT=ACCEPTED:mailto:sre@example.com\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:vendor\r\n" +
+	"SUMMARY:Vendor demo\r\n" +
+	"DTSTART:20240715T100000Z\r\n" +
+	"DTEND:20240715T103000Z\r\n" +
+	"LOCATION:https://acme.zoom.us/j/123456\r\n" +
+	"ATTENDEE;PARTSTAT=DECLINED:mailto:alex@example.com\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:focus\r\n" +
+	"SUMMARY:Focus time\r\n" +
+	"DTSTART:20240715T140000Z\r\n" +
+	"DTEND:20240715T150000Z\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:retro\r\n" +
+	"SUMMARY:Retro\r\n" +
+	"DTSTART:20240715T170000Z\r\n" +
+	"DTEND:20240715T180000Z\r\n" +
+	"ATTENDEE;PARTSTAT=ACCEPTED:mailto:alex@example.com\r\n" +
+	"ATTENDEE;PARTSTAT=ACCEPTED:mailto:lead@example.com\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestEventsOnExpandsRecurringEvents(t *testing.T) {
+	events, err := ParseICS(strings.NewReader(meetingsICS))
+	if err != nil {
+		t.Fatalf("ParseICS() error = %v", err)
+	}
+
+	titles := func(date time.Time) []string {
+		var result []string
+		for _, event := range EventsOn(events, date) {
+			result = append(result, event.Summary+"@"+event.Start.UTC().Format("15:04"))
+		}
+		return result
+	}
+
+	tests := []struct {
+		date     time.Time
+		expected string
+	}{
+		// The planning occurrence was moved to 15:00
+		{time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC), "Team standup@09:00,Sprint planning (moved)@15:00,Incident review: runner outage@11:00,Vendor demo@10:00,Focus time@14:00,Retro@17:00"},
+		{time.Date(2024, 7, 16, 0, 0, 0, 0, time.UTC), ""},
+		// Excluded standup
+		{time.Date(2024, 7, 17, 0, 0, 0, 0, time.UTC), ""},
+		{time.Date(2024, 7, 19, 0, 0, 0, 0, time.UTC), "Team standup@09:00"},
+		// Every other Monday until the end of July
+		{time.Date(2024, 7, 29, 0, 0, 0, 0, time.UTC), "Team standup@09:00,Sprint planning@13:00"},
+		{time.Date(2024, 7, 22, 0, 0, 0, 0, time.UTC), "Team standup@09:00"},
+		{time.Date(2024, 8, 12, 0, 0, 0, 0, time.UTC), "Team standup@09:00"},
+	}
+
+	for _, tt := range tests {
+		if got := strings.Join(titles(tt.date), ","); got != tt.expected {
+			t.Errorf("EventsOn(%s) = %q, expected %q", tt.date.Format("2006-01-02"), got, tt.expected)
+		}
+	}
+}
+
+func TestMeetingsAttendance(t *testing.T) {
+	events, err := ParseICS(strings.NewReader(meetingsICS))
+	if err != nil {
+		t.Fatalf("ParseICS() error = %v", err)
+	}
+
+	day := time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 7, 15, 16, 30, 0, 0, time.UTC)
+	meetings := Meetings(EventsOn(events, day), "Alex@example.com", now)
+
+	attended := make(map[string]bool)
+	for _, meeting := range meetings {
+		attended[meeting.Summary] = meeting.Attended
+	}
+	expected := map[string]bool{
+		"Team standup":                   true,
+		"Sprint planning (moved)":        true,
+		"Incident review: runner outage": true,  // Organized by the user
+		"Vendor demo":                    false, // Declined
+		"Retro":                          false, // Not over yet
+	}
+	if len(attended) != len(expected) {
+		t.Errorf("expected focus time to be left out, got %+v", attended)
+	}
+	for summary, want := range expected {
+		if attended[summary] != want {
+			t.Errorf("%s: attended = %t, expected %t", summary, attended[summary], want)
+		}
+	}
+
+	if summary := SummarizeAttendance(meetings); summary != "2.3h in meetings (2 recurring, 1 incident review)" {
+		t.Errorf("SummarizeAttendance() = %q", summary)
+	}
+	if summary := SummarizeAttendance(nil); summary != "" {
+		t.Errorf("expected no summary without meetings, got %q", summary)
+	}
+}
@@ -0,0 +1,95 @@
+package calendar
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"my-day/internal/jira"
+)
+
+// minKeywordMatches is the number of shared title keywords needed to propose an issue
+const minKeywordMatches = 2
+
+// issueKeyPattern matches Jira issue keys such as OPS-123 in event titles
+var issueKeyPattern = regexp.MustCompile(`\b[A-Z][A-Z0-9]+-\d+\b`)
+
+// stopWords are ignored when comparing event and issue titles
+var stopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "from": true, "into": true,
+	"meeting": true, "sync": true, "call": true, "weekly": true, "daily": true,
+	"review": true, "chat": true, "about": true, "our": true, "team": true,
+}
+
+// Proposal is a suggested worklog for a calendar event
+type Proposal struct {
+	Event Event
+	Issue *jira.Issue // nil when no issue matched
+	Score int         // Number of shared keywords, or -1 for an explicit issue key
+}
+
+// ProposeWorklogs matches each event to the best issue by explicit issue key or title keywords
+func ProposeWorklogs(events []Event, issues []jira.Issue) []Proposal {
+	var proposals []Proposal
+	for _, event := range events {
+		issue, score := MatchIssue(event, issues)
+		proposals = append(proposals, Proposal{Event: event, Issue: issue, Score: score})
+	}
+	return proposals
+}
+
+// MatchIssue finds the issue that best matches an event title.
+// An issue key in the title always wins; otherwise the issue sharing the most keywords is
+// returned if it shares at least minKeywordMatches of them.
+func MatchIssue(event Event, issues []jira.Issue) (*jira.Issue, int) {
+	for _, key := range issueKeyPattern.FindAllString(event.Summary, -1) {
+		for i := range issues {
+			if issues[i].Key == key {
+				return &issues[i], -1
+			}
+		}
+	}
+
+	eventWords := keywords(event.Summary)
+	if len(eventWords) == 0 {
+		return nil, 0
+	}
+
+	var best *jira.Issue
+	bestScore := 0
+	for i := range issues {
+		score := 0
+		for word := range keywords(issues[i].Fields.Summary) {
+			if eventWords[word] {
+				score++
+			}
+		}
+		if score > bestScore {
+			best = &issues[i]
+			bestScore = score
+		}
+	}
+
+	if bestScore < minKeywordMatches {
+		return nil, bestScore
+	}
+
+	return best, bestScore
+}
+
+// keywords returns the set of significant lowercase words in a title
+func keywords(title string) map[string]bool {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	result := make(map[string]bool)
+	for _, word := range words {
+		if len(word) < 3 || stopWords[word] {
+			continue
+		}
+		result[word] = true
+	}
+
+	return result
+}
@@ -0,0 +1,139 @@
+package calendar
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// weekdays maps iCalendar BYDAY codes to weekdays
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// recurrenceRule is the subset of an RRULE that occurrenceOn understands
+type recurrenceRule struct {
+	freq     string
+	interval int
+	count    int
+	until    time.Time
+	byDay    []time.Weekday
+}
+
+// parseRule parses an RRULE value such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE"
+func parseRule(value string) recurrenceRule {
+	rule := recurrenceRule{interval: 1}
+	for _, part := range strings.Split(value, ";") {
+		key, val, _ := strings.Cut(part, "=")
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.freq = strings.ToUpper(val)
+		case "INTERVAL":
+			if interval, err := strconv.Atoi(val); err == nil && interval > 0 {
+				rule.interval = interval
+			}
+		case "COUNT":
+			rule.count, _ = strconv.Atoi(val)
+		case "UNTIL":
+			until, allDay, err := parseDateTime(val, nil)
+			if err == nil {
+				if allDay {
+					until = until.Add(24*time.Hour - time.Second)
+				}
+				rule.until = until
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(val, ",") {
+				// Ordinal prefixes such as "1MO" only apply to monthly rules, which use the start day
+				if len(day) >= 2 {
+					if weekday, ok := weekdays[strings.ToUpper(day[len(day)-2:])]; ok {
+						rule.byDay = append(rule.byDay, weekday)
+					}
+				}
+			}
+		}
+	}
+	return rule
+}
+
+// occurrenceOn returns the start of the occurrence of a recurring event on the given day.
+// DAILY, WEEKLY (with BYDAY), MONTHLY and YEARLY rules are supported with INTERVAL, COUNT,
+// UNTIL and EXDATE; monthly and yearly events repeat on the day of their first occurrence.
+func occurrenceOn(event Event, date time.Time) (time.Time, bool) {
+	rule := parseRule(event.Recurrence)
+	dayEnd := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location()).AddDate(0, 0, 1)
+	if !event.Start.Before(dayEnd) {
+		return time.Time{}, false
+	}
+
+	count := 0
+	for period := 0; ; period++ {
+		candidates, ok := periodOccurrences(event.Start, rule, period)
+		if !ok {
+			return time.Time{}, false
+		}
+
+		for _, candidate := range candidates {
+			if !rule.until.IsZero() && candidate.After(rule.until) {
+				return time.Time{}, false
+			}
+			count++
+			if rule.count > 0 && count > rule.count {
+				return time.Time{}, false
+			}
+			if !candidate.Before(dayEnd) {
+				return time.Time{}, false
+			}
+			if sameDay(candidate, date) {
+				return candidate, !containsTime(event.ExDates, candidate)
+			}
+		}
+	}
+}
+
+// periodOccurrences returns the occurrences in the nth period (day, week, month or year) of
+// a rule, in order. It returns false for unsupported rules.
+func periodOccurrences(start time.Time, rule recurrenceRule, period int) ([]time.Time, bool) {
+	step := period * rule.interval
+	switch rule.freq {
+	case "DAILY":
+		return []time.Time{start.AddDate(0, 0, step)}, true
+	case "WEEKLY":
+		if len(rule.byDay) == 0 {
+			return []time.Time{start.AddDate(0, 0, 7*step)}, true
+		}
+		// Weeks start on Monday; list the rule's days of this week in order
+		weekStart := start.AddDate(0, 0, 7*step-(int(start.Weekday())+6)%7)
+		var occurrences []time.Time
+		for offset := 0; offset < 7; offset++ {
+			candidate := weekStart.AddDate(0, 0, offset)
+			if containsWeekday(rule.byDay, candidate.Weekday()) && !candidate.Before(start) {
+				occurrences = append(occurrences, candidate)
+			}
+		}
+		return occurrences, true
+	case "MONTHLY":
+		// Months without the start day (e.g. the 31st) have no occurrence
+		if candidate := start.AddDate(0, step, 0); candidate.Day() == start.Day() {
+			return []time.Time{candidate}, true
+		}
+		return nil, true
+	case "YEARLY":
+		if candidate := start.AddDate(step, 0, 0); candidate.Day() == start.Day() {
+			return []time.Time{candidate}, true
+		}
+		return nil, true
+	default:
+		return nil, false
+	}
+}
+
+func containsWeekday(days []time.Weekday, day time.Weekday) bool {
+	for _, d := range days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+// Package clipboard places text on the system clipboard with the copy tool of the operating
+// system (pbcopy on macOS, wl-copy, xclip or xsel on Linux, clip on Windows).
+package clipboard
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Copy places text on the system clipboard
+func Copy(text string) error {
+	args, err := copyCommand(runtime.GOOS, os.Getenv("WAYLAND_DISPLAY") != "", exec.LookPath)
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		if message := strings.TrimSpace(string(output)); message != "" {
+			return fmt.Errorf("%s failed: %s", args[0], message)
+		}
+		return fmt.Errorf("%s failed: %w", args[0], err)
+	}
+	return nil
+}
+
+// copyCommand returns the first copy tool found of the operating system goos, with the
+// arguments that make it read the text from standard input. Wayland sessions prefer wl-copy.
+func copyCommand(goos string, wayland bool, lookPath func(string) (string, error)) ([]string, error) {
+	var candidates [][]string
+	switch goos {
+	case "darwin":
+		candidates = [][]string{{"pbcopy"}}
+	case "windows":
+		candidates = [][]string{{"clip"}}
+	default:
+		candidates = [][]string{{"xclip", "-selection", "clipboard"}, {"xsel", "--clipboard", "--input"}}
+		if wayland {
+			candidates = append([][]string{{"wl-copy"}}, candidates...)
+		}
+	}
+
+	var tools []string
+	for _, args := range candidates {
+		if _, err := lookPath(args[0]); err == nil {
+			return args, nil
+		}
+		tools = append(tools, args[0])
+	}
+	return nil, fmt.Errorf("no clipboard tool found (looked for %s)", strings.Join(tools, ", "))
+}
@@ -0,0 +1,54 @@
+package clipboard
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// installed returns a lookPath finding only the given tools
+func installed(tools ...string) func(string) (string, error) {
+	return func(name string) (string, error) {
+		for _, tool := range tools {
+			if tool == name {
+				return "/usr/bin/" + name, nil
+			}
+		}
+		return "", errors.New("not found")
+	}
+}
+
+func TestCopyCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		goos    string
+		wayland bool
+		tools   []string
+		want    string
+	}{
+		{"macOS", "darwin", false, []string{"pbcopy"}, "pbcopy"},
+		{"Windows", "windows", false, []string{"clip"}, "clip"},
+		{"X11", "linux", false, []string{"wl-copy", "xclip", "xsel"}, "xclip -selection clipboard"},
+		{"X11 with xsel only", "linux", false, []string{"xsel"}, "xsel --clipboard --input"},
+		{"Wayland", "linux", true, []string{"wl-copy", "xclip"}, "wl-copy"},
+		{"Wayland without wl-copy", "linux", true, []string{"xclip"}, "xclip -selection clipboard"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, err := copyCommand(tt.goos, tt.wayland, installed(tt.tools...))
+			if err != nil {
+				t.Fatalf("copyCommand() error = %v", err)
+			}
+			if got := strings.Join(args, " "); got != tt.want {
+				t.Errorf("copyCommand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCopyCommandWithoutTool(t *testing.T) {
+	_, err := copyCommand("linux", true, installed())
+	if err == nil || !strings.Contains(err.Error(), "wl-copy, xclip, xsel") {
+		t.Errorf("expected an error listing the tools looked for, got %v", err)
+	}
+}
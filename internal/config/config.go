@@ -1,24 +1,65 @@
 package config
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/spf13/viper"
 )
 
 // Config represents the application configuration
 type Config struct {
-	Jira   JiraConfig   `mapstructure:"jira" yaml:"jira"`
-	GitHub GitHubConfig `mapstructure:"github" yaml:"github"`
-	LLM    LLMConfig    `mapstructure:"llm" yaml:"llm"`
-	Report ReportConfig `mapstructure:"report" yaml:"report"`
+	Tracker      string                   `mapstructure:"tracker" yaml:"tracker"` // Issue source: jira or github
+	Jira         JiraConfig               `mapstructure:"jira" yaml:"jira"`
+	GitHub       GitHubConfig             `mapstructure:"github" yaml:"github"`
+	GitLab       GitLabConfig             `mapstructure:"gitlab" yaml:"gitlab"`
+	Trello       TrelloConfig             `mapstructure:"trello" yaml:"trello"`
+	Asana        AsanaConfig              `mapstructure:"asana" yaml:"asana"`
+	TimeTracking TimeTrackingConfig       `mapstructure:"time_tracking" yaml:"time_tracking"`
+	Tempo        TempoConfig              `mapstructure:"tempo" yaml:"tempo"`
+	LLM          LLMConfig                `mapstructure:"llm" yaml:"llm"`
+	Report       ReportConfig             `mapstructure:"report" yaml:"report"`
+	Projects     map[string]ProjectConfig `mapstructure:"projects" yaml:"projects,omitempty"` // Settings of a Jira project by key, e.g. DAT
+	Calendar     CalendarConfig           `mapstructure:"calendar" yaml:"calendar"`
+	SyncState    SyncStateConfig          `mapstructure:"sync_state" yaml:"sync_state"`
+	Slack        SlackConfig              `mapstructure:"slack" yaml:"slack"`
+	Handoff      HandoffConfig            `mapstructure:"handoff" yaml:"handoff"`
+	TTS          TTSConfig                `mapstructure:"tts" yaml:"tts"`
+	Daemon       DaemonConfig             `mapstructure:"daemon" yaml:"daemon"`
+	Metrics      MetricsConfig            `mapstructure:"metrics" yaml:"metrics"`
 }
 
 // JiraConfig represents Jira configuration
 type JiraConfig struct {
-	BaseURL      string                 `mapstructure:"base_url" yaml:"base_url"`
-	Email        string                 `mapstructure:"email" yaml:"email"`
-	Token        string                 `mapstructure:"token" yaml:"token"`
-	Projects     []string               `mapstructure:"projects" yaml:"projects"`
-	CustomFields map[string]CustomField `mapstructure:"custom_fields" yaml:"custom_fields"`
+	BaseURL          string                 `mapstructure:"base_url" yaml:"base_url"`
+	Email            string                 `mapstructure:"email" yaml:"email"`
+	Token            string                 `mapstructure:"token" yaml:"token"`
+	Deployment       string                 `mapstructure:"deployment" yaml:"deployment"` // cloud, server (Server/Data Center) or auto to detect
+	Projects         []string               `mapstructure:"projects" yaml:"projects"`
+	BoardID          int                    `mapstructure:"board_id" yaml:"board_id"` // Agile board used for --group-by column (0 to disable)
+	MaxResults       int                    `mapstructure:"max_results" yaml:"max_results"` // Hard cap on issues fetched per search across all pages (0 for no limit)
+	LowBandwidth     bool                   `mapstructure:"low_bandwidth" yaml:"low_bandwidth"`
+	MaxCommentLength int                    `mapstructure:"max_comment_length" yaml:"max_comment_length"` // Longer comment bodies are skipped in low-bandwidth mode (0 for no limit)
+	CommentAuthors   []string               `mapstructure:"comment_authors" yaml:"comment_authors"` // Account IDs whose comments are synced besides yours
+	AllComments      bool                   `mapstructure:"all_comments" yaml:"all_comments"`       // Sync everyone's comments, not only yours
+	CustomFields     map[string]CustomField `mapstructure:"custom_fields" yaml:"custom_fields"`
+	Team             TeamConfig             `mapstructure:"team" yaml:"team"`
+	OAuth            JiraOAuthConfig        `mapstructure:"oauth" yaml:"oauth"`
+	Profiles         map[string]JiraConfig  `mapstructure:"profiles" yaml:"profiles,omitempty"` // Named instances selected with --profile, overriding these settings
+}
+
+// TeamConfig lists the members of the team 'my-day report team' reports on
+type TeamConfig struct {
+	Members []string `mapstructure:"members" yaml:"members"` // Account IDs, or usernames on Jira Server/Data Center
+	Group   string   `mapstructure:"group" yaml:"group"`     // Jira group whose members are added to the team
+}
+
+// JiraOAuthConfig represents the Atlassian OAuth 2.0 (3LO) app used by 'my-day auth login'
+type JiraOAuthConfig struct {
+	ClientID     string `mapstructure:"client_id" yaml:"client_id"`
+	ClientSecret string `mapstructure:"client_secret" yaml:"client_secret"`
+	CallbackPort int    `mapstructure:"callback_port" yaml:"callback_port"` // Callback URL registered with the app is http://localhost:<port>/callback
 }
 
 // CustomField represents a custom field configuration
@@ -36,6 +77,48 @@ type GitHubConfig struct {
 	IncludePRs   bool     `mapstructure:"include_prs" yaml:"include_prs"`
 	IncludeCommits bool   `mapstructure:"include_commits" yaml:"include_commits"`
 	IncludeWorkflows bool `mapstructure:"include_workflows" yaml:"include_workflows"`
+	ProjectStatusField string `mapstructure:"project_status_field" yaml:"project_status_field"` // Projects field used as issue status when tracker is github
+}
+
+// GitLabConfig represents GitLab configuration (GitLab.com or self-hosted)
+type GitLabConfig struct {
+	Enabled              bool     `mapstructure:"enabled" yaml:"enabled"`
+	BaseURL              string   `mapstructure:"base_url" yaml:"base_url"`
+	Token                string   `mapstructure:"token" yaml:"token"`
+	Projects             []string `mapstructure:"projects" yaml:"projects"`
+	IncludeMergeRequests bool     `mapstructure:"include_merge_requests" yaml:"include_merge_requests"`
+	IncludeCommits       bool     `mapstructure:"include_commits" yaml:"include_commits"`
+	IncludePipelines     bool     `mapstructure:"include_pipelines" yaml:"include_pipelines"`
+}
+
+// TrelloConfig represents read-only Trello configuration
+type TrelloConfig struct {
+	Enabled bool     `mapstructure:"enabled" yaml:"enabled"`
+	APIKey  string   `mapstructure:"api_key" yaml:"api_key"`
+	Token   string   `mapstructure:"token" yaml:"token"`
+	Boards  []string `mapstructure:"boards" yaml:"boards"` // Board IDs, short links or names (empty = all boards)
+}
+
+// AsanaConfig represents read-only Asana configuration
+type AsanaConfig struct {
+	Enabled   bool     `mapstructure:"enabled" yaml:"enabled"`
+	Token     string   `mapstructure:"token" yaml:"token"`         // Personal access token
+	Workspace string   `mapstructure:"workspace" yaml:"workspace"` // Workspace GID (empty = all workspaces)
+	Projects  []string `mapstructure:"projects" yaml:"projects"`   // Project GIDs or names (empty = all projects)
+}
+
+// TimeTrackingConfig represents the time tracker that time entries are imported from
+type TimeTrackingConfig struct {
+	Provider  string `mapstructure:"provider" yaml:"provider"`     // toggl or harvest (empty = disabled)
+	Token     string `mapstructure:"token" yaml:"token"`           // Toggl API token or Harvest personal access token
+	AccountID string `mapstructure:"account_id" yaml:"account_id"` // Harvest account ID
+}
+
+// TempoConfig represents Tempo Timesheets, which replaces native Jira worklogs when enabled
+type TempoConfig struct {
+	Enabled bool   `mapstructure:"enabled" yaml:"enabled"`
+	Token   string `mapstructure:"token" yaml:"token"`       // Tempo API token
+	BaseURL string `mapstructure:"base_url" yaml:"base_url"` // Tempo API base URL
 }
 
 // LLMConfig represents LLM configuration
@@ -45,17 +128,56 @@ type LLMConfig struct {
 	Model                    string       `mapstructure:"model" yaml:"model"`
 	Debug                    bool         `mapstructure:"debug" yaml:"debug"`
 	SummaryStyle             string       `mapstructure:"summary_style" yaml:"summary_style"`
+	Language                 string       `mapstructure:"language" yaml:"language"` // "auto" or a language name/code such as "es"
 	MaxSummaryLength         int          `mapstructure:"max_summary_length" yaml:"max_summary_length"`
 	IncludeTechnicalDetails  bool         `mapstructure:"include_technical_details" yaml:"include_technical_details"`
 	PrioritizeRecentWork     bool         `mapstructure:"prioritize_recent_work" yaml:"prioritize_recent_work"`
 	FallbackStrategy         string       `mapstructure:"fallback_strategy" yaml:"fallback_strategy"`
+	Concurrency              int          `mapstructure:"concurrency" yaml:"concurrency"` // Issues summarized at once in detailed reports
 	Ollama                   OllamaConfig `mapstructure:"ollama" yaml:"ollama"`
+	OpenAI                   OpenAIConfig `mapstructure:"openai" yaml:"openai"`
+	LocalOpenAI              LocalOpenAIConfig `mapstructure:"local_openai" yaml:"local_openai"`
+	Privacy                  PrivacyConfig `mapstructure:"privacy" yaml:"privacy"`
+}
+
+// ProjectConfig represents the settings of a single Jira project
+type ProjectConfig struct {
+	LLM ProjectLLMConfig `mapstructure:"llm" yaml:"llm"`
+}
+
+// ProjectLLMConfig overrides how the LLM writes about the issues of a project
+type ProjectLLMConfig struct {
+	SummaryStyle string `mapstructure:"summary_style" yaml:"summary_style"` // technical, business or brief; empty for llm.summary_style
+	Prompt       string `mapstructure:"prompt" yaml:"prompt"`               // Instructions added to the prompts about the project's issues
+}
+
+// PrivacyConfig controls what issues and comments are sent to a remote LLM backend
+type PrivacyConfig struct {
+	Redact          bool     `mapstructure:"redact" yaml:"redact"`                     // Remove emails, hostnames, IPs and secrets
+	Patterns        []string `mapstructure:"patterns" yaml:"patterns"`                 // Extra regular expressions to remove
+	AllowedProjects []string `mapstructure:"allowed_projects" yaml:"allowed_projects"` // Projects that may use remote models (empty = all)
 }
 
 // OllamaConfig represents Ollama-specific configuration
 type OllamaConfig struct {
+	BaseURL         string `mapstructure:"base_url" yaml:"base_url"`
+	Model           string `mapstructure:"model" yaml:"model"`
+	IdleStopMinutes int    `mapstructure:"idle_stop_minutes" yaml:"idle_stop_minutes"` // Stop the Docker container after this many idle minutes (0 = never)
+}
+
+// OpenAIConfig represents OpenAI-compatible API configuration (OpenAI, Azure OpenAI, OpenRouter)
+type OpenAIConfig struct {
+	BaseURL    string `mapstructure:"base_url" yaml:"base_url"`
+	APIKey     string `mapstructure:"api_key" yaml:"api_key"`
+	Model      string `mapstructure:"model" yaml:"model"`
+	APIVersion string `mapstructure:"api_version" yaml:"api_version"` // Azure OpenAI only
+}
+
+// LocalOpenAIConfig represents a local OpenAI-compatible server (LM Studio, llama-server)
+type LocalOpenAIConfig struct {
 	BaseURL string `mapstructure:"base_url" yaml:"base_url"`
-	Model   string `mapstructure:"model" yaml:"model"`
+	APIKey  string `mapstructure:"api_key" yaml:"api_key"` // Only when the server requires one
+	Model   string `mapstructure:"model" yaml:"model"`     // Empty for the model the server has loaded
 }
 
 // ReportConfig represents report generation configuration
@@ -64,7 +186,52 @@ type ReportConfig struct {
 	IncludeYesterday  bool         `mapstructure:"include_yesterday" yaml:"include_yesterday"`
 	IncludeToday      bool         `mapstructure:"include_today" yaml:"include_today"`
 	IncludeInProgress bool         `mapstructure:"include_in_progress" yaml:"include_in_progress"`
+	MaxCommentExcerpt int          `mapstructure:"max_comment_excerpt" yaml:"max_comment_excerpt"` // Runes of the latest comment shown in detailed mode (0 for no limit)
+	VarianceThreshold int          `mapstructure:"variance_threshold" yaml:"variance_threshold"`   // Percent time spent may exceed estimates before an issue is flagged
+	DuplicateSimilarity int        `mapstructure:"duplicate_similarity" yaml:"duplicate_similarity"` // Percent of words active issues must share to be flagged as possible duplicates (0 to turn off)
+	WorkdayHours      float64      `mapstructure:"workday_hours" yaml:"workday_hours"`             // Workday length utilization is measured against (0 to turn it off)
+	Risk              RiskConfig   `mapstructure:"risk" yaml:"risk"`
+	TemplatePath      string       `mapstructure:"template_path" yaml:"template_path"`             // Go text/template file reports are rendered with instead of the built-in layout
+	Themes            bool         `mapstructure:"themes" yaml:"themes"`                           // Structure the AI summary around 2-4 themes of the day's work
+	GroupSummaries    bool         `mapstructure:"group_summaries" yaml:"group_summaries"`         // Add an AI mini-summary of each group's comments to grouped reports
+	Structured        bool         `mapstructure:"structured" yaml:"structured"`                   // Render the AI summary from JSON with accomplishments, in progress, blockers and next steps
+	NextSteps         bool         `mapstructure:"next_steps" yaml:"next_steps"`                   // Add the next steps for tomorrow proposed by the LLM
+	Layout            string       `mapstructure:"layout" yaml:"layout"`                           // "default" (by status) or "standup" (Yesterday, Today and Blockers)
+	Statuses          map[string]StatusConfig `mapstructure:"statuses" yaml:"statuses"`         // Icon and label of Jira statuses by name, e.g. "En curso"
+	Sections          SectionsConfig `mapstructure:"sections" yaml:"sections"`
 	Export            ExportConfig `mapstructure:"export" yaml:"export"`
+	Email             EmailConfig  `mapstructure:"email" yaml:"email"`
+}
+
+// RiskConfig represents how in-progress issues are scored for the "At risk" list
+type RiskConfig struct {
+	MinScore int               `mapstructure:"min_score" yaml:"min_score"` // Score, 0 to 100, from which an issue is listed
+	Weights  RiskWeightsConfig `mapstructure:"weights" yaml:"weights"`
+}
+
+// RiskWeightsConfig represents the weights of the risk score factors (all zero to turn the list off)
+type RiskWeightsConfig struct {
+	Priority     int `mapstructure:"priority" yaml:"priority"`
+	DaysInStatus int `mapstructure:"days_in_status" yaml:"days_in_status"`
+	Blockers     int `mapstructure:"blockers" yaml:"blockers"`   // Unresolved "is blocked by" links
+	Sentiment    int `mapstructure:"sentiment" yaml:"sentiment"` // Share of negative comments
+}
+
+// SectionsConfig represents which built-in parts of the report are shown
+type SectionsConfig struct {
+	AISummary bool `mapstructure:"ai_summary" yaml:"ai_summary"`
+	Summary   bool `mapstructure:"summary" yaml:"summary"` // Issue, comment and worklog counts
+	Worklog   bool `mapstructure:"worklog" yaml:"worklog"`
+	ToDo      bool `mapstructure:"todo" yaml:"todo"`
+	Quality   bool `mapstructure:"quality" yaml:"quality"` // Quality indicators of --show-quality
+	Footer    bool `mapstructure:"footer" yaml:"footer"`
+}
+
+// StatusConfig represents how a Jira status is shown in reports. Empty fields keep the built-in
+// icon and the status name.
+type StatusConfig struct {
+	Icon  string `mapstructure:"icon" yaml:"icon"`
+	Label string `mapstructure:"label" yaml:"label"`
 }
 
 // ExportConfig represents export configuration
@@ -73,17 +240,134 @@ type ExportConfig struct {
 	FolderPath    string `mapstructure:"folder_path" yaml:"folder_path"`
 	FileNameDate  string `mapstructure:"filename_date" yaml:"filename_date"`
 	Tags          []string `mapstructure:"tags" yaml:"tags"`
+	Target        string   `mapstructure:"target" yaml:"target"` // obsidian (markdown files), confluence or notion
+	Confluence    ConfluenceExportConfig `mapstructure:"confluence" yaml:"confluence"`
+	Notion        NotionExportConfig     `mapstructure:"notion" yaml:"notion"`
+	DailyNote     DailyNoteExportConfig  `mapstructure:"daily_note" yaml:"daily_note"`
+	WikiLinks     bool                   `mapstructure:"wiki_links" yaml:"wiki_links"` // Render issue keys as [[KEY]] wiki-links
+	IssueNotes    IssueNotesExportConfig `mapstructure:"issue_notes" yaml:"issue_notes"`
+	Targets       ExportTargetsConfig    `mapstructure:"targets" yaml:"targets"` // Targets exported to in addition to target
+}
+
+// ExportTargetsConfig represents the export targets that run, all in one run, after the report
+// is generated
+type ExportTargetsConfig struct {
+	Obsidian   bool `mapstructure:"obsidian" yaml:"obsidian"`
+	Confluence bool `mapstructure:"confluence" yaml:"confluence"`
+	Notion     bool `mapstructure:"notion" yaml:"notion"`
+	Slack      bool `mapstructure:"slack" yaml:"slack"`         // Posts with the slack settings
+	Clipboard  bool `mapstructure:"clipboard" yaml:"clipboard"` // Copies the report to the system clipboard
+}
+
+// ConfluenceExportConfig represents where the Confluence export target publishes report pages
+type ConfluenceExportConfig struct {
+	BaseURL     string `mapstructure:"base_url" yaml:"base_url"` // Defaults to the Jira base URL + /wiki
+	SpaceKey    string `mapstructure:"space_key" yaml:"space_key"`
+	ParentID    string `mapstructure:"parent_id" yaml:"parent_id"` // Parent page ID (empty for the space root)
+	TitlePrefix string `mapstructure:"title_prefix" yaml:"title_prefix"`
+	Mode        string `mapstructure:"mode" yaml:"mode"`     // page (a page per report) or team (your section of a page shared by the team)
+	Member      string `mapstructure:"member" yaml:"member"` // Heading of your section of the team page (default: your Atlassian display name)
+}
+
+// NotionExportConfig represents the Notion database the Notion export target writes report pages to
+type NotionExportConfig struct {
+	DatabaseID string `mapstructure:"database_id" yaml:"database_id"`
+	Token      string `mapstructure:"token" yaml:"token"` // Internal integration secret
+}
+
+// DailyNoteExportConfig represents the Obsidian daily note the report is written into instead of
+// a note of its own
+type DailyNoteExportConfig struct {
+	Enabled bool   `mapstructure:"enabled" yaml:"enabled"`
+	Folder  string `mapstructure:"folder" yaml:"folder"`   // Daily notes folder (default: folder_path)
+	Format  string `mapstructure:"format" yaml:"format"`   // Daily note file name format, as in Obsidian, e.g. YYYY-MM-DD
+	Heading string `mapstructure:"heading" yaml:"heading"` // Heading the report is written under
+}
+
+// IssueNotesExportConfig represents the Obsidian notes kept per issue the reports mention
+type IssueNotesExportConfig struct {
+	Enabled bool   `mapstructure:"enabled" yaml:"enabled"`
+	Folder  string `mapstructure:"folder" yaml:"folder"` // Default: the issues folder of folder_path
+}
+
+// EmailConfig represents the SMTP server and addresses 'my-day digest --send' emails the digest with
+type EmailConfig struct {
+	SMTPHost string   `mapstructure:"smtp_host" yaml:"smtp_host"`
+	SMTPPort int      `mapstructure:"smtp_port" yaml:"smtp_port"` // 465 for implicit TLS, otherwise STARTTLS when offered
+	Username string   `mapstructure:"username" yaml:"username"`   // Empty to send without authentication
+	Password string   `mapstructure:"password" yaml:"password"`
+	From     string   `mapstructure:"from" yaml:"from"` // Defaults to jira.email
+	To       []string `mapstructure:"to" yaml:"to"`
 }
 
-// Load loads the configuration from viper
+// CalendarConfig represents calendar integration configuration
+type CalendarConfig struct {
+	Source string `mapstructure:"source" yaml:"source"` // iCalendar file path or URL
+	Email  string `mapstructure:"email" yaml:"email"`   // Your address on invitations (defaults to jira.email)
+}
+
+// SyncStateConfig represents encrypted state sync configuration for 'my-day sync-state'
+type SyncStateConfig struct {
+	Backend         string `mapstructure:"backend" yaml:"backend"` // dir, webdav, git, s3
+	Passphrase      string `mapstructure:"passphrase" yaml:"passphrase"`
+	Path            string `mapstructure:"path" yaml:"path"`
+	URL             string `mapstructure:"url" yaml:"url"`
+	Username        string `mapstructure:"username" yaml:"username"`
+	Password        string `mapstructure:"password" yaml:"password"`
+	Bucket          string `mapstructure:"bucket" yaml:"bucket"`
+	Region          string `mapstructure:"region" yaml:"region"`
+	Endpoint        string `mapstructure:"endpoint" yaml:"endpoint"`
+	Prefix          string `mapstructure:"prefix" yaml:"prefix"`
+	AccessKeyID     string `mapstructure:"access_key_id" yaml:"access_key_id"`
+	SecretAccessKey string `mapstructure:"secret_access_key" yaml:"secret_access_key"`
+}
+
+// SlackConfig represents Slack posting configuration for 'my-day report --post-slack'
+type SlackConfig struct {
+	WebhookURL string `mapstructure:"webhook_url" yaml:"webhook_url"` // Incoming webhook, takes precedence over the bot token
+	BotToken   string `mapstructure:"bot_token" yaml:"bot_token"`
+	Channel    string `mapstructure:"channel" yaml:"channel"` // Channel ID or name, used with the bot token
+}
+
+// HandoffConfig represents where 'my-day handoff --post-slack' posts, the channel of the team
+// picking up the work. The bot token of slack is reused.
+type HandoffConfig struct {
+	WebhookURL string `mapstructure:"webhook_url" yaml:"webhook_url"` // Incoming webhook of the receiving team's channel, takes precedence over the channel
+	Channel    string `mapstructure:"channel" yaml:"channel"`         // Channel ID or name, used with slack.bot_token
+}
+
+// TTSConfig represents the text-to-speech engine used by 'my-day report --speak'
+type TTSConfig struct {
+	Engine string `mapstructure:"engine" yaml:"engine"` // local (say, espeak-ng, System.Speech) or openai (uses llm.openai.base_url and api_key)
+	Voice  string `mapstructure:"voice" yaml:"voice"`   // Voice name, empty for the engine's default
+	Model  string `mapstructure:"model" yaml:"model"`   // Speech model of the openai engine
+}
+
+// DaemonConfig represents the schedule of 'my-day daemon'
+type DaemonConfig struct {
+	SyncInterval time.Duration `mapstructure:"sync_interval" yaml:"sync_interval"` // Time between background syncs, 0 to only sync before the report
+	ReportTime   string        `mapstructure:"report_time" yaml:"report_time"`     // Local time of the daily report (HH:MM)
+	WeekdaysOnly bool          `mapstructure:"weekdays_only" yaml:"weekdays_only"` // Skip the report on weekends
+	PostSlack    bool          `mapstructure:"post_slack" yaml:"post_slack"`       // Post the report to Slack when slack is configured
+}
+
+// MetricsConfig represents the Prometheus metrics endpoint of 'my-day daemon' and 'my-day serve'
+type MetricsConfig struct {
+	Enabled bool   `mapstructure:"enabled" yaml:"enabled"` // Serve the metrics at /metrics
+	Addr    string `mapstructure:"addr" yaml:"addr"`       // Address the daemon serves /metrics on; 'my-day serve' uses its own
+}
+
+// Load loads the configuration from viper, with the Jira profile selected by --profile applied
 func Load() (*Config, error) {
-	var config Config
-	
-	if err := viper.Unmarshal(&config); err != nil {
-		return nil, err
+	profiles := ActiveProfiles()
+	switch len(profiles) {
+	case 0:
+		return LoadProfile("")
+	case 1:
+		return LoadProfile(profiles[0])
+	default:
+		return nil, fmt.Errorf("several Jira profiles selected (%s); only sync and report combine profiles", strings.Join(profiles, ", "))
 	}
-
-	return &config, nil
 }
 
 // GetString returns a string configuration value
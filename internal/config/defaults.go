@@ -4,9 +4,24 @@ import "github.com/spf13/viper"
 
 // SetDefaults sets default configuration values
 func SetDefaults() {
+	// Issue tracker the tickets are synced from
+	viper.SetDefault("tracker", "jira")
+
 	// Jira defaults (API token authentication)
 	viper.SetDefault("jira.email", "")
 	viper.SetDefault("jira.token", "")
+	viper.SetDefault("jira.deployment", "auto") // Detect Jira Cloud or Server/Data Center
+	viper.SetDefault("jira.board_id", 0) // No board column lookup
+	viper.SetDefault("jira.max_results", 1000)
+	viper.SetDefault("jira.low_bandwidth", false)
+	viper.SetDefault("jira.max_comment_length", 2000)
+	viper.SetDefault("jira.comment_authors", []string{})
+	viper.SetDefault("jira.all_comments", false)
+	viper.SetDefault("jira.team.members", []string{})
+	viper.SetDefault("jira.team.group", "")
+	viper.SetDefault("jira.oauth.client_id", "")
+	viper.SetDefault("jira.oauth.client_secret", "")
+	viper.SetDefault("jira.oauth.callback_port", 8765)
 	
 	// Default projects for DevOps teams (project keys only)
 	viper.SetDefault("jira.projects", []string{
@@ -21,6 +36,36 @@ func SetDefaults() {
 	viper.SetDefault("github.include_prs", true)
 	viper.SetDefault("github.include_commits", true)
 	viper.SetDefault("github.include_workflows", true)
+	viper.SetDefault("github.project_status_field", "Status")
+
+	// GitLab defaults
+	viper.SetDefault("gitlab.enabled", false)
+	viper.SetDefault("gitlab.base_url", "https://gitlab.com")
+	viper.SetDefault("gitlab.token", "")
+	viper.SetDefault("gitlab.projects", []string{}) // Empty means all member projects
+	viper.SetDefault("gitlab.include_merge_requests", true)
+	viper.SetDefault("gitlab.include_commits", true)
+	viper.SetDefault("gitlab.include_pipelines", true)
+
+	// Trello and Asana defaults (read-only task board activity)
+	viper.SetDefault("trello.enabled", false)
+	viper.SetDefault("trello.api_key", "")
+	viper.SetDefault("trello.token", "")
+	viper.SetDefault("trello.boards", []string{}) // Empty means all boards
+	viper.SetDefault("asana.enabled", false)
+	viper.SetDefault("asana.token", "")
+	viper.SetDefault("asana.workspace", "") // Empty means all workspaces
+	viper.SetDefault("asana.projects", []string{})
+
+	// Time tracking defaults (Toggl or Harvest time entries)
+	viper.SetDefault("time_tracking.provider", "") // Empty means disabled
+	viper.SetDefault("time_tracking.token", "")
+	viper.SetDefault("time_tracking.account_id", "")
+
+	// Tempo defaults (worklogs from Tempo Timesheets instead of Jira)
+	viper.SetDefault("tempo.enabled", false)
+	viper.SetDefault("tempo.token", "")
+	viper.SetDefault("tempo.base_url", "https://api.tempo.io/4")
 
 	// LLM defaults (Docker-based by default for better summarization)
 	viper.SetDefault("llm.enabled", true)
@@ -28,26 +73,122 @@ func SetDefaults() {
 	viper.SetDefault("llm.model", "qwen2.5:3b")
 	viper.SetDefault("llm.debug", false)
 	viper.SetDefault("llm.summary_style", "technical")
+	viper.SetDefault("llm.language", "auto") // Match the dominant language of today's comments
 	viper.SetDefault("llm.max_summary_length", 0) // No limit for better summaries
 	viper.SetDefault("llm.include_technical_details", true)
 	viper.SetDefault("llm.prioritize_recent_work", true)
 	viper.SetDefault("llm.fallback_strategy", "graceful")
+	viper.SetDefault("llm.concurrency", 4)
 	viper.SetDefault("llm.ollama.base_url", "http://localhost:11434")
 	viper.SetDefault("llm.ollama.model", "qwen2.5:3b")
+	viper.SetDefault("llm.ollama.idle_stop_minutes", 0)
+	viper.SetDefault("llm.openai.base_url", "https://api.openai.com/v1")
+	viper.SetDefault("llm.openai.api_key", "")
+	viper.SetDefault("llm.openai.model", "gpt-4o-mini")
+	viper.SetDefault("llm.openai.api_version", "")
+	viper.SetDefault("llm.local_openai.base_url", "http://localhost:1234/v1")
+	viper.SetDefault("llm.local_openai.api_key", "")
+	viper.SetDefault("llm.local_openai.model", "")
+	viper.SetDefault("llm.privacy.redact", true) // Only applies to remote backends
+	viper.SetDefault("llm.privacy.patterns", []string{})
+	viper.SetDefault("llm.privacy.allowed_projects", []string{})
 
 	// Report defaults
 	viper.SetDefault("report.format", "console")
 	viper.SetDefault("report.include_yesterday", true)
 	viper.SetDefault("report.include_today", true)
 	viper.SetDefault("report.include_in_progress", true)
+	viper.SetDefault("report.max_comment_excerpt", 500)
+	viper.SetDefault("report.variance_threshold", 20)
+	viper.SetDefault("report.duplicate_similarity", 60)
+	viper.SetDefault("report.workday_hours", 8)
+	viper.SetDefault("report.risk.min_score", 40)
+	viper.SetDefault("report.risk.weights.priority", 30)
+	viper.SetDefault("report.risk.weights.days_in_status", 30)
+	viper.SetDefault("report.risk.weights.blockers", 25)
+	viper.SetDefault("report.risk.weights.sentiment", 15)
+	viper.SetDefault("report.template_path", "")
+	viper.SetDefault("report.themes", false)
+	viper.SetDefault("report.group_summaries", false)
+	viper.SetDefault("report.structured", false)
+	viper.SetDefault("report.next_steps", false)
+	viper.SetDefault("report.layout", "default")
+	viper.SetDefault("report.sections.ai_summary", true)
+	viper.SetDefault("report.sections.summary", true)
+	viper.SetDefault("report.sections.worklog", true)
+	viper.SetDefault("report.sections.todo", true)
+	viper.SetDefault("report.sections.quality", true)
+	viper.SetDefault("report.sections.footer", true)
 	
 	// Export defaults
 	viper.SetDefault("report.export.enabled", false)
 	viper.SetDefault("report.export.folder_path", "~/Documents/my-day-reports")
 	viper.SetDefault("report.export.filename_date", "2006-01-02")
 	viper.SetDefault("report.export.tags", []string{"report", "my-day"})
+	viper.SetDefault("report.export.target", "obsidian")
+	viper.SetDefault("report.export.confluence.base_url", "") // Derived from jira.base_url
+	viper.SetDefault("report.export.confluence.space_key", "")
+	viper.SetDefault("report.export.confluence.parent_id", "")
+	viper.SetDefault("report.export.confluence.title_prefix", "Daily Standup Report")
+	viper.SetDefault("report.export.confluence.mode", "page")
+	viper.SetDefault("report.export.confluence.member", "") // Empty means the Atlassian display name
+	viper.SetDefault("report.export.notion.database_id", "")
+	viper.SetDefault("report.export.notion.token", "")
+	viper.SetDefault("report.export.daily_note.enabled", false)
+	viper.SetDefault("report.export.daily_note.folder", "") // Empty means report.export.folder_path
+	viper.SetDefault("report.export.daily_note.format", "YYYY-MM-DD")
+	viper.SetDefault("report.export.daily_note.heading", "## my-day")
+	viper.SetDefault("report.export.wiki_links", false)
+	viper.SetDefault("report.export.issue_notes.enabled", false)
+	viper.SetDefault("report.export.issue_notes.folder", "") // Empty means the issues folder of folder_path
+	viper.SetDefault("report.export.targets.obsidian", false)
+	viper.SetDefault("report.export.targets.confluence", false)
+	viper.SetDefault("report.export.targets.notion", false)
+	viper.SetDefault("report.export.targets.slack", false)
+	viper.SetDefault("report.export.targets.clipboard", false)
+	viper.SetDefault("report.email.smtp_host", "")
+	viper.SetDefault("report.email.smtp_port", 587)
+	viper.SetDefault("report.email.username", "")
+	viper.SetDefault("report.email.password", "")
+	viper.SetDefault("report.email.from", "") // Empty means jira.email
+	viper.SetDefault("report.email.to", []string{})
+
+	// Calendar defaults
+	viper.SetDefault("calendar.source", "")
+	viper.SetDefault("calendar.email", "") // Empty means jira.email
+
+	// Sync state defaults
+	viper.SetDefault("sync_state.backend", "")
+	viper.SetDefault("sync_state.region", "us-east-1")
+
+	// Slack defaults
+	viper.SetDefault("slack.webhook_url", "")
+	viper.SetDefault("slack.bot_token", "")
+	viper.SetDefault("slack.channel", "")
+
+	// Handoff defaults
+	viper.SetDefault("handoff.webhook_url", "")
+	viper.SetDefault("handoff.channel", "")
+
+	// Text-to-speech defaults
+	viper.SetDefault("tts.engine", "local")
+	viper.SetDefault("tts.voice", "") // Empty means the engine's default voice
+	viper.SetDefault("tts.model", "tts-1")
+
+	// Daemon defaults
+	viper.SetDefault("daemon.sync_interval", "30m")
+	viper.SetDefault("daemon.report_time", "08:45")
+	viper.SetDefault("daemon.weekdays_only", true)
+	viper.SetDefault("daemon.post_slack", true)
+
+	// Metrics defaults
+	viper.SetDefault("metrics.enabled", false)
+	viper.SetDefault("metrics.addr", "127.0.0.1:9464")
 
 	// Application defaults
 	viper.SetDefault("verbose", false)
 	viper.SetDefault("quiet", false)
+	viper.SetDefault("trace", false)
+	viper.SetDefault("log_level", "auto")
+	viper.SetDefault("log_file", "")
 }
\ No newline at end of file
@@ -0,0 +1,179 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Setting is a value to write into the config file by its dotted key, e.g. llm.ollama.model
+type Setting struct {
+	Key   string
+	Value string
+}
+
+// DefaultPath returns the config file read when --config is not given
+func DefaultPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".my-day", "config.yaml"), nil
+}
+
+// UpdateYAML returns content with settings applied. Settings whose key is already in the file
+// are changed in place, so comments and alignment are kept; when a key is missing the document
+// is written out again with it, which keeps comments but not blank lines or alignment.
+func UpdateYAML(content []byte, settings []Setting) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("config file is not a YAML mapping")
+	}
+
+	lines := strings.Split(string(content), "\n")
+	inPlace := true
+	for _, setting := range settings {
+		node := findValue(root, strings.Split(setting.Key, "."))
+		if node == nil || !replaceScalar(lines, node, setting.Value) {
+			inPlace = false
+			break
+		}
+	}
+	if inPlace {
+		return []byte(strings.Join(lines, "\n")), nil
+	}
+
+	for _, setting := range settings {
+		node := ensureValue(root, strings.Split(setting.Key, "."))
+		node.Kind, node.Tag, node.Value, node.Content = yaml.ScalarNode, "!!str", setting.Value, nil
+		if node.Style == 0 {
+			node.Style = yaml.DoubleQuotedStyle
+		}
+	}
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to write config file: %w", err)
+	}
+	encoder.Close()
+	return out.Bytes(), nil
+}
+
+// findValue returns the value node of a key path in a mapping, or nil when it is missing
+func findValue(mapping *yaml.Node, path []string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value != path[0] {
+			continue
+		}
+		value := mapping.Content[i+1]
+		if len(path) == 1 {
+			return value
+		}
+		if value.Kind != yaml.MappingNode {
+			return nil
+		}
+		return findValue(value, path[1:])
+	}
+	return nil
+}
+
+// ensureValue returns the value node of a key path in a mapping, adding the missing keys
+func ensureValue(mapping *yaml.Node, path []string) *yaml.Node {
+	value := findValue(mapping, path[:1])
+	if value == nil {
+		value = &yaml.Node{Kind: yaml.MappingNode}
+		mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: path[0]}, value)
+	}
+	if len(path) == 1 {
+		return value
+	}
+	if value.Kind != yaml.MappingNode {
+		value.Kind, value.Tag, value.Value, value.Style = yaml.MappingNode, "", "", 0
+	}
+	return ensureValue(value, path[1:])
+}
+
+// replaceScalar replaces a single-line scalar value in its line, keeping its quoting and the
+// column of a trailing comment. It reports false for values it cannot replace in place.
+func replaceScalar(lines []string, node *yaml.Node, value string) bool {
+	if node.Kind != yaml.ScalarNode || node.Line < 1 || node.Line > len(lines) {
+		return false
+	}
+	line := lines[node.Line-1]
+	start := node.Column - 1
+	if start < 0 || start > len(line) {
+		return false
+	}
+
+	// Find where the value ends, and its replacement in the same style
+	var end int
+	var replacement string
+	switch node.Style {
+	case yaml.DoubleQuotedStyle:
+		end = closingQuote(line, start, '"')
+		replacement = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+	case yaml.SingleQuotedStyle:
+		end = closingQuote(line, start, '\'')
+		replacement = "'" + strings.ReplaceAll(value, "'", "''") + "'"
+	case 0:
+		end = len(line)
+		if comment := strings.Index(line[start:], " #"); comment >= 0 {
+			end = start + comment
+		}
+		end = start + len(strings.TrimRight(line[start:end], " \t"))
+		replacement = plainScalar(value)
+	default:
+		return false
+	}
+	if end < 0 {
+		return false
+	}
+
+	// Keep a trailing comment where it was, as long as there is room for it
+	rest := line[end:]
+	if trimmed := strings.TrimLeft(rest, " \t"); strings.HasPrefix(trimmed, "#") {
+		padding := len(rest) - len(trimmed) + (end - start) - len(replacement)
+		rest = strings.Repeat(" ", max(padding, 1)) + trimmed
+	}
+	lines[node.Line-1] = line[:start] + replacement + rest
+	return true
+}
+
+// closingQuote returns the index after the quote closing the string opened at start, or -1
+func closingQuote(line string, start int, quote byte) int {
+	if start >= len(line) || line[start] != quote {
+		return -1
+	}
+	for i := start + 1; i < len(line); i++ {
+		switch {
+		case quote == '"' && line[i] == '\\':
+			i++
+		case line[i] == quote && quote == '\'' && i+1 < len(line) && line[i+1] == '\'':
+			i++
+		case line[i] == quote:
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// plainScalar returns value unquoted when YAML reads it back as the same string, quoted otherwise
+func plainScalar(value string) string {
+	out, err := yaml.Marshal(value)
+	if err != nil {
+		return `"` + value + `"`
+	}
+	return strings.TrimSuffix(string(out), "\n")
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const editConfig = `# my-day configuration
+llm:
+  mode: "ollama"                                     # env: MY_DAY_LLM_MODE
+  model: "qwen2.5:3b"                                # env: MY_DAY_LLM_MODEL
+
+  # Ollama Configuration
+  ollama:
+    base_url: "http://localhost:11434"               # env: MY_DAY_LLM_OLLAMA_BASE_URL
+    model: qwen2.5:3b
+`
+
+func TestUpdateYAMLInPlace(t *testing.T) {
+	updated, err := UpdateYAML([]byte(editConfig), []Setting{
+		{Key: "llm.model", Value: "llama3.1:8b"},
+		{Key: "llm.ollama.model", Value: "llama3.1:8b"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := strings.NewReplacer(
+		`  model: "qwen2.5:3b"                                # env: MY_DAY_LLM_MODEL`,
+		`  model: "llama3.1:8b"                               # env: MY_DAY_LLM_MODEL`,
+		`    model: qwen2.5:3b`,
+		`    model: llama3.1:8b`,
+	).Replace(editConfig)
+	if string(updated) != expected {
+		t.Errorf("expected only the values to change, got:\n%s", updated)
+	}
+}
+
+func TestUpdateYAMLAddsMissingKeys(t *testing.T) {
+	updated, err := UpdateYAML([]byte(editConfig), []Setting{{Key: "llm.openai.model", Value: "gpt-4o"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var parsed struct {
+		LLM struct {
+			Mode   string `yaml:"mode"`
+			OpenAI struct {
+				Model string `yaml:"model"`
+			} `yaml:"openai"`
+		} `yaml:"llm"`
+	}
+	if err := yaml.Unmarshal(updated, &parsed); err != nil {
+		t.Fatal(err)
+	}
+	if parsed.LLM.OpenAI.Model != "gpt-4o" || parsed.LLM.Mode != "ollama" {
+		t.Errorf("expected the new key next to the existing ones, got %+v", parsed)
+	}
+	if !strings.Contains(string(updated), "# env: MY_DAY_LLM_OLLAMA_BASE_URL") {
+		t.Errorf("expected comments to be kept:\n%s", updated)
+	}
+
+	// An empty file gets the settings
+	updated, err = UpdateYAML(nil, []Setting{{Key: "llm.model", Value: "mistral:7b"}})
+	if err != nil || string(updated) != "llm:\n  model: \"mistral:7b\"\n" {
+		t.Errorf("unexpected content %q (err=%v)", updated, err)
+	}
+}
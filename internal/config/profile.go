@@ -0,0 +1,68 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// AllProfiles selects every configured Jira profile with --profile
+const AllProfiles = "all"
+
+// ProfileNames returns the names of the Jira profiles in jira.profiles, sorted
+func ProfileNames() []string {
+	var names []string
+	for name := range viper.GetStringMap("jira.profiles") {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ActiveProfiles returns the Jira profiles selected with --profile (or MY_DAY_PROFILE),
+// expanding "all" to every configured profile. It is empty when no profile is selected.
+func ActiveProfiles() []string {
+	var profiles []string
+	for _, name := range viper.GetStringSlice("profile") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch {
+		case name == "":
+		case name == AllProfiles:
+			return ProfileNames()
+		default:
+			profiles = append(profiles, name)
+		}
+	}
+	return profiles
+}
+
+// LoadProfile loads the configuration with the named Jira profile applied: every setting of
+// jira.profiles.<name> replaces the matching jira setting, and the others are kept. An empty
+// name loads the configuration without a profile.
+func LoadProfile(name string) (*Config, error) {
+	var config Config
+	if err := viper.Unmarshal(&config); err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return &config, nil
+	}
+
+	profile := viper.Sub("jira.profiles." + strings.ToLower(name))
+	if profile == nil {
+		available := "none"
+		if names := ProfileNames(); len(names) > 0 {
+			available = strings.Join(names, ", ")
+		}
+		return nil, fmt.Errorf("Jira profile %q not found in jira.profiles (available: %s)", name, available)
+	}
+
+	profiles := config.Jira.Profiles
+	if err := profile.Unmarshal(&config.Jira); err != nil {
+		return nil, fmt.Errorf("invalid Jira profile %q: %w", name, err)
+	}
+	config.Jira.Profiles = profiles
+	return &config, nil
+}
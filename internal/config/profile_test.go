@@ -0,0 +1,80 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// useConfig replaces the viper configuration for one test
+func useConfig(t *testing.T, yaml string) {
+	t.Helper()
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	SetDefaults()
+	viper.SetConfigType("yaml")
+	if err := viper.ReadConfig(strings.NewReader(yaml)); err != nil {
+		t.Fatalf("failed to read config: %v", err)
+	}
+}
+
+const profilesConfig = `
+jira:
+  base_url: "https://work.atlassian.net"
+  email: "me@work.com"
+  projects: ["WORK"]
+  profiles:
+    client-a:
+      base_url: "https://client-a.atlassian.net"
+      projects: ["CA"]
+    onprem:
+      base_url: "https://jira.example.com"
+      deployment: "server"
+`
+
+func TestLoadAppliesProfile(t *testing.T) {
+	useConfig(t, profilesConfig)
+	viper.Set("profile", []string{"client-a"})
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Jira.BaseURL != "https://client-a.atlassian.net" || !reflect.DeepEqual(cfg.Jira.Projects, []string{"CA"}) {
+		t.Errorf("expected the profile's site and projects, got %s %v", cfg.Jira.BaseURL, cfg.Jira.Projects)
+	}
+	if cfg.Jira.Email != "me@work.com" || cfg.Jira.MaxResults != 1000 {
+		t.Errorf("expected settings missing from the profile to be kept, got %q and %d", cfg.Jira.Email, cfg.Jira.MaxResults)
+	}
+}
+
+func TestLoadWithoutProfile(t *testing.T) {
+	useConfig(t, profilesConfig)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Jira.BaseURL != "https://work.atlassian.net" || len(cfg.Jira.Profiles) != 2 {
+		t.Errorf("expected the base configuration with both profiles, got %s %v", cfg.Jira.BaseURL, cfg.Jira.Profiles)
+	}
+}
+
+func TestActiveProfiles(t *testing.T) {
+	useConfig(t, profilesConfig)
+
+	viper.Set("profile", []string{"all"})
+	if got := ActiveProfiles(); !reflect.DeepEqual(got, []string{"client-a", "onprem"}) {
+		t.Errorf("ActiveProfiles() = %v, want every profile", got)
+	}
+	if _, err := Load(); err == nil {
+		t.Error("expected Load() to reject several profiles")
+	}
+
+	viper.Set("profile", []string{"missing"})
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "client-a, onprem") {
+		t.Errorf("expected an unknown profile error listing the profiles, got %v", err)
+	}
+}
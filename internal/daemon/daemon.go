@@ -0,0 +1,178 @@
+// Package daemon runs the sync and the daily report on a schedule. The schedule is checked
+// against the wall clock at a short interval instead of sleeping until the next run, so it
+// survives a laptop sleeping: a report missed while asleep is generated once on wake.
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTick is how often the runner checks whether a sync or report is due
+const DefaultTick = time.Minute
+
+// DefaultRetryDelay is how long the runner waits before retrying a failed report
+const DefaultRetryDelay = 5 * time.Minute
+
+// Schedule is when the daemon syncs and generates the report
+type Schedule struct {
+	SyncInterval time.Duration // Time between syncs (0 to only sync before the report)
+	ReportHour   int           // Local time the report is generated
+	ReportMinute int
+	WeekdaysOnly bool // Skip the report on Saturdays and Sundays
+}
+
+// ParseClock parses a time of day such as "08:45"
+func ParseClock(value string) (hour, minute int, err error) {
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid time %q (use HH:MM, e.g. 08:45)", value)
+	}
+	hour, hourErr := strconv.Atoi(parts[0])
+	minute, minuteErr := strconv.Atoi(parts[1])
+	if hourErr != nil || minuteErr != nil || hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+		return 0, 0, fmt.Errorf("invalid time %q (use HH:MM, e.g. 08:45)", value)
+	}
+	return hour, minute, nil
+}
+
+// reportTimeOn returns the report time on the day of t
+func (s Schedule) reportTimeOn(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), s.ReportHour, s.ReportMinute, 0, 0, t.Location())
+}
+
+// reportDay reports whether a report is generated on the day of t
+func (s Schedule) reportDay(t time.Time) bool {
+	return !s.WeekdaysOnly || (t.Weekday() != time.Saturday && t.Weekday() != time.Sunday)
+}
+
+// ReportDue reports whether the report of today is due at now: its time has passed and no
+// report was generated since. A report missed on an earlier day is not caught up.
+func (s Schedule) ReportDue(now, lastReport time.Time) bool {
+	scheduled := s.reportTimeOn(now)
+	return s.reportDay(now) && !now.Before(scheduled) && lastReport.Before(scheduled)
+}
+
+// NextReport returns the first report time after t
+func (s Schedule) NextReport(t time.Time) time.Time {
+	next := s.reportTimeOn(t)
+	for !next.After(t) || !s.reportDay(next) {
+		next = s.reportTimeOn(next.AddDate(0, 0, 1))
+	}
+	return next
+}
+
+// SyncDue reports whether a sync is due at now
+func (s Schedule) SyncDue(now, lastSync time.Time) bool {
+	return s.SyncInterval > 0 && now.Sub(lastSync) >= s.SyncInterval
+}
+
+// Runner syncs and generates the report on a schedule
+type Runner struct {
+	Schedule   Schedule
+	Sync       func(ctx context.Context) error
+	Report     func(ctx context.Context) error // Generates and delivers the report; the runner syncs first
+	LastSync   time.Time                       // When the data was last synced
+	LastReport time.Time                       // When the report was last generated
+	Tick       time.Duration                   // How often to check the schedule (default DefaultTick)
+	RetryDelay time.Duration                   // Wait before retrying a failed report (default DefaultRetryDelay)
+	Now        func() time.Time                // Clock, time.Now by default
+	Logf       func(format string, args ...interface{})
+
+	retryAt time.Time
+}
+
+// Run checks the schedule until ctx is cancelled
+func (r *Runner) Run(ctx context.Context) error {
+	tick := r.Tick
+	if tick <= 0 {
+		tick = DefaultTick
+	}
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	r.logf("Next report at %s", r.Schedule.NextReport(r.now()).Format("Mon Jan 2 15:04"))
+	for {
+		r.Step(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Step runs what is due now: the report, with a sync before it, or a periodic sync
+func (r *Runner) Step(ctx context.Context) {
+	now := r.now()
+
+	if r.Schedule.ReportDue(now, r.LastReport) && !now.Before(r.retryAt) {
+		late := now.Sub(r.Schedule.reportTimeOn(now))
+		if late >= 2*r.tick() {
+			r.logf("Generating the report scheduled at %s (%s late, e.g. after sleep)", r.Schedule.reportTimeOn(now).Format("15:04"), late.Round(time.Minute))
+		} else {
+			r.logf("Generating the report")
+		}
+
+		if err := r.ReportNow(ctx); err != nil {
+			r.retryAt = now.Add(r.retryDelay())
+			r.logf("Report failed, retrying at %s: %v", r.retryAt.Format("15:04"), err)
+			return
+		}
+		r.logf("Next report at %s", r.Schedule.NextReport(now).Format("Mon Jan 2 15:04"))
+		return
+	}
+
+	if r.Schedule.SyncDue(now, r.LastSync) {
+		r.sync(ctx, now)
+	}
+}
+
+// ReportNow syncs and generates the report regardless of the schedule
+func (r *Runner) ReportNow(ctx context.Context) error {
+	now := r.now()
+	r.sync(ctx, now)
+	if err := r.Report(ctx); err != nil {
+		return err
+	}
+	r.LastReport = now
+	return nil
+}
+
+// sync syncs, counting a failed sync as done so it is only retried at the next interval
+func (r *Runner) sync(ctx context.Context, now time.Time) {
+	if err := r.Sync(ctx); err != nil {
+		r.logf("Sync failed: %v", err)
+	}
+	r.LastSync = now
+}
+
+func (r *Runner) now() time.Time {
+	if r.Now != nil {
+		return r.Now()
+	}
+	return time.Now()
+}
+
+func (r *Runner) tick() time.Duration {
+	if r.Tick > 0 {
+		return r.Tick
+	}
+	return DefaultTick
+}
+
+func (r *Runner) retryDelay() time.Duration {
+	if r.RetryDelay > 0 {
+		return r.RetryDelay
+	}
+	return DefaultRetryDelay
+}
+
+func (r *Runner) logf(format string, args ...interface{}) {
+	if r.Logf != nil {
+		r.Logf(format, args...)
+	}
+}
@@ -0,0 +1,120 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// at returns a time on Monday July 15, 2024 plus the given days
+func at(days, hour, minute int) time.Time {
+	return time.Date(2024, 7, 15+days, hour, minute, 0, 0, time.Local)
+}
+
+func TestParseClock(t *testing.T) {
+	if hour, minute, err := ParseClock("08:45"); err != nil || hour != 8 || minute != 45 {
+		t.Errorf("ParseClock(08:45) = %d, %d, %v", hour, minute, err)
+	}
+	for _, value := range []string{"8.45", "24:00", "08:60", "", "noon"} {
+		if _, _, err := ParseClock(value); err == nil {
+			t.Errorf("expected an error for %q", value)
+		}
+	}
+}
+
+func TestScheduleReportDue(t *testing.T) {
+	schedule := Schedule{ReportHour: 8, ReportMinute: 45, WeekdaysOnly: true}
+
+	tests := []struct {
+		name       string
+		now        time.Time
+		lastReport time.Time
+		want       bool
+	}{
+		{"before the report time", at(0, 8, 44), at(-3, 8, 45), false},
+		{"at the report time", at(0, 8, 45), at(-3, 8, 45), true},
+		{"woken up late", at(0, 13, 10), at(-3, 8, 45), true},
+		{"already reported today", at(0, 13, 10), at(0, 8, 45), false},
+		{"reported early by hand", at(0, 9, 0), at(0, 8, 0), true},
+		{"saturday", at(5, 9, 0), at(4, 8, 45), false},
+	}
+	for _, tt := range tests {
+		if got := schedule.ReportDue(tt.now, tt.lastReport); got != tt.want {
+			t.Errorf("%s: ReportDue() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestScheduleNextReportSkipsWeekends(t *testing.T) {
+	schedule := Schedule{ReportHour: 8, ReportMinute: 45, WeekdaysOnly: true}
+
+	if next := schedule.NextReport(at(0, 8, 0)); !next.Equal(at(0, 8, 45)) {
+		t.Errorf("expected today's report, got %v", next)
+	}
+	if next := schedule.NextReport(at(4, 9, 0)); !next.Equal(at(7, 8, 45)) {
+		t.Errorf("expected Friday after the report to wait for Monday, got %v", next)
+	}
+
+	schedule.WeekdaysOnly = false
+	if next := schedule.NextReport(at(4, 9, 0)); !next.Equal(at(5, 8, 45)) {
+		t.Errorf("expected Saturday's report, got %v", next)
+	}
+}
+
+func TestRunnerStepSyncsAndReports(t *testing.T) {
+	now := at(0, 8, 0)
+	var calls []string
+	runner := &Runner{
+		Schedule:   Schedule{SyncInterval: 30 * time.Minute, ReportHour: 8, ReportMinute: 45},
+		Sync:       func(ctx context.Context) error { calls = append(calls, "sync"); return nil },
+		Report:     func(ctx context.Context) error { calls = append(calls, "report"); return nil },
+		LastSync:   at(0, 7, 45),
+		LastReport: at(-1, 8, 45),
+		Now:        func() time.Time { return now },
+	}
+
+	step := func(t time.Time) []string {
+		now, calls = t, nil
+		runner.Step(context.Background())
+		return calls
+	}
+
+	if got := step(at(0, 8, 0)); len(got) != 0 {
+		t.Errorf("expected nothing before the sync interval, got %v", got)
+	}
+	if got := step(at(0, 8, 15)); len(got) != 1 || got[0] != "sync" {
+		t.Errorf("expected a periodic sync, got %v", got)
+	}
+	if got := step(at(0, 8, 45)); len(got) != 2 || got[0] != "sync" || got[1] != "report" {
+		t.Errorf("expected a sync before the report, got %v", got)
+	}
+	if got := step(at(0, 8, 46)); len(got) != 0 {
+		t.Errorf("expected the report only once, got %v", got)
+	}
+}
+
+func TestRunnerRetriesFailedReportAfterDelay(t *testing.T) {
+	now := at(0, 8, 45)
+	reports := 0
+	runner := &Runner{
+		Schedule:   Schedule{ReportHour: 8, ReportMinute: 45},
+		Sync:       func(ctx context.Context) error { return errors.New("offline") },
+		Report:     func(ctx context.Context) error { reports++; return errors.New("Slack is down") },
+		Now:        func() time.Time { return now },
+		RetryDelay: 5 * time.Minute,
+	}
+
+	runner.Step(context.Background())
+	now = at(0, 8, 46)
+	runner.Step(context.Background())
+	if reports != 1 {
+		t.Fatalf("expected no retry before the delay, got %d reports", reports)
+	}
+
+	now = at(0, 8, 50)
+	runner.Step(context.Background())
+	if reports != 2 || !runner.LastReport.IsZero() {
+		t.Errorf("expected a retry after the delay, got %d reports (last %v)", reports, runner.LastReport)
+	}
+}
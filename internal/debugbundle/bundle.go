@@ -0,0 +1,99 @@
+// Package debugbundle packs what is needed to reproduce a problem, such as the sanitized
+// configuration and the last LLM prompt, into a single archive users can attach to an issue.
+package debugbundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ManifestFile is the name of the manifest in the archive
+const ManifestFile = "manifest.json"
+
+// File is a file added to a bundle
+type File struct {
+	Name string // Path in the archive, e.g. "prompts/<hash>.json"
+	Data []byte
+}
+
+// Manifest lists the files of a bundle with their SHA-256
+type Manifest struct {
+	ID        string            `json:"id"`
+	CreatedAt time.Time         `json:"created_at"`
+	Files     map[string]string `json:"files"` // Name -> SHA-256
+}
+
+// Build packs the files and their manifest into a tar.gz archive. The bundle ID is derived
+// from the names and contents of the files, so the same files always give the same ID.
+func Build(files []File, createdAt time.Time) ([]byte, *Manifest, error) {
+	manifest := &Manifest{CreatedAt: createdAt, Files: make(map[string]string, len(files))}
+	for _, file := range files {
+		if file.Name == ManifestFile {
+			return nil, nil, fmt.Errorf("%s is reserved for the manifest", ManifestFile)
+		}
+		if _, ok := manifest.Files[file.Name]; ok {
+			return nil, nil, fmt.Errorf("duplicate file %s", file.Name)
+		}
+		manifest.Files[file.Name] = Hash(file.Data)
+	}
+	manifest.ID = bundleID(manifest.Files)
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, file := range append([]File{{Name: ManifestFile, Data: manifestData}}, files...) {
+		header := &tar.Header{
+			Name:    file.Name,
+			Mode:    0600,
+			Size:    int64(len(file.Data)),
+			ModTime: createdAt,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, nil, fmt.Errorf("failed to write %s: %w", file.Name, err)
+		}
+		if _, err := tw.Write(file.Data); err != nil {
+			return nil, nil, fmt.Errorf("failed to write %s: %w", file.Name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, nil, fmt.Errorf("failed to finalize archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, nil, fmt.Errorf("failed to compress archive: %w", err)
+	}
+	return buf.Bytes(), manifest, nil
+}
+
+// Hash returns the hex SHA-256 of data
+func Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// bundleID returns the first 12 hex digits of the SHA-256 of the sorted file names and hashes
+func bundleID(files map[string]string) string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hasher := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(hasher, "%s\x00%s\n", name, files[name])
+	}
+	return hex.EncodeToString(hasher.Sum(nil))[:12]
+}
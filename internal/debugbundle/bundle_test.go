@@ -0,0 +1,88 @@
+package debugbundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+)
+
+// unpack returns the files of an archive by name
+func unpack(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("gzip.NewReader() error = %v", err)
+	}
+	tr := tar.NewReader(gz)
+
+	files := make(map[string][]byte)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		if err != nil {
+			t.Fatalf("tar Next() error = %v", err)
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("ReadAll() error = %v", err)
+		}
+		files[header.Name] = content
+	}
+}
+
+func TestBuildWritesFilesAndManifest(t *testing.T) {
+	files := []File{
+		{Name: "version.json", Data: []byte(`{"version":"1.2.0"}`)},
+		{Name: "prompts/abc.json", Data: []byte(`{"prompt":"Summarize"}`)},
+	}
+
+	data, manifest, err := Build(files, time.Date(2024, 7, 15, 9, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	archived := unpack(t, data)
+	if string(archived["version.json"]) != `{"version":"1.2.0"}` || string(archived["prompts/abc.json"]) != `{"prompt":"Summarize"}` {
+		t.Errorf("unexpected archived files %v", archived)
+	}
+
+	var archivedManifest Manifest
+	if err := json.Unmarshal(archived[ManifestFile], &archivedManifest); err != nil {
+		t.Fatalf("manifest is not JSON: %v", err)
+	}
+	if archivedManifest.ID != manifest.ID || len(manifest.ID) != 12 {
+		t.Errorf("unexpected bundle ID %q (archived %q)", manifest.ID, archivedManifest.ID)
+	}
+	if archivedManifest.Files["version.json"] != Hash(files[0].Data) {
+		t.Errorf("unexpected manifest files %v", archivedManifest.Files)
+	}
+}
+
+func TestBuildIDDependsOnContentOnly(t *testing.T) {
+	files := []File{{Name: "a.json", Data: []byte("a")}, {Name: "b.json", Data: []byte("b")}}
+	_, first, _ := Build(files, time.Now())
+	_, reordered, _ := Build([]File{files[1], files[0]}, time.Now().Add(time.Hour))
+	_, changed, _ := Build([]File{files[0], {Name: "b.json", Data: []byte("c")}}, time.Now())
+
+	if first.ID != reordered.ID {
+		t.Errorf("expected the same ID for the same files, got %s and %s", first.ID, reordered.ID)
+	}
+	if first.ID == changed.ID {
+		t.Errorf("expected a different ID when a file changes, got %s", changed.ID)
+	}
+}
+
+func TestBuildRejectsDuplicateAndReservedNames(t *testing.T) {
+	if _, _, err := Build([]File{{Name: "a.json"}, {Name: "a.json"}}, time.Now()); err == nil {
+		t.Error("expected an error for duplicate files")
+	}
+	if _, _, err := Build([]File{{Name: ManifestFile}}, time.Now()); err == nil {
+		t.Error("expected an error for a file named like the manifest")
+	}
+}
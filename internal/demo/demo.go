@@ -0,0 +1,117 @@
+// Package demo provides bundled sample data so the report pipeline can run without Jira.
+package demo
+
+import (
+	"time"
+
+	"my-day/internal/github"
+	"my-day/internal/jira"
+	"my-day/internal/report"
+)
+
+// JiraURL is the placeholder Jira instance the sample issues belong to
+const JiraURL = "https://demo.atlassian.net"
+
+// Data is a realistic day of DevOps work
+type Data struct {
+	TargetDate         time.Time
+	IssuesWithComments []report.IssueWithComments
+	Worklogs           []jira.WorklogEntry
+	GitHubActivity     []github.Activity
+}
+
+// SampleData returns a day of work ending on the given date: issues in every status group
+// with comments, worklogs and GitHub activity
+func SampleData(date time.Time) Data {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	at := func(hour, minute int) jira.JiraTime {
+		return jira.JiraTime{Time: day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)}
+	}
+
+	me := jira.User{AccountID: "demo-user", DisplayName: "Alex Dev", EmailAddress: "alex@example.com"}
+	teammate := jira.User{AccountID: "demo-teammate", DisplayName: "Sam Ops"}
+
+	inProgress := jira.Status{Name: "In Progress", Category: jira.StatusCategory{Key: "indeterminate", Name: "In Progress"}}
+	inReview := jira.Status{Name: "Code Review", Category: jira.StatusCategory{Key: "indeterminate", Name: "In Progress"}}
+	done := jira.Status{Name: "Done", Category: jira.StatusCategory{Key: "done", Name: "Done"}}
+	todo := jira.Status{Name: "To Do", Category: jira.StatusCategory{Key: "new", Name: "To Do"}}
+	platform := jira.Project{Key: "PLAT", Name: "Platform"}
+	security := jira.Project{Key: "SEC", Name: "Security"}
+
+	issue := func(key, summary string, status jira.Status, priority, issueType string, project jira.Project, updated jira.JiraTime) jira.Issue {
+		return jira.Issue{
+			Key: key,
+			Fields: jira.Fields{
+				Summary:   summary,
+				Status:    status,
+				Priority:  jira.Priority{Name: priority},
+				IssueType: jira.IssueType{Name: issueType},
+				Project:   project,
+				Assignee:  &me,
+				Reporter:  teammate,
+				Created:   jira.JiraTime{Time: updated.Time.AddDate(0, 0, -6)},
+				Updated:   updated,
+			},
+		}
+	}
+	comment := func(id string, author jira.User, text string, created jira.JiraTime) jira.Comment {
+		return jira.Comment{ID: id, Author: author, Body: jira.JiraDescription{Text: text}, Created: created, Updated: created}
+	}
+
+	issues := []report.IssueWithComments{
+		{
+			Issue: issue("PLAT-412", "Migrate CI runners to Kubernetes", inProgress, "High", "Story", platform, at(15, 40)),
+			Comments: []jira.Comment{
+				comment("1001", me, "Deployed the runner Helm chart to staging and verified autoscaling from 2 to 12 pods under load. Next: move the nightly pipelines over.", at(10, 15)),
+				comment("1002", teammate, "Nice! Can we keep the old runners around until Friday as a fallback?", at(11, 2)),
+				comment("1003", me, "Yes, old runners stay registered with a lower priority until Friday. Added a dashboard for queue time per runner pool.", at(15, 40)),
+			},
+		},
+		{
+			Issue: issue("PLAT-398", "Add Terraform module for shared VPC peering", inReview, "Medium", "Task", platform, at(13, 20)),
+			Comments: []jira.Comment{
+				comment("1004", me, "Opened the PR with the peering module and a terratest suite; plan is clean for dev and staging.", at(13, 20)),
+			},
+		},
+		{
+			Issue: issue("SEC-77", "Rotate database credentials stored in Vault", done, "Highest", "Task", security, at(9, 30)),
+			Comments: []jira.Comment{
+				comment("1005", me, "Rotated credentials for all 14 services, restarted pods with zero downtime and revoked the old leases.", at(9, 30)),
+			},
+		},
+		{
+			Issue: issue("PLAT-405", "Fix flaky health check in blue/green deploys", done, "High", "Bug", platform, at(-6, 0)),
+			Comments: []jira.Comment{
+				comment("1006", me, "Root cause was the readiness probe timeout being shorter than the JVM warmup. Increased it to 30s and added a startup probe.", at(-7, 10)),
+			},
+		},
+		{
+			Issue: issue("PLAT-420", "Write runbook for database failover", todo, "Medium", "Task", platform, at(16, 5)),
+			Comments: []jira.Comment{
+				comment("1007", me, "Collected the failover steps from last incident; will draft the runbook tomorrow.", at(16, 5)),
+			},
+		},
+	}
+
+	worklogs := []jira.WorklogEntry{
+		{ID: "2001", Author: me, IssueID: "PLAT-412", Comment: "Runner migration and load testing", Started: at(9, 45), TimeSpentSeconds: 3 * 3600},
+		{ID: "2002", Author: me, IssueID: "SEC-77", Comment: "Credential rotation", Started: at(8, 30), TimeSpentSeconds: 3600},
+		{ID: "2003", Author: me, IssueID: "PLAT-398", Comment: "VPC peering module review fixes", Started: at(13, 0), TimeSpentSeconds: 5400},
+	}
+
+	githubTime := func(hour, minute int) time.Time {
+		return at(hour, minute).Time
+	}
+	activity := []github.Activity{
+		{Type: "commit", ID: "9f2c1ab", Title: "PLAT-412 Add autoscaling policy for runner pools", State: "committed", URL: "https://github.com/acme/ci-runners/commit/9f2c1ab", Repository: "acme/ci-runners", Author: "alexdev", CreatedAt: githubTime(10, 5), UpdatedAt: githubTime(10, 5), JiraTickets: []string{"PLAT-412"}},
+		{Type: "pull_request", ID: "5512", Title: "PLAT-398 Shared VPC peering module", State: "open", URL: "https://github.com/acme/terraform-modules/pull/87", Repository: "acme/terraform-modules", Author: "alexdev", CreatedAt: githubTime(13, 15), UpdatedAt: githubTime(13, 15), JiraTickets: []string{"PLAT-398"}, Metadata: map[string]interface{}{"number": 87}},
+		{Type: "review", ID: "7781", Title: "Bump base images to Debian 12", State: "approved", URL: "https://github.com/acme/platform-images/pull/214", Repository: "acme/platform-images", Author: "alexdev", CreatedAt: githubTime(14, 30), UpdatedAt: githubTime(14, 30), Metadata: map[string]interface{}{"number": 214}},
+	}
+
+	return Data{
+		TargetDate:         day.Add(17 * time.Hour),
+		IssuesWithComments: issues,
+		Worklogs:           worklogs,
+		GitHubActivity:     activity,
+	}
+}
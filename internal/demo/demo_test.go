@@ -0,0 +1,34 @@
+package demo
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/report"
+)
+
+func TestSampleDataCoversEveryReportSection(t *testing.T) {
+	data := SampleData(time.Date(2024, 7, 15, 9, 0, 0, 0, time.UTC))
+
+	generator := report.NewGenerator(&report.Config{
+		Format:            "markdown",
+		IncludeToday:      true,
+		IncludeYesterday:  true,
+		IncludeInProgress: true,
+		GitHubActivity:    data.GitHubActivity,
+	})
+	content, err := generator.GenerateWithComments(data.IssuesWithComments, data.Worklogs, data.TargetDate)
+	if err != nil {
+		t.Fatalf("GenerateWithComments() error = %v", err)
+	}
+
+	for _, section := range []string{"## 🔄 Currently Working On", "## ✅ Recently Completed", "## 📋 To Do", "## 🐙 GitHub Activity", "## ⏰ Work Log"} {
+		if !strings.Contains(content, section) {
+			t.Errorf("demo report is missing %q", section)
+		}
+	}
+	if problems := report.LintMarkdown(content); len(problems) > 0 {
+		t.Errorf("demo report has markdown problems: %v", problems)
+	}
+}
@@ -6,10 +6,11 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
+
+	"my-day/internal/issuekeys"
 )
 
 const (
@@ -89,6 +90,31 @@ func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
 	return &user, nil
 }
 
+// GetRepository returns a single repository by owner and name
+func (c *Client) GetRepository(ctx context.Context, owner, repo string) (*Repository, error) {
+	endpoint := fmt.Sprintf("/repos/%s/%s", owner, repo)
+	resp, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil {
+			return nil, fmt.Errorf("GitHub API error: %s", errResp.Message)
+		}
+		return nil, fmt.Errorf("GitHub API error: status %d", resp.StatusCode)
+	}
+
+	var repository Repository
+	if err := json.NewDecoder(resp.Body).Decode(&repository); err != nil {
+		return nil, fmt.Errorf("failed to decode repository response: %w", err)
+	}
+
+	return &repository, nil
+}
+
 // GetUserRepositories returns repositories for the authenticated user
 func (c *Client) GetUserRepositories(ctx context.Context, since time.Time) ([]Repository, error) {
 	params := url.Values{
@@ -167,6 +193,35 @@ func (c *Client) GetPullRequests(ctx context.Context, owner, repo string, state
 	return pullRequests, nil
 }
 
+// GetPullRequestReviews returns the reviews submitted on a pull request
+func (c *Client) GetPullRequestReviews(ctx context.Context, owner, repo string, number int) ([]Review, error) {
+	params := url.Values{
+		"per_page": {"100"},
+	}
+
+	endpoint := fmt.Sprintf("/repos/%s/%s/pulls/%d/reviews", owner, repo, number)
+	resp, err := c.makeRequest(ctx, "GET", endpoint, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request reviews: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil {
+			return nil, fmt.Errorf("GitHub API error: %s", errResp.Message)
+		}
+		return nil, fmt.Errorf("GitHub API error: status %d", resp.StatusCode)
+	}
+
+	var reviews []Review
+	if err := json.NewDecoder(resp.Body).Decode(&reviews); err != nil {
+		return nil, fmt.Errorf("failed to decode reviews response: %w", err)
+	}
+
+	return reviews, nil
+}
+
 // GetUserPullRequests returns pull requests involving the authenticated user
 func (c *Client) GetUserPullRequests(ctx context.Context, repos []Repository, since time.Time) ([]PullRequest, error) {
 	var allPRs []PullRequest
@@ -311,7 +366,12 @@ func (c *Client) GetUserActivity(ctx context.Context, since time.Time, repos []s
 			if len(parts) != 2 {
 				continue
 			}
-			// We'll need to implement GetRepository if we want to validate specific repos
+			repo, err := c.GetRepository(ctx, parts[0], parts[1])
+			if err != nil {
+				// Skip repositories that are missing or not accessible
+				continue
+			}
+			repositories = append(repositories, *repo)
 		}
 	} else {
 		// Get all user repositories
@@ -342,18 +402,19 @@ func (c *Client) getRepositoryActivity(ctx context.Context, repo Repository, use
 	commits, err := c.GetCommits(ctx, repo.Owner.Login, repo.Name, since, username)
 	if err == nil {
 		for _, commit := range commits {
+			message := commit.GetMessage()
 			activity := Activity{
 				Type:        "commit",
 				ID:          commit.SHA,
-				Title:       commit.Message,
+				Title:       strings.SplitN(message, "\n", 2)[0],
 				Description: fmt.Sprintf("Commit to %s", repo.FullName),
 				State:       "committed",
 				URL:         commit.HTMLURL,
 				Repository:  repo.FullName,
 				Author:      username,
-				CreatedAt:   commit.Author.Date.Time,
-				UpdatedAt:   commit.Author.Date.Time,
-				JiraTickets: extractJiraTickets(commit.Message),
+				CreatedAt:   commit.GetDate(),
+				UpdatedAt:   commit.GetDate(),
+				JiraTickets: issuekeys.Extract(message),
 				Metadata: map[string]interface{}{
 					"sha":     commit.SHA,
 					"tree":    commit.Tree.SHA,
@@ -368,9 +429,20 @@ func (c *Client) getRepositoryActivity(ctx context.Context, repo Repository, use
 	prs, err := c.GetPullRequests(ctx, repo.Owner.Login, repo.Name, "all", since)
 	if err == nil {
 		for _, pr := range prs {
+			// The list endpoint only reports merges through merged_at
+			merged := pr.Merged || (pr.MergedAt != nil && !pr.MergedAt.IsZero())
+
+			if pr.User.Login != username {
+				// Reviews are only listed per pull request, so check the ones updated in the window
+				reviews, err := c.GetPullRequestReviews(ctx, repo.Owner.Login, repo.Name, pr.Number)
+				if err == nil {
+					activities = append(activities, reviewActivities(pr, reviews, repo, username, since)...)
+				}
+			}
+
 			if c.isUserInvolvedInPR(pr, username) {
 				state := pr.State
-				if pr.Merged {
+				if merged {
 					state = "merged"
 				}
 
@@ -385,16 +457,19 @@ func (c *Client) getRepositoryActivity(ctx context.Context, repo Repository, use
 					Author:      pr.User.Login,
 					CreatedAt:   pr.CreatedAt.Time,
 					UpdatedAt:   pr.UpdatedAt.Time,
-					JiraTickets: extractJiraTickets(pr.Title + " " + pr.Body),
+					JiraTickets: issuekeys.Extract(pr.Title + " " + pr.Body),
 					Metadata: map[string]interface{}{
 						"number":    pr.Number,
 						"draft":     pr.Draft,
-						"merged":    pr.Merged,
+						"merged":    merged,
 						"head_ref":  pr.Head.Ref,
 						"base_ref":  pr.Base.Ref,
 						"mergeable": pr.State == "open",
 					},
 				}
+				if merged && pr.MergedAt != nil {
+					activity.Metadata["merged_at"] = pr.MergedAt.Time
+				}
 				activities = append(activities, activity)
 			}
 		}
@@ -403,26 +478,40 @@ func (c *Client) getRepositoryActivity(ctx context.Context, repo Repository, use
 	return activities, nil
 }
 
-// extractJiraTickets extracts Jira ticket references from text
-func extractJiraTickets(text string) []string {
-	// Common Jira ticket patterns: PROJECT-123, ABC-456, etc.
-	re := regexp.MustCompile(`\b([A-Z]{2,10}-\d+)\b`)
-	matches := re.FindAllStringSubmatch(text, -1)
-	
-	var tickets []string
-	seen := make(map[string]bool)
-	
-	for _, match := range matches {
-		if len(match) > 1 {
-			ticket := match[1]
-			if !seen[ticket] {
-				tickets = append(tickets, ticket)
-				seen[ticket] = true
-			}
+// reviewActivities converts the reviews a user submitted on a pull request since the given time into activities
+func reviewActivities(pr PullRequest, reviews []Review, repo Repository, username string, since time.Time) []Activity {
+	var activities []Activity
+	for _, review := range reviews {
+		if review.User.Login != username || review.State == "PENDING" {
+			continue
 		}
-	}
-	
-	return tickets
+		if !since.IsZero() && review.SubmittedAt.Time.Before(since) {
+			continue
+		}
+
+		url := review.HTMLURL
+		if url == "" {
+			url = pr.HTMLURL
+		}
+		activities = append(activities, Activity{
+			Type:        "review",
+			ID:          strconv.FormatInt(review.ID, 10),
+			Title:       pr.Title,
+			Description: review.Body,
+			State:       strings.ToLower(review.State),
+			URL:         url,
+			Repository:  repo.FullName,
+			Author:      username,
+			CreatedAt:   review.SubmittedAt.Time,
+			UpdatedAt:   review.SubmittedAt.Time,
+			JiraTickets: issuekeys.Extract(pr.Title + " " + review.Body),
+			Metadata: map[string]interface{}{
+				"number":    pr.Number,
+				"pr_author": pr.User.Login,
+			},
+		})
+	}
+	return activities
 }
 
 // TestConnection tests the GitHub API connection
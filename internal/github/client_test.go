@@ -0,0 +1,87 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetUserActivity(t *testing.T) {
+	since := time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)
+
+	responses := map[string]interface{}{
+		"/user":             map[string]interface{}{"login": "alex"},
+		"/repos/acme/infra": map[string]interface{}{"name": "infra", "full_name": "acme/infra", "owner": map[string]interface{}{"login": "acme"}},
+		"/repos/acme/infra/commits": []interface{}{
+			map[string]interface{}{
+				"sha":      "abc123",
+				"html_url": "https://github.com/acme/infra/commit/abc123",
+				"commit": map[string]interface{}{
+					"message": "OPS-101 Add runner autoscaling\n\nLonger description",
+					"author":  map[string]interface{}{"name": "Alex", "date": "2024-07-15T11:00:00Z"},
+				},
+			},
+		},
+		"/repos/acme/infra/pulls": []interface{}{
+			map[string]interface{}{
+				"id": 1, "number": 42, "title": "Rotate credentials", "state": "closed",
+				"user":       map[string]interface{}{"login": "alex"},
+				"created_at": "2024-07-14T09:00:00Z", "updated_at": "2024-07-15T13:00:00Z", "merged_at": "2024-07-15T13:00:00Z",
+			},
+			map[string]interface{}{
+				"id": 2, "number": 43, "title": "Bump base image", "state": "open",
+				"user":       map[string]interface{}{"login": "sam"},
+				"created_at": "2024-07-15T08:00:00Z", "updated_at": "2024-07-15T10:00:00Z",
+			},
+		},
+		"/repos/acme/infra/pulls/43/reviews": []interface{}{
+			map[string]interface{}{"id": 7, "user": map[string]interface{}{"login": "alex"}, "state": "APPROVED", "submitted_at": "2024-07-15T10:00:00Z"},
+			map[string]interface{}{"id": 8, "user": map[string]interface{}{"login": "kim"}, "state": "COMMENTED", "submitted_at": "2024-07-15T10:30:00Z"},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(ErrorResponse{Message: "Not Found"})
+			return
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "token")
+	activities, err := client.GetUserActivity(context.Background(), since, []string{"acme/infra", "acme/missing"})
+	if err != nil {
+		t.Fatalf("GetUserActivity() error = %v", err)
+	}
+
+	byType := make(map[string]Activity)
+	for _, activity := range activities {
+		byType[activity.Type] = activity
+	}
+	if len(activities) != 3 {
+		t.Fatalf("expected commit, pull request and review, got %+v", activities)
+	}
+
+	commit := byType["commit"]
+	if commit.Title != "OPS-101 Add runner autoscaling" || !commit.CreatedAt.Equal(time.Date(2024, 7, 15, 11, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected commit activity: %+v", commit)
+	}
+	if len(commit.JiraTickets) != 1 || commit.JiraTickets[0] != "OPS-101" {
+		t.Errorf("commit Jira tickets = %v, want [OPS-101]", commit.JiraTickets)
+	}
+
+	if pr := byType["pull_request"]; pr.State != "merged" || pr.Title != "Rotate credentials" {
+		t.Errorf("unexpected pull request activity: %+v", pr)
+	}
+
+	review := byType["review"]
+	if review.State != "approved" || review.Title != "Bump base image" || review.Metadata["pr_author"] != "sam" {
+		t.Errorf("unexpected review activity: %+v", review)
+	}
+}
@@ -0,0 +1,259 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ProjectStatus is the status an issue has on a GitHub Projects board, with the
+// options of the board's status field in column order
+type ProjectStatus struct {
+	Project  string          `json:"project"`
+	Name     string          `json:"name"`
+	OptionID string          `json:"option_id"`
+	Options  []ProjectOption `json:"options"`
+}
+
+// ProjectOption is one option of a Projects single-select field
+type ProjectOption struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetAssignedIssues returns the issues assigned to the authenticated user that were updated
+// since the given time, optionally limited to the given repositories (owner/repo).
+// Pull requests are left out; they are reported as GitHub activity.
+func (c *Client) GetAssignedIssues(ctx context.Context, since time.Time, repos []string) ([]Issue, error) {
+	params := url.Values{
+		"filter":    {"assigned"},
+		"state":     {"all"},
+		"sort":      {"updated"},
+		"direction": {"desc"},
+		"per_page":  {"100"},
+	}
+	if !since.IsZero() {
+		params.Set("since", since.UTC().Format(time.RFC3339))
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", "/issues", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assigned issues: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil {
+			return nil, fmt.Errorf("GitHub API error: %s", errResp.Message)
+		}
+		return nil, fmt.Errorf("GitHub API error: status %d", resp.StatusCode)
+	}
+
+	var issues []Issue
+	if err := json.NewDecoder(resp.Body).Decode(&issues); err != nil {
+		return nil, fmt.Errorf("failed to decode issues response: %w", err)
+	}
+
+	var filtered []Issue
+	for _, issue := range issues {
+		if issue.PullRequest != nil {
+			continue
+		}
+		if len(repos) > 0 && !containsFold(repos, IssueRepository(issue)) {
+			continue
+		}
+		filtered = append(filtered, issue)
+	}
+
+	return filtered, nil
+}
+
+// GetIssueComments returns the comments on an issue created or updated since the given time
+func (c *Client) GetIssueComments(ctx context.Context, repoFullName string, number int, since time.Time) ([]IssueComment, error) {
+	params := url.Values{
+		"per_page": {"100"},
+	}
+	if !since.IsZero() {
+		params.Set("since", since.UTC().Format(time.RFC3339))
+	}
+
+	endpoint := fmt.Sprintf("/repos/%s/issues/%d/comments", repoFullName, number)
+	resp, err := c.makeRequest(ctx, "GET", endpoint, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue comments: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil {
+			return nil, fmt.Errorf("GitHub API error: %s", errResp.Message)
+		}
+		return nil, fmt.Errorf("GitHub API error: status %d", resp.StatusCode)
+	}
+
+	var comments []IssueComment
+	if err := json.NewDecoder(resp.Body).Decode(&comments); err != nil {
+		return nil, fmt.Errorf("failed to decode comments response: %w", err)
+	}
+
+	return comments, nil
+}
+
+// projectStatusQuery reads the value of a single-select field from the project items of issues
+const projectStatusQuery = `query($ids: [ID!]!, $field: String!) {
+  nodes(ids: $ids) {
+    ... on Issue {
+      id
+      projectItems(first: 10) {
+        nodes {
+          project { title }
+          fieldValueByName(name: $field) {
+            ... on ProjectV2ItemFieldSingleSelectValue {
+              name
+              optionId
+              field { ... on ProjectV2SingleSelectField { options { id name } } }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// GetProjectStatuses returns the Projects board status of issues, keyed by issue node ID.
+// The status is read from the named single-select field (usually "Status"); issues that
+// are not on a project, or have no value for the field, are left out.
+func (c *Client) GetProjectStatuses(ctx context.Context, nodeIDs []string, field string) (map[string]*ProjectStatus, error) {
+	statuses := make(map[string]*ProjectStatus)
+
+	// GraphQL allows at most 100 node IDs per lookup
+	for start := 0; start < len(nodeIDs); start += 100 {
+		end := start + 100
+		if end > len(nodeIDs) {
+			end = len(nodeIDs)
+		}
+
+		var result struct {
+			Data struct {
+				Nodes []struct {
+					ID           string `json:"id"`
+					ProjectItems struct {
+						Nodes []struct {
+							Project struct {
+								Title string `json:"title"`
+							} `json:"project"`
+							FieldValueByName *struct {
+								Name     string `json:"name"`
+								OptionID string `json:"optionId"`
+								Field    struct {
+									Options []ProjectOption `json:"options"`
+								} `json:"field"`
+							} `json:"fieldValueByName"`
+						} `json:"nodes"`
+					} `json:"projectItems"`
+				} `json:"nodes"`
+			} `json:"data"`
+			Errors []struct {
+				Message string `json:"message"`
+			} `json:"errors"`
+		}
+
+		variables := map[string]interface{}{"ids": nodeIDs[start:end], "field": field}
+		if err := c.graphQL(ctx, projectStatusQuery, variables, &result); err != nil {
+			return nil, fmt.Errorf("failed to get project statuses: %w", err)
+		}
+		if len(result.Errors) > 0 {
+			return nil, fmt.Errorf("GitHub GraphQL error: %s", result.Errors[0].Message)
+		}
+
+		for _, node := range result.Data.Nodes {
+			// An issue can be on several projects; the first one with a status wins
+			for _, item := range node.ProjectItems.Nodes {
+				if item.FieldValueByName == nil || item.FieldValueByName.Name == "" {
+					continue
+				}
+				statuses[node.ID] = &ProjectStatus{
+					Project:  item.Project.Title,
+					Name:     item.FieldValueByName.Name,
+					OptionID: item.FieldValueByName.OptionID,
+					Options:  item.FieldValueByName.Field.Options,
+				}
+				break
+			}
+		}
+	}
+
+	return statuses, nil
+}
+
+// graphQL runs a query against the GitHub GraphQL API
+func (c *Client) graphQL(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	payload, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	if err != nil {
+		return fmt.Errorf("failed to encode query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.graphQLURL(), bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "my-day-cli/1.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Message != "" {
+			return fmt.Errorf("GitHub API error: %s", errResp.Message)
+		}
+		return fmt.Errorf("GitHub API error: status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode GraphQL response: %w", err)
+	}
+	return nil
+}
+
+// graphQLURL returns the GraphQL endpoint matching the REST base URL.
+// GitHub Enterprise serves REST under /api/v3 and GraphQL under /api/graphql.
+func (c *Client) graphQLURL() string {
+	if strings.HasSuffix(c.baseURL, "/api/v3") {
+		return strings.TrimSuffix(c.baseURL, "/v3") + "/graphql"
+	}
+	return c.baseURL + "/graphql"
+}
+
+// IssueRepository returns the owner/repo name of the repository an issue belongs to
+func IssueRepository(issue Issue) string {
+	if issue.Repository.FullName != "" {
+		return issue.Repository.FullName
+	}
+	// The repository object is only included by some endpoints
+	if index := strings.Index(issue.RepositoryURL, "/repos/"); index >= 0 {
+		return issue.RepositoryURL[index+len("/repos/"):]
+	}
+	return ""
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
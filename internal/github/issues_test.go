@@ -0,0 +1,100 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetAssignedIssuesAndProjectStatuses(t *testing.T) {
+	since := time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/issues":
+			if r.URL.Query().Get("filter") != "assigned" || r.URL.Query().Get("since") != "2024-07-15T00:00:00Z" {
+				t.Errorf("unexpected issues query %s", r.URL.RawQuery)
+			}
+			json.NewEncoder(w).Encode([]interface{}{
+				map[string]interface{}{"id": 1, "node_id": "I_1", "number": 7, "title": "Flaky runner", "state": "open", "repository_url": "https://api.github.com/repos/acme/infra"},
+				map[string]interface{}{"id": 2, "node_id": "PR_2", "number": 8, "title": "Fix runner", "state": "open", "repository_url": "https://api.github.com/repos/acme/infra", "pull_request": map[string]interface{}{}},
+				map[string]interface{}{"id": 3, "node_id": "I_3", "number": 9, "title": "Other repo", "state": "open", "repository_url": "https://api.github.com/repos/acme/web"},
+			})
+		case "/graphql":
+			var request struct {
+				Variables struct {
+					IDs   []string `json:"ids"`
+					Field string   `json:"field"`
+				} `json:"variables"`
+			}
+			json.NewDecoder(r.Body).Decode(&request)
+			if len(request.Variables.IDs) != 1 || request.Variables.Field != "Status" {
+				t.Errorf("unexpected GraphQL variables %+v", request.Variables)
+			}
+			w.Write([]byte(`{"data": {"nodes": [{"id": "I_1", "projectItems": {"nodes": [
+				{"project": {"title": "Platform"}, "fieldValueByName": {"name": "In Review", "optionId": "b",
+				 "field": {"options": [{"id": "a", "name": "Todo"}, {"id": "b", "name": "In Review"}, {"id": "c", "name": "Done"}]}}}
+			]}}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "token")
+	issues, err := client.GetAssignedIssues(context.Background(), since, []string{"acme/infra"})
+	if err != nil {
+		t.Fatalf("GetAssignedIssues() error = %v", err)
+	}
+	if len(issues) != 1 || issues[0].Number != 7 {
+		t.Fatalf("expected only issue 7 (no pull requests or other repos), got %+v", issues)
+	}
+
+	statuses, err := client.GetProjectStatuses(context.Background(), []string{issues[0].NodeID}, "Status")
+	if err != nil {
+		t.Fatalf("GetProjectStatuses() error = %v", err)
+	}
+	status := statuses["I_1"]
+	if status == nil || status.Name != "In Review" || status.Project != "Platform" || len(status.Options) != 3 {
+		t.Fatalf("unexpected project status %+v", status)
+	}
+
+	issue := ToJiraIssue(issues[0], status)
+	if issue.Key != "acme/infra#7" || issue.Fields.Project.Key != "infra" {
+		t.Errorf("unexpected issue key %s (project %s)", issue.Key, issue.Fields.Project.Key)
+	}
+	if issue.Fields.Status.Name != "In Review" || issue.Fields.Status.Category.Key != "indeterminate" {
+		t.Errorf("expected in-progress board status, got %+v", issue.Fields.Status)
+	}
+
+	columns := ProjectBoardColumns(statuses)
+	if columns == nil || columns.Column(issue.Fields.Status) != "In Review" || len(columns.ColumnNames()) != 3 {
+		t.Errorf("unexpected board columns %+v", columns)
+	}
+}
+
+func TestIssueStatus(t *testing.T) {
+	tests := []struct {
+		state    string
+		status   *ProjectStatus
+		name     string
+		category string
+	}{
+		{"open", nil, "Open", "new"},
+		{"closed", nil, "Closed", "done"},
+		{"open", &ProjectStatus{Name: "Backlog"}, "Backlog", "new"},
+		{"open", &ProjectStatus{Name: "In Progress"}, "In Progress", "indeterminate"},
+		{"open", &ProjectStatus{Name: "Done"}, "Done", "done"},
+		{"closed", &ProjectStatus{Name: "In Progress"}, "In Progress", "done"},
+	}
+
+	for _, tt := range tests {
+		status := issueStatus(Issue{State: tt.state}, tt.status)
+		if status.Name != tt.name || status.Category.Key != tt.category {
+			t.Errorf("issueStatus(%s, %+v) = %s/%s, want %s/%s", tt.state, tt.status, status.Name, status.Category.Key, tt.name, tt.category)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package github
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"my-day/internal/jira"
+)
+
+// The GitHub Issues tracker maps issues onto Jira's issue model so the existing report and
+// LLM pipeline can be reused unchanged. Issue keys are "owner/repo#number" and the status is
+// the Projects board status when the issue is on a board, or Open/Closed otherwise.
+
+var (
+	todoCategory       = jira.StatusCategory{ID: "2", Key: "new", Name: "To Do"}
+	inProgressCategory = jira.StatusCategory{ID: "4", Key: "indeterminate", Name: "In Progress"}
+	doneCategory       = jira.StatusCategory{ID: "3", Key: "done", Name: "Done"}
+)
+
+// ToJiraIssue converts a GitHub issue, and its Projects board status if any, to a Jira issue
+func ToJiraIssue(issue Issue, status *ProjectStatus) jira.Issue {
+	repo := IssueRepository(issue)
+	key := fmt.Sprintf("%s#%d", repo, issue.Number)
+
+	var labels []string
+	for _, label := range issue.Labels {
+		labels = append(labels, label.Name)
+	}
+
+	var assignee *jira.User
+	if len(issue.Assignees) > 0 {
+		user := ToJiraUser(issue.Assignees[0])
+		assignee = &user
+	}
+
+	var resolution *jira.Resolution
+	if issue.State == "closed" {
+		resolution = &jira.Resolution{ID: "closed", Name: "Closed"}
+	}
+
+	repoName := repo
+	if index := strings.LastIndex(repo, "/"); index >= 0 {
+		repoName = repo[index+1:]
+	}
+
+	return jira.Issue{
+		ID:   strconv.FormatInt(issue.ID, 10),
+		Key:  key,
+		Self: issue.HTMLURL,
+		Fields: jira.Fields{
+			Summary:     issue.Title,
+			Description: jira.JiraDescription{Text: issue.Body},
+			Status:      issueStatus(issue, status),
+			IssueType:   jira.IssueType{ID: "issue", Name: "Issue"},
+			Project:     jira.Project{ID: repo, Key: repoName, Name: repo},
+			Assignee:    assignee,
+			Reporter:    ToJiraUser(issue.User),
+			Created:     jira.JiraTime{Time: issue.CreatedAt.Time},
+			Updated:     jira.JiraTime{Time: issue.UpdatedAt.Time},
+			Resolution:  resolution,
+			Labels:      labels,
+		},
+	}
+}
+
+// ToJiraComment converts a GitHub issue comment to a Jira comment
+func ToJiraComment(comment IssueComment) jira.Comment {
+	return jira.Comment{
+		ID:      strconv.FormatInt(comment.ID, 10),
+		Author:  ToJiraUser(comment.User),
+		Body:    jira.JiraDescription{Text: comment.Body},
+		Created: jira.JiraTime{Time: comment.CreatedAt.Time},
+		Updated: jira.JiraTime{Time: comment.UpdatedAt.Time},
+	}
+}
+
+// ProjectBoardColumns returns the columns of the first Projects board found in the statuses,
+// in board order, so reports can be grouped by board column
+func ProjectBoardColumns(statuses map[string]*ProjectStatus) *jira.BoardColumnMap {
+	var board *ProjectStatus
+	for _, status := range statuses {
+		if len(status.Options) == 0 {
+			continue
+		}
+		// Map iteration order is random; pick the board deterministically
+		if board == nil || status.Project < board.Project {
+			board = status
+		}
+	}
+	if board == nil {
+		return nil
+	}
+
+	columns := &jira.BoardColumnMap{BoardName: board.Project}
+	for _, option := range board.Options {
+		columns.Columns = append(columns.Columns, jira.BoardColumn{Name: option.Name, StatusIDs: []string{option.ID}})
+	}
+	return columns
+}
+
+// ToJiraUser converts a GitHub user to a Jira user, using the login as account ID
+func ToJiraUser(user User) jira.User {
+	displayName := user.Name
+	if displayName == "" {
+		displayName = user.Login
+	}
+	return jira.User{AccountID: user.Login, DisplayName: displayName, EmailAddress: user.Email}
+}
+
+// issueStatus returns the Jira status for an issue. Closed issues are always done; open issues
+// take their category from the board status name, since Projects has no status categories.
+func issueStatus(issue Issue, status *ProjectStatus) jira.Status {
+	closed := issue.State == "closed"
+	if status == nil {
+		if closed {
+			return jira.Status{ID: "closed", Name: "Closed", Category: doneCategory}
+		}
+		return jira.Status{ID: "open", Name: "Open", Category: todoCategory}
+	}
+
+	result := jira.Status{ID: status.OptionID, Name: status.Name}
+	name := strings.ToLower(status.Name)
+	switch {
+	case closed || containsAny(name, "done", "closed", "complete", "shipped", "released"):
+		result.Category = doneCategory
+	case containsAny(name, "todo", "to do", "backlog", "triage"):
+		result.Category = todoCategory
+	default:
+		result.Category = inProgressCategory
+	}
+	return result
+}
+
+func containsAny(value string, substrings ...string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(value, substring) {
+			return true
+		}
+	}
+	return false
+}
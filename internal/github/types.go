@@ -131,6 +131,30 @@ type Commit struct {
 	HTMLURL   string     `json:"html_url"`
 	Tree      Tree       `json:"tree"`
 	Parents   []Tree     `json:"parents"`
+	Details   CommitDetails `json:"commit"` // Git data as returned by the repository commits API
+}
+
+// CommitDetails holds the git data of a commit listed by the repository commits API
+type CommitDetails struct {
+	Message   string     `json:"message"`
+	Author    CommitUser `json:"author"`
+	Committer CommitUser `json:"committer"`
+}
+
+// GetMessage returns the commit message from whichever representation the API returned
+func (c Commit) GetMessage() string {
+	if c.Details.Message != "" {
+		return c.Details.Message
+	}
+	return c.Message
+}
+
+// GetDate returns the authored date from whichever representation the API returned
+func (c Commit) GetDate() time.Time {
+	if !c.Details.Author.Date.IsZero() {
+		return c.Details.Author.Date.Time
+	}
+	return c.Author.Date.Time
 }
 
 // CommitUser represents the author/committer of a commit
@@ -148,21 +172,34 @@ type Tree struct {
 
 // Issue represents a GitHub issue
 type Issue struct {
-	ID          int64      `json:"id"`
-	Number      int        `json:"number"`
-	Title       string     `json:"title"`
-	Body        string     `json:"body"`
-	State       string     `json:"state"`       // open, closed
-	Locked      bool       `json:"locked"`
-	HTMLURL     string     `json:"html_url"`
-	User        User       `json:"user"`
-	Assignees   []User     `json:"assignees"`
-	Labels      []Label    `json:"labels"`
-	Milestone   *Milestone `json:"milestone"`
-	CreatedAt   GitHubTime `json:"created_at"`
-	UpdatedAt   GitHubTime `json:"updated_at"`
-	ClosedAt    *GitHubTime `json:"closed_at"`
-	Repository  Repository `json:"repository"`
+	ID            int64       `json:"id"`
+	NodeID        string      `json:"node_id"`
+	Number        int         `json:"number"`
+	Title         string      `json:"title"`
+	Body          string      `json:"body"`
+	State         string      `json:"state"` // open, closed
+	Locked        bool        `json:"locked"`
+	HTMLURL       string      `json:"html_url"`
+	User          User        `json:"user"`
+	Assignees     []User      `json:"assignees"`
+	Labels        []Label     `json:"labels"`
+	Milestone     *Milestone  `json:"milestone"`
+	CreatedAt     GitHubTime  `json:"created_at"`
+	UpdatedAt     GitHubTime  `json:"updated_at"`
+	ClosedAt      *GitHubTime `json:"closed_at"`
+	Repository    Repository  `json:"repository"`
+	RepositoryURL string      `json:"repository_url"`
+	PullRequest   *struct{}   `json:"pull_request,omitempty"` // Set when the issue is a pull request
+}
+
+// IssueComment represents a comment on a GitHub issue
+type IssueComment struct {
+	ID        int64      `json:"id"`
+	Body      string     `json:"body"`
+	HTMLURL   string     `json:"html_url"`
+	User      User       `json:"user"`
+	CreatedAt GitHubTime `json:"created_at"`
+	UpdatedAt GitHubTime `json:"updated_at"`
 }
 
 // Label represents a GitHub label
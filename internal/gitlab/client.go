@@ -0,0 +1,302 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"my-day/internal/issuekeys"
+)
+
+const (
+	// DefaultBaseURL is the GitLab.com base URL; self-hosted instances use their own
+	DefaultBaseURL = "https://gitlab.com"
+
+	// DefaultTimeout is the default HTTP client timeout
+	DefaultTimeout = 30 * time.Second
+)
+
+// Client represents a GitLab API client
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	token      string
+}
+
+// NewClient creates a new GitLab client for the given instance using a personal access token
+func NewClient(baseURL, token string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{Timeout: DefaultTimeout},
+		token:      token,
+	}
+}
+
+// get makes an authenticated GET request to the GitLab REST API and decodes the JSON response
+func (c *Client) get(ctx context.Context, endpoint string, params url.Values, result interface{}) error {
+	reqURL := c.baseURL + "/api/v4" + endpoint
+	if len(params) > 0 {
+		reqURL += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("PRIVATE-TOKEN", c.token)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "my-day-cli/1.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.String() != "" {
+			return fmt.Errorf("GitLab API error: %s", errResp.String())
+		}
+		return fmt.Errorf("GitLab API error: status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// GetCurrentUser returns the user the token belongs to
+func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	var user User
+	if err := c.get(ctx, "/user", nil, &user); err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+	return &user, nil
+}
+
+// GetProject returns a project by its path with namespace, e.g. "group/subgroup/project"
+func (c *Client) GetProject(ctx context.Context, path string) (*Project, error) {
+	var project Project
+	if err := c.get(ctx, "/projects/"+url.PathEscape(path), nil, &project); err != nil {
+		return nil, fmt.Errorf("failed to get project %s: %w", path, err)
+	}
+	return &project, nil
+}
+
+// GetMemberProjects returns projects the user is a member of with activity since the given time
+func (c *Client) GetMemberProjects(ctx context.Context, since time.Time) ([]Project, error) {
+	params := url.Values{
+		"membership": {"true"},
+		"order_by":   {"last_activity_at"},
+		"sort":       {"desc"},
+		"simple":     {"true"},
+		"per_page":   {"100"},
+	}
+	if !since.IsZero() {
+		params.Set("last_activity_after", since.Format(time.RFC3339))
+	}
+
+	var projects []Project
+	if err := c.get(ctx, "/projects", params, &projects); err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+	return projects, nil
+}
+
+// GetMergeRequests returns merge requests in a project authored by the user and updated since the given time
+func (c *Client) GetMergeRequests(ctx context.Context, projectID int64, authorID int64, since time.Time) ([]MergeRequest, error) {
+	params := url.Values{
+		"author_id": {strconv.FormatInt(authorID, 10)},
+		"scope":     {"all"},
+		"order_by":  {"updated_at"},
+		"per_page":  {"100"},
+	}
+	if !since.IsZero() {
+		params.Set("updated_after", since.Format(time.RFC3339))
+	}
+
+	var mergeRequests []MergeRequest
+	endpoint := fmt.Sprintf("/projects/%d/merge_requests", projectID)
+	if err := c.get(ctx, endpoint, params, &mergeRequests); err != nil {
+		return nil, fmt.Errorf("failed to get merge requests: %w", err)
+	}
+	return mergeRequests, nil
+}
+
+// GetCommits returns commits on any branch of a project since the given time
+func (c *Client) GetCommits(ctx context.Context, projectID int64, since time.Time) ([]Commit, error) {
+	params := url.Values{
+		"all":      {"true"},
+		"per_page": {"100"},
+	}
+	if !since.IsZero() {
+		params.Set("since", since.Format(time.RFC3339))
+	}
+
+	var commits []Commit
+	endpoint := fmt.Sprintf("/projects/%d/repository/commits", projectID)
+	if err := c.get(ctx, endpoint, params, &commits); err != nil {
+		return nil, fmt.Errorf("failed to get commits: %w", err)
+	}
+	return commits, nil
+}
+
+// GetPipelines returns pipelines in a project triggered by the user and updated since the given time
+func (c *Client) GetPipelines(ctx context.Context, projectID int64, username string, since time.Time) ([]Pipeline, error) {
+	params := url.Values{
+		"username": {username},
+		"order_by": {"updated_at"},
+		"per_page": {"100"},
+	}
+	if !since.IsZero() {
+		params.Set("updated_after", since.Format(time.RFC3339))
+	}
+
+	var pipelines []Pipeline
+	endpoint := fmt.Sprintf("/projects/%d/pipelines", projectID)
+	if err := c.get(ctx, endpoint, params, &pipelines); err != nil {
+		return nil, fmt.Errorf("failed to get pipelines: %w", err)
+	}
+	return pipelines, nil
+}
+
+// GetUserActivity returns the merge requests, commits and pipelines of the authenticated user
+// since the given time. With no projects listed, every project the user is a member of is scanned.
+func (c *Client) GetUserActivity(ctx context.Context, since time.Time, projects []string) ([]Activity, error) {
+	user, err := c.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var scanned []Project
+	if len(projects) > 0 {
+		for _, path := range projects {
+			project, err := c.GetProject(ctx, path)
+			if err != nil {
+				// Skip projects that are missing or not accessible
+				continue
+			}
+			scanned = append(scanned, *project)
+		}
+	} else {
+		scanned, err = c.GetMemberProjects(ctx, since)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var activities []Activity
+	for _, project := range scanned {
+		activities = append(activities, c.getProjectActivity(ctx, project, user, since)...)
+	}
+	return activities, nil
+}
+
+// getProjectActivity collects the user's activity in one project, skipping any API that fails
+func (c *Client) getProjectActivity(ctx context.Context, project Project, user *User, since time.Time) []Activity {
+	var activities []Activity
+
+	if mergeRequests, err := c.GetMergeRequests(ctx, project.ID, user.ID, since); err == nil {
+		for _, mr := range mergeRequests {
+			activity := Activity{
+				Type:        "merge_request",
+				ID:          strconv.FormatInt(mr.ID, 10),
+				Title:       mr.Title,
+				Description: mr.Description,
+				State:       mr.State,
+				URL:         mr.WebURL,
+				Project:     project.PathWithNamespace,
+				Author:      mr.Author.Username,
+				CreatedAt:   mr.CreatedAt,
+				UpdatedAt:   mr.UpdatedAt,
+				JiraTickets: issuekeys.Extract(mr.Title + " " + mr.Description + " " + mr.SourceBranch),
+				Metadata: map[string]interface{}{
+					"iid":           mr.IID,
+					"draft":         mr.Draft,
+					"source_branch": mr.SourceBranch,
+					"target_branch": mr.TargetBranch,
+				},
+			}
+			if mr.MergedAt != nil {
+				activity.Metadata["merged_at"] = *mr.MergedAt
+			}
+			activities = append(activities, activity)
+		}
+	}
+
+	if commits, err := c.GetCommits(ctx, project.ID, since); err == nil {
+		for _, commit := range commits {
+			if !isUserCommit(commit, user) {
+				continue
+			}
+			activities = append(activities, Activity{
+				Type:        "commit",
+				ID:          commit.ID,
+				Title:       commit.Title,
+				Description: commit.Message,
+				State:       "committed",
+				URL:         commit.WebURL,
+				Project:     project.PathWithNamespace,
+				Author:      user.Username,
+				CreatedAt:   commit.AuthoredDate,
+				UpdatedAt:   commit.AuthoredDate,
+				JiraTickets: issuekeys.Extract(commit.Message),
+				Metadata: map[string]interface{}{
+					"short_id": commit.ShortID,
+				},
+			})
+		}
+	}
+
+	if pipelines, err := c.GetPipelines(ctx, project.ID, user.Username, since); err == nil {
+		for _, pipeline := range pipelines {
+			activities = append(activities, Activity{
+				Type:        "pipeline",
+				ID:          strconv.FormatInt(pipeline.ID, 10),
+				Title:       fmt.Sprintf("Pipeline on %s", pipeline.Ref),
+				State:       pipeline.Status,
+				URL:         pipeline.WebURL,
+				Project:     project.PathWithNamespace,
+				Author:      user.Username,
+				CreatedAt:   pipeline.CreatedAt,
+				UpdatedAt:   pipeline.UpdatedAt,
+				JiraTickets: issuekeys.Extract(pipeline.Ref),
+				Metadata: map[string]interface{}{
+					"ref":    pipeline.Ref,
+					"sha":    pipeline.SHA,
+					"source": pipeline.Source,
+				},
+			})
+		}
+	}
+
+	return activities
+}
+
+// isUserCommit reports whether a commit was authored by the user; the commits API has no author filter
+func isUserCommit(commit Commit, user *User) bool {
+	for _, email := range []string{user.Email, user.CommitEmail} {
+		if email != "" && strings.EqualFold(commit.AuthorEmail, email) {
+			return true
+		}
+	}
+	return user.Name != "" && commit.AuthorName == user.Name
+}
+
+// TestConnection tests the GitLab API connection
+func (c *Client) TestConnection(ctx context.Context) error {
+	if _, err := c.GetCurrentUser(ctx); err != nil {
+		return fmt.Errorf("GitLab connection test failed: %w", err)
+	}
+	return nil
+}
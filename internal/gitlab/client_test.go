@@ -0,0 +1,90 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetUserActivity(t *testing.T) {
+	since := time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)
+
+	responses := map[string]interface{}{
+		"/api/v4/user":                      map[string]interface{}{"id": 5, "username": "alex", "name": "Alex Dev", "email": "alex@example.com"},
+		"/api/v4/projects/acme/platform/ci": map[string]interface{}{"id": 77, "path_with_namespace": "acme/platform/ci"},
+		"/api/v4/projects/77/merge_requests": []interface{}{
+			map[string]interface{}{
+				"id": 301, "iid": 12, "title": "Runner autoscaling", "state": "merged", "source_branch": "OPS-101-autoscaling",
+				"author":     map[string]interface{}{"username": "alex"},
+				"created_at": "2024-07-14T09:00:00Z", "updated_at": "2024-07-15T16:00:00Z", "merged_at": "2024-07-15T15:00:00Z",
+			},
+		},
+		"/api/v4/projects/77/repository/commits": []interface{}{
+			map[string]interface{}{"id": "f00d", "title": "Fix runner cache path", "message": "Fix runner cache path", "author_email": "ALEX@example.com", "authored_date": "2024-07-15T12:00:00Z"},
+			map[string]interface{}{"id": "beef", "title": "Someone else's commit", "author_email": "sam@example.com", "author_name": "Sam", "authored_date": "2024-07-15T13:00:00Z"},
+		},
+		"/api/v4/projects/77/pipelines": []interface{}{
+			map[string]interface{}{"id": 9001, "status": "failed", "ref": "main", "created_at": "2024-07-15T15:00:00Z", "updated_at": "2024-07-15T15:10:00Z"},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("PRIVATE-TOKEN") != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"message": "401 Unauthorized"})
+			return
+		}
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]string{"message": "404 Project Not Found"})
+			return
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL+"/", "token")
+	activities, err := client.GetUserActivity(context.Background(), since, []string{"acme/platform/ci", "acme/missing"})
+	if err != nil {
+		t.Fatalf("GetUserActivity() error = %v", err)
+	}
+	if len(activities) != 3 {
+		t.Fatalf("expected merge request, own commit and pipeline, got %+v", activities)
+	}
+
+	byType := make(map[string]Activity)
+	for _, activity := range activities {
+		byType[activity.Type] = activity
+	}
+
+	mr := byType["merge_request"]
+	if mr.State != "merged" || mr.Project != "acme/platform/ci" || len(mr.JiraTickets) != 1 || mr.JiraTickets[0] != "OPS-101" {
+		t.Errorf("unexpected merge request activity: %+v", mr)
+	}
+	if _, ok := mr.Metadata["merged_at"].(time.Time); !ok {
+		t.Errorf("merge request metadata is missing merged_at: %+v", mr.Metadata)
+	}
+	if commit := byType["commit"]; commit.ID != "f00d" {
+		t.Errorf("unexpected commit activity: %+v", commit)
+	}
+	if pipeline := byType["pipeline"]; pipeline.State != "failed" || pipeline.Metadata["ref"] != "main" {
+		t.Errorf("unexpected pipeline activity: %+v", pipeline)
+	}
+}
+
+func TestGetCurrentUserError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(map[string]string{"message": "401 Unauthorized"})
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "bad").GetCurrentUser(context.Background())
+	if err == nil || err.Error() != "failed to get current user: GitLab API error: 401 Unauthorized" {
+		t.Errorf("GetCurrentUser() error = %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// User represents a GitLab user
+type User struct {
+	ID          int64  `json:"id"`
+	Username    string `json:"username"`
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	CommitEmail string `json:"commit_email"`
+	WebURL      string `json:"web_url"`
+}
+
+// Project represents a GitLab project
+type Project struct {
+	ID                int64     `json:"id"`
+	Name              string    `json:"name"`
+	PathWithNamespace string    `json:"path_with_namespace"`
+	WebURL            string    `json:"web_url"`
+	LastActivityAt    time.Time `json:"last_activity_at"`
+}
+
+// MergeRequest represents a GitLab merge request
+type MergeRequest struct {
+	ID           int64      `json:"id"`
+	IID          int        `json:"iid"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	State        string     `json:"state"` // opened, closed, locked, merged
+	Draft        bool       `json:"draft"`
+	WebURL       string     `json:"web_url"`
+	Author       User       `json:"author"`
+	SourceBranch string     `json:"source_branch"`
+	TargetBranch string     `json:"target_branch"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	MergedAt     *time.Time `json:"merged_at"`
+}
+
+// Commit represents a GitLab commit
+type Commit struct {
+	ID           string    `json:"id"`
+	ShortID      string    `json:"short_id"`
+	Title        string    `json:"title"`
+	Message      string    `json:"message"`
+	AuthorName   string    `json:"author_name"`
+	AuthorEmail  string    `json:"author_email"`
+	AuthoredDate time.Time `json:"authored_date"`
+	WebURL       string    `json:"web_url"`
+}
+
+// Pipeline represents a GitLab CI/CD pipeline
+type Pipeline struct {
+	ID        int64     `json:"id"`
+	IID       int       `json:"iid"`
+	Status    string    `json:"status"` // created, running, success, failed, canceled, ...
+	Source    string    `json:"source"`
+	Ref       string    `json:"ref"`
+	SHA       string    `json:"sha"`
+	WebURL    string    `json:"web_url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Activity represents a unified activity item from GitLab
+type Activity struct {
+	Type        string                 `json:"type"`         // merge_request, commit, pipeline
+	ID          string                 `json:"id"`           // Unique identifier
+	Title       string                 `json:"title"`        // Human readable title
+	Description string                 `json:"description"`  // Additional details
+	State       string                 `json:"state"`        // Current state
+	URL         string                 `json:"url"`          // Link to GitLab
+	Project     string                 `json:"project"`      // Project path with namespace
+	Author      string                 `json:"author"`       // Author username
+	CreatedAt   time.Time              `json:"created_at"`   // When it was created
+	UpdatedAt   time.Time              `json:"updated_at"`   // When it was last updated
+	JiraTickets []string               `json:"jira_tickets"` // Linked Jira ticket keys
+	Metadata    map[string]interface{} `json:"metadata"`     // Additional type-specific data
+}
+
+// ErrorResponse represents a GitLab API error response
+type ErrorResponse struct {
+	Message interface{} `json:"message"` // A string or a map of field errors
+	Error   string      `json:"error"`
+}
+
+// String returns the most useful error text from the response
+func (e ErrorResponse) String() string {
+	if e.Error != "" {
+		return e.Error
+	}
+	if message, ok := e.Message.(string); ok {
+		return message
+	}
+	if e.Message != nil {
+		return fmt.Sprintf("%v", e.Message)
+	}
+	return ""
+}
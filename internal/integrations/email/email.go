@@ -0,0 +1,90 @@
+// Package email delivers messages, such as the weekly digest, through an SMTP server.
+package email
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+)
+
+// implicitTLSPort is the SMTP submission port whose connections start with TLS (SMTPS).
+// Other ports upgrade to TLS with STARTTLS when the server offers it.
+const implicitTLSPort = 465
+
+// Config is the SMTP server messages are sent through
+type Config struct {
+	Host     string
+	Port     int
+	Username string // Empty to send without authentication
+	Password string
+}
+
+// Send delivers message, a complete RFC 5322 message with its headers, from from to the
+// recipients in to
+func Send(config Config, from string, to []string, message []byte) error {
+	if config.Host == "" {
+		return fmt.Errorf("no SMTP server configured, set report.email.smtp_host")
+	}
+	if from == "" {
+		return fmt.Errorf("no sender address, set report.email.from")
+	}
+	if len(to) == 0 {
+		return fmt.Errorf("no recipients, set report.email.to")
+	}
+
+	addr := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	var client *smtp.Client
+	if config.Port == implicitTLSPort {
+		conn, err := tls.Dial("tcp", addr, &tls.Config{ServerName: config.Host})
+		if err != nil {
+			return fmt.Errorf("failed to connect to %s: %w", addr, err)
+		}
+		client, err = smtp.NewClient(conn, config.Host)
+		if err != nil {
+			conn.Close()
+			return fmt.Errorf("failed to connect to %s: %w", addr, err)
+		}
+	} else {
+		var err error
+		client, err = smtp.Dial(addr)
+		if err != nil {
+			return fmt.Errorf("failed to connect to %s: %w", addr, err)
+		}
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: config.Host}); err != nil {
+				client.Close()
+				return fmt.Errorf("failed to start TLS with %s: %w", addr, err)
+			}
+		}
+	}
+	defer client.Close()
+
+	if config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", config.Username, config.Password, config.Host)); err != nil {
+			return fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+	if err := client.Mail(from); err != nil {
+		return fmt.Errorf("SMTP server rejected the sender %s: %w", from, err)
+	}
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return fmt.Errorf("SMTP server rejected the recipient %s: %w", recipient, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	if _, err := w.Write(message); err != nil {
+		w.Close()
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	return client.Quit()
+}
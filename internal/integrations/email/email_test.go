@@ -0,0 +1,108 @@
+package email
+
+import (
+	"bufio"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// fakeSMTPServer accepts one SMTP session without TLS or authentication and returns its
+// listener and a channel with the envelope and data it received
+func fakeSMTPServer(t *testing.T) (net.Listener, <-chan []string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	received := make(chan []string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+		var lines []string
+		reply("220 localhost ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			switch command := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); command {
+			case "EHLO", "HELO":
+				reply("250 localhost")
+			case "MAIL", "RCPT":
+				lines = append(lines, line)
+				reply("250 OK")
+			case "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				for {
+					data, err := reader.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if data == ".\r\n" {
+						break
+					}
+					lines = append(lines, strings.TrimRight(data, "\r\n"))
+				}
+				reply("250 OK")
+			case "QUIT":
+				reply("221 Bye")
+				received <- lines
+				return
+			default:
+				reply("502 Command not implemented")
+			}
+		}
+	}()
+	return listener, received
+}
+
+func TestSend(t *testing.T) {
+	listener, received := fakeSMTPServer(t)
+	defer listener.Close()
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	portNumber, _ := strconv.Atoi(port)
+
+	message := "Subject: Team Digest\r\n\r\nHello team\r\n"
+	err := Send(Config{Host: host, Port: portNumber}, "me@example.com", []string{"boss@example.com", "lead@example.com"}, []byte(message))
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	got := strings.Join(<-received, "\n")
+	for _, want := range []string{"MAIL FROM:<me@example.com>", "RCPT TO:<boss@example.com>", "RCPT TO:<lead@example.com>", "Subject: Team Digest", "Hello team"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in the session, got:\n%s", want, got)
+		}
+	}
+}
+
+func TestSendRequiresConfiguration(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		from   string
+		to     []string
+		want   string
+	}{
+		{"no server", Config{}, "me@example.com", []string{"boss@example.com"}, "report.email.smtp_host"},
+		{"no sender", Config{Host: "smtp.example.com", Port: 587}, "", []string{"boss@example.com"}, "report.email.from"},
+		{"no recipients", Config{Host: "smtp.example.com", Port: 587}, "me@example.com", nil, "report.email.to"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Send(tt.config, tt.from, tt.to, []byte("Subject: test\r\n\r\n"))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected an error mentioning %s, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+const (
+	// DefaultAPIURL is the Slack Web API base URL
+	DefaultAPIURL = "https://slack.com/api"
+
+	// DefaultTimeout is the default HTTP client timeout
+	DefaultTimeout = 30 * time.Second
+
+	// maxBlocks is the maximum number of blocks Slack accepts in one message
+	maxBlocks = 50
+
+	// maxSectionText is the maximum length of a section block's text
+	maxSectionText = 3000
+)
+
+// Message is a Slack message with Block Kit blocks
+type Message struct {
+	Channel string  `json:"channel,omitempty"`
+	Text    string  `json:"text"` // Fallback for notifications and clients without Block Kit
+	Blocks  []Block `json:"blocks"`
+}
+
+// Block is a Block Kit layout block
+type Block struct {
+	Type     string  `json:"type"`
+	Text     *Text   `json:"text,omitempty"`
+	Elements []*Text `json:"elements,omitempty"`
+}
+
+// Text is a Block Kit text object
+type Text struct {
+	Type  string `json:"type"` // plain_text or mrkdwn
+	Text  string `json:"text"`
+	Emoji bool   `json:"emoji,omitempty"`
+}
+
+// Section is a titled group of issues in the report, such as "In Progress"
+type Section struct {
+	Title  string
+	Issues []jira.Issue
+	Notes  map[string][]string // Lines shown under an issue, such as its next step, by issue key
+}
+
+// BuildMessage formats the report sections as a Block Kit message.
+// When jiraURL is set, issue keys link to the issues in Jira.
+func BuildMessage(targetDate time.Time, jiraURL string, sections []Section, worklogCount int) Message {
+	title := fmt.Sprintf("Daily Standup Report - %s", targetDate.Format("January 2, 2006"))
+
+	issueCount := 0
+	for _, section := range sections {
+		issueCount += len(section.Issues)
+	}
+
+	return BuildTitledMessage("🚀", title, fmt.Sprintf("%d issues · %d worklog entries · generated by my-day", issueCount, worklogCount), jiraURL, sections)
+}
+
+// BuildTitledMessage formats sections of issues as a Block Kit message with a header, led by
+// icon, and a context line, such as the handoff to another team
+func BuildTitledMessage(icon, title, context, jiraURL string, sections []Section) Message {
+	msg := Message{Text: title}
+	msg.Blocks = append(msg.Blocks,
+		Block{Type: "header", Text: &Text{Type: "plain_text", Text: icon + " " + title, Emoji: true}},
+		Block{Type: "context", Elements: []*Text{
+			{Type: "mrkdwn", Text: context},
+		}},
+	)
+
+	for _, section := range sections {
+		if len(section.Issues) == 0 {
+			continue
+		}
+
+		msg.Blocks = append(msg.Blocks, Block{Type: "divider"})
+
+		var lines []string
+		lines = append(lines, fmt.Sprintf("*%s (%d)*", escape(section.Title), len(section.Issues)))
+		for _, issue := range section.Issues {
+			lines = append(lines, formatIssue(issue, jiraURL))
+			for _, note := range section.Notes[issue.Key] {
+				lines = append(lines, "    ↳ "+escape(note))
+			}
+		}
+
+		for _, text := range chunkLines(lines, maxSectionText) {
+			msg.Blocks = append(msg.Blocks, Block{Type: "section", Text: &Text{Type: "mrkdwn", Text: text}})
+		}
+	}
+
+	if len(msg.Blocks) > maxBlocks {
+		msg.Blocks = append(msg.Blocks[:maxBlocks-1], Block{Type: "context", Elements: []*Text{
+			{Type: "mrkdwn", Text: "_Report truncated to fit Slack's message limits_"},
+		}})
+	}
+
+	return msg
+}
+
+// formatIssue renders one issue as a mrkdwn bullet line
+func formatIssue(issue jira.Issue, jiraURL string) string {
+	key := escape(issue.Key)
+	if jiraURL != "" {
+		key = fmt.Sprintf("<%s/browse/%s|%s>", strings.TrimSuffix(jiraURL, "/"), issue.Key, key)
+	}
+
+	line := fmt.Sprintf("• *%s* %s", key, escape(issue.Fields.Summary))
+	if issue.Fields.Status.Name != "" {
+		line += fmt.Sprintf(" _(%s)_", escape(issue.Fields.Status.Name))
+	}
+	return line
+}
+
+// chunkLines joins lines into texts no longer than limit, splitting only between lines
+func chunkLines(lines []string, limit int) []string {
+	var chunks []string
+	var current strings.Builder
+
+	for _, line := range lines {
+		if len(line) > limit {
+			line = line[:limit-1] + "…"
+		}
+		if current.Len() > 0 && current.Len()+1+len(line) > limit {
+			chunks = append(chunks, current.String())
+			current.Reset()
+		}
+		if current.Len() > 0 {
+			current.WriteString("\n")
+		}
+		current.WriteString(line)
+	}
+	if current.Len() > 0 {
+		chunks = append(chunks, current.String())
+	}
+
+	return chunks
+}
+
+// escape escapes the characters Slack treats as control sequences in mrkdwn
+func escape(s string) string {
+	s = strings.ReplaceAll(s, "&", "&amp;")
+	s = strings.ReplaceAll(s, "<", "&lt;")
+	s = strings.ReplaceAll(s, ">", "&gt;")
+	return s
+}
+
+// Client posts messages to Slack through an incoming webhook or a bot token
+type Client struct {
+	apiURL     string
+	httpClient *http.Client
+	webhookURL string
+	botToken   string
+	channel    string
+}
+
+// NewClient creates a Slack client. The webhook URL takes precedence over the bot token.
+func NewClient(webhookURL, botToken, channel string) *Client {
+	return &Client{
+		apiURL:     DefaultAPIURL,
+		httpClient: &http.Client{Timeout: DefaultTimeout},
+		webhookURL: webhookURL,
+		botToken:   botToken,
+		channel:    channel,
+	}
+}
+
+// Destination describes where messages are posted
+func (c *Client) Destination() string {
+	if c.webhookURL != "" {
+		return "incoming webhook"
+	}
+	return c.channel
+}
+
+// Post sends the message to the configured webhook or channel
+func (c *Client) Post(ctx context.Context, msg Message) error {
+	if c.webhookURL != "" {
+		return c.postWebhook(ctx, msg)
+	}
+	if c.botToken == "" || c.channel == "" {
+		return fmt.Errorf("slack not configured. Set slack.webhook_url, or slack.bot_token and slack.channel")
+	}
+	return c.postMessage(ctx, msg)
+}
+
+// postWebhook posts to an incoming webhook, which replies with a plain "ok"
+func (c *Client) postWebhook(ctx context.Context, msg Message) error {
+	msg.Channel = ""
+	resp, err := c.post(ctx, c.webhookURL, msg, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("slack webhook error (status %d): %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+// postMessage posts through chat.postMessage, which replies with {"ok": false, "error": ...} on failure
+func (c *Client) postMessage(ctx context.Context, msg Message) error {
+	msg.Channel = c.channel
+	resp, err := c.post(ctx, c.apiURL+"/chat.postMessage", msg, c.botToken)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack API error: status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode slack response: %w", err)
+	}
+	if !result.OK {
+		return fmt.Errorf("slack API error: %s", result.Error)
+	}
+
+	return nil
+}
+
+func (c *Client) post(ctx context.Context, url string, msg Message, token string) (*http.Response, error) {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal slack message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to post to slack: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,177 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func testIssue(key, summary, status string) jira.Issue {
+	return jira.Issue{
+		Key: key,
+		Fields: jira.Fields{
+			Summary: summary,
+			Status:  jira.Status{Name: status},
+		},
+	}
+}
+
+func TestBuildMessage(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC)
+	sections := []Section{
+		{Title: "In Progress", Issues: []jira.Issue{testIssue("OPS-1", "Fix <script> & friends", "In Review")}},
+		{Title: "Completed", Issues: nil},
+		{Title: "To Do", Issues: []jira.Issue{testIssue("OPS-2", "Write runbook", "To Do")}},
+	}
+
+	msg := BuildMessage(targetDate, "https://example.atlassian.net/", sections, 3)
+
+	if msg.Text != "Daily Standup Report - July 15, 2024" {
+		t.Errorf("unexpected fallback text %q", msg.Text)
+	}
+	if msg.Blocks[0].Type != "header" {
+		t.Errorf("expected header block first, got %s", msg.Blocks[0].Type)
+	}
+
+	var sectionTexts []string
+	for _, block := range msg.Blocks {
+		if block.Type == "section" {
+			sectionTexts = append(sectionTexts, block.Text.Text)
+		}
+	}
+	if len(sectionTexts) != 2 {
+		t.Fatalf("expected 2 sections (empty groups skipped), got %d", len(sectionTexts))
+	}
+
+	first := sectionTexts[0]
+	if !strings.HasPrefix(first, "*In Progress (1)*") {
+		t.Errorf("unexpected section title in %q", first)
+	}
+	if !strings.Contains(first, "<https://example.atlassian.net/browse/OPS-1|OPS-1>") {
+		t.Errorf("expected issue link in %q", first)
+	}
+	if !strings.Contains(first, "Fix &lt;script&gt; &amp; friends") {
+		t.Errorf("expected escaped summary in %q", first)
+	}
+}
+
+func TestChunkLines(t *testing.T) {
+	lines := []string{strings.Repeat("a", 40), strings.Repeat("b", 40), strings.Repeat("c", 40)}
+
+	chunks := chunkLines(lines, 100)
+	if len(chunks) != 2 {
+		t.Fatalf("expected 2 chunks, got %d", len(chunks))
+	}
+	for _, chunk := range chunks {
+		if len(chunk) > 100 {
+			t.Errorf("chunk exceeds limit: %d", len(chunk))
+		}
+	}
+}
+
+func TestBuildMessageBlockLimit(t *testing.T) {
+	var issues []jira.Issue
+	for i := 0; i < 200; i++ {
+		issues = append(issues, testIssue("OPS-1", strings.Repeat("x", 2000), "In Progress"))
+	}
+
+	msg := BuildMessage(time.Now(), "", []Section{{Title: "In Progress", Issues: issues}}, 0)
+	if len(msg.Blocks) > maxBlocks {
+		t.Errorf("expected at most %d blocks, got %d", maxBlocks, len(msg.Blocks))
+	}
+}
+
+func TestPostWebhook(t *testing.T) {
+	var received Message
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "xoxb-ignored", "#standup")
+	if err := client.Post(context.Background(), Message{Channel: "#other", Text: "hello"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if received.Text != "hello" || received.Channel != "" {
+		t.Errorf("unexpected webhook payload: %+v", received)
+	}
+}
+
+func TestPostMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		wantErr  string
+	}{
+		{name: "success", response: `{"ok": true}`},
+		{name: "api error", response: `{"ok": false, "error": "channel_not_found"}`, wantErr: "channel_not_found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received Message
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/chat.postMessage" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				if r.Header.Get("Authorization") != "Bearer xoxb-token" {
+					t.Errorf("unexpected authorization header %q", r.Header.Get("Authorization"))
+				}
+				json.NewDecoder(r.Body).Decode(&received)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			client := NewClient("", "xoxb-token", "#standup")
+			client.apiURL = server.URL
+
+			err := client.Post(context.Background(), Message{Text: "hello"})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if received.Channel != "#standup" {
+				t.Errorf("expected channel #standup, got %q", received.Channel)
+			}
+		})
+	}
+}
+
+func TestPostNotConfigured(t *testing.T) {
+	if err := NewClient("", "", "").Post(context.Background(), Message{}); err == nil {
+		t.Error("expected error when neither webhook nor bot token is configured")
+	}
+}
+
+func TestBuildTitledMessageNotes(t *testing.T) {
+	sections := []Section{{
+		Title:  "In Flight",
+		Issues: []jira.Issue{testIssue("OPS-1", "Upgrade ingress", "In Progress")},
+		Notes:  map[string][]string{"OPS-1": {"Next: roll out to <prod-us>"}},
+	}}
+
+	msg := BuildTitledMessage("🌏", "Handoff - July 15, 2024", "From Ana", "", sections)
+
+	if msg.Text != "Handoff - July 15, 2024" || msg.Blocks[0].Text.Text != "🌏 Handoff - July 15, 2024" {
+		t.Errorf("unexpected title %q / %q", msg.Text, msg.Blocks[0].Text.Text)
+	}
+	if got := msg.Blocks[1].Elements[0].Text; got != "From Ana" {
+		t.Errorf("unexpected context %q", got)
+	}
+	want := "*In Flight (1)*\n• *OPS-1* Upgrade ingress _(In Progress)_\n    ↳ Next: roll out to &lt;prod-us&gt;"
+	if got := msg.Blocks[3].Text.Text; got != want {
+		t.Errorf("unexpected section %q, expected %q", got, want)
+	}
+}
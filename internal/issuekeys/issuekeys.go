@@ -0,0 +1,22 @@
+// Package issuekeys finds Jira issue keys such as PROJECT-123 in the text of commits, pull
+// requests, cards, tasks and time entries, so activity on other platforms can be linked to the
+// Jira issues it belongs to.
+package issuekeys
+
+import "regexp"
+
+// pattern matches Jira issue keys such as PROJECT-123
+var pattern = regexp.MustCompile(`\b([A-Z]{2,10}-\d+)\b`)
+
+// Extract returns the unique Jira issue keys referenced in text, in the order they appear
+func Extract(text string) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, match := range pattern.FindAllStringSubmatch(text, -1) {
+		if !seen[match[1]] {
+			keys = append(keys, match[1])
+			seen[match[1]] = true
+		}
+	}
+	return keys
+}
@@ -0,0 +1,22 @@
+package issuekeys
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"OPS-12: fix the deploy, see OPS-12 and DEVOPS-7", []string{"OPS-12", "DEVOPS-7"}},
+		{"feature/ABC-456-retry-sync", []string{"ABC-456"}},
+		{"no keys here, nor A-1 or abc-12", nil},
+	}
+	for _, tt := range tests {
+		if got := Extract(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Extract(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
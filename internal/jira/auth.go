@@ -6,27 +6,57 @@ import (
 	"os"
 	"path/filepath"
 	"time"
+
+	"my-day/internal/secrets"
 )
 
+// keychain is the credential store tokens are kept in when requested; tests replace it
+var keychain secrets.Store = secrets.Default()
+
+// profile is the Jira profile new auth managers keep credentials for, empty for the default
+var profile string
+
+// SetProfile keeps the credentials of auth managers created afterwards apart for the named
+// Jira profile: in ~/.my-day/profiles/<name>/auth.json and under their own keychain entries.
+// An empty name selects the default credentials.
+func SetProfile(name string) {
+	profile = name
+}
+
 // AuthManager handles API token authentication with Jira
 type AuthManager struct {
 	authFile string
+	profile  string
 	apiToken *APITokenAuth
 }
 
+// Authentication types stored with the credentials
+const (
+	// AuthTypeBasic sends the email and API token (username and password on Jira Server/Data Center) with basic auth
+	AuthTypeBasic = "basic"
+	// AuthTypeBearer sends a Jira Server/Data Center personal access token as a bearer token
+	AuthTypeBearer = "bearer"
+)
+
 // APITokenAuth represents API token authentication
 type APITokenAuth struct {
-	Email string `json:"email"`
-	Token string `json:"token"`
+	Email    string `json:"email"`
+	Token    string `json:"token"`
+	Type     string `json:"type,omitempty"`     // AuthTypeBasic (default) or AuthTypeBearer
+	Keychain bool   `json:"keychain,omitempty"` // The token is in the keychain instead of the auth file
 }
 
 // NewAuthManager creates a new API token authentication manager
 func NewAuthManager(email, token string) *AuthManager {
 	homeDir, _ := os.UserHomeDir()
 	authFile := filepath.Join(homeDir, ".my-day", "auth.json")
+	if profile != "" {
+		authFile = filepath.Join(homeDir, ".my-day", "profiles", profile, "auth.json")
+	}
 
 	return &AuthManager{
 		authFile: authFile,
+		profile:  profile,
 		apiToken: &APITokenAuth{
 			Email: email,
 			Token: token,
@@ -34,6 +64,13 @@ func NewAuthManager(email, token string) *AuthManager {
 	}
 }
 
+// NewBearerAuthManager creates an authentication manager for a Jira Server/Data Center personal access token
+func NewBearerAuthManager(token string) *AuthManager {
+	am := NewAuthManager("", token)
+	am.apiToken.Type = AuthTypeBearer
+	return am
+}
+
 // SaveAPIToken saves the API token credentials to disk
 func (am *AuthManager) SaveAPIToken() error {
 	if am.apiToken == nil {
@@ -46,6 +83,124 @@ func (am *AuthManager) SaveAPIToken() error {
 		ExpiresAt: time.Now().Add(365 * 24 * time.Hour), // API tokens don't expire, but we set a far future date
 	}
 
+	return am.writeAuthInfo(&authInfo)
+}
+
+// SaveAPITokenToKeychain saves the API token to the operating system's credential store,
+// leaving only the email and authentication type in the auth file
+func (am *AuthManager) SaveAPITokenToKeychain() error {
+	if am.apiToken == nil {
+		return fmt.Errorf("no API token configured")
+	}
+	if !keychain.Available() {
+		return fmt.Errorf("no keychain available on this system")
+	}
+
+	if err := keychain.Set(am.account(secrets.JiraToken), am.apiToken.Token); err != nil {
+		return fmt.Errorf("failed to save API token to keychain: %w", err)
+	}
+
+	stored := *am.apiToken
+	stored.Token = ""
+	stored.Keychain = true
+	authInfo := AuthInfo{
+		AuthType:  "token",
+		APIToken:  &stored,
+		ExpiresAt: time.Now().Add(365 * 24 * time.Hour),
+	}
+
+	return am.writeAuthInfo(&authInfo)
+}
+
+// SaveOAuthToken saves an OAuth login, keeping the access and refresh tokens in the
+// keychain when one is available and in the auth file otherwise
+func (am *AuthManager) SaveOAuthToken(token *OAuthToken) error {
+	stored := *token
+	stored.Keychain = false
+	if keychain.Available() {
+		tokens, err := json.Marshal(oauthSecrets{AccessToken: token.AccessToken, RefreshToken: token.RefreshToken})
+		if err != nil {
+			return fmt.Errorf("failed to marshal OAuth token: %w", err)
+		}
+		if err := keychain.Set(am.account(secrets.JiraOAuth), string(tokens)); err == nil {
+			stored.AccessToken = ""
+			stored.RefreshToken = ""
+			stored.Keychain = true
+		}
+	}
+
+	authInfo := AuthInfo{
+		AuthType:  "oauth",
+		OAuth:     &stored,
+		ExpiresAt: token.ExpiresAt,
+	}
+
+	return am.writeAuthInfo(&authInfo)
+}
+
+// LoadOAuthToken loads the OAuth login, reading its tokens from the keychain if they are kept there
+func (am *AuthManager) LoadOAuthToken() (*OAuthToken, error) {
+	authInfo, err := am.readAuthInfo()
+	if err != nil {
+		return nil, err
+	}
+	if authInfo.OAuth == nil {
+		return nil, errNoOAuthToken
+	}
+
+	token := authInfo.OAuth
+	if token.Keychain {
+		data, err := keychain.Get(am.account(secrets.JiraOAuth))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read OAuth token from keychain: %w", err)
+		}
+		var tokens oauthSecrets
+		if err := json.Unmarshal([]byte(data), &tokens); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal OAuth token: %w", err)
+		}
+		token.AccessToken = tokens.AccessToken
+		token.RefreshToken = tokens.RefreshToken
+	}
+
+	return token, nil
+}
+
+// HasOAuth reports whether the stored authentication is an OAuth login
+func (am *AuthManager) HasOAuth() bool {
+	authInfo, err := am.readAuthInfo()
+	return err == nil && authInfo.OAuth != nil
+}
+
+// oauthSecrets are the parts of an OAuth login kept in the keychain
+type oauthSecrets struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// account returns the keychain account for a secret of the auth manager's profile
+func (am *AuthManager) account(name string) string {
+	if am.profile == "" {
+		return name
+	}
+	return name + "-" + am.profile
+}
+
+// readAuthInfo reads the auth file
+func (am *AuthManager) readAuthInfo() (*AuthInfo, error) {
+	data, err := os.ReadFile(am.authFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read auth file: %w", err)
+	}
+
+	var authInfo AuthInfo
+	if err := json.Unmarshal(data, &authInfo); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal auth info: %w", err)
+	}
+	return &authInfo, nil
+}
+
+// writeAuthInfo replaces the auth file, readable only by the user
+func (am *AuthManager) writeAuthInfo(authInfo *AuthInfo) error {
 	data, err := json.MarshalIndent(authInfo, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal auth info: %w", err)
@@ -66,31 +221,46 @@ func (am *AuthManager) SaveAPIToken() error {
 
 // LoadAPIToken loads the API token from disk
 func (am *AuthManager) LoadAPIToken() (*APITokenAuth, error) {
-	data, err := os.ReadFile(am.authFile)
+	authInfo, err := am.readAuthInfo()
 	if err != nil {
-		return nil, fmt.Errorf("failed to read auth file: %w", err)
-	}
-
-	var authInfo AuthInfo
-	if err := json.Unmarshal(data, &authInfo); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal auth info: %w", err)
+		return nil, err
 	}
 
 	if authInfo.APIToken == nil {
 		return nil, fmt.Errorf("no API token found in auth file")
 	}
 
+	if authInfo.APIToken.Keychain {
+		token, err := keychain.Get(am.account(secrets.JiraToken))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read API token from keychain: %w", err)
+		}
+		authInfo.APIToken.Token = token
+	}
+
 	return authInfo.APIToken, nil
 }
 
-// IsAuthenticated checks if valid API token authentication exists
+// IsAuthenticated checks if API token or OAuth authentication exists
 func (am *AuthManager) IsAuthenticated() bool {
 	_, err := am.LoadAPIToken()
-	return err == nil
+	return err == nil || am.HasOAuth()
 }
 
-// ClearAuth removes stored authentication
+// ClearAuth removes stored authentication, including tokens kept in the keychain
 func (am *AuthManager) ClearAuth() error {
+	if authInfo, err := am.readAuthInfo(); err == nil {
+		if authInfo.OAuth != nil && authInfo.OAuth.Keychain {
+			if err := keychain.Delete(am.account(secrets.JiraOAuth)); err != nil {
+				return fmt.Errorf("failed to remove OAuth token from keychain: %w", err)
+			}
+		}
+		if authInfo.APIToken != nil && authInfo.APIToken.Keychain {
+			if err := keychain.Delete(am.account(secrets.JiraToken)); err != nil {
+				return fmt.Errorf("failed to remove API token from keychain: %w", err)
+			}
+		}
+	}
 	if err := os.Remove(am.authFile); err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf("failed to remove auth file: %w", err)
 	}
@@ -0,0 +1,85 @@
+package jira
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"my-day/internal/secrets"
+)
+
+func TestAPITokenInKeychain(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	store := memoryKeychain{}
+	previous := keychain
+	keychain = store
+	t.Cleanup(func() { keychain = previous })
+
+	if err := NewAuthManager("alex@example.com", "s3cret").SaveAPITokenToKeychain(); err != nil {
+		t.Fatalf("SaveAPITokenToKeychain() error = %v", err)
+	}
+
+	home, _ := os.UserHomeDir()
+	data, err := os.ReadFile(filepath.Join(home, ".my-day", "auth.json"))
+	if err != nil {
+		t.Fatalf("failed to read auth file: %v", err)
+	}
+	if strings.Contains(string(data), "s3cret") || store[secrets.JiraToken] != "s3cret" {
+		t.Errorf("expected the token in the keychain only, auth file:\n%s", data)
+	}
+
+	authManager := NewAuthManager("", "")
+	apiToken, err := authManager.LoadAPIToken()
+	if err != nil {
+		t.Fatalf("LoadAPIToken() error = %v", err)
+	}
+	if apiToken.Email != "alex@example.com" || apiToken.Token != "s3cret" {
+		t.Errorf("expected the email from the auth file and the token from the keychain, got %+v", apiToken)
+	}
+
+	if err := authManager.ClearAuth(); err != nil {
+		t.Fatalf("ClearAuth() error = %v", err)
+	}
+	if _, ok := store[secrets.JiraToken]; ok {
+		t.Error("expected ClearAuth to remove the keychain entry")
+	}
+}
+
+func TestProfileCredentialsAreKeptApart(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	store := memoryKeychain{}
+	previous := keychain
+	keychain = store
+	t.Cleanup(func() {
+		keychain = previous
+		SetProfile("")
+	})
+
+	if err := NewAuthManager("alex@example.com", "default-token").SaveAPIToken(); err != nil {
+		t.Fatalf("SaveAPIToken() error = %v", err)
+	}
+	SetProfile("client-a")
+	if err := NewAuthManager("alex@client-a.com", "client-token").SaveAPITokenToKeychain(); err != nil {
+		t.Fatalf("SaveAPITokenToKeychain() error = %v", err)
+	}
+	if store[secrets.JiraToken+"-client-a"] != "client-token" {
+		t.Errorf("expected the profile token under its own keychain entry, got %v", store)
+	}
+
+	home, _ := os.UserHomeDir()
+	if _, err := os.Stat(filepath.Join(home, ".my-day", "profiles", "client-a", "auth.json")); err != nil {
+		t.Errorf("expected a separate auth file for the profile: %v", err)
+	}
+
+	apiToken, err := NewAuthManager("", "").LoadAPIToken()
+	if err != nil || apiToken.Token != "client-token" {
+		t.Errorf("expected the profile token, got %+v (%v)", apiToken, err)
+	}
+
+	SetProfile("")
+	apiToken, err = NewAuthManager("", "").LoadAPIToken()
+	if err != nil || apiToken.Token != "default-token" {
+		t.Errorf("expected the default token, got %+v (%v)", apiToken, err)
+	}
+}
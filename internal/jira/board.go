@@ -0,0 +1,220 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"my-day/internal/trace"
+)
+
+// BoardColumn is a column of an Agile board and the statuses mapped to it
+type BoardColumn struct {
+	Name      string   `json:"name"`
+	StatusIDs []string `json:"status_ids"`
+}
+
+// BoardColumnMap maps statuses to the column they appear in on a board, keeping the board's column order
+type BoardColumnMap struct {
+	BoardID   int           `json:"board_id"`
+	BoardName string        `json:"board_name"`
+	Columns   []BoardColumn `json:"columns"` // Left to right, as shown on the board
+}
+
+// Column returns the name of the board column a status is mapped to, or "" if it is not on the board
+func (m *BoardColumnMap) Column(status Status) string {
+	if m == nil || status.ID == "" {
+		return ""
+	}
+	for _, column := range m.Columns {
+		for _, id := range column.StatusIDs {
+			if id == status.ID {
+				return column.Name
+			}
+		}
+	}
+	return ""
+}
+
+// ColumnNames returns the board's column names from left to right
+func (m *BoardColumnMap) ColumnNames() []string {
+	if m == nil {
+		return nil
+	}
+	names := make([]string, 0, len(m.Columns))
+	for _, column := range m.Columns {
+		names = append(names, column.Name)
+	}
+	return names
+}
+
+// Merge returns a column layout combining m and other, as when reporting on several Jira
+// instances. Columns of other with the same name as one of m get its statuses, the rest are
+// added to the right. A status already on a column of m stays there.
+func (m *BoardColumnMap) Merge(other *BoardColumnMap) *BoardColumnMap {
+	if m == nil {
+		return other
+	}
+	if other == nil {
+		return m
+	}
+	merged := &BoardColumnMap{BoardID: m.BoardID, BoardName: m.BoardName}
+	mapped := make(map[string]bool)
+	index := make(map[string]int)
+	for _, column := range m.Columns {
+		index[strings.ToLower(column.Name)] = len(merged.Columns)
+		merged.Columns = append(merged.Columns, BoardColumn{Name: column.Name, StatusIDs: append([]string(nil), column.StatusIDs...)})
+		for _, id := range column.StatusIDs {
+			mapped[id] = true
+		}
+	}
+	for _, column := range other.Columns {
+		i, ok := index[strings.ToLower(column.Name)]
+		if !ok {
+			i = len(merged.Columns)
+			index[strings.ToLower(column.Name)] = i
+			merged.Columns = append(merged.Columns, BoardColumn{Name: column.Name})
+		}
+		for _, id := range column.StatusIDs {
+			if !mapped[id] {
+				merged.Columns[i].StatusIDs = append(merged.Columns[i].StatusIDs, id)
+				mapped[id] = true
+			}
+		}
+	}
+	return merged
+}
+
+// boardConfiguration is the Agile API response for a board's configuration
+type boardConfiguration struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	ColumnConfig struct {
+		Columns []struct {
+			Name     string `json:"name"`
+			Statuses []struct {
+				ID string `json:"id"`
+			} `json:"statuses"`
+		} `json:"columns"`
+	} `json:"columnConfig"`
+}
+
+// GetBoardColumns retrieves the column layout of an Agile (Scrum or Kanban) board
+func (c *Client) GetBoardColumns(ctx context.Context, boardID int) (*BoardColumnMap, error) {
+	client, err := c.getAuthenticatedClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	configURL := fmt.Sprintf("%s/rest/agile/1.0/board/%d/configuration", c.baseURL, boardID)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", configURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.do(client, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, trace.Errorf(resp, "failed to get board %d configuration: status %d", boardID, resp.StatusCode)
+	}
+
+	var config boardConfiguration
+	if err := json.NewDecoder(resp.Body).Decode(&config); err != nil {
+		return nil, err
+	}
+
+	columns := &BoardColumnMap{BoardID: config.ID, BoardName: config.Name}
+	for _, column := range config.ColumnConfig.Columns {
+		boardColumn := BoardColumn{Name: strings.TrimSpace(column.Name)}
+		for _, status := range column.Statuses {
+			boardColumn.StatusIDs = append(boardColumn.StatusIDs, status.ID)
+		}
+		columns.Columns = append(columns.Columns, boardColumn)
+	}
+
+	return columns, nil
+}
+
+// Sprint is a sprint of a Scrum board
+type Sprint struct {
+	ID           int      `json:"id"`
+	Name         string   `json:"name"`
+	State        string   `json:"state"` // future, active or closed
+	StartDate    JiraTime `json:"startDate"`
+	EndDate      JiraTime `json:"endDate"`      // Planned end
+	CompleteDate JiraTime `json:"completeDate"` // When a closed sprint was completed
+}
+
+// End returns when the sprint was completed, or its planned end while it is running
+func (s Sprint) End() time.Time {
+	if !s.CompleteDate.IsZero() {
+		return s.CompleteDate.Time
+	}
+	return s.EndDate.Time
+}
+
+// sprintPage is a page of the Agile API sprint list
+type sprintPage struct {
+	IsLast bool     `json:"isLast"`
+	Values []Sprint `json:"values"`
+}
+
+// GetSprints retrieves the sprints of a Scrum board in the given comma-separated states
+// (e.g. "active,closed"), in the board's order, oldest first
+func (c *Client) GetSprints(ctx context.Context, boardID int, state string) ([]Sprint, error) {
+	client, err := c.getAuthenticatedClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	var sprints []Sprint
+	for {
+		params := url.Values{
+			"startAt":    {strconv.Itoa(len(sprints))},
+			"maxResults": {"50"},
+		}
+		if state != "" {
+			params.Set("state", state)
+		}
+		sprintsURL := fmt.Sprintf("%s/rest/agile/1.0/board/%d/sprint?%s", c.baseURL, boardID, params.Encode())
+
+		req, err := http.NewRequestWithContext(ctx, "GET", sprintsURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := c.do(client, req)
+		if err != nil {
+			return nil, err
+		}
+
+		var page sprintPage
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, trace.Errorf(resp, "failed to get board %d sprints: status %d", boardID, resp.StatusCode)
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		sprints = append(sprints, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return sprints, nil
+		}
+	}
+}
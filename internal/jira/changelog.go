@@ -0,0 +1,128 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"my-day/internal/trace"
+)
+
+// changelogHistory is an entry of an issue's changelog: the fields one user changed at once
+type changelogHistory struct {
+	ID      string   `json:"id"`
+	Author  User     `json:"author"`
+	Created JiraTime `json:"created"`
+	Items   []struct {
+		Field      string `json:"field"`
+		FromString string `json:"fromString"`
+		ToString   string `json:"toString"`
+	} `json:"items"`
+}
+
+// changelogPage is a page of the Jira Cloud changelog API
+type changelogPage struct {
+	IsLast bool               `json:"isLast"`
+	Values []changelogHistory `json:"values"`
+}
+
+// GetMyTransitions retrieves the status changes of an issue made since the given time by the
+// authenticated user, and by the authors set with SetCommentAuthors, oldest first
+func (c *Client) GetMyTransitions(ctx context.Context, issueKey string, since time.Time) ([]StatusTransition, error) {
+	authors, err := c.authors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	histories, err := c.getChangelog(ctx, issueKey)
+	if err != nil {
+		return nil, err
+	}
+	return transitionsBy(histories, authors, since), nil
+}
+
+// getChangelog retrieves the changelog of an issue. Jira Cloud pages it through its own
+// endpoint; Jira Server/Data Center only serves it expanded on the issue.
+func (c *Client) getChangelog(ctx context.Context, issueKey string) ([]changelogHistory, error) {
+	client, err := c.getAuthenticatedClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	if c.Deployment(ctx) == DeploymentServer {
+		var issue struct {
+			Changelog struct {
+				Histories []changelogHistory `json:"histories"`
+			} `json:"changelog"`
+		}
+		if err := c.getChangelogJSON(ctx, client, issueKey, c.api(ctx, "/issue/%s?expand=changelog&fields=status", issueKey), &issue); err != nil {
+			return nil, err
+		}
+		return issue.Changelog.Histories, nil
+	}
+
+	var histories []changelogHistory
+	for {
+		var page changelogPage
+		url := c.api(ctx, "/issue/%s/changelog?startAt=%d&maxResults=100", issueKey, len(histories))
+		if err := c.getChangelogJSON(ctx, client, issueKey, url, &page); err != nil {
+			return nil, err
+		}
+		histories = append(histories, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return histories, nil
+		}
+	}
+}
+
+// getChangelogJSON decodes the response to a changelog request of an issue into value
+func (c *Client) getChangelogJSON(ctx context.Context, client *http.Client, issueKey, url string, value interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.do(client, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("failed to get changelog for %s: %w", issueKey, ErrRestricted)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return trace.Errorf(resp, "failed to get changelog: status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(value)
+}
+
+// transitionsBy returns the status changes in histories made since the given time by the given
+// account IDs, or by anyone when authors is empty, oldest first
+func transitionsBy(histories []changelogHistory, authors map[string]bool, since time.Time) []StatusTransition {
+	var transitions []StatusTransition
+	for _, history := range histories {
+		if history.Created.Time.Before(since) || (len(authors) > 0 && !authors[history.Author.AccountID]) {
+			continue
+		}
+		for _, item := range history.Items {
+			if item.Field != "status" {
+				continue
+			}
+			transitions = append(transitions, StatusTransition{
+				ID:     history.ID,
+				From:   item.FromString,
+				To:     item.ToString,
+				At:     history.Created,
+				Author: history.Author,
+			})
+		}
+	}
+	sort.SliceStable(transitions, func(i, j int) bool {
+		return transitions[i].At.Time.Before(transitions[j].At.Time)
+	})
+	return transitions
+}
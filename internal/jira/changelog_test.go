@@ -0,0 +1,57 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetMyTransitions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/myself":
+			fmt.Fprint(w, `{"accountId": "me"}`)
+		case "/rest/api/3/issue/OPS-1/changelog":
+			if r.URL.Query().Get("startAt") == "0" {
+				fmt.Fprint(w, `{"isLast": false, "values": [
+					{"id": "1", "author": {"accountId": "me"}, "created": "2024-06-02T09:00:00.000+0000",
+					 "items": [{"field": "status", "fromString": "To Do", "toString": "In Progress"}]},
+					{"id": "2", "author": {"accountId": "me"}, "created": "2024-06-03T11:00:00.000+0000",
+					 "items": [{"field": "assignee", "fromString": "", "toString": "Alex"},
+					           {"field": "status", "fromString": "In Progress", "toString": "In Review"}]}
+				]}`)
+				return
+			}
+			fmt.Fprint(w, `{"isLast": true, "values": [
+				{"id": "3", "author": {"accountId": "teammate"}, "created": "2024-06-03T14:00:00.000+0000",
+				 "items": [{"field": "status", "fromString": "In Review", "toString": "Reopened"}]},
+				{"id": "4", "author": {"accountId": "me"}, "created": "2024-06-03T15:20:00.000+0000",
+				 "items": [{"field": "status", "fromString": "In Review", "toString": "Done"}]}
+			]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.SetDeployment(DeploymentCloud)
+
+	since := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+	transitions, err := client.GetMyTransitions(context.Background(), "OPS-1", since)
+	if err != nil {
+		t.Fatalf("GetMyTransitions() error = %v", err)
+	}
+	if len(transitions) != 2 {
+		t.Fatalf("expected your 2 status changes since Monday, got %+v", transitions)
+	}
+	if got := transitions[1].Format("15:04"); got != "In Review → Done at 15:20" {
+		t.Errorf("unexpected transition %q", got)
+	}
+	if transitions[0].ID != "2" || transitions[0].To != "In Review" {
+		t.Errorf("expected the status item of a history with several fields, got %+v", transitions[0])
+	}
+}
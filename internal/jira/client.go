@@ -1,52 +1,153 @@
 package jira
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
+
+	"my-day/internal/trace"
 )
 
+// Fields requested in low-bandwidth mode: enough to build a report, without descriptions,
+// people and attachments
+const lowBandwidthFields = "summary,status,priority,issuetype,project,updated,resolution,fixVersions,timespent"
+
+// lowBandwidthCommentPage is the number of most recent comments fetched per issue in low-bandwidth mode
+const lowBandwidthCommentPage = 20
+
+// DefaultMaxResults is the default hard cap on the number of issues a search returns across all pages
+const DefaultMaxResults = 1000
+
+// searchPageSize is the number of issues requested per search page; Jira Cloud allows at most 100
+const searchPageSize = 100
+
+// SkippedCommentText replaces comment bodies over the length limit in low-bandwidth mode
+const SkippedCommentText = "[Long comment skipped in low-bandwidth mode]"
+
+// ErrRestricted is returned for issues you are not allowed to see, e.g. moved to a restricted
+// project or given a security level since the search
+var ErrRestricted = errors.New("issue is restricted or no longer exists")
+
+// ErrUnauthorized is returned for requests Jira rejects because the API token or OAuth login is
+// invalid or expired
+var ErrUnauthorized = errors.New("Jira rejected the credentials, run 'my-day auth' again")
+
+// ErrDegraded is returned for requests that are not sent because too many requests in a row
+// failed, e.g. while Jira or the VPN to it is down
+var ErrDegraded = errors.New("Jira is unavailable, request skipped after repeated failures")
+
 // Client represents a Jira API client
 type Client struct {
-	baseURL     string
-	httpClient  *http.Client
-	authManager *AuthManager
+	baseURL          string
+	httpClient       *http.Client
+	authManager      *AuthManager
+	lowBandwidth     bool
+	maxCommentLength int
+	rateLimiter      *rateLimiter
+	deployment       string // DeploymentCloud or DeploymentServer, empty until detected
+	deploymentMu     sync.Mutex
+	oauth            *OAuthConfig // Set for clients authenticating with an OAuth login
+	oauthMu          sync.Mutex
+	commentAuthors   []string // Account IDs whose comments GetMyComments returns besides yours
+	allComments      bool     // GetMyComments returns everyone's comments
+	currentUser      *User    // Authenticated user, once fetched
+	currentUserMu    sync.Mutex
+	customFields     []string // Custom field IDs fetched with every issue search
 }
 
-// NewClient creates a new Jira client with API token authentication
+// NewClient creates a new Jira Cloud client with API token authentication. On Jira
+// Server/Data Center, email is the username and token the password.
 func NewClient(baseURL, email, token string) *Client {
-	authManager := NewAuthManager(email, token)
-	
+	return newClient(baseURL, NewAuthManager(email, token))
+}
+
+// NewClientWithBearerToken creates a new Jira client authenticating with a Jira
+// Server/Data Center personal access token
+func NewClientWithBearerToken(baseURL, token string) *Client {
+	return newClient(baseURL, NewBearerAuthManager(token))
+}
+
+// NewOAuthClient creates a new Jira Cloud client authenticating with the saved OAuth login,
+// refreshing its token with the given OAuth app
+func NewOAuthClient(baseURL string, oauth OAuthConfig) *Client {
+	c := newClient(baseURL, NewAuthManager("", ""))
+	c.oauth = &oauth
+	return c
+}
+
+func newClient(baseURL string, authManager *AuthManager) *Client {
 	return &Client{
 		baseURL:     strings.TrimSuffix(baseURL, "/"),
 		httpClient:  &http.Client{Timeout: 30 * time.Second},
 		authManager: authManager,
+		rateLimiter: newRateLimiter(),
+		deployment:  DeploymentCloud,
 	}
 }
 
+// SetLowBandwidth makes the client fetch as little as possible: a minimal field list, no
+// attachments, only recent comments, and no comment bodies longer than maxCommentLength
+// characters (0 for no limit)
+func (c *Client) SetLowBandwidth(maxCommentLength int) {
+	c.lowBandwidth = true
+	c.maxCommentLength = maxCommentLength
+}
+
+// SetCustomFields sets the custom fields, by field ID, fetched with every issue search
+func (c *Client) SetCustomFields(fieldIDs []string) {
+	c.customFields = fieldIDs
+}
+
+// SetCommentAuthors makes GetMyComments also return the comments of the given account IDs, or
+// of everyone when all is set
+func (c *Client) SetCommentAuthors(accountIDs []string, all bool) {
+	c.commentAuthors = accountIDs
+	c.allComments = all
+}
+
 // GetAuthManager returns the authentication manager
 func (c *Client) GetAuthManager() *AuthManager {
 	return c.authManager
 }
 
-// getAuthenticatedClient returns an HTTP client with API token authentication
+// getAuthenticatedClient returns an HTTP client with API token or OAuth authentication
 func (c *Client) getAuthenticatedClient(ctx context.Context) (*http.Client, error) {
+	if c.oauth != nil {
+		token, err := c.oauthToken(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("OAuth authentication required: %w", err)
+		}
+		return &http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &oauthTransport{
+				token:   token.AccessToken,
+				siteURL: c.baseURL,
+				apiURL:  oauthAPIURL + token.CloudID,
+				base:    trace.Transport("jira", nil),
+			},
+		}, nil
+	}
+
 	apiToken, err := c.authManager.LoadAPIToken()
 	if err != nil {
 		return nil, fmt.Errorf("API token authentication required: %w", err)
 	}
 	
-	// Create HTTP client with basic auth transport
+	// Create HTTP client with basic or bearer auth transport
 	client := &http.Client{
 		Timeout: 30 * time.Second,
 		Transport: &apiTokenTransport{
 			email:    apiToken.Email,
 			token:    apiToken.Token,
-			base:     http.DefaultTransport,
+			bearer:   apiToken.Type == AuthTypeBearer,
+			base:     trace.Transport("jira", nil),
 		},
 	}
 	return client, nil
@@ -54,23 +155,30 @@ func (c *Client) getAuthenticatedClient(ctx context.Context) (*http.Client, erro
 
 // apiTokenTransport implements HTTP transport with API token authentication
 type apiTokenTransport struct {
-	email string
-	token string
-	base  http.RoundTripper
+	email  string
+	token  string
+	bearer bool // Send the token as a personal access token instead of basic auth
+	base   http.RoundTripper
 }
 
 func (t *apiTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	// Set basic auth header
-	req.SetBasicAuth(t.email, t.token)
+	if t.bearer {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	} else {
+		req.SetBasicAuth(t.email, t.token)
+	}
 	return t.base.RoundTrip(req)
 }
 
-// SearchIssues searches for issues using JQL
+// SearchIssues searches for issues using JQL, returning at most maxResults issues
 func (c *Client) SearchIssues(ctx context.Context, jql string, maxResults int) (*SearchResponse, error) {
 	return c.SearchIssuesWithFields(ctx, jql, maxResults, []string{})
 }
 
-// SearchIssuesWithFields searches for issues using JQL with additional custom fields
+// SearchIssuesWithFields searches for issues using JQL with additional custom fields. Results are
+// fetched page by page until every matching issue has been read or maxResults issues have been
+// collected (0 for no limit). The returned Total is the number of matching issues, which is
+// larger than len(Issues) when the cap was reached.
 func (c *Client) SearchIssuesWithFields(ctx context.Context, jql string, maxResults int, additionalFields []string) (*SearchResponse, error) {
 	client, err := c.getAuthenticatedClient(ctx)
 	if err != nil {
@@ -78,36 +186,70 @@ func (c *Client) SearchIssuesWithFields(ctx context.Context, jql string, maxResu
 	}
 
 	// Build search URL using direct Jira instance URL
-	searchURL := fmt.Sprintf("%s/rest/api/3/search", c.baseURL)
+	searchURL := c.api(ctx, "/search")
 	
 	// Build fields list - include standard fields plus any additional custom fields
-	standardFields := "summary,description,status,priority,issuetype,project,assignee,reporter,created,updated,resolution,labels"
+	additionalFields = append(append([]string{}, additionalFields...), c.customFields...)
+	standardFields := "summary,description,status,priority,issuetype,project,assignee,reporter,created,updated,resolution,labels,fixVersions,timeoriginalestimate,timespent,issuelinks,parent"
 	fields := standardFields
+	if c.lowBandwidth {
+		fields = lowBandwidthFields
+		additionalFields = withoutAttachments(additionalFields)
+	}
 	if len(additionalFields) > 0 {
 		fields += "," + strings.Join(additionalFields, ",")
 	}
-	
-	params := url.Values{
-		"jql":        {jql},
-		"maxResults": {fmt.Sprintf("%d", maxResults)},
-		"fields":     {fields},
+
+	result := &SearchResponse{Issues: []Issue{}}
+	for {
+		pageSize := searchPageSize
+		if maxResults > 0 && maxResults-len(result.Issues) < pageSize {
+			pageSize = maxResults - len(result.Issues)
+		}
+
+		params := url.Values{
+			"jql":        {jql},
+			"startAt":    {fmt.Sprintf("%d", len(result.Issues))},
+			"maxResults": {fmt.Sprintf("%d", pageSize)},
+			"fields":     {fields},
+		}
+
+		page, err := c.searchPage(ctx, client, searchURL+"?"+params.Encode())
+		if err != nil {
+			return nil, err
+		}
+
+		result.Expand = page.Expand
+		result.Total = page.Total
+		result.Issues = append(result.Issues, page.Issues...)
+
+		// Stop on the last page, an empty page (issues removed while paging) or at the cap
+		if len(page.Issues) == 0 || len(result.Issues) >= page.Total || (maxResults > 0 && len(result.Issues) >= maxResults) {
+			break
+		}
 	}
+	result.MaxResults = len(result.Issues)
+
+	return result, nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", searchURL+"?"+params.Encode(), nil)
+// searchPage fetches one page of search results
+func (c *Client) searchPage(ctx context.Context, client *http.Client, pageURL string) (*SearchResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := client.Do(req)
+	resp, err := c.do(client, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+		return nil, trace.Errorf(resp, "API request failed with status %d", resp.StatusCode)
 	}
 
 	var searchResponse SearchResponse
@@ -148,7 +290,7 @@ func (c *Client) GetMyWorklog(ctx context.Context, since time.Time) ([]WorklogEn
 	sinceStr := since.Format("2006-01-02")
 	jql := fmt.Sprintf("worklogAuthor = currentUser() AND worklogDate >= %s ORDER BY updated DESC", sinceStr)
 
-	searchResponse, err := c.SearchIssues(ctx, jql, 100)
+	searchResponse, err := c.SearchIssues(ctx, jql, DefaultMaxResults)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search worklog issues: %w", err)
 	}
@@ -165,9 +307,20 @@ func (c *Client) GetMyWorklog(ctx context.Context, since time.Time) ([]WorklogEn
 	return worklogEntries, nil
 }
 
-// GetCurrentUser gets information about the current authenticated user
+// GetCurrentUser gets information about the current authenticated user. The user is fetched
+// once per client.
 func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
-	return c.getCurrentUser(ctx)
+	c.currentUserMu.Lock()
+	defer c.currentUserMu.Unlock()
+	if c.currentUser != nil {
+		return c.currentUser, nil
+	}
+	user, err := c.getCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.currentUser = user
+	return user, nil
 }
 
 // getCurrentUser gets information about the current authenticated user
@@ -177,7 +330,7 @@ func (c *Client) getCurrentUser(ctx context.Context) (*User, error) {
 		return nil, fmt.Errorf("authentication required: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/rest/api/3/myself", c.baseURL)
+	url := c.api(ctx, "/myself")
 	
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -186,14 +339,14 @@ func (c *Client) getCurrentUser(ctx context.Context) (*User, error) {
 
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := client.Do(req)
+	resp, err := c.do(client, req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get user info: status %d", resp.StatusCode)
+		return nil, trace.Errorf(resp, "failed to get user info: status %d", resp.StatusCode)
 	}
 
 	var user User
@@ -236,7 +389,10 @@ func (c *Client) GetIssueComments(ctx context.Context, issueKey string) ([]Comme
 		return nil, fmt.Errorf("authentication required: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/rest/api/3/issue/%s/comment", c.baseURL, issueKey)
+	url := c.api(ctx, "/issue/%s/comment", issueKey)
+	if c.lowBandwidth {
+		url += fmt.Sprintf("?orderBy=-created&maxResults=%d", lowBandwidthCommentPage)
+	}
 	
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -245,14 +401,17 @@ func (c *Client) GetIssueComments(ctx context.Context, issueKey string) ([]Comme
 
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := client.Do(req)
+	resp, err := c.do(client, req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("failed to get comments for %s: %w", issueKey, ErrRestricted)
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get comments: status %d", resp.StatusCode)
+		return nil, trace.Errorf(resp, "failed to get comments: status %d", resp.StatusCode)
 	}
 
 	var response struct {
@@ -262,9 +421,76 @@ func (c *Client) GetIssueComments(ctx context.Context, issueKey string) ([]Comme
 		return nil, err
 	}
 
+	if c.lowBandwidth && c.maxCommentLength > 0 {
+		for i := range response.Comments {
+			if len([]rune(response.Comments[i].Body.Text)) > c.maxCommentLength {
+				response.Comments[i].Body.Text = SkippedCommentText
+			}
+		}
+	}
+
 	return response.Comments, nil
 }
 
+// GetMyComments retrieves the comments on an issue created since the given time by the
+// authenticated user, matched by account ID, and by the authors set with SetCommentAuthors
+func (c *Client) GetMyComments(ctx context.Context, issueKey string, since time.Time) ([]Comment, error) {
+	authors, err := c.authors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := c.GetIssueComments(ctx, issueKey)
+	if err != nil {
+		return nil, err
+	}
+	return commentsBy(comments, authors, since), nil
+}
+
+// authors returns the account IDs of the authenticated user and the authors set with
+// SetCommentAuthors, or an empty set when everyone's activity is wanted
+func (c *Client) authors(ctx context.Context) (map[string]bool, error) {
+	authors := make(map[string]bool, len(c.commentAuthors)+1)
+	if c.allComments {
+		return authors, nil
+	}
+	user, err := c.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+	authors[user.AccountID] = true
+	for _, accountID := range c.commentAuthors {
+		authors[accountID] = true
+	}
+	return authors, nil
+}
+
+// commentsBy returns the comments created since the given time by the given account IDs, or by
+// anyone when authors is empty
+func commentsBy(comments []Comment, authors map[string]bool, since time.Time) []Comment {
+	var kept []Comment
+	for _, comment := range comments {
+		if comment.Created.Time.Before(since) {
+			continue
+		}
+		if len(authors) > 0 && !authors[comment.Author.AccountID] {
+			continue
+		}
+		kept = append(kept, comment)
+	}
+	return kept
+}
+
+// withoutAttachments drops the attachment field from a field list
+func withoutAttachments(fields []string) []string {
+	var kept []string
+	for _, field := range fields {
+		if field != "attachment" {
+			kept = append(kept, field)
+		}
+	}
+	return kept
+}
+
 // getIssueWorklogs retrieves worklog entries for a specific issue
 func (c *Client) getIssueWorklogs(ctx context.Context, issueKey string, userAccountID string, since time.Time) ([]WorklogEntry, error) {
 	client, err := c.getAuthenticatedClient(ctx)
@@ -272,7 +498,7 @@ func (c *Client) getIssueWorklogs(ctx context.Context, issueKey string, userAcco
 		return nil, fmt.Errorf("authentication required: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/rest/api/3/issue/%s/worklog", c.baseURL, issueKey)
+	url := c.api(ctx, "/issue/%s/worklog", issueKey)
 	
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -281,14 +507,14 @@ func (c *Client) getIssueWorklogs(ctx context.Context, issueKey string, userAcco
 
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := client.Do(req)
+	resp, err := c.do(client, req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get worklogs: status %d", resp.StatusCode)
+		return nil, trace.Errorf(resp, "failed to get worklogs: status %d", resp.StatusCode)
 	}
 
 	var response struct {
@@ -309,6 +535,105 @@ func (c *Client) getIssueWorklogs(ctx context.Context, issueKey string, userAcco
 	return filteredWorklogs, nil
 }
 
+// AddWorklog logs time spent on an issue
+func (c *Client) AddWorklog(ctx context.Context, issueKey string, started time.Time, timeSpent time.Duration, comment string) error {
+	client, err := c.getAuthenticatedClient(ctx)
+	if err != nil {
+		return fmt.Errorf("authentication required: %w", err)
+	}
+
+	payload := map[string]interface{}{
+		"started":          started.Format("2006-01-02T15:04:05.000-0700"),
+		"timeSpentSeconds": int(timeSpent.Seconds()),
+	}
+	if comment != "" && c.Deployment(ctx) == DeploymentServer {
+		// API v2 on Jira Server/Data Center takes plain text
+		payload["comment"] = comment
+	} else if comment != "" {
+		// API v3 expects comments in Atlassian Document Format
+		payload["comment"] = map[string]interface{}{
+			"type":    "doc",
+			"version": 1,
+			"content": []map[string]interface{}{
+				{
+					"type": "paragraph",
+					"content": []map[string]interface{}{
+						{"type": "text", "text": comment},
+					},
+				},
+			},
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode worklog: %w", err)
+	}
+
+	url := c.api(ctx, "/issue/%s/worklog", issueKey)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(client, req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return trace.Errorf(resp, "failed to add worklog: status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// AddLabels adds labels to an issue, keeping its other labels
+func (c *Client) AddLabels(ctx context.Context, issueKey string, labels []string) error {
+	client, err := c.getAuthenticatedClient(ctx)
+	if err != nil {
+		return fmt.Errorf("authentication required: %w", err)
+	}
+
+	var operations []map[string]string
+	for _, label := range labels {
+		operations = append(operations, map[string]string{"add": label})
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"update": map[string]interface{}{"labels": operations},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode labels: %w", err)
+	}
+
+	url := c.api(ctx, "/issue/%s", issueKey)
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(client, req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return trace.Errorf(resp, "failed to add labels: status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
 // TestConnection tests the connection to Jira
 func (c *Client) TestConnection(ctx context.Context) error {
 	_, err := c.getCurrentUser(ctx)
@@ -0,0 +1,208 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client for the test server with credentials saved in a temporary home
+func newTestClient(t *testing.T, baseURL string) *Client {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	client := NewClient(baseURL, "alex@example.com", "token")
+	if err := client.GetAuthManager().SaveAPIToken(); err != nil {
+		t.Fatalf("SaveAPIToken() error = %v", err)
+	}
+	return client
+}
+
+func TestSearchIssuesPaginates(t *testing.T) {
+	const total = 250
+
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		maxResults, _ := strconv.Atoi(r.URL.Query().Get("maxResults"))
+		pages = append(pages, fmt.Sprintf("%d+%d", startAt, maxResults))
+
+		// Like Jira, return fewer issues per page than requested
+		if maxResults > 50 {
+			maxResults = 50
+		}
+		response := SearchResponse{StartAt: startAt, MaxResults: maxResults, Total: total}
+		for i := startAt; i < startAt+maxResults && i < total; i++ {
+			response.Issues = append(response.Issues, Issue{Key: fmt.Sprintf("OPS-%d", i+1)})
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	result, err := client.SearchIssues(context.Background(), "project = OPS", 0)
+	if err != nil {
+		t.Fatalf("SearchIssues() error = %v", err)
+	}
+	if len(result.Issues) != total || result.Total != total || result.Issues[total-1].Key != "OPS-250" {
+		t.Fatalf("expected all %d issues, got %d (total %d)", total, len(result.Issues), result.Total)
+	}
+	if len(pages) != 5 || pages[1] != "50+100" {
+		t.Errorf("unexpected pages %v", pages)
+	}
+
+	pages = nil
+	capped, err := client.SearchIssues(context.Background(), "project = OPS", 120)
+	if err != nil {
+		t.Fatalf("SearchIssues() error = %v", err)
+	}
+	if len(capped.Issues) != 120 || capped.Total != total {
+		t.Errorf("expected 120 of %d issues, got %d (total %d)", total, len(capped.Issues), capped.Total)
+	}
+	if last := pages[len(pages)-1]; last != "100+20" {
+		t.Errorf("expected the last page to request only the remaining 20 issues, got %s", last)
+	}
+}
+
+func TestGetSprintsPaginates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/agile/1.0/board/7/sprint" || r.URL.Query().Get("state") != "active,closed" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		if r.URL.Query().Get("startAt") == "0" {
+			w.Write([]byte(`{"isLast": false, "values": [{"id": 1, "name": "Sprint 1", "state": "closed", "endDate": "2024-07-12T17:00:00.000Z", "completeDate": "2024-07-12T15:30:00.000Z"}]}`))
+			return
+		}
+		w.Write([]byte(`{"isLast": true, "values": [{"id": 2, "name": "Sprint 2", "state": "active", "endDate": "2024-07-26T17:00:00.000Z"}]}`))
+	}))
+	defer server.Close()
+
+	sprints, err := newTestClient(t, server.URL).GetSprints(context.Background(), 7, "active,closed")
+	if err != nil {
+		t.Fatalf("GetSprints() error = %v", err)
+	}
+	if len(sprints) != 2 || sprints[1].Name != "Sprint 2" {
+		t.Fatalf("expected both pages of sprints, got %+v", sprints)
+	}
+	if sprints[0].End().Hour() != 15 || sprints[1].End().Hour() != 17 {
+		t.Errorf("expected the completion date for closed sprints and the planned end otherwise, got %v and %v", sprints[0].End(), sprints[1].End())
+	}
+}
+
+func TestGetIssueCommentsRestricted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/issue/SEC-1/comment":
+			w.WriteHeader(http.StatusForbidden)
+		case "/rest/api/3/issue/OPS-1/comment":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.SetDeployment(DeploymentCloud)
+
+	if _, err := client.GetIssueComments(context.Background(), "SEC-1"); !errors.Is(err, ErrRestricted) {
+		t.Errorf("expected ErrRestricted for a forbidden issue, got %v", err)
+	}
+	if _, err := client.GetIssueComments(context.Background(), "GONE-1"); !errors.Is(err, ErrRestricted) {
+		t.Errorf("expected ErrRestricted for a missing issue, got %v", err)
+	}
+	if _, err := client.GetIssueComments(context.Background(), "OPS-1"); err == nil || errors.Is(err, ErrRestricted) {
+		t.Errorf("expected a plain error for a server error, got %v", err)
+	}
+}
+
+func TestGetMyCommentsFiltersByAccountID(t *testing.T) {
+	myselfRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/myself":
+			myselfRequests++
+			fmt.Fprint(w, `{"accountId": "me", "displayName": "Alex"}`)
+		case "/rest/api/3/issue/OPS-1/comment":
+			fmt.Fprint(w, `{"comments": [
+				{"id": "1", "author": {"accountId": "me"}, "created": "2024-06-03T09:00:00.000+0000"},
+				{"id": "2", "author": {"accountId": "teammate"}, "created": "2024-06-03T10:00:00.000+0000"},
+				{"id": "3", "author": {"accountId": "pair"}, "created": "2024-06-03T11:00:00.000+0000"},
+				{"id": "4", "author": {"accountId": "me"}, "created": "2024-06-01T09:00:00.000+0000"}
+			]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.SetDeployment(DeploymentCloud)
+	since := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+
+	ids := func(comments []Comment) string {
+		var ids []string
+		for _, comment := range comments {
+			ids = append(ids, comment.ID)
+		}
+		return strings.Join(ids, ",")
+	}
+
+	comments, err := client.GetMyComments(context.Background(), "OPS-1", since)
+	if err != nil {
+		t.Fatalf("GetMyComments() error = %v", err)
+	}
+	if got := ids(comments); got != "1" {
+		t.Errorf("expected only your comment since Monday, got %s", got)
+	}
+
+	client.SetCommentAuthors([]string{"pair"}, false)
+	comments, _ = client.GetMyComments(context.Background(), "OPS-1", since)
+	if got := ids(comments); got != "1,3" {
+		t.Errorf("expected your and your pair's comments, got %s", got)
+	}
+
+	client.SetCommentAuthors(nil, true)
+	comments, _ = client.GetMyComments(context.Background(), "OPS-1", since)
+	if got := ids(comments); got != "1,2,3" {
+		t.Errorf("expected everyone's comments since Monday, got %s", got)
+	}
+
+	if myselfRequests != 1 {
+		t.Errorf("expected the current user to be fetched once, got %d requests", myselfRequests)
+	}
+}
+
+func TestAddLabels(t *testing.T) {
+	var method, path string
+	var payload map[string]map[string][]map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.SetDeployment(DeploymentCloud)
+
+	if err := client.AddLabels(context.Background(), "OPS-1", []string{"terraform", "kubernetes"}); err != nil {
+		t.Fatalf("AddLabels() error = %v", err)
+	}
+	if method != "PUT" || path != "/rest/api/3/issue/OPS-1" {
+		t.Errorf("unexpected request %s %s", method, path)
+	}
+	labels := payload["update"]["labels"]
+	if len(labels) != 2 || labels[0]["add"] != "terraform" || labels[1]["add"] != "kubernetes" {
+		t.Errorf("expected add operations that keep existing labels, got %v", labels)
+	}
+}
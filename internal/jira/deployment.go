@@ -0,0 +1,94 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Jira deployments. Jira Cloud serves REST API v3, where rich text such as comments is in
+// Atlassian Document Format; Jira Server and Data Center serve v2, where it is plain text.
+const (
+	DeploymentAuto   = "auto"
+	DeploymentCloud  = "cloud"
+	DeploymentServer = "server"
+)
+
+// SetDeployment sets whether the client talks to Jira Cloud or Jira Server/Data Center.
+// With DeploymentAuto (or an empty string) the deployment is detected on first use.
+func (c *Client) SetDeployment(deployment string) {
+	c.deploymentMu.Lock()
+	defer c.deploymentMu.Unlock()
+
+	switch strings.ToLower(deployment) {
+	case DeploymentCloud:
+		c.deployment = DeploymentCloud
+	case DeploymentServer, "datacenter", "data-center":
+		c.deployment = DeploymentServer
+	default:
+		c.deployment = ""
+	}
+}
+
+// Deployment returns DeploymentCloud or DeploymentServer, detecting it the first time in auto mode
+func (c *Client) Deployment(ctx context.Context) string {
+	c.deploymentMu.Lock()
+	defer c.deploymentMu.Unlock()
+
+	if c.deployment == "" {
+		c.deployment = c.detectDeployment(ctx)
+	}
+	return c.deployment
+}
+
+// detectDeployment recognizes Jira Cloud by its host name and otherwise asks the server
+// info endpoint, which every deployment serves under API v2. It assumes Jira Cloud when
+// the server cannot be reached, so the request that follows reports the actual error.
+func (c *Client) detectDeployment(ctx context.Context) string {
+	if base, err := url.Parse(c.baseURL); err == nil {
+		host := strings.ToLower(base.Hostname())
+		if strings.HasSuffix(host, ".atlassian.net") || strings.HasSuffix(host, ".jira.com") {
+			return DeploymentCloud
+		}
+	}
+
+	client, err := c.getAuthenticatedClient(ctx)
+	if err != nil {
+		return DeploymentCloud
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+"/rest/api/2/serverInfo", nil)
+	if err != nil {
+		return DeploymentCloud
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.do(client, req)
+	if err != nil {
+		return DeploymentCloud
+	}
+	defer resp.Body.Close()
+
+	var info struct {
+		DeploymentType string `json:"deploymentType"` // Cloud, Server or DataCenter
+	}
+	if resp.StatusCode != http.StatusOK || json.NewDecoder(resp.Body).Decode(&info) != nil {
+		return DeploymentCloud
+	}
+	if strings.EqualFold(info.DeploymentType, "Cloud") {
+		return DeploymentCloud
+	}
+	return DeploymentServer
+}
+
+// api returns the URL of a REST API path, such as "/myself", for the client's deployment
+func (c *Client) api(ctx context.Context, path string, args ...interface{}) string {
+	version := 3
+	if c.Deployment(ctx) == DeploymentServer {
+		version = 2
+	}
+	return fmt.Sprintf("%s/rest/api/%d%s", c.baseURL, version, fmt.Sprintf(path, args...))
+}
@@ -0,0 +1,129 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newServerStub serves serverInfo with the given deployment type and records the other requests
+func newServerStub(t *testing.T, deploymentType string, requests *[]*http.Request, bodies *[]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/api/2/serverInfo" {
+			json.NewEncoder(w).Encode(map[string]string{"deploymentType": deploymentType})
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		*requests = append(*requests, r)
+		if bodies != nil {
+			*bodies = append(*bodies, string(body))
+		}
+
+		switch r.URL.Path {
+		case "/rest/api/2/myself":
+			w.Write([]byte(`{"key": "JIRAUSER10100", "name": "alex", "displayName": "Alex"}`))
+		case "/rest/api/3/myself":
+			w.Write([]byte(`{"accountId": "abc", "displayName": "Alex"}`))
+		default:
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestServerDeploymentUsesAPIv2AndBearerToken(t *testing.T) {
+	var requests []*http.Request
+	server := newServerStub(t, "DataCenter", &requests, nil)
+
+	t.Setenv("HOME", t.TempDir())
+	client := NewClientWithBearerToken(server.URL, "pat-secret")
+	if err := client.GetAuthManager().SaveAPIToken(); err != nil {
+		t.Fatalf("SaveAPIToken() error = %v", err)
+	}
+	client.SetDeployment(DeploymentAuto)
+
+	user, err := client.GetCurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("GetCurrentUser() error = %v", err)
+	}
+	if client.Deployment(context.Background()) != DeploymentServer {
+		t.Errorf("expected a Data Center instance to be detected as server")
+	}
+	if user.AccountID != "JIRAUSER10100" || user.Name != "alex" {
+		t.Errorf("expected the user key as account ID, got %+v", user)
+	}
+	if got := requests[0].Header.Get("Authorization"); got != "Bearer pat-secret" {
+		t.Errorf("expected bearer authentication, got %q", got)
+	}
+}
+
+func TestCloudDeploymentIsDetected(t *testing.T) {
+	var requests []*http.Request
+	server := newServerStub(t, "Cloud", &requests, nil)
+
+	client := newTestClient(t, server.URL)
+	client.SetDeployment("")
+
+	user, err := client.GetCurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("GetCurrentUser() error = %v", err)
+	}
+	if user.AccountID != "abc" || requests[0].URL.Path != "/rest/api/3/myself" {
+		t.Errorf("expected API v3 for Jira Cloud, got %s for %+v", requests[0].URL.Path, user)
+	}
+	if username, _, ok := requests[0].BasicAuth(); !ok || username != "alex@example.com" {
+		t.Errorf("expected basic authentication with the email")
+	}
+}
+
+func TestCloudHostSkipsDetection(t *testing.T) {
+	client := NewClient("https://example.atlassian.net", "alex@example.com", "token")
+	client.SetDeployment(DeploymentAuto)
+
+	if got := client.api(context.Background(), "/issue/%s/comment", "OPS-1"); got != "https://example.atlassian.net/rest/api/3/issue/OPS-1/comment" {
+		t.Errorf("unexpected API URL %s", got)
+	}
+}
+
+func TestServerWorklogCommentIsPlainText(t *testing.T) {
+	var requests []*http.Request
+	var bodies []string
+	server := newServerStub(t, "Server", &requests, &bodies)
+
+	client := newTestClient(t, server.URL)
+	client.SetDeployment(DeploymentServer)
+
+	if err := client.AddWorklog(context.Background(), "OPS-1", time.Now(), time.Hour, "Pairing"); err != nil {
+		t.Fatalf("AddWorklog() error = %v", err)
+	}
+	if requests[0].URL.Path != "/rest/api/2/issue/OPS-1/worklog" {
+		t.Errorf("expected API v2, got %s", requests[0].URL.Path)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(bodies[0]), &payload); err != nil {
+		t.Fatalf("invalid worklog payload %q: %v", bodies[0], err)
+	}
+	if payload["comment"] != "Pairing" {
+		t.Errorf("expected a plain text comment, got %v", payload["comment"])
+	}
+}
+
+func TestCommentBodyFormats(t *testing.T) {
+	var cloud, server Comment
+	if err := json.Unmarshal([]byte(`{"body": {"type": "doc", "content": [{"type": "paragraph", "content": [{"type": "text", "text": "Deployed"}]}]}}`), &cloud); err != nil {
+		t.Fatalf("Unmarshal(ADF) error = %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"body": "Deployed", "author": {"name": "alex"}}`), &server); err != nil {
+		t.Fatalf("Unmarshal(plain) error = %v", err)
+	}
+	if cloud.Body.Text != "Deployed" || server.Body.Text != "Deployed" || server.Author.AccountID != "alex" {
+		t.Errorf("expected both formats to read the same, got %+v and %+v", cloud, server)
+	}
+}
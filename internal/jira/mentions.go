@@ -0,0 +1,84 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// maxMentionIssues caps the issues searched for comments that mention the user
+const maxMentionIssues = 50
+
+// Mention is a comment that mentions the user on an issue someone else owns
+type Mention struct {
+	IssueKey     string  `json:"issue_key"`
+	IssueSummary string  `json:"issue_summary"`
+	Comment      Comment `json:"comment"`
+}
+
+// textMentionPattern matches the [~name] and [~accountid:ID] mentions of wiki markup bodies
+var textMentionPattern = regexp.MustCompile(`\[~(?:accountid:)?([^\]\s]+)\]`)
+
+// textMentions returns the users mentioned in a wiki markup body, by account ID or username
+func textMentions(text string) []string {
+	var mentions []string
+	for _, match := range textMentionPattern.FindAllStringSubmatch(text, -1) {
+		mentions = append(mentions, match[1])
+	}
+	return mentions
+}
+
+// Mentions returns whether the comment mentions user, by account ID on Jira Cloud or by user
+// key or name on Jira Server/Data Center
+func (c Comment) Mentions(user User) bool {
+	for _, mention := range c.Body.Mentions {
+		if mention == "" {
+			continue
+		}
+		if mention == user.AccountID || strings.EqualFold(mention, user.Name) || mention == user.Key {
+			return true
+		}
+	}
+	return false
+}
+
+// GetMentions retrieves the comments created since the given time that mention the
+// authenticated user on issues assigned to someone else or to no one, newest issues first
+func (c *Client) GetMentions(ctx context.Context, projectKeys []string, since time.Time) ([]Mention, error) {
+	user, err := c.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	jqlParts := []string{
+		"comment ~ currentUser()",
+		"(assignee != currentUser() OR assignee is EMPTY)",
+		fmt.Sprintf("updated >= %s", since.Format("2006-01-02")),
+	}
+	if len(projectKeys) > 0 {
+		jqlParts = append(jqlParts, fmt.Sprintf("project in (%s)", strings.Join(projectKeys, ",")))
+	}
+	jql := strings.Join(jqlParts, " AND ") + " ORDER BY updated DESC"
+
+	result, err := c.SearchIssues(ctx, jql, maxMentionIssues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search mentions: %w", err)
+	}
+
+	var mentions []Mention
+	for _, issue := range result.Issues {
+		comments, err := c.GetIssueComments(ctx, issue.Key)
+		if err != nil {
+			return nil, err
+		}
+		for _, comment := range comments {
+			if comment.Created.Time.Before(since) || comment.Author.AccountID == user.AccountID || !comment.Mentions(*user) {
+				continue
+			}
+			mentions = append(mentions, Mention{IssueKey: issue.Key, IssueSummary: issue.Fields.Summary, Comment: comment})
+		}
+	}
+	return mentions, nil
+}
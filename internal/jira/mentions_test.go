@@ -0,0 +1,75 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCommentMentions(t *testing.T) {
+	var adf Comment
+	if err := json.Unmarshal([]byte(`{"body": {"type": "doc", "content": [{"type": "paragraph", "content": [
+		{"type": "text", "text": "Could"},
+		{"type": "mention", "attrs": {"id": "me", "text": "@Alex"}},
+		{"type": "text", "text": "review this?"}
+	]}]}}`), &adf); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if adf.Body.Text != "Could @Alex review this?" {
+		t.Errorf("expected the mention in the text, got %q", adf.Body.Text)
+	}
+	if !adf.Mentions(User{AccountID: "me"}) || adf.Mentions(User{AccountID: "teammate"}) {
+		t.Errorf("expected the ADF comment to mention only you, got %v", adf.Body.Mentions)
+	}
+
+	var wiki Comment
+	if err := json.Unmarshal([]byte(`{"body": "[~alex] and [~accountid:5b10] please check"}`), &wiki); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !wiki.Mentions(User{AccountID: "JIRAUSER1", Name: "alex"}) || !wiki.Mentions(User{AccountID: "5b10"}) {
+		t.Errorf("expected the wiki markup mentions to match, got %v", wiki.Body.Mentions)
+	}
+}
+
+func TestGetMentions(t *testing.T) {
+	var jql string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/myself":
+			fmt.Fprint(w, `{"accountId": "me"}`)
+		case "/rest/api/3/search":
+			jql = r.URL.Query().Get("jql")
+			fmt.Fprint(w, `{"total": 1, "issues": [{"key": "WEB-7", "fields": {"summary": "Checkout page"}}]}`)
+		case "/rest/api/3/issue/WEB-7/comment":
+			mention := `{"type": "doc", "content": [{"type": "paragraph", "content": [{"type": "mention", "attrs": {"id": "me", "text": "@Alex"}}]}]}`
+			fmt.Fprintf(w, `{"comments": [
+				{"id": "1", "author": {"accountId": "teammate"}, "body": %s, "created": "2024-06-03T09:00:00.000+0000"},
+				{"id": "2", "author": {"accountId": "teammate"}, "body": "no mention", "created": "2024-06-03T10:00:00.000+0000"},
+				{"id": "3", "author": {"accountId": "me"}, "body": %s, "created": "2024-06-03T11:00:00.000+0000"},
+				{"id": "4", "author": {"accountId": "teammate"}, "body": %s, "created": "2024-05-30T09:00:00.000+0000"}
+			]}`, mention, mention, mention)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.SetDeployment(DeploymentCloud)
+
+	mentions, err := client.GetMentions(context.Background(), []string{"WEB"}, time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GetMentions() error = %v", err)
+	}
+	if !strings.Contains(jql, "comment ~ currentUser()") || !strings.Contains(jql, "project in (WEB)") {
+		t.Errorf("unexpected JQL %q", jql)
+	}
+	if len(mentions) != 1 || mentions[0].Comment.ID != "1" || mentions[0].IssueSummary != "Checkout page" {
+		t.Errorf("expected only the teammate's recent mention, got %+v", mentions)
+	}
+}
@@ -0,0 +1,322 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Atlassian OAuth 2.0 (3LO) endpoints, variables so tests can point them at a local server
+var (
+	oauthAuthorizeURL = "https://auth.atlassian.com/authorize"
+	oauthTokenURL     = "https://auth.atlassian.com/oauth/token"
+	oauthResourcesURL = "https://api.atlassian.com/oauth/token/accessible-resources"
+	oauthAPIURL       = "https://api.atlassian.com/ex/jira/"
+)
+
+// DefaultOAuthCallbackPort is the local port the OAuth callback is served on. The callback
+// URL, http://localhost:<port>/callback, must be registered with the OAuth app.
+const DefaultOAuthCallbackPort = 8765
+
+// OAuthScopes are the scopes requested at login; offline_access provides a refresh token
+var OAuthScopes = []string{"read:jira-work", "write:jira-work", "read:jira-user", "offline_access"}
+
+// errNoOAuthToken reports that the auth file holds no OAuth login
+var errNoOAuthToken = errors.New("no OAuth token found in auth file")
+
+// OAuthConfig identifies the OAuth 2.0 app created in the Atlassian developer console
+type OAuthConfig struct {
+	ClientID     string
+	ClientSecret string
+	CallbackPort int // 0 picks a free port, which only works with a matching callback URL
+}
+
+// OAuthToken is an OAuth access token for one Jira Cloud site
+type OAuthToken struct {
+	AccessToken  string    `json:"access_token,omitempty"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	CloudID      string    `json:"cloud_id"`
+	SiteURL      string    `json:"site_url"`
+	Keychain     bool      `json:"keychain,omitempty"` // The tokens are in the keychain instead of the auth file
+}
+
+// oauthSite is a Jira Cloud site the user granted access to
+type oauthSite struct {
+	ID   string `json:"id"`
+	URL  string `json:"url"`
+	Name string `json:"name"`
+}
+
+// OAuthLogin runs the authorization code flow: it serves the callback locally, passes the
+// authorization URL to openURL for the user to approve, and exchanges the returned code for
+// a token for the Jira Cloud site at siteURL
+func OAuthLogin(ctx context.Context, cfg OAuthConfig, siteURL string, openURL func(authURL string)) (*OAuthToken, error) {
+	if cfg.ClientID == "" || cfg.ClientSecret == "" {
+		return nil, fmt.Errorf("OAuth client ID and secret are required")
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", cfg.CallbackPort))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for the OAuth callback: %w", err)
+	}
+	redirectURL := fmt.Sprintf("http://localhost:%d/callback", listener.Addr().(*net.TCPAddr).Port)
+
+	state, err := randomState()
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	type callback struct {
+		code string
+		err  error
+	}
+	results := make(chan callback, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		var result callback
+		switch {
+		case query.Get("state") != state:
+			result.err = fmt.Errorf("OAuth callback state does not match")
+		case query.Get("error") != "":
+			result.err = fmt.Errorf("authorization denied: %s", strings.TrimSpace(query.Get("error")+" "+query.Get("error_description")))
+		case query.Get("code") == "":
+			result.err = fmt.Errorf("OAuth callback has no authorization code")
+		default:
+			result.code = query.Get("code")
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if result.err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "<html><body><h1>my-day login failed</h1><p>%s</p></body></html>", html.EscapeString(result.err.Error()))
+		} else {
+			fmt.Fprint(w, "<html><body><h1>my-day is logged in to Jira</h1><p>You can close this window.</p></body></html>")
+		}
+
+		select {
+		case results <- result:
+		default:
+		}
+	})
+
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go server.Serve(listener)
+	defer server.Close()
+
+	openURL(authorizationURL(cfg, redirectURL, state))
+
+	var result callback
+	select {
+	case result = <-results:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("waiting for the OAuth callback: %w", ctx.Err())
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	token, err := requestOAuthToken(ctx, map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     cfg.ClientID,
+		"client_secret": cfg.ClientSecret,
+		"code":          result.code,
+		"redirect_uri":  redirectURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	site, err := findOAuthSite(ctx, token.AccessToken, siteURL)
+	if err != nil {
+		return nil, err
+	}
+	token.CloudID = site.ID
+	token.SiteURL = strings.TrimSuffix(site.URL, "/")
+	return token, nil
+}
+
+// authorizationURL returns the consent page URL the user approves access on
+func authorizationURL(cfg OAuthConfig, redirectURL, state string) string {
+	params := url.Values{}
+	params.Set("audience", "api.atlassian.com")
+	params.Set("client_id", cfg.ClientID)
+	params.Set("scope", strings.Join(OAuthScopes, " "))
+	params.Set("redirect_uri", redirectURL)
+	params.Set("state", state)
+	params.Set("response_type", "code")
+	params.Set("prompt", "consent")
+	return oauthAuthorizeURL + "?" + params.Encode()
+}
+
+// refreshOAuthToken exchanges the refresh token for a new access token. Atlassian rotates
+// refresh tokens, so the returned token replaces the stored one.
+func refreshOAuthToken(ctx context.Context, cfg OAuthConfig, token *OAuthToken) (*OAuthToken, error) {
+	if token.RefreshToken == "" {
+		return nil, fmt.Errorf("OAuth token expired and cannot be refreshed. Run 'my-day auth login' again")
+	}
+
+	refreshed, err := requestOAuthToken(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     cfg.ClientID,
+		"client_secret": cfg.ClientSecret,
+		"refresh_token": token.RefreshToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh OAuth token: %w", err)
+	}
+	if refreshed.RefreshToken == "" {
+		refreshed.RefreshToken = token.RefreshToken
+	}
+	refreshed.CloudID = token.CloudID
+	refreshed.SiteURL = token.SiteURL
+	return refreshed, nil
+}
+
+// requestOAuthToken posts a token request and returns the granted token
+func requestOAuthToken(ctx context.Context, params map[string]string) (*OAuthToken, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal token request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", oauthTokenURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := (&http.Client{Timeout: 30 * time.Second}).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request OAuth token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("OAuth token request failed with status %d: %s", resp.StatusCode, string(message))
+	}
+
+	var authResp AuthResponse
+	if err := json.NewDecoder(resp.Body).Decode(&authResp); err != nil {
+		return nil, fmt.Errorf("failed to decode OAuth token: %w", err)
+	}
+	if authResp.AccessToken == "" {
+		return nil, fmt.Errorf("OAuth token response has no access token")
+	}
+
+	return &OAuthToken{
+		AccessToken:  authResp.AccessToken,
+		RefreshToken: authResp.RefreshToken,
+		ExpiresAt:    time.Now().Add(time.Duration(authResp.ExpiresIn) * time.Second),
+	}, nil
+}
+
+// findOAuthSite returns the site at siteURL among the sites the token grants access to, or
+// the only site when siteURL is empty
+func findOAuthSite(ctx context.Context, accessToken, siteURL string) (*oauthSite, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", oauthResourcesURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := (&http.Client{Timeout: 30 * time.Second}).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accessible Jira sites: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("listing accessible Jira sites failed with status %d: %s", resp.StatusCode, string(message))
+	}
+
+	var sites []oauthSite
+	if err := json.NewDecoder(resp.Body).Decode(&sites); err != nil {
+		return nil, fmt.Errorf("failed to decode accessible Jira sites: %w", err)
+	}
+
+	siteURL = strings.TrimSuffix(siteURL, "/")
+	var names []string
+	for i, site := range sites {
+		if siteURL == "" && len(sites) == 1 || strings.EqualFold(strings.TrimSuffix(site.URL, "/"), siteURL) {
+			return &sites[i], nil
+		}
+		names = append(names, site.URL)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("the authorization does not grant access to any Jira site")
+	}
+	return nil, fmt.Errorf("the authorization does not grant access to %s (granted: %s)", siteURL, strings.Join(names, ", "))
+}
+
+// oauthToken returns a valid access token, refreshing and saving it when it is about to expire
+func (c *Client) oauthToken(ctx context.Context) (*OAuthToken, error) {
+	c.oauthMu.Lock()
+	defer c.oauthMu.Unlock()
+
+	token, err := c.authManager.LoadOAuthToken()
+	if err != nil {
+		return nil, err
+	}
+	if time.Until(token.ExpiresAt) > time.Minute {
+		return token, nil
+	}
+
+	token, err = refreshOAuthToken(ctx, *c.oauth, token)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.authManager.SaveOAuthToken(token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// oauthTransport sends requests for the Jira site through the Atlassian API gateway with
+// the OAuth access token, which is how OAuth apps reach Jira Cloud
+type oauthTransport struct {
+	token   string
+	siteURL string // Jira site URL requests are built with
+	apiURL  string // Gateway URL for the site's cloud ID
+	base    http.RoundTripper
+}
+
+func (t *oauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if rest, ok := strings.CutPrefix(req.URL.String(), t.siteURL); ok {
+		gatewayURL, err := url.Parse(t.apiURL + rest)
+		if err != nil {
+			return nil, err
+		}
+		req.URL = gatewayURL
+		req.Host = ""
+	}
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	return t.base.RoundTrip(req)
+}
+
+// randomState returns an unguessable OAuth state value
+func randomState() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate OAuth state: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
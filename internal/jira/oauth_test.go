@@ -0,0 +1,195 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/secrets"
+)
+
+// memoryKeychain is an in-memory secrets.Store
+type memoryKeychain map[string]string
+
+func (k memoryKeychain) Available() bool { return true }
+
+func (k memoryKeychain) Set(account, secret string) error {
+	k[account] = secret
+	return nil
+}
+
+func (k memoryKeychain) Get(account string) (string, error) {
+	secret, ok := k[account]
+	if !ok {
+		return "", fmt.Errorf("no %s entry", account)
+	}
+	return secret, nil
+}
+
+func (k memoryKeychain) Delete(account string) error {
+	delete(k, account)
+	return nil
+}
+
+// useOAuthServer points the OAuth endpoints and keychain at test doubles for one test
+func useOAuthServer(t *testing.T, handler http.Handler) memoryKeychain {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	store := memoryKeychain{}
+	previous := []string{oauthAuthorizeURL, oauthTokenURL, oauthResourcesURL, oauthAPIURL}
+	previousKeychain := keychain
+	oauthAuthorizeURL = server.URL + "/authorize"
+	oauthTokenURL = server.URL + "/oauth/token"
+	oauthResourcesURL = server.URL + "/oauth/token/accessible-resources"
+	oauthAPIURL = server.URL + "/ex/jira/"
+	keychain = store
+	t.Cleanup(func() {
+		oauthAuthorizeURL, oauthTokenURL, oauthResourcesURL, oauthAPIURL = previous[0], previous[1], previous[2], previous[3]
+		keychain = previousKeychain
+	})
+
+	t.Setenv("HOME", t.TempDir())
+	return store
+}
+
+func TestOAuthLogin(t *testing.T) {
+	var exchange map[string]string
+	useOAuthServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			json.NewDecoder(r.Body).Decode(&exchange)
+			w.Write([]byte(`{"access_token": "access-1", "refresh_token": "refresh-1", "expires_in": 3600}`))
+		case "/oauth/token/accessible-resources":
+			if r.Header.Get("Authorization") != "Bearer access-1" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(`[{"id": "cloud-a", "url": "https://a.atlassian.net"}, {"id": "cloud-b", "url": "https://b.atlassian.net"}]`))
+		}
+	}))
+
+	cfg := OAuthConfig{ClientID: "client", ClientSecret: "secret"}
+	token, err := OAuthLogin(context.Background(), cfg, "https://b.atlassian.net/", func(authURL string) {
+		parsed, _ := url.Parse(authURL)
+		params := parsed.Query()
+		if params.Get("client_id") != "client" || !strings.Contains(params.Get("scope"), "offline_access") {
+			t.Errorf("unexpected authorization URL %s", authURL)
+		}
+		callback := params.Get("redirect_uri") + "?code=auth-code&state=" + params.Get("state")
+		go func() {
+			resp, err := http.Get(callback)
+			if err == nil {
+				resp.Body.Close()
+			}
+		}()
+	})
+	if err != nil {
+		t.Fatalf("OAuthLogin() error = %v", err)
+	}
+
+	if exchange["grant_type"] != "authorization_code" || exchange["code"] != "auth-code" || exchange["client_secret"] != "secret" {
+		t.Errorf("unexpected code exchange %v", exchange)
+	}
+	if token.AccessToken != "access-1" || token.RefreshToken != "refresh-1" || token.CloudID != "cloud-b" || token.SiteURL != "https://b.atlassian.net" {
+		t.Errorf("unexpected token %+v", token)
+	}
+}
+
+func TestOAuthLoginRejectsWrongState(t *testing.T) {
+	useOAuthServer(t, http.NotFoundHandler())
+
+	cfg := OAuthConfig{ClientID: "client", ClientSecret: "secret"}
+	_, err := OAuthLogin(context.Background(), cfg, "", func(authURL string) {
+		parsed, _ := url.Parse(authURL)
+		go func() {
+			resp, err := http.Get(parsed.Query().Get("redirect_uri") + "?code=auth-code&state=forged")
+			if err == nil {
+				resp.Body.Close()
+			}
+		}()
+	})
+	if err == nil || !strings.Contains(err.Error(), "state") {
+		t.Errorf("expected a state mismatch error, got %v", err)
+	}
+}
+
+func TestOAuthClientRefreshesAndUsesGateway(t *testing.T) {
+	var refresh map[string]string
+	var apiPath, apiAuth string
+	store := useOAuthServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/oauth/token":
+			json.NewDecoder(r.Body).Decode(&refresh)
+			w.Write([]byte(`{"access_token": "access-2", "refresh_token": "refresh-2", "expires_in": 3600}`))
+		case strings.HasPrefix(r.URL.Path, "/ex/jira/"):
+			apiPath = r.URL.Path
+			apiAuth = r.Header.Get("Authorization")
+			w.Write([]byte(`{"accountId": "abc", "displayName": "Alex"}`))
+		}
+	}))
+
+	authManager := NewAuthManager("", "")
+	expired := &OAuthToken{AccessToken: "access-1", RefreshToken: "refresh-1", ExpiresAt: time.Now().Add(-time.Minute), CloudID: "cloud-a", SiteURL: "https://a.atlassian.net"}
+	if err := authManager.SaveOAuthToken(expired); err != nil {
+		t.Fatalf("SaveOAuthToken() error = %v", err)
+	}
+
+	client := NewOAuthClient("https://a.atlassian.net", OAuthConfig{ClientID: "client", ClientSecret: "secret"})
+	if _, err := client.GetCurrentUser(context.Background()); err != nil {
+		t.Fatalf("GetCurrentUser() error = %v", err)
+	}
+
+	if refresh["grant_type"] != "refresh_token" || refresh["refresh_token"] != "refresh-1" {
+		t.Errorf("unexpected refresh request %v", refresh)
+	}
+	if apiPath != "/ex/jira/cloud-a/rest/api/3/myself" || apiAuth != "Bearer access-2" {
+		t.Errorf("expected the gateway with the refreshed token, got %s with %q", apiPath, apiAuth)
+	}
+
+	saved, err := authManager.LoadOAuthToken()
+	if err != nil {
+		t.Fatalf("LoadOAuthToken() error = %v", err)
+	}
+	if saved.RefreshToken != "refresh-2" || !strings.Contains(store[secrets.JiraOAuth], "refresh-2") {
+		t.Errorf("expected the rotated refresh token to be saved, got %+v", saved)
+	}
+}
+
+func TestOAuthTokensStayOutOfAuthFile(t *testing.T) {
+	store := useOAuthServer(t, http.NotFoundHandler())
+
+	authManager := NewAuthManager("", "")
+	token := &OAuthToken{AccessToken: "access-1", RefreshToken: "refresh-1", ExpiresAt: time.Now().Add(time.Hour), CloudID: "cloud-a"}
+	if err := authManager.SaveOAuthToken(token); err != nil {
+		t.Fatalf("SaveOAuthToken() error = %v", err)
+	}
+
+	home, _ := os.UserHomeDir()
+	data, err := os.ReadFile(filepath.Join(home, ".my-day", "auth.json"))
+	if err != nil {
+		t.Fatalf("failed to read auth file: %v", err)
+	}
+	if strings.Contains(string(data), "access-1") || strings.Contains(string(data), "refresh-1") {
+		t.Errorf("expected the tokens in the keychain only, auth file:\n%s", data)
+	}
+	if !authManager.IsAuthenticated() {
+		t.Error("expected an OAuth login to count as authenticated")
+	}
+
+	if err := authManager.ClearAuth(); err != nil {
+		t.Fatalf("ClearAuth() error = %v", err)
+	}
+	if _, ok := store[secrets.JiraOAuth]; ok {
+		t.Error("expected ClearAuth to remove the keychain entry")
+	}
+}
@@ -0,0 +1,260 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"my-day/internal/trace"
+)
+
+// Large Jira Cloud instances throttle clients that make many requests in a row, as a sync does
+// when it fetches comments for every issue. Throttled requests get 429 Too Many Requests,
+// usually with a Retry-After header, and are retried after waiting.
+//
+// Reads that fail on the way, with a network error or a 502, 503 or 504 response, are retried a
+// few times too. Writes are not, since Jira may have applied them before the connection dropped.
+// When requests keep failing, the Jira instance is marked degraded and the following requests
+// fail at once with ErrDegraded instead of waiting for each to time out, until one request let
+// through after degradedCooldown succeeds.
+
+const (
+	// maxRateLimitRetries is how many times a throttled request is retried before giving up
+	maxRateLimitRetries = 5
+
+	// maxFailureRetries is how many times a request that failed on the way is retried
+	maxFailureRetries = 2
+
+	// degradedAfter is how many requests in a row must fail, after their retries, for the Jira
+	// instance to be marked degraded
+	degradedAfter = 3
+
+	// degradedCooldown is how long a degraded Jira instance is left alone before one request is
+	// let through to check whether it recovered
+	degradedCooldown = time.Minute
+
+	// defaultRetryDelay is the first backoff delay when Jira sends no Retry-After header; it doubles on every retry
+	defaultRetryDelay = time.Second
+
+	// maxRetryDelay caps the exponential backoff delay
+	maxRetryDelay = time.Minute
+)
+
+// RateLimitStats counts how often Jira throttled the client or failed its requests
+type RateLimitStats struct {
+	Throttled   int           // 429 responses received
+	Retries     int           // Requests retried after a 429 or a failure
+	Failed      int           // Requests still throttled after all retries
+	Unavailable int           // Requests that still failed after all retries, e.g. with a 503
+	Skipped     int           // Requests not sent because Jira was degraded
+	Waited      time.Duration // Total time spent waiting before retries
+	Degraded    bool          // Too many requests in a row failed, so the following ones are skipped
+}
+
+// rateLimiter retries throttled and failed requests, marks the Jira instance degraded when
+// requests keep failing, and keeps the statistics of a client
+type rateLimiter struct {
+	mu         sync.Mutex
+	stats      RateLimitStats
+	baseDelay  time.Duration
+	failures   int       // Requests failed in a row
+	degradedAt time.Time // When Jira was last marked degraded
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{baseDelay: defaultRetryDelay}
+}
+
+// RateLimitStats returns how often Jira throttled this client so far
+func (c *Client) RateLimitStats() RateLimitStats {
+	c.rateLimiter.mu.Lock()
+	defer c.rateLimiter.mu.Unlock()
+	return c.rateLimiter.stats
+}
+
+// Degraded reports whether requests kept failing, so the client skips the following ones
+func (c *Client) Degraded() bool {
+	c.rateLimiter.mu.Lock()
+	defer c.rateLimiter.mu.Unlock()
+	return c.rateLimiter.stats.Degraded
+}
+
+// do sends every request of the client to Jira. It retries a request while Jira answers 429 Too
+// Many Requests, waiting for the Retry-After delay when Jira sends one and otherwise backing
+// off exponentially with jitter, and retries a GET or HEAD request that failed on the way a few
+// times. The last response or error is returned when all retries are used up. A 401
+// Unauthorized response is returned as ErrUnauthorized, and while Jira is degraded requests
+// are not sent and ErrDegraded is returned.
+func (c *Client) do(client *http.Client, req *http.Request) (*http.Response, error) {
+	limiter := c.rateLimiter
+	if limiter.skip() {
+		return nil, ErrDegraded
+	}
+	for attempt := 0; ; attempt++ {
+		resp, err := client.Do(req)
+		if err == nil && resp.StatusCode == http.StatusUnauthorized {
+			resp.Body.Close()
+			return nil, trace.Errorf(resp, "%w", ErrUnauthorized)
+		}
+		throttled := err == nil && resp.StatusCode == http.StatusTooManyRequests
+		failed := failedOnTheWay(req, resp, err)
+		if !throttled && !failed {
+			if err == nil {
+				limiter.succeeded()
+			}
+			return resp, err
+		}
+
+		limiter.mu.Lock()
+		retries := maxFailureRetries
+		if !idempotent(req.Method) {
+			retries = 0
+		}
+		if throttled {
+			limiter.stats.Throttled++
+			retries = maxRateLimitRetries
+		}
+		// A request body that cannot be replayed cannot be retried
+		if attempt >= retries || (req.Body != nil && req.GetBody == nil) {
+			if throttled {
+				limiter.stats.Failed++
+			} else {
+				limiter.failed()
+			}
+			limiter.mu.Unlock()
+			return resp, err
+		}
+		retryAfter := ""
+		if resp != nil {
+			retryAfter = resp.Header.Get("Retry-After")
+		}
+		delay := limiter.retryDelay(retryAfter, attempt)
+		limiter.stats.Retries++
+		limiter.stats.Waited += delay
+		limiter.mu.Unlock()
+
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := sleepContext(req.Context(), delay); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to replay request body: %w", err)
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// failedOnTheWay reports whether a request failed with a network error or a response of a
+// gateway or an overloaded server, which may succeed when retried. Canceled requests did not.
+func failedOnTheWay(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		return req.Context().Err() == nil
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// idempotent reports whether a request with method can be sent again without applying a change
+// twice. Only those are retried after failing on the way.
+func idempotent(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// skip reports whether a request is skipped because Jira is degraded, counting it. Once
+// degradedCooldown has passed, one request is let through to check whether Jira recovered.
+func (l *rateLimiter) skip() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.stats.Degraded {
+		return false
+	}
+	if time.Since(l.degradedAt) >= degradedCooldown {
+		// Let this request through and skip the others until it is answered
+		l.degradedAt = time.Now()
+		return false
+	}
+	l.stats.Skipped++
+	return true
+}
+
+// succeeded records a request Jira answered, ending a run of failures and the degraded state
+func (l *rateLimiter) succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.failures = 0
+	l.stats.Degraded = false
+}
+
+// failed records a request that still failed after its retries, and marks Jira degraded after
+// degradedAfter of them in a row. The caller holds l.mu.
+func (l *rateLimiter) failed() {
+	l.stats.Unavailable++
+	l.failures++
+	if l.failures >= degradedAfter {
+		l.stats.Degraded = true
+		l.degradedAt = time.Now()
+	}
+}
+
+// retryDelay returns how long to wait before retrying a throttled request: the Retry-After
+// value in seconds or as an HTTP date when present, otherwise an exponential backoff. The
+// delay is capped at maxRetryDelay either way.
+// Jitter spreads out retries from requests that were throttled together.
+func (l *rateLimiter) retryDelay(retryAfter string, attempt int) time.Duration {
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		// Compared in seconds, as a huge value would overflow a Duration
+		delay := maxRetryDelay
+		if seconds < int(maxRetryDelay/time.Second) {
+			delay = time.Duration(seconds) * time.Second
+		}
+		return delay + jitter(l.baseDelay)
+	}
+	if date, err := http.ParseTime(retryAfter); err == nil {
+		delay := min(max(time.Until(date), 0), maxRetryDelay)
+		return delay + jitter(l.baseDelay)
+	}
+
+	delay := l.baseDelay << attempt
+	if delay > maxRetryDelay || delay <= 0 {
+		delay = maxRetryDelay
+	}
+	// Wait between half and the full backoff delay
+	return delay/2 + jitter(delay/2)
+}
+
+// jitter returns a random duration in [0, max)
+func jitter(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
+// sleepContext waits for the given duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,204 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestThrottledRequestsAreRetried(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= 2 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"accountId": "abc", "displayName": "Alex"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.rateLimiter.baseDelay = time.Millisecond
+
+	user, err := client.GetCurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("GetCurrentUser() error = %v", err)
+	}
+	if user.AccountID != "abc" || requests != 3 {
+		t.Errorf("expected success on the third request, got %+v after %d requests", user, requests)
+	}
+
+	stats := client.RateLimitStats()
+	if stats.Throttled != 2 || stats.Retries != 2 || stats.Failed != 0 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestThrottledRequestGivesUp(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.rateLimiter.baseDelay = time.Millisecond
+
+	err := client.AddWorklog(context.Background(), "OPS-1", time.Now(), time.Hour, "Pairing")
+	if err == nil {
+		t.Fatal("expected an error once the retries are used up")
+	}
+	if len(bodies) != maxRateLimitRetries+1 || bodies[len(bodies)-1] != bodies[0] || bodies[0] == "" {
+		t.Errorf("expected %d requests with the same body, got %q", maxRateLimitRetries+1, bodies)
+	}
+
+	stats := client.RateLimitStats()
+	if stats.Throttled != maxRateLimitRetries+1 || stats.Retries != maxRateLimitRetries || stats.Failed != 1 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestFailedRequestsAreRetried(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"accountId": "abc", "displayName": "Alex"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.rateLimiter.baseDelay = time.Millisecond
+
+	if _, err := client.GetCurrentUser(context.Background()); err != nil {
+		t.Fatalf("GetCurrentUser() error = %v", err)
+	}
+	stats := client.RateLimitStats()
+	if requests != 2 || stats.Retries != 1 || stats.Unavailable != 0 || stats.Degraded {
+		t.Errorf("expected success on the second request, got %d requests and stats %+v", requests, stats)
+	}
+}
+
+func TestRepeatedFailuresDegradeJira(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.rateLimiter.baseDelay = time.Millisecond
+
+	for i := 0; i < degradedAfter; i++ {
+		if _, err := client.GetCurrentUser(context.Background()); err == nil || errors.Is(err, ErrDegraded) {
+			t.Fatalf("expected request %d to fail with the 503, got %v", i+1, err)
+		}
+	}
+	if !client.Degraded() {
+		t.Fatalf("expected Jira to be degraded after %d failed requests", degradedAfter)
+	}
+
+	sent := requests
+	if _, err := client.GetCurrentUser(context.Background()); !errors.Is(err, ErrDegraded) {
+		t.Errorf("expected ErrDegraded once degraded, got %v", err)
+	}
+	if requests != sent {
+		t.Errorf("expected no request to be sent once degraded, got %d more", requests-sent)
+	}
+
+	stats := client.RateLimitStats()
+	if sent != degradedAfter*(maxFailureRetries+1) || stats.Unavailable != degradedAfter || stats.Skipped != 1 {
+		t.Errorf("unexpected stats %+v after %d requests", stats, sent)
+	}
+}
+
+func TestDegradedJiraRecovers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"accountId": "abc", "displayName": "Alex"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.rateLimiter.stats.Degraded = true
+	client.rateLimiter.failures = degradedAfter
+	client.rateLimiter.degradedAt = time.Now()
+
+	if _, err := client.GetCurrentUser(context.Background()); !errors.Is(err, ErrDegraded) {
+		t.Fatalf("expected ErrDegraded during the cooldown, got %v", err)
+	}
+
+	client.rateLimiter.degradedAt = time.Now().Add(-degradedCooldown)
+	if _, err := client.GetCurrentUser(context.Background()); err != nil {
+		t.Fatalf("expected a request to be let through after the cooldown, got %v", err)
+	}
+	if client.Degraded() {
+		t.Error("expected Jira to no longer be degraded after a request succeeded")
+	}
+}
+
+func TestFailedWritesAreNotRetried(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.rateLimiter.baseDelay = time.Millisecond
+
+	if err := client.AddWorklog(context.Background(), "OPS-1", time.Now(), time.Hour, "Pairing"); err == nil {
+		t.Fatal("expected the 502 to fail the worklog")
+	}
+	if requests != 1 {
+		t.Errorf("expected a failed write to be sent once, got %d requests", requests)
+	}
+}
+
+func TestUnauthorizedRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	if _, err := client.GetCurrentUser(context.Background()); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	limiter := &rateLimiter{baseDelay: time.Second}
+
+	if delay := limiter.retryDelay("5", 0); delay < 5*time.Second || delay >= 6*time.Second {
+		t.Errorf("expected the Retry-After seconds plus jitter, got %v", delay)
+	}
+	date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	if delay := limiter.retryDelay(date, 0); delay < 8*time.Second || delay >= 11*time.Second {
+		t.Errorf("expected about 10s for a Retry-After date, got %v", delay)
+	}
+	if delay := limiter.retryDelay("86400", 0); delay < maxRetryDelay || delay >= maxRetryDelay+time.Second {
+		t.Errorf("expected a long Retry-After to be capped, got %v", delay)
+	}
+	if delay := limiter.retryDelay("99999999999999", 0); delay < maxRetryDelay || delay >= maxRetryDelay+time.Second {
+		t.Errorf("expected a huge Retry-After to be capped, got %v", delay)
+	}
+	if delay := limiter.retryDelay("", 3); delay < 4*time.Second || delay >= 8*time.Second {
+		t.Errorf("expected a backoff between 4s and 8s on the fourth attempt, got %v", delay)
+	}
+	if delay := limiter.retryDelay("", 20); delay < maxRetryDelay/2 || delay >= maxRetryDelay {
+		t.Errorf("expected the backoff to be capped, got %v", delay)
+	}
+}
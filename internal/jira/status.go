@@ -0,0 +1,132 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"my-day/internal/trace"
+)
+
+// knownStatusCategoryKeys are the status category keys Jira uses
+var knownStatusCategoryKeys = map[string]bool{
+	"new":           true,
+	"indeterminate": true,
+	"done":          true,
+}
+
+// StatusCategoryMap maps statuses to their real status category, by status ID and by name
+type StatusCategoryMap struct {
+	ByID   map[string]StatusCategory `json:"by_id"`
+	ByName map[string]StatusCategory `json:"by_name"` // Lowercase status name
+}
+
+// NewStatusCategoryMap builds a mapping from status metadata
+func NewStatusCategoryMap(statuses []Status) *StatusCategoryMap {
+	m := &StatusCategoryMap{
+		ByID:   make(map[string]StatusCategory),
+		ByName: make(map[string]StatusCategory),
+	}
+
+	for _, status := range statuses {
+		if !knownStatusCategoryKeys[strings.ToLower(status.Category.Key)] {
+			continue
+		}
+		if status.ID != "" {
+			m.ByID[status.ID] = status.Category
+		}
+		if status.Name != "" {
+			m.ByName[strings.ToLower(status.Name)] = status.Category
+		}
+	}
+
+	return m
+}
+
+// Resolve returns the category for a status, preferring the status ID over its name
+func (m *StatusCategoryMap) Resolve(status Status) (StatusCategory, bool) {
+	if m == nil {
+		return StatusCategory{}, false
+	}
+	if category, ok := m.ByID[status.ID]; ok && status.ID != "" {
+		return category, true
+	}
+	if category, ok := m.ByName[strings.ToLower(status.Name)]; ok {
+		return category, true
+	}
+	return StatusCategory{}, false
+}
+
+// Merge returns a mapping combining m and other, as when reporting on several Jira instances.
+// Where both map the same status, the mapping in m wins.
+func (m *StatusCategoryMap) Merge(other *StatusCategoryMap) *StatusCategoryMap {
+	if m == nil {
+		return other
+	}
+	if other == nil {
+		return m
+	}
+	merged := &StatusCategoryMap{
+		ByID:   make(map[string]StatusCategory, len(m.ByID)+len(other.ByID)),
+		ByName: make(map[string]StatusCategory, len(m.ByName)+len(other.ByName)),
+	}
+	for _, source := range []*StatusCategoryMap{other, m} {
+		for id, category := range source.ByID {
+			merged.ByID[id] = category
+		}
+		for name, category := range source.ByName {
+			merged.ByName[name] = category
+		}
+	}
+	return merged
+}
+
+// Apply fills in the status category of an issue whose category is missing or unknown.
+// It returns false if the category is still unknown afterwards.
+func (m *StatusCategoryMap) Apply(issue *Issue) bool {
+	status := &issue.Fields.Status
+	if knownStatusCategoryKeys[strings.ToLower(status.Category.Key)] {
+		return true
+	}
+	category, ok := m.Resolve(*status)
+	if ok {
+		status.Category = category
+	}
+	return ok
+}
+
+// GetStatuses retrieves all statuses visible to the user, including their status category
+func (c *Client) GetStatuses(ctx context.Context) ([]Status, error) {
+	client, err := c.getAuthenticatedClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	url := c.api(ctx, "/status")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.do(client, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, trace.Errorf(resp, "failed to get statuses: status %d", resp.StatusCode)
+	}
+
+	var statuses []Status
+	if err := json.NewDecoder(resp.Body).Decode(&statuses); err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
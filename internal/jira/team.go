@@ -0,0 +1,120 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"my-day/internal/trace"
+)
+
+// groupMemberPage is a page of the members of a Jira group
+type groupMemberPage struct {
+	IsLast bool   `json:"isLast"`
+	Values []User `json:"values"`
+}
+
+// GetGroupMembers retrieves the active members of a Jira group
+func (c *Client) GetGroupMembers(ctx context.Context, group string) ([]User, error) {
+	client, err := c.getAuthenticatedClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	var members []User
+	for {
+		pageURL := c.api(ctx, "/group/member?groupname=%s&startAt=%d&maxResults=50", url.QueryEscape(group), len(members))
+		req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := c.do(client, req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			err := trace.Errorf(resp, "failed to get members of group %s: status %d", group, resp.StatusCode)
+			resp.Body.Close()
+			return nil, err
+		}
+		var page groupMemberPage
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return members, nil
+		}
+	}
+}
+
+// TeamMemberID returns how a user is referred to in JQL: by username on Jira Server/Data
+// Center, which has no account IDs, and by account ID on Jira Cloud
+func TeamMemberID(user User) string {
+	if user.Name != "" {
+		return user.Name
+	}
+	return user.AccountID
+}
+
+// IsTeamMember returns whether user is one of members, given by account ID, or by user key or
+// username on Jira Server/Data Center
+func IsTeamMember(user User, members []string) bool {
+	for _, member := range members {
+		if member == "" {
+			continue
+		}
+		if member == user.AccountID || member == user.Key || strings.EqualFold(member, user.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTeamIssues retrieves the issues assigned to the given team members that were updated since
+// the given time, newest first
+func (c *Client) GetTeamIssues(ctx context.Context, members []string, projectKeys []string, since time.Time, maxResults int) (*SearchResponse, error) {
+	if len(members) == 0 {
+		return &SearchResponse{}, nil
+	}
+
+	quoted := make([]string, len(members))
+	for i, member := range members {
+		quoted[i] = fmt.Sprintf("%q", member)
+	}
+	jqlParts := []string{
+		fmt.Sprintf("assignee in (%s)", strings.Join(quoted, ",")),
+		fmt.Sprintf("updated >= %s", since.Format("2006-01-02")),
+	}
+	if len(projectKeys) > 0 {
+		jqlParts = append(jqlParts, fmt.Sprintf("project in (%s)", strings.Join(projectKeys, ",")))
+	}
+	jql := strings.Join(jqlParts, " AND ") + " ORDER BY updated DESC"
+
+	return c.SearchIssues(ctx, jql, maxResults)
+}
+
+// GetTeamComments retrieves the comments on an issue created since the given time by the given
+// team members
+func (c *Client) GetTeamComments(ctx context.Context, issueKey string, members []string, since time.Time) ([]Comment, error) {
+	comments, err := c.GetIssueComments(ctx, issueKey)
+	if err != nil {
+		return nil, err
+	}
+	var kept []Comment
+	for _, comment := range comments {
+		if !comment.Created.Time.Before(since) && IsTeamMember(comment.Author, members) {
+			kept = append(kept, comment)
+		}
+	}
+	return kept, nil
+}
@@ -0,0 +1,93 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetGroupMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/group/member" || r.URL.Query().Get("groupname") != "team platform" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("startAt") == "0" {
+			fmt.Fprint(w, `{"isLast": false, "values": [{"accountId": "a1", "displayName": "Ana"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"isLast": true, "values": [{"accountId": "b2", "displayName": "Ben"}]}`)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.SetDeployment(DeploymentCloud)
+
+	members, err := client.GetGroupMembers(context.Background(), "team platform")
+	if err != nil {
+		t.Fatalf("GetGroupMembers() error = %v", err)
+	}
+	if len(members) != 2 || members[0].AccountID != "a1" || members[1].DisplayName != "Ben" {
+		t.Errorf("expected both pages of members, got %+v", members)
+	}
+}
+
+func TestGetTeamIssuesAndComments(t *testing.T) {
+	var jql string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/search":
+			jql = r.URL.Query().Get("jql")
+			fmt.Fprint(w, `{"total": 1, "issues": [{"key": "WEB-7", "fields": {"summary": "Checkout page"}}]}`)
+		case "/rest/api/3/issue/WEB-7/comment":
+			fmt.Fprint(w, `{"comments": [
+				{"id": "1", "author": {"accountId": "a1"}, "body": "done", "created": "2024-06-03T09:00:00.000+0000"},
+				{"id": "2", "author": {"accountId": "outsider"}, "body": "thanks", "created": "2024-06-03T10:00:00.000+0000"},
+				{"id": "3", "author": {"accountId": "b2"}, "body": "old", "created": "2024-05-30T09:00:00.000+0000"}
+			]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.SetDeployment(DeploymentCloud)
+	since := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+	members := []string{"a1", "b2"}
+
+	result, err := client.GetTeamIssues(context.Background(), members, []string{"WEB"}, since, 0)
+	if err != nil {
+		t.Fatalf("GetTeamIssues() error = %v", err)
+	}
+	if want := `assignee in ("a1","b2") AND updated >= 2024-06-03 AND project in (WEB) ORDER BY updated DESC`; jql != want {
+		t.Errorf("JQL = %q, want %q", jql, want)
+	}
+	if len(result.Issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(result.Issues))
+	}
+
+	comments, err := client.GetTeamComments(context.Background(), "WEB-7", members, since)
+	if err != nil {
+		t.Fatalf("GetTeamComments() error = %v", err)
+	}
+	if len(comments) != 1 || comments[0].ID != "1" {
+		t.Errorf("expected only the recent comment of a team member, got %+v", comments)
+	}
+}
+
+func TestIsTeamMember(t *testing.T) {
+	server := User{AccountID: "JIRAUSER10", Key: "JIRAUSER10", Name: "ana"}
+	if !IsTeamMember(server, []string{"Ana"}) || !IsTeamMember(server, []string{"JIRAUSER10"}) {
+		t.Error("expected a Server user to match by username or key")
+	}
+	if IsTeamMember(User{AccountID: "a1"}, []string{"b2", ""}) {
+		t.Error("expected no match for another account ID")
+	}
+	if got := TeamMemberID(server); got != "ana" {
+		t.Errorf("TeamMemberID() = %q, want the username", got)
+	}
+}
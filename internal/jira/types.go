@@ -3,6 +3,7 @@ package jira
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 )
@@ -56,15 +57,17 @@ func (jt JiraTime) MarshalJSON() ([]byte, error) {
 
 // Issue represents a Jira issue
 type Issue struct {
-	ID     string `json:"id"`
-	Key    string `json:"key"`
-	Self   string `json:"self"`
-	Fields Fields `json:"fields"`
+	ID          string             `json:"id"`
+	Key         string             `json:"key"`
+	Self        string             `json:"self"`
+	Fields      Fields             `json:"fields"`
+	Transitions []StatusTransition `json:"transitions,omitempty"` // Your status changes, read from the changelog by sync
 }
 
 // JiraDescription represents a description field that can be string or object
 type JiraDescription struct {
-	Text string
+	Text     string
+	Mentions []string // Account IDs of the users mentioned in a document body, not kept when cached
 }
 
 // UnmarshalJSON handles Jira's description field variations
@@ -73,6 +76,7 @@ func (jd *JiraDescription) UnmarshalJSON(data []byte) error {
 	var str string
 	if err := json.Unmarshal(data, &str); err == nil {
 		jd.Text = str
+		jd.Mentions = textMentions(str)
 		return nil
 	}
 	
@@ -80,7 +84,12 @@ func (jd *JiraDescription) UnmarshalJSON(data []byte) error {
 	var obj struct {
 		Content []struct {
 			Content []struct {
-				Text string `json:"text"`
+				Type  string `json:"type"`
+				Text  string `json:"text"`
+				Attrs struct {
+					ID   string `json:"id"`
+					Text string `json:"text"`
+				} `json:"attrs"`
 			} `json:"content"`
 		} `json:"content"`
 	}
@@ -90,6 +99,13 @@ func (jd *JiraDescription) UnmarshalJSON(data []byte) error {
 		var text []string
 		for _, content := range obj.Content {
 			for _, innerContent := range content.Content {
+				if innerContent.Type == "mention" {
+					jd.Mentions = append(jd.Mentions, innerContent.Attrs.ID)
+					if innerContent.Attrs.Text != "" {
+						text = append(text, innerContent.Attrs.Text)
+					}
+					continue
+				}
 				if innerContent.Text != "" {
 					text = append(text, innerContent.Text)
 				}
@@ -116,19 +132,24 @@ func (jd JiraDescription) String() string {
 
 // Fields represents Jira issue fields
 type Fields struct {
-	Summary      string                    `json:"summary"`
-	Description  JiraDescription           `json:"description"`
-	Status       Status                    `json:"status"`
-	Priority     Priority                  `json:"priority"`
-	IssueType    IssueType                 `json:"issuetype"`
-	Project      Project                   `json:"project"`
-	Assignee     *User                     `json:"assignee"`
-	Reporter     User                      `json:"reporter"`
-	Created      JiraTime                  `json:"created"`
-	Updated      JiraTime                  `json:"updated"`
-	Resolution   *Resolution               `json:"resolution"`
-	Labels       []string                  `json:"labels"`
-	CustomFields map[string]*CustomField   `json:"-"` // Store all custom fields dynamically
+	Summary              string                  `json:"summary"`
+	Description          JiraDescription         `json:"description"`
+	Status               Status                  `json:"status"`
+	Priority             Priority                `json:"priority"`
+	IssueType            IssueType               `json:"issuetype"`
+	Project              Project                 `json:"project"`
+	Assignee             *User                   `json:"assignee"`
+	Reporter             User                    `json:"reporter"`
+	Created              JiraTime                `json:"created"`
+	Updated              JiraTime                `json:"updated"`
+	Resolution           *Resolution             `json:"resolution"`
+	Labels               []string                `json:"labels"`
+	FixVersions          []Version               `json:"fixVersions"`
+	TimeOriginalEstimate int                     `json:"timeoriginalestimate"` // Seconds
+	TimeSpent            int                     `json:"timespent"`            // Seconds
+	IssueLinks           []IssueLink             `json:"issuelinks"`
+	Parent               *LinkedIssue            `json:"parent,omitempty"` // Parent of a subtask, or epic of an issue in team-managed projects
+	CustomFields         map[string]*CustomField `json:"-"`                    // Store all custom fields dynamically
 }
 
 // StatusCategory represents a status category that can have string or number ID
@@ -190,6 +211,14 @@ type IssueType struct {
 	Description string `json:"description"`
 }
 
+// Version represents a project version an issue is fixed in
+type Version struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Released    bool   `json:"released"`
+	ReleaseDate string `json:"releaseDate,omitempty"` // YYYY-MM-DD
+}
+
 // Project represents a Jira project
 type Project struct {
 	ID   string `json:"id"`
@@ -202,6 +231,68 @@ type User struct {
 	AccountID    string `json:"accountId"`
 	DisplayName  string `json:"displayName"`
 	EmailAddress string `json:"emailAddress"`
+	Key          string `json:"key,omitempty"`  // User key on Jira Server/Data Center
+	Name         string `json:"name,omitempty"` // Username on Jira Server/Data Center
+}
+
+// UnmarshalJSON fills in AccountID from the user key or name on Jira Server/Data Center,
+// which has no account IDs, so users can be compared the same way on every deployment
+func (u *User) UnmarshalJSON(data []byte) error {
+	type userAlias User // Prevent infinite recursion
+	var alias userAlias
+	if err := json.Unmarshal(data, &alias); err != nil {
+		return err
+	}
+
+	*u = User(alias)
+	if u.AccountID == "" {
+		u.AccountID = u.Key
+	}
+	if u.AccountID == "" {
+		u.AccountID = u.Name
+	}
+	return nil
+}
+
+// IssueLink links an issue to another. Only one of InwardIssue and OutwardIssue is set: with
+// InwardIssue, the issue relates to it as Type.Inward ("is blocked by"), with OutwardIssue as
+// Type.Outward ("blocks").
+type IssueLink struct {
+	Type         IssueLinkType `json:"type"`
+	InwardIssue  *LinkedIssue  `json:"inwardIssue,omitempty"`
+	OutwardIssue *LinkedIssue  `json:"outwardIssue,omitempty"`
+}
+
+// IssueLinkType is the kind of an issue link, e.g. Blocks
+type IssueLinkType struct {
+	Name    string `json:"name"`
+	Inward  string `json:"inward"`
+	Outward string `json:"outward"`
+}
+
+// LinkedIssue is the issue at the other end of a link
+type LinkedIssue struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Summary   string    `json:"summary"`
+		Status    Status    `json:"status"`
+		IssueType IssueType `json:"issuetype"`
+	} `json:"fields"`
+}
+
+// BlockedBy returns the unresolved issues blocking this one
+func (i Issue) BlockedBy() []LinkedIssue {
+	var blockers []LinkedIssue
+	for _, link := range i.Fields.IssueLinks {
+		if link.InwardIssue == nil || !strings.EqualFold(link.Type.Name, "Blocks") {
+			continue
+		}
+		if strings.EqualFold(link.InwardIssue.Fields.Status.Category.Key, "done") {
+			continue
+		}
+		blockers = append(blockers, *link.InwardIssue)
+	}
+	return blockers
 }
 
 // Resolution represents issue resolution
@@ -231,21 +322,23 @@ type AuthResponse struct {
 
 // AuthInfo represents stored authentication information
 type AuthInfo struct {
-	AuthType  string         `json:"auth_type"` // always "token"
+	AuthType  string         `json:"auth_type"` // "token" or "oauth"
 	APIToken  *APITokenAuth  `json:"api_token"`
+	OAuth     *OAuthToken    `json:"oauth,omitempty"`
 	ExpiresAt time.Time      `json:"expires_at"`
 }
 
 
 // WorklogEntry represents a worklog entry
 type WorklogEntry struct {
-	ID      string   `json:"id"`
-	Author  User     `json:"author"`
-	Comment string   `json:"comment"`
-	Started JiraTime `json:"started"`
-	Created JiraTime `json:"created"`
-	Updated JiraTime `json:"updated"`
-	IssueID string   `json:"issueId"`
+	ID               string   `json:"id"`
+	Author           User     `json:"author"`
+	Comment          string   `json:"comment"`
+	Started          JiraTime `json:"started"`
+	TimeSpentSeconds int      `json:"timeSpentSeconds"`
+	Created          JiraTime `json:"created"`
+	Updated          JiraTime `json:"updated"`
+	IssueID          string   `json:"issueId"`
 }
 
 // Comment represents a comment on an issue
@@ -257,6 +350,20 @@ type Comment struct {
 	Updated JiraTime        `json:"updated"`
 }
 
+// StatusTransition is a change of an issue's status, read from the issue's changelog
+type StatusTransition struct {
+	ID     string   `json:"id"` // ID of the changelog entry
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	At     JiraTime `json:"at"`
+	Author User     `json:"author"`
+}
+
+// Format describes the transition, e.g. "In Review → Done at 15:20", with its time in layout
+func (t StatusTransition) Format(layout string) string {
+	return fmt.Sprintf("%s → %s at %s", t.From, t.To, t.At.Time.Format(layout))
+}
+
 // CustomField represents a Jira custom field that can have various value types
 type CustomField struct {
 	ID    string      `json:"id"`
@@ -284,11 +391,41 @@ func (cf *CustomField) GetStringValue() string {
 			return val
 		}
 		return ""
+	case []interface{}:
+		return strings.Join(cf.GetStringValues(), ", ")
 	default:
 		return fmt.Sprintf("%v", v)
 	}
 }
 
+// sprintNamePattern matches the name in the string form of a sprint on Jira Server/Data Center,
+// e.g. "com.atlassian.greenhopper.service.sprint.Sprint@1a2b[id=7,state=ACTIVE,name=Sprint 5,...]"
+var sprintNamePattern = regexp.MustCompile(`\bname=([^,\]]*)`)
+
+// GetStringValues returns the values of a multi-value custom field, such as sprints or
+// components, or the single value of any other field
+func (cf *CustomField) GetStringValues() []string {
+	items, ok := cf.Value.([]interface{})
+	if !ok {
+		if value := cf.GetStringValue(); value != "" {
+			return []string{value}
+		}
+		return nil
+	}
+
+	var values []string
+	for _, item := range items {
+		value := (&CustomField{ID: cf.ID, Value: item}).GetStringValue()
+		if match := sprintNamePattern.FindStringSubmatch(value); match != nil {
+			value = match[1]
+		}
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 // UnmarshalJSON handles dynamic custom field unmarshaling for Fields
 func (f *Fields) UnmarshalJSON(data []byte) error {
 	// First unmarshal into a temporary map to capture all fields
@@ -320,6 +457,11 @@ func (f *Fields) UnmarshalJSON(data []byte) error {
 	f.Updated = alias.Updated
 	f.Resolution = alias.Resolution
 	f.Labels = alias.Labels
+	f.FixVersions = alias.FixVersions
+	f.TimeOriginalEstimate = alias.TimeOriginalEstimate
+	f.TimeSpent = alias.TimeSpent
+	f.IssueLinks = alias.IssueLinks
+	f.Parent = alias.Parent
 	
 	// Extract custom fields (they start with "customfield_")
 	for key, value := range temp {
@@ -340,4 +482,38 @@ func (f *Fields) GetCustomFieldValue(fieldID string) string {
 		return cf.GetStringValue()
 	}
 	return ""
+}
+
+// GetCustomFieldValues returns the values of a multi-value custom field by field ID
+func (f *Fields) GetCustomFieldValues(fieldID string) []string {
+	if cf, exists := f.CustomFields[fieldID]; exists && cf != nil {
+		return cf.GetStringValues()
+	}
+	return nil
+}
+
+// MarshalJSON writes the custom fields back as fields of their own, so that issues kept in the
+// local store can still be grouped by them
+func (f Fields) MarshalJSON() ([]byte, error) {
+	type FieldsAlias Fields // Prevent infinite recursion
+	data, err := json.Marshal(FieldsAlias(f))
+	if err != nil || len(f.CustomFields) == 0 {
+		return data, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for id, cf := range f.CustomFields {
+		if cf == nil {
+			continue
+		}
+		value, err := json.Marshal(cf.Value)
+		if err != nil {
+			return nil, err
+		}
+		fields[id] = value
+	}
+	return json.Marshal(fields)
 }
\ No newline at end of file
@@ -0,0 +1,49 @@
+package jira
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestCustomFieldValues(t *testing.T) {
+	var fields Fields
+	if err := json.Unmarshal([]byte(`{
+		"summary": "Rotate the certificates",
+		"customfield_10020": [{"id": 7, "name": "Sprint 5"}, {"id": 8, "name": "Sprint 6"}],
+		"customfield_10021": ["com.atlassian.greenhopper.service.sprint.Sprint@1a2b[id=7,rapidViewId=3,state=CLOSED,name=Sprint 5,startDate=2024-06-03]"],
+		"customfield_12946": [{"value": "API"}, {"value": "Web"}],
+		"customfield_12944": {"value": "Platform"}
+	}`), &fields); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	tests := map[string][]string{
+		"customfield_10020": {"Sprint 5", "Sprint 6"},
+		"customfield_10021": {"Sprint 5"},
+		"customfield_12946": {"API", "Web"},
+		"customfield_12944": {"Platform"},
+		"customfield_99999": nil,
+	}
+	for fieldID, want := range tests {
+		if got := fields.GetCustomFieldValues(fieldID); !reflect.DeepEqual(got, want) {
+			t.Errorf("GetCustomFieldValues(%s) = %v, want %v", fieldID, got, want)
+		}
+	}
+	if got := fields.GetCustomFieldValue("customfield_12946"); got != "API, Web" {
+		t.Errorf("expected the joined components, got %q", got)
+	}
+
+	// Custom fields survive the local store
+	data, err := json.Marshal(fields)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var stored Fields
+	if err := json.Unmarshal(data, &stored); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got := stored.GetCustomFieldValues("customfield_10020"); !reflect.DeepEqual(got, []string{"Sprint 5", "Sprint 6"}) || stored.Summary != fields.Summary {
+		t.Errorf("expected the custom fields kept when stored, got %v from %s", got, data)
+	}
+}
@@ -0,0 +1,56 @@
+package llm
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// DefaultConcurrency is how many issues are summarized at once when llm.concurrency is not set
+const DefaultConcurrency = 4
+
+// issueSummaryDeadline is how long summarizing one issue may take, retries included, so a
+// stuck request doesn't hold up the others
+const issueSummaryDeadline = 2 * time.Minute
+
+// concurrency returns how many requests the backend is sent at once
+func (c *LLMConfig) concurrency() int {
+	if c == nil || c.Concurrency <= 0 {
+		return DefaultConcurrency
+	}
+	return c.Concurrency
+}
+
+// summarizeConcurrently summarizes issues with at most concurrency requests in flight, each
+// with its own deadline, and reassembles the summaries in the order of the issues. Issues that
+// cannot be summarized are left out, as the embedded LLM does, so callers can summarize them
+// on their own and see why they fail.
+func summarizeConcurrently(issues []jira.Issue, concurrency int, summarize func(ctx context.Context, issue jira.Issue) (string, error)) map[string]string {
+	results := make([]string, len(issues))
+	errs := make([]error, len(issues))
+	slots := make(chan struct{}, max(concurrency, 1))
+	var wg sync.WaitGroup
+
+	for i, issue := range issues {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, issue jira.Issue) {
+			defer func() { <-slots; wg.Done() }()
+
+			ctx, cancel := context.WithTimeout(context.Background(), issueSummaryDeadline)
+			defer cancel()
+			results[i], errs[i] = summarize(ctx, issue)
+		}(i, issue)
+	}
+	wg.Wait()
+
+	summaries := make(map[string]string, len(issues))
+	for i, issue := range issues {
+		if errs[i] == nil {
+			summaries[issue.Key] = results[i]
+		}
+	}
+	return summaries
+}
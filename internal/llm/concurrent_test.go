@@ -0,0 +1,58 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func TestSummarizeConcurrently(t *testing.T) {
+	var issues []jira.Issue
+	for _, key := range []string{"OPS-1", "OPS-2", "OPS-3", "OPS-4", "OPS-5", "OPS-6"} {
+		issues = append(issues, jira.Issue{Key: key})
+	}
+
+	var inFlight, maxInFlight atomic.Int32
+	summaries := summarizeConcurrently(issues, 2, func(ctx context.Context, issue jira.Issue) (string, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			t.Errorf("expected a deadline for %s", issue.Key)
+		}
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		if issue.Key == "OPS-4" {
+			return "", errors.New("model not found")
+		}
+		return "Summary of " + issue.Key, nil
+	})
+
+	if got := maxInFlight.Load(); got > 2 {
+		t.Errorf("expected at most 2 requests at once, got %d", got)
+	}
+	if len(summaries) != 5 {
+		t.Errorf("expected 5 summaries, got %d: %v", len(summaries), summaries)
+	}
+	for _, issue := range issues {
+		summary, found := summaries[issue.Key]
+		if issue.Key == "OPS-4" {
+			if found {
+				t.Errorf("expected the failed issue to be left out, got %q", summary)
+			}
+			continue
+		}
+		if summary != "Summary of "+issue.Key {
+			t.Errorf("expected the summary of %s, got %q", issue.Key, summary)
+		}
+	}
+}
@@ -3,8 +3,9 @@ package llm
 import (
 	"encoding/json"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 )
@@ -74,9 +75,10 @@ func NewDebugLogger(enabled, verbose bool) *DebugLogger {
 		startTime: time.Now(),
 	}
 	
-	// Create log file if verbose mode is enabled
+	// Create log file if verbose mode is enabled, in the temporary directory rather than the
+	// working directory, which is often a source tree
 	if verbose {
-		logFileName := fmt.Sprintf("llm_debug_%s.log", time.Now().Format("20060102_150405"))
+		logFileName := filepath.Join(os.TempDir(), fmt.Sprintf("llm_debug_%s.log", time.Now().Format("20060102_150405")))
 		if file, err := os.Create(logFileName); err == nil {
 			logger.logFile = file
 			logger.logToFile(fmt.Sprintf("Debug session started at %s", time.Now().Format(time.RFC3339)))
@@ -548,8 +550,8 @@ func (d *DebugLogger) logVerbose(level, message string) {
 	timestamp := time.Now().Format("15:04:05.000")
 	logMessage := fmt.Sprintf("[%s] %s: %s", timestamp, level, message)
 	
-	// Log to console
-	log.Println(logMessage)
+	// Log to the leveled logger
+	slog.Debug(message, "kind", level)
 	
 	// Log to file if available
 	if d.logFile != nil {
@@ -3,8 +3,10 @@ package llm
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os/exec"
+	"strconv"
 	"strings"
 	"time"
 	
@@ -18,8 +20,12 @@ type DockerLLMManager struct {
 	port          string
 	baseURL       string
 	model         string
+	idleStop      int // Minutes without requests before the container stops itself, 0 to keep it running
 }
 
+// idleStopLabel records the idle stop of a container, so it is recreated when the setting changes
+const idleStopLabel = "my-day.idle-stop-minutes"
+
 // NewDockerLLMManager creates a new Docker LLM manager
 func NewDockerLLMManager() *DockerLLMManager {
 	return &DockerLLMManager{
@@ -31,6 +37,18 @@ func NewDockerLLMManager() *DockerLLMManager {
 	}
 }
 
+// SetModel selects the model to pull and run, keeping the default when model is empty
+func (d *DockerLLMManager) SetModel(model string) {
+	if model != "" {
+		d.model = model
+	}
+}
+
+// SetIdleStop makes new containers stop themselves after minutes without requests, 0 for never
+func (d *DockerLLMManager) SetIdleStop(minutes int) {
+	d.idleStop = max(minutes, 0)
+}
+
 // IsDockerAvailable checks if Docker is installed and running
 func (d *DockerLLMManager) IsDockerAvailable() bool {
 	cmd := exec.Command("docker", "ps")
@@ -61,6 +79,14 @@ func (d *DockerLLMManager) IsModelLoaded() bool {
 func (d *DockerLLMManager) StartContainer() error {
 	color.Cyan("🐳 Starting Docker LLM container...")
 	
+	// A container made with another idle stop is made again; the models stay in the volume
+	if d.containerExists() && d.ContainerIdleStop() != d.idleStop {
+		color.White("🔄 Recreating container with the new idle stop...")
+		if err := exec.Command("docker", "rm", "-f", d.containerName).Run(); err != nil {
+			return fmt.Errorf("failed to remove container: %w", err)
+		}
+	}
+
 	// Check if container exists but is stopped
 	if d.containerExists() {
 		color.White("🔄 Starting existing container...")
@@ -71,11 +97,11 @@ func (d *DockerLLMManager) StartContainer() error {
 	} else {
 		// Create and run new container
 		color.White("📦 Creating new LLM container...")
-		cmd := exec.Command("docker", "run", "-d",
-			"--name", d.containerName,
-			"-p", d.port+":11434",
-			"-v", "my-day-ollama:/root/.ollama",
-			d.imageName)
+		gpu := d.DetectResources().GPUName
+		if gpu != "" {
+			color.White("🎮 Using %s", gpu)
+		}
+		cmd := exec.Command("docker", d.runArgs(gpu != "")...)
 		
 		if err := cmd.Run(); err != nil {
 			return fmt.Errorf("failed to create container: %w", err)
@@ -87,6 +113,73 @@ func (d *DockerLLMManager) StartContainer() error {
 	return d.waitForContainer()
 }
 
+// runArgs returns the docker run arguments that create the container. With an idle stop, Ollama
+// unloads the model idleStop minutes after the last request and a loop around ollama serve
+// stops the container once nothing is loaded, or after idleStop minutes if nothing ever was.
+func (d *DockerLLMManager) runArgs(gpu bool) []string {
+	args := []string{"run", "-d",
+		"--name", d.containerName,
+		"-p", d.port + ":11434",
+		"-v", "my-day-ollama:/root/.ollama",
+		"--label", fmt.Sprintf("%s=%d", idleStopLabel, d.idleStop)}
+	if gpu {
+		args = append(args, "--gpus=all")
+	}
+	if d.idleStop == 0 {
+		return append(args, d.imageName)
+	}
+
+	script := fmt.Sprintf(`ollama serve & server=$!
+used=0; idle=0
+while kill -0 $server 2>/dev/null; do
+  sleep 60
+  if ollama ps 2>/dev/null | tail -n +2 | grep -q .; then used=1; idle=0; else idle=$((idle+1)); fi
+  if { [ $used = 1 ] && [ $idle -ge 1 ]; } || [ $idle -ge %d ]; then kill $server; fi
+done
+wait $server`, d.idleStop)
+	return append(args,
+		"-e", fmt.Sprintf("OLLAMA_KEEP_ALIVE=%dm", d.idleStop),
+		"--entrypoint", "/bin/sh",
+		d.imageName, "-c", script)
+}
+
+// ContainerIdleStop returns the minutes without requests after which the container stops
+// itself, as it was made, 0 for never
+func (d *DockerLLMManager) ContainerIdleStop() int {
+	output, err := exec.Command("docker", "inspect", "--format", fmt.Sprintf(`{{index .Config.Labels %q}}`, idleStopLabel), d.containerName).Output()
+	if err != nil {
+		return 0
+	}
+	minutes, _ := strconv.Atoi(strings.TrimSpace(string(output)))
+	return minutes
+}
+
+// ContainerStatus returns the status of the container as docker ps shows it, e.g. "Up 5 minutes",
+// or "" when there is no container
+func (d *DockerLLMManager) ContainerStatus() (string, error) {
+	output, err := exec.Command("docker", "ps", "-a", "--filter", fmt.Sprintf("name=^%s$", d.containerName), "--format", "{{.Status}}").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect container: %w", err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// Logs writes the last tail lines of the container's log to out, and keeps following it when follow is set
+func (d *DockerLLMManager) Logs(tail int, follow bool, out io.Writer) error {
+	if !d.containerExists() {
+		return fmt.Errorf("there is no %s container; start it with 'my-day llm start'", d.containerName)
+	}
+
+	args := []string{"logs", "--tail", strconv.Itoa(tail)}
+	if follow {
+		args = append(args, "--follow")
+	}
+	cmd := exec.Command("docker", append(args, d.containerName)...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	return cmd.Run()
+}
+
 // containerExists checks if the container exists (running or stopped)
 func (d *DockerLLMManager) containerExists() bool {
 	cmd := exec.Command("docker", "ps", "-a", "--filter", fmt.Sprintf("name=%s", d.containerName), "--format", "{{.Names}}")
@@ -125,19 +218,20 @@ func (d *DockerLLMManager) PullModel() error {
 	color.Cyan("🧠 Setting up LLM model...")
 	
 	// Check if model is already available
-	if d.IsModelLoaded() {
-		cmd := exec.Command("docker", "exec", d.containerName, "ollama", "list")
-		output, err := cmd.Output()
-		if err == nil && strings.Contains(string(output), d.model) {
-			color.Green("✅ Model already available!")
-			return nil
-		}
+	if installed, err := ListOllamaModels(d.baseURL); err == nil && HasOllamaModel(installed, d.model) {
+		color.Green("✅ Model already available!")
+		return nil
 	}
 	
 	color.White("📥 Downloading LLM model (this may take a few minutes on first run)...")
-	cmd := exec.Command("docker", "exec", d.containerName, "ollama", "pull", d.model)
-	
-	if err := cmd.Run(); err != nil {
+	lastStatus := ""
+	err := PullOllamaModel(d.baseURL, d.model, func(progress PullProgress) {
+		if progress.Status != lastStatus && progress.Percent() < 0 {
+			color.White("   %s", progress.Status)
+		}
+		lastStatus = progress.Status
+	})
+	if err != nil {
 		return fmt.Errorf("failed to pull model: %w", err)
 	}
 	
@@ -157,6 +251,9 @@ func (d *DockerLLMManager) EnsureReady() error {
 		}
 	}
 	
+	if warning := d.CheckResources(); warning != "" {
+		color.Yellow("⚠️  %s", warning)
+	}
 	return d.PullModel()
 }
 
@@ -0,0 +1,22 @@
+package llm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDockerRunArgsIdleStop(t *testing.T) {
+	manager := NewDockerLLMManager()
+	args := strings.Join(manager.runArgs(false), " ")
+	if strings.Contains(args, "--entrypoint") || !strings.HasSuffix(args, "my-day.idle-stop-minutes=0 ollama/ollama") {
+		t.Errorf("expected the image's own entrypoint without an idle stop, got %s", args)
+	}
+
+	manager.SetIdleStop(15)
+	args = strings.Join(manager.runArgs(true), " ")
+	for _, expected := range []string{"--label my-day.idle-stop-minutes=15", "--gpus=all", "-e OLLAMA_KEEP_ALIVE=15m", "--entrypoint /bin/sh ollama/ollama -c ollama serve &", "[ $idle -ge 15 ]"} {
+		if !strings.Contains(args, expected) {
+			t.Errorf("expected %q in %s", expected, args)
+		}
+	}
+}
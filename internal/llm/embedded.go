@@ -12,6 +12,7 @@ type EmbeddedLLM struct {
 	debugLogger  *DebugLogger
 	errorHandler *ErrorHandler
 	config       *LLMConfig
+	standIn      error // Why it stands in for the configured backend, nil when it is the configured one
 }
 
 // NewEmbeddedLLM creates a new embedded LLM instance
@@ -45,6 +46,20 @@ func NewEmbeddedLLMWithConfig(config LLMConfig) *EmbeddedLLM {
 	}
 }
 
+// NewEmbeddedLLMStandIn creates the embedded LLM standing in for the configured backend, which
+// is unavailable because of reason
+func NewEmbeddedLLMStandIn(config LLMConfig, reason error) *EmbeddedLLM {
+	e := NewEmbeddedLLMWithConfig(config)
+	e.standIn = reason
+	return e
+}
+
+// StandInReason returns why the embedded LLM stands in for the configured backend, or nil when
+// it is the configured one
+func (e *EmbeddedLLM) StandInReason() error {
+	return e.standIn
+}
+
 // GetDebugReport returns the current debug report
 func (e *EmbeddedLLM) GetDebugReport() (*DebugReport, error) {
 	if e.debugLogger == nil {
@@ -136,12 +151,19 @@ func (e *EmbeddedLLM) GenerateStandupSummary(issues []jira.Issue, worklogs []jir
 	return "Recent activity: " + strings.Join(parts, ", "), nil
 }
 
-// GenerateStandupSummaryWithComments creates an enhanced summary using comment data
+// GenerateStandupSummaryWithComments creates an enhanced summary using comment data, from the
+// most informative sentences of the comments when they have any, shortened to the configured
+// max length
 func (e *EmbeddedLLM) GenerateStandupSummaryWithComments(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (string, error) {
 	if len(issues) == 0 && len(comments) == 0 && len(worklogs) == 0 {
 		return "No recent activity to report", nil
 	}
 	
+	if summary := e.extractiveSummary(comments); summary != "" {
+		// The most informative sentence is picked even when it alone is longer than the max length
+		return e.shortenText(summary, e.getConfiguredMaxLength()), nil
+	}
+	
 	var parts []string
 	
 	if len(issues) > 0 {
@@ -159,6 +181,87 @@ func (e *EmbeddedLLM) GenerateStandupSummaryWithComments(issues []jira.Issue, co
 	return "Recent activity: " + strings.Join(parts, ", "), nil
 }
 
+// GenerateWeeklySummary creates a summary of the week's activity
+func (e *EmbeddedLLM) GenerateWeeklySummary(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (string, error) {
+	if len(issues) == 0 && len(comments) == 0 && len(worklogs) == 0 {
+		return "No activity to report this week", nil
+	}
+
+	activeDays := make(map[string]bool)
+	for _, comment := range comments {
+		activeDays[comment.Created.Time.Format("2006-01-02")] = true
+	}
+	for _, worklog := range worklogs {
+		activeDays[worklog.Started.Time.Format("2006-01-02")] = true
+	}
+
+	done := 0
+	for _, issue := range issues {
+		if strings.ToLower(issue.Fields.Status.Category.Key) == "done" {
+			done++
+		}
+	}
+
+	summary := fmt.Sprintf("This week: worked on %d issues (%d completed)", len(issues), done)
+	if len(comments) > 0 {
+		summary += fmt.Sprintf(", %d comments", len(comments))
+	}
+	if len(worklogs) > 0 {
+		summary += fmt.Sprintf(", %d worklog entries", len(worklogs))
+	}
+	if len(activeDays) > 0 {
+		summary += fmt.Sprintf(" across %d days", len(activeDays))
+	}
+
+	return summary, nil
+}
+
+// GenerateThemes groups the issues into themes by label or project
+func (e *EmbeddedLLM) GenerateThemes(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) ([]Theme, error) {
+	return groupThemes(issues), nil
+}
+
+// GenerateNextSteps proposes the next steps stated in comments and continuing the work in progress
+func (e *EmbeddedLLM) GenerateNextSteps(issues []jira.Issue, comments []jira.Comment) ([]string, error) {
+	return suggestNextSteps(issues, comments), nil
+}
+
+// GenerateStructuredSummary sorts the work by the status of its issues and what comments say
+func (e *EmbeddedLLM) GenerateStructuredSummary(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (*StructuredSummary, error) {
+	return structureWork(issues, comments), nil
+}
+
+// GenerateReleaseNotes introduces the release with a count of its changes by kind
+func (e *EmbeddedLLM) GenerateReleaseNotes(version string, issues []jira.Issue) (string, error) {
+	if len(issues) == 0 {
+		return fmt.Sprintf("Version %s has no completed changes yet", version), nil
+	}
+
+	features, fixes := 0, 0
+	for _, issue := range issues {
+		switch strings.ToLower(issue.Fields.IssueType.Name) {
+		case "bug", "defect":
+			fixes++
+		case "story", "feature", "new feature", "epic":
+			features++
+		}
+	}
+
+	var parts []string
+	count := func(n int, one, many string) {
+		if n == 1 {
+			parts = append(parts, "1 "+one)
+		} else if n > 1 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, many))
+		}
+	}
+	count(features, "new feature", "new features")
+	count(fixes, "bug fix", "bug fixes")
+	count(len(issues)-features-fixes, "improvement", "improvements")
+
+	return fmt.Sprintf("Version %s brings %s", version, strings.Join(parts, ", ")), nil
+}
+
 // generateRuleBasedSummary creates a concise summary using rule-based approach
 func (e *EmbeddedLLM) generateRuleBasedSummary(issue jira.Issue) string {
 	// Start with the status and priority context
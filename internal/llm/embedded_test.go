@@ -440,4 +440,20 @@ func TestDebugFunctionality(t *testing.T) {
 	if report == nil {
 		t.Error("Expected debug report, got nil")
 	}
-}
\ No newline at end of file
+}
+
+// TestReleaseNotes tests the rule-based release overview
+func TestReleaseNotes(t *testing.T) {
+	llm := NewEmbeddedLLMWithConfig(LLMConfig{})
+	issue := func(issueType string) jira.Issue {
+		return jira.Issue{Fields: jira.Fields{IssueType: jira.IssueType{Name: issueType}}}
+	}
+
+	notes, err := llm.GenerateReleaseNotes("2.14", []jira.Issue{issue("Story"), issue("Story"), issue("Bug"), issue("Task")})
+	if err != nil {
+		t.Fatalf("GenerateReleaseNotes() error = %v", err)
+	}
+	if notes != "Version 2.14 brings 2 new features, 1 bug fix, 1 improvement" {
+		t.Errorf("unexpected release notes: %q", notes)
+	}
+}
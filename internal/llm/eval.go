@@ -0,0 +1,155 @@
+package llm
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+
+	"my-day/internal/jira"
+)
+
+// evalCorpus is the bundled corpus of anonymized issues and comments with reference summaries
+//
+//go:embed eval_corpus.json
+var evalCorpus []byte
+
+// EvalCase is a day of work to summarize, with the summary a person would write of it
+type EvalCase struct {
+	Name      string         `json:"name"`
+	Issues    []jira.Issue   `json:"issues"`
+	Comments  []jira.Comment `json:"comments"`
+	Reference string         `json:"reference"`
+}
+
+// EvalResult is how well a summarizer did on the cases of a corpus, averaged over the cases it
+// summarized
+type EvalResult struct {
+	Cases      int
+	Errors     int           // Cases the summarizer failed on
+	Fallbacks  int           // Cases answered by the embedded LLM instead of the backend
+	Quality    float64       // Quality score of the quality indicators, out of 100
+	Confidence float64       // Confidence of the technical patterns recognized, from 0 to 1
+	Rouge1     float64       // Word overlap with the reference summary, as an F1 score
+	RougeL     float64       // Longest common word sequence with the reference summary, as an F1 score
+	Duration   time.Duration // Average time to summarize a case
+}
+
+// LoadEvalCorpus reads the cases of a corpus file, or of the bundled corpus when path is ""
+func LoadEvalCorpus(path string) ([]EvalCase, error) {
+	data := evalCorpus
+	if path != "" {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return nil, fmt.Errorf("failed to read corpus: %w", err)
+		}
+	}
+
+	var cases []EvalCase
+	if err := json.Unmarshal(data, &cases); err != nil {
+		return nil, fmt.Errorf("failed to parse corpus: %w", err)
+	}
+	if len(cases) == 0 {
+		return nil, fmt.Errorf("the corpus has no cases")
+	}
+	return cases, nil
+}
+
+// Evaluate summarizes the day of each case and scores the summaries against the references
+func Evaluate(summarizer Summarizer, cases []EvalCase) EvalResult {
+	counter, counts := summarizer.(interface{ Fallbacks() int })
+
+	result := EvalResult{Cases: len(cases)}
+	var total time.Duration
+	for _, c := range cases {
+		fallbacks := 0
+		if counts {
+			fallbacks = counter.Fallbacks()
+		}
+
+		start := time.Now()
+		summary, err := summarizer.GenerateStandupSummaryWithComments(c.Issues, c.Comments, nil)
+		total += time.Since(start)
+		if err != nil || summary == "" {
+			result.Errors++
+			continue
+		}
+		if counts && counter.Fallbacks() != fallbacks {
+			result.Fallbacks++
+		}
+
+		quality, _ := SummaryQuality(summary, len(c.Issues), len(c.Comments))
+		result.Quality += quality
+		result.Confidence += SummaryConfidence(summary)
+		result.Rouge1 += Rouge1(summary, c.Reference)
+		result.RougeL += RougeL(summary, c.Reference)
+	}
+
+	if scored := float64(result.Cases - result.Errors); scored > 0 {
+		result.Quality /= scored
+		result.Confidence /= scored
+		result.Rouge1 /= scored
+		result.RougeL /= scored
+	}
+	if result.Cases > 0 {
+		result.Duration = total / time.Duration(result.Cases)
+	}
+	return result
+}
+
+// Rouge1 scores the words a summary shares with a reference, as the F1 of their precision and
+// recall, in the manner of ROUGE-1
+func Rouge1(summary, reference string) float64 {
+	candidate, ref := evalWords(summary), evalWords(reference)
+	counts := make(map[string]int)
+	for _, word := range ref {
+		counts[word]++
+	}
+	overlap := 0
+	for _, word := range candidate {
+		if counts[word] > 0 {
+			counts[word]--
+			overlap++
+		}
+	}
+	return f1(overlap, len(candidate), len(ref))
+}
+
+// RougeL scores the longest sequence of words a summary shares in order with a reference, as
+// the F1 of its precision and recall, in the manner of ROUGE-L
+func RougeL(summary, reference string) float64 {
+	candidate, ref := evalWords(summary), evalWords(reference)
+	previous := make([]int, len(ref)+1)
+	current := make([]int, len(ref)+1)
+	for i := range candidate {
+		for j := range ref {
+			if candidate[i] == ref[j] {
+				current[j+1] = previous[j] + 1
+			} else {
+				current[j+1] = max(previous[j+1], current[j])
+			}
+		}
+		previous, current = current, previous
+	}
+	return f1(previous[len(ref)], len(candidate), len(ref))
+}
+
+// f1 returns the harmonic mean of the precision and recall of overlap words
+func f1(overlap, candidateWords, referenceWords int) float64 {
+	if overlap == 0 {
+		return 0
+	}
+	precision := float64(overlap) / float64(candidateWords)
+	recall := float64(overlap) / float64(referenceWords)
+	return 2 * precision * recall / (precision + recall)
+}
+
+// evalWords returns the lowercase words of text, without punctuation
+func evalWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,52 @@
+package llm
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRougeScores(t *testing.T) {
+	reference := "I fixed the login bug and deployed the fix"
+	tests := []struct {
+		summary        string
+		rouge1, rougeL float64
+	}{
+		{"I fixed the login bug and deployed the fix", 1, 1},
+		{"Deployed the fix. I fixed the login bug!", 0.941, 0.588},
+		{"Nothing to report", 0, 0},
+		{"", 0, 0},
+	}
+	for _, test := range tests {
+		if got := Rouge1(test.summary, reference); math.Abs(got-test.rouge1) > 0.001 {
+			t.Errorf("Rouge1(%q) = %.3f, want %.3f", test.summary, got, test.rouge1)
+		}
+		if got := RougeL(test.summary, reference); math.Abs(got-test.rougeL) > 0.001 {
+			t.Errorf("RougeL(%q) = %.3f, want %.3f", test.summary, got, test.rougeL)
+		}
+	}
+}
+
+func TestEvaluateBundledCorpus(t *testing.T) {
+	cases, err := LoadEvalCorpus("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range cases {
+		if c.Name == "" || c.Reference == "" || len(c.Issues) == 0 || len(c.Comments) == 0 {
+			t.Errorf("incomplete case %+v", c)
+		}
+	}
+
+	embedded := Evaluate(NewEmbeddedLLM("embedded"), cases)
+	disabled := Evaluate(NewDisabledSummarizer(), cases)
+	if embedded.Cases != len(cases) || embedded.Errors != 0 {
+		t.Errorf("expected every case summarized, got %+v", embedded)
+	}
+	if embedded.Rouge1 <= disabled.Rouge1 || embedded.RougeL <= disabled.RougeL {
+		t.Errorf("expected the embedded summaries closer to the references than counts: %+v vs %+v", embedded, disabled)
+	}
+
+	if _, err := LoadEvalCorpus("testdata/missing.json"); err == nil {
+		t.Error("expected an error for a missing corpus")
+	}
+}
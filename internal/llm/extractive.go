@@ -0,0 +1,183 @@
+package llm
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"my-day/internal/jira"
+)
+
+// extractiveSentences is how many sentences the embedded standup summary picks at most
+const extractiveSentences = 4
+
+// duplicateOverlap is the share of words two sentences have in common for the second to be
+// left out as a repeat of the first
+const duplicateOverlap = 0.6
+
+// sentenceEnd matches the end of a sentence, or a line break between list items
+var sentenceEnd = regexp.MustCompile(`[.!?]+(\s+|$)|\n+`)
+
+// workActions are verbs that say what was done, which make a sentence worth reporting
+var workActions = []string{
+	"implemented", "created", "fixed", "updated", "resolved", "deployed", "configured", "integrated",
+	"refactored", "optimized", "tested", "merged", "reviewed", "investigating", "working on",
+	"added", "removed", "migrated", "released", "upgraded", "completed", "finished", "blocked",
+}
+
+// candidate is a sentence of a comment that may go into an extractive summary
+type candidate struct {
+	text  string
+	words map[string]bool
+	score float64
+	order int
+}
+
+// extractiveSummary picks the most informative sentences of the comments: sentences are scored
+// by the technical patterns the pattern matcher recognizes, the work they report and how recent
+// they are, repeats are dropped, and the rest are ordered by importance within the configured
+// max summary length. It returns "" when no sentence says anything worth reporting.
+func (e *EmbeddedLLM) extractiveSummary(comments []jira.Comment) string {
+	// Oldest comments first, so the order of a sentence tells how recent it is
+	sorted := append([]jira.Comment(nil), comments...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Created.Time.Before(sorted[j].Created.Time) })
+
+	matcher := NewTechnicalPatternMatcher(false)
+	var candidates []candidate
+	for i, comment := range sorted {
+		recency := 0.0
+		if e.shouldPrioritizeRecentWork() && len(sorted) > 1 {
+			recency = 0.5 * float64(i) / float64(len(sorted)-1)
+		}
+		for _, sentence := range splitSentences(comment.Body.Text) {
+			score := e.scoreSentence(matcher, sentence)
+			if score <= 0 {
+				continue
+			}
+			candidates = append(candidates, candidate{sentence, sentenceWords(sentence), score + recency, len(candidates)})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].score > candidates[j].score })
+
+	budget := e.getConfiguredMaxLength()
+	var picked []candidate
+	length := 0
+	for _, c := range candidates {
+		if len(picked) == extractiveSentences {
+			break
+		}
+		if repeatsAny(c, picked) || (len(picked) > 0 && length+len(c.text)+1 > budget) {
+			continue
+		}
+		picked = append(picked, c)
+		length += len(c.text) + 1
+	}
+
+	sentences := make([]string, 0, len(picked))
+	for _, c := range picked {
+		sentences = append(sentences, asSentence(c.text))
+	}
+	return strings.Join(sentences, " ")
+}
+
+// scoreSentence scores how much a sentence tells about the work done, 0 for sentences not worth
+// reporting such as greetings, links or questions without technical content
+func (e *EmbeddedLLM) scoreSentence(matcher *TechnicalPatternMatcher, sentence string) float64 {
+	words := strings.Fields(sentence)
+	if len(words) < 3 {
+		return 0
+	}
+
+	score := 0.0
+	if results, err := matcher.MatchAllPatterns(sentence); err == nil {
+		confidence, _ := results["overall_confidence"].(float64)
+		matches, _ := results["total_matches"].(int)
+		score += confidence * float64(min(matches, 3))
+	}
+	lower := strings.ToLower(sentence)
+	for _, action := range workActions {
+		if strings.Contains(lower, action) {
+			score++
+			break
+		}
+	}
+	score += 0.2 * float64(min(len(e.extractTechnicalTerms(sentence)), 5))
+	if score == 0 {
+		return 0
+	}
+
+	// Links and questions carry little on their own, and long sentences crowd out the rest
+	if len(e.extractURLs(sentence)) > 0 && len(words) < 6 {
+		score *= 0.3
+	}
+	if strings.HasSuffix(sentence, "?") {
+		score *= 0.5
+	}
+	if len(words) > 40 {
+		score *= 0.7
+	}
+	return score
+}
+
+// splitSentences splits text into sentences and list items, without their bullets
+func splitSentences(text string) []string {
+	var sentences []string
+	start := 0
+	for _, end := range sentenceEnd.FindAllStringIndex(text, -1) {
+		sentences = appendSentence(sentences, text[start:end[1]])
+		start = end[1]
+	}
+	return appendSentence(sentences, text[start:])
+}
+
+// appendSentence appends a sentence trimmed of spaces and list bullets, unless it is empty
+func appendSentence(sentences []string, sentence string) []string {
+	sentence = strings.TrimLeft(strings.TrimSpace(sentence), "-*•> ")
+	if sentence == "" {
+		return sentences
+	}
+	return append(sentences, sentence)
+}
+
+// sentenceWords returns the distinct lowercase words of a sentence longer than two letters
+func sentenceWords(sentence string) map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(sentence), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len(word) > 2 {
+			words[word] = true
+		}
+	}
+	return words
+}
+
+// repeatsAny reports whether a candidate shares most of its words with a picked sentence
+func repeatsAny(c candidate, picked []candidate) bool {
+	for _, p := range picked {
+		common := 0
+		for word := range c.words {
+			if p.words[word] {
+				common++
+			}
+		}
+		if smaller := min(len(c.words), len(p.words)); smaller > 0 && float64(common)/float64(smaller) >= duplicateOverlap {
+			return true
+		}
+	}
+	return false
+}
+
+// asSentence capitalizes text and ends it with a full stop unless it already ends a sentence
+func asSentence(text string) string {
+	text = strings.TrimRight(text, " ,;:")
+	runes := []rune(text)
+	runes[0] = unicode.ToUpper(runes[0])
+	text = string(runes)
+	if !strings.ContainsAny(text[len(text)-1:], ".!?") {
+		text += "."
+	}
+	return text
+}
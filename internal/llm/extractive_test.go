@@ -0,0 +1,57 @@
+package llm
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func TestEmbeddedExtractiveStandupSummary(t *testing.T) {
+	day := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	comment := func(hour int, text string) jira.Comment {
+		return jira.Comment{Body: jira.JiraDescription{Text: text}, Created: jira.JiraTime{Time: day.Add(time.Duration(hour) * time.Hour)}}
+	}
+	comments := []jira.Comment{
+		comment(0, "Thanks! See https://example.com/build/42"),
+		comment(1, "Deployed the Terraform module for the staging VPC. Looks good."),
+		comment(2, "deployed the terraform module for the staging VPC again after the review"),
+		comment(3, "- fixed the flaky Kubernetes readiness probe in the helm chart\n- updated the docs"),
+	}
+	issues := []jira.Issue{{Key: "OPS-1"}, {Key: "OPS-2"}}
+
+	summary, err := NewEmbeddedLLMWithConfig(LLMConfig{PrioritizeRecentWork: true}).GenerateStandupSummaryWithComments(issues, comments, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.HasPrefix(summary, "Recent activity") {
+		t.Fatalf("expected prose extracted from the comments, got %q", summary)
+	}
+	if !strings.HasPrefix(summary, "Fixed the flaky Kubernetes readiness probe in the helm chart.") {
+		t.Errorf("expected the most informative and recent sentence first, got %q", summary)
+	}
+	if strings.Count(strings.ToLower(summary), "terraform module") != 1 {
+		t.Errorf("expected the repeated deployment once, got %q", summary)
+	}
+	for _, noise := range []string{"Thanks", "Looks good", "example.com"} {
+		if strings.Contains(summary, noise) {
+			t.Errorf("expected %q to be left out, got %q", noise, summary)
+		}
+	}
+
+	// Comments without anything to report keep the activity counts
+	summary, _ = NewEmbeddedLLM("embedded").GenerateStandupSummaryWithComments(issues, []jira.Comment{comment(0, "ok, thanks")}, nil)
+	if summary != "Recent activity: 2 issues, 1 comments" {
+		t.Errorf("unexpected fallback summary %q", summary)
+	}
+}
+
+func TestSplitSentences(t *testing.T) {
+	got := splitSentences("Bumped to v1.2.3. Is it done?\n* checked the logs\n\nok")
+	want := []string{"Bumped to v1.2.3.", "Is it done?", "checked the logs", "ok"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("splitSentences() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,54 @@
+package llm
+
+import (
+	"sort"
+	"strings"
+
+	"my-day/internal/jira"
+)
+
+// parentLabel describes the parent of an issue in the prompts, e.g. "epic WEB-1 (Checkout revamp)"
+func parentLabel(parent *jira.LinkedIssue) string {
+	label := parent.Key
+	if parent.Fields.Summary != "" {
+		label += " (" + parent.Fields.Summary + ")"
+	}
+	if kind := strings.ToLower(parent.Fields.IssueType.Name); kind != "" {
+		label = kind + " " + label
+	}
+	return label
+}
+
+// hierarchyInstructions lists the parents several issues of a summary roll up to, so the model
+// reports the work as progress on the parent. It returns "" when no issues share a parent.
+func hierarchyInstructions(issues []jira.Issue) string {
+	parents := make(map[string]*jira.LinkedIssue)
+	children := make(map[string][]string)
+	for _, issue := range issues {
+		parent := issue.Fields.Parent
+		if parent == nil || parent.Key == "" {
+			continue
+		}
+		parents[parent.Key] = parent
+		children[parent.Key] = append(children[parent.Key], issue.Key)
+	}
+
+	var keys []string
+	for key, subtasks := range children {
+		if len(subtasks) > 1 {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return ""
+	}
+	sort.Strings(keys)
+
+	var instructions strings.Builder
+	instructions.WriteString("These issues roll up to a parent. Describe the work on them as progress on the parent, e.g. \"progressed epic X via subtasks A and B\":\n")
+	for _, key := range keys {
+		instructions.WriteString("- " + parentLabel(parents[key]) + ": " + strings.Join(children[key], ", ") + "\n")
+	}
+	instructions.WriteString("\n")
+	return instructions.String()
+}
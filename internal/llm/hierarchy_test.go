@@ -0,0 +1,34 @@
+package llm
+
+import (
+	"strings"
+	"testing"
+
+	"my-day/internal/jira"
+)
+
+func TestHierarchyInPrompt(t *testing.T) {
+	epic := &jira.LinkedIssue{Key: "WEB-1"}
+	epic.Fields.Summary = "Checkout revamp"
+	epic.Fields.IssueType.Name = "Epic"
+
+	subtask := func(key string) jira.Issue {
+		issue := projectIssue(key, "WEB")
+		issue.Fields.Parent = epic
+		return issue
+	}
+	issues := []jira.Issue{subtask("WEB-2"), subtask("WEB-3"), projectIssue("OPS-1", "OPS")}
+
+	client := NewOllamaClientWithConfig(LLMConfig{SummaryStyle: "technical"})
+	prompt := client.buildEnhancedStandupPrompt(issues, nil, nil)
+	if !strings.Contains(prompt, "- epic WEB-1 (Checkout revamp): WEB-2, WEB-3\n") {
+		t.Errorf("expected the epic and its subtasks in the prompt, got:\n%s", prompt)
+	}
+	if !strings.Contains(prompt, "  Parent: epic WEB-1 (Checkout revamp)\n") {
+		t.Errorf("expected the parent of each issue in the work data, got:\n%s", prompt)
+	}
+
+	if got := hierarchyInstructions([]jira.Issue{subtask("WEB-2"), projectIssue("OPS-1", "OPS")}); got != "" {
+		t.Errorf("expected no hierarchy without issues sharing a parent, got:\n%s", got)
+	}
+}
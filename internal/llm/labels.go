@@ -0,0 +1,84 @@
+package llm
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"my-day/internal/jira"
+)
+
+// genericTerms are technical terms the processor detects that are too broad to label issues with
+var genericTerms = map[string]bool{
+	"api": true, "rest": true, "endpoint": true, "microservice": true, "database": true,
+	"pipeline": true, "authentication": true, "authorization": true, "security": true,
+	"encryption": true, "https": true, "monitoring": true, "logging": true, "metrics": true,
+	"alerts": true, "proxy": true,
+}
+
+// labelAliases maps detected terms to the label used for them
+var labelAliases = map[string]string{
+	"k8s":           "kubernetes",
+	"ci/cd":         "ci-cd",
+	"load balancer": "load-balancer",
+}
+
+// LabelSuggestion is a label proposed for an issue
+type LabelSuggestion struct {
+	Label    string
+	Mentions int // Comments mentioning the technology
+}
+
+// SuggestLabels proposes Jira labels for an issue from the technologies the processor detects
+// in its comments, most mentioned first. Labels the issue already has, and technologies
+// mentioned in fewer than minMentions comments, are left out.
+func SuggestLabels(issue jira.Issue, comments []jira.Comment, minMentions int) []LabelSuggestion {
+	existing := make(map[string]bool)
+	for _, label := range issue.Fields.Labels {
+		existing[strings.ToLower(label)] = true
+	}
+
+	processor := NewEnhancedDataProcessor(false)
+	mentions := make(map[string]int)
+	for _, comment := range comments {
+		processed, err := processor.AnalyzeComment(comment)
+		if err != nil {
+			continue
+		}
+		labels := make(map[string]bool)
+		for _, term := range processed.TechnicalTerms {
+			// The processor matches terms inside words too ("rds" in "words"), labels need a whole word
+			if genericTerms[term] || !mentionsWord(comment.Body.Text, term) {
+				continue
+			}
+			label := term
+			if alias, ok := labelAliases[term]; ok {
+				label = alias
+			}
+			labels[label] = true
+		}
+		for label := range labels {
+			mentions[label]++
+		}
+	}
+
+	var suggestions []LabelSuggestion
+	for label, count := range mentions {
+		if count >= minMentions && !existing[label] {
+			suggestions = append(suggestions, LabelSuggestion{Label: label, Mentions: count})
+		}
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Mentions != suggestions[j].Mentions {
+			return suggestions[i].Mentions > suggestions[j].Mentions
+		}
+		return suggestions[i].Label < suggestions[j].Label
+	})
+	return suggestions
+}
+
+// mentionsWord reports whether text mentions term as a whole word, ignoring case
+func mentionsWord(text, term string) bool {
+	pattern := `(?i)(^|[^a-z0-9])` + regexp.QuoteMeta(term) + `($|[^a-z0-9])`
+	return regexp.MustCompile(pattern).MatchString(text)
+}
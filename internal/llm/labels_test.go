@@ -0,0 +1,31 @@
+package llm
+
+import (
+	"testing"
+
+	"my-day/internal/jira"
+)
+
+func TestSuggestLabels(t *testing.T) {
+	issue := jira.Issue{Key: "OPS-1", Fields: jira.Fields{Labels: []string{"Docker"}}}
+	comments := []jira.Comment{
+		{ID: "1", Body: jira.JiraDescription{Text: "Applied the Terraform plan for the k8s cluster and rebuilt the docker image"}},
+		{ID: "2", Body: jira.JiraDescription{Text: "Terraform state is locked again, retrying"}},
+		{ID: "3", Body: jira.JiraDescription{Text: "A few words about the API endpoint"}},
+	}
+
+	suggestions := SuggestLabels(issue, comments, 1)
+	want := []LabelSuggestion{{Label: "terraform", Mentions: 2}, {Label: "kubernetes", Mentions: 1}}
+	if len(suggestions) != len(want) {
+		t.Fatalf("expected %v, got %v", want, suggestions)
+	}
+	for i := range want {
+		if suggestions[i] != want[i] {
+			t.Errorf("suggestion %d: expected %v, got %v", i, want[i], suggestions[i])
+		}
+	}
+
+	if suggestions := SuggestLabels(issue, comments, 2); len(suggestions) != 1 || suggestions[0].Label != "terraform" {
+		t.Errorf("expected only terraform with two mentions, got %v", suggestions)
+	}
+}
@@ -0,0 +1,131 @@
+package llm
+
+import (
+	"strings"
+	"unicode"
+
+	"my-day/internal/jira"
+)
+
+// languageStopwords are short, frequent words that identify each supported language.
+// Languages are listed in tie-break order.
+var languageStopwords = []struct {
+	language string
+	words    map[string]bool
+}{
+	{"English", wordSet("the and is are was were with this that for have has not but from will it to of")},
+	{"Spanish", wordSet("el la los las que y en con para por una es está se del al pero hemos ya como muy")},
+	{"Portuguese", wordSet("o os as que e em com para uma não está foi do da mas já muito também")},
+	{"French", wordSet("le la les des et est un une pour avec dans que pas sur du au nous")},
+	{"German", wordSet("der die das und ist nicht mit für auf ein eine zu den wir ich auch")},
+}
+
+// languageCodes maps ISO 639-1 codes accepted in llm.language to language names
+var languageCodes = map[string]string{
+	"en": "English",
+	"es": "Spanish",
+	"pt": "Portuguese",
+	"fr": "French",
+	"de": "German",
+	"it": "Italian",
+	"nl": "Dutch",
+	"ca": "Catalan",
+	"gl": "Galician",
+	"eu": "Basque",
+	"pl": "Polish",
+	"sv": "Swedish",
+	"da": "Danish",
+	"nb": "Norwegian",
+	"no": "Norwegian",
+	"fi": "Finnish",
+	"cs": "Czech",
+	"ro": "Romanian",
+	"tr": "Turkish",
+	"ru": "Russian",
+	"uk": "Ukrainian",
+	"ja": "Japanese",
+	"zh": "Chinese",
+	"ko": "Korean",
+}
+
+// minLanguageEvidence is the number of stopword hits needed before a language is trusted
+const minLanguageEvidence = 3
+
+func wordSet(words string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(words) {
+		set[word] = true
+	}
+	return set
+}
+
+// DetectLanguage returns the dominant language of the given texts, or "" if there is
+// not enough text to tell
+func DetectLanguage(texts []string) string {
+	scores := make([]int, len(languageStopwords))
+	for _, text := range texts {
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r)
+		})
+		for _, word := range words {
+			for i, candidate := range languageStopwords {
+				if candidate.words[word] {
+					scores[i]++
+				}
+			}
+		}
+	}
+
+	best := -1
+	for i, score := range scores {
+		if score >= minLanguageEvidence && (best < 0 || score > scores[best]) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return ""
+	}
+	return languageStopwords[best].language
+}
+
+// summaryLanguage returns the language summaries should be written in: the configured
+// language, or the dominant language of the comments when set to "auto"
+func summaryLanguage(config *LLMConfig, comments []jira.Comment) string {
+	var texts []string
+	for _, comment := range comments {
+		texts = append(texts, comment.Body.Text)
+	}
+	return textLanguage(config, texts)
+}
+
+// textLanguage returns the configured language, or the dominant language of texts when set
+// to "auto"
+func textLanguage(config *LLMConfig, texts []string) string {
+	language := "auto"
+	if config != nil && config.Language != "" {
+		language = config.Language
+	}
+
+	if !strings.EqualFold(language, "auto") {
+		if name, ok := languageCodes[strings.ToLower(language)]; ok {
+			return name
+		}
+		return language
+	}
+	return DetectLanguage(texts)
+}
+
+// languageInstruction tells the model which language to write in, so days with comments in
+// several languages don't produce half-translated summaries
+func (o *OllamaClient) languageInstruction(comments []jira.Comment) string {
+	return writeIn("summary", summaryLanguage(o.config, comments))
+}
+
+// writeIn asks the model to write what, such as "summary", in language. Issue keys and
+// technical terms are kept as they are, so they still match Jira.
+func writeIn(what, language string) string {
+	if language == "" {
+		return ""
+	}
+	return "IMPORTANT: Write the entire " + what + " in " + language + ", translating any notes written in other languages. Keep issue keys, names and technical terms as they are.\n\n"
+}
@@ -0,0 +1,109 @@
+package llm
+
+import (
+	"strings"
+	"testing"
+
+	"my-day/internal/jira"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		texts    []string
+		expected string
+	}{
+		{
+			name:     "English",
+			texts:    []string{"Deployed the runner chart to staging and verified that autoscaling works"},
+			expected: "English",
+		},
+		{
+			name:     "Spanish",
+			texts:    []string{"Hemos desplegado el chart en staging y ya funciona el autoescalado de los runners"},
+			expected: "Spanish",
+		},
+		{
+			name: "Mixed day with mostly Spanish comments",
+			texts: []string{
+				"Revisé la configuración de los runners y ya está lista para producción",
+				"Actualizado el pipeline para que use la nueva imagen",
+				"Fixed the cache path",
+			},
+			expected: "Spanish",
+		},
+		{
+			name:     "Too little text",
+			texts:    []string{"LGTM", "OPS-101 done"},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectLanguage(tt.texts); got != tt.expected {
+				t.Errorf("DetectLanguage() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSummaryLanguage(t *testing.T) {
+	spanish := []jira.Comment{{Body: jira.JiraDescription{Text: "Hemos migrado los runners y ya está en producción con la nueva imagen"}}}
+
+	tests := []struct {
+		name     string
+		language string
+		expected string
+	}{
+		{"auto detects from comments", "auto", "Spanish"},
+		{"empty behaves like auto", "", "Spanish"},
+		{"code override", "en", "English"},
+		{"name override", "Italian", "Italian"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := summaryLanguage(&LLMConfig{Language: tt.language}, spanish); got != tt.expected {
+				t.Errorf("summaryLanguage() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStandupPromptIncludesLanguageInstruction(t *testing.T) {
+	client := NewOllamaClientWithConfig(LLMConfig{SummaryStyle: "brief", Language: "auto"})
+	comments := []jira.Comment{{Body: jira.JiraDescription{Text: "Hemos terminado la migración de la base de datos y ya está en producción"}}}
+
+	prompt := client.buildStandupPromptWithComments(nil, comments, nil)
+	if !strings.Contains(prompt, "Write the entire summary in Spanish") {
+		t.Errorf("expected prompt to ask for a Spanish summary, got:\n%s", prompt)
+	}
+
+	prompt = client.buildStandupPromptWithComments(nil, nil, nil)
+	if strings.Contains(prompt, "Write the entire summary in") {
+		t.Errorf("expected no language instruction without comments, got:\n%s", prompt)
+	}
+}
+
+func TestPromptsAreWrittenInTheConfiguredLanguage(t *testing.T) {
+	client := NewOllamaClientWithConfig(LLMConfig{Language: "de"})
+	issue := jira.Issue{Key: "OPS-1", Fields: jira.Fields{Summary: "Upgrade the ingress controller"}}
+
+	if prompt := client.buildIssuePrompt(issue); !strings.Contains(prompt, "Write the entire summary in German") {
+		t.Errorf("expected the issue prompt to ask for German, got:\n%s", prompt)
+	}
+	if prompt := client.buildNextStepsPrompt([]jira.Issue{issue}, nil); !strings.Contains(prompt, "Write the entire list of next steps in German") {
+		t.Errorf("expected the next steps prompt to ask for German, got:\n%s", prompt)
+	}
+	if prompt := client.buildThemesPrompt([]jira.Issue{issue}, nil, nil); !strings.Contains(prompt, "Keep the THEME, ISSUES and SUMMARY labels in English") {
+		t.Errorf("expected the themes prompt to keep its labels parseable, got:\n%s", prompt)
+	}
+
+	// With auto, an issue summary follows the language of the issue itself
+	client = NewOllamaClientWithConfig(LLMConfig{Language: "auto"})
+	issue.Fields.Summary = "Migrar la base de datos de los runners a la nueva región"
+	if prompt := client.buildIssuePrompt(issue); !strings.Contains(prompt, "Write the entire summary in Spanish") {
+		t.Errorf("expected the issue prompt to follow the Spanish issue, got:\n%s", prompt)
+	}
+}
@@ -0,0 +1,86 @@
+package llm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"my-day/internal/jira"
+)
+
+// maxNextSteps is how many next steps are proposed at most
+const maxNextSteps = 5
+
+var (
+	// nextStepItemPattern matches an item of a numbered or bulleted list, e.g. "1. Rerun the migration"
+	nextStepItemPattern = regexp.MustCompile(`^\s*(?:\d+[.)]|[-*•])\s+(.+)$`)
+
+	// nextStepLinePattern matches a line of a comment stating what comes next, e.g. "Next: rerun the
+	// migration" or "Mañana: relanzar la migración"
+	nextStepLinePattern = regexp.MustCompile(`(?i)^\W*(?:next steps?|next|todo|to do|tomorrow|siguientes? pasos?|próximos? pasos?|mañana|amanhã|prochaines? étapes?|demain|nächste schritte?|morgen)\s*[:\-–—]\s*(.+)$`)
+)
+
+// buildNextStepsPrompt creates a prompt that proposes concrete next steps for tomorrow from the
+// in-progress issues and today's comments on them
+func (o *OllamaClient) buildNextStepsPrompt(issues []jira.Issue, comments []jira.Comment) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("You are helping plan tomorrow's work. Based on the issues in progress and the latest comments on them, propose what to do next.\n\n")
+	prompt.WriteString(o.buildStructuredDataSection(issues, comments, nil, false))
+	prompt.WriteString(fmt.Sprintf("Propose 3 to %d concrete next steps for tomorrow. Each step is one short, actionable sentence that starts with a verb and names the issue key it belongs to, e.g. \"Roll out the ingress upgrade to prod-us (OPS-12)\".\n", maxNextSteps))
+	prompt.WriteString("Only propose steps that follow from the work shown; don't invent issues or tasks.\n\n")
+	prompt.WriteString("Answer with a numbered list and nothing else.\n\n")
+	prompt.WriteString(o.projectInstructions(issues, ""))
+	prompt.WriteString(hierarchyInstructions(issues))
+	prompt.WriteString(writeIn("list of next steps", summaryLanguage(o.config, comments)))
+
+	return prompt.String()
+}
+
+// parseNextSteps reads the steps of a numbered or bulleted list in a response to the next steps
+// prompt, keeping at most maxNextSteps
+func parseNextSteps(response string) ([]string, error) {
+	var steps []string
+	for _, line := range strings.Split(response, "\n") {
+		match := nextStepItemPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		step := strings.TrimSpace(strings.NewReplacer("**", "", "__", "").Replace(match[1]))
+		if step != "" {
+			steps = append(steps, step)
+		}
+	}
+
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("no next steps found in the LLM response")
+	}
+	if len(steps) > maxNextSteps {
+		steps = steps[:maxNextSteps]
+	}
+	return steps, nil
+}
+
+// suggestNextSteps proposes next steps without an LLM: the steps stated in comments, such as
+// "Next: rerun the migration", then continuing each in-progress issue
+func suggestNextSteps(issues []jira.Issue, comments []jira.Comment) []string {
+	var steps []string
+	for i := len(comments) - 1; i >= 0 && len(steps) < maxNextSteps; i-- {
+		for _, line := range strings.Split(comments[i].Body.Text, "\n") {
+			if match := nextStepLinePattern.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+				steps = append(steps, strings.TrimSpace(match[1]))
+				break
+			}
+		}
+	}
+
+	for _, issue := range issues {
+		if len(steps) >= maxNextSteps {
+			break
+		}
+		if strings.EqualFold(issue.Fields.Status.Category.Key, "indeterminate") {
+			steps = append(steps, fmt.Sprintf("Continue %s: %s", issue.Key, issue.Fields.Summary))
+		}
+	}
+	return steps
+}
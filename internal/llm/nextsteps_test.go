@@ -0,0 +1,55 @@
+package llm
+
+import (
+	"reflect"
+	"testing"
+
+	"my-day/internal/jira"
+)
+
+func TestParseNextSteps(t *testing.T) {
+	response := `Here are the next steps:
+
+1. **Roll out** the ingress upgrade to prod-us (OPS-12)
+2) Ask the DBA team for the replica (OPS-15)
+- Rerun the failing migration test (OPS-20)
+* Update the runbook (OPS-12)
+5. Close the release checklist (OPS-21)
+6. One step too many (OPS-22)`
+
+	steps, err := parseNextSteps(response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"Roll out the ingress upgrade to prod-us (OPS-12)",
+		"Ask the DBA team for the replica (OPS-15)",
+		"Rerun the failing migration test (OPS-20)",
+		"Update the runbook (OPS-12)",
+		"Close the release checklist (OPS-21)",
+	}
+	if !reflect.DeepEqual(steps, expected) {
+		t.Errorf("unexpected steps %q", steps)
+	}
+
+	if _, err := parseNextSteps("Keep going with what you were doing."); err == nil {
+		t.Error("expected an error for a response without a list")
+	}
+}
+
+func TestSuggestNextSteps(t *testing.T) {
+	issues := []jira.Issue{
+		{Key: "OPS-1", Fields: jira.Fields{Summary: "Upgrade ingress", Status: jira.Status{Category: jira.StatusCategory{Key: "indeterminate"}}}},
+		{Key: "OPS-2", Fields: jira.Fields{Summary: "Rotate certificates", Status: jira.Status{Category: jira.StatusCategory{Key: "done"}}}},
+	}
+	comments := []jira.Comment{
+		{Body: jira.JiraDescription{Text: "Staging is done.\nNext: roll out to prod-us"}},
+		{Body: jira.JiraDescription{Text: "TODO: ask the DBA team for the replica"}},
+		{Body: jira.JiraDescription{Text: "Mañana: revisar las alertas con el equipo"}},
+	}
+
+	expected := []string{"revisar las alertas con el equipo", "ask the DBA team for the replica", "roll out to prod-us", "Continue OPS-1: Upgrade ingress"}
+	if steps := suggestNextSteps(issues, comments); !reflect.DeepEqual(steps, expected) {
+		t.Errorf("unexpected steps %q", steps)
+	}
+}
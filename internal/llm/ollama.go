@@ -5,11 +5,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"sort"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"my-day/internal/jira"
+	"my-day/internal/trace"
 )
 
 // OllamaClient represents a client for Ollama API
@@ -18,13 +22,16 @@ type OllamaClient struct {
 	model   string
 	client  *http.Client
 	config  *LLMConfig
+
+	fallbacks atomic.Int64 // Calls answered by the embedded fallback
 }
 
 // OllamaRequest represents a request to Ollama API
 type OllamaRequest struct {
-	Model  string `json:"model"`
-	Prompt string `json:"prompt"`
-	Stream bool   `json:"stream"`
+	Model  string          `json:"model"`
+	Prompt string          `json:"prompt"`
+	Stream bool            `json:"stream"`
+	Format json.RawMessage `json:"format,omitempty"` // JSON schema of the response, for structured output
 }
 
 // OllamaResponse represents a response from Ollama API
@@ -54,7 +61,7 @@ func NewOllamaClient(baseURL, model string) *OllamaClient {
 	return &OllamaClient{
 		baseURL: strings.TrimSuffix(baseURL, "/"),
 		model:   model,
-		client:  &http.Client{Timeout: 30 * time.Second},
+		client:  &http.Client{Timeout: 30 * time.Second, Transport: trace.Transport("ollama", nil)},
 	}
 }
 
@@ -68,7 +75,7 @@ func NewOllamaClientWithConfig(config LLMConfig) *OllamaClient {
 	return &OllamaClient{
 		baseURL: strings.TrimSuffix(config.OllamaURL, "/"),
 		model:   config.OllamaModel,
-		client:  &http.Client{Timeout: timeout},
+		client:  &http.Client{Timeout: timeout, Transport: trace.Transport("ollama", nil)},
 		config:  &config, // Store config for prompt generation
 	}
 }
@@ -76,12 +83,14 @@ func NewOllamaClientWithConfig(config LLMConfig) *OllamaClient {
 // NewOllamaClientWithDockerManagement creates an Ollama client with automatic Docker management
 func NewOllamaClientWithDockerManagement(config LLMConfig) (Summarizer, error) {
 	dockerManager := NewDockerLLMManager()
+	dockerManager.SetModel(config.OllamaModel)
+	dockerManager.SetIdleStop(config.OllamaIdleStop)
 	
 	// Try to ensure Docker LLM is ready
 	if err := dockerManager.EnsureReady(); err != nil {
 		// If Docker setup fails, fall back to embedded LLM with a warning
-		fmt.Printf("⚠️  Docker LLM setup failed (%v), falling back to embedded model\n", err)
-		return NewEmbeddedLLMWithConfig(config), nil
+		slog.Warn("Docker LLM setup failed, falling back to the embedded model", "error", err)
+		return NewEmbeddedLLMStandIn(config, err), nil
 	}
 	
 	// Use the Docker-managed Ollama instance
@@ -89,13 +98,18 @@ func NewOllamaClientWithDockerManagement(config LLMConfig) (Summarizer, error) {
 	dockerConfig.OllamaURL = dockerManager.GetBaseURL()
 	dockerConfig.OllamaModel = dockerManager.GetModel()
 	
-	return NewOllamaClientWithConfig(dockerConfig), nil
+	return NewOllamaSummarizer(dockerConfig), nil
 }
 
 // SummarizeIssue generates a summary for a Jira issue using Ollama with fallback
 func (o *OllamaClient) SummarizeIssue(issue jira.Issue) (string, error) {
+	return o.summarizeIssue(context.Background(), issue)
+}
+
+// summarizeIssue summarizes an issue, giving up on Ollama when ctx is done
+func (o *OllamaClient) summarizeIssue(ctx context.Context, issue jira.Issue) (string, error) {
 	prompt := o.buildIssuePrompt(issue)
-	result, err := o.generate(prompt)
+	result, err := o.generateContext(ctx, prompt)
 	
 	// If Ollama fails, fallback to embedded LLM
 	if err != nil && o.shouldFallbackToEmbedded(err) {
@@ -122,21 +136,9 @@ func (o *OllamaClient) SummarizeComments(comments []jira.Comment) (string, error
 	return result, err
 }
 
-// SummarizeIssues generates summaries for multiple issues
+// SummarizeIssues generates summaries for multiple issues, llm.concurrency at a time
 func (o *OllamaClient) SummarizeIssues(issues []jira.Issue) (map[string]string, error) {
-	summaries := make(map[string]string)
-	
-	for _, issue := range issues {
-		summary, err := o.SummarizeIssue(issue)
-		if err != nil {
-			// Use fallback for failed requests
-			summaries[issue.Key] = fmt.Sprintf("Status: %s - %s", issue.Fields.Status.Name, issue.Fields.Summary)
-			continue
-		}
-		summaries[issue.Key] = summary
-	}
-	
-	return summaries, nil
+	return summarizeConcurrently(issues, o.config.concurrency(), o.summarizeIssue), nil
 }
 
 // SummarizeWorklog generates a summary for worklog entries
@@ -182,6 +184,81 @@ func (o *OllamaClient) GenerateStandupSummaryWithComments(issues []jira.Issue, c
 	return result, err
 }
 
+// GenerateWeeklySummary creates a narrative summary of a week of work
+func (o *OllamaClient) GenerateWeeklySummary(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (string, error) {
+	prompt := o.buildWeeklyPrompt(issues, comments, worklogs)
+	result, err := o.generate(prompt)
+	
+	// If Ollama fails, fallback to embedded LLM
+	if err != nil && o.shouldFallbackToEmbedded(err) {
+		return o.fallbackToEmbedded().GenerateWeeklySummary(issues, comments, worklogs)
+	}
+	
+	return result, err
+}
+
+// GenerateThemes groups the day's work into 2-4 themes with a summary of each
+func (o *OllamaClient) GenerateThemes(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) ([]Theme, error) {
+	prompt := o.buildThemesPrompt(issues, comments, worklogs)
+	result, err := o.generate(prompt)
+	
+	// If Ollama fails, fallback to embedded LLM
+	if err != nil && o.shouldFallbackToEmbedded(err) {
+		return o.fallbackToEmbedded().GenerateThemes(issues, comments, worklogs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	
+	return parseThemes(result, issues)
+}
+
+// GenerateNextSteps proposes 3-5 concrete next steps for tomorrow from the work in progress
+func (o *OllamaClient) GenerateNextSteps(issues []jira.Issue, comments []jira.Comment) ([]string, error) {
+	prompt := o.buildNextStepsPrompt(issues, comments)
+	result, err := o.generate(prompt)
+	
+	// If Ollama fails, fallback to embedded LLM
+	if err != nil && o.shouldFallbackToEmbedded(err) {
+		return o.fallbackToEmbedded().GenerateNextSteps(issues, comments)
+	}
+	if err != nil {
+		return nil, err
+	}
+	
+	return parseNextSteps(result)
+}
+
+// GenerateStructuredSummary summarizes the day as accomplishments, work in progress, blockers
+// and next steps, asking Ollama for JSON that follows the schema
+func (o *OllamaClient) GenerateStructuredSummary(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (*StructuredSummary, error) {
+	prompt := o.buildStructuredSummaryPrompt(issues, comments, worklogs)
+	result, err := o.generateContext(withResponseSchema(context.Background(), structuredSummarySchema), prompt)
+	
+	// If Ollama fails, fallback to embedded LLM
+	if err != nil && o.shouldFallbackToEmbedded(err) {
+		return o.fallbackToEmbedded().GenerateStructuredSummary(issues, comments, worklogs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	
+	return parseStructuredSummary(result)
+}
+
+// GenerateReleaseNotes drafts user-facing release notes for the issues completed in a version
+func (o *OllamaClient) GenerateReleaseNotes(version string, issues []jira.Issue) (string, error) {
+	prompt := o.buildReleaseNotesPrompt(version, issues)
+	result, err := o.generate(prompt)
+	
+	// If Ollama fails, fallback to embedded LLM
+	if err != nil && o.shouldFallbackToEmbedded(err) {
+		return o.fallbackToEmbedded().GenerateReleaseNotes(version, issues)
+	}
+	
+	return result, err
+}
+
 // TestConnection tests if Ollama is available
 func (o *OllamaClient) TestConnection() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -199,7 +276,7 @@ func (o *OllamaClient) TestConnection() error {
 	defer resp.Body.Close()
 	
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Ollama returned status %d", resp.StatusCode)
+		return trace.Errorf(resp, "Ollama returned status %d", resp.StatusCode)
 	}
 	
 	return nil
@@ -207,21 +284,32 @@ func (o *OllamaClient) TestConnection() error {
 
 // generate sends a prompt to Ollama and returns the response with retry logic
 func (o *OllamaClient) generate(prompt string) (string, error) {
-	return o.generateWithRetry(prompt, 3) // Default 3 retries
+	return o.generateContext(context.Background(), prompt)
+}
+
+// generateContext sends a prompt to Ollama with retry logic, giving up when ctx is done
+func (o *OllamaClient) generateContext(ctx context.Context, prompt string) (string, error) {
+	result, err := o.generateWithRetry(ctx, prompt, 3) // Default 3 retries
+	recordPrompt("ollama", o.model, prompt, result, err)
+	return result, err
 }
 
 // generateWithRetry sends a prompt to Ollama with retry logic and enhanced error handling
-func (o *OllamaClient) generateWithRetry(prompt string, maxRetries int) (string, error) {
+func (o *OllamaClient) generateWithRetry(ctx context.Context, prompt string, maxRetries int) (string, error) {
 	var lastErr error
 	
 	for attempt := 0; attempt <= maxRetries; attempt++ {
 		if attempt > 0 {
 			// Exponential backoff: wait 1s, 2s, 4s between retries
 			waitTime := time.Duration(1<<(attempt-1)) * time.Second
-			time.Sleep(waitTime)
+			select {
+			case <-time.After(waitTime):
+			case <-ctx.Done():
+				return "", o.enhanceErrorMessage(lastErr, attempt-1)
+			}
 		}
 		
-		result, err := o.attemptGenerate(prompt)
+		result, err := o.attemptGenerate(ctx, prompt)
 		if err == nil {
 			return result, nil
 		}
@@ -233,10 +321,7 @@ func (o *OllamaClient) generateWithRetry(prompt string, maxRetries int) (string,
 			break
 		}
 		
-		// Log retry attempt if debug is enabled
-		if o.config != nil && o.config.Debug {
-			fmt.Printf("Ollama request failed (attempt %d/%d): %v\n", attempt+1, maxRetries+1, err)
-		}
+		slog.Debug("Ollama request failed", "attempt", attempt+1, "attempts", maxRetries+1, "error", err)
 	}
 	
 	// All retries failed, return enhanced error message
@@ -244,20 +329,21 @@ func (o *OllamaClient) generateWithRetry(prompt string, maxRetries int) (string,
 }
 
 // attemptGenerate makes a single attempt to generate a response from Ollama
-func (o *OllamaClient) attemptGenerate(prompt string) (string, error) {
+func (o *OllamaClient) attemptGenerate(ctx context.Context, prompt string) (string, error) {
 	// Use longer timeout if debug is enabled
 	timeout := 30 * time.Second
 	if o.config != nil && o.config.Debug {
 		timeout = 60 * time.Second
 	}
 	
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 	
 	request := OllamaRequest{
 		Model:  o.model,
 		Prompt: prompt,
 		Stream: false,
+		Format: responseSchema(ctx),
 	}
 	
 	requestBody, err := json.Marshal(request)
@@ -303,7 +389,7 @@ func (o *OllamaClient) attemptGenerate(prompt string) (string, error) {
 		bodyBytes, _ := json.Marshal(resp.Body)
 		return "", &OllamaError{
 			Type:    "api_error",
-			Message: fmt.Sprintf("Ollama API returned status %d", resp.StatusCode),
+			Message: trace.Errorf(resp, "Ollama API returned status %d", resp.StatusCode).Error(),
 			Details: map[string]interface{}{
 				"status_code": resp.StatusCode,
 				"response_body": string(bodyBytes),
@@ -354,7 +440,7 @@ func (o *OllamaClient) enhanceErrorMessage(err error, retries int) error {
 			if details, ok := ollamaErr.Details["status_code"].(int); ok {
 				switch details {
 				case 404:
-					return fmt.Errorf("model '%s' not found in Ollama. Please pull the model first with 'ollama pull %s'", o.model, o.model)
+					return fmt.Errorf("model '%s' not found in Ollama. Please pull the model first with 'my-day llm pull %s'", o.model, o.model)
 				case 500:
 					return fmt.Errorf("Ollama server error after %d retries. The service might be overloaded or experiencing issues", retries+1)
 				default:
@@ -390,7 +476,9 @@ Summary: %s`,
 		prompt += fmt.Sprintf("\nDescription: %s", issue.Fields.Description.Text)
 	}
 	
-	prompt += "\n\nIMPORTANT: Write the summary in first person (using 'I' statements) as if you are the person working on this ticket.\n"
+	prompt += "\n\n" + o.projectInstructions([]jira.Issue{issue}, "")
+	prompt += writeIn("summary", textLanguage(o.config, []string{issue.Fields.Summary, issue.Fields.Description.Text}))
+	prompt += "IMPORTANT: Write the summary in first person (using 'I' statements) as if you are the person working on this ticket.\n"
 	prompt += "Provide a 1-2 sentence summary suitable for a standup report:"
 	
 	return prompt
@@ -410,7 +498,8 @@ func (o *OllamaClient) buildWorklogPrompt(worklogs []jira.WorklogEntry) string {
 			worklog.Comment)
 	}
 	
-	prompt += "\nIMPORTANT: Write the summary in first person (using 'I' statements) as if you are the person who did this work.\n"
+	prompt += "\n" + o.languageInstruction(nil)
+	prompt += "IMPORTANT: Write the summary in first person (using 'I' statements) as if you are the person who did this work.\n"
 	prompt += "Provide a brief summary of the work accomplished:"
 	
 	return prompt
@@ -425,22 +514,164 @@ func (o *OllamaClient) buildStandupPrompt(issues []jira.Issue, worklogs []jira.W
 // buildCommentsPrompt creates a prompt for summarizing user's comments
 func (o *OllamaClient) buildCommentsPrompt(comments []jira.Comment) string {
 	prompt := "Summarize the following comments made today for a daily standup report. Focus on what work was accomplished:\n\n"
+	if period := o.period(); period != "" {
+		prompt = "Summarize the following comments made from " + period + " for a standup report. Focus on what work was accomplished:\n\n"
+	}
 	
 	for i, comment := range comments {
 		if i >= 5 { // Limit to avoid too long prompts
 			break
 		}
 		
-		timeStr := comment.Created.Time.Format("15:04")
+		timeStr := o.commentTime(comment)
 		prompt += fmt.Sprintf("Comment at %s: %s\n", timeStr, comment.Body.Text)
 	}
 	
-	prompt += "\nIMPORTANT: Write the summary in first person (using 'I' statements) as if you are the person who did the work.\n"
+	prompt += "\n" + o.periodInstruction() + o.languageInstruction(comments)
+	prompt += "IMPORTANT: Write the summary in first person (using 'I' statements) as if you are the person who did the work.\n"
 	prompt += "Provide a 1-2 sentence summary of the work progress described in these comments:"
 	
 	return prompt
 }
 
+// period returns the days a multi-day report covers, or "" for a daily report
+func (o *OllamaClient) period() string {
+	if o.config == nil {
+		return ""
+	}
+	return o.config.Period
+}
+
+// periodInstruction tells the model that the work spans several days, so a report after a
+// vacation isn't summarized as if it all happened today
+func (o *OllamaClient) periodInstruction() string {
+	if period := o.period(); period != "" {
+		return "IMPORTANT: This work spans several days (" + period + "), not a single day. Summarize the period as a whole, leading with the most important outcomes.\n\n"
+	}
+	return ""
+}
+
+// commentTime returns when a comment was written for the prompts: the time of day, with the
+// day in front for a multi-day report
+func (o *OllamaClient) commentTime(comment jira.Comment) string {
+	if o.period() != "" {
+		return comment.Created.Time.Format("Jan 2 15:04")
+	}
+	return comment.Created.Time.Format("15:04")
+}
+
+// statusChanges lists the status changes of an issue for the prompts, timed like comments, so
+// work done by moving an issue without commenting is not missed
+func (o *OllamaClient) statusChanges(issue jira.Issue) string {
+	layout := "15:04"
+	if o.period() != "" {
+		layout = "Jan 2 15:04"
+	}
+	changes := make([]string, 0, len(issue.Transitions))
+	for _, transition := range issue.Transitions {
+		changes = append(changes, transition.Format(layout))
+	}
+	return strings.Join(changes, ", ")
+}
+
+// buildWeeklyPrompt creates a prompt for a weekly narrative, with activity grouped by day
+func (o *OllamaClient) buildWeeklyPrompt(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) string {
+	var prompt strings.Builder
+	
+	prompt.WriteString("You are writing a weekly summary of one person's work for their team. ")
+	prompt.WriteString("Describe what was accomplished over the week, how the work progressed from day to day, and what is still in progress.\n\n")
+	
+	prompt.WriteString("=== ISSUES ===\n")
+	for i, issue := range issues {
+		if i >= 15 { // Limit to avoid too long prompts
+			break
+		}
+		prompt.WriteString(fmt.Sprintf("- %s [%s]: %s\n", issue.Key, issue.Fields.Status.Name, issue.Fields.Summary))
+	}
+	
+	// List comments and worklogs chronologically, grouped by day, so the model can follow the week's progression
+	type activity struct {
+		at   time.Time
+		text string
+	}
+	var activities []activity
+	for i, comment := range comments {
+		if i >= 30 { // Limit to avoid too long prompts
+			break
+		}
+		activities = append(activities, activity{comment.Created.Time, "Comment: " + comment.Body.Text})
+	}
+	for i, worklog := range worklogs {
+		if i >= 20 {
+			break
+		}
+		activities = append(activities, activity{worklog.Started.Time, fmt.Sprintf("Worklog on %s: %s", worklog.IssueID, worklog.Comment)})
+	}
+	for _, issue := range issues {
+		for _, transition := range issue.Transitions {
+			activities = append(activities, activity{transition.At.Time, fmt.Sprintf("Moved %s from %s to %s", issue.Key, transition.From, transition.To)})
+		}
+	}
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].at.Before(activities[j].at)
+	})
+	
+	prompt.WriteString("\n=== DAILY ACTIVITY ===\n")
+	currentDay := ""
+	for _, a := range activities {
+		if day := a.at.Format("Monday, Jan 2"); day != currentDay {
+			currentDay = day
+			prompt.WriteString(day + ":\n")
+		}
+		prompt.WriteString("- " + a.text + "\n")
+	}
+	prompt.WriteString("=== END DATA ===\n\n")
+	
+	prompt.WriteString(o.projectInstructions(issues, ""))
+	prompt.WriteString(hierarchyInstructions(issues))
+	prompt.WriteString(o.languageInstruction(comments))
+	prompt.WriteString("IMPORTANT: Write in first person (using 'I' statements) as if you are the person who did this work.\n")
+	if maxLength := o.getMaxSummaryLength(); maxLength > 0 {
+		prompt.WriteString(fmt.Sprintf("Keep the summary under %d characters.\n", maxLength))
+	}
+	prompt.WriteString("Write a short weekly narrative of 3-5 sentences:")
+	
+	return prompt.String()
+}
+
+// buildReleaseNotesPrompt creates a prompt for user-facing release notes of a version
+func (o *OllamaClient) buildReleaseNotesPrompt(version string, issues []jira.Issue) string {
+	var prompt strings.Builder
+	
+	prompt.WriteString(fmt.Sprintf("You are writing the release notes for version %s of a product, for its users. ", version))
+	prompt.WriteString("Describe what changed from the user's point of view: what is new, what works better and what was fixed. ")
+	prompt.WriteString("Leave out internal work that users would not notice, such as refactoring, CI or dependency updates.\n\n")
+	
+	prompt.WriteString("=== COMPLETED ISSUES ===\n")
+	for i, issue := range issues {
+		if i >= 50 { // Limit to avoid too long prompts
+			break
+		}
+		prompt.WriteString(fmt.Sprintf("- [%s] %s", issue.Fields.IssueType.Name, issue.Fields.Summary))
+		if description := strings.TrimSpace(issue.Fields.Description.Text); description != "" {
+			if len(description) > 200 {
+				description = description[:200] + "..."
+			}
+			prompt.WriteString(": " + strings.Join(strings.Fields(description), " "))
+		}
+		prompt.WriteString("\n")
+	}
+	prompt.WriteString("=== END DATA ===\n\n")
+	
+	prompt.WriteString(o.languageInstruction(nil))
+	prompt.WriteString("IMPORTANT: Do not mention issue keys, issue types or internal team names.\n")
+	prompt.WriteString("Group the notes under the headings 'New Features', 'Improvements' and 'Bug Fixes', leaving out empty headings, ")
+	prompt.WriteString("with one short bullet per change written in plain language.\n")
+	prompt.WriteString("Start with one sentence introducing the release, then write the notes:")
+	
+	return prompt.String()
+}
+
 // buildStandupPromptWithComments creates a comprehensive prompt for standup summary with comments
 func (o *OllamaClient) buildStandupPromptWithComments(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) string {
 	// Use enhanced prompt generation with configuration-aware templates
@@ -449,8 +680,8 @@ func (o *OllamaClient) buildStandupPromptWithComments(issues []jira.Issue, comme
 
 // buildEnhancedStandupPrompt creates an enhanced standup prompt with configuration-aware templates
 func (o *OllamaClient) buildEnhancedStandupPrompt(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) string {
-	// Get summary style from configuration
-	summaryStyle := o.getSummaryStyle()
+	// Get summary style from configuration, or from the projects of the issues
+	summaryStyle := o.styleFor(issues)
 	maxLength := o.getMaxSummaryLength()
 	includeTechnicalDetails := o.shouldIncludeTechnicalDetails()
 	
@@ -497,6 +728,10 @@ func (o *OllamaClient) buildTechnicalStylePrompt(issues []jira.Issue, comments [
 		prompt += "Use technical terminology appropriately and mention specific tools, services, or technologies involved.\n\n"
 	}
 	
+	prompt += o.projectInstructions(issues, "technical")
+	prompt += hierarchyInstructions(issues)
+	prompt += o.periodInstruction()
+	prompt += o.languageInstruction(comments)
 	prompt += "IMPORTANT: Write the summary in first person (using 'I' statements) as if you are the person who did the work. This should sound natural when read aloud in a standup meeting.\n\n"
 	prompt += "Technical Summary:"
 	
@@ -525,6 +760,10 @@ func (o *OllamaClient) buildBusinessStylePrompt(issues []jira.Issue, comments []
 	prompt += "4. Next steps toward project milestones\n\n"
 	
 	prompt += "Avoid technical jargon and focus on business value and outcomes.\n\n"
+	prompt += o.projectInstructions(issues, "business")
+	prompt += hierarchyInstructions(issues)
+	prompt += o.periodInstruction()
+	prompt += o.languageInstruction(comments)
 	prompt += "IMPORTANT: Write the summary in first person (using 'I' statements) as if you are the person who did the work. This should sound natural when read aloud in a standup meeting.\n\n"
 	prompt += "Business Summary:"
 	
@@ -545,6 +784,10 @@ func (o *OllamaClient) buildBriefStylePrompt(issues []jira.Issue, comments []jir
 	prompt += "3. Any immediate blockers\n\n"
 	
 	prompt += "Keep it concise and focus on high-impact activities only.\n\n"
+	prompt += o.projectInstructions(issues, "brief")
+	prompt += hierarchyInstructions(issues)
+	prompt += o.periodInstruction()
+	prompt += o.languageInstruction(comments)
 	prompt += "IMPORTANT: Write the summary in first person (using 'I' statements) as if you are the person who did the work. This should sound natural when read aloud in a standup meeting.\n\n"
 	prompt += "Brief Summary:"
 	
@@ -586,19 +829,29 @@ func (o *OllamaClient) buildStructuredDataSection(issues []jira.Issue, comments
 				}
 			}
 			section.WriteString("\n")
+			if parent := issue.Fields.Parent; parent != nil && parent.Key != "" {
+				section.WriteString("  Parent: " + parentLabel(parent) + "\n")
+			}
+			if changes := o.statusChanges(issue); changes != "" {
+				section.WriteString("  Status changes: " + changes + "\n")
+			}
 		}
 		section.WriteString("\n")
 	}
 	
 	// Add comments with enhanced analysis
 	if len(comments) > 0 {
-		section.WriteString("Today's Activity Comments:\n")
+		if period := o.period(); period != "" {
+			section.WriteString("Activity Comments from " + period + ":\n")
+		} else {
+			section.WriteString("Today's Activity Comments:\n")
+		}
 		for i, comment := range comments {
 			if i >= 8 { // Show more comments since they're the main data source
 				break
 			}
 			
-			timeStr := comment.Created.Time.Format("15:04")
+			timeStr := o.commentTime(comment)
 			activityType := o.determineActivityType(comment.Body.Text)
 			
 			section.WriteString(fmt.Sprintf("- [%s] %s: %s\n", 
@@ -609,9 +862,11 @@ func (o *OllamaClient) buildStructuredDataSection(issues []jira.Issue, comments
 		section.WriteString("\n")
 	}
 	
-	// Add worklog summary
+	// Add where the logged time went, largest first
 	if len(worklogs) > 0 {
-		section.WriteString(fmt.Sprintf("Work Logged: %d entries\n\n", len(worklogs)))
+		section.WriteString(fmt.Sprintf("Work Logged: %d entries\n", len(worklogs)))
+		section.WriteString(formatTimeSpent(worklogs))
+		section.WriteString("\n")
 	}
 	
 	section.WriteString("=== END DATA ===\n\n")
@@ -619,6 +874,44 @@ func (o *OllamaClient) buildStructuredDataSection(issues []jira.Issue, comments
 	return section.String()
 }
 
+// formatTimeSpent lists the time logged per issue, largest first, with the worklog comments
+func formatTimeSpent(worklogs []jira.WorklogEntry) string {
+	type issueTime struct {
+		issue    string
+		seconds  int
+		comments []string
+	}
+
+	var totals []*issueTime
+	byIssue := make(map[string]*issueTime)
+	for _, worklog := range worklogs {
+		total := byIssue[worklog.IssueID]
+		if total == nil {
+			total = &issueTime{issue: worklog.IssueID}
+			byIssue[worklog.IssueID] = total
+			totals = append(totals, total)
+		}
+		total.seconds += worklog.TimeSpentSeconds
+		if worklog.Comment != "" {
+			total.comments = append(total.comments, worklog.Comment)
+		}
+	}
+	sort.SliceStable(totals, func(i, j int) bool { return totals[i].seconds > totals[j].seconds })
+
+	var result strings.Builder
+	for _, total := range totals {
+		if total.seconds == 0 {
+			continue
+		}
+		result.WriteString(fmt.Sprintf("- %s: %.1fh", total.issue, float64(total.seconds)/3600))
+		if len(total.comments) > 0 {
+			result.WriteString(" (" + strings.Join(total.comments, "; ") + ")")
+		}
+		result.WriteString("\n")
+	}
+	return result.String()
+}
+
 // Configuration helper methods
 func (o *OllamaClient) getSummaryStyle() string {
 	if o.config != nil && o.config.SummaryStyle != "" {
@@ -744,8 +1037,14 @@ func (o *OllamaClient) shouldFallbackToEmbedded(err error) bool {
 	return true // Fallback on unknown errors
 }
 
+// Fallbacks returns how many calls the embedded fallback answered because Ollama could not
+func (o *OllamaClient) Fallbacks() int {
+	return int(o.fallbacks.Load())
+}
+
 // fallbackToEmbedded creates an embedded LLM instance for fallback
 func (o *OllamaClient) fallbackToEmbedded() *EmbeddedLLM {
+	o.fallbacks.Add(1)
 	if o.config != nil {
 		return NewEmbeddedLLMWithConfig(*o.config)
 	}
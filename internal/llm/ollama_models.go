@@ -0,0 +1,179 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"my-day/internal/trace"
+)
+
+// OllamaModel is a model installed in Ollama, as listed by /api/tags
+type OllamaModel struct {
+	Name       string    `json:"name"`
+	Size       int64     `json:"size"`
+	ModifiedAt time.Time `json:"modified_at"`
+	Details    struct {
+		ParameterSize     string `json:"parameter_size"`
+		QuantizationLevel string `json:"quantization_level"`
+	} `json:"details"`
+}
+
+// RunningOllamaModel is a model loaded in Ollama, as listed by /api/ps
+type RunningOllamaModel struct {
+	Name      string    `json:"name"`
+	Size      int64     `json:"size"`
+	SizeVRAM  int64     `json:"size_vram"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// PullProgress is a progress update of a model pull, as streamed by /api/pull
+type PullProgress struct {
+	Status    string `json:"status"`
+	Digest    string `json:"digest"`
+	Total     int64  `json:"total"`
+	Completed int64  `json:"completed"`
+	Error     string `json:"error"`
+}
+
+// Percent returns how much of the current layer is downloaded, or -1 when the update is not a download
+func (p PullProgress) Percent() int {
+	if p.Total <= 0 {
+		return -1
+	}
+	return int(p.Completed * 100 / p.Total)
+}
+
+// ListOllamaModels returns the models installed in the Ollama server at baseURL
+func ListOllamaModels(baseURL string) ([]OllamaModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(baseURL, "/")+"/api/tags", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	client := &http.Client{Transport: trace.Transport("ollama", nil)}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Ollama: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, trace.Errorf(resp, "Ollama returned status %d", resp.StatusCode)
+	}
+
+	var tags struct {
+		Models []OllamaModel `json:"models"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tags); err != nil {
+		return nil, fmt.Errorf("failed to decode Ollama models: %w", err)
+	}
+	return tags.Models, nil
+}
+
+// ListRunningOllamaModels returns the models loaded in the Ollama server at baseURL
+func ListRunningOllamaModels(baseURL string) ([]RunningOllamaModel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(baseURL, "/")+"/api/ps", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	client := &http.Client{Transport: trace.Transport("ollama", nil)}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Ollama: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, trace.Errorf(resp, "Ollama returned status %d", resp.StatusCode)
+	}
+
+	var running struct {
+		Models []RunningOllamaModel `json:"models"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&running); err != nil {
+		return nil, fmt.Errorf("failed to decode Ollama running models: %w", err)
+	}
+	return running.Models, nil
+}
+
+// HasOllamaModel reports whether model is among the installed models, where a model without a
+// tag stands for its latest tag
+func HasOllamaModel(models []OllamaModel, model string) bool {
+	if !strings.Contains(model, ":") {
+		model += ":latest"
+	}
+	for _, installed := range models {
+		if installed.Name == model {
+			return true
+		}
+	}
+	return false
+}
+
+// PullOllamaModel downloads model into the Ollama server at baseURL, calling progress with each
+// update Ollama streams back. Pulls of large models take minutes, so there is no overall timeout.
+func PullOllamaModel(baseURL, model string, progress func(PullProgress)) error {
+	body, err := json.Marshal(map[string]interface{}{"model": model, "stream": true})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", strings.TrimSuffix(baseURL, "/")+"/api/pull", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Transport: trace.Transport("ollama", nil)}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Ollama: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return trace.Errorf(resp, "Ollama returned status %d", resp.StatusCode)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	succeeded := false
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var update PullProgress
+		if err := json.Unmarshal(line, &update); err != nil {
+			return fmt.Errorf("failed to decode pull progress: %w", err)
+		}
+		if update.Error != "" {
+			return fmt.Errorf("failed to pull %s: %s", model, update.Error)
+		}
+		if progress != nil {
+			progress(update)
+		}
+		succeeded = update.Status == "success"
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read pull progress: %w", err)
+	}
+	if !succeeded {
+		return fmt.Errorf("pull of %s ended before it completed", model)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestListOllamaModels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/tags" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"models":[{"name":"qwen2.5:3b","size":1929912432,"details":{"parameter_size":"3.1B","quantization_level":"Q4_K_M"}},{"name":"mistral:latest","size":4113301824}]}`)
+	}))
+	defer server.Close()
+
+	models, err := ListOllamaModels(server.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(models) != 2 || models[0].Name != "qwen2.5:3b" || models[0].Details.ParameterSize != "3.1B" {
+		t.Fatalf("unexpected models %+v", models)
+	}
+	if !HasOllamaModel(models, "qwen2.5:3b") || !HasOllamaModel(models, "mistral") || HasOllamaModel(models, "llama3.1:8b") {
+		t.Error("expected installed models to be found, with a missing tag meaning latest")
+	}
+}
+
+func TestListRunningOllamaModels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/ps" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"models":[{"name":"qwen2.5:3b","size":3000000000,"size_vram":1500000000,"expires_at":"2024-07-15T09:05:00Z"}]}`)
+	}))
+	defer server.Close()
+
+	running, err := ListRunningOllamaModels(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(running) != 1 || running[0].Name != "qwen2.5:3b" || running[0].SizeVRAM != 1500000000 || running[0].ExpiresAt.Minute() != 5 {
+		t.Errorf("unexpected running models %+v", running)
+	}
+}
+
+func TestPullOllamaModelStreamsProgress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Model  string `json:"model"`
+			Stream bool   `json:"stream"`
+		}
+		if r.Method != "POST" || r.URL.Path != "/api/pull" || json.NewDecoder(r.Body).Decode(&request) != nil || !request.Stream {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if request.Model == "missing:1b" {
+			fmt.Fprintln(w, `{"status":"pulling manifest"}`)
+			fmt.Fprintln(w, `{"error":"pull model manifest: file does not exist"}`)
+			return
+		}
+		fmt.Fprintln(w, `{"status":"pulling manifest"}`)
+		fmt.Fprintln(w, `{"status":"pulling 6a0746a1ec1a","digest":"sha256:6a0746a1ec1a","total":200,"completed":50}`)
+		fmt.Fprintln(w, `{"status":"pulling 6a0746a1ec1a","digest":"sha256:6a0746a1ec1a","total":200,"completed":200}`)
+		fmt.Fprintln(w, `{"status":"success"}`)
+	}))
+	defer server.Close()
+
+	var updates []string
+	err := PullOllamaModel(server.URL, "qwen2.5:3b", func(progress PullProgress) {
+		updates = append(updates, fmt.Sprintf("%s %d", progress.Status, progress.Percent()))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "pulling manifest -1|pulling 6a0746a1ec1a 25|pulling 6a0746a1ec1a 100|success -1"
+	if strings.Join(updates, "|") != expected {
+		t.Errorf("unexpected progress %q", updates)
+	}
+
+	if err := PullOllamaModel(server.URL, "missing:1b", nil); err == nil || !strings.Contains(err.Error(), "file does not exist") {
+		t.Errorf("expected the pull error from Ollama, got %v", err)
+	}
+}
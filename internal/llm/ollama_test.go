@@ -1,6 +1,7 @@
 package llm
 
 import (
+	"strings"
 	"testing"
 	"time"
 	"my-day/internal/jira"
@@ -228,7 +229,7 @@ func TestEnhancedErrorMessages(t *testing.T) {
 			retries: 1,
 			expectedContains: []string{
 				"model 'test-model' not found",
-				"ollama pull test-model",
+				"my-day llm pull test-model",
 			},
 		},
 		{
@@ -542,6 +543,20 @@ func TestFallbackToEmbedded(t *testing.T) {
 	}
 }
 
+// TestFormatTimeSpent tests that logged time is listed per issue, largest first
+func TestFormatTimeSpent(t *testing.T) {
+	worklogs := []jira.WorklogEntry{
+		{IssueID: "OPS-1", Comment: "Toggl: standup", TimeSpentSeconds: 900},
+		{IssueID: "OPS-2", Comment: "Toggl: runner migration", TimeSpentSeconds: 5400},
+		{IssueID: "OPS-2", TimeSpentSeconds: 1800},
+	}
+
+	expected := "- OPS-2: 2.0h (Toggl: runner migration)\n- OPS-1: 0.2h (Toggl: standup)\n"
+	if got := formatTimeSpent(worklogs); got != expected {
+		t.Errorf("formatTimeSpent() = %q, want %q", got, expected)
+	}
+}
+
 // Helper function to check if a string contains a substring
 func containsSubstring(s, substr string) bool {
 	return len(s) >= len(substr) && 
@@ -550,4 +565,59 @@ func containsSubstring(s, substr string) bool {
 		  (s[:len(substr)] == substr || 
 		   s[len(s)-len(substr):] == substr || 
 		   containsSubstring(s[1:], substr))))
-}
\ No newline at end of file
+}
+
+func TestBuildReleaseNotesPrompt(t *testing.T) {
+	client := NewOllamaClientWithConfig(LLMConfig{})
+	issues := []jira.Issue{
+		{Key: "OPS-1", Fields: jira.Fields{
+			Summary:     "Export reports to Notion",
+			IssueType:   jira.IssueType{Name: "Story"},
+			Description: jira.JiraDescription{Text: "Users can publish\nthe daily report to a Notion page"},
+		}},
+	}
+
+	prompt := client.buildReleaseNotesPrompt("2.14", issues)
+	for _, want := range []string{
+		"release notes for version 2.14",
+		"- [Story] Export reports to Notion: Users can publish the daily report to a Notion page",
+		"Do not mention issue keys",
+	} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("expected %q in prompt:\n%s", want, prompt)
+		}
+	}
+}
+
+func TestStandupPromptCoversPeriod(t *testing.T) {
+	comments := []jira.Comment{{
+		Body:    jira.JiraDescription{Text: "Finished the database migration"},
+		Created: jira.JiraTime{Time: time.Date(2024, 6, 4, 14, 30, 0, 0, time.UTC)},
+	}}
+
+	prompt := NewOllamaClientWithConfig(LLMConfig{SummaryStyle: "technical", Period: "June 3 to June 7, 2024"}).buildStandupPromptWithComments(nil, comments, nil)
+	for _, expected := range []string{"Activity Comments from June 3 to June 7, 2024:", "[Jun 4 14:30]", "This work spans several days (June 3 to June 7, 2024)"} {
+		if !strings.Contains(prompt, expected) {
+			t.Errorf("expected %q in the prompt, got:\n%s", expected, prompt)
+		}
+	}
+
+	prompt = NewOllamaClientWithConfig(LLMConfig{SummaryStyle: "technical"}).buildStandupPromptWithComments(nil, comments, nil)
+	if !strings.Contains(prompt, "Today's Activity Comments:") || strings.Contains(prompt, "several days") {
+		t.Errorf("expected a single-day prompt, got:\n%s", prompt)
+	}
+}
+
+// TestStructuredDataSectionStatusChanges tests that status moves reach the prompt
+func TestStructuredDataSectionStatusChanges(t *testing.T) {
+	issue := jira.Issue{Key: "OPS-1", Transitions: []jira.StatusTransition{
+		{From: "In Review", To: "Done", At: jira.JiraTime{Time: time.Date(2024, 6, 3, 15, 20, 0, 0, time.UTC)}},
+	}}
+	issue.Fields.Status.Name = "Done"
+
+	client := NewOllamaClientWithConfig(LLMConfig{})
+	section := client.buildStructuredDataSection([]jira.Issue{issue}, nil, nil, false)
+	if !strings.Contains(section, "  Status changes: In Review → Done at 15:20\n") {
+		t.Errorf("expected the status changes in the prompt, got:\n%s", section)
+	}
+}
@@ -0,0 +1,481 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/trace"
+)
+
+const (
+	// DefaultOpenAIBaseURL is the default OpenAI-compatible API base URL
+	DefaultOpenAIBaseURL = "https://api.openai.com/v1"
+
+	// DefaultOpenAIModel is the default chat completion model
+	DefaultOpenAIModel = "gpt-4o-mini"
+
+	// DefaultLocalOpenAIBaseURL is the OpenAI-compatible API of LM Studio's local server
+	DefaultLocalOpenAIBaseURL = "http://localhost:1234/v1"
+)
+
+// OpenAIClient represents a client for OpenAI-compatible chat completion APIs
+// (OpenAI, Azure OpenAI, OpenRouter and other compatible gateways, or a local server such as
+// LM Studio or llama-server)
+type OpenAIClient struct {
+	baseURL    string
+	apiKey     string
+	model      string
+	apiVersion string // Set for Azure OpenAI deployments
+	local      bool   // A local server, which needs no API key
+	client     *http.Client
+	config     *LLMConfig
+	prompts    *OllamaClient // Prompt templates are shared with the Ollama backend
+	fallbacks  atomic.Int64  // Calls answered by the embedded fallback
+}
+
+// OpenAIChatMessage represents a single chat message
+type OpenAIChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// OpenAIChatRequest represents a chat completion request
+type OpenAIChatRequest struct {
+	Model          string                `json:"model,omitempty"`
+	Messages       []OpenAIChatMessage   `json:"messages"`
+	Temperature    float64               `json:"temperature"`
+	ResponseFormat *OpenAIResponseFormat `json:"response_format,omitempty"`
+}
+
+// OpenAIResponseFormat asks for a response in JSON following a schema
+type OpenAIResponseFormat struct {
+	Type       string `json:"type"` // "json_schema"
+	JSONSchema struct {
+		Name   string          `json:"name"`
+		Strict bool            `json:"strict"`
+		Schema json.RawMessage `json:"schema"`
+	} `json:"json_schema"`
+}
+
+// OpenAIChatResponse represents a chat completion response
+type OpenAIChatResponse struct {
+	Choices []struct {
+		Message OpenAIChatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+// OpenAIError represents a structured error from OpenAI-compatible API operations
+type OpenAIError struct {
+	Type       string
+	Message    string
+	StatusCode int
+	Cause      error
+}
+
+// Error implements the error interface
+func (e *OpenAIError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %s (caused by: %v)", e.Type, e.Message, e.Cause)
+	}
+	return fmt.Sprintf("%s: %s", e.Type, e.Message)
+}
+
+// NewOpenAIClientWithConfig creates a new OpenAI-compatible client with full configuration
+func NewOpenAIClientWithConfig(config LLMConfig) *OpenAIClient {
+	timeout := 30 * time.Second
+	if config.Debug {
+		timeout = 60 * time.Second // Longer timeout for debug mode
+	}
+
+	baseURL := config.OpenAIURL
+	if baseURL == "" {
+		baseURL = DefaultOpenAIBaseURL
+	}
+
+	model := config.OpenAIModel
+	if model == "" {
+		model = DefaultOpenAIModel
+	}
+
+	return &OpenAIClient{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     config.OpenAIAPIKey,
+		model:      model,
+		apiVersion: config.OpenAIAPIVersion,
+		client:     &http.Client{Timeout: timeout, Transport: trace.Transport("openai", nil)},
+		config:     &config,
+		prompts:    NewOllamaClientWithConfig(config),
+	}
+}
+
+// NewLocalOpenAIClient creates a client for a local OpenAI-compatible server, such as LM Studio
+// or llama-server, for the local-openai mode. Local models are slower than hosted ones, so
+// requests may take longer, and no API key is needed.
+func NewLocalOpenAIClient(config LLMConfig) *OpenAIClient {
+	timeout := 60 * time.Second
+	if config.Debug {
+		timeout = 120 * time.Second // Longer timeout for debug mode
+	}
+
+	baseURL := config.LocalOpenAIURL
+	if baseURL == "" {
+		baseURL = DefaultLocalOpenAIBaseURL
+	}
+
+	return &OpenAIClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		apiKey:  config.LocalOpenAIAPIKey,
+		model:   config.LocalOpenAIModel,
+		local:   true,
+		client:  &http.Client{Timeout: timeout, Transport: trace.Transport("local-openai", nil)},
+		config:  &config,
+		prompts: NewOllamaClientWithConfig(config),
+	}
+}
+
+// SummarizeIssue generates a summary for a Jira issue with fallback
+func (c *OpenAIClient) SummarizeIssue(issue jira.Issue) (string, error) {
+	return c.summarizeIssue(context.Background(), issue)
+}
+
+// summarizeIssue summarizes an issue, giving up on the API when ctx is done
+func (c *OpenAIClient) summarizeIssue(ctx context.Context, issue jira.Issue) (string, error) {
+	result, err := c.generateContext(ctx, c.prompts.buildIssuePrompt(issue))
+
+	// If the API fails, fallback to embedded LLM
+	if err != nil && c.shouldFallbackToEmbedded(err) {
+		return c.fallbackToEmbedded().SummarizeIssue(issue)
+	}
+
+	return result, err
+}
+
+// SummarizeComments generates a summary of user's comments with fallback
+func (c *OpenAIClient) SummarizeComments(comments []jira.Comment) (string, error) {
+	if len(comments) == 0 {
+		return "", nil
+	}
+
+	result, err := c.generate(c.prompts.buildCommentsPrompt(comments))
+
+	if err != nil && c.shouldFallbackToEmbedded(err) {
+		return c.fallbackToEmbedded().SummarizeComments(comments)
+	}
+
+	return result, err
+}
+
+// SummarizeIssues generates summaries for multiple issues, llm.concurrency at a time
+func (c *OpenAIClient) SummarizeIssues(issues []jira.Issue) (map[string]string, error) {
+	return summarizeConcurrently(issues, c.config.concurrency(), c.summarizeIssue), nil
+}
+
+// SummarizeWorklog generates a summary for worklog entries
+func (c *OpenAIClient) SummarizeWorklog(worklogs []jira.WorklogEntry) (string, error) {
+	if len(worklogs) == 0 {
+		return "No work logged", nil
+	}
+
+	result, err := c.generate(c.prompts.buildWorklogPrompt(worklogs))
+
+	if err != nil && c.shouldFallbackToEmbedded(err) {
+		return c.fallbackToEmbedded().SummarizeWorklog(worklogs)
+	}
+
+	return result, err
+}
+
+// GenerateStandupSummary creates an overall summary for standup reporting
+func (c *OpenAIClient) GenerateStandupSummary(issues []jira.Issue, worklogs []jira.WorklogEntry) (string, error) {
+	result, err := c.generate(c.prompts.buildStandupPrompt(issues, worklogs))
+
+	if err != nil && c.shouldFallbackToEmbedded(err) {
+		return c.fallbackToEmbedded().GenerateStandupSummary(issues, worklogs)
+	}
+
+	return result, err
+}
+
+// GenerateStandupSummaryWithComments creates an enhanced summary using comment data
+func (c *OpenAIClient) GenerateStandupSummaryWithComments(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (string, error) {
+	result, err := c.generate(c.prompts.buildStandupPromptWithComments(issues, comments, worklogs))
+
+	if err != nil && c.shouldFallbackToEmbedded(err) {
+		return c.fallbackToEmbedded().GenerateStandupSummaryWithComments(issues, comments, worklogs)
+	}
+
+	return result, err
+}
+
+// GenerateWeeklySummary creates a narrative summary of a week of work
+func (c *OpenAIClient) GenerateWeeklySummary(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (string, error) {
+	result, err := c.generate(c.prompts.buildWeeklyPrompt(issues, comments, worklogs))
+
+	if err != nil && c.shouldFallbackToEmbedded(err) {
+		return c.fallbackToEmbedded().GenerateWeeklySummary(issues, comments, worklogs)
+	}
+
+	return result, err
+}
+
+// GenerateThemes groups the day's work into 2-4 themes with a summary of each
+func (c *OpenAIClient) GenerateThemes(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) ([]Theme, error) {
+	result, err := c.generate(c.prompts.buildThemesPrompt(issues, comments, worklogs))
+
+	if err != nil && c.shouldFallbackToEmbedded(err) {
+		return c.fallbackToEmbedded().GenerateThemes(issues, comments, worklogs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return parseThemes(result, issues)
+}
+
+// GenerateNextSteps proposes 3-5 concrete next steps for tomorrow from the work in progress
+func (c *OpenAIClient) GenerateNextSteps(issues []jira.Issue, comments []jira.Comment) ([]string, error) {
+	result, err := c.generate(c.prompts.buildNextStepsPrompt(issues, comments))
+
+	if err != nil && c.shouldFallbackToEmbedded(err) {
+		return c.fallbackToEmbedded().GenerateNextSteps(issues, comments)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return parseNextSteps(result)
+}
+
+// GenerateStructuredSummary summarizes the day as accomplishments, work in progress, blockers
+// and next steps, asking for JSON that follows the schema
+func (c *OpenAIClient) GenerateStructuredSummary(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (*StructuredSummary, error) {
+	result, err := c.generateContext(withResponseSchema(context.Background(), structuredSummarySchema), c.prompts.buildStructuredSummaryPrompt(issues, comments, worklogs))
+
+	if err != nil && c.shouldFallbackToEmbedded(err) {
+		return c.fallbackToEmbedded().GenerateStructuredSummary(issues, comments, worklogs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return parseStructuredSummary(result)
+}
+
+// GenerateReleaseNotes drafts user-facing release notes for the issues completed in a version
+func (c *OpenAIClient) GenerateReleaseNotes(version string, issues []jira.Issue) (string, error) {
+	result, err := c.generate(c.prompts.buildReleaseNotesPrompt(version, issues))
+
+	if err != nil && c.shouldFallbackToEmbedded(err) {
+		return c.fallbackToEmbedded().GenerateReleaseNotes(version, issues)
+	}
+
+	return result, err
+}
+
+// TestConnection tests if the OpenAI-compatible endpoint is reachable and the API key is accepted
+func (c *OpenAIClient) TestConnection() error {
+	if c.apiKey == "" && !c.local {
+		return fmt.Errorf("OpenAI API key not configured. Set MY_DAY_LLM_OPENAI_API_KEY or llm.openai.api_key")
+	}
+
+	_, err := c.attemptGenerate(context.Background(), "Reply with OK.")
+	if err != nil {
+		if c.local {
+			return fmt.Errorf("failed to connect to the local OpenAI-compatible server at %s (is LM Studio's server or llama-server running?): %w", c.baseURL, err)
+		}
+		return fmt.Errorf("failed to connect to OpenAI-compatible API at %s: %w", c.baseURL, err)
+	}
+
+	return nil
+}
+
+// generate sends a prompt to the API and returns the response with retry logic
+func (c *OpenAIClient) generate(prompt string) (string, error) {
+	return c.generateContext(context.Background(), prompt)
+}
+
+// generateContext sends a prompt to the API with retry logic, giving up when ctx is done
+func (c *OpenAIClient) generateContext(ctx context.Context, prompt string) (string, error) {
+	result, err := c.generateWithRetry(ctx, prompt, 3) // Default 3 retries
+	recordPrompt(c.provider(), c.model, prompt, result, err)
+	return result, err
+}
+
+// generateWithRetry sends a prompt with retry logic and exponential backoff
+func (c *OpenAIClient) generateWithRetry(ctx context.Context, prompt string, maxRetries int) (string, error) {
+	var lastErr error
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			// Exponential backoff: wait 1s, 2s, 4s between retries
+			waitTime := time.Duration(1<<(attempt-1)) * time.Second
+			select {
+			case <-time.After(waitTime):
+			case <-ctx.Done():
+				return "", lastErr
+			}
+		}
+
+		result, err := c.attemptGenerate(ctx, prompt)
+		if err == nil {
+			return result, nil
+		}
+
+		lastErr = err
+
+		if !c.isRetryableError(err) {
+			break
+		}
+
+		slog.Debug("OpenAI request failed", "attempt", attempt+1, "attempts", maxRetries+1, "error", err)
+	}
+
+	return "", lastErr
+}
+
+// attemptGenerate makes a single chat completion request
+func (c *OpenAIClient) attemptGenerate(ctx context.Context, prompt string) (string, error) {
+	if c.apiKey == "" && !c.local {
+		return "", &OpenAIError{
+			Type:    "auth_error",
+			Message: "OpenAI API key not configured",
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.client.Timeout)
+	defer cancel()
+
+	request := OpenAIChatRequest{
+		Model: c.model,
+		Messages: []OpenAIChatMessage{
+			{Role: "system", Content: "You write concise daily standup summaries for software and DevOps engineers."},
+			{Role: "user", Content: prompt},
+		},
+		Temperature: 0.3,
+	}
+	if schema := responseSchema(ctx); schema != nil {
+		request.ResponseFormat = &OpenAIResponseFormat{Type: "json_schema"}
+		request.ResponseFormat.JSONSchema.Name = "response"
+		request.ResponseFormat.JSONSchema.Strict = true
+		request.ResponseFormat.JSONSchema.Schema = schema
+	}
+
+	requestBody, err := json.Marshal(request)
+	if err != nil {
+		return "", &OpenAIError{Type: "marshal_error", Message: "Failed to prepare request data", Cause: err}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.completionsURL(), bytes.NewBuffer(requestBody))
+	if err != nil {
+		return "", &OpenAIError{Type: "request_creation_error", Message: "Failed to create HTTP request", Cause: err}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiVersion != "" {
+		// Azure OpenAI authenticates with an api-key header
+		req.Header.Set("api-key", c.apiKey)
+	} else if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return "", &OpenAIError{Type: "timeout_error", Message: fmt.Sprintf("Request timed out after %v", c.client.Timeout), Cause: err}
+		}
+		return "", &OpenAIError{Type: "connection_error", Message: "Failed to connect to OpenAI-compatible API", Cause: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", &OpenAIError{
+			Type:       "api_error",
+			Message:    trace.Errorf(resp, "API returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body))).Error(),
+			StatusCode: resp.StatusCode,
+		}
+	}
+
+	var response OpenAIChatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return "", &OpenAIError{Type: "decode_error", Message: "Failed to decode chat completion response", Cause: err}
+	}
+
+	if len(response.Choices) == 0 {
+		return "", &OpenAIError{Type: "decode_error", Message: "Chat completion response contained no choices"}
+	}
+
+	return strings.TrimSpace(response.Choices[0].Message.Content), nil
+}
+
+// provider names the backend in prompt records
+func (c *OpenAIClient) provider() string {
+	if c.local {
+		return "local-openai"
+	}
+	return "openai"
+}
+
+// completionsURL returns the chat completions endpoint for the configured provider
+func (c *OpenAIClient) completionsURL() string {
+	url := c.baseURL + "/chat/completions"
+	if c.apiVersion != "" {
+		url += "?api-version=" + c.apiVersion
+	}
+	return url
+}
+
+// isRetryableError determines if an error should trigger a retry
+func (c *OpenAIClient) isRetryableError(err error) bool {
+	if openAIErr, ok := err.(*OpenAIError); ok {
+		switch openAIErr.Type {
+		case "timeout_error", "connection_error":
+			return true
+		case "api_error":
+			// Retry on rate limiting and server errors, but not other client errors
+			return openAIErr.StatusCode == http.StatusTooManyRequests ||
+				(openAIErr.StatusCode >= 500 && openAIErr.StatusCode < 600)
+		}
+	}
+	return false
+}
+
+// shouldFallbackToEmbedded determines if we should fallback to embedded LLM based on the error
+func (c *OpenAIClient) shouldFallbackToEmbedded(err error) bool {
+	if openAIErr, ok := err.(*OpenAIError); ok {
+		switch openAIErr.Type {
+		case "connection_error", "timeout_error", "auth_error":
+			return true
+		case "api_error":
+			return openAIErr.StatusCode == http.StatusTooManyRequests ||
+				openAIErr.StatusCode == http.StatusUnauthorized ||
+				(openAIErr.StatusCode >= 500 && openAIErr.StatusCode < 600)
+		default:
+			return false
+		}
+	}
+	return true // Fallback on unknown errors
+}
+
+// Fallbacks returns how many calls the embedded fallback answered because the API could not
+func (c *OpenAIClient) Fallbacks() int {
+	return int(c.fallbacks.Load())
+}
+
+// fallbackToEmbedded creates an embedded LLM instance for fallback
+func (c *OpenAIClient) fallbackToEmbedded() *EmbeddedLLM {
+	c.fallbacks.Add(1)
+	if c.config != nil {
+		return NewEmbeddedLLMWithConfig(*c.config)
+	}
+	return NewEmbeddedLLM(c.model)
+}
@@ -0,0 +1,163 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"my-day/internal/jira"
+)
+
+// TestOpenAIClientSummarizeIssue tests a successful chat completion round trip
+func TestOpenAIClientSummarizeIssue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat/completions" {
+			t.Errorf("Expected path /chat/completions, got %s", r.URL.Path)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer test-key" {
+			t.Errorf("Expected bearer auth header, got %q", auth)
+		}
+
+		var request OpenAIChatRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if request.Model != "test-model" {
+			t.Errorf("Expected model 'test-model', got '%s'", request.Model)
+		}
+
+		w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"  I fixed the login timeout.  "}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewOpenAIClientWithConfig(LLMConfig{
+		Enabled:      true,
+		Mode:         "openai",
+		OpenAIURL:    server.URL,
+		OpenAIAPIKey: "test-key",
+		OpenAIModel:  "test-model",
+	})
+
+	summary, err := client.SummarizeIssue(jira.Issue{Key: "TEST-1", Fields: jira.Fields{Summary: "Login timeout"}})
+	if err != nil {
+		t.Fatalf("SummarizeIssue() error = %v", err)
+	}
+	if summary != "I fixed the login timeout." {
+		t.Errorf("Expected trimmed summary, got %q", summary)
+	}
+}
+
+// TestOpenAIClientAzureHeaders tests that Azure deployments use the api-key header and api-version
+func TestOpenAIClientAzureHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("api-key") != "azure-key" {
+			t.Errorf("Expected api-key header, got %q", r.Header.Get("api-key"))
+		}
+		if r.URL.Query().Get("api-version") != "2024-02-01" {
+			t.Errorf("Expected api-version query parameter, got %q", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"ok"}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewOpenAIClientWithConfig(LLMConfig{
+		OpenAIURL:        server.URL,
+		OpenAIAPIKey:     "azure-key",
+		OpenAIAPIVersion: "2024-02-01",
+	})
+
+	if _, err := client.attemptGenerate(context.Background(), "test"); err != nil {
+		t.Fatalf("attemptGenerate() error = %v", err)
+	}
+}
+
+// TestLocalOpenAIClient tests that a local server is used without an API key or model
+func TestLocalOpenAIClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" {
+			t.Errorf("Expected path /v1/chat/completions, got %s", r.URL.Path)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("Expected no auth header, got %q", auth)
+		}
+
+		var request map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if _, found := request["model"]; found {
+			t.Errorf("Expected the loaded model to be used, got model %v", request["model"])
+		}
+
+		w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"Fixed the login timeout."}}]}`))
+	}))
+	defer server.Close()
+
+	config := LLMConfig{Enabled: true, Mode: "local-openai", LocalOpenAIURL: server.URL + "/v1/"}
+	if err := TestLLMConnection(config); err != nil {
+		t.Fatalf("TestLLMConnection() error = %v", err)
+	}
+
+	summarizer, err := NewSummarizer(config)
+	if err != nil {
+		t.Fatalf("NewSummarizer() error = %v", err)
+	}
+	if _, filtered := summarizer.(*privacyFilter); filtered {
+		t.Error("Expected a server on this machine not to be treated as remote")
+	}
+	summary, err := summarizer.SummarizeIssue(jira.Issue{Key: "TEST-1", Fields: jira.Fields{Summary: "Login timeout"}})
+	if err != nil || summary != "Fixed the login timeout." {
+		t.Errorf("Expected the server's summary, got %q (err=%v)", summary, err)
+	}
+}
+
+// TestOpenAIClientFallback tests fallback to the embedded model when the API key is missing
+func TestOpenAIClientFallback(t *testing.T) {
+	client := NewOpenAIClientWithConfig(LLMConfig{
+		Enabled:   true,
+		Mode:      "openai",
+		OpenAIURL: "http://127.0.0.1:0",
+	})
+
+	summary, err := client.SummarizeIssue(jira.Issue{
+		Key: "TEST-1",
+		Fields: jira.Fields{
+			Summary: "Fix authentication timeout",
+			Status:  jira.Status{Name: "In Progress"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected fallback summary, got error: %v", err)
+	}
+	if strings.TrimSpace(summary) == "" {
+		t.Error("Expected non-empty fallback summary")
+	}
+}
+
+// TestOpenAIClientRetryableErrors tests retry classification
+func TestOpenAIClientRetryableErrors(t *testing.T) {
+	client := NewOpenAIClientWithConfig(LLMConfig{})
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"Connection error", &OpenAIError{Type: "connection_error"}, true},
+		{"Rate limited", &OpenAIError{Type: "api_error", StatusCode: 429}, true},
+		{"Server error", &OpenAIError{Type: "api_error", StatusCode: 503}, true},
+		{"Bad request", &OpenAIError{Type: "api_error", StatusCode: 400}, false},
+		{"Missing key", &OpenAIError{Type: "auth_error"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := client.isRetryableError(tc.err); result != tc.expected {
+				t.Errorf("isRetryableError() = %v, expected %v", result, tc.expected)
+			}
+		})
+	}
+}
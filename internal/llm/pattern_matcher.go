@@ -2,6 +2,7 @@ package llm
 
 import (
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 )
@@ -370,6 +371,25 @@ func (m *TechnicalPatternMatcher) MatchInfrastructurePatterns(text string) ([]In
 	return patterns, nil
 }
 
+// MatchTechnologies returns the infrastructure and database technologies mentioned in text,
+// e.g. "terraform" or "kubernetes". Keywords must appear as whole words, so "tf" does not
+// match inside "platform".
+func (m *TechnicalPatternMatcher) MatchTechnologies(text string) []string {
+	var technologies []string
+	for _, patterns := range []map[string]*PatternDefinition{m.infrastructurePatterns, m.databasePatterns} {
+		for name, patternDef := range patterns {
+			for _, keyword := range patternDef.Keywords {
+				if mentionsWord(text, keyword) {
+					technologies = append(technologies, name)
+					break
+				}
+			}
+		}
+	}
+	sort.Strings(technologies)
+	return technologies
+}
+
 // MatchDeploymentPatterns finds deployment patterns in text
 func (m *TechnicalPatternMatcher) MatchDeploymentPatterns(text string) ([]DeploymentPattern, error) {
 	var patterns []DeploymentPattern
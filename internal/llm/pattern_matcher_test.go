@@ -1,6 +1,7 @@
 package llm
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -463,4 +464,18 @@ func TestRealWorldPatternMatching(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestMatchTechnologies(t *testing.T) {
+	matcher := NewTechnicalPatternMatcher(false)
+
+	technologies := matcher.MatchTechnologies("Applied the TF plan and bumped the Helm chart; Liquibase changeset pending")
+	expected := []string{"kubernetes", "liquibase", "terraform"}
+	if strings.Join(technologies, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected %v, got %v", expected, technologies)
+	}
+
+	if technologies := matcher.MatchTechnologies("Reviewed the platform roadmap"); len(technologies) != 0 {
+		t.Errorf("expected no technologies inside words, got %v", technologies)
+	}
+}
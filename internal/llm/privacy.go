@@ -0,0 +1,374 @@
+package llm
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"my-day/internal/jira"
+)
+
+// PrivacyConfig controls what leaves the machine when the LLM backend is remote
+type PrivacyConfig struct {
+	Redact          bool     // Remove emails, hostnames, IP addresses and secrets from issues and comments
+	Patterns        []string // Extra regular expressions whose matches are removed
+	AllowedProjects []string // Projects whose issues may be sent to a remote backend, empty for all
+}
+
+// enabled reports whether anything is redacted or withheld
+func (c PrivacyConfig) enabled() bool {
+	return c.Redact || len(c.Patterns) > 0 || len(c.AllowedProjects) > 0
+}
+
+var (
+	// secretPatterns match credentials pasted into issues and comments
+	secretPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)\b(password|passwd|pwd|secret|token|api[_-]?key|access[_-]?key)\b(\s*[:=]\s*)\S+`),
+		regexp.MustCompile(`(?i)\b(bearer|basic)\s+[A-Za-z0-9\-._~+/]{8,}=*`),
+		regexp.MustCompile(`(https?://)[^\s/:@]+:[^\s/@]+@`),
+		regexp.MustCompile(`\b(AKIA[0-9A-Z]{16}|gh[pousr]_[A-Za-z0-9]{20,}|xox[abprs]-[A-Za-z0-9-]{10,})\b`),
+	}
+
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	ipPattern    = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b|\b(?:[0-9A-Fa-f]{1,4}:){7}[0-9A-Fa-f]{1,4}\b`)
+
+	// hostnamePattern matches names ending in a common or internal top-level domain, so file
+	// names such as values.yaml are left alone
+	hostnamePattern = regexp.MustCompile(`(?i)\b(?:[a-z0-9](?:[a-z0-9\-]{0,61}[a-z0-9])?\.)+(?:com|net|org|io|dev|app|cloud|ai|co|info|biz|us|eu|uk|de|es|fr|internal|local|localdomain|lan|corp|intra|intranet|svc|cluster)\b`)
+)
+
+// RedactSecrets replaces passwords, tokens and keys in text with [redacted]
+func RedactSecrets(text string) string {
+	text = secretPatterns[0].ReplaceAllString(text, "$1$2[redacted]")
+	text = secretPatterns[1].ReplaceAllString(text, "$1 [redacted]")
+	text = secretPatterns[2].ReplaceAllString(text, "$1[redacted]@")
+	return secretPatterns[3].ReplaceAllString(text, "[redacted]")
+}
+
+// Redactor removes personal and infrastructure details from text sent to a remote LLM
+type Redactor struct {
+	builtin  bool             // Remove secrets, emails, IP addresses and hostnames
+	patterns []*regexp.Regexp // Extra patterns from llm.privacy.patterns
+}
+
+// NewRedactor creates a redactor of the built-in patterns, when builtin is set, and the extra patterns
+func NewRedactor(builtin bool, patterns []string) (*Redactor, error) {
+	redactor := &Redactor{builtin: builtin}
+	for _, pattern := range patterns {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid llm.privacy.patterns entry %q: %w", pattern, err)
+		}
+		redactor.patterns = append(redactor.patterns, compiled)
+	}
+	return redactor, nil
+}
+
+// Redact replaces secrets, emails, IP addresses, hostnames and the extra patterns in text.
+// Secrets go first, so credentials in URLs are gone before the hostname is replaced.
+func (r *Redactor) Redact(text string) string {
+	if r.builtin {
+		text = RedactSecrets(text)
+		text = emailPattern.ReplaceAllString(text, "[email]")
+		text = ipPattern.ReplaceAllString(text, "[ip]")
+		text = hostnamePattern.ReplaceAllString(text, "[host]")
+	}
+	for _, pattern := range r.patterns {
+		text = pattern.ReplaceAllString(text, "[redacted]")
+	}
+	return text
+}
+
+// isRemote reports whether the configured backend runs on another machine. Ollama on this
+// machine, including the Docker-managed one, is local.
+func isRemote(config LLMConfig) bool {
+	var baseURL string
+	switch config.Mode {
+	case "openai":
+		baseURL = config.OpenAIURL
+		if baseURL == "" {
+			return true
+		}
+	case "local-openai":
+		baseURL = config.LocalOpenAIURL
+		if baseURL == "" {
+			return false
+		}
+	case "ollama", "docker":
+		baseURL = config.OllamaURL
+	default:
+		return false
+	}
+
+	parsed, err := url.Parse(baseURL)
+	if err != nil || parsed.Hostname() == "" {
+		return true
+	}
+	host := parsed.Hostname()
+	if strings.EqualFold(host, "localhost") {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip == nil || !ip.IsLoopback()
+}
+
+// privacyFilter redacts issues and comments before they reach a remote backend, and answers
+// with the embedded LLM on this machine for the projects not allowed to use it
+type privacyFilter struct {
+	Summarizer                 // The remote backend
+	local      *EmbeddedLLM    // Answers for issues that may not leave the machine
+	redactor   *Redactor       // nil when llm.privacy.redact is off
+	allowed    map[string]bool // Projects that may use the remote backend, nil for all
+
+	mu              sync.Mutex
+	commentProjects map[string]string // Project of the tracked comments, by comment ID
+	localAnswers    atomic.Int64      // Calls answered by the embedded LLM instead of the backend
+}
+
+// newPrivacyFilter wraps a remote backend with the privacy settings of config
+func newPrivacyFilter(remote Summarizer, config LLMConfig) (*privacyFilter, error) {
+	filter := &privacyFilter{
+		Summarizer:      remote,
+		local:           NewEmbeddedLLMWithConfig(config),
+		commentProjects: make(map[string]string),
+	}
+	if config.Privacy.Redact || len(config.Privacy.Patterns) > 0 {
+		redactor, err := NewRedactor(config.Privacy.Redact, config.Privacy.Patterns)
+		if err != nil {
+			return nil, err
+		}
+		filter.redactor = redactor
+	}
+	if len(config.Privacy.AllowedProjects) > 0 {
+		filter.allowed = make(map[string]bool)
+		for _, project := range config.Privacy.AllowedProjects {
+			filter.allowed[strings.ToUpper(strings.TrimSpace(project))] = true
+		}
+	}
+	return filter, nil
+}
+
+// TrackComments records the issue of comments, so comments of projects that may not use the
+// remote backend are kept from it
+func (f *privacyFilter) TrackComments(issue jira.Issue, comments []jira.Comment) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, comment := range comments {
+		f.commentProjects[comment.ID] = issueProject(issue)
+	}
+}
+
+// Fallbacks counts the calls the backend did not answer: the embedded fallback of the backend
+// and the calls answered on this machine for privacy
+func (f *privacyFilter) Fallbacks() int {
+	fallbacks := int(f.localAnswers.Load())
+	if counter, ok := f.Summarizer.(interface{ Fallbacks() int }); ok {
+		fallbacks += counter.Fallbacks()
+	}
+	return fallbacks
+}
+
+// TestConnection tests the remote backend
+func (f *privacyFilter) TestConnection() error {
+	if tester, ok := f.Summarizer.(ConnectionTester); ok {
+		return tester.TestConnection()
+	}
+	return nil
+}
+
+// issueProject returns the project key of an issue, from its key when the project is not set
+func issueProject(issue jira.Issue) string {
+	if issue.Fields.Project.Key != "" {
+		return strings.ToUpper(issue.Fields.Project.Key)
+	}
+	project, _, _ := strings.Cut(issue.Key, "-")
+	return strings.ToUpper(project)
+}
+
+func (f *privacyFilter) issueAllowed(issue jira.Issue) bool {
+	return f.allowed == nil || f.allowed[issueProject(issue)]
+}
+
+// commentAllowed keeps comments of unknown issues from the backend when projects are restricted
+func (f *privacyFilter) commentAllowed(comment jira.Comment) bool {
+	if f.allowed == nil {
+		return true
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	project, tracked := f.commentProjects[comment.ID]
+	return tracked && f.allowed[project]
+}
+
+func (f *privacyFilter) redact(text string) string {
+	if f.redactor == nil || text == "" {
+		return text
+	}
+	return f.redactor.Redact(text)
+}
+
+// issues returns the issues that may be sent to the backend, redacted
+func (f *privacyFilter) issues(issues []jira.Issue) []jira.Issue {
+	var allowed []jira.Issue
+	for _, issue := range issues {
+		if !f.issueAllowed(issue) {
+			continue
+		}
+		issue.Fields.Summary = f.redact(issue.Fields.Summary)
+		issue.Fields.Description.Text = f.redact(issue.Fields.Description.Text)
+		allowed = append(allowed, issue)
+	}
+	return allowed
+}
+
+// comments returns the comments that may be sent to the backend, redacted
+func (f *privacyFilter) comments(comments []jira.Comment) []jira.Comment {
+	var allowed []jira.Comment
+	for _, comment := range comments {
+		if !f.commentAllowed(comment) {
+			continue
+		}
+		comment.Body.Text = f.redact(comment.Body.Text)
+		allowed = append(allowed, comment)
+	}
+	return allowed
+}
+
+// worklogs returns the worklog entries with their comments redacted
+func (f *privacyFilter) worklogs(worklogs []jira.WorklogEntry) []jira.WorklogEntry {
+	redacted := make([]jira.WorklogEntry, 0, len(worklogs))
+	for _, worklog := range worklogs {
+		worklog.Comment = f.redact(worklog.Comment)
+		redacted = append(redacted, worklog)
+	}
+	return redacted
+}
+
+// keepLocal reports whether a call goes to the embedded LLM: when nothing of its input may be
+// sent to the backend, or any of it may not for calls that can't leave part of it out
+func (f *privacyFilter) keepLocal(withheld bool) bool {
+	if withheld {
+		f.localAnswers.Add(1)
+	}
+	return withheld
+}
+
+// SummarizeIssue summarizes an issue with the backend, or locally for projects not allowed to use it
+func (f *privacyFilter) SummarizeIssue(issue jira.Issue) (string, error) {
+	issues := f.issues([]jira.Issue{issue})
+	if f.keepLocal(len(issues) == 0) {
+		return f.local.SummarizeIssue(issue)
+	}
+	return f.Summarizer.SummarizeIssue(issues[0])
+}
+
+// SummarizeIssues summarizes the issues that may be sent with the backend and the others locally
+func (f *privacyFilter) SummarizeIssues(issues []jira.Issue) (map[string]string, error) {
+	var withheld []jira.Issue
+	for _, issue := range issues {
+		if !f.issueAllowed(issue) {
+			withheld = append(withheld, issue)
+		}
+	}
+
+	summaries := make(map[string]string)
+	if allowed := f.issues(issues); len(allowed) > 0 {
+		remote, err := f.Summarizer.SummarizeIssues(allowed)
+		if err != nil {
+			return nil, err
+		}
+		summaries = remote
+	}
+	if f.keepLocal(len(withheld) > 0) {
+		local, _ := f.local.SummarizeIssues(withheld)
+		for key, summary := range local {
+			summaries[key] = summary
+		}
+	}
+	return summaries, nil
+}
+
+// SummarizeComments summarizes the comments of an issue with the backend, or locally when they
+// belong to a project not allowed to use it
+func (f *privacyFilter) SummarizeComments(comments []jira.Comment) (string, error) {
+	allowed := f.comments(comments)
+	if f.keepLocal(len(allowed) < len(comments)) {
+		return f.local.SummarizeComments(comments)
+	}
+	return f.Summarizer.SummarizeComments(allowed)
+}
+
+// SummarizeWorklog summarizes worklog entries with their comments redacted
+func (f *privacyFilter) SummarizeWorklog(worklogs []jira.WorklogEntry) (string, error) {
+	return f.Summarizer.SummarizeWorklog(f.worklogs(worklogs))
+}
+
+// GenerateStandupSummary summarizes the day with the issues that may be sent to the backend
+func (f *privacyFilter) GenerateStandupSummary(issues []jira.Issue, worklogs []jira.WorklogEntry) (string, error) {
+	allowed := f.issues(issues)
+	if f.keepLocal(len(allowed) == 0 && len(issues) > 0) {
+		return f.local.GenerateStandupSummary(issues, worklogs)
+	}
+	return f.Summarizer.GenerateStandupSummary(allowed, f.worklogs(worklogs))
+}
+
+// GenerateStandupSummaryWithComments summarizes the day with the issues and comments that may
+// be sent to the backend
+func (f *privacyFilter) GenerateStandupSummaryWithComments(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (string, error) {
+	allowed := f.issues(issues)
+	if f.keepLocal(len(allowed) == 0 && len(issues) > 0) {
+		return f.local.GenerateStandupSummaryWithComments(issues, comments, worklogs)
+	}
+	return f.Summarizer.GenerateStandupSummaryWithComments(allowed, f.comments(comments), f.worklogs(worklogs))
+}
+
+// GenerateWeeklySummary summarizes the week with the issues and comments that may be sent to
+// the backend
+func (f *privacyFilter) GenerateWeeklySummary(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (string, error) {
+	allowed := f.issues(issues)
+	if f.keepLocal(len(allowed) == 0 && len(issues) > 0) {
+		return f.local.GenerateWeeklySummary(issues, comments, worklogs)
+	}
+	return f.Summarizer.GenerateWeeklySummary(allowed, f.comments(comments), f.worklogs(worklogs))
+}
+
+// GenerateReleaseNotes drafts release notes from the issues that may be sent to the backend
+func (f *privacyFilter) GenerateReleaseNotes(version string, issues []jira.Issue) (string, error) {
+	allowed := f.issues(issues)
+	if f.keepLocal(len(allowed) == 0 && len(issues) > 0) {
+		return f.local.GenerateReleaseNotes(version, issues)
+	}
+	return f.Summarizer.GenerateReleaseNotes(version, allowed)
+}
+
+// GenerateThemes groups the issues that may be sent to the backend into themes
+func (f *privacyFilter) GenerateThemes(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) ([]Theme, error) {
+	allowed := f.issues(issues)
+	if f.keepLocal(len(allowed) == 0 && len(issues) > 0) {
+		return f.local.GenerateThemes(issues, comments, worklogs)
+	}
+	return f.Summarizer.GenerateThemes(allowed, f.comments(comments), f.worklogs(worklogs))
+}
+
+// GenerateNextSteps proposes next steps from the issues that may be sent to the backend
+func (f *privacyFilter) GenerateNextSteps(issues []jira.Issue, comments []jira.Comment) ([]string, error) {
+	allowed := f.issues(issues)
+	if f.keepLocal(len(allowed) == 0 && len(issues) > 0) {
+		return f.local.GenerateNextSteps(issues, comments)
+	}
+	return f.Summarizer.GenerateNextSteps(allowed, f.comments(comments))
+}
+
+// GenerateStructuredSummary summarizes the issues that may be sent to the backend as
+// accomplishments, work in progress, blockers and next steps
+func (f *privacyFilter) GenerateStructuredSummary(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (*StructuredSummary, error) {
+	allowed := f.issues(issues)
+	if f.keepLocal(len(allowed) == 0 && len(issues) > 0) {
+		return f.local.GenerateStructuredSummary(issues, comments, worklogs)
+	}
+	return f.Summarizer.GenerateStructuredSummary(allowed, f.comments(comments), f.worklogs(worklogs))
+}
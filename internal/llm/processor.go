@@ -2,6 +2,7 @@ package llm
 
 import (
 	"fmt"
+	"log/slog"
 	"strings"
 	"time"
 	"my-day/internal/jira"
@@ -30,17 +31,13 @@ func (p *EnhancedDataProcessor) ProcessIssuesWithComments(issues []jira.Issue, c
 	for _, issue := range issues {
 		enhancedIssue, err := p.processIssue(issue, commentsByIssue[issue.Key])
 		if err != nil {
-			if p.debug {
-				fmt.Printf("Warning: Failed to process issue %s: %v\n", issue.Key, err)
-			}
+			slog.Debug("Failed to process issue", "issue", issue.Key, "error", err)
 			// Continue processing other issues even if one fails
 			continue
 		}
 		
 		if err := processedData.AddIssue(enhancedIssue); err != nil {
-			if p.debug {
-				fmt.Printf("Warning: Failed to add issue %s: %v\n", issue.Key, err)
-			}
+			slog.Debug("Failed to add issue", "issue", issue.Key, "error", err)
 			continue
 		}
 		
@@ -53,9 +50,7 @@ func (p *EnhancedDataProcessor) ProcessIssuesWithComments(issues []jira.Issue, c
 	
 	// Validate the processed data
 	if validationErrors := processedData.Validate(); len(validationErrors) > 0 {
-		if p.debug {
-			fmt.Printf("Validation warnings: %+v\n", validationErrors)
-		}
+		slog.Debug("Processed data has validation warnings", "warnings", fmt.Sprintf("%+v", validationErrors))
 		// Continue despite validation warnings for now
 	}
 	
@@ -83,9 +78,7 @@ func (p *EnhancedDataProcessor) processIssue(issue jira.Issue, comments []jira.C
 	for _, comment := range comments {
 		processedComment, err := p.processComment(comment)
 		if err != nil {
-			if p.debug {
-				fmt.Printf("Warning: Failed to process comment %s: %v\n", comment.ID, err)
-			}
+			slog.Debug("Failed to process comment", "comment", comment.ID, "error", err)
 			continue
 		}
 		enhancedIssue.ProcessedComments = append(enhancedIssue.ProcessedComments, processedComment)
@@ -100,6 +93,11 @@ func (p *EnhancedDataProcessor) processIssue(issue jira.Issue, comments []jira.C
 	return enhancedIssue, nil
 }
 
+// AnalyzeComment extracts the insights of a single comment, such as its sentiment and activity type
+func (p *EnhancedDataProcessor) AnalyzeComment(comment jira.Comment) (ProcessedComment, error) {
+	return p.processComment(comment)
+}
+
 // processComment converts a Jira comment to a ProcessedComment
 func (p *EnhancedDataProcessor) processComment(comment jira.Comment) (ProcessedComment, error) {
 	if comment.ID == "" {
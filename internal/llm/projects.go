@@ -0,0 +1,98 @@
+package llm
+
+import (
+	"sort"
+	"strings"
+
+	"my-day/internal/jira"
+)
+
+// ProjectPrompt overrides how the prompts describe the issues of a project
+type ProjectPrompt struct {
+	SummaryStyle string // "technical", "business" or "brief"; "" for the summary style of llm
+	Prompt       string // Instructions added to the prompts that include the project's issues
+}
+
+// styleGuidance is how each summary style asks the model to write about a project whose style
+// differs from the rest of the summary
+var styleGuidance = map[string]string{
+	"technical": "go into the technical detail: technologies, infrastructure and deployment changes",
+	"business":  "focus on deliverables and business impact, and avoid technical jargon",
+	"brief":     "mention only the most important outcome, in a few words",
+}
+
+// projectPrompt returns the override of a project, matching its key case-insensitively since
+// config keys are read lowercased
+func (o *OllamaClient) projectPrompt(project string) (ProjectPrompt, bool) {
+	if o.config == nil {
+		return ProjectPrompt{}, false
+	}
+	if override, found := o.config.Projects[project]; found {
+		return override, true
+	}
+	for key, override := range o.config.Projects {
+		if strings.EqualFold(key, project) {
+			return override, true
+		}
+	}
+	return ProjectPrompt{}, false
+}
+
+// styleFor returns the summary style of a summary of issues: the style of their projects when
+// they all share one, or the style of llm otherwise
+func (o *OllamaClient) styleFor(issues []jira.Issue) string {
+	style := ""
+	for _, issue := range issues {
+		override, _ := o.projectPrompt(issue.Fields.Project.Key)
+		projectStyle := override.SummaryStyle
+		if projectStyle == "" {
+			projectStyle = o.getSummaryStyle()
+		}
+		if style != "" && projectStyle != style {
+			return o.getSummaryStyle()
+		}
+		style = projectStyle
+	}
+	if style == "" {
+		return o.getSummaryStyle()
+	}
+	return style
+}
+
+// projectInstructions tells the model how to write about the projects among issues that have
+// an override: the guidance of their style when it differs from style, the one of the prompt,
+// and their custom instructions
+func (o *OllamaClient) projectInstructions(issues []jira.Issue, style string) string {
+	seen := make(map[string]bool)
+	var projects []string
+	for _, issue := range issues {
+		project := issue.Fields.Project.Key
+		if !seen[project] {
+			seen[project] = true
+			projects = append(projects, project)
+		}
+	}
+	sort.Strings(projects)
+
+	var instructions strings.Builder
+	for _, project := range projects {
+		override, found := o.projectPrompt(project)
+		if !found {
+			continue
+		}
+		var parts []string
+		if guidance, known := styleGuidance[override.SummaryStyle]; known && override.SummaryStyle != style {
+			parts = append(parts, guidance)
+		}
+		if prompt := strings.TrimSpace(override.Prompt); prompt != "" {
+			parts = append(parts, prompt)
+		}
+		if len(parts) > 0 {
+			instructions.WriteString("- " + project + " issues: " + strings.Join(parts, "; ") + "\n")
+		}
+	}
+	if instructions.Len() == 0 {
+		return ""
+	}
+	return "For the issues of these projects:\n" + instructions.String() + "\n"
+}
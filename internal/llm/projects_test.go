@@ -0,0 +1,67 @@
+package llm
+
+import (
+	"strings"
+	"testing"
+
+	"my-day/internal/jira"
+)
+
+// projectIssue returns an issue of a project
+func projectIssue(key, project string) jira.Issue {
+	issue := jira.Issue{Key: key}
+	issue.Fields.Project.Key = project
+	issue.Fields.Summary = "Work on " + key
+	return issue
+}
+
+func TestProjectStyle(t *testing.T) {
+	client := NewOllamaClientWithConfig(LLMConfig{
+		SummaryStyle: "technical",
+		Projects: map[string]ProjectPrompt{
+			"dat": {SummaryStyle: "business", Prompt: "Mention the customer."},
+			"OPS": {SummaryStyle: "technical"},
+		},
+	})
+	dat, ops, web := projectIssue("DAT-1", "DAT"), projectIssue("OPS-1", "OPS"), projectIssue("WEB-1", "WEB")
+
+	// Issues of a single project get its style, matched regardless of case
+	prompt := client.buildEnhancedStandupPrompt([]jira.Issue{dat}, nil, nil)
+	if !strings.Contains(prompt, "Business Summary:") {
+		t.Errorf("expected the business prompt for DAT, got:\n%s", prompt)
+	}
+	if !strings.Contains(prompt, "- DAT issues: Mention the customer.\n") {
+		t.Errorf("expected the custom prompt of DAT, got:\n%s", prompt)
+	}
+	if strings.Contains(prompt, "avoid technical jargon") {
+		t.Error("expected no style guidance when the prompt already has the project's style")
+	}
+
+	// Mixed projects keep the configured style and ask for the project's style on its issues
+	prompt = client.buildEnhancedStandupPrompt([]jira.Issue{dat, ops, web}, nil, nil)
+	if !strings.Contains(prompt, "Technical Summary:") {
+		t.Errorf("expected the technical prompt for mixed projects, got:\n%s", prompt)
+	}
+	if !strings.Contains(prompt, "- DAT issues: "+styleGuidance["business"]+"; Mention the customer.\n") {
+		t.Errorf("expected the business guidance for DAT, got:\n%s", prompt)
+	}
+	if strings.Contains(prompt, "OPS issues") || strings.Contains(prompt, "WEB issues") {
+		t.Errorf("expected no instructions for projects in the configured style, got:\n%s", prompt)
+	}
+
+	// Without overrides the prompts are unchanged
+	plain := NewOllamaClientWithConfig(LLMConfig{SummaryStyle: "brief"})
+	if prompt := plain.buildEnhancedStandupPrompt([]jira.Issue{dat}, nil, nil); strings.Contains(prompt, "For the issues of these projects") {
+		t.Errorf("expected no project instructions, got:\n%s", prompt)
+	}
+}
+
+func TestProjectInstructionsInIssuePrompt(t *testing.T) {
+	client := NewOllamaClientWithConfig(LLMConfig{
+		Projects: map[string]ProjectPrompt{"DAT": {SummaryStyle: "business"}},
+	})
+	prompt := client.buildIssuePrompt(projectIssue("DAT-1", "DAT"))
+	if !strings.Contains(prompt, "- DAT issues: "+styleGuidance["business"]) {
+		t.Errorf("expected the business guidance in the issue prompt, got:\n%s", prompt)
+	}
+}
@@ -0,0 +1,104 @@
+package llm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Files kept in the debug directory for 'my-day debug bundle'
+const (
+	LastPromptFile      = "last_prompt.json"
+	LastDebugReportFile = "last_debug_report.json"
+)
+
+// PromptRecord is a prompt sent to the LLM with the response it got, kept so that poor or
+// failed summaries can be reproduced
+type PromptRecord struct {
+	Mode     string    `json:"mode"`
+	Model    string    `json:"model"`
+	Prompt   string    `json:"prompt"`
+	Response string    `json:"response,omitempty"`
+	Error    string    `json:"error,omitempty"`
+	Time     time.Time `json:"time"`
+}
+
+// Hash returns the content address of the record: the SHA-256 of its mode, model and prompt,
+// so the same prompt sent to the same model always gets the same address
+func (r PromptRecord) Hash() string {
+	sum := sha256.Sum256([]byte(r.Mode + "\x00" + r.Model + "\x00" + r.Prompt))
+	return hex.EncodeToString(sum[:])
+}
+
+// DebugDir returns the directory the last prompt and debug report are kept in
+func DebugDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".my-day", "debug"), nil
+}
+
+// recordMu keeps prompts summarized at once from writing the record file over each other
+var recordMu sync.Mutex
+
+// recordPrompt keeps the last prompt and its response. Recording is best effort: a failure
+// never affects the summary.
+func recordPrompt(mode, model, prompt, response string, err error) {
+	recordMu.Lock()
+	defer recordMu.Unlock()
+
+	record := PromptRecord{Mode: mode, Model: model, Prompt: prompt, Response: response, Time: time.Now()}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	saveDebugFile(LastPromptFile, record)
+}
+
+// LoadLastPrompt returns the last prompt sent to the LLM, or nil when none was recorded
+func LoadLastPrompt() (*PromptRecord, error) {
+	dir, err := DebugDir()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, LastPromptFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var record PromptRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", LastPromptFile, err)
+	}
+	return &record, nil
+}
+
+// SaveLastDebugReport keeps the debug report of the last 'my-day report --debug' run
+func SaveLastDebugReport(report *DebugReport) error {
+	return saveDebugFile(LastDebugReportFile, report)
+}
+
+func saveDebugFile(name string, value interface{}) error {
+	dir, err := DebugDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create debug directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+	// Prompts contain your comments, so the files are only readable by you
+	return os.WriteFile(filepath.Join(dir, name), data, 0600)
+}
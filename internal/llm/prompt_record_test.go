@@ -0,0 +1,48 @@
+package llm
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecordPromptKeepsTheLastPrompt(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if record, err := LoadLastPrompt(); err != nil || record != nil {
+		t.Fatalf("expected no prompt before the first one, got %v, %v", record, err)
+	}
+
+	recordPrompt("ollama", "qwen2.5:3b", "Summarize OPS-1", "Rotated certificates", nil)
+	recordPrompt("openai", "gpt-4o-mini", "Summarize OPS-2", "", errors.New("rate limited"))
+
+	record, err := LoadLastPrompt()
+	if err != nil {
+		t.Fatalf("LoadLastPrompt() error = %v", err)
+	}
+	if record.Mode != "openai" || record.Prompt != "Summarize OPS-2" || record.Error != "rate limited" {
+		t.Errorf("unexpected last prompt %+v", record)
+	}
+
+	dir, _ := DebugDir()
+	if info, err := os.Stat(filepath.Join(dir, LastPromptFile)); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("expected the prompt to be readable only by you, got %v, %v", info, err)
+	}
+}
+
+func TestPromptRecordHashIsContentAddressed(t *testing.T) {
+	record := PromptRecord{Mode: "ollama", Model: "qwen2.5:3b", Prompt: "Summarize OPS-1", Response: "first"}
+	again := record
+	again.Response = "second"
+
+	if record.Hash() != again.Hash() {
+		t.Error("expected the hash to ignore the response")
+	}
+	if other := (PromptRecord{Mode: "ollama", Model: "llama3", Prompt: "Summarize OPS-1"}); other.Hash() == record.Hash() {
+		t.Error("expected a different hash for a different model")
+	}
+	if len(record.Hash()) != 64 {
+		t.Errorf("expected a SHA-256 hex digest, got %q", record.Hash())
+	}
+}
@@ -0,0 +1,66 @@
+package llm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SummaryQuality scores a summary out of 100 with the heuristic of the quality indicators: its
+// length, whether it says more than counts, its technical terms and the data behind it. It
+// returns the score and the factors that make it up.
+func SummaryQuality(summary string, issueCount int, commentCount int) (float64, []string) {
+	var score float64
+	var factors []string
+
+	// Length appropriateness (50-300 characters is good)
+	if length := len(summary); length >= 50 && length <= 300 {
+		score += 25
+		factors = append(factors, "✓ Appropriate length")
+	} else if length < 50 {
+		factors = append(factors, "⚠ Summary might be too brief")
+	} else {
+		factors = append(factors, "⚠ Summary might be too verbose")
+	}
+
+	// Content richness (more than just counts)
+	if !strings.Contains(summary, "issues") || !strings.Contains(summary, "comments") {
+		score += 25
+		factors = append(factors, "✓ Contains meaningful content")
+	} else {
+		factors = append(factors, "⚠ May be too generic")
+	}
+
+	// Technical context (contains technical terms)
+	technicalCount := 0
+	for _, term := range []string{"deploy", "config", "test", "fix", "update", "implement", "review"} {
+		if strings.Contains(strings.ToLower(summary), term) {
+			technicalCount++
+		}
+	}
+	if technicalCount > 0 {
+		score += 25
+		factors = append(factors, fmt.Sprintf("✓ Contains %d technical terms", technicalCount))
+	} else {
+		factors = append(factors, "⚠ Limited technical context")
+	}
+
+	// Data completeness (has both issues and comments)
+	if issueCount > 0 && commentCount > 0 {
+		score += 25
+		factors = append(factors, "✓ Complete data available")
+	} else {
+		factors = append(factors, "⚠ Limited data available")
+	}
+	return score, factors
+}
+
+// SummaryConfidence returns the average confidence of the technical patterns the pattern
+// matcher recognizes in text, or 0 when it recognizes none
+func SummaryConfidence(text string) float64 {
+	results, err := NewTechnicalPatternMatcher(false).MatchAllPatterns(text)
+	if err != nil {
+		return 0
+	}
+	confidence, _ := results["overall_confidence"].(float64)
+	return confidence
+}
@@ -0,0 +1,66 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// readinessTimeout is how long the readiness probe waits for the test generation, loading
+// the model included
+const readinessTimeout = 15 * time.Second
+
+var (
+	readinessMu sync.Mutex
+	readiness   = make(map[string]error) // Probe results by server and model, so a report probes once
+)
+
+// Ready probes whether Ollama can summarize: the model is installed, and it answers a short
+// test prompt within readinessTimeout. The result is kept for the rest of the run.
+func (o *OllamaClient) Ready() error {
+	readinessMu.Lock()
+	defer readinessMu.Unlock()
+
+	key := o.baseURL + "|" + o.model
+	if err, probed := readiness[key]; probed {
+		return err
+	}
+	err := o.probe(readinessTimeout)
+	readiness[key] = err
+	return err
+}
+
+// NewOllamaSummarizer returns an Ollama client when Ollama is ready, or else the embedded LLM
+// standing in for it, so the report notes the fallback rather than waiting out a timeout on
+// every summary
+func NewOllamaSummarizer(config LLMConfig) Summarizer {
+	client := NewOllamaClientWithConfig(config)
+	if err := client.Ready(); err != nil {
+		slog.Warn("Ollama is not ready, using the embedded model instead", "error", err)
+		return NewEmbeddedLLMStandIn(config, err)
+	}
+	return client
+}
+
+// probe checks that the model is installed and answers a test prompt within timeout, without retries
+func (o *OllamaClient) probe(timeout time.Duration) error {
+	installed, err := ListOllamaModels(o.baseURL)
+	if err != nil {
+		return fmt.Errorf("Ollama is not reachable at %s", o.baseURL)
+	}
+	if !HasOllamaModel(installed, o.model) {
+		return fmt.Errorf("model %s is not installed, pull it with 'my-day llm pull %s'", o.model, o.model)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if _, err := o.attemptGenerate(ctx, "Reply with OK."); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("model %s did not answer a test prompt within %v", o.model, timeout)
+		}
+		return fmt.Errorf("model %s failed a test prompt: %w", o.model, err)
+	}
+	return nil
+}
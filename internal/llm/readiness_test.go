@@ -0,0 +1,69 @@
+package llm
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestOllamaReadinessProbe(t *testing.T) {
+	// Shared with the handler, which may still be answering a probe that timed out
+	var delay, generations atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tags":
+			fmt.Fprint(w, `{"models":[{"name":"qwen2.5:3b"}]}`)
+		case "/api/generate":
+			generations.Add(1)
+			time.Sleep(time.Duration(delay.Load()))
+			fmt.Fprint(w, `{"response":"OK","done":true}`)
+		}
+	}))
+	defer server.Close()
+
+	if err := NewOllamaClient(server.URL, "qwen2.5:3b").probe(time.Second); err != nil {
+		t.Errorf("expected a ready model, got %v", err)
+	}
+	if err := NewOllamaClient(server.URL, "llama3.1:8b").probe(time.Second); err == nil || !strings.Contains(err.Error(), "my-day llm pull llama3.1:8b") {
+		t.Errorf("expected a missing model to be reported, got %v", err)
+	}
+
+	delay.Store(int64(200 * time.Millisecond))
+	if err := NewOllamaClient(server.URL, "qwen2.5:3b").probe(50 * time.Millisecond); err == nil || !strings.Contains(err.Error(), "did not answer a test prompt within 50ms") {
+		t.Errorf("expected a slow model to fail the probe, got %v", err)
+	}
+	if n := generations.Load(); n != 2 {
+		t.Errorf("expected one test generation per probe without retries, got %d", n)
+	}
+
+	// Ready probes a server and model once
+	delay.Store(0)
+	client := NewOllamaClient(server.URL, "qwen2.5:3b")
+	client.Ready()
+	client.Ready()
+	if n := generations.Load(); n != 3 {
+		t.Errorf("expected the probe result to be kept, got %d generations", n)
+	}
+}
+
+func TestUnreadyOllamaGetsAStandIn(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	config := LLMConfig{Mode: "ollama", OllamaURL: url, OllamaModel: "qwen2.5:3b"}
+	standIn, ok := NewOllamaSummarizer(config).(*EmbeddedLLM)
+	if !ok {
+		t.Fatal("expected the embedded LLM to stand in for an unreachable Ollama")
+	}
+	if err := standIn.StandInReason(); err == nil || !strings.Contains(err.Error(), "not reachable") {
+		t.Errorf("expected the stand-in to say why, got %v", err)
+	}
+	if NewEmbeddedLLMWithConfig(config).StandInReason() != nil {
+		t.Error("expected a configured embedded LLM not to be a stand-in")
+	}
+}
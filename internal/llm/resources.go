@@ -0,0 +1,163 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// modelOverheadBytes is the memory a model needs on top of its weights, for the context and
+// the Ollama runtime
+const modelOverheadBytes = 1_500_000_000
+
+// bytesPerParameter is the size of a parameter in the 4-bit quantizations Ollama pulls by default
+const bytesPerParameter = 0.6
+
+// HostResources is the memory and GPU a model can run on
+type HostResources struct {
+	MemoryBytes    uint64 // Memory the container may use, 0 when unknown
+	MemorySource   string // Where MemoryBytes comes from, e.g. "available host memory"
+	GPUName        string // NVIDIA GPU Docker can use, "" for none
+	GPUMemoryBytes uint64 // Free memory of the GPU
+}
+
+// fits reports whether a model needing need bytes runs in memory or on the GPU. Unknown
+// memory is assumed to fit.
+func (r HostResources) fits(need uint64) bool {
+	return r.MemoryBytes == 0 || need <= r.MemoryBytes || (r.GPUName != "" && need <= r.GPUMemoryBytes)
+}
+
+// usable returns the most memory a model can use, in memory or on the GPU
+func (r HostResources) usable() uint64 {
+	if r.GPUName != "" && r.GPUMemoryBytes > r.MemoryBytes {
+		return r.GPUMemoryBytes
+	}
+	return r.MemoryBytes
+}
+
+// smallerModels are the models suggested when the selected one doesn't fit, largest first
+var smallerModels = []string{"llama3.1:8b", "qwen2.5:7b", "phi3:3.8b", "qwen2.5:3b", "llama3.2:3b", "qwen2.5:1.5b", "qwen2.5:0.5b"}
+
+// parameterPattern matches the parameter count in a model tag, e.g. the 3.8b of phi3:3.8b
+var parameterPattern = regexp.MustCompile(`(?i)(?:^|[:\-_])(\d+(?:\.\d+)?)b(?:$|[\-_])`)
+
+// EstimateModelMemory returns the memory an Ollama model needs from the parameter count in
+// its tag, or false when the tag doesn't say, e.g. mistral:latest
+func EstimateModelMemory(model string) (uint64, bool) {
+	match := parameterPattern.FindStringSubmatch(model)
+	if match == nil {
+		return 0, false
+	}
+	billions, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, false
+	}
+	return uint64(billions*1e9*bytesPerParameter) + modelOverheadBytes, true
+}
+
+// SuggestSmallerModel returns the largest recommended model that fits in memory bytes, or ""
+func SuggestSmallerModel(memory uint64) string {
+	for _, model := range smallerModels {
+		if need, _ := EstimateModelMemory(model); need <= memory {
+			return model
+		}
+	}
+	return ""
+}
+
+// DetectResources finds the memory and GPU the Ollama container can use
+func (d *DockerLLMManager) DetectResources() HostResources {
+	var resources HostResources
+
+	// On Linux containers share the host's memory; elsewhere Docker runs in a VM of its own size
+	if data, err := os.ReadFile("/proc/meminfo"); err == nil && runtime.GOOS == "linux" {
+		resources.MemoryBytes = parseMeminfo(data)
+		resources.MemorySource = "available host memory"
+	}
+	if resources.MemoryBytes == 0 {
+		if output, err := exec.Command("docker", "info", "--format", "{{.MemTotal}}").Output(); err == nil {
+			resources.MemoryBytes, _ = strconv.ParseUint(strings.TrimSpace(string(output)), 10, 64)
+			resources.MemorySource = "memory of the Docker VM"
+		}
+	}
+
+	// The GPU only helps when Docker can hand it to the container
+	if output, err := exec.Command("nvidia-smi", "--query-gpu=name,memory.free", "--format=csv,noheader,nounits").Output(); err == nil && d.dockerHasGPURuntime() {
+		resources.GPUName, resources.GPUMemoryBytes = parseNvidiaSMI(output)
+	}
+	return resources
+}
+
+// dockerHasGPURuntime reports whether Docker has the NVIDIA container runtime
+func (d *DockerLLMManager) dockerHasGPURuntime() bool {
+	output, err := exec.Command("docker", "info", "--format", "{{json .Runtimes}}").Output()
+	return err == nil && strings.Contains(string(output), "nvidia")
+}
+
+// parseMeminfo returns MemAvailable of /proc/meminfo in bytes
+func parseMeminfo(data []byte) uint64 {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "MemAvailable:" {
+			kilobytes, _ := strconv.ParseUint(fields[1], 10, 64)
+			return kilobytes * 1024
+		}
+	}
+	return 0
+}
+
+// parseNvidiaSMI returns the name and free memory in bytes of the GPU with the most free memory
+// in nvidia-smi's "name, memory.free" CSV output, where memory is in MiB
+func parseNvidiaSMI(output []byte) (string, uint64) {
+	var name string
+	var free uint64
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		gpu, memory, found := strings.Cut(line, ",")
+		if !found {
+			continue
+		}
+		mebibytes, err := strconv.ParseUint(strings.TrimSpace(memory), 10, 64)
+		if err == nil && mebibytes*1024*1024 > free {
+			name, free = strings.TrimSpace(gpu), mebibytes*1024*1024
+		}
+	}
+	return name, free
+}
+
+// CheckResources warns when the model needs more memory than the container can use, and
+// suggests a model that fits. It returns the warning, or "" when the model fits or its size
+// is unknown.
+func (d *DockerLLMManager) CheckResources() string {
+	need, known := EstimateModelMemory(d.model)
+	if !known {
+		return ""
+	}
+	resources := d.DetectResources()
+	if resources.fits(need) {
+		return ""
+	}
+
+	warning := fmt.Sprintf("%s needs about %s of memory, but only %s is free (%s)",
+		d.model, formatGigabytes(need), formatGigabytes(resources.usable()), resources.MemorySource)
+	if resources.GPUName != "" {
+		warning += fmt.Sprintf(" and %s free on %s", formatGigabytes(resources.GPUMemoryBytes), resources.GPUName)
+	}
+	if suggestion := SuggestSmallerModel(resources.usable()); suggestion != "" && suggestion != d.model {
+		warning += fmt.Sprintf(". Try a smaller model: my-day llm switch %s", suggestion)
+	} else {
+		warning += ". Try the embedded mode: my-day report --llm-mode embedded"
+	}
+	return warning
+}
+
+// formatGigabytes formats bytes as gigabytes, e.g. 4.7GB
+func formatGigabytes(bytes uint64) string {
+	return fmt.Sprintf("%.1fGB", float64(bytes)/1e9)
+}
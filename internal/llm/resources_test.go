@@ -0,0 +1,62 @@
+package llm
+
+import (
+	"testing"
+)
+
+func TestEstimateModelMemory(t *testing.T) {
+	tests := []struct {
+		model string
+		gb    float64
+	}{
+		{"qwen2.5:3b", 3.3},
+		{"phi3:3.8b", 3.78},
+		{"llama3.1:8b", 6.3},
+		{"qwen2.5:7b-instruct-q4_K_M", 5.7},
+		{"llama3.1:70b", 43.5},
+	}
+	for _, test := range tests {
+		need, known := EstimateModelMemory(test.model)
+		if !known || float64(need)/1e9 < test.gb-0.01 || float64(need)/1e9 > test.gb+0.01 {
+			t.Errorf("EstimateModelMemory(%q) = %d, %v, expected about %.2fGB", test.model, need, known, test.gb)
+		}
+	}
+	if _, known := EstimateModelMemory("mistral:latest"); known {
+		t.Error("expected the size of an untagged model to be unknown")
+	}
+}
+
+func TestSuggestSmallerModel(t *testing.T) {
+	if model := SuggestSmallerModel(5e9); model != "phi3:3.8b" {
+		t.Errorf("expected the largest model that fits 5GB, got %q", model)
+	}
+	if model := SuggestSmallerModel(1e9); model != "" {
+		t.Errorf("expected no model to fit 1GB, got %q", model)
+	}
+}
+
+func TestHostResourcesFits(t *testing.T) {
+	laptop := HostResources{MemoryBytes: 4e9}
+	if laptop.fits(6.3e9) || !laptop.fits(3.3e9) {
+		t.Error("expected a 6.3GB model not to fit in 4GB and a 3.3GB one to")
+	}
+	withGPU := HostResources{MemoryBytes: 4e9, GPUName: "NVIDIA GeForce RTX 4070", GPUMemoryBytes: 11e9}
+	if !withGPU.fits(6.3e9) || withGPU.usable() != 11e9 {
+		t.Error("expected the model to fit on the GPU")
+	}
+	if !(HostResources{}).fits(43.5e9) {
+		t.Error("expected unknown memory not to hold back any model")
+	}
+}
+
+func TestParseMeminfoAndNvidiaSMI(t *testing.T) {
+	meminfo := []byte("MemTotal:        8039184 kB\nMemFree:          512000 kB\nMemAvailable:    3906250 kB\n")
+	if memory := parseMeminfo(meminfo); memory != 3906250*1024 {
+		t.Errorf("unexpected available memory %d", memory)
+	}
+
+	name, free := parseNvidiaSMI([]byte("NVIDIA GeForce GTX 1050, 1800\nNVIDIA GeForce RTX 4070, 11264\n"))
+	if name != "NVIDIA GeForce RTX 4070" || free != 11264*1024*1024 {
+		t.Errorf("expected the GPU with the most free memory, got %q with %d", name, free)
+	}
+}
@@ -0,0 +1,176 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"my-day/internal/jira"
+)
+
+// maxStructuredItems is how many items a part of the structured summary keeps at most
+const maxStructuredItems = 8
+
+// StructuredSummary is the summary of the day split into the parts of a standup, so the
+// report renders each part itself instead of relying on the formatting of free text
+type StructuredSummary struct {
+	Accomplishments []string `json:"accomplishments"`
+	InProgress      []string `json:"in_progress"`
+	Blockers        []string `json:"blockers"`
+	NextSteps       []string `json:"next_steps"`
+}
+
+// structuredSummaryFields are the fields of the structured summary, in the order of the schema
+var structuredSummaryFields = []string{"accomplishments", "in_progress", "blockers", "next_steps"}
+
+// structuredSummarySchema is the JSON schema the LLM answers the structured summary prompt in.
+// Ollama and OpenAI-compatible APIs are asked to follow it, and every answer is validated
+// against it.
+var structuredSummarySchema = json.RawMessage(`{
+  "type": "object",
+  "properties": {
+    "accomplishments": {"type": "array", "items": {"type": "string"}},
+    "in_progress": {"type": "array", "items": {"type": "string"}},
+    "blockers": {"type": "array", "items": {"type": "string"}},
+    "next_steps": {"type": "array", "items": {"type": "string"}}
+  },
+  "required": ["accomplishments", "in_progress", "blockers", "next_steps"],
+  "additionalProperties": false
+}`)
+
+// blockerLinePattern matches a sentence of a comment saying the work is stuck
+var blockerLinePattern = regexp.MustCompile(`(?i)\b(blocked|blocker|waiting (?:on|for)|stuck|can't proceed|cannot proceed|bloqueado|esperando)\b`)
+
+// responseSchemaKey is the context key of the JSON schema a response must follow
+type responseSchemaKey struct{}
+
+// withResponseSchema asks the backends to answer the requests made with ctx in JSON following
+// schema
+func withResponseSchema(ctx context.Context, schema json.RawMessage) context.Context {
+	return context.WithValue(ctx, responseSchemaKey{}, schema)
+}
+
+// responseSchema returns the JSON schema requests made with ctx are answered in, or nil for text
+func responseSchema(ctx context.Context) json.RawMessage {
+	schema, _ := ctx.Value(responseSchemaKey{}).(json.RawMessage)
+	return schema
+}
+
+// buildStructuredSummaryPrompt creates a prompt that summarizes the day as JSON with what was
+// accomplished, what is in progress, what is blocked and what comes next
+func (o *OllamaClient) buildStructuredSummaryPrompt(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("You are preparing a standup update. Sort the work below into what I accomplished, what is still in progress, what is blocked and what I will do next.\n\n")
+	prompt.WriteString(o.buildStructuredDataSection(issues, comments, worklogs, false))
+	prompt.WriteString("Answer with a single JSON object and nothing else, following this JSON schema:\n")
+	prompt.WriteString(string(structuredSummarySchema))
+	prompt.WriteString("\n\nEach item is one short sentence of plain text, without markdown, that names the issue key it belongs to, e.g. \"Rolled out the ingress upgrade to staging (OPS-12)\". ")
+	prompt.WriteString("Use an empty list for a part with nothing in it, and only list blockers the comments mention.\n\n")
+	prompt.WriteString(o.projectInstructions(issues, ""))
+	prompt.WriteString(hierarchyInstructions(issues))
+	prompt.WriteString(o.periodInstruction())
+	if instruction := o.languageInstruction(comments); instruction != "" {
+		prompt.WriteString(instruction)
+		prompt.WriteString("Keep the JSON keys in English.\n\n")
+	}
+	prompt.WriteString("IMPORTANT: Write the items in first person (using 'I' statements) as if you are the person who did the work.\n")
+
+	return prompt.String()
+}
+
+// parseStructuredSummary reads the JSON object of a response to the structured summary prompt
+// and validates it against the schema: every field present, a list of strings, and nothing
+// else. Items are flattened to a single line without list markers.
+func parseStructuredSummary(response string) (*StructuredSummary, error) {
+	start, end := strings.Index(response, "{"), strings.LastIndex(response, "}")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("no JSON object found in the LLM response")
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(response[start:end+1]), &fields); err != nil {
+		return nil, fmt.Errorf("invalid JSON in the LLM response: %w", err)
+	}
+	for key := range fields {
+		if !contains(structuredSummaryFields, key) {
+			return nil, fmt.Errorf("unexpected field %q in the LLM response", key)
+		}
+	}
+
+	var summary StructuredSummary
+	parts := map[string]*[]string{
+		"accomplishments": &summary.Accomplishments,
+		"in_progress":     &summary.InProgress,
+		"blockers":        &summary.Blockers,
+		"next_steps":      &summary.NextSteps,
+	}
+	empty := true
+	for _, key := range structuredSummaryFields {
+		raw, found := fields[key]
+		if !found {
+			return nil, fmt.Errorf("field %q missing in the LLM response", key)
+		}
+		var items []string
+		if !bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return nil, fmt.Errorf("field %q of the LLM response is not a list of strings", key)
+			}
+		}
+		for _, item := range items {
+			if item = structuredItem(item); item != "" && len(*parts[key]) < maxStructuredItems {
+				*parts[key] = append(*parts[key], item)
+				empty = false
+			}
+		}
+	}
+	if empty {
+		return nil, fmt.Errorf("the LLM response lists no work")
+	}
+	return &summary, nil
+}
+
+// structuredItem flattens an item of the structured summary to one line of plain text
+func structuredItem(item string) string {
+	item = strings.Join(strings.Fields(item), " ")
+	item = strings.TrimLeft(item, "-*•# ")
+	return strings.TrimSpace(strings.NewReplacer("**", "", "__", "").Replace(item))
+}
+
+// contains reports whether values holds value
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// structureWork sorts the work without an LLM: completed issues are accomplishments, issues in
+// progress are in progress, comments saying the work is stuck are blockers, and the next steps
+// are the ones stated in comments
+func structureWork(issues []jira.Issue, comments []jira.Comment) *StructuredSummary {
+	summary := &StructuredSummary{}
+	for _, issue := range issues {
+		item := fmt.Sprintf("%s (%s)", issue.Fields.Summary, issue.Key)
+		switch strings.ToLower(issue.Fields.Status.Category.Key) {
+		case "done":
+			summary.Accomplishments = append(summary.Accomplishments, item)
+		case "indeterminate":
+			summary.InProgress = append(summary.InProgress, item)
+		}
+	}
+	for _, comment := range comments {
+		for _, sentence := range splitSentences(comment.Body.Text) {
+			if blockerLinePattern.MatchString(sentence) && len(summary.Blockers) < maxStructuredItems {
+				summary.Blockers = append(summary.Blockers, structuredItem(sentence))
+			}
+		}
+	}
+	summary.NextSteps = suggestNextSteps(issues, comments)
+	return summary
+}
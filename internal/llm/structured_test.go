@@ -0,0 +1,86 @@
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"my-day/internal/jira"
+)
+
+func TestParseStructuredSummary(t *testing.T) {
+	response := "```json\n" + `{
+  "accomplishments": ["Rolled out the **ingress** upgrade\nto staging (OPS-1)", ""],
+  "in_progress": ["- Migrating the runners (OPS-2)"],
+  "blockers": [],
+  "next_steps": null
+}` + "\n```"
+
+	summary, err := parseStructuredSummary(response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &StructuredSummary{
+		Accomplishments: []string{"Rolled out the ingress upgrade to staging (OPS-1)"},
+		InProgress:      []string{"Migrating the runners (OPS-2)"},
+	}
+	if !reflect.DeepEqual(summary, expected) {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+
+	invalid := map[string]string{
+		"no JSON":       "I rolled out the ingress upgrade.",
+		"missing field": `{"accomplishments": ["Done (OPS-1)"], "in_progress": [], "blockers": []}`,
+		"extra field":   `{"accomplishments": ["Done (OPS-1)"], "in_progress": [], "blockers": [], "next_steps": [], "mood": ["great"]}`,
+		"wrong type":    `{"accomplishments": "Done (OPS-1)", "in_progress": [], "blockers": [], "next_steps": []}`,
+		"no work":       `{"accomplishments": [], "in_progress": [], "blockers": [], "next_steps": []}`,
+	}
+	for name, response := range invalid {
+		if _, err := parseStructuredSummary(response); err == nil {
+			t.Errorf("%s: expected the response to fail validation", name)
+		}
+	}
+}
+
+func TestOllamaStructuredSummaryRequestsSchema(t *testing.T) {
+	var request OllamaRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&request)
+		answer, _ := json.Marshal(`{"accomplishments": ["Fixed the flaky test (OPS-1)"], "in_progress": [], "blockers": ["Waiting on the DBA (OPS-2)"], "next_steps": []}`)
+		fmt.Fprintf(w, `{"response":%s,"done":true}`, answer)
+	}))
+	defer server.Close()
+
+	summary, err := NewOllamaClient(server.URL, "qwen2.5:3b").GenerateStructuredSummary([]jira.Issue{{Key: "OPS-1"}}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(request.Format), `"in_progress"`) {
+		t.Errorf("expected the request to carry the schema, got %s", request.Format)
+	}
+	if len(summary.Blockers) != 1 || summary.Blockers[0] != "Waiting on the DBA (OPS-2)" {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+}
+
+func TestStructureWork(t *testing.T) {
+	issue := func(key, category string) jira.Issue {
+		return jira.Issue{Key: key, Fields: jira.Fields{Summary: "Work on " + key, Status: jira.Status{Category: jira.StatusCategory{Key: category}}}}
+	}
+	comments := []jira.Comment{{Body: jira.JiraDescription{Text: "Ran the tests. Blocked on the staging credentials."}}}
+
+	summary := structureWork([]jira.Issue{issue("OPS-1", "done"), issue("OPS-2", "indeterminate"), issue("OPS-3", "new")}, comments)
+	if !reflect.DeepEqual(summary.Accomplishments, []string{"Work on OPS-1 (OPS-1)"}) || !reflect.DeepEqual(summary.InProgress, []string{"Work on OPS-2 (OPS-2)"}) {
+		t.Errorf("unexpected work by status %+v", summary)
+	}
+	if !reflect.DeepEqual(summary.Blockers, []string{"Blocked on the staging credentials."}) {
+		t.Errorf("unexpected blockers %q", summary.Blockers)
+	}
+	if len(summary.NextSteps) != 1 || !strings.HasPrefix(summary.NextSteps[0], "Continue OPS-2") {
+		t.Errorf("unexpected next steps %q", summary.NextSteps)
+	}
+}
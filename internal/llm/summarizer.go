@@ -13,6 +13,11 @@ type Summarizer interface {
 	SummarizeWorklog(worklogs []jira.WorklogEntry) (string, error)
 	GenerateStandupSummary(issues []jira.Issue, worklogs []jira.WorklogEntry) (string, error)
 	GenerateStandupSummaryWithComments(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (string, error)
+	GenerateWeeklySummary(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (string, error)
+	GenerateReleaseNotes(version string, issues []jira.Issue) (string, error)
+	GenerateThemes(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) ([]Theme, error)
+	GenerateNextSteps(issues []jira.Issue, comments []jira.Comment) ([]string, error)
+	GenerateStructuredSummary(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (*StructuredSummary, error)
 }
 
 // ConnectionTester defines interface for testing LLM connectivity
@@ -23,20 +28,47 @@ type ConnectionTester interface {
 // LLMConfig represents LLM configuration options
 type LLMConfig struct {
 	Enabled                  bool
-	Mode                     string // "embedded", "ollama", "disabled"
+	Mode                     string // "embedded", "ollama", "openai", "local-openai", "disabled"
 	Model                    string
 	Debug                    bool
 	SummaryStyle             string // "technical", "business", "brief"
+	Language                 string // "auto" to match the language of today's comments, or a language name or code
+	Period                   string // Days a multi-day report covers, e.g. "June 3 to June 7, 2024"; "" for a single day
 	MaxSummaryLength         int
 	IncludeTechnicalDetails  bool
 	PrioritizeRecentWork     bool
 	FallbackStrategy         string // "graceful", "strict", "minimal"
 	OllamaURL                string
 	OllamaModel              string
+	OllamaIdleStop           int    // Minutes without requests before the Docker container stops, 0 for never
+	OpenAIURL                string
+	OpenAIAPIKey             string
+	OpenAIModel              string
+	OpenAIAPIVersion         string // Azure OpenAI only
+	LocalOpenAIURL           string // LM Studio or llama-server, for the local-openai mode
+	LocalOpenAIAPIKey        string // Only when the local server was started with an API key
+	LocalOpenAIModel         string // "" for the model the server has loaded
+	Concurrency              int    // Issues summarized at once by SummarizeIssues, 0 for DefaultConcurrency
+	Privacy                  PrivacyConfig // Applied when the backend is remote
+	Projects                 map[string]ProjectPrompt // Style and prompt overrides by project key
 }
 
-// NewSummarizer creates a new summarizer based on configuration
+// NewSummarizer creates a new summarizer based on configuration. Remote backends get the
+// issues and comments through the privacy filter of llm.privacy.
 func NewSummarizer(config LLMConfig) (Summarizer, error) {
+	summarizer, err := newBackend(config)
+	if err != nil || !isRemote(config) || !config.Privacy.enabled() {
+		return summarizer, err
+	}
+	filter, err := newPrivacyFilter(summarizer, config)
+	if err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// newBackend creates the summarizer of the configured mode
+func newBackend(config LLMConfig) (Summarizer, error) {
 	if !config.Enabled {
 		return NewDisabledSummarizer(), nil
 	}
@@ -50,10 +82,14 @@ func NewSummarizer(config LLMConfig) (Summarizer, error) {
 	case "docker":
 		// Explicit docker mode - same as ollama but with clear intent
 		return NewOllamaClientWithDockerManagement(config)
+	case "openai":
+		return NewOpenAIClientWithConfig(config), nil
+	case "local-openai":
+		return NewLocalOpenAIClient(config), nil
 	case "disabled":
 		return NewDisabledSummarizer(), nil
 	default:
-		return nil, fmt.Errorf("unknown LLM mode: %s (supported: embedded, ollama, docker, disabled)", config.Mode)
+		return nil, fmt.Errorf("unknown LLM mode: %s (supported: embedded, ollama, docker, openai, local-openai, disabled)", config.Mode)
 	}
 }
 
@@ -109,6 +145,31 @@ func (d *DisabledSummarizer) GenerateStandupSummaryWithComments(issues []jira.Is
 	return fmt.Sprintf("Recent activity: %d issues, %d comments, %d worklog entries", len(issues), len(comments), len(worklogs)), nil
 }
 
+// GenerateWeeklySummary returns basic weekly activity summary
+func (d *DisabledSummarizer) GenerateWeeklySummary(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (string, error) {
+	return fmt.Sprintf("Weekly activity: %d issues, %d comments, %d worklog entries", len(issues), len(comments), len(worklogs)), nil
+}
+
+// GenerateReleaseNotes returns a basic overview of the release
+func (d *DisabledSummarizer) GenerateReleaseNotes(version string, issues []jira.Issue) (string, error) {
+	return fmt.Sprintf("Version %s: %d changes", version, len(issues)), nil
+}
+
+// GenerateThemes groups the issues into themes by label or project
+func (d *DisabledSummarizer) GenerateThemes(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) ([]Theme, error) {
+	return groupThemes(issues), nil
+}
+
+// GenerateNextSteps proposes the next steps stated in comments and continuing the work in progress
+func (d *DisabledSummarizer) GenerateNextSteps(issues []jira.Issue, comments []jira.Comment) ([]string, error) {
+	return suggestNextSteps(issues, comments), nil
+}
+
+// GenerateStructuredSummary sorts the work by the status of its issues
+func (d *DisabledSummarizer) GenerateStructuredSummary(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (*StructuredSummary, error) {
+	return structureWork(issues, comments), nil
+}
+
 // TestLLMConnection tests if the configured LLM service is available
 func TestLLMConnection(config LLMConfig) error {
 	if !config.Enabled || config.Mode == "disabled" {
@@ -122,6 +183,10 @@ func TestLLMConnection(config LLMConfig) error {
 	case "ollama":
 		client := NewOllamaClient(config.OllamaURL, config.OllamaModel)
 		return client.TestConnection()
+	case "openai":
+		return NewOpenAIClientWithConfig(config).TestConnection()
+	case "local-openai":
+		return NewLocalOpenAIClient(config).TestConnection()
 	default:
 		return fmt.Errorf("unknown LLM mode: %s", config.Mode)
 	}
@@ -0,0 +1,155 @@
+package llm
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"my-day/internal/jira"
+)
+
+// maxThemes is how many themes the day's work is grouped into at most
+const maxThemes = 4
+
+// Theme is a stream of related work of the day, such as "IAM cleanup" or "Release 2.14 prep",
+// with the issues that belong to it and a summary of the work done on them
+type Theme struct {
+	Name    string
+	Issues  []string // Keys of the issues of the theme
+	Summary string
+}
+
+// themeIssueKeyPattern matches the issue keys listed for a theme
+var themeIssueKeyPattern = regexp.MustCompile(`[A-Z][A-Z0-9_]+-\d+`)
+
+// buildThemesPrompt creates a prompt that groups the day's work into themes and summarizes each
+func (o *OllamaClient) buildThemesPrompt(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("You are preparing a standup update. Instead of going through the work issue by issue, group it into the streams of work it belongs to, the way people talk in standup.\n\n")
+	prompt.WriteString(o.buildStructuredDataSection(issues, comments, worklogs, false))
+	prompt.WriteString(fmt.Sprintf("Group this work into 2 to %d themes. A theme is a short name for a stream of related work, such as \"IAM cleanup\" or \"Release 2.14 prep\". Put every issue in exactly one theme, and use a single theme if all the work is related.\n", maxThemes))
+	prompt.WriteString("For each theme, write 1-2 sentences about the work done on it.\n\n")
+	prompt.WriteString("Answer with one block per theme and nothing else, in this format:\n")
+	prompt.WriteString("THEME: <short name>\nISSUES: <issue keys, comma-separated>\nSUMMARY: <1-2 sentences>\n\n")
+	prompt.WriteString(o.projectInstructions(issues, ""))
+	prompt.WriteString(hierarchyInstructions(issues))
+	prompt.WriteString(o.periodInstruction())
+	if instruction := o.languageInstruction(comments); instruction != "" {
+		prompt.WriteString(instruction)
+		prompt.WriteString("Keep the THEME, ISSUES and SUMMARY labels in English.\n\n")
+	}
+	prompt.WriteString("IMPORTANT: Write the summaries in first person (using 'I' statements) as if you are the person who did the work.\n")
+
+	return prompt.String()
+}
+
+// parseThemes reads the themes of a response to the themes prompt. Issue keys that are not
+// part of the day's work are dropped, so made-up keys don't reach the report.
+func parseThemes(response string, issues []jira.Issue) ([]Theme, error) {
+	known := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		known[issue.Key] = true
+	}
+
+	var themes []Theme
+	var current *Theme
+	var field string
+	for _, line := range strings.Split(response, "\n") {
+		line = strings.TrimSpace(strings.NewReplacer("**", "", "__", "").Replace(line))
+		line = strings.TrimLeft(line, "-*# ")
+		if line == "" {
+			continue
+		}
+
+		label, value, found := strings.Cut(line, ":")
+		label = strings.ToUpper(strings.TrimSpace(label))
+		value = strings.TrimSpace(value)
+		switch {
+		case found && label == "THEME":
+			themes = append(themes, Theme{Name: strings.Trim(value, `"`)})
+			current, field = &themes[len(themes)-1], label
+		case current == nil:
+			continue
+		case found && label == "ISSUES":
+			for _, key := range themeIssueKeyPattern.FindAllString(value, -1) {
+				if known[key] || len(known) == 0 {
+					current.Issues = append(current.Issues, key)
+				}
+			}
+			field = label
+		case found && label == "SUMMARY":
+			current.Summary, field = value, label
+		case field == "SUMMARY":
+			// A summary that wraps onto the next lines
+			current.Summary = strings.TrimSpace(current.Summary + " " + line)
+		}
+	}
+
+	var parsed []Theme
+	for _, theme := range themes {
+		if theme.Name != "" && theme.Summary != "" {
+			parsed = append(parsed, theme)
+		}
+	}
+	if len(parsed) == 0 {
+		return nil, fmt.Errorf("no themes found in the LLM response")
+	}
+	if len(parsed) > maxThemes {
+		parsed = parsed[:maxThemes]
+	}
+	return parsed, nil
+}
+
+// groupThemes groups issues into themes without an LLM: by their first label, or by project
+// for unlabeled issues. The smallest groups are merged into "Other work" beyond maxThemes.
+func groupThemes(issues []jira.Issue) []Theme {
+	var names []string
+	groups := make(map[string][]jira.Issue)
+	for _, issue := range issues {
+		name := issue.Fields.Project.Key
+		if len(issue.Fields.Labels) > 0 {
+			name = issue.Fields.Labels[0]
+		}
+		if name == "" {
+			name = "Other work"
+		}
+		if _, ok := groups[name]; !ok {
+			names = append(names, name)
+		}
+		groups[name] = append(groups[name], issue)
+	}
+
+	sort.SliceStable(names, func(i, j int) bool {
+		return len(groups[names[i]]) > len(groups[names[j]])
+	})
+	if len(names) > maxThemes {
+		var other []jira.Issue
+		for _, name := range names[maxThemes-1:] {
+			other = append(other, groups[name]...)
+		}
+		names = append(names[:maxThemes-1], "Other work")
+		groups["Other work"] = other
+	}
+
+	var themes []Theme
+	for _, name := range names {
+		theme := Theme{Name: name}
+		var summaries []string
+		done := 0
+		for _, issue := range groups[name] {
+			theme.Issues = append(theme.Issues, issue.Key)
+			summaries = append(summaries, issue.Fields.Summary)
+			if strings.EqualFold(issue.Fields.Status.Category.Key, "done") {
+				done++
+			}
+		}
+		theme.Summary = "Worked on " + strings.Join(summaries, "; ")
+		if done > 0 {
+			theme.Summary += fmt.Sprintf(" (%d completed)", done)
+		}
+		themes = append(themes, theme)
+	}
+	return themes
+}
@@ -0,0 +1,67 @@
+package llm
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"my-day/internal/jira"
+)
+
+func TestParseThemes(t *testing.T) {
+	issues := []jira.Issue{{Key: "OPS-1"}, {Key: "OPS-2"}, {Key: "OPS-3"}}
+	response := `Here are the themes:
+
+**THEME:** IAM cleanup
+**ISSUES:** OPS-1, OPS-3, OPS-99
+**SUMMARY:** I removed unused service accounts
+and tightened the deploy role.
+
+THEME: "Release 2.14 prep"
+ISSUES: OPS-2
+SUMMARY: I cut the release branch.
+
+THEME: Unfinished theme`
+
+	themes, err := parseThemes(response, issues)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Theme{
+		{Name: "IAM cleanup", Issues: []string{"OPS-1", "OPS-3"}, Summary: "I removed unused service accounts and tightened the deploy role."},
+		{Name: "Release 2.14 prep", Issues: []string{"OPS-2"}, Summary: "I cut the release branch."},
+	}
+	if !reflect.DeepEqual(themes, expected) {
+		t.Errorf("unexpected themes %+v", themes)
+	}
+
+	if _, err := parseThemes("I worked on a lot of things today.", issues); err == nil {
+		t.Error("expected an error for a response without themes")
+	}
+}
+
+func TestGroupThemes(t *testing.T) {
+	issue := func(key, project, label string) jira.Issue {
+		issue := jira.Issue{Key: key, Fields: jira.Fields{Summary: "Work on " + key, Project: jira.Project{Key: project}}}
+		if label != "" {
+			issue.Fields.Labels = []string{label}
+		}
+		return issue
+	}
+	themes := groupThemes([]jira.Issue{
+		issue("OPS-1", "OPS", "iam"), issue("OPS-2", "OPS", ""), issue("OPS-3", "OPS", "iam"),
+		issue("WEB-1", "WEB", ""), issue("DOC-1", "DOC", ""), issue("SEC-1", "SEC", ""),
+	})
+
+	var names []string
+	for _, theme := range themes {
+		names = append(names, theme.Name+":"+strings.Join(theme.Issues, ","))
+	}
+	expected := []string{"iam:OPS-1,OPS-3", "OPS:OPS-2", "WEB:WEB-1", "Other work:DOC-1,SEC-1"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected themes %v, got %v", expected, names)
+	}
+	if themes[0].Summary != "Worked on Work on OPS-1; Work on OPS-3" {
+		t.Errorf("unexpected summary %q", themes[0].Summary)
+	}
+}
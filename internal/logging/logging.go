@@ -0,0 +1,140 @@
+// Package logging sets up the leveled logger (log/slog) that reports warnings and debug
+// information. Records go to stderr as text and, when a log file is set, to the file as JSON
+// lines, so daemon and CI runs leave logs that can be parsed.
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// level is the level of the default logger, warn until Setup is called
+var level = func() *slog.LevelVar {
+	v := new(slog.LevelVar)
+	v.Set(slog.LevelWarn)
+	return v
+}()
+
+// levelSet is whether the level was set explicitly rather than left to auto
+var levelSet bool
+
+// logFile is the log file records are written to, nil for none
+var logFile *os.File
+
+// ParseLevel parses a level name: debug, info, warn (or warning) or error
+func ParseLevel(name string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return 0, fmt.Errorf("unknown log level %q (use debug, info, warn or error)", name)
+}
+
+// Setup makes the default slog logger write records at levelName and above to stderr, and to
+// the file at path when path is set. An empty or "auto" level is warn, lowered to debug by
+// Verbose.
+func Setup(levelName, path string) error {
+	return setup(levelName, path, os.Stderr)
+}
+
+func setup(levelName, path string, stderr io.Writer) error {
+	levelSet = false
+	level.Set(slog.LevelWarn)
+	if name := strings.ToLower(strings.TrimSpace(levelName)); name != "" && name != "auto" {
+		parsed, err := ParseLevel(name)
+		if err != nil {
+			return err
+		}
+		level.Set(parsed)
+		levelSet = true
+	}
+
+	if err := Close(); err != nil {
+		return err
+	}
+	handlers := []slog.Handler{slog.NewTextHandler(stderr, &slog.HandlerOptions{Level: level, ReplaceAttr: withoutTime})}
+	if path != "" {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to open log file: %w", err)
+		}
+		logFile = file
+		handlers = append(handlers, slog.NewJSONHandler(file, &slog.HandlerOptions{Level: level}))
+	}
+	slog.SetDefault(slog.New(multiHandler(handlers)))
+	return nil
+}
+
+// Verbose lowers the level to debug for --debug and --verbose, unless a level was set
+func Verbose() {
+	if !levelSet {
+		level.Set(slog.LevelDebug)
+	}
+}
+
+// Close closes the log file
+func Close() error {
+	if logFile == nil {
+		return nil
+	}
+	err := logFile.Close()
+	logFile = nil
+	return err
+}
+
+// withoutTime leaves the time out of the records written to the terminal
+func withoutTime(groups []string, attr slog.Attr) slog.Attr {
+	if attr.Key == slog.TimeKey && len(groups) == 0 {
+		return slog.Attr{}
+	}
+	return attr
+}
+
+// multiHandler passes every record to each of its handlers
+type multiHandler []slog.Handler
+
+func (h multiHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, handler := range h {
+		if handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h multiHandler) Handle(ctx context.Context, record slog.Record) error {
+	var errs []error
+	for _, handler := range h {
+		if handler.Enabled(ctx, record.Level) {
+			errs = append(errs, handler.Handle(ctx, record.Clone()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (h multiHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make(multiHandler, len(h))
+	for i, handler := range h {
+		handlers[i] = handler.WithAttrs(attrs)
+	}
+	return handlers
+}
+
+func (h multiHandler) WithGroup(name string) slog.Handler {
+	handlers := make(multiHandler, len(h))
+	for i, handler := range h {
+		handlers[i] = handler.WithGroup(name)
+	}
+	return handlers
+}
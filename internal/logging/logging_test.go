@@ -0,0 +1,78 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetupWritesLevelsToStderrAndFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "my-day.log")
+	var stderr bytes.Buffer
+	if err := setup("info", path, &stderr); err != nil {
+		t.Fatalf("setup() error = %v", err)
+	}
+	defer Close()
+
+	slog.Debug("left out")
+	slog.Info("report cached", "id", "abc123")
+	slog.Warn("cache unavailable")
+
+	if got := stderr.String(); strings.Contains(got, "left out") || !strings.Contains(got, `level=INFO msg="report cached" id=abc123`) || strings.Contains(got, "time=") {
+		t.Errorf("unexpected stderr:\n%s", got)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 log lines, got %d:\n%s", len(lines), data)
+	}
+	var record map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatalf("expected JSON lines, got %q: %v", lines[0], err)
+	}
+	if record["level"] != "INFO" || record["msg"] != "report cached" || record["id"] != "abc123" || record["time"] == nil {
+		t.Errorf("unexpected record %v", record)
+	}
+}
+
+func TestVerboseLowersAutoLevel(t *testing.T) {
+	var stderr bytes.Buffer
+	if err := setup("", "", &stderr); err != nil {
+		t.Fatalf("setup() error = %v", err)
+	}
+	slog.Info("hidden")
+	Verbose()
+	slog.Debug("shown")
+	if got := stderr.String(); strings.Contains(got, "hidden") || !strings.Contains(got, "shown") {
+		t.Errorf("unexpected stderr:\n%s", got)
+	}
+
+	stderr.Reset()
+	if err := setup("error", "", &stderr); err != nil {
+		t.Fatalf("setup() error = %v", err)
+	}
+	Verbose()
+	slog.Warn("still hidden")
+	if stderr.Len() != 0 {
+		t.Errorf("expected an explicit level to be kept, got:\n%s", stderr.String())
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	for name, want := range map[string]slog.Level{"debug": slog.LevelDebug, "INFO": slog.LevelInfo, "warning": slog.LevelWarn, "error": slog.LevelError} {
+		if got, err := ParseLevel(name); err != nil || got != want {
+			t.Errorf("ParseLevel(%q) = %v, %v; want %v", name, got, err, want)
+		}
+	}
+	if _, err := ParseLevel("trace"); err == nil {
+		t.Error("expected an error for an unknown level")
+	}
+}
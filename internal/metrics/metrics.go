@@ -0,0 +1,205 @@
+// Package metrics counts what my-day does while it keeps running as 'my-day daemon' or
+// 'my-day serve': syncs, the requests sent to Jira and the LLM, and the reports generated with
+// or without their AI summary. The counts are served in the Prometheus text format, so an
+// alert can fire when LLM fallbacks spike.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// durationBuckets are the upper bounds, in seconds, of the duration histograms
+var durationBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+
+// Report summaries, the label of my_day_reports_total
+const (
+	SummaryAI       = "ai"       // The LLM wrote the summary
+	SummaryFallback = "fallback" // The LLM failed and the report fell back to the comments
+	SummaryNone     = "none"     // The LLM is disabled
+)
+
+var (
+	defaultRegistry = &registry{}
+
+	syncs           = defaultRegistry.counter("my_day_syncs_total", "Syncs by result: success or failure.", "result")
+	syncDuration    = defaultRegistry.histogram("my_day_sync_duration_seconds", "Time a sync took.")
+	requests        = defaultRegistry.counter("my_day_requests_total", "HTTP requests sent to Jira and the LLM by service and status code, or error when no response came.", "service", "code")
+	requestDuration = defaultRegistry.histogram("my_day_request_duration_seconds", "Time HTTP requests to Jira and the LLM took by service.", "service")
+	reports         = defaultRegistry.counter("my_day_reports_total", "Reports generated by how their summary was written: ai, fallback (the LLM failed) or none (LLM disabled).", "summary")
+	reportDuration  = defaultRegistry.histogram("my_day_report_duration_seconds", "Time generating a report took.")
+)
+
+// ObserveSync records a sync that took d and failed when err is not nil
+func ObserveSync(d time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	syncs.add(1, result)
+	syncDuration.observe(d.Seconds())
+}
+
+// ObserveRequest records an HTTP request of service (e.g. "jira" or "ollama") answered with
+// status code after d, or failed without a response when code is 0
+func ObserveRequest(service string, code int, d time.Duration) {
+	status := "error"
+	if code > 0 {
+		status = strconv.Itoa(code)
+	}
+	requests.add(1, service, status)
+	requestDuration.observe(d.Seconds(), service)
+}
+
+// ObserveReport records a report generated in d with its summary written as summary, one of
+// SummaryAI, SummaryFallback or SummaryNone
+func ObserveReport(d time.Duration, summary string) {
+	reports.add(1, summary)
+	reportDuration.observe(d.Seconds())
+}
+
+// Handler serves the metrics in the Prometheus text format
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		defaultRegistry.write(w)
+	})
+}
+
+// registry keeps the metric families in the order they are served
+type registry struct {
+	families []*family
+}
+
+// family is a counter or histogram with the series of each combination of its label values
+type family struct {
+	name   string
+	help   string
+	kind   string // counter or histogram
+	labels []string
+
+	mu     sync.Mutex
+	series map[string]*series
+}
+
+// series is the value of a family for one combination of label values
+type series struct {
+	labelValues []string
+	value       float64  // Counter value, or the sum of the observations of a histogram
+	count       uint64   // Observations of a histogram
+	buckets     []uint64 // Observations of a histogram up to each of durationBuckets
+}
+
+func (r *registry) counter(name, help string, labels ...string) *family {
+	return r.register(name, help, "counter", labels)
+}
+
+func (r *registry) histogram(name, help string, labels ...string) *family {
+	return r.register(name, help, "histogram", labels)
+}
+
+func (r *registry) register(name, help, kind string, labels []string) *family {
+	f := &family{name: name, help: help, kind: kind, labels: labels, series: make(map[string]*series)}
+	r.families = append(r.families, f)
+	return f
+}
+
+// get returns the series of the label values, created at zero the first time
+func (f *family) get(labelValues []string) *series {
+	key := strings.Join(labelValues, "\xff")
+	s, ok := f.series[key]
+	if !ok {
+		s = &series{labelValues: labelValues}
+		if f.kind == "histogram" {
+			s.buckets = make([]uint64, len(durationBuckets))
+		}
+		f.series[key] = s
+	}
+	return s
+}
+
+// add adds value to a counter
+func (f *family) add(value float64, labelValues ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.get(labelValues).value += value
+}
+
+// observe records an observation of a histogram
+func (f *family) observe(value float64, labelValues ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s := f.get(labelValues)
+	s.value += value
+	s.count++
+	for i, bound := range durationBuckets {
+		if value <= bound {
+			s.buckets[i]++
+		}
+	}
+}
+
+// write renders every family in the Prometheus text format. Families without series yet are
+// listed with their help and type only.
+func (r *registry) write(w io.Writer) {
+	for _, f := range r.families {
+		f.write(w)
+	}
+}
+
+func (f *family) write(w io.Writer) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n", f.name, f.help)
+	fmt.Fprintf(w, "# TYPE %s %s\n", f.name, f.kind)
+
+	keys := make([]string, 0, len(f.series))
+	for key := range f.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		s := f.series[key]
+		if f.kind == "counter" {
+			fmt.Fprintf(w, "%s%s %s\n", f.name, labelSet(f.labels, s.labelValues, ""), formatValue(s.value))
+			continue
+		}
+		for i, bound := range durationBuckets {
+			fmt.Fprintf(w, "%s_bucket%s %d\n", f.name, labelSet(f.labels, s.labelValues, formatValue(bound)), s.buckets[i])
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", f.name, labelSet(f.labels, s.labelValues, "+Inf"), s.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", f.name, labelSet(f.labels, s.labelValues, ""), formatValue(s.value))
+		fmt.Fprintf(w, "%s_count%s %d\n", f.name, labelSet(f.labels, s.labelValues, ""), s.count)
+	}
+}
+
+// labelEscaper escapes label values the way the Prometheus text format expects
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// labelSet renders label names and values as {name="value",...}, with the le label of a
+// histogram bucket last when le is set, or "" without labels
+func labelSet(names, values []string, le string) string {
+	var pairs []string
+	for i, name := range names {
+		pairs = append(pairs, name+`="`+labelEscaper.Replace(values[i])+`"`)
+	}
+	if le != "" {
+		pairs = append(pairs, `le="`+le+`"`)
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// formatValue renders a sample value the shortest way, e.g. 3 or 0.25
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
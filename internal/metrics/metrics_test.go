@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRegistryWrite(t *testing.T) {
+	r := &registry{}
+	calls := r.counter("calls_total", "Calls.", "service", "code")
+	latency := r.histogram("latency_seconds", "Latency.")
+	r.counter("unused_total", "Never incremented.")
+
+	calls.add(1, "jira", "200")
+	calls.add(2, "jira", "200")
+	calls.add(1, "ollama", "error")
+	latency.observe(0.3)
+	latency.observe(400)
+
+	var out strings.Builder
+	r.write(&out)
+	for _, want := range []string{
+		"# HELP calls_total Calls.\n# TYPE calls_total counter\n" +
+			"calls_total{service=\"jira\",code=\"200\"} 3\ncalls_total{service=\"ollama\",code=\"error\"} 1\n",
+		"latency_seconds_bucket{le=\"0.25\"} 0\n",
+		"latency_seconds_bucket{le=\"0.5\"} 1\n",
+		"latency_seconds_bucket{le=\"300\"} 1\n",
+		"latency_seconds_bucket{le=\"+Inf\"} 2\n",
+		"latency_seconds_sum 400.3\nlatency_seconds_count 2\n",
+		"# TYPE unused_total counter\n",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestLabelSetEscapes(t *testing.T) {
+	got := labelSet([]string{"service"}, []string{"a\"b\\c\nd"}, "")
+	if want := `{service="a\"b\\c\nd"}`; got != want {
+		t.Errorf("labelSet() = %s, want %s", got, want)
+	}
+	if got := labelSet(nil, nil, ""); got != "" {
+		t.Errorf("expected no label set without labels, got %q", got)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	ObserveReport(2*time.Second, SummaryFallback)
+	ObserveRequest("jira", 429, 100*time.Millisecond)
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain; version=0.0.4") {
+		t.Errorf("unexpected content type %q", rec.Header().Get("Content-Type"))
+	}
+	for _, want := range []string{`my_day_reports_total{summary="fallback"} 1`, `my_day_requests_total{service="jira",code="429"} 1`, "my_day_sync_duration_seconds histogram"} {
+		if !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("expected %q in:\n%s", want, rec.Body.String())
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package qrcode
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReedSolomon(t *testing.T) {
+	// "HELLO WORLD" as a 1-M code, from the worked example in the QR code tutorial at thonky.com
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	if got := rsRemainder(data, rsGenerator(10)); !bytes.Equal(got, want) {
+		t.Errorf("rsRemainder() = %v, want %v", got, want)
+	}
+}
+
+func TestEncodeChoosesSmallestVersion(t *testing.T) {
+	tests := []struct {
+		text    string
+		version int
+	}{
+		{"hi", 1},
+		{strings.Repeat("a", 14), 1},
+		{strings.Repeat("a", 15), 2},
+		{"http://192.168.1.20:41235/r/6f1c0e2b9a4d8e73c5b2a1f0e9d8c7b6", 4},
+		{strings.Repeat("a", 213), 10},
+	}
+
+	for _, tt := range tests {
+		code, err := Encode(tt.text)
+		if err != nil {
+			t.Fatalf("Encode(%d bytes) error = %v", len(tt.text), err)
+		}
+		if code.Version != tt.version || code.Size != 17+4*tt.version {
+			t.Errorf("Encode(%d bytes) = version %d size %d, want version %d", len(tt.text), code.Version, code.Size, tt.version)
+		}
+	}
+
+	if _, err := Encode(strings.Repeat("a", 214)); err == nil {
+		t.Error("expected an error for text that does not fit")
+	}
+}
+
+func TestEncodeReadsBack(t *testing.T) {
+	text := "http://10.0.0.5:8080/r/abc123"
+	code, err := Encode(text)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	// Both copies of the format information name level M and the chosen mask
+	var first, second int
+	for i, pos := range [][2]int{{8, 0}, {8, 1}, {8, 2}, {8, 3}, {8, 4}, {8, 5}, {8, 7}, {8, 8}, {7, 8}, {5, 8}, {4, 8}, {3, 8}, {2, 8}, {1, 8}, {0, 8}} {
+		if code.Dark(pos[0], pos[1]) {
+			first |= 1 << i
+		}
+	}
+	for i := 0; i < 15; i++ {
+		x, y := code.Size-1-i, 8
+		if i >= 8 {
+			x, y = 8, code.Size-15+i
+		}
+		if code.Dark(x, y) {
+			second |= 1 << i
+		}
+	}
+	if first != second {
+		t.Fatalf("format copies differ: %015b vs %015b", first, second)
+	}
+	format := (first ^ 0x5412) >> 10
+	if format>>3 != 0 || format&7 != code.Mask {
+		t.Errorf("format bits %05b, want level M with mask %d", format, code.Mask)
+	}
+
+	// Unmask the data modules and read the codewords back in placement order
+	code.applyMask(code.Mask)
+	var bits bitBuffer
+	for right := code.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < code.Size; vert++ {
+			y := vert
+			if (right+1)&2 == 0 {
+				y = code.Size - 1 - vert
+			}
+			for j := 0; j < 2; j++ {
+				if !code.isFunction[y][right-j] {
+					bits = append(bits, code.modules[y][right-j])
+				}
+			}
+		}
+	}
+	codewords := bits.bytes()
+
+	// A single-block version stores the mode, length and text at the start
+	if code.Version > 3 {
+		t.Fatalf("expected a single-block version, got %d", code.Version)
+	}
+	if codewords[0]>>4 != 0x4 {
+		t.Errorf("mode = %x, want byte mode", codewords[0]>>4)
+	}
+	length := int(codewords[0]&0x0F)<<4 | int(codewords[1]>>4)
+	if length != len(text) {
+		t.Fatalf("length = %d, want %d", length, len(text))
+	}
+	decoded := make([]byte, length)
+	for i := range decoded {
+		decoded[i] = codewords[1+i]<<4 | codewords[2+i]>>4
+	}
+	if string(decoded) != text {
+		t.Errorf("decoded %q, want %q", decoded, text)
+	}
+}
+
+func TestTerminal(t *testing.T) {
+	code, err := Encode("hi")
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(code.Terminal(), "\n"), "\n")
+	// 21 modules plus a quiet zone of 4 on each side, two rows per line
+	if len(lines) != 15 {
+		t.Errorf("expected 15 lines, got %d", len(lines))
+	}
+	for _, line := range lines {
+		if n := len([]rune(line)); n != 29 {
+			t.Fatalf("expected 29 columns, got %d in %q", n, line)
+		}
+	}
+	if lines[0] != strings.Repeat("█", 29) {
+		t.Errorf("expected the first line to be quiet zone, got %q", lines[0])
+	}
+}
@@ -0,0 +1,51 @@
+package qrcode
+
+// Reed-Solomon error correction over GF(256) with the QR code polynomial x^8+x^4+x^3+x^2+1
+
+// gfMultiply multiplies two elements of GF(256)
+func gfMultiply(a, b byte) byte {
+	var product byte
+	for i := 7; i >= 0; i-- {
+		// Multiply by x, reducing by the field polynomial on overflow
+		carry := product >> 7
+		product = product<<1 ^ carry*0x1D
+		if (b>>i)&1 != 0 {
+			product ^= a
+		}
+	}
+	return product
+}
+
+// rsGenerator returns the coefficients of the generator polynomial of the given degree,
+// highest power first with the leading 1 omitted
+func rsGenerator(degree int) []byte {
+	generator := make([]byte, degree)
+	generator[degree-1] = 1
+
+	// Multiply by (x - a^i) for i in 0..degree-1, where a = 2
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range generator {
+			generator[j] = gfMultiply(generator[j], root)
+			if j+1 < len(generator) {
+				generator[j] ^= generator[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return generator
+}
+
+// rsRemainder returns the error correction codewords of data for a generator polynomial
+func rsRemainder(data, generator []byte) []byte {
+	remainder := make([]byte, len(generator))
+	for _, b := range data {
+		factor := b ^ remainder[0]
+		copy(remainder, remainder[1:])
+		remainder[len(remainder)-1] = 0
+		for i, coefficient := range generator {
+			remainder[i] ^= gfMultiply(coefficient, factor)
+		}
+	}
+	return remainder
+}
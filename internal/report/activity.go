@@ -0,0 +1,350 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	"my-day/internal/asana"
+	"my-day/internal/github"
+	"my-day/internal/gitlab"
+	"my-day/internal/jira"
+	"my-day/internal/trello"
+)
+
+// codeActivity is a commit, pull/merge request, review or pipeline from a code hosting
+// source, or a card or task from a task board, normalized for the report timeline
+type codeActivity struct {
+	At         time.Time
+	Action     string // What was done, e.g. "Merged PR #42"
+	Repository string // Repository, or board/project for task boards
+	Title      string
+	Detail     string // Comment text, if any
+	URL        string
+	Tickets    []string // Linked Jira tickets
+}
+
+// codeActivitySource is the in-window activity of one code hosting source
+type codeActivitySource struct {
+	Name  string
+	Icon  string
+	Items []codeActivity
+}
+
+// codeActivityProviders are the code hosting and task board sources, in report order
+var codeActivityProviders = []struct {
+	Name     string
+	Icon     string
+	Timeline func(config *Config) []codeActivity
+}{
+	{"GitHub", "🐙", func(config *Config) []codeActivity { return githubTimeline(config.GitHubActivity) }},
+	{"GitLab", "🦊", func(config *Config) []codeActivity { return gitlabTimeline(config.GitLabActivity) }},
+	{"Trello", "📌", func(config *Config) []codeActivity { return trelloTimeline(config.TrelloActivity) }},
+	{"Asana", "🎯", func(config *Config) []codeActivity { return asanaTimeline(config.AsanaActivity) }},
+}
+
+func init() {
+	// Each source is its own section, so sources without activity are left out
+	for i, provider := range codeActivityProviders {
+		name := provider.Name
+		RegisterSection(NewSection(fmt.Sprintf("%s %s Activity", provider.Icon, name), PriorityActivity+i, func(model SectionModel, format string) string {
+			for _, source := range model.generator.codeActivitySources(model.TargetDate) {
+				if source.Name == name {
+					return formatCodeActivity(source, format)
+				}
+			}
+			return ""
+		}))
+	}
+}
+
+// codeActivitySources returns the GitHub, GitLab, Trello and Asana activity in the report window,
+// oldest first. Sources without activity are omitted.
+func (g *Generator) codeActivitySources(targetDate time.Time) []codeActivitySource {
+	var sources []codeActivitySource
+	for _, provider := range codeActivityProviders {
+		var items []codeActivity
+		for _, item := range provider.Timeline(g.config) {
+			if g.inReportWindow(item.At, targetDate) {
+				items = append(items, item)
+			}
+		}
+		if len(items) == 0 {
+			continue
+		}
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].At.Before(items[j].At)
+		})
+		sources = append(sources, codeActivitySource{Name: provider.Name, Icon: provider.Icon, Items: items})
+	}
+	return sources
+}
+
+// inReportWindow reports whether t falls on the target date or the day before,
+// following the same today/yesterday settings as worklogs
+func (g *Generator) inReportWindow(t time.Time, targetDate time.Time) bool {
+	ty, tm, td := targetDate.Date()
+	today := time.Date(ty, tm, td, 0, 0, 0, 0, targetDate.Location())
+
+	y, m, d := t.In(targetDate.Location()).Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, targetDate.Location())
+
+	if g.config.IncludeToday && day.Equal(today) {
+		return true
+	}
+	return g.config.IncludeYesterday && day.Equal(today.AddDate(0, 0, -1))
+}
+
+// githubTimeline converts GitHub commits, pull requests and reviews into timeline items
+func githubTimeline(activities []github.Activity) []codeActivity {
+	var items []codeActivity
+	for _, activity := range activities {
+		number := metadataNumber(activity.Metadata, "number", "#")
+		item := codeActivity{
+			At:         activity.UpdatedAt,
+			Repository: activity.Repository,
+			Title:      activity.Title,
+			URL:        activity.URL,
+			Tickets:    activity.JiraTickets,
+		}
+
+		switch activity.Type {
+		case "commit":
+			item.Action = "Committed"
+		case "review":
+			switch activity.State {
+			case "approved":
+				item.Action = "Approved PR" + number
+			case "changes_requested":
+				item.Action = "Requested changes on PR" + number
+			default:
+				item.Action = "Reviewed PR" + number
+			}
+		case "pull_request":
+			switch activity.State {
+			case "merged":
+				item.Action = "Merged PR" + number
+				if mergedAt, ok := metadataTime(activity.Metadata, "merged_at"); ok {
+					item.At = mergedAt
+				}
+			case "open":
+				item.Action = "Opened PR" + number
+				item.At = activity.CreatedAt
+			default:
+				item.Action = "Closed PR" + number
+			}
+		default:
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// gitlabTimeline converts GitLab merge requests, commits and pipelines into timeline items
+func gitlabTimeline(activities []gitlab.Activity) []codeActivity {
+	var items []codeActivity
+	for _, activity := range activities {
+		item := codeActivity{
+			At:         activity.UpdatedAt,
+			Repository: activity.Project,
+			Title:      activity.Title,
+			URL:        activity.URL,
+			Tickets:    activity.JiraTickets,
+		}
+
+		switch activity.Type {
+		case "commit":
+			item.Action = "Committed"
+		case "merge_request":
+			iid := metadataNumber(activity.Metadata, "iid", "!")
+			switch activity.State {
+			case "merged":
+				item.Action = "Merged MR" + iid
+				if mergedAt, ok := metadataTime(activity.Metadata, "merged_at"); ok {
+					item.At = mergedAt
+				}
+			case "opened":
+				item.Action = "Opened MR" + iid
+				item.At = activity.CreatedAt
+			default:
+				item.Action = "Closed MR" + iid
+			}
+		case "pipeline":
+			item.Action = "Pipeline " + activity.State
+			if ref, ok := activity.Metadata["ref"].(string); ok {
+				item.Title = ref
+			}
+		default:
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// trelloTimeline converts Trello card creations, moves and comments into timeline items
+func trelloTimeline(activities []trello.Activity) []codeActivity {
+	var items []codeActivity
+	for _, activity := range activities {
+		item := codeActivity{
+			At:         activity.CreatedAt,
+			Repository: activity.Board,
+			Title:      activity.Title,
+			URL:        activity.URL,
+			Tickets:    activity.JiraTickets,
+		}
+
+		switch activity.Type {
+		case "created":
+			item.Action = "Created card"
+		case "moved":
+			item.Action = "Moved card to " + activity.List
+		case "commented":
+			item.Action = "Commented on card"
+			item.Detail = activity.Description
+		default:
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// asanaTimeline converts completed, moved and commented Asana tasks into timeline items
+func asanaTimeline(activities []asana.Activity) []codeActivity {
+	var items []codeActivity
+	for _, activity := range activities {
+		item := codeActivity{
+			At:         activity.CreatedAt,
+			Repository: activity.Project,
+			Title:      activity.Title,
+			URL:        activity.URL,
+			Tickets:    activity.JiraTickets,
+		}
+		if item.Repository == "" {
+			item.Repository = "My Tasks"
+		}
+
+		switch activity.Type {
+		case "completed":
+			item.Action = "Completed task"
+		case "moved":
+			item.Action = "Moved task"
+		case "commented":
+			item.Action = "Commented on task"
+			item.Detail = activity.Description
+		default:
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// metadataNumber formats a numeric metadata value with a prefix, e.g. " #42"
+func metadataNumber(metadata map[string]interface{}, key, prefix string) string {
+	if n, ok := metadata[key]; ok {
+		return fmt.Sprintf(" %s%v", prefix, n)
+	}
+	return ""
+}
+
+// metadataTime reads a time from activity metadata, which is a string once read back from the sync cache
+func metadataTime(metadata map[string]interface{}, key string) (time.Time, bool) {
+	switch value := metadata[key].(type) {
+	case time.Time:
+		return value, !value.IsZero()
+	case string:
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// text is a one-line description of the item used in reports and LLM prompts
+func (a codeActivity) text() string {
+	return fmt.Sprintf("%s in %s: %s%s%s", a.Action, a.Repository, a.Title, a.ticketSuffix(), a.detailSuffix())
+}
+
+// detailSuffix quotes the first line of the item's comment, if any
+func (a codeActivity) detailSuffix() string {
+	detail := strings.TrimSpace(a.Detail)
+	if detail == "" {
+		return ""
+	}
+	if line, _, found := strings.Cut(detail, "\n"); found {
+		detail = strings.TrimSpace(line) + " …"
+	}
+	return fmt.Sprintf(" — \"%s\"", commentExcerpt(detail, 200))
+}
+
+// ticketSuffix lists the linked Jira tickets not already mentioned in the title
+func (a codeActivity) ticketSuffix() string {
+	var tickets []string
+	for _, ticket := range a.Tickets {
+		if !strings.Contains(a.Title, ticket) {
+			tickets = append(tickets, ticket)
+		}
+	}
+	if len(tickets) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (%s)", strings.Join(tickets, ", "))
+}
+
+// withCodeActivityComments adds the report window's GitHub, GitLab, Trello and Asana activity to the
+// comments given to the LLM, so work that never reached a Jira comment still appears in the summary
+func (g *Generator) withCodeActivityComments(comments []jira.Comment, targetDate time.Time) []jira.Comment {
+	sources := g.codeActivitySources(targetDate)
+	if len(sources) == 0 {
+		return comments
+	}
+
+	result := append([]jira.Comment{}, comments...)
+	for _, source := range sources {
+		for i, item := range source.Items {
+			result = append(result, jira.Comment{
+				ID:      fmt.Sprintf("%s-%d", strings.ToLower(source.Name), i),
+				Body:    jira.JiraDescription{Text: source.Name + ": " + item.text()},
+				Created: jira.JiraTime{Time: item.At},
+				Updated: jira.JiraTime{Time: item.At},
+			})
+		}
+	}
+	return result
+}
+
+// formatCodeActivity renders a source's activity as a list
+func formatCodeActivity(source codeActivitySource, format string) string {
+	var result strings.Builder
+	if format == FormatHTML {
+		result.WriteString("<ul>\n")
+	}
+	for _, item := range source.Items {
+		switch format {
+		case FormatHTML:
+			action := html.EscapeString(item.Action)
+			if item.URL != "" {
+				action = fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(item.URL), action)
+			}
+			result.WriteString(fmt.Sprintf("<li>%s %s in %s: %s%s</li>\n",
+				item.At.Format("15:04"), action, html.EscapeString(item.Repository), html.EscapeString(item.Title), html.EscapeString(item.detailSuffix())))
+		case FormatMarkdown:
+			action := item.Action
+			if item.URL != "" {
+				action = fmt.Sprintf("[%s](%s)", action, item.URL)
+			}
+			result.WriteString(fmt.Sprintf("- **%s** %s in %s: %s%s%s\n", item.At.Format("15:04"), action, item.Repository, item.Title, item.ticketSuffix(), item.detailSuffix()))
+		default:
+			result.WriteString(fmt.Sprintf("  • %s %s\n", item.At.Format("15:04"), item.text()))
+		}
+	}
+	if format == FormatHTML {
+		result.WriteString("</ul>\n")
+	}
+	return result.String()
+}
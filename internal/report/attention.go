@@ -0,0 +1,55 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// maxMentionExcerpt is the most runes of a comment mentioning the user shown in the report
+const maxMentionExcerpt = 200
+
+func init() {
+	RegisterSection(NewSection("🔔 Needs my attention", PriorityAttention, func(model SectionModel, format string) string {
+		return model.generator.formatMentions(format)
+	}))
+}
+
+// formatMentions renders the comments mentioning the user on issues others own, as synced,
+// oldest first
+func (g *Generator) formatMentions(format string) string {
+	if len(g.config.Mentions) == 0 {
+		return ""
+	}
+
+	layout := "15:04"
+	if g.isMultiDay() {
+		layout = "Jan 2 15:04"
+	}
+
+	var result strings.Builder
+	if format == FormatHTML {
+		result.WriteString("<ul>\n")
+	}
+	for _, mention := range g.config.Mentions {
+		author := mention.Comment.Author.DisplayName
+		if author == "" {
+			author = "someone"
+		}
+		at := mention.Comment.Created.Time.Local().Format(layout)
+		excerpt := commentExcerpt(mention.Comment.Body.Text, maxMentionExcerpt)
+		switch format {
+		case FormatHTML:
+			result.WriteString(fmt.Sprintf("<li><span class=\"key\">%s</span> %s <span class=\"meta\">— %s at %s</span>: %s</li>\n",
+				html.EscapeString(mention.IssueKey), html.EscapeString(mention.IssueSummary), html.EscapeString(author), at, html.EscapeString(excerpt)))
+		case FormatMarkdown:
+			result.WriteString(fmt.Sprintf("- **[%s]** %s — %s at %s: %s\n", mention.IssueKey, mention.IssueSummary, author, at, excerpt))
+		default:
+			result.WriteString(fmt.Sprintf("  %s %s\n    %s at %s: %s\n", mention.IssueKey, mention.IssueSummary, author, at, excerpt))
+		}
+	}
+	if format == FormatHTML {
+		result.WriteString("</ul>\n")
+	}
+	return result.String()
+}
@@ -0,0 +1,30 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func TestFormatMentions(t *testing.T) {
+	comment := jira.Comment{
+		Author:  jira.User{DisplayName: "Sam"},
+		Body:    jira.JiraDescription{Text: "@Alex could you review the rollout plan?"},
+		Created: jira.JiraTime{Time: time.Date(2024, 6, 3, 14, 5, 0, 0, time.Local)},
+	}
+	g := NewGenerator(&Config{Mentions: []jira.Mention{{IssueKey: "WEB-7", IssueSummary: "Checkout page", Comment: comment}}})
+
+	markdown := g.formatMentions(FormatMarkdown)
+	if markdown != "- **[WEB-7]** Checkout page — Sam at 14:05: @Alex could you review the rollout plan?\n" {
+		t.Errorf("unexpected markdown mentions:\n%s", markdown)
+	}
+	if html := g.formatMentions(FormatHTML); !strings.Contains(html, "<span class=\"key\">WEB-7</span>") {
+		t.Errorf("expected the issue key in the HTML mentions, got:\n%s", html)
+	}
+
+	if content := NewGenerator(&Config{}).formatMentions(FormatConsole); content != "" {
+		t.Errorf("expected no section without mentions, got:\n%s", content)
+	}
+}
@@ -0,0 +1,81 @@
+package report
+
+import (
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// readingWordsPerMinute is the reading speed a time budget assumes
+const readingWordsPerMinute = 200
+
+// charsPerWord converts words to characters, the rough estimate the LLM prompts use too
+const charsPerWord = 5
+
+// Words each part of a report takes to read
+const (
+	headlineWords   = 10  // One issue line: key, project and summary
+	detailWords     = 25  // The AI summary, status and update lines of a detailed issue
+	minExcerptWords = 15  // Shortest comment excerpt worth showing
+	maxExcerptWords = 80  // Longest comment excerpt, even with time to spare
+	minSummaryWords = 25  // Shortest AI summary of the day
+	maxSummaryWords = 250 // Longest AI summary of the day
+)
+
+// detailPlan is how much of a report fits in the reader's time budget
+type detailPlan struct {
+	budget         time.Duration
+	summaryChars   int // Length of the AI summary of the day
+	detailedIssues int // Issues shown with their AI summary, status and latest comment; the others get one line
+	excerptRunes   int // Length of the latest comment excerpt of detailed issues
+}
+
+// planDetail splits the words readable in budget between the AI summary of the day, one line
+// per issue, and the details of as many issues as fit, first come first detailed
+func planDetail(budget time.Duration, issueCount int) detailPlan {
+	words := int(budget.Minutes() * readingWordsPerMinute)
+
+	summaryWords := words * 2 / 5
+	if summaryWords < minSummaryWords {
+		summaryWords = minSummaryWords
+	} else if summaryWords > maxSummaryWords {
+		summaryWords = maxSummaryWords
+	}
+	plan := detailPlan{budget: budget, summaryChars: summaryWords * charsPerWord}
+
+	remaining := words - summaryWords - issueCount*headlineWords
+	if remaining <= 0 {
+		return plan
+	}
+	plan.detailedIssues = min(issueCount, remaining/(detailWords+minExcerptWords))
+	if plan.detailedIssues == 0 {
+		return plan
+	}
+	excerptWords := min(maxExcerptWords, remaining/plan.detailedIssues-detailWords)
+	plan.excerptRunes = excerptWords * charsPerWord
+	return plan
+}
+
+// planReport adapts the detail of the report to the time budget, if there is one, noting
+// when only some issues fit
+func (g *Generator) planReport(issues []jira.Issue) {
+	if g.config.TimeBudget <= 0 {
+		return
+	}
+	plan := planDetail(g.config.TimeBudget, len(issues))
+	g.plan = &plan
+	if plan.detailedIssues < len(issues) {
+		g.warnings.Add(SeverityInfo, "Time budget", "To read in %v, details are shown for %d of %d issues", plan.budget, plan.detailedIssues, len(issues))
+	}
+}
+
+// nextIssueDetail returns whether the next issue rendered gets its AI summary and its details,
+// and the length of its comment excerpt
+func (g *Generator) nextIssueDetail() (summarize, detailed bool, excerptRunes int) {
+	if g.plan == nil {
+		return g.config.LLMEnabled, g.config.Detailed, g.config.MaxCommentExcerpt
+	}
+	g.issuesShown++
+	detailed = g.issuesShown <= g.plan.detailedIssues
+	return g.config.LLMEnabled && detailed, detailed, g.plan.excerptRunes
+}
@@ -0,0 +1,82 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+func TestPlanDetailScalesWithBudget(t *testing.T) {
+	short := planDetail(30*time.Second, 5)
+	long := planDetail(3*time.Minute, 5)
+
+	if short.summaryChars >= long.summaryChars {
+		t.Errorf("expected a longer summary for a longer budget, got %d and %d", short.summaryChars, long.summaryChars)
+	}
+	if short.detailedIssues >= long.detailedIssues {
+		t.Errorf("expected more detailed issues for a longer budget, got %d and %d", short.detailedIssues, long.detailedIssues)
+	}
+	if long.detailedIssues != 5 || long.excerptRunes > maxExcerptWords*charsPerWord {
+		t.Errorf("expected every issue detailed within the excerpt cap, got %+v", long)
+	}
+}
+
+func TestPlanDetailKeepsHeadlinesWhenBudgetIsTight(t *testing.T) {
+	plan := planDetail(10*time.Second, 20)
+	if plan.detailedIssues != 0 || plan.excerptRunes != 0 {
+		t.Errorf("expected no details, got %+v", plan)
+	}
+	if plan.summaryChars != minSummaryWords*charsPerWord {
+		t.Errorf("expected the shortest summary, got %d", plan.summaryChars)
+	}
+}
+
+func TestTimeBudgetDetailsFirstIssues(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+	var issues []IssueWithComments
+	for i := 1; i <= 6; i++ {
+		issues = append(issues, IssueWithComments{
+			Issue: jira.Issue{Key: fmt.Sprintf("OPS-%d", i), Fields: jira.Fields{
+				Summary: "Rotate certificates",
+				Status:  jira.Status{Name: "In Progress", Category: jira.StatusCategory{Key: "indeterminate"}},
+				Updated: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)},
+			}},
+			Comments: []jira.Comment{{
+				ID:      "1",
+				Body:    jira.JiraDescription{Text: strings.Repeat("Rotated the staging certificates and updated the listeners. ", 10)},
+				Created: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)},
+			}},
+		})
+	}
+
+	config := &Config{Format: "markdown", IncludeToday: true, TimeBudget: 90 * time.Second}
+	g := &Generator{config: config, summarizer: llm.NewDisabledSummarizer()}
+	plan := planDetail(config.TimeBudget, len(issues))
+	if plan.detailedIssues == 0 || plan.detailedIssues >= len(issues) {
+		t.Fatalf("expected some issues detailed, got %+v", plan)
+	}
+
+	content, err := g.GenerateWithComments(issues, nil, targetDate)
+	if err != nil {
+		t.Fatalf("GenerateWithComments() error = %v", err)
+	}
+	if got := strings.Count(content, "Latest comment:"); got != plan.detailedIssues {
+		t.Errorf("expected %d detailed issues, got %d:\n%s", plan.detailedIssues, got, content)
+	}
+	if !strings.Contains(content, fmt.Sprintf("details are shown for %d of 6 issues", plan.detailedIssues)) {
+		t.Errorf("expected a note about the issues left out:\n%s", content)
+	}
+
+	config.TimeBudget = 0
+	content, err = g.GenerateWithComments(issues, nil, targetDate)
+	if err != nil {
+		t.Fatalf("GenerateWithComments() error = %v", err)
+	}
+	if strings.Contains(content, "Latest comment:") || strings.Contains(content, "Time budget") {
+		t.Errorf("expected the fixed layout without a budget:\n%s", content)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -29,6 +30,7 @@ type ReportCache struct {
 	LLMUsed           bool                     `json:"llm_used"`
 	GenerationTimeMs  int64                    `json:"generation_time_ms"`
 	ExportPaths       map[string]string        `json:"export_paths,omitempty"` // format -> file path
+	Warnings          Warnings                 `json:"warnings,omitempty"`     // Notes listed at the end of the report
 }
 
 // ReportCacheIndex maintains an index of all cached reports
@@ -70,19 +72,44 @@ func NewCacheManager() (*CacheManager, error) {
 	return &CacheManager{cacheDir: cacheDir}, nil
 }
 
+// reportIDPattern matches the IDs GenerateReportID creates, e.g. 2024-06-05_3f9a1c2e7b40
+var reportIDPattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}_[0-9a-f]{12}$`)
+
 // GenerateReportID creates a unique ID for a report based on input parameters
 func (cm *CacheManager) GenerateReportID(config *Config, issues []jira.Issue, comments map[string][]jira.Comment, worklogs []jira.WorklogEntry, targetDate time.Time) string {
 	// Create a hash based on all input parameters that affect the report
 	hasher := sha256.New()
 	
-	// Include date
+	// Include date, and the first day of a multi-day report
 	hasher.Write([]byte(targetDate.Format("2006-01-02")))
+	if !config.From.IsZero() {
+		hasher.Write([]byte(config.From.Format("2006-01-02")))
+	}
+
+	// Include when the data of an offline report was synced, as its header says so
+	if !config.DataAsOf.IsZero() {
+		hasher.Write([]byte("offline:" + config.DataAsOf.Format(time.RFC3339)))
+	}
 	
 	// Include config parameters that affect output
-	configData := fmt.Sprintf("format:%s|llm:%t|mode:%s|model:%s|detailed:%t|debug:%t|quality:%t|verbose:%t|field:%s",
-		config.Format, config.LLMEnabled, config.LLMMode, config.LLMModel, 
-		config.Detailed, config.Debug, config.ShowQuality, config.Verbose, config.GroupByField)
+	configData := fmt.Sprintf("format:%s|llm:%t|mode:%s|model:%s|language:%s|detailed:%t|debug:%t|quality:%t|verbose:%t|field:%s|fields:%v|groups:%t|themes:%t|structured:%t|next:%t|layout:%s|variance:%d|workday:%g|columns:%s|meetings:%s|budget:%v|duplicates:%d|risk:%v/%d|statuses:%v|hide:%+v",
+		config.Format, config.LLMEnabled, config.LLMMode, config.LLMModel, config.LLMLanguage,
+		config.Detailed, config.Debug, config.ShowQuality, config.Verbose, config.GroupByField, config.CustomFields, config.GroupSummaries, config.Themes, config.Structured, config.NextSteps, config.Layout, config.VarianceThreshold, config.WorkdayHours,
+		strings.Join(config.BoardColumns.ColumnNames(), ","), config.MeetingsSummary, config.TimeBudget, config.DuplicateSimilarity, config.RiskWeights, config.RiskMinScore, config.StatusStyles, config.Hide)
 	hasher.Write([]byte(configData))
+
+	// Include the custom template, so editing it regenerates the report
+	if config.TemplatePath != "" {
+		hasher.Write([]byte(config.TemplatePath))
+		if content, err := readReportTemplate(config.TemplatePath); err == nil {
+			hasher.Write(content)
+		}
+	}
+
+	// Include the notes passed in, e.g. from the sync, as they are listed in the report
+	for _, warning := range config.Warnings {
+		hasher.Write([]byte(warning.String()))
+	}
 	
 	// Include issue IDs and update times (sorted for consistency)
 	var issueData []string
@@ -105,11 +132,28 @@ func (cm *CacheManager) GenerateReportID(config *Config, issues []jira.Issue, co
 	// Include worklog data (sorted for consistency)
 	var worklogData []string
 	for _, worklog := range worklogs {
-		worklogData = append(worklogData, fmt.Sprintf("%s:%s", worklog.IssueID, worklog.Started.Time.Format(time.RFC3339)))
+		worklogData = append(worklogData, fmt.Sprintf("%s:%s:%d", worklog.IssueID, worklog.Started.Time.Format(time.RFC3339), worklog.TimeSpentSeconds))
 	}
 	sort.Strings(worklogData)
 	hasher.Write([]byte(strings.Join(worklogData, "|")))
 	
+	// Include GitHub, GitLab, Trello and Asana activity data (sorted for consistency)
+	var activityData []string
+	for _, activity := range config.GitHubActivity {
+		activityData = append(activityData, fmt.Sprintf("github:%s:%s:%s:%s", activity.Type, activity.ID, activity.State, activity.UpdatedAt.Format(time.RFC3339)))
+	}
+	for _, activity := range config.GitLabActivity {
+		activityData = append(activityData, fmt.Sprintf("gitlab:%s:%s:%s:%s", activity.Type, activity.ID, activity.State, activity.UpdatedAt.Format(time.RFC3339)))
+	}
+	for _, activity := range config.TrelloActivity {
+		activityData = append(activityData, fmt.Sprintf("trello:%s:%s", activity.Type, activity.ID))
+	}
+	for _, activity := range config.AsanaActivity {
+		activityData = append(activityData, fmt.Sprintf("asana:%s:%s", activity.Type, activity.ID))
+	}
+	sort.Strings(activityData)
+	hasher.Write([]byte(strings.Join(activityData, "|")))
+	
 	hash := hex.EncodeToString(hasher.Sum(nil))
 	
 	// Create a readable ID with date and hash prefix
@@ -118,7 +162,7 @@ func (cm *CacheManager) GenerateReportID(config *Config, issues []jira.Issue, co
 
 // SaveReport saves a generated report to cache
 func (cm *CacheManager) SaveReport(reportID string, config *Config, content string, targetDate time.Time, 
-	issueCount, commentCount, worklogCount int, generationTimeMs int64, inputHash string) error {
+	issueCount, commentCount, worklogCount int, generationTimeMs int64, inputHash string, warnings Warnings) error {
 	
 	cache := &ReportCache{
 		ID:               reportID,
@@ -134,6 +178,7 @@ func (cm *CacheManager) SaveReport(reportID string, config *Config, content stri
 		LLMUsed:          config.LLMEnabled,
 		GenerationTimeMs: generationTimeMs,
 		ExportPaths:      make(map[string]string),
+		Warnings:         warnings,
 	}
 	
 	// Save the full report cache
@@ -172,6 +217,37 @@ func (cm *CacheManager) LoadReport(reportID string) (*ReportCache, error) {
 	return &cache, nil
 }
 
+// ImportReport stores a report produced on another machine.
+// An existing local copy is kept unless the imported one was generated later. Reports whose ID
+// was not created by GenerateReportID are refused, as the ID names the cache file.
+func (cm *CacheManager) ImportReport(data []byte) (bool, error) {
+	var cache ReportCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return false, fmt.Errorf("failed to unmarshal report cache: %w", err)
+	}
+	if cache.ID == "" {
+		return false, fmt.Errorf("report cache has no ID")
+	}
+	if !reportIDPattern.MatchString(cache.ID) {
+		return false, fmt.Errorf("report cache has an invalid ID %q", cache.ID)
+	}
+
+	if existing, err := cm.LoadReport(cache.ID); err == nil && !cache.GeneratedAt.After(existing.GeneratedAt) {
+		return false, nil
+	}
+
+	cacheFile := filepath.Join(cm.cacheDir, fmt.Sprintf("%s.json", cache.ID))
+	if err := os.WriteFile(cacheFile, data, 0644); err != nil {
+		return false, fmt.Errorf("failed to write report cache: %w", err)
+	}
+
+	if err := cm.updateIndex(&cache); err != nil {
+		return false, fmt.Errorf("failed to update cache index: %w", err)
+	}
+
+	return true, nil
+}
+
 // FindReport finds a cached report for the given parameters
 func (cm *CacheManager) FindReport(config *Config, issues []jira.Issue, comments map[string][]jira.Comment, worklogs []jira.WorklogEntry, targetDate time.Time) (*ReportCache, error) {
 	reportID := cm.GenerateReportID(config, issues, comments, worklogs, targetDate)
@@ -0,0 +1,34 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestImportReportRefusesInvalidIDs(t *testing.T) {
+	dir := t.TempDir()
+	cm := &CacheManager{cacheDir: filepath.Join(dir, "reports")}
+	if err := os.MkdirAll(cm.cacheDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{"../escaped", "2024-06-05_3f9a1c2e7b40/../../escaped", "report"} {
+		if _, err := cm.ImportReport([]byte(`{"id": "` + id + `"}`)); err == nil {
+			t.Errorf("expected the ID %q to be refused", id)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "escaped.json")); !os.IsNotExist(err) {
+		t.Errorf("expected no file outside the cache directory, got %v", err)
+	}
+
+	id := cm.GenerateReportID(&Config{}, nil, nil, nil, time.Date(2024, 6, 5, 0, 0, 0, 0, time.UTC))
+	imported, err := cm.ImportReport([]byte(`{"id": "` + id + `", "generated_at": "2024-06-05T17:00:00Z"}`))
+	if err != nil || !imported {
+		t.Fatalf("expected a generated ID to be imported, got %v, %v", imported, err)
+	}
+	if _, err := cm.LoadReport(id); err != nil {
+		t.Errorf("expected the imported report to be cached, got %v", err)
+	}
+}
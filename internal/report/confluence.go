@@ -0,0 +1,439 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// teamPageAttempts is how often a team page update is tried when another member's update
+// wins the race for the page version
+const teamPageAttempts = 5
+
+var (
+	// confluenceAnchorUnsafe matches what anchor names of team page sections leave out
+	confluenceAnchorUnsafe = regexp.MustCompile(`[^A-Za-z0-9]+`)
+	// confluenceHeadingTag matches the heading tags demoted in team page sections
+	confluenceHeadingTag = regexp.MustCompile(`<(/?)h([1-5])>`)
+)
+
+// ConfluenceTarget describes where the Confluence export publishes reports
+type ConfluenceTarget struct {
+	BaseURL     string // Confluence base URL, e.g. https://your-instance.atlassian.net/wiki
+	Email       string
+	Token       string `json:"-"`
+	SpaceKey    string
+	ParentID    string // Page the report pages are created under (empty for the space root)
+	TitlePrefix string // Page title before the date, e.g. "Daily Standup Report"
+	Mode        string // "page" for a page per report, "team" for a section on a page shared by the team
+	Member      string // Heading of your section of a team page (empty for your Atlassian display name)
+}
+
+// confluencePage is the subset of a Confluence content object used by the export
+type confluencePage struct {
+	ID        string               `json:"id,omitempty"`
+	Type      string               `json:"type"`
+	Title     string               `json:"title"`
+	Space     confluenceSpace      `json:"space"`
+	Ancestors []confluenceAncestor `json:"ancestors,omitempty"`
+	Version   *confluenceVersion   `json:"version,omitempty"`
+	Body      *confluenceBody      `json:"body,omitempty"`
+	Links     struct {
+		WebUI string `json:"webui"`
+	} `json:"_links"`
+}
+
+type confluenceSpace struct {
+	Key string `json:"key"`
+}
+
+type confluenceAncestor struct {
+	ID string `json:"id"`
+}
+
+type confluenceVersion struct {
+	Number int `json:"number"`
+}
+
+type confluenceBody struct {
+	Storage struct {
+		Value          string `json:"value"`
+		Representation string `json:"representation"`
+	} `json:"storage"`
+}
+
+// ExportToConfluence publishes the report as a Confluence page, creating it on the first
+// export of a day and updating it afterwards. It returns the URL of the page.
+func (g *Generator) ExportToConfluence(ctx context.Context, reportContent string, targetDate time.Time) (string, error) {
+	if !g.config.ExportEnabled {
+		return "", nil
+	}
+
+	target := g.config.Confluence
+	if target == nil || target.BaseURL == "" || target.SpaceKey == "" {
+		return "", fmt.Errorf("Confluence export requires report.export.confluence.base_url and space_key")
+	}
+	if target.Email == "" || target.Token == "" {
+		return "", fmt.Errorf("Confluence export requires Atlassian credentials. Run 'my-day auth' first")
+	}
+	if g.config.Format == "html" {
+		return "", fmt.Errorf("Confluence export requires console or markdown format, not html")
+	}
+
+	var body string
+	if g.config.Format == "markdown" {
+		repaired, problems := RepairMarkdown(reportContent)
+		if len(problems) > 0 {
+			slog.Warn("Repaired markdown problems before export", "problems", len(problems))
+		}
+		body = markdownToConfluence(repaired)
+	} else {
+		body = "<pre>" + html.EscapeString(reportContent) + "</pre>"
+	}
+
+	title := g.ConfluencePageTitle(targetDate)
+
+	client := &confluenceClient{
+		baseURL:    strings.TrimSuffix(target.BaseURL, "/"),
+		email:      target.Email,
+		token:      target.Token,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+
+	switch target.Mode {
+	case "", "page":
+	case "team":
+		return g.exportTeamSection(ctx, client, target, title, body)
+	default:
+		return "", fmt.Errorf("unknown Confluence export mode %q (use page or team)", target.Mode)
+	}
+
+	existing, err := client.findPage(ctx, target.SpaceKey, title)
+	if err != nil {
+		return "", err
+	}
+
+	page := &confluencePage{Type: "page", Title: title, Space: confluenceSpace{Key: target.SpaceKey}, Body: &confluenceBody{}}
+	page.Body.Storage.Value = body
+	page.Body.Storage.Representation = "storage"
+
+	var published *confluencePage
+	if existing == nil {
+		if target.ParentID != "" {
+			page.Ancestors = []confluenceAncestor{{ID: target.ParentID}}
+		}
+		published, err = client.send(ctx, "POST", "/rest/api/content", page)
+	} else {
+		page.ID = existing.ID
+		page.Version = &confluenceVersion{Number: 1}
+		if existing.Version != nil {
+			page.Version.Number = existing.Version.Number + 1
+		}
+		published, err = client.send(ctx, "PUT", "/rest/api/content/"+existing.ID, page)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return client.baseURL + published.Links.WebUI, nil
+}
+
+// exportTeamSection adds your section to the team page of the day, creating the page if you
+// are the first to export. Each section sits between anchor macros named after your account,
+// so exporting again replaces your section and leaves the others alone. Concurrent updates
+// are detected with the page version and retried on the latest page.
+func (g *Generator) exportTeamSection(ctx context.Context, client *confluenceClient, target *ConfluenceTarget, title, body string) (string, error) {
+	user, err := client.currentUser(ctx)
+	if err != nil {
+		return "", err
+	}
+	member := target.Member
+	if member == "" {
+		member = user.DisplayName
+	}
+	section := fmt.Sprintf("<h2>%s</h2>\n<p><em>Updated %s</em></p>\n%s", html.EscapeString(member), time.Now().Format("15:04"), demoteHeadings(body))
+	account := user.AccountID
+	if account == "" {
+		account = client.email
+	}
+	key := teamSectionKey(account)
+
+	for attempt := 1; ; attempt++ {
+		existing, err := client.findPage(ctx, target.SpaceKey, title)
+		if err != nil {
+			return "", err
+		}
+
+		page := &confluencePage{Type: "page", Title: title, Space: confluenceSpace{Key: target.SpaceKey}, Body: &confluenceBody{}}
+		page.Body.Storage.Representation = "storage"
+
+		var published *confluencePage
+		if existing == nil {
+			page.Body.Storage.Value = mergeTeamSection("", key, section)
+			if target.ParentID != "" {
+				page.Ancestors = []confluenceAncestor{{ID: target.ParentID}}
+			}
+			published, err = client.send(ctx, "POST", "/rest/api/content", page)
+		} else {
+			current := ""
+			if existing.Body != nil {
+				current = existing.Body.Storage.Value
+			}
+			page.Body.Storage.Value = mergeTeamSection(current, key, section)
+			page.ID = existing.ID
+			page.Version = &confluenceVersion{Number: 1}
+			if existing.Version != nil {
+				page.Version.Number = existing.Version.Number + 1
+			}
+			published, err = client.send(ctx, "PUT", "/rest/api/content/"+existing.ID, page)
+		}
+		if err == nil {
+			return client.baseURL + published.Links.WebUI, nil
+		}
+
+		// A conflict means another member updated the page first, and a bad request on create
+		// that they created it first: both are retried on the page they left
+		var apiErr *confluenceAPIError
+		lostRace := errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusConflict || (existing == nil && apiErr.StatusCode == http.StatusBadRequest))
+		if !lostRace || attempt == teamPageAttempts {
+			return "", err
+		}
+	}
+}
+
+// teamSectionKey returns the anchor name of a member's section, made of characters anchors keep
+func teamSectionKey(account string) string {
+	return "my-day-" + confluenceAnchorUnsafe.ReplaceAllString(account, "-")
+}
+
+// teamSectionAnchor returns an anchor macro marking the start or end of a section
+func teamSectionAnchor(name string) string {
+	return `<ac:structured-macro ac:name="anchor"><ac:parameter ac:name="">` + name + `</ac:parameter></ac:structured-macro>`
+}
+
+// findAnchor returns the bounds of the anchor macro with the given name. Confluence adds
+// attributes such as ac:macro-id to stored macros, so it is found by its name only.
+func findAnchor(page, name string) (start, end int, ok bool) {
+	i := strings.Index(page, ">"+name+"</ac:parameter>")
+	if i < 0 {
+		return 0, 0, false
+	}
+	start = strings.LastIndex(page[:i], "<ac:structured-macro")
+	closing := strings.Index(page[i:], "</ac:structured-macro>")
+	if start < 0 || closing < 0 {
+		return 0, 0, false
+	}
+	return start, i + closing + len("</ac:structured-macro>"), true
+}
+
+// mergeTeamSection replaces the section of key on a team page, or appends it if the page
+// has none yet
+func mergeTeamSection(page, key, section string) string {
+	endKey := key + "-end"
+	wrapped := teamSectionAnchor(key) + "\n" + section + teamSectionAnchor(endKey) + "\n"
+	if start, _, ok := findAnchor(page, key); ok {
+		if _, end, ok := findAnchor(page[start:], endKey); ok {
+			return page[:start] + wrapped + page[start+end:]
+		}
+	}
+	return page + wrapped
+}
+
+// demoteHeadings moves headings one level down, so a report's headings nest under a section heading
+func demoteHeadings(body string) string {
+	return confluenceHeadingTag.ReplaceAllStringFunc(body, func(tag string) string {
+		level, _ := strconv.Atoi(tag[len(tag)-2 : len(tag)-1])
+		return strings.Replace(tag, strconv.Itoa(level), strconv.Itoa(level+1), 1)
+	})
+}
+
+// confluenceUser is the subset of a Confluence user used by team pages
+type confluenceUser struct {
+	AccountID   string `json:"accountId"`
+	DisplayName string `json:"displayName"`
+}
+
+// confluenceAPIError is an error response of the Confluence API
+type confluenceAPIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *confluenceAPIError) Error() string {
+	if e.Message != "" {
+		return "Confluence API error: " + e.Message
+	}
+	return fmt.Sprintf("Confluence API error: status %d", e.StatusCode)
+}
+
+// confluenceClient is a minimal Confluence Cloud REST API client authenticated with an API token
+type confluenceClient struct {
+	baseURL    string
+	email      string
+	token      string
+	httpClient *http.Client
+}
+
+// findPage returns the page with the given title in a space, or nil if there is none
+func (c *confluenceClient) findPage(ctx context.Context, spaceKey, title string) (*confluencePage, error) {
+	params := url.Values{
+		"spaceKey": {spaceKey},
+		"title":    {title},
+		"type":     {"page"},
+		"expand":   {"version,body.storage"},
+	}
+
+	var result struct {
+		Results []confluencePage `json:"results"`
+	}
+	if err := c.do(ctx, "GET", "/rest/api/content?"+params.Encode(), nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to look up Confluence page: %w", err)
+	}
+	if len(result.Results) == 0 {
+		return nil, nil
+	}
+	return &result.Results[0], nil
+}
+
+// currentUser returns the account the API token belongs to
+func (c *confluenceClient) currentUser(ctx context.Context) (*confluenceUser, error) {
+	var user confluenceUser
+	if err := c.do(ctx, "GET", "/rest/api/user/current", nil, &user); err != nil {
+		return nil, fmt.Errorf("failed to get Confluence user: %w", err)
+	}
+	return &user, nil
+}
+
+// send creates or updates a page and returns the page Confluence stored
+func (c *confluenceClient) send(ctx context.Context, method, endpoint string, page *confluencePage) (*confluencePage, error) {
+	var published confluencePage
+	if err := c.do(ctx, method, endpoint, page, &published); err != nil {
+		return nil, fmt.Errorf("failed to publish Confluence page: %w", err)
+	}
+	return &published, nil
+}
+
+func (c *confluenceClient) do(ctx context.Context, method, endpoint string, payload, result interface{}) error {
+	var body bytes.Buffer
+	if payload != nil {
+		if err := json.NewEncoder(&body).Encode(payload); err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+endpoint, &body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.SetBasicAuth(c.email, c.token)
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp struct {
+			Message string `json:"message"`
+		}
+		json.NewDecoder(resp.Body).Decode(&errResp)
+		return &confluenceAPIError{StatusCode: resp.StatusCode, Message: errResp.Message}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// markdownToConfluence converts report markdown to Confluence storage format (XHTML)
+func markdownToConfluence(markdown string) string {
+	var out strings.Builder
+	writeConfluenceBlocks(&out, parseMarkdownBlocks(markdown))
+	return out.String()
+}
+
+func writeConfluenceBlocks(out *strings.Builder, blocks []*markdownBlock) {
+	for i := 0; i < len(blocks); i++ {
+		block := blocks[i]
+		switch block.Kind {
+		case "heading":
+			out.WriteString(fmt.Sprintf("<h%d>%s</h%d>\n", block.Level, confluenceInline(block.Text), block.Level))
+		case "paragraph":
+			out.WriteString("<p>" + confluenceInline(block.Text) + "</p>\n")
+		case "quote":
+			out.WriteString("<blockquote><p>" + confluenceInline(block.Text) + "</p></blockquote>\n")
+		case "rule":
+			out.WriteString("<hr />\n")
+		case "code":
+			cdata := strings.ReplaceAll(block.Text, "]]>", "]]]]><![CDATA[>")
+			out.WriteString(`<ac:structured-macro ac:name="code"><ac:plain-text-body><![CDATA[` + cdata + "]]></ac:plain-text-body></ac:structured-macro>\n")
+		case "table":
+			out.WriteString("<table><tbody>\n")
+			for r, row := range block.Rows {
+				cell := "td"
+				if r == 0 {
+					cell = "th"
+				}
+				out.WriteString("<tr>")
+				for _, value := range row {
+					out.WriteString("<" + cell + ">" + confluenceInline(value) + "</" + cell + ">")
+				}
+				out.WriteString("</tr>\n")
+			}
+			out.WriteString("</tbody></table>\n")
+		case "list_item":
+			// Consecutive items of the same kind form one list
+			tag := "ul"
+			if block.Ordered {
+				tag = "ol"
+			}
+			out.WriteString("<" + tag + ">\n")
+			for ; i < len(blocks) && blocks[i].Kind == "list_item" && blocks[i].Ordered == block.Ordered; i++ {
+				out.WriteString("<li>" + confluenceInline(blocks[i].Text))
+				if len(blocks[i].Children) > 0 {
+					writeConfluenceBlocks(out, blocks[i].Children)
+				}
+				out.WriteString("</li>\n")
+			}
+			i--
+			out.WriteString("</" + tag + ">\n")
+		}
+	}
+}
+
+// confluenceInline escapes text and converts inline markdown (code, links, bold, italic) to XHTML
+func confluenceInline(text string) string {
+	var out strings.Builder
+	for _, span := range parseMarkdownInline(text) {
+		escaped := html.EscapeString(span.Text)
+		switch {
+		case span.Code:
+			out.WriteString("<code>" + escaped + "</code>")
+		case span.Link != "":
+			out.WriteString(`<a href="` + html.EscapeString(span.Link) + `">` + escaped + "</a>")
+		case span.Bold:
+			out.WriteString("<strong>" + escaped + "</strong>")
+		case span.Italic:
+			out.WriteString("<em>" + escaped + "</em>")
+		default:
+			out.WriteString(escaped)
+		}
+	}
+	return out.String()
+}
@@ -0,0 +1,222 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarkdownToConfluence(t *testing.T) {
+	markdown := strings.Join([]string{
+		"# Daily Standup Report - July 15, 2024",
+		"",
+		"*Issues with your comments today*",
+		"",
+		"- 🔄 **[OPS-101]** Migrate <runners>",
+		"  - Status: `In Progress`",
+		"- ✅ **[OPS-102]** See [PR](https://github.com/acme/infra/pull/42?a=1&b=2)",
+		"",
+		"| Issue | Spent |",
+		"|-------|-------|",
+		"| OPS-101 | 4h |",
+		"",
+		"```",
+		"kubectl get pods",
+		"```",
+		"",
+		"---",
+	}, "\n")
+
+	expected := strings.Join([]string{
+		"<h1>Daily Standup Report - July 15, 2024</h1>",
+		"<p><em>Issues with your comments today</em></p>",
+		"<ul>",
+		"<li>🔄 <strong>[OPS-101]</strong> Migrate &lt;runners&gt;<ul>",
+		"<li>Status: <code>In Progress</code></li>",
+		"</ul>",
+		"</li>",
+		`<li>✅ <strong>[OPS-102]</strong> See <a href="https://github.com/acme/infra/pull/42?a=1&amp;b=2">PR</a></li>`,
+		"</ul>",
+		"<table><tbody>",
+		"<tr><th>Issue</th><th>Spent</th></tr>",
+		"<tr><td>OPS-101</td><td>4h</td></tr>",
+		"</tbody></table>",
+		`<ac:structured-macro ac:name="code"><ac:plain-text-body><![CDATA[kubectl get pods]]></ac:plain-text-body></ac:structured-macro>`,
+		"<hr />",
+		"",
+	}, "\n")
+
+	if got := markdownToConfluence(markdown); got != expected {
+		t.Errorf("unexpected storage format\n--- expected ---\n%s\n--- actual ---\n%s", expected, got)
+	}
+}
+
+func TestExportToConfluence(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		existing      string // JSON search results
+		expectMethod  string
+		expectPath    string
+		expectVersion int
+	}{
+		{
+			name:         "creates the page on the first export",
+			existing:     `{"results": []}`,
+			expectMethod: "POST",
+			expectPath:   "/wiki/rest/api/content",
+		},
+		{
+			name:          "updates the existing page",
+			existing:      `{"results": [{"id": "777", "title": "Standup - 2024-07-15", "version": {"number": 3}}]}`,
+			expectMethod:  "PUT",
+			expectPath:    "/wiki/rest/api/content/777",
+			expectVersion: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var published confluencePage
+			var method, path string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if user, token, ok := r.BasicAuth(); !ok || user != "me@example.com" || token != "secret" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if r.Method == "GET" {
+					if r.URL.Query().Get("title") != "Standup - 2024-07-15" || r.URL.Query().Get("spaceKey") != "OPS" {
+						t.Errorf("unexpected page lookup: %s", r.URL.RawQuery)
+					}
+					w.Write([]byte(tt.existing))
+					return
+				}
+				method, path = r.Method, r.URL.Path
+				if err := json.NewDecoder(r.Body).Decode(&published); err != nil {
+					t.Fatalf("failed to decode page: %v", err)
+				}
+				w.Write([]byte(`{"id": "777", "_links": {"webui": "/spaces/OPS/pages/777"}}`))
+			}))
+			defer server.Close()
+
+			config := goldenConfig("markdown")
+			config.ExportEnabled = true
+			config.Confluence = &ConfluenceTarget{
+				BaseURL:     server.URL + "/wiki",
+				Email:       "me@example.com",
+				Token:       "secret",
+				SpaceKey:    "OPS",
+				ParentID:    "100",
+				TitlePrefix: "Standup",
+			}
+
+			pageURL, err := NewGenerator(config).ExportToConfluence(context.Background(), "# Report\n", targetDate)
+			if err != nil {
+				t.Fatalf("ExportToConfluence() error = %v", err)
+			}
+			if pageURL != server.URL+"/wiki/spaces/OPS/pages/777" {
+				t.Errorf("unexpected page URL %s", pageURL)
+			}
+			if method != tt.expectMethod || path != tt.expectPath {
+				t.Errorf("expected %s %s, got %s %s", tt.expectMethod, tt.expectPath, method, path)
+			}
+			if published.Body == nil || published.Body.Storage.Value != "<h1>Report</h1>\n" {
+				t.Errorf("unexpected page body: %+v", published.Body)
+			}
+			if tt.expectVersion > 0 && (published.Version == nil || published.Version.Number != tt.expectVersion) {
+				t.Errorf("expected version %d, got %+v", tt.expectVersion, published.Version)
+			}
+			if tt.expectVersion == 0 && (len(published.Ancestors) != 1 || published.Ancestors[0].ID != "100") {
+				t.Errorf("expected new page under parent 100, got %+v", published.Ancestors)
+			}
+		})
+	}
+}
+
+func TestMergeTeamSection(t *testing.T) {
+	page := mergeTeamSection("", "my-day-alice", "<h2>Alice</h2>\n<p>first</p>\n")
+	page = mergeTeamSection(page, "my-day-bob", "<h2>Bob</h2>\n")
+
+	// Confluence adds attributes to the macros it stores
+	page = strings.ReplaceAll(page, `ac:name="anchor">`, `ac:name="anchor" ac:schema-version="1" ac:macro-id="42">`)
+
+	page = mergeTeamSection(page, "my-day-alice", "<h2>Alice</h2>\n<p>second</p>\n")
+	if strings.Contains(page, "first") || strings.Count(page, "<h2>Alice</h2>") != 1 {
+		t.Errorf("expected Alice's section replaced:\n%s", page)
+	}
+	if !strings.Contains(page, "<h2>Bob</h2>") || strings.Index(page, "Alice") > strings.Index(page, "Bob") {
+		t.Errorf("expected Bob's section kept after Alice's:\n%s", page)
+	}
+}
+
+func TestDemoteHeadings(t *testing.T) {
+	if got := demoteHeadings("<h1>Report</h1>\n<h2>Done</h2>\n<h6>Deep</h6>"); got != "<h2>Report</h2>\n<h3>Done</h3>\n<h6>Deep</h6>" {
+		t.Errorf("unexpected headings %q", got)
+	}
+}
+
+func TestExportToConfluenceTeamPageRetriesConflicts(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC)
+	stored := mergeTeamSection("", "my-day-bob", "<h2>Bob</h2>\n")
+	version := 3
+	conflicts := 1
+	var published confluencePage
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/wiki/rest/api/user/current":
+			w.Write([]byte(`{"accountId": "557058:abc", "displayName": "Alice Smith"}`))
+		case r.Method == "GET":
+			page := confluencePage{ID: "777", Title: "Team Standup - 2024-07-15", Version: &confluenceVersion{Number: version}, Body: &confluenceBody{}}
+			page.Body.Storage.Value = stored
+			json.NewEncoder(w).Encode(map[string][]confluencePage{"results": {page}})
+		case r.Method == "PUT":
+			if conflicts > 0 {
+				// Bob updated the page in the meantime
+				conflicts--
+				version++
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"message": "Version must be incremented on update"}`))
+				return
+			}
+			if err := json.NewDecoder(r.Body).Decode(&published); err != nil {
+				t.Fatalf("failed to decode page: %v", err)
+			}
+			w.Write([]byte(`{"id": "777", "_links": {"webui": "/spaces/OPS/pages/777"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	config := goldenConfig("markdown")
+	config.ExportEnabled = true
+	config.Confluence = &ConfluenceTarget{
+		BaseURL:     server.URL + "/wiki",
+		Email:       "alice@example.com",
+		Token:       "secret",
+		SpaceKey:    "OPS",
+		TitlePrefix: "Team Standup",
+		Mode:        "team",
+	}
+
+	if _, err := NewGenerator(config).ExportToConfluence(context.Background(), "# Report\n", targetDate); err != nil {
+		t.Fatalf("ExportToConfluence() error = %v", err)
+	}
+	if published.Version == nil || published.Version.Number != 5 {
+		t.Errorf("expected the retry to update version 4, got %+v", published.Version)
+	}
+	body := published.Body.Storage.Value
+	if !strings.Contains(body, "<h2>Bob</h2>") || !strings.Contains(body, "<h2>Alice Smith</h2>") || !strings.Contains(body, "<h2>Report</h2>") {
+		t.Errorf("expected Bob's and Alice's sections:\n%s", body)
+	}
+	if !strings.Contains(body, ">my-day-557058-abc</ac:parameter>") {
+		t.Errorf("expected Alice's section anchored by account:\n%s", body)
+	}
+}
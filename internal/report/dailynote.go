@@ -0,0 +1,182 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DailyNoteTarget is the Obsidian daily note the report is written into instead of a note of
+// its own
+type DailyNoteTarget struct {
+	Folder  string // Daily notes folder of the vault (default: the export folder)
+	Format  string // Daily note file name format, in Obsidian's Moment.js syntax, e.g. "YYYY-MM-DD"
+	Heading string // Markdown heading the report is written under, e.g. "## Work log"
+}
+
+// momentTokens maps the Moment.js date tokens of Obsidian file name formats to Go layouts,
+// longest first so that "MMMM" is not read as "MM" twice
+var momentTokens = []struct{ moment, layout string }{
+	{"YYYY", "2006"},
+	{"YY", "06"},
+	{"MMMM", "January"},
+	{"MMM", "Jan"},
+	{"MM", "01"},
+	{"M", "1"},
+	{"dddd", "Monday"},
+	{"ddd", "Mon"},
+	{"DD", "02"},
+	{"D", "2"},
+}
+
+// momentLayout converts an Obsidian (Moment.js) date format to a Go time layout. Text in
+// square brackets is kept as is.
+func momentLayout(format string) string {
+	var layout strings.Builder
+	for i := 0; i < len(format); {
+		if format[i] == '[' {
+			if end := strings.IndexByte(format[i:], ']'); end > 0 {
+				layout.WriteString(format[i+1 : i+end])
+				i += end + 1
+				continue
+			}
+		}
+		matched := false
+		for _, token := range momentTokens {
+			if strings.HasPrefix(format[i:], token.moment) {
+				layout.WriteString(token.layout)
+				i += len(token.moment)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			layout.WriteByte(format[i])
+			i++
+		}
+	}
+	return layout.String()
+}
+
+// ObsidianExportPath returns the note ExportToObsidian writes the report of a date to: the
+// daily note when the report is written into it, or else a note of its own
+func (g *Generator) ObsidianExportPath(targetDate time.Time) (string, error) {
+	target := g.config.DailyNote
+	if target == nil {
+		return ExportFilePath(g.config.ExportFolderPath, g.config.ExportFileDate, targetDate)
+	}
+	folder := target.Folder
+	if folder == "" {
+		folder = g.config.ExportFolderPath
+	}
+	format := target.Format
+	if format == "" {
+		format = "YYYY-MM-DD"
+	}
+	return ExportFilePath(folder, momentLayout(format), targetDate)
+}
+
+// writeDailyNote writes the report under the heading of the daily note at path, replacing the
+// report written there earlier and keeping everything else in the note
+func writeDailyNote(path, heading, reportContent string) error {
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read daily note: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create daily notes folder: %w", err)
+	}
+	note := mergeDailyNote(string(existing), heading, reportContent)
+	if err := os.WriteFile(path, []byte(note), 0644); err != nil {
+		return fmt.Errorf("failed to write daily note: %w", err)
+	}
+	return nil
+}
+
+// mergeDailyNote returns note with the report under heading: the section of the heading, up to
+// the next heading of the same or a higher level, is replaced, or added at the end of the note.
+// The report's headings are moved below the heading so the section ends where the report does.
+func mergeDailyNote(note, heading, reportContent string) string {
+	heading = strings.TrimSpace(heading)
+	if heading == "" {
+		heading = "## my-day"
+	}
+	level := markdownHeadingLevel(heading)
+	if level == 0 {
+		heading, level = "## "+heading, 2
+	}
+	section := heading + "\n\n" + strings.TrimSpace(shiftHeadings(reportContent, level)) + "\n"
+
+	lines := strings.Split(note, "\n")
+	start, end := -1, len(lines)
+	inFence := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if start < 0 {
+			if strings.TrimSpace(line) == heading {
+				start = i
+			}
+			continue
+		}
+		if lineLevel := markdownHeadingLevel(line); lineLevel > 0 && lineLevel <= level {
+			end = i
+			break
+		}
+	}
+
+	if start < 0 {
+		note = strings.TrimRight(note, "\n")
+		if note == "" {
+			return section
+		}
+		return note + "\n\n" + section
+	}
+
+	before := strings.Join(lines[:start], "\n")
+	after := strings.Join(lines[end:], "\n")
+	if after != "" {
+		section += "\n" + after
+	}
+	if before == "" {
+		return section
+	}
+	return before + "\n" + section
+}
+
+// markdownHeadingLevel returns the level of an ATX heading line, or 0 if line is not a heading
+func markdownHeadingLevel(line string) int {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || (level < len(line) && line[level] != ' ') {
+		return 0
+	}
+	return level
+}
+
+// shiftHeadings moves the headings of markdown, outside code blocks, by levels, at most to
+// level 6
+func shiftHeadings(markdown string, levels int) string {
+	lines := strings.Split(markdown, "\n")
+	inFence := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if level := markdownHeadingLevel(line); level > 0 && !inFence {
+			shifted := min(level+levels, 6)
+			lines[i] = strings.Repeat("#", shifted) + line[level:]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,84 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMomentLayout(t *testing.T) {
+	date := time.Date(2024, 7, 5, 0, 0, 0, 0, time.UTC)
+	tests := map[string]string{
+		"YYYY-MM-DD":           "2024-07-05",
+		"DD.MM.YY":             "05.07.24",
+		"YYYY-MM-DD dddd":      "2024-07-05 Friday",
+		"MMMM D, YYYY":         "July 5, 2024",
+		"[Journal] YYYY-MM-DD": "Journal 2024-07-05",
+	}
+	for format, want := range tests {
+		if got := date.Format(momentLayout(format)); got != want {
+			t.Errorf("%q: got %q, want %q", format, got, want)
+		}
+	}
+}
+
+func TestMergeDailyNote(t *testing.T) {
+	report := "# Daily Standup Report\n\n## In Progress\n\n- OPS-1\n"
+	section := "## Work log\n\n### Daily Standup Report\n\n#### In Progress\n\n- OPS-1\n"
+
+	// The section is added at the end of a note without it
+	note := "---\ntags: [daily]\n---\n\n## Journal\n\nCoffee with the team.\n"
+	merged := mergeDailyNote(note, "## Work log", report)
+	if want := note + "\n" + section; merged != want {
+		t.Errorf("expected the section appended, got:\n%s", merged)
+	}
+
+	// Exporting again replaces the section and keeps what comes before and after it
+	merged = mergeDailyNote(merged+"\n## Evening\n\nGym.\n", "## Work log", "# Daily Standup Report\n\n- OPS-2\n")
+	for _, want := range []string{"Coffee with the team.\n\n## Work log\n\n### Daily Standup Report\n\n- OPS-2\n\n## Evening\n\nGym.\n"} {
+		if !strings.Contains(merged, want) {
+			t.Errorf("expected %q in:\n%s", want, merged)
+		}
+	}
+	if strings.Contains(merged, "OPS-1") {
+		t.Errorf("expected the earlier report to be replaced, got:\n%s", merged)
+	}
+
+	// Headings in code blocks are left alone
+	if got := shiftHeadings("# Title\n```\n# comment\n```\n", 2); got != "### Title\n```\n# comment\n```\n" {
+		t.Errorf("unexpected shifted headings %q", got)
+	}
+}
+
+func TestExportToDailyNote(t *testing.T) {
+	folder := t.TempDir()
+	notePath := filepath.Join(folder, "Daily", "2024-07-15.md")
+	if err := os.MkdirAll(filepath.Dir(notePath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(notePath, []byte("Morning notes\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := goldenConfig(FormatMarkdown)
+	config.ExportEnabled = true
+	config.ExportFolderPath = folder
+	config.DailyNote = &DailyNoteTarget{Folder: filepath.Join(folder, "Daily"), Format: "YYYY-MM-DD", Heading: "## my-day"}
+	g := NewGenerator(config)
+
+	if path, err := g.ObsidianExportPath(goldenTargetDate); err != nil || path != notePath {
+		t.Fatalf("ObsidianExportPath() = %q (%v), want %q", path, err, notePath)
+	}
+	if err := g.ExportToObsidian("# Daily Standup Report\n\n- OPS-1\n", goldenTargetDate); err != nil {
+		t.Fatalf("ExportToObsidian() error = %v", err)
+	}
+	content, err := os.ReadFile(notePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Morning notes\n\n## my-day\n\n### Daily Standup Report\n\n- OPS-1\n"; string(content) != want {
+		t.Errorf("daily note = %q, want %q", content, want)
+	}
+}
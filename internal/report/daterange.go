@@ -0,0 +1,62 @@
+package report
+
+import (
+	"fmt"
+	"time"
+)
+
+// A multi-day report (--from/--to) covers every day of its range instead of the report date
+// and the day before it. Issues updated and time logged on any day of the range are included,
+// and the headings and LLM prompts name the range.
+
+// isMultiDay reports whether the report covers a range of days rather than a single day
+func (g *Generator) isMultiDay() bool {
+	return !g.config.From.IsZero()
+}
+
+// inRange reports whether t falls on a day of the range of a multi-day report
+func (g *Generator) inRange(t time.Time) bool {
+	day := t.Truncate(24 * time.Hour)
+	return !day.Before(g.config.From.Truncate(24*time.Hour)) && !day.After(g.config.To.Truncate(24*time.Hour))
+}
+
+// reportTitle returns the title of the report, e.g. "Daily Standup Report - June 3, 2024" or,
+// for a multi-day report, "Standup Report - June 3 to June 7, 2024". The title of an offline
+// report ends with the freshness of its data.
+func (g *Generator) reportTitle(targetDate time.Time) string {
+	title := "Daily Standup Report - " + targetDate.Format("January 2, 2006")
+	if g.isMultiDay() {
+		title = "Standup Report - " + formatDateRange(g.config.From, g.config.To)
+	}
+	if freshness := g.dataFreshness(); freshness != "" {
+		title += " (" + freshness + ")"
+	}
+	return title
+}
+
+// dataFreshness returns when the data of an offline report was synced, e.g. "offline, data as
+// of 2024-06-05 17:42", or "" for a report that is not offline
+func (g *Generator) dataFreshness() string {
+	if g.config.DataAsOf.IsZero() {
+		return ""
+	}
+	return "offline, data as of " + g.config.DataAsOf.Local().Format("2006-01-02 15:04")
+}
+
+// reportPeriod returns when the comments of the report were written: "today", or the range of
+// a multi-day report, e.g. "from June 3 to June 7, 2024"
+func (g *Generator) reportPeriod() string {
+	if g.isMultiDay() {
+		return "from " + formatDateRange(g.config.From, g.config.To)
+	}
+	return "today"
+}
+
+// formatDateRange returns a range of days, leaving out the year of the first day when both
+// days are in the same year
+func formatDateRange(from, to time.Time) string {
+	if from.Year() == to.Year() {
+		return fmt.Sprintf("%s to %s", from.Format("January 2"), to.Format("January 2, 2006"))
+	}
+	return fmt.Sprintf("%s to %s", from.Format("January 2, 2006"), to.Format("January 2, 2006"))
+}
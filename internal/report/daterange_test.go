@@ -0,0 +1,73 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+func TestMultiDayReport(t *testing.T) {
+	from := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 7, 0, 0, 0, 0, time.UTC)
+	issue := func(key string, updated time.Time) IssueWithComments {
+		return IssueWithComments{Issue: jira.Issue{ID: key, Key: key, Fields: jira.Fields{
+			Summary: "Work on " + key,
+			Status:  jira.Status{Name: "Done", Category: jira.StatusCategory{Key: "done"}},
+			Updated: jira.JiraTime{Time: updated},
+		}}}
+	}
+	issues := []IssueWithComments{
+		issue("OPS-1", from.Add(9*time.Hour)),
+		issue("OPS-2", to.Add(15*time.Hour)),
+		issue("OPS-3", from.Add(-48*time.Hour)),
+	}
+	worklogs := []jira.WorklogEntry{
+		{IssueID: "OPS-1", TimeSpentSeconds: 3600, Started: jira.JiraTime{Time: from.Add(10 * time.Hour)}},
+		{IssueID: "OPS-2", TimeSpentSeconds: 1800, Started: jira.JiraTime{Time: to.Add(-24 * time.Hour)}},
+		{IssueID: "OPS-3", TimeSpentSeconds: 7200, Started: jira.JiraTime{Time: from.Add(-48 * time.Hour)}},
+	}
+
+	g := &Generator{config: &Config{Format: "markdown", IncludeToday: true, IncludeYesterday: true, From: from, To: to}, summarizer: llm.NewDisabledSummarizer()}
+	content, err := g.GenerateWithComments(issues, worklogs, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(content, "# Standup Report - June 3 to June 7, 2024\n\n*Issues with your comments from June 3 to June 7, 2024*") {
+		t.Errorf("expected the range in the heading, got:\n%s", content)
+	}
+	for _, key := range []string{"OPS-1", "OPS-2"} {
+		if !strings.Contains(content, key) {
+			t.Errorf("expected %s, updated within the range, in the report", key)
+		}
+	}
+	if strings.Contains(content, "OPS-3") {
+		t.Errorf("expected OPS-3, updated before the range, to be left out:\n%s", content)
+	}
+	if got := len(g.filterWorklogs(worklogs, to)); got != 2 {
+		t.Errorf("expected the 2 worklogs within the range, got %d", got)
+	}
+
+	if got := g.inclusionReasons(issues[0].Issue, to); len(got) != 1 || got[0] != "updated from June 3 to June 7, 2024" {
+		t.Errorf("unexpected inclusion reasons %v", got)
+	}
+	if got := formatDateRange(time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)); got != "December 30, 2024 to January 3, 2025" {
+		t.Errorf("unexpected range across years %q", got)
+	}
+}
+
+func TestOfflineReportTitle(t *testing.T) {
+	targetDate := time.Date(2024, 6, 5, 0, 0, 0, 0, time.Local)
+	g := &Generator{config: &Config{}}
+	if got := g.reportTitle(targetDate); got != "Daily Standup Report - June 5, 2024" {
+		t.Errorf("unexpected title %q", got)
+	}
+
+	g.config.DataAsOf = time.Date(2024, 6, 5, 17, 42, 10, 0, time.Local)
+	if got := g.reportTitle(targetDate); got != "Daily Standup Report - June 5, 2024 (offline, data as of 2024-06-05 17:42)" {
+		t.Errorf("expected the freshness of the data in the title, got %q", got)
+	}
+}
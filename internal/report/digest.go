@@ -0,0 +1,247 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"mime/multipart"
+	"net/textproto"
+	"sort"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// staleAfter is how long an in-progress issue can go without updates before it is flagged as a risk
+const staleAfter = 3 * 24 * time.Hour
+
+// Digest is a weekly, business-style summary of work for managers
+type Digest struct {
+	WeekStart       time.Time
+	WeekEnd         time.Time
+	Accomplishments []DigestItem
+	InFlight        []DigestItem
+	Risks           []DigestItem
+	NextWeek        []DigestItem
+	CommentCount    int
+	WorklogCount    int
+}
+
+// DigestItem is an issue in a digest section, with an optional note explaining why it is there
+type DigestItem struct {
+	Issue jira.Issue
+	Note  string
+}
+
+// BuildDigest builds the digest for the seven days ending on weekEnd from the synced issues and worklogs
+func BuildDigest(issuesWithComments []IssueWithComments, worklogs []jira.WorklogEntry, weekEnd time.Time) *Digest {
+	end := weekEnd.Truncate(24 * time.Hour).Add(24 * time.Hour)
+	start := end.Add(-7 * 24 * time.Hour)
+
+	digest := &Digest{
+		WeekStart: start,
+		WeekEnd:   end.Add(-24 * time.Hour),
+	}
+
+	inWeek := func(t time.Time) bool {
+		return !t.Before(start) && t.Before(end)
+	}
+
+	for _, iwc := range issuesWithComments {
+		issue := iwc.Issue
+		for _, comment := range iwc.Comments {
+			if inWeek(comment.Created.Time) {
+				digest.CommentCount++
+			}
+		}
+
+		updated := issue.Fields.Updated.Time
+		switch getStatusCategory(issue) {
+		case 3: // Done
+			if inWeek(updated) {
+				digest.Accomplishments = append(digest.Accomplishments, DigestItem{Issue: issue})
+			}
+		case 1: // In Progress
+			digest.InFlight = append(digest.InFlight, DigestItem{Issue: issue})
+			digest.NextWeek = append(digest.NextWeek, DigestItem{Issue: issue, Note: "continue"})
+		default: // To Do
+			digest.NextWeek = append(digest.NextWeek, DigestItem{Issue: issue, Note: "planned"})
+		}
+
+		if risk := digestRisk(issue, end); risk != "" {
+			digest.Risks = append(digest.Risks, DigestItem{Issue: issue, Note: risk})
+		}
+	}
+
+	for _, worklog := range worklogs {
+		if inWeek(worklog.Started.Time) {
+			digest.WorklogCount++
+		}
+	}
+
+	for _, items := range [][]DigestItem{digest.Accomplishments, digest.InFlight, digest.Risks, digest.NextWeek} {
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].Issue.Fields.Updated.Time.After(items[j].Issue.Fields.Updated.Time)
+		})
+	}
+
+	return digest
+}
+
+// digestRisk returns why an unfinished issue is at risk, or "" if it is not
+func digestRisk(issue jira.Issue, asOf time.Time) string {
+	if getStatusCategory(issue) == 3 {
+		return ""
+	}
+
+	if digestBlocked(issue) {
+		return fmt.Sprintf("status is %s", issue.Fields.Status.Name)
+	}
+
+	if isInProgress(issue) && asOf.Sub(issue.Fields.Updated.Time) > staleAfter {
+		return fmt.Sprintf("no updates since %s", issue.Fields.Updated.Time.Format("Jan 2"))
+	}
+
+	switch strings.ToLower(issue.Fields.Priority.Name) {
+	case "highest", "critical", "blocker":
+		return fmt.Sprintf("%s priority, not completed", issue.Fields.Priority.Name)
+	}
+
+	return ""
+}
+
+// digestBlocked returns whether an issue's status says it is blocked or on hold
+func digestBlocked(issue jira.Issue) bool {
+	status := strings.ToLower(issue.Fields.Status.Name)
+	return strings.Contains(status, "block") || strings.Contains(status, "hold")
+}
+
+// Title returns the digest title
+func (d *Digest) Title() string {
+	return fmt.Sprintf("Weekly Digest: %s - %s", d.WeekStart.Format("Jan 2"), d.WeekEnd.Format("Jan 2, 2006"))
+}
+
+// digestSection pairs a section heading with its items and empty-state text
+type digestSection struct {
+	heading string
+	items   []DigestItem
+	empty   string
+}
+
+func (d *Digest) sections() []digestSection {
+	return []digestSection{
+		{"Accomplishments", d.Accomplishments, "No issues completed this week."},
+		{"In Flight", d.InFlight, "Nothing in progress."},
+		{"Risks", d.Risks, "No risks identified."},
+		{"Next Week", d.NextWeek, "Nothing planned yet."},
+	}
+}
+
+// Markdown renders the digest as a markdown document
+func (d *Digest) Markdown() string {
+	var result strings.Builder
+
+	result.WriteString(fmt.Sprintf("# %s\n\n", d.Title()))
+	result.WriteString(fmt.Sprintf("**%d** completed · **%d** in flight · **%d** risks · %d comments · %d worklog entries\n\n",
+		len(d.Accomplishments), len(d.InFlight), len(d.Risks), d.CommentCount, d.WorklogCount))
+
+	for _, section := range d.sections() {
+		result.WriteString(fmt.Sprintf("## %s\n\n", section.heading))
+		if len(section.items) == 0 {
+			result.WriteString(fmt.Sprintf("_%s_\n\n", section.empty))
+			continue
+		}
+		for _, item := range section.items {
+			result.WriteString(fmt.Sprintf("- **%s** %s", item.Issue.Key, item.Issue.Fields.Summary))
+			if item.Note != "" {
+				result.WriteString(fmt.Sprintf(" — %s", item.Note))
+			}
+			result.WriteString("\n")
+		}
+		result.WriteString("\n")
+	}
+
+	result.WriteString("---\n*Generated by my-day CLI*\n")
+	return result.String()
+}
+
+// HTML renders the digest as a self-contained HTML page
+func (d *Digest) HTML() string {
+	var result strings.Builder
+
+	result.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n")
+	result.WriteString("<meta charset=\"utf-8\">\n")
+	result.WriteString(fmt.Sprintf("<title>%s</title>\n", html.EscapeString(d.Title())))
+	result.WriteString("<style>" + htmlStyles + "</style>\n")
+	result.WriteString("</head>\n<body>\n<main>\n")
+
+	result.WriteString(fmt.Sprintf("<h1>%s</h1>\n", html.EscapeString(d.Title())))
+	result.WriteString("<div class=\"stats\">\n")
+	result.WriteString(htmlStat(len(d.Accomplishments), "Completed"))
+	result.WriteString(htmlStat(len(d.InFlight), "In flight"))
+	result.WriteString(htmlStat(len(d.Risks), "Risks"))
+	result.WriteString("</div>\n")
+
+	for _, section := range d.sections() {
+		result.WriteString(fmt.Sprintf("<h2>%s</h2>\n", section.heading))
+		if len(section.items) == 0 {
+			result.WriteString(fmt.Sprintf("<p class=\"meta\">%s</p>\n", section.empty))
+			continue
+		}
+		result.WriteString("<ul>\n")
+		for _, item := range section.items {
+			result.WriteString(fmt.Sprintf("<li><span class=\"key\">%s</span> %s",
+				html.EscapeString(item.Issue.Key), html.EscapeString(item.Issue.Fields.Summary)))
+			if item.Note != "" {
+				result.WriteString(fmt.Sprintf(" <span class=\"meta\">— %s</span>", html.EscapeString(item.Note)))
+			}
+			result.WriteString("</li>\n")
+		}
+		result.WriteString("</ul>\n")
+	}
+
+	result.WriteString("<footer>Generated by my-day CLI</footer>\n")
+	result.WriteString("</main>\n</body>\n</html>\n")
+	return result.String()
+}
+
+// Email renders the digest as an RFC 5322 message (.eml) with plain text and HTML alternatives
+func (d *Digest) Email(from string, to []string) string {
+	return emailMessage(from, to, d.Title(), d.Markdown(), d.HTML())
+}
+
+// emailMessage renders an RFC 5322 message with a plain text and an HTML alternative
+func emailMessage(from string, to []string, subject, text, htmlContent string) string {
+	var body strings.Builder
+	writer := multipart.NewWriter(&body)
+
+	parts := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", text},
+		{"text/html; charset=utf-8", htmlContent},
+	}
+	for _, part := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.contentType)
+		header.Set("Content-Transfer-Encoding", "8bit")
+		w, _ := writer.CreatePart(header)
+		w.Write([]byte(strings.ReplaceAll(part.content, "\n", "\r\n")))
+	}
+	writer.Close()
+
+	var message strings.Builder
+	if from != "" {
+		message.WriteString(fmt.Sprintf("From: %s\r\n", from))
+	}
+	if len(to) > 0 {
+		message.WriteString(fmt.Sprintf("To: %s\r\n", strings.Join(to, ", ")))
+	}
+	message.WriteString(fmt.Sprintf("Subject: %s\r\n", subject))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString(fmt.Sprintf("Content-Type: multipart/alternative; boundary=%q\r\n\r\n", writer.Boundary()))
+	message.WriteString(body.String())
+
+	return message.String()
+}
@@ -0,0 +1,82 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func TestDigestRisk(t *testing.T) {
+	asOf := time.Date(2024, 7, 16, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		status   jira.Status
+		priority string
+		updated  time.Time
+		want     string
+	}{
+		{
+			name:    "done is never a risk",
+			status:  jira.Status{Name: "Blocked", Category: jira.StatusCategory{Key: "done"}},
+			updated: asOf.Add(-10 * 24 * time.Hour),
+		},
+		{
+			name:    "blocked status",
+			status:  jira.Status{Name: "Blocked", Category: jira.StatusCategory{Key: "indeterminate"}},
+			updated: asOf,
+			want:    "status is Blocked",
+		},
+		{
+			name:    "stale in progress",
+			status:  jira.Status{Name: "In Progress", Category: jira.StatusCategory{Key: "indeterminate"}},
+			updated: time.Date(2024, 7, 10, 9, 0, 0, 0, time.UTC),
+			want:    "no updates since Jul 10",
+		},
+		{
+			name:     "critical to do",
+			status:   jira.Status{Name: "To Do", Category: jira.StatusCategory{Key: "new"}},
+			priority: "Critical",
+			updated:  asOf,
+			want:     "Critical priority, not completed",
+		},
+		{
+			name:    "recent in progress",
+			status:  jira.Status{Name: "In Progress", Category: jira.StatusCategory{Key: "indeterminate"}},
+			updated: asOf.Add(-24 * time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue := jira.Issue{Key: "OPS-1", Fields: jira.Fields{
+				Status:   tt.status,
+				Priority: jira.Priority{Name: tt.priority},
+				Updated:  jira.JiraTime{Time: tt.updated},
+			}}
+			if got := digestRisk(issue, asOf); got != tt.want {
+				t.Errorf("digestRisk() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigestEmail(t *testing.T) {
+	issues, worklogs := goldenFixture()
+	email := BuildDigest(issues, worklogs, goldenTargetDate).Email("me@example.com", []string{"boss@example.com", "team@example.com"})
+
+	for _, want := range []string{
+		"From: me@example.com\r\n",
+		"To: boss@example.com, team@example.com\r\n",
+		"Subject: Weekly Digest: Jul 9 - Jul 15, 2024\r\n",
+		"Content-Type: multipart/alternative",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Type: text/html; charset=utf-8",
+	} {
+		if !strings.Contains(email, want) {
+			t.Errorf("email missing %q", want)
+		}
+	}
+}
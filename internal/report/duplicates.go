@@ -0,0 +1,87 @@
+package report
+
+import (
+	"regexp"
+	"strings"
+
+	"my-day/internal/jira"
+)
+
+// duplicateWord matches the words compared between issues
+var duplicateWord = regexp.MustCompile(`[a-z0-9][a-z0-9._-]*[a-z0-9]`)
+
+// duplicateStopWords are words too common in issue text to make two issues alike
+var duplicateStopWords = map[string]bool{
+	"an": true, "as": true, "at": true, "be": true, "by": true, "in": true, "is": true, "it": true,
+	"of": true, "on": true, "or": true, "to": true, "we": true,
+	"the": true, "and": true, "for": true, "with": true, "from": true, "into": true, "that": true,
+	"this": true, "are": true, "was": true, "not": true, "but": true, "all": true, "can": true,
+	"should": true, "will": true, "when": true, "our": true, "use": true, "new": true, "add": true,
+	"update": true, "fix": true, "issue": true, "task": true, "ticket": true, "need": true, "needs": true,
+}
+
+// issueWords returns the distinct words of an issue's summary and description, without stop words
+func issueWords(issue jira.Issue) map[string]bool {
+	words := make(map[string]bool)
+	text := strings.ToLower(issue.Fields.Summary + " " + issue.Fields.Description.Text)
+	for _, word := range duplicateWord.FindAllString(text, -1) {
+		if !duplicateStopWords[word] {
+			words[word] = true
+		}
+	}
+	return words
+}
+
+// wordSimilarity returns the share of words two issues have in common (Jaccard index)
+func wordSimilarity(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for word := range a {
+		if b[word] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// findDuplicates returns, for active issues whose summaries and descriptions share at least
+// the given percent of their words, the key of the most similar other issue
+func findDuplicates(issues []jira.Issue, similarity int) map[string]string {
+	if similarity <= 0 {
+		return nil
+	}
+	threshold := float64(similarity) / 100
+
+	var active []jira.Issue
+	var words []map[string]bool
+	for _, issue := range issues {
+		if strings.EqualFold(issue.Fields.Status.Category.Key, "done") {
+			continue
+		}
+		active = append(active, issue)
+		words = append(words, issueWords(issue))
+	}
+
+	duplicates := make(map[string]string)
+	best := make(map[string]float64)
+	for i := range active {
+		for j := i + 1; j < len(active); j++ {
+			if active[i].Key == active[j].Key {
+				continue
+			}
+			score := wordSimilarity(words[i], words[j])
+			if score < threshold {
+				continue
+			}
+			if score > best[active[i].Key] {
+				best[active[i].Key], duplicates[active[i].Key] = score, active[j].Key
+			}
+			if score > best[active[j].Key] {
+				best[active[j].Key], duplicates[active[j].Key] = score, active[i].Key
+			}
+		}
+	}
+	return duplicates
+}
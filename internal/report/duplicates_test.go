@@ -0,0 +1,64 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	issue := func(key, summary, category string) jira.Issue {
+		return jira.Issue{Key: key, Fields: jira.Fields{
+			Summary: summary,
+			Status:  jira.Status{Name: category, Category: jira.StatusCategory{Key: category}},
+		}}
+	}
+	issues := []jira.Issue{
+		issue("DEVOPS-45", "Rotate the TLS certificates of the staging ingress", "indeterminate"),
+		issue("DEVOPS-52", "Rotate TLS certificates for staging ingress", "new"),
+		issue("DEVOPS-60", "Upgrade the production Kafka cluster", "indeterminate"),
+		issue("DEVOPS-30", "Rotate TLS certificates on staging ingress", "done"),
+	}
+
+	duplicates := findDuplicates(issues, 60)
+	if duplicates["DEVOPS-45"] != "DEVOPS-52" || duplicates["DEVOPS-52"] != "DEVOPS-45" {
+		t.Errorf("expected DEVOPS-45 and DEVOPS-52 flagged as duplicates of each other, got %v", duplicates)
+	}
+	if _, ok := duplicates["DEVOPS-60"]; ok {
+		t.Errorf("expected DEVOPS-60 not flagged, got %v", duplicates)
+	}
+	if _, ok := duplicates["DEVOPS-30"]; ok {
+		t.Errorf("expected done issues not flagged, got %v", duplicates)
+	}
+
+	if duplicates := findDuplicates(issues, 0); len(duplicates) != 0 {
+		t.Errorf("expected no duplicates when turned off, got %v", duplicates)
+	}
+}
+
+func TestDuplicateHintInReport(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+	issue := func(key, summary string) jira.Issue {
+		return jira.Issue{Key: key, Fields: jira.Fields{
+			Summary: summary,
+			Status:  jira.Status{Name: "In Progress", Category: jira.StatusCategory{Key: "indeterminate"}},
+			Updated: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)},
+		}}
+	}
+	issues := []jira.Issue{
+		issue("DEVOPS-45", "Rotate staging ingress TLS certificates"),
+		issue("DEVOPS-52", "Rotate TLS certificates of staging ingress"),
+	}
+
+	g := &Generator{config: &Config{Format: "markdown", IncludeToday: true, DuplicateSimilarity: 60}, summarizer: llm.NewDisabledSummarizer()}
+	content, err := g.Generate(issues, nil, targetDate)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !strings.Contains(content, "Possible duplicate of **DEVOPS-52**") || !strings.Contains(content, "Possible duplicate of **DEVOPS-45**") {
+		t.Errorf("expected both issues flagged:\n%s", content)
+	}
+}
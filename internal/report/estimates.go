@@ -0,0 +1,149 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// estimateVariance compares an issue's original estimate with the time spent on it
+type estimateVariance struct {
+	Issue    jira.Issue
+	Estimate time.Duration
+	Spent    time.Duration
+	Over     bool // Spent exceeds the estimate by more than the configured threshold
+}
+
+// Variance is the time spent beyond the estimate (negative when under)
+func (v estimateVariance) Variance() time.Duration {
+	return v.Spent - v.Estimate
+}
+
+// varianceText formats the variance with its percentage of the estimate, e.g. "+2h (+50%)"
+func (v estimateVariance) varianceText() string {
+	if v.Estimate == 0 {
+		return "no estimate"
+	}
+	sign := "+"
+	if v.Variance() < 0 {
+		sign = "-"
+	}
+	percent := float64(v.Variance()) / float64(v.Estimate) * 100
+	return fmt.Sprintf("%s%s (%+.0f%%)", sign, formatTrackedTime(v.Variance()), percent)
+}
+
+// estimateVariances returns the variance of every issue with an estimate or logged time, in report order
+func (g *Generator) estimateVariances(issues []jira.Issue) []estimateVariance {
+	var variances []estimateVariance
+	for _, issue := range issues {
+		if issue.Fields.TimeOriginalEstimate == 0 && issue.Fields.TimeSpent == 0 {
+			continue
+		}
+		variance := estimateVariance{
+			Issue:    issue,
+			Estimate: time.Duration(issue.Fields.TimeOriginalEstimate) * time.Second,
+			Spent:    time.Duration(issue.Fields.TimeSpent) * time.Second,
+		}
+		threshold := float64(variance.Estimate) * (1 + float64(g.config.VarianceThreshold)/100)
+		variance.Over = variance.Estimate > 0 && float64(variance.Spent) > threshold
+		variances = append(variances, variance)
+	}
+	return variances
+}
+
+// issuesInGroups flattens field groups in the given group order, keeping the first occurrence
+// of issues that belong to several groups
+func issuesInGroups(fieldGroups map[string][]jira.Issue, groupNames []string) []jira.Issue {
+	var issues []jira.Issue
+	seen := make(map[string]bool)
+	for _, groupName := range groupNames {
+		for _, issue := range fieldGroups[groupName] {
+			if !seen[issue.Key] {
+				issues = append(issues, issue)
+				seen[issue.Key] = true
+			}
+		}
+	}
+	return issues
+}
+
+// formatTrackedTime formats a duration the way Jira shows time tracking, e.g. "1h 30m"
+func formatTrackedTime(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	switch {
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+func init() {
+	RegisterSection(NewSection("📐 Estimate vs Actual", PriorityEstimates, func(model SectionModel, format string) string {
+		return model.generator.formatEstimates(model.Issues, format)
+	}))
+}
+
+// formatEstimates renders the estimate vs actual table shown in detailed mode
+func (g *Generator) formatEstimates(issues []jira.Issue, format string) string {
+	if !g.config.Detailed {
+		return ""
+	}
+	variances := g.estimateVariances(issues)
+	if len(variances) == 0 {
+		return ""
+	}
+
+	var result strings.Builder
+	switch format {
+	case FormatHTML:
+		result.WriteString("<table>\n<tr><th>Issue</th><th>Estimate</th><th>Spent</th><th>Variance</th></tr>\n")
+	case FormatMarkdown:
+		result.WriteString("| Issue | Estimate | Spent | Variance |\n")
+		result.WriteString("|-------|----------|-------|----------|\n")
+	default:
+		result.WriteString(fmt.Sprintf("  %-12s %-10s %-10s %s\n", "Issue", "Estimate", "Spent", "Variance"))
+	}
+
+	for _, v := range variances {
+		estimate := "-"
+		if v.Estimate > 0 {
+			estimate = formatTrackedTime(v.Estimate)
+		}
+		switch format {
+		case FormatHTML:
+			variance := html.EscapeString(v.varianceText())
+			if v.Over {
+				variance = "<span class=\"over-estimate\">⚠️ " + variance + "</span>"
+			}
+			result.WriteString(fmt.Sprintf("<tr><td class=\"key\">%s</td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+				html.EscapeString(v.Issue.Key), estimate, formatTrackedTime(v.Spent), variance))
+		case FormatMarkdown:
+			variance := v.varianceText()
+			if v.Over {
+				variance = "⚠️ " + variance
+			}
+			result.WriteString(fmt.Sprintf("| %s | %s | %s | %s |\n", v.Issue.Key, estimate, formatTrackedTime(v.Spent), variance))
+		default:
+			line := fmt.Sprintf("  %-12s %-10s %-10s %s", v.Issue.Key, estimate, formatTrackedTime(v.Spent), v.varianceText())
+			if v.Over {
+				line += " ⚠️  over estimate"
+			}
+			result.WriteString(line + "\n")
+		}
+	}
+
+	if format == FormatHTML {
+		result.WriteString("</table>\n")
+	}
+	return result.String()
+}
@@ -0,0 +1,58 @@
+package report
+
+import (
+	"testing"
+
+	"my-day/internal/jira"
+)
+
+func TestEstimateVariances(t *testing.T) {
+	issue := func(key string, estimate, spent int) jira.Issue {
+		return jira.Issue{Key: key, Fields: jira.Fields{TimeOriginalEstimate: estimate, TimeSpent: spent}}
+	}
+
+	generator := &Generator{config: &Config{VarianceThreshold: 20}}
+	variances := generator.estimateVariances([]jira.Issue{
+		issue("OPS-1", 3600*10, 3600*12),    // exactly at the threshold
+		issue("OPS-2", 3600*10, 3600*12+60), // just over the threshold
+		issue("OPS-3", 3600*4, 3600*3),      // under estimate
+		issue("OPS-4", 0, 1800),             // time logged without an estimate
+		issue("OPS-5", 0, 0),                // no time tracking at all
+	})
+
+	tests := []struct {
+		key        string
+		expectOver bool
+		expectText string
+	}{
+		{"OPS-1", false, "+2h (+20%)"},
+		{"OPS-2", true, "+2h 1m (+20%)"},
+		{"OPS-3", false, "-1h (-25%)"},
+		{"OPS-4", false, "no estimate"},
+	}
+
+	if len(variances) != len(tests) {
+		t.Fatalf("expected %d variances, got %d", len(tests), len(variances))
+	}
+	for i, tt := range tests {
+		v := variances[i]
+		if v.Issue.Key != tt.key {
+			t.Errorf("variance %d: expected %s, got %s", i, tt.key, v.Issue.Key)
+		}
+		if v.Over != tt.expectOver {
+			t.Errorf("%s: expected over=%t, got %t", tt.key, tt.expectOver, v.Over)
+		}
+		if text := v.varianceText(); text != tt.expectText {
+			t.Errorf("%s: expected %q, got %q", tt.key, tt.expectText, text)
+		}
+	}
+}
+
+func TestFormatEstimatesRequiresDetailed(t *testing.T) {
+	issues := []jira.Issue{{Key: "OPS-1", Fields: jira.Fields{TimeOriginalEstimate: 3600, TimeSpent: 7200}}}
+
+	generator := &Generator{config: &Config{VarianceThreshold: 20}}
+	if output := generator.formatEstimates(issues, FormatMarkdown); output != "" {
+		t.Errorf("expected no estimates section outside detailed mode, got %q", output)
+	}
+}
@@ -0,0 +1,145 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// IssueExplanation describes why an issue is included in or excluded from a report
+type IssueExplanation struct {
+	Issue    jira.Issue
+	Included bool
+	Reasons  []string // Filter rules that matched (or why none did)
+	Signals  []string // Additional activity context that does not affect inclusion
+}
+
+// ExplainIssue evaluates an issue against the report filters for the target date.
+// It uses the same rules as filterIssues so the explanation always matches the report.
+func (g *Generator) ExplainIssue(issue jira.Issue, comments []jira.Comment, targetDate time.Time, accountID string) IssueExplanation {
+	explanation := IssueExplanation{Issue: issue}
+
+	explanation.Reasons = g.inclusionReasons(issue, targetDate)
+	explanation.Included = len(explanation.Reasons) > 0
+
+	if !explanation.Included {
+		explanation.Reasons = g.exclusionReasons(issue, targetDate)
+	}
+
+	// Activity signals help explain the context even when they don't drive inclusion
+	today := targetDate.Truncate(24 * time.Hour)
+	todaysComments := 0
+	for _, comment := range comments {
+		if g.isMultiDay() && g.inRange(comment.Created.Time) || !g.isMultiDay() && comment.Created.Time.Truncate(24*time.Hour).Equal(today) {
+			todaysComments++
+		}
+	}
+	if todaysComments > 0 {
+		explanation.Signals = append(explanation.Signals, fmt.Sprintf("commented %s (%d)", g.reportPeriod(), todaysComments))
+	} else if len(comments) > 0 {
+		explanation.Signals = append(explanation.Signals, fmt.Sprintf("commented recently (%d)", len(comments)))
+	}
+
+	if accountID != "" && issue.Fields.Assignee != nil && issue.Fields.Assignee.AccountID == accountID {
+		explanation.Signals = append(explanation.Signals, "assigned to you")
+	}
+
+	return explanation
+}
+
+// inclusionReasons returns the filter rules an issue satisfies for the target date
+func (g *Generator) inclusionReasons(issue jira.Issue, targetDate time.Time) []string {
+	var reasons []string
+
+	today := targetDate.Truncate(24 * time.Hour)
+	yesterday := today.Add(-24 * time.Hour)
+	issueDate := issue.Fields.Updated.Time.Truncate(24 * time.Hour)
+
+	if g.isMultiDay() {
+		if g.inRange(issue.Fields.Updated.Time) {
+			reasons = append(reasons, "updated "+g.reportPeriod())
+		}
+	} else {
+		if g.config.IncludeToday && issueDate.Equal(today) {
+			reasons = append(reasons, "updated today")
+		}
+		if g.config.IncludeYesterday && issueDate.Equal(yesterday) {
+			reasons = append(reasons, "updated yesterday")
+		}
+	}
+	if g.config.IncludeInProgress && isInProgress(issue) {
+		reasons = append(reasons, "in progress")
+	}
+
+	return reasons
+}
+
+// exclusionReasons explains why none of the inclusion rules matched
+func (g *Generator) exclusionReasons(issue jira.Issue, targetDate time.Time) []string {
+	var reasons []string
+
+	updated := issue.Fields.Updated.Time.Format("Jan 2, 15:04")
+	switch {
+	case g.isMultiDay():
+		reasons = append(reasons, fmt.Sprintf("last updated %s (outside %s)", updated, formatDateRange(g.config.From, g.config.To)))
+	case !g.config.IncludeToday && !g.config.IncludeYesterday:
+		reasons = append(reasons, "today/yesterday updates are disabled in config")
+	default:
+		reasons = append(reasons, fmt.Sprintf("last updated %s (outside the report window)", updated))
+	}
+
+	if !g.config.IncludeInProgress {
+		reasons = append(reasons, "in-progress tickets are disabled in config")
+	} else if !isInProgress(issue) {
+		reasons = append(reasons, fmt.Sprintf("status '%s' is not in progress", issue.Fields.Status.Name))
+	}
+
+	return reasons
+}
+
+// FormatExplanation renders inclusion explanations and the active filters as plain text
+func FormatExplanation(explanations []IssueExplanation, filters []string) string {
+	var result strings.Builder
+
+	result.WriteString("🔎 REPORT EXPLANATION\n")
+	if len(filters) > 0 {
+		result.WriteString("Active filters:\n")
+		for _, filter := range filters {
+			result.WriteString(fmt.Sprintf("  • %s\n", filter))
+		}
+	}
+	result.WriteString("\n")
+
+	var included, excluded []IssueExplanation
+	for _, explanation := range explanations {
+		if explanation.Included {
+			included = append(included, explanation)
+		} else {
+			excluded = append(excluded, explanation)
+		}
+	}
+
+	result.WriteString(fmt.Sprintf("Included (%d):\n", len(included)))
+	for _, explanation := range included {
+		result.WriteString(formatExplanationLine("✓", explanation))
+	}
+
+	result.WriteString(fmt.Sprintf("\nExcluded (%d):\n", len(excluded)))
+	for _, explanation := range excluded {
+		result.WriteString(formatExplanationLine("✗", explanation))
+	}
+
+	result.WriteString("\n")
+	return result.String()
+}
+
+func formatExplanationLine(marker string, explanation IssueExplanation) string {
+	line := fmt.Sprintf("  %s %s %s\n", marker, explanation.Issue.Key, truncateString(explanation.Issue.Fields.Summary, 60))
+	line += fmt.Sprintf("      why: %s\n", strings.Join(explanation.Reasons, "; "))
+	if len(explanation.Signals) > 0 {
+		line += fmt.Sprintf("      activity: %s\n", strings.Join(explanation.Signals, ", "))
+	}
+	return line
+}
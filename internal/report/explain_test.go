@@ -0,0 +1,110 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func TestExplainIssue(t *testing.T) {
+	generator := &Generator{config: &Config{
+		IncludeToday:      true,
+		IncludeYesterday:  true,
+		IncludeInProgress: true,
+	}}
+
+	targetDate := time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		issue          jira.Issue
+		comments       []jira.Comment
+		expectIncluded bool
+		expectReason   string
+		expectSignal   string
+	}{
+		{
+			name: "Updated today and commented",
+			issue: jira.Issue{
+				Key: "TEST-1",
+				Fields: jira.Fields{
+					Status:   jira.Status{Name: "To Do", Category: jira.StatusCategory{Key: "new"}},
+					Updated:  jira.JiraTime{Time: targetDate},
+					Assignee: &jira.User{AccountID: "me"},
+				},
+			},
+			comments:       []jira.Comment{{Created: jira.JiraTime{Time: targetDate}}},
+			expectIncluded: true,
+			expectReason:   "updated today",
+			expectSignal:   "commented today (1)",
+		},
+		{
+			name: "In progress but stale",
+			issue: jira.Issue{
+				Key: "TEST-2",
+				Fields: jira.Fields{
+					Status:  jira.Status{Name: "In Progress", Category: jira.StatusCategory{Key: "indeterminate"}},
+					Updated: jira.JiraTime{Time: targetDate.Add(-5 * 24 * time.Hour)},
+				},
+			},
+			expectIncluded: true,
+			expectReason:   "in progress",
+		},
+		{
+			name: "Stale and not in progress",
+			issue: jira.Issue{
+				Key: "TEST-3",
+				Fields: jira.Fields{
+					Status:  jira.Status{Name: "Done", Category: jira.StatusCategory{Key: "done"}},
+					Updated: jira.JiraTime{Time: targetDate.Add(-5 * 24 * time.Hour)},
+				},
+			},
+			expectIncluded: false,
+			expectReason:   "status 'Done' is not in progress",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			explanation := generator.ExplainIssue(tt.issue, tt.comments, targetDate, "me")
+
+			if explanation.Included != tt.expectIncluded {
+				t.Errorf("Included = %v, expected %v", explanation.Included, tt.expectIncluded)
+			}
+
+			if !strings.Contains(strings.Join(explanation.Reasons, "; "), tt.expectReason) {
+				t.Errorf("Expected reason %q, got %v", tt.expectReason, explanation.Reasons)
+			}
+
+			if tt.expectSignal != "" && !strings.Contains(strings.Join(explanation.Signals, ", "), tt.expectSignal) {
+				t.Errorf("Expected signal %q, got %v", tt.expectSignal, explanation.Signals)
+			}
+		})
+	}
+}
+
+func TestExplainIssueMatchesFilter(t *testing.T) {
+	generator := &Generator{config: &Config{IncludeToday: true}}
+	targetDate := time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC)
+
+	issues := []jira.Issue{
+		{Key: "TEST-1", Fields: jira.Fields{Updated: jira.JiraTime{Time: targetDate}}},
+		{Key: "TEST-2", Fields: jira.Fields{Updated: jira.JiraTime{Time: targetDate.Add(-24 * time.Hour)}}},
+	}
+
+	filtered := generator.filterIssues(issues, targetDate)
+	for _, issue := range issues {
+		explanation := generator.ExplainIssue(issue, nil, targetDate, "")
+		inFiltered := false
+		for _, f := range filtered {
+			if f.Key == issue.Key {
+				inFiltered = true
+			}
+		}
+		if explanation.Included != inFiltered {
+			t.Errorf("%s: explanation Included = %v but filterIssues included = %v", issue.Key, explanation.Included, inFiltered)
+		}
+	}
+}
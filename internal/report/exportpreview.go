@@ -0,0 +1,48 @@
+package report
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+)
+
+// ObsidianExportFiles returns the files ExportToObsidian would write for the report, without
+// writing them: the note of the report, or the daily note it goes into, followed by the notes
+// of the issues it mentions
+func (g *Generator) ObsidianExportFiles(reportContent string, targetDate time.Time) ([]string, error) {
+	if g.config.Format == "html" {
+		return nil, fmt.Errorf("Obsidian export requires console or markdown format, not html")
+	}
+
+	notePath, err := g.ObsidianExportPath(targetDate)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{notePath}
+
+	if g.config.IssueNotes != nil {
+		folder, err := expandHome(g.config.IssueNotes.Folder)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range g.mentionedIssues(reportContent) {
+			files = append(files, filepath.Join(folder, issue.Key+".md"))
+		}
+	}
+	return files, nil
+}
+
+// ConfluencePageTitle returns the title of the Confluence page the report of a date is
+// published as
+func (g *Generator) ConfluencePageTitle(targetDate time.Time) string {
+	titlePrefix := "Daily Standup Report"
+	if g.config.Confluence != nil && g.config.Confluence.TitlePrefix != "" {
+		titlePrefix = g.config.Confluence.TitlePrefix
+	}
+	return fmt.Sprintf("%s - %s", titlePrefix, targetDate.Format(g.config.ExportFileDate))
+}
+
+// NotionPageTitle returns the title of the Notion page the report of a date is written to
+func (g *Generator) NotionPageTitle(targetDate time.Time) string {
+	return fmt.Sprintf("Daily Standup Report - %s", targetDate.Format(g.config.ExportFileDate))
+}
@@ -0,0 +1,68 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"my-day/internal/jira"
+)
+
+func TestObsidianExportFiles(t *testing.T) {
+	folder := t.TempDir()
+	config := goldenConfig(FormatMarkdown)
+	config.ExportEnabled = true
+	config.ExportFolderPath = folder
+	config.ExportFileDate = "2006-01-02"
+	config.IssueNotes = &IssueNotesTarget{Folder: filepath.Join(folder, "issues")}
+	g := NewGenerator(config)
+	g.SetExportIssues([]jira.Issue{noteIssue("OPS-2", "Rotate keys", "Done"), noteIssue("OPS-1", "Migrate runners", "In Progress")})
+
+	files, err := g.ObsidianExportFiles("- **[OPS-1]** Migrate runners\n- **[OPS-2]** Rotate keys\n", goldenTargetDate)
+	if err != nil {
+		t.Fatalf("ObsidianExportFiles() error = %v", err)
+	}
+	want := []string{
+		filepath.Join(folder, "2024-07-15.md"),
+		filepath.Join(folder, "issues", "OPS-1.md"),
+		filepath.Join(folder, "issues", "OPS-2.md"),
+	}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("ObsidianExportFiles() = %v, want %v", files, want)
+	}
+	if entries, _ := os.ReadDir(folder); len(entries) != 0 {
+		t.Errorf("expected nothing written, found %d entries", len(entries))
+	}
+}
+
+func TestObsidianExportFilesDailyNote(t *testing.T) {
+	config := goldenConfig(FormatMarkdown)
+	config.ExportFolderPath = "/vault"
+	config.DailyNote = &DailyNoteTarget{Folder: "/vault/Daily", Format: "YYYY/MM/DD"}
+	files, err := NewGenerator(config).ObsidianExportFiles("# Daily Standup Report\n", goldenTargetDate)
+	if err != nil {
+		t.Fatalf("ObsidianExportFiles() error = %v", err)
+	}
+	if want := []string{filepath.Join("/vault/Daily", "2024/07/15.md")}; !reflect.DeepEqual(files, want) {
+		t.Errorf("ObsidianExportFiles() = %v, want %v", files, want)
+	}
+}
+
+func TestObsidianExportFilesRejectsHTML(t *testing.T) {
+	if _, err := NewGenerator(goldenConfig(FormatHTML)).ObsidianExportFiles("<h1>Report</h1>", goldenTargetDate); err == nil {
+		t.Error("expected an error for the html format")
+	}
+}
+
+func TestConfluencePageTitle(t *testing.T) {
+	config := goldenConfig(FormatMarkdown)
+	config.ExportFileDate = "2006-01-02"
+	if got, want := NewGenerator(config).ConfluencePageTitle(goldenTargetDate), "Daily Standup Report - 2024-07-15"; got != want {
+		t.Errorf("ConfluencePageTitle() = %q, want %q", got, want)
+	}
+	config.Confluence = &ConfluenceTarget{TitlePrefix: "Standup"}
+	if got, want := NewGenerator(config).ConfluencePageTitle(goldenTargetDate), "Standup - 2024-07-15"; got != want {
+		t.Errorf("ConfluencePageTitle() = %q, want %q", got, want)
+	}
+}
@@ -1,15 +1,23 @@
 package report
 
 import (
+	"errors"
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
+	"my-day/internal/asana"
+	"my-day/internal/github"
+	"my-day/internal/gitlab"
 	"my-day/internal/jira"
 	"my-day/internal/llm"
+	"my-day/internal/trello"
 )
 
 // IssueWithComments represents an issue with today's comments
@@ -23,6 +31,15 @@ type Generator struct {
 	config       *Config
 	summarizer   llm.Summarizer
 	cacheManager *CacheManager
+	exportMetrics *ExportMetrics // Written as frontmatter properties when set
+	exportIssues  []jira.Issue   // Issues whose keys link to their notes in exported reports
+	summarizerErr error          // Why the configured LLM could not be started, if it could not
+	warnings      Warnings       // Found while generating the last report
+	plan          *detailPlan    // Detail that fits the time budget of the report being generated
+	issuesShown   int            // Issues rendered so far, the first ones of the plan are detailed
+	duplicates    map[string]string // Active issues of the report being generated that look alike, by key
+	issueSummaries map[string]string // AI summaries of the issues of the report being generated, summarized ahead
+	fallbacksAtStart int             // Calls the embedded fallback answered before the report being generated
 }
 
 // Config represents report generation configuration
@@ -31,50 +48,92 @@ type Config struct {
 	LLMEnabled        bool
 	LLMMode           string
 	LLMModel          string
+	LLMLanguage       string // "auto" or the language summaries are written in
 	OllamaURL         string
 	OllamaModel       string
+	OllamaIdleStop    int // Minutes without requests before the Docker LLM stops, 0 for never
+	OpenAIURL         string
+	OpenAIAPIKey      string `json:"-"`
+	OpenAIModel       string
+	OpenAIAPIVersion  string
+	LocalOpenAIURL    string
+	LocalOpenAIAPIKey string `json:"-"`
+	LocalOpenAIModel  string
+	StrictLLM         bool // Fail instead of falling back to the comments when the LLM cannot write a summary
+	LLMCache          SummaryCache `json:"-"` // Issue and comment summaries kept between reports, nil to always ask the LLM
+	LLMConcurrency    int // Issues summarized at once in detailed reports, 0 for the default
+	LLMPrivacy        llm.PrivacyConfig // Redaction and allowed projects of remote LLM backends
+	LLMProjects       map[string]llm.ProjectPrompt // Summary style and prompt overrides by project key
 	IncludeYesterday  bool
 	IncludeToday      bool
 	IncludeInProgress bool
+	From              time.Time // First day of a multi-day report (--from), zero for a daily report
+	To                time.Time // Last day of a multi-day report (--to)
+	DataAsOf          time.Time // Last sync of the data of an offline report (--offline), labeled in its header; zero otherwise
 	Detailed          bool
+	MaxCommentExcerpt int // Maximum runes of the latest comment shown in detailed mode (0 for no limit)
+	TimeBudget        time.Duration // Reading time the report targets, scaling its detail instead of the fixed caps (0 for none)
+	VarianceThreshold int // Percent time spent may exceed the original estimate before an issue is flagged
+	DuplicateSimilarity int // Percent of words two active issues must share to be flagged as possible duplicates (0 to turn off)
+	RiskWeights       RiskWeights // Weights of the risk score of in-progress issues (all zero to leave out the at-risk list)
+	RiskMinScore      int // Risk score, 0 to 100, from which in-progress issues are listed as at risk
+	StatusSince       map[string]time.Time `json:"-"` // When issues entered their current status, by issue key, as recorded by syncs
+	Mentions          []jira.Mention `json:"-"` // Comments mentioning the user on issues others own, listed as needing attention
+	WorkdayHours      float64 // Workday length the time logged is compared against (0 to leave out utilization)
 	Debug             bool
 	ShowQuality       bool
 	Verbose           bool
-	GroupByField      string
+	GroupByField      string // Field, or comma-separated fields nested in that order, issues are grouped by
+	GroupSummaries    bool // Add an AI mini-summary of the comments of each group of a grouped report
+	CustomFields      map[string]string // Jira field IDs of the names issues can be grouped by, from jira.custom_fields
+	Themes            bool // Structure the AI summary of the day around 2-4 themes found by the LLM
+	Structured        bool // Render the AI summary of the day from the JSON the LLM answers, part by part
+	NextSteps         bool // Add the 3-5 next steps for tomorrow the LLM proposes from the work in progress
+	Layout            string // "default" (by status) or "standup" (Yesterday, Today and Blockers)
+	AccountID         string // Jira account of the user, whose unstarted issues the standup layout plans for today
+	Hide              HiddenSections // Parts of the report left out, e.g. with --no-todo
+	StatusStyles      map[string]StatusStyle // Icon and label of Jira statuses by name, overriding the built-in icons
+	TemplatePath      string // Custom text/template file the report is rendered with instead of the built-in layout
+	BoardColumns      *jira.BoardColumnMap `json:"-"` // Board layout used when grouping by "column"
 	ExportEnabled     bool
 	ExportFolderPath  string
 	ExportFileDate    string
 	ExportTags        []string
+	DailyNote         *DailyNoteTarget  `json:"-"` // Daily note ExportToObsidian writes into, nil for a note of its own
+	ExportWikiLinks   bool              // Render issue keys in Obsidian exports as [[KEY]] wiki-links
+	IssueNotes        *IssueNotesTarget `json:"-"` // Folder ExportToObsidian keeps a note per issue in, nil for none
+	Confluence        *ConfluenceTarget `json:"-"` // Destination of ExportToConfluence
+	Notion            *NotionTarget     `json:"-"` // Destination of ExportToNotion
+	GitHubActivity    []github.Activity `json:"-"` // Synced GitHub activity reported alongside Jira work
+	GitLabActivity    []gitlab.Activity `json:"-"` // Synced GitLab activity reported alongside Jira work
+	TrelloActivity    []trello.Activity `json:"-"` // Synced Trello card activity reported alongside Jira work
+	AsanaActivity     []asana.Activity  `json:"-"` // Synced Asana task activity reported alongside Jira work
+	MeetingsSummary   string            // Time in attended meetings, e.g. "3h in meetings (2 recurring, 1 incident review)"
+	Warnings          Warnings          `json:"-"` // Found before generating the report, e.g. by the sync, and listed in its notes
 }
 
 // NewGenerator creates a new report generator
 func NewGenerator(config *Config) *Generator {
 	// Initialize LLM summarizer based on configuration
-	llmConfig := llm.LLMConfig{
-		Enabled:                  config.LLMEnabled,
-		Mode:                     config.LLMMode,
-		Model:                    config.LLMModel,
-		Debug:                    config.Debug,
-		SummaryStyle:             "technical", // Default to technical style for DevOps context
-		MaxSummaryLength:         200,
-		IncludeTechnicalDetails:  true,
-		PrioritizeRecentWork:     true,
-		FallbackStrategy:         "graceful",
-		OllamaURL:                config.OllamaURL,
-		OllamaModel:              config.OllamaModel,
-	}
-	
-	summarizer, err := llm.NewSummarizer(llmConfig)
-	if err != nil {
+	// Default to technical style for DevOps context
+	summarizer, summarizerErr := llm.NewSummarizer(newLLMConfig(config, "technical"))
+	if summarizerErr != nil {
 		// Fallback to disabled summarizer if initialization fails
 		summarizer = llm.NewDisabledSummarizer()
 	}
-	
+	g := NewGeneratorWithSummarizer(config, summarizer)
+	g.summarizerErr = summarizerErr
+	return g
+}
+
+// NewGeneratorWithSummarizer creates a report generator whose summaries summarizer writes
+// instead of the configured LLM
+func NewGeneratorWithSummarizer(config *Config, summarizer llm.Summarizer) *Generator {
 	// Initialize cache manager
 	cacheManager, err := NewCacheManager()
 	if err != nil {
 		// Log warning but continue without caching
-		fmt.Printf("Warning: Failed to initialize report cache: %v\n", err)
+		slog.Warn("Failed to initialize report cache", "error", err)
 		cacheManager = nil
 	}
 	
@@ -85,15 +144,133 @@ func NewGenerator(config *Config) *Generator {
 	}
 }
 
+// trackComments tells the privacy filter of a remote LLM which issue each comment belongs to,
+// so comments of projects not allowed to use it are kept from it
+func trackComments(summarizer llm.Summarizer, issuesWithComments []IssueWithComments) {
+	tracker, ok := summarizer.(interface{ TrackComments(jira.Issue, []jira.Comment) })
+	if !ok {
+		return
+	}
+	for _, iwc := range issuesWithComments {
+		tracker.TrackComments(iwc.Issue, iwc.Comments)
+	}
+}
+
+// startReport clears the warnings and the detail plan of the previous report
+func (g *Generator) startReport() {
+	g.warnings = nil
+	g.plan, g.issuesShown = nil, 0
+	g.duplicates = nil
+	g.issueSummaries = nil
+	g.fallbacksAtStart = g.llmFallbacks()
+	if g.summarizerErr != nil {
+		g.warnings.Add(SeverityWarning, "LLM", "The %s summarizer could not be started, so the report has no AI summaries: %v", g.config.LLMMode, g.summarizerErr)
+	}
+	if reason := g.llmStandIn(); reason != nil {
+		g.warnings.Add(SeverityWarning, "LLM", "The %s backend is unavailable, so the embedded model wrote the AI summaries: %v", g.config.LLMMode, reason)
+	}
+}
+
+// llmStandIn returns why the embedded LLM stands in for the configured backend, or nil when the
+// configured backend writes the summaries
+func (g *Generator) llmStandIn() error {
+	embedded, ok := g.summarizer.(*llm.EmbeddedLLM)
+	if !ok || !g.config.LLMEnabled || g.config.LLMMode == "embedded" {
+		return nil
+	}
+	if reason := embedded.StandInReason(); reason != nil {
+		return reason
+	}
+	return errors.New("it was not started")
+}
+
+// llmFallbacks returns how many calls the embedded fallback answered so far because the
+// backend could not
+func (g *Generator) llmFallbacks() int {
+	if counter, ok := g.summarizer.(fallbackCounter); ok {
+		return counter.Fallbacks()
+	}
+	return 0
+}
+
+// llmFallbackStrategy returns the fallback strategy of the summarizer: strict when the report
+// must fail without an AI summary
+func llmFallbackStrategy(config *Config) string {
+	if config.StrictLLM {
+		return "strict"
+	}
+	return "graceful"
+}
+
+// newLLMConfig returns the summarizer configuration for the report configuration and summary style
+func newLLMConfig(config *Config, style string) llm.LLMConfig {
+	return llm.LLMConfig{
+		Enabled:                  config.LLMEnabled,
+		Mode:                     config.LLMMode,
+		Model:                    config.LLMModel,
+		Debug:                    config.Debug,
+		SummaryStyle:             style,
+		Language:                 config.LLMLanguage,
+		MaxSummaryLength:         maxSummaryLength(config),
+		IncludeTechnicalDetails:  true,
+		PrioritizeRecentWork:     true,
+		FallbackStrategy:         llmFallbackStrategy(config),
+		Period:                   llmPeriod(config),
+		OllamaURL:                config.OllamaURL,
+		OllamaModel:              config.OllamaModel,
+		OllamaIdleStop:           config.OllamaIdleStop,
+		OpenAIURL:                config.OpenAIURL,
+		OpenAIAPIKey:             config.OpenAIAPIKey,
+		OpenAIModel:              config.OpenAIModel,
+		OpenAIAPIVersion:         config.OpenAIAPIVersion,
+		LocalOpenAIURL:           config.LocalOpenAIURL,
+		LocalOpenAIAPIKey:        config.LocalOpenAIAPIKey,
+		LocalOpenAIModel:         config.LocalOpenAIModel,
+		Concurrency:              config.LLMConcurrency,
+		Privacy:                  config.LLMPrivacy,
+		Projects:                 config.LLMProjects,
+	}
+}
+
+// llmPeriod returns the days a multi-day report covers for the LLM prompts, "" for a daily report
+func llmPeriod(config *Config) string {
+	if config.From.IsZero() {
+		return ""
+	}
+	return formatDateRange(config.From, config.To)
+}
+
+// maxSummaryLength returns the length of the AI summary of the day, scaled to the time budget if there is one
+func maxSummaryLength(config *Config) int {
+	if config.TimeBudget > 0 {
+		return planDetail(config.TimeBudget, 0).summaryChars
+	}
+	return 200
+}
+
 // Generate creates a daily standup report
 func (g *Generator) Generate(issues []jira.Issue, worklogs []jira.WorklogEntry, targetDate time.Time) (string, error) {
+	g.startReport()
+
 	// Filter issues based on configuration and target date
 	filteredIssues := g.filterIssues(issues, targetDate)
 	filteredWorklogs := g.filterWorklogs(worklogs, targetDate)
+	g.planReport(filteredIssues)
+	g.duplicates = findDuplicates(filteredIssues, g.config.DuplicateSimilarity)
+
+	if g.config.TemplatePath != "" {
+		return g.generateFromTemplate(filteredIssues, nil, filteredWorklogs, targetDate)
+	}
+	if g.isStandupLayout() {
+		return g.generateStandup(filteredIssues, issues, nil, filteredWorklogs, targetDate)
+	}
+	g.prefetchIssueSummaries(filteredIssues)
 
 	switch g.config.Format {
 	case "markdown":
 		return g.generateMarkdown(filteredIssues, filteredWorklogs, targetDate)
+	case "html":
+		return g.generateHTML(filteredIssues, nil, filteredWorklogs, targetDate, "")
 	default:
 		return g.generateConsole(filteredIssues, filteredWorklogs, targetDate)
 	}
@@ -101,6 +278,9 @@ func (g *Generator) Generate(issues []jira.Issue, worklogs []jira.WorklogEntry,
 
 // GenerateWithComments creates a daily standup report with comment summaries
 func (g *Generator) GenerateWithComments(issuesWithComments []IssueWithComments, worklogs []jira.WorklogEntry, targetDate time.Time) (string, error) {
+	g.startReport()
+	trackComments(g.summarizer, issuesWithComments)
+
 	// Extract just the issues for filtering
 	var issues []jira.Issue
 	for _, iwc := range issuesWithComments {
@@ -110,6 +290,8 @@ func (g *Generator) GenerateWithComments(issuesWithComments []IssueWithComments,
 	// Filter issues and worklogs
 	filteredIssues := g.filterIssues(issues, targetDate)
 	filteredWorklogs := g.filterWorklogs(worklogs, targetDate)
+	g.planReport(filteredIssues)
+	g.duplicates = findDuplicates(filteredIssues, g.config.DuplicateSimilarity)
 
 	// Create a map of issue key to comments for quick lookup
 	commentsMap := make(map[string][]jira.Comment)
@@ -117,6 +299,13 @@ func (g *Generator) GenerateWithComments(issuesWithComments []IssueWithComments,
 		commentsMap[iwc.Issue.Key] = iwc.Comments
 	}
 
+	if g.config.TemplatePath != "" {
+		return g.generateFromTemplate(filteredIssues, commentsMap, filteredWorklogs, targetDate)
+	}
+	if g.isStandupLayout() {
+		return g.generateStandup(filteredIssues, issues, commentsMap, filteredWorklogs, targetDate)
+	}
+
 	if g.config.GroupByField != "" {
 		return g.generateFieldGroupedReport(filteredIssues, commentsMap, filteredWorklogs, targetDate, g.config.GroupByField)
 	}
@@ -124,6 +313,8 @@ func (g *Generator) GenerateWithComments(issuesWithComments []IssueWithComments,
 	switch g.config.Format {
 	case "markdown":
 		return g.generateMarkdownWithComments(filteredIssues, commentsMap, filteredWorklogs, targetDate)
+	case "html":
+		return g.generateHTML(filteredIssues, commentsMap, filteredWorklogs, targetDate, "")
 	default:
 		return g.generateConsoleWithComments(filteredIssues, commentsMap, filteredWorklogs, targetDate)
 	}
@@ -131,29 +322,10 @@ func (g *Generator) GenerateWithComments(issuesWithComments []IssueWithComments,
 
 func (g *Generator) filterIssues(issues []jira.Issue, targetDate time.Time) []jira.Issue {
 	var filtered []jira.Issue
-	
-	today := targetDate.Truncate(24 * time.Hour)
-	yesterday := today.Add(-24 * time.Hour)
 
 	for _, issue := range issues {
-		issueDate := issue.Fields.Updated.Time.Truncate(24 * time.Hour)
-		
-		include := false
-		
-		// Check if issue should be included based on date
-		if g.config.IncludeToday && issueDate.Equal(today) {
-			include = true
-		}
-		if g.config.IncludeYesterday && issueDate.Equal(yesterday) {
-			include = true
-		}
-		
-		// Always include in-progress issues if configured
-		if g.config.IncludeInProgress && isInProgress(issue) {
-			include = true
-		}
-
-		if include {
+		// Include issues updated in the report window or still in progress
+		if len(g.inclusionReasons(issue, targetDate)) > 0 {
 			filtered = append(filtered, issue)
 		}
 	}
@@ -184,6 +356,13 @@ func (g *Generator) filterWorklogs(worklogs []jira.WorklogEntry, targetDate time
 	for _, worklog := range worklogs {
 		worklogDate := worklog.Started.Time.Truncate(24 * time.Hour)
 		
+		if g.isMultiDay() {
+			if g.inRange(worklog.Started.Time) {
+				filtered = append(filtered, worklog)
+			}
+			continue
+		}
+
 		include := false
 		if g.config.IncludeToday && worklogDate.Equal(today) {
 			include = true
@@ -209,24 +388,27 @@ func (g *Generator) generateConsole(issues []jira.Issue, worklogs []jira.Worklog
 	var report strings.Builder
 	
 	// Header
-	report.WriteString(fmt.Sprintf("🚀 Daily Standup Report - %s\n", targetDate.Format("January 2, 2006")))
+	report.WriteString(fmt.Sprintf("🚀 %s\n", g.reportTitle(targetDate)))
 	report.WriteString(strings.Repeat("=", 50) + "\n")
-	report.WriteString("📝 Issues with your comments today\n\n")
+	report.WriteString(fmt.Sprintf("📝 Issues with your comments %s\n\n", g.reportPeriod()))
 
 	// AI Summary if enabled
-	if g.config.LLMEnabled {
+	if g.config.LLMEnabled && !g.config.Hide.AISummary {
 		standupSummary, err := g.summarizer.GenerateStandupSummary(issues, worklogs)
 		if err == nil && standupSummary != "" {
 			report.WriteString("🤖 AI SUMMARY\n")
 			report.WriteString(fmt.Sprintf("%s\n\n", standupSummary))
+		} else if err != nil {
+			g.warnLLM("Summarizing your day", err)
 		}
 	}
 
 	// Summary
-	report.WriteString("📊 SUMMARY\n")
-	report.WriteString(fmt.Sprintf("• Issues with comments today: %d\n", len(issues)))
-	report.WriteString(fmt.Sprintf("• Worklog entries: %d\n", len(worklogs)))
-	report.WriteString("\n")
+	if !g.config.Hide.Summary {
+		report.WriteString("📊 SUMMARY\n")
+		report.WriteString(g.formatSummaryTable(issues, nil, worklogs))
+		report.WriteString("\n")
+	}
 
 	// Group issues by status
 	statusGroups := groupIssuesByStatus(issues)
@@ -250,7 +432,7 @@ func (g *Generator) generateConsole(issues []jira.Issue, worklogs []jira.Worklog
 	}
 
 	// To Do section
-	if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 {
+	if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 && !g.config.Hide.ToDo {
 		report.WriteString("📋 TO DO\n")
 		for _, issue := range todo {
 			report.WriteString(g.formatIssueConsole(issue))
@@ -258,18 +440,14 @@ func (g *Generator) generateConsole(issues []jira.Issue, worklogs []jira.Worklog
 		report.WriteString("\n")
 	}
 
-	// Worklog section
-	if len(worklogs) > 0 {
-		report.WriteString("⏰ WORK LOG\n")
-		for _, worklog := range worklogs {
-			report.WriteString(g.formatWorklogConsole(worklog))
-		}
-		report.WriteString("\n")
-	}
+	// Estimates, code activity, work log and other integration sections
+	report.WriteString(g.renderSections(SectionModel{TargetDate: targetDate, Issues: issues, Worklogs: worklogs}, FormatConsole))
 
 	// Footer
-	report.WriteString("---\n")
-	report.WriteString("Generated by my-day CLI 🤖\n")
+	if !g.config.Hide.Footer {
+		report.WriteString("---\n")
+		report.WriteString("Generated by my-day CLI 🤖\n")
+	}
 
 	return report.String(), nil
 }
@@ -278,23 +456,26 @@ func (g *Generator) generateConsoleWithComments(issues []jira.Issue, commentsMap
 	var report strings.Builder
 	
 	// Header
-	report.WriteString(fmt.Sprintf("🚀 Daily Standup Report - %s\n", targetDate.Format("January 2, 2006")))
+	report.WriteString(fmt.Sprintf("🚀 %s\n", g.reportTitle(targetDate)))
 	report.WriteString(strings.Repeat("=", 50) + "\n")
-	report.WriteString("📝 Issues with your comments today\n\n")
+	report.WriteString(fmt.Sprintf("📝 Issues with your comments %s\n\n", g.reportPeriod()))
 
 	// AI Summary if enabled - based on comments
-	if g.config.LLMEnabled {
+	if g.config.LLMEnabled && !g.config.Hide.AISummary {
 		allComments := []jira.Comment{}
 		for _, comments := range commentsMap {
 			allComments = append(allComments, comments...)
 		}
+		allComments = g.withCodeActivityComments(allComments, targetDate)
 		
 		if hasMeaningfulComments(allComments) {
 			// Use the enhanced LLM method for intelligent summary
-			summary, err := g.summarizer.GenerateStandupSummaryWithComments(issues, allComments, worklogs)
+			summary, err := g.daySummary(issues, allComments, worklogs, FormatConsole)
 			if err == nil && summary != "" {
 				report.WriteString("🤖 AI SUMMARY OF TODAY'S WORK\n")
 				report.WriteString(fmt.Sprintf("%s\n\n", summary))
+			} else if err != nil {
+				g.warnLLM("Summarizing your day", err)
 			}
 		} else if len(allComments) > 0 {
 			// Show warning when there are comments but they're not meaningful enough for AI summary
@@ -305,16 +486,11 @@ func (g *Generator) generateConsoleWithComments(issues []jira.Issue, commentsMap
 	}
 
 	// Summary
-	report.WriteString("📊 SUMMARY\n")
-	report.WriteString(fmt.Sprintf("• Issues with comments today: %d\n", len(issues)))
-	
-	totalComments := 0
-	for _, comments := range commentsMap {
-		totalComments += len(comments)
+	if !g.config.Hide.Summary {
+		report.WriteString("📊 SUMMARY\n")
+		report.WriteString(g.formatSummaryTable(issues, commentsMap, worklogs))
+		report.WriteString("\n")
 	}
-	report.WriteString(fmt.Sprintf("• Total comments added: %d\n", totalComments))
-	report.WriteString(fmt.Sprintf("• Worklog entries: %d\n", len(worklogs)))
-	report.WriteString("\n")
 
 	// Group issues by status
 	statusGroups := groupIssuesByStatus(issues)
@@ -338,7 +514,7 @@ func (g *Generator) generateConsoleWithComments(issues []jira.Issue, commentsMap
 	}
 
 	// To Do section
-	if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 {
+	if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 && !g.config.Hide.ToDo {
 		report.WriteString("📋 TO DO\n")
 		for _, issue := range todo {
 			report.WriteString(g.formatIssueConsoleWithComments(issue, commentsMap[issue.Key]))
@@ -346,18 +522,14 @@ func (g *Generator) generateConsoleWithComments(issues []jira.Issue, commentsMap
 		report.WriteString("\n")
 	}
 
-	// Worklog section
-	if len(worklogs) > 0 {
-		report.WriteString("⏰ WORK LOG\n")
-		for _, worklog := range worklogs {
-			report.WriteString(g.formatWorklogConsole(worklog))
-		}
-		report.WriteString("\n")
-	}
+	// Estimates, code activity, work log and other integration sections
+	report.WriteString(g.renderSections(SectionModel{TargetDate: targetDate, Issues: issues, Worklogs: worklogs, Comments: commentsMap}, FormatConsole))
 
 	// Footer
-	report.WriteString("---\n")
-	report.WriteString("Generated by my-day CLI 🤖\n")
+	if !g.config.Hide.Footer {
+		report.WriteString("---\n")
+		report.WriteString("Generated by my-day CLI 🤖\n")
+	}
 
 	return report.String(), nil
 }
@@ -366,22 +538,29 @@ func (g *Generator) generateMarkdown(issues []jira.Issue, worklogs []jira.Worklo
 	var report strings.Builder
 	
 	// Header
-	report.WriteString(fmt.Sprintf("# Daily Standup Report - %s\n\n", targetDate.Format("January 2, 2006")))
-	report.WriteString("*Issues with your comments today*\n\n")
+	report.WriteString(fmt.Sprintf("# %s\n\n", g.reportTitle(targetDate)))
+	report.WriteString(fmt.Sprintf("*Issues with your comments %s*\n\n", g.reportPeriod()))
 
 	// AI Summary if enabled
-	if g.config.LLMEnabled {
+	if g.config.LLMEnabled && !g.config.Hide.AISummary {
 		standupSummary, err := g.summarizer.GenerateStandupSummary(issues, worklogs)
 		if err == nil && standupSummary != "" {
 			report.WriteString("## 🤖 AI Summary\n\n")
 			report.WriteString(fmt.Sprintf("%s\n\n", standupSummary))
+		} else if err != nil {
+			g.warnLLM("Summarizing your day", err)
 		}
 	}
 
 	// Summary
-	report.WriteString("## Summary\n\n")
-	report.WriteString(fmt.Sprintf("- **Issues with comments today**: %d\n", len(issues)))
-	report.WriteString(fmt.Sprintf("- **Worklog entries**: %d\n\n", len(worklogs)))
+	if !g.config.Hide.Summary {
+		report.WriteString("## Summary\n\n")
+		report.WriteString(fmt.Sprintf("- **Issues with comments %s**: %d\n", g.reportPeriod(), len(issues)))
+		report.WriteString(fmt.Sprintf("- **Worklog entries**: %d\n", len(worklogs)))
+		report.WriteString(g.formatTimeLogged("- **Time logged**: %s\n", worklogs))
+		report.WriteString(g.formatMeetingsSummary("- "))
+		report.WriteString("\n")
+	}
 
 	// Group issues by status
 	statusGroups := groupIssuesByStatus(issues)
@@ -405,7 +584,7 @@ func (g *Generator) generateMarkdown(issues []jira.Issue, worklogs []jira.Worklo
 	}
 
 	// To Do section
-	if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 {
+	if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 && !g.config.Hide.ToDo {
 		report.WriteString("## 📋 To Do\n\n")
 		for _, issue := range todo {
 			report.WriteString(g.formatIssueMarkdown(issue))
@@ -413,18 +592,14 @@ func (g *Generator) generateMarkdown(issues []jira.Issue, worklogs []jira.Worklo
 		report.WriteString("\n")
 	}
 
-	// Worklog section
-	if len(worklogs) > 0 {
-		report.WriteString("## ⏰ Work Log\n\n")
-		for _, worklog := range worklogs {
-			report.WriteString(g.formatWorklogMarkdown(worklog))
-		}
-		report.WriteString("\n")
-	}
+	// Estimates, code activity, work log and other integration sections
+	report.WriteString(g.renderSections(SectionModel{TargetDate: targetDate, Issues: issues, Worklogs: worklogs}, FormatMarkdown))
 
 	// Footer
-	report.WriteString("---\n")
-	report.WriteString("*Generated by my-day CLI*\n")
+	if !g.config.Hide.Footer {
+		report.WriteString("---\n")
+		report.WriteString("*Generated by my-day CLI*\n")
+	}
 
 	return report.String(), nil
 }
@@ -432,7 +607,7 @@ func (g *Generator) generateMarkdown(issues []jira.Issue, worklogs []jira.Worklo
 func (g *Generator) formatIssueConsole(issue jira.Issue) string {
 	var result strings.Builder
 	
-	statusIcon := getStatusIcon(issue.Fields.Status.Name)
+	statusIcon := g.statusIcon(issue.Fields.Status.Name)
 	priorityIcon := getPriorityIcon(issue.Fields.Priority.Name)
 	
 	result.WriteString(fmt.Sprintf("  %s %s [%s] %s\n", 
@@ -442,17 +617,20 @@ func (g *Generator) formatIssueConsole(issue jira.Issue) string {
 		issue.Fields.Summary))
 	
 	// Add AI summary if enabled and detailed mode
-	if g.config.LLMEnabled && g.config.Detailed {
-		if summary, err := g.summarizer.SummarizeIssue(issue); err == nil && summary != "" {
+	summarize, detailed, _ := g.nextIssueDetail()
+	if summarize && detailed {
+		if summary, err := g.summarizeIssue(issue); err == nil && summary != "" {
 			result.WriteString(fmt.Sprintf("    🤖 %s\n", summary))
+		} else if err != nil {
+			g.warnLLM("Summarizing issues", err)
 		}
 	}
 	
-	if g.config.Detailed {
+	if detailed {
 		result.WriteString(fmt.Sprintf("    Priority: %s %s | Status: %s\n", 
 			priorityIcon,
 			issue.Fields.Priority.Name,
-			issue.Fields.Status.Name))
+			g.StatusLabel(issue.Fields.Status.Name)))
 		result.WriteString(fmt.Sprintf("    Updated: %s\n", 
 			issue.Fields.Updated.Time.Format("Jan 2, 15:04")))
 		
@@ -461,26 +639,33 @@ func (g *Generator) formatIssueConsole(issue jira.Issue) string {
 		}
 	}
 	
+	if duplicate := g.duplicates[issue.Key]; duplicate != "" {
+		result.WriteString(fmt.Sprintf("    🔁 Possible duplicate of %s\n", duplicate))
+	}
+	
 	result.WriteString("\n")
 	return result.String()
 }
 
 func (g *Generator) formatIssueMarkdown(issue jira.Issue) string {
-	statusIcon := getStatusIcon(issue.Fields.Status.Name)
+	statusIcon := g.statusIcon(issue.Fields.Status.Name)
 	priorityIcon := getPriorityIcon(issue.Fields.Priority.Name)
 	
 	result := fmt.Sprintf("- %s **[%s]** %s\n", statusIcon, issue.Key, issue.Fields.Summary)
 	
 	// Add AI summary if enabled and detailed mode
-	if g.config.LLMEnabled && g.config.Detailed {
-		if summary, err := g.summarizer.SummarizeIssue(issue); err == nil && summary != "" {
+	summarize, detailed, _ := g.nextIssueDetail()
+	if summarize && detailed {
+		if summary, err := g.summarizeIssue(issue); err == nil && summary != "" {
 			result += fmt.Sprintf("  - 🤖 **AI Summary**: %s\n", summary)
+		} else if err != nil {
+			g.warnLLM("Summarizing issues", err)
 		}
 	}
 	
-	if g.config.Detailed {
+	if detailed {
 		result += fmt.Sprintf("  - Priority: %s %s\n", priorityIcon, issue.Fields.Priority.Name)
-		result += fmt.Sprintf("  - Status: %s\n", issue.Fields.Status.Name)
+		result += fmt.Sprintf("  - Status: %s\n", g.StatusLabel(issue.Fields.Status.Name))
 		result += fmt.Sprintf("  - Updated: %s\n", issue.Fields.Updated.Time.Format("Jan 2, 15:04"))
 		
 		if issue.Fields.Description.Text != "" {
@@ -488,14 +673,19 @@ func (g *Generator) formatIssueMarkdown(issue jira.Issue) string {
 		}
 	}
 	
+	if duplicate := g.duplicates[issue.Key]; duplicate != "" {
+		result += fmt.Sprintf("  - 🔁 Possible duplicate of **%s**\n", duplicate)
+	}
+	
 	result += "\n"
 	return result
 }
 
 func (g *Generator) formatWorklogConsole(worklog jira.WorklogEntry) string {
-	result := fmt.Sprintf("  ⏱️  [%s] %s\n", 
+	result := fmt.Sprintf("  ⏱️  [%s] %s%s\n", 
 		worklog.IssueID,
-		worklog.Started.Time.Format("Jan 2, 15:04"))
+		worklog.Started.Time.Format("Jan 2, 15:04"),
+		worklogDuration(worklog))
 	
 	if worklog.Comment != "" {
 		result += fmt.Sprintf("    %s\n", worklog.Comment)
@@ -506,9 +696,10 @@ func (g *Generator) formatWorklogConsole(worklog jira.WorklogEntry) string {
 }
 
 func (g *Generator) formatWorklogMarkdown(worklog jira.WorklogEntry) string {
-	result := fmt.Sprintf("- ⏱️ **[%s]** %s\n", 
+	result := fmt.Sprintf("- ⏱️ **[%s]** %s%s\n", 
 		worklog.IssueID,
-		worklog.Started.Time.Format("Jan 2, 15:04"))
+		worklog.Started.Time.Format("Jan 2, 15:04"),
+		worklogDuration(worklog))
 	
 	if worklog.Comment != "" {
 		result += fmt.Sprintf("  - %s\n", worklog.Comment)
@@ -518,6 +709,22 @@ func (g *Generator) formatWorklogMarkdown(worklog jira.WorklogEntry) string {
 	return result
 }
 
+// formatMeetingsSummary returns the summary line about attended meetings, or "" without a calendar
+func (g *Generator) formatMeetingsSummary(bullet string) string {
+	if g.config.MeetingsSummary == "" {
+		return ""
+	}
+	return bullet + g.config.MeetingsSummary + "\n"
+}
+
+// worklogDuration returns the time spent on a worklog as a suffix such as " (1h 30m)"
+func worklogDuration(worklog jira.WorklogEntry) string {
+	if worklog.TimeSpentSeconds <= 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (%s)", formatTrackedTime(time.Duration(worklog.TimeSpentSeconds)*time.Second))
+}
+
 // Helper functions
 
 func isInProgress(issue jira.Issue) bool {
@@ -538,6 +745,42 @@ func getStatusCategory(issue jira.Issue) int {
 	}
 }
 
+// FilterIssues returns the issues the report includes for the target date, in report order
+func (g *Generator) FilterIssues(issues []jira.Issue, targetDate time.Time) []jira.Issue {
+	return g.filterIssues(issues, targetDate)
+}
+
+// FilterWorklogs returns the worklog entries the report includes for the target date
+func (g *Generator) FilterWorklogs(worklogs []jira.WorklogEntry, targetDate time.Time) []jira.WorklogEntry {
+	return g.filterWorklogs(worklogs, targetDate)
+}
+
+// SummarizeIssue returns a brief AI summary of one issue from your comments and the time logged on it
+func (g *Generator) SummarizeIssue(iwc IssueWithComments, worklogs []jira.WorklogEntry) (string, error) {
+	if !g.config.LLMEnabled {
+		return "", fmt.Errorf("AI summaries are disabled (llm.enabled is false or --no-llm is set)")
+	}
+
+	var issueWorklogs []jira.WorklogEntry
+	for _, worklog := range worklogs {
+		if worklog.IssueID == iwc.Issue.ID || worklog.IssueID == iwc.Issue.Key {
+			issueWorklogs = append(issueWorklogs, worklog)
+		}
+	}
+
+	summarizer, err := llm.NewSummarizer(newLLMConfig(g.config, "brief"))
+	if err != nil {
+		return "", fmt.Errorf("failed to create summarizer: %w", err)
+	}
+	trackComments(summarizer, []IssueWithComments{iwc})
+	return summarizer.GenerateStandupSummaryWithComments([]jira.Issue{iwc.Issue}, iwc.Comments, issueWorklogs)
+}
+
+// GroupIssuesByStatus groups issues into "In Progress", "To Do", "Done" and "Other" by status category
+func GroupIssuesByStatus(issues []jira.Issue) map[string][]jira.Issue {
+	return groupIssuesByStatus(issues)
+}
+
 func groupIssuesByStatus(issues []jira.Issue) map[string][]jira.Issue {
 	groups := make(map[string][]jira.Issue)
 	
@@ -604,6 +847,34 @@ func truncateString(s string, maxLen int) string {
 }
 
 // min returns the minimum of two integers
+// commentExcerpt shortens a comment to at most maxRunes runes, breaking at a word boundary
+// where possible and noting how many lines were left out. A maxRunes of 0 disables truncation.
+func commentExcerpt(text string, maxRunes int) string {
+	text = strings.TrimSpace(text)
+	runes := []rune(text)
+	if maxRunes <= 0 || len(runes) <= maxRunes {
+		return text
+	}
+
+	excerpt := string(runes[:maxRunes])
+	if cut := strings.LastIndexAny(excerpt, " \t\n"); cut > len(excerpt)/2 {
+		excerpt = excerpt[:cut]
+	}
+	excerpt = strings.TrimRightFunc(excerpt, unicode.IsSpace)
+
+	totalLines := strings.Count(text, "\n") + 1
+	shownLines := strings.Count(excerpt, "\n") + 1
+	if hidden := totalLines - shownLines; hidden > 0 {
+		return fmt.Sprintf("%s… (+%d more lines)", excerpt, hidden)
+	}
+	return excerpt + "…"
+}
+
+// indentContinuation indents every line after the first so multi-line text stays inside its list item
+func indentContinuation(text, indent string) string {
+	return strings.ReplaceAll(text, "\n", "\n"+indent)
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
@@ -633,7 +904,7 @@ func hasMeaningfulComments(comments []jira.Comment) bool {
 func (g *Generator) formatIssueConsoleWithComments(issue jira.Issue, comments []jira.Comment) string {
 	var result strings.Builder
 	
-	statusIcon := getStatusIcon(issue.Fields.Status.Name)
+	statusIcon := g.statusIcon(issue.Fields.Status.Name)
 	priorityIcon := getPriorityIcon(issue.Fields.Priority.Name)
 	
 	result.WriteString(fmt.Sprintf("  %s %s [%s] %s\n", 
@@ -643,31 +914,41 @@ func (g *Generator) formatIssueConsoleWithComments(issue jira.Issue, comments []
 		issue.Fields.Summary))
 	
 	// Add comment summary if enabled
-	if g.config.LLMEnabled && len(comments) > 0 {
-		if summary, err := g.summarizer.SummarizeComments(comments); err == nil && summary != "" {
-			result.WriteString(fmt.Sprintf("    💬 Today's work: %s\n", summary))
+	summarize, detailed, excerptRunes := g.nextIssueDetail()
+	if summarize && len(comments) > 0 {
+		if summary, err := g.summarizeComments(comments); err == nil && summary != "" {
+			result.WriteString(fmt.Sprintf("    💬 Today's work: %s%s\n", summary, g.qualityNote(summary, 1, len(comments), FormatConsole)))
+		} else if err != nil {
+			g.warnLLM("Summarizing issues", err)
 		}
 	}
+	if changes := g.statusChanges(issue); changes != "" {
+		result.WriteString(fmt.Sprintf("    🔀 Status changes %s: %s\n", g.reportPeriod(), changes))
+	}
 	
-	if g.config.Detailed {
+	if detailed {
 		result.WriteString(fmt.Sprintf("    Priority: %s %s | Status: %s\n", 
 			priorityIcon,
 			issue.Fields.Priority.Name,
-			issue.Fields.Status.Name))
+			g.StatusLabel(issue.Fields.Status.Name)))
 		result.WriteString(fmt.Sprintf("    Updated: %s\n", 
 			issue.Fields.Updated.Time.Format("Jan 2, 15:04")))
 		
 		// Show comment count and latest comment
 		if len(comments) > 0 {
-			result.WriteString(fmt.Sprintf("    Comments today: %d\n", len(comments)))
+			result.WriteString(fmt.Sprintf("    Comments %s: %d\n", g.reportPeriod(), len(comments)))
 			if len(comments) > 0 {
 				latestComment := comments[len(comments)-1]
-				// Show full comment text without truncation
-				result.WriteString(fmt.Sprintf("    Latest: %s\n", latestComment.Body.Text))
+				excerpt := commentExcerpt(latestComment.Body.Text, excerptRunes)
+				result.WriteString(fmt.Sprintf("    Latest: %s\n", indentContinuation(excerpt, "      ")))
 			}
 		}
 	}
 	
+	if duplicate := g.duplicates[issue.Key]; duplicate != "" {
+		result.WriteString(fmt.Sprintf("    🔁 Possible duplicate of %s\n", duplicate))
+	}
+	
 	result.WriteString("\n")
 	return result.String()
 }
@@ -676,22 +957,25 @@ func (g *Generator) generateMarkdownWithComments(issues []jira.Issue, commentsMa
 	var report strings.Builder
 	
 	// Header
-	report.WriteString(fmt.Sprintf("# Daily Standup Report - %s\n\n", targetDate.Format("January 2, 2006")))
-	report.WriteString("*Issues with your comments today*\n\n")
+	report.WriteString(fmt.Sprintf("# %s\n\n", g.reportTitle(targetDate)))
+	report.WriteString(fmt.Sprintf("*Issues with your comments %s*\n\n", g.reportPeriod()))
 
 	// AI Summary if enabled - based on comments
-	if g.config.LLMEnabled {
+	if g.config.LLMEnabled && !g.config.Hide.AISummary {
 		allComments := []jira.Comment{}
 		for _, comments := range commentsMap {
 			allComments = append(allComments, comments...)
 		}
+		allComments = g.withCodeActivityComments(allComments, targetDate)
 		
 		if hasMeaningfulComments(allComments) {
 			// Use the enhanced LLM method for intelligent summary
-			summary, err := g.summarizer.GenerateStandupSummaryWithComments(issues, allComments, worklogs)
+			summary, err := g.daySummary(issues, allComments, worklogs, FormatMarkdown)
 			if err == nil && summary != "" {
 				report.WriteString("## 🤖 AI Summary of Today's Work\n\n")
 				report.WriteString(fmt.Sprintf("%s\n\n", summary))
+			} else if err != nil {
+				g.warnLLM("Summarizing your day", err)
 			}
 		} else if len(allComments) > 0 {
 			// Show warning when there are comments but they're not meaningful enough for AI summary
@@ -702,15 +986,20 @@ func (g *Generator) generateMarkdownWithComments(issues []jira.Issue, commentsMa
 	}
 
 	// Summary
-	report.WriteString("## Summary\n\n")
-	report.WriteString(fmt.Sprintf("- **Issues with comments today**: %d\n", len(issues)))
+	if !g.config.Hide.Summary {
+		report.WriteString("## Summary\n\n")
+		report.WriteString(fmt.Sprintf("- **Issues with comments %s**: %d\n", g.reportPeriod(), len(issues)))
 	
-	totalComments := 0
-	for _, comments := range commentsMap {
-		totalComments += len(comments)
+		totalComments := 0
+		for _, comments := range commentsMap {
+			totalComments += len(comments)
+		}
+		report.WriteString(fmt.Sprintf("- **Total comments added**: %d\n", totalComments))
+		report.WriteString(fmt.Sprintf("- **Worklog entries**: %d\n", len(worklogs)))
+		report.WriteString(g.formatTimeLogged("- **Time logged**: %s\n", worklogs))
+		report.WriteString(g.formatMeetingsSummary("- "))
+		report.WriteString("\n")
 	}
-	report.WriteString(fmt.Sprintf("- **Total comments added**: %d\n", totalComments))
-	report.WriteString(fmt.Sprintf("- **Worklog entries**: %d\n\n", len(worklogs)))
 
 	// Group issues by status
 	statusGroups := groupIssuesByStatus(issues)
@@ -734,7 +1023,7 @@ func (g *Generator) generateMarkdownWithComments(issues []jira.Issue, commentsMa
 	}
 
 	// To Do section
-	if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 {
+	if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 && !g.config.Hide.ToDo {
 		report.WriteString("## 📋 To Do\n\n")
 		for _, issue := range todo {
 			report.WriteString(g.formatIssueMarkdownWithComments(issue, commentsMap[issue.Key]))
@@ -742,57 +1031,66 @@ func (g *Generator) generateMarkdownWithComments(issues []jira.Issue, commentsMa
 		report.WriteString("\n")
 	}
 
-	// Worklog section
-	if len(worklogs) > 0 {
-		report.WriteString("## ⏰ Work Log\n\n")
-		for _, worklog := range worklogs {
-			report.WriteString(g.formatWorklogMarkdown(worklog))
-		}
-		report.WriteString("\n")
-	}
+	// Estimates, code activity, work log and other integration sections
+	report.WriteString(g.renderSections(SectionModel{TargetDate: targetDate, Issues: issues, Worklogs: worklogs, Comments: commentsMap}, FormatMarkdown))
 
 	// Footer
-	report.WriteString("---\n")
-	report.WriteString("*Generated by my-day CLI*\n")
+	if !g.config.Hide.Footer {
+		report.WriteString("---\n")
+		report.WriteString("*Generated by my-day CLI*\n")
+	}
 
 	return report.String(), nil
 }
 
 func (g *Generator) formatIssueMarkdownWithComments(issue jira.Issue, comments []jira.Comment) string {
-	statusIcon := getStatusIcon(issue.Fields.Status.Name)
+	statusIcon := g.statusIcon(issue.Fields.Status.Name)
 	priorityIcon := getPriorityIcon(issue.Fields.Priority.Name)
 	
 	result := fmt.Sprintf("- %s **[%s]** %s\n", statusIcon, issue.Key, issue.Fields.Summary)
 	
 	// Add comment summary if enabled
-	if g.config.LLMEnabled && len(comments) > 0 {
-		if summary, err := g.summarizer.SummarizeComments(comments); err == nil && summary != "" {
-			result += fmt.Sprintf("  - 💬 **Today's work**: %s\n", summary)
+	summarize, detailed, excerptRunes := g.nextIssueDetail()
+	if summarize && len(comments) > 0 {
+		if summary, err := g.summarizeComments(comments); err == nil && summary != "" {
+			result += fmt.Sprintf("  - 💬 **Today's work**: %s%s\n", summary, g.qualityNote(summary, 1, len(comments), FormatMarkdown))
+		} else if err != nil {
+			g.warnLLM("Summarizing issues", err)
 		}
 	}
+	if changes := g.statusChanges(issue); changes != "" {
+		result += fmt.Sprintf("  - 🔀 **Status changes %s**: %s\n", g.reportPeriod(), changes)
+	}
 	
-	if g.config.Detailed {
+	if detailed {
 		result += fmt.Sprintf("  - Priority: %s %s\n", priorityIcon, issue.Fields.Priority.Name)
-		result += fmt.Sprintf("  - Status: %s\n", issue.Fields.Status.Name)
+		result += fmt.Sprintf("  - Status: %s\n", g.StatusLabel(issue.Fields.Status.Name))
 		result += fmt.Sprintf("  - Updated: %s\n", issue.Fields.Updated.Time.Format("Jan 2, 15:04"))
 		
 		// Show comment count and latest comment
 		if len(comments) > 0 {
-			result += fmt.Sprintf("  - Comments today: %d\n", len(comments))
+			result += fmt.Sprintf("  - Comments %s: %d\n", g.reportPeriod(), len(comments))
 			if len(comments) > 0 {
 				latestComment := comments[len(comments)-1]
-				// Show full comment text without truncation
-				result += fmt.Sprintf("  - Latest comment: %s\n", latestComment.Body.Text)
+				excerpt := commentExcerpt(latestComment.Body.Text, excerptRunes)
+				result += fmt.Sprintf("  - Latest comment: %s\n", indentContinuation(excerpt, "    "))
 			}
 		}
 	}
 	
+	if duplicate := g.duplicates[issue.Key]; duplicate != "" {
+		result += fmt.Sprintf("  - 🔁 Possible duplicate of **%s**\n", duplicate)
+	}
+	
 	result += "\n"
 	return result
 }
 
 // GenerateWithEnhancedContext creates a report using enhanced LLM processing with additional context
 func (g *Generator) GenerateWithEnhancedContext(issuesWithComments []IssueWithComments, worklogs []jira.WorklogEntry, targetDate time.Time) (string, error) {
+	g.startReport()
+	trackComments(g.summarizer, issuesWithComments)
+
 	// Extract just the issues for filtering
 	var issues []jira.Issue
 	var allComments []jira.Comment
@@ -804,6 +1102,8 @@ func (g *Generator) GenerateWithEnhancedContext(issuesWithComments []IssueWithCo
 	// Filter issues and worklogs
 	filteredIssues := g.filterIssues(issues, targetDate)
 	filteredWorklogs := g.filterWorklogs(worklogs, targetDate)
+	g.planReport(filteredIssues)
+	g.duplicates = findDuplicates(filteredIssues, g.config.DuplicateSimilarity)
 
 	// Create a map of issue key to comments for quick lookup
 	commentsMap := make(map[string][]jira.Comment)
@@ -818,9 +1118,9 @@ func (g *Generator) GenerateWithEnhancedContext(issuesWithComments []IssueWithCo
 		
 		// Pass context to LLM summarizer if it supports enhanced context
 		if contextualSummarizer, ok := g.summarizer.(interface{ SetEnhancedContext(map[string]interface{}) error }); ok {
-			if err := contextualSummarizer.SetEnhancedContext(enhancedContext); err != nil && g.config.Debug {
+			if err := contextualSummarizer.SetEnhancedContext(enhancedContext); err != nil {
 				// Log error but continue processing
-				fmt.Printf("Warning: Failed to set enhanced context: %v\n", err)
+				slog.Debug("Failed to set enhanced context", "error", err)
 			}
 		}
 	}
@@ -829,9 +1129,15 @@ func (g *Generator) GenerateWithEnhancedContext(issuesWithComments []IssueWithCo
 	var reportContent string
 	var err error
 	
-	switch g.config.Format {
-	case "markdown":
+	switch {
+	case g.config.TemplatePath != "":
+		reportContent, err = g.generateFromTemplate(filteredIssues, commentsMap, filteredWorklogs, targetDate)
+	case g.isStandupLayout():
+		reportContent, err = g.generateStandup(filteredIssues, issues, commentsMap, filteredWorklogs, targetDate)
+	case g.config.Format == "markdown":
 		reportContent, err = g.generateMarkdownWithEnhancedContext(filteredIssues, commentsMap, filteredWorklogs, targetDate)
+	case g.config.Format == "html":
+		reportContent, err = g.generateHTML(filteredIssues, commentsMap, filteredWorklogs, targetDate, "")
 	default:
 		reportContent, err = g.generateConsoleWithEnhancedContext(filteredIssues, commentsMap, filteredWorklogs, targetDate)
 	}
@@ -918,16 +1224,17 @@ func (g *Generator) generateConsoleWithEnhancedContext(issues []jira.Issue, comm
 	var report strings.Builder
 	
 	// Header
-	report.WriteString(fmt.Sprintf("🚀 Daily Standup Report - %s\n", targetDate.Format("January 2, 2006")))
+	report.WriteString(fmt.Sprintf("🚀 %s\n", g.reportTitle(targetDate)))
 	report.WriteString(strings.Repeat("=", 50) + "\n")
-	report.WriteString("📝 Issues with your comments today (Enhanced Analysis)\n\n")
+	report.WriteString(fmt.Sprintf("📝 Issues with your comments %s (Enhanced Analysis)\n\n", g.reportPeriod()))
 
 	// AI Summary if enabled - with enhanced processing
-	if g.config.LLMEnabled {
+	if g.config.LLMEnabled && !g.config.Hide.AISummary {
 		allComments := []jira.Comment{}
 		for _, comments := range commentsMap {
 			allComments = append(allComments, comments...)
 		}
+		allComments = g.withCodeActivityComments(allComments, targetDate)
 		
 		if hasMeaningfulComments(allComments) {
 			// Use enhanced data processor for better analysis
@@ -940,12 +1247,12 @@ func (g *Generator) generateConsoleWithEnhancedContext(issues []jira.Issue, comm
 				keyActivities := processedData.GetKeyActivities()
 				
 				report.WriteString("🤖 AI SUMMARY OF TODAY'S WORK (Enhanced)\n")
-				report.WriteString(fmt.Sprintf("%s\n", summary))
+				report.WriteString(fmt.Sprintf("%s%s\n", summary, g.qualityNote(summary, len(issues), len(allComments), FormatConsole)))
 				
 				if len(keyActivities) > 0 {
 					report.WriteString("🔑 Key Activities:\n")
 					for _, activity := range keyActivities {
-						report.WriteString(fmt.Sprintf("  • %s\n", activity))
+						report.WriteString(fmt.Sprintf("  • %s%s\n", activity, g.confidenceNote(activity, FormatConsole)))
 					}
 				}
 				report.WriteString("\n")
@@ -960,10 +1267,12 @@ func (g *Generator) generateConsoleWithEnhancedContext(issues []jira.Issue, comm
 				}
 			} else {
 				// Fallback to standard summary generation
-				summary, err := g.summarizer.GenerateStandupSummaryWithComments(issues, allComments, worklogs)
+				summary, err := g.daySummary(issues, allComments, worklogs, FormatConsole)
 				if err == nil && summary != "" {
 					report.WriteString("🤖 AI SUMMARY OF TODAY'S WORK\n")
-					report.WriteString(fmt.Sprintf("%s\n\n", summary))
+					report.WriteString(fmt.Sprintf("%s%s\n\n", summary, g.qualityNote(summary, len(issues), len(allComments), FormatConsole)))
+				} else if err != nil {
+					g.warnLLM("Summarizing your day", err)
 				}
 			}
 		} else if len(allComments) > 0 {
@@ -975,32 +1284,27 @@ func (g *Generator) generateConsoleWithEnhancedContext(issues []jira.Issue, comm
 	}
 
 	// Summary with enhanced metrics
-	report.WriteString("📊 SUMMARY\n")
-	report.WriteString(fmt.Sprintf("• Issues with comments today: %d\n", len(issues)))
-	
-	totalComments := 0
-	for _, comments := range commentsMap {
-		totalComments += len(comments)
-	}
-	report.WriteString(fmt.Sprintf("• Total comments added: %d\n", totalComments))
-	report.WriteString(fmt.Sprintf("• Worklog entries: %d\n", len(worklogs)))
-	
-	// Add technical context summary if available
-	if g.config.LLMEnabled {
-		allComments := []jira.Comment{}
-		for _, comments := range commentsMap {
-			allComments = append(allComments, comments...)
-		}
+	if !g.config.Hide.Summary {
+		report.WriteString("📊 SUMMARY\n")
+		report.WriteString(g.formatSummaryTable(issues, commentsMap, worklogs))
+	
+		// Add technical context summary if available
+		if g.config.LLMEnabled {
+			allComments := []jira.Comment{}
+			for _, comments := range commentsMap {
+				allComments = append(allComments, comments...)
+			}
 		
-		processor := llm.NewEnhancedDataProcessor(g.config.Debug)
-		if processedData, err := processor.ProcessIssuesWithComments(issues, allComments); err == nil && processedData != nil {
-			if processedData.TechnicalContext != nil && len(processedData.TechnicalContext.Technologies) > 0 {
-				report.WriteString(fmt.Sprintf("• Technologies involved: %s\n", 
-					strings.Join(processedData.TechnicalContext.Technologies[:min(5, len(processedData.TechnicalContext.Technologies))], ", ")))
+			processor := llm.NewEnhancedDataProcessor(g.config.Debug)
+			if processedData, err := processor.ProcessIssuesWithComments(issues, allComments); err == nil && processedData != nil {
+				if processedData.TechnicalContext != nil && len(processedData.TechnicalContext.Technologies) > 0 {
+					report.WriteString(fmt.Sprintf("  Technologies involved: %s\n", 
+						strings.Join(processedData.TechnicalContext.Technologies[:min(5, len(processedData.TechnicalContext.Technologies))], ", ")))
+				}
 			}
 		}
+		report.WriteString("\n")
 	}
-	report.WriteString("\n")
 
 	// Group issues by status
 	statusGroups := groupIssuesByStatus(issues)
@@ -1024,7 +1328,7 @@ func (g *Generator) generateConsoleWithEnhancedContext(issues []jira.Issue, comm
 	}
 
 	// To Do section
-	if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 {
+	if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 && !g.config.Hide.ToDo {
 		report.WriteString("📋 TO DO\n")
 		for _, issue := range todo {
 			report.WriteString(g.formatIssueConsoleWithComments(issue, commentsMap[issue.Key]))
@@ -1032,18 +1336,14 @@ func (g *Generator) generateConsoleWithEnhancedContext(issues []jira.Issue, comm
 		report.WriteString("\n")
 	}
 
-	// Worklog section
-	if len(worklogs) > 0 {
-		report.WriteString("⏰ WORK LOG\n")
-		for _, worklog := range worklogs {
-			report.WriteString(g.formatWorklogConsole(worklog))
-		}
-		report.WriteString("\n")
-	}
+	// Estimates, code activity, work log and other integration sections
+	report.WriteString(g.renderSections(SectionModel{TargetDate: targetDate, Issues: issues, Worklogs: worklogs, Comments: commentsMap}, FormatConsole))
 
 	// Footer
-	report.WriteString("---\n")
-	report.WriteString("Generated by my-day CLI 🤖 (Enhanced Mode)\n")
+	if !g.config.Hide.Footer {
+		report.WriteString("---\n")
+		report.WriteString("Generated by my-day CLI 🤖 (Enhanced Mode)\n")
+	}
 
 	return report.String(), nil
 }
@@ -1053,15 +1353,16 @@ func (g *Generator) generateMarkdownWithEnhancedContext(issues []jira.Issue, com
 	var report strings.Builder
 	
 	// Header
-	report.WriteString(fmt.Sprintf("# Daily Standup Report - %s\n\n", targetDate.Format("January 2, 2006")))
-	report.WriteString("*Issues with your comments today (Enhanced Analysis)*\n\n")
+	report.WriteString(fmt.Sprintf("# %s\n\n", g.reportTitle(targetDate)))
+	report.WriteString(fmt.Sprintf("*Issues with your comments %s (Enhanced Analysis)*\n\n", g.reportPeriod()))
 
 	// AI Summary if enabled - with enhanced processing
-	if g.config.LLMEnabled {
+	if g.config.LLMEnabled && !g.config.Hide.AISummary {
 		allComments := []jira.Comment{}
 		for _, comments := range commentsMap {
 			allComments = append(allComments, comments...)
 		}
+		allComments = g.withCodeActivityComments(allComments, targetDate)
 		
 		if hasMeaningfulComments(allComments) {
 			// Use enhanced data processor for better analysis
@@ -1074,12 +1375,12 @@ func (g *Generator) generateMarkdownWithEnhancedContext(issues []jira.Issue, com
 				keyActivities := processedData.GetKeyActivities()
 				
 				report.WriteString("## 🤖 AI Summary of Today's Work (Enhanced)\n\n")
-				report.WriteString(fmt.Sprintf("%s\n\n", summary))
+				report.WriteString(fmt.Sprintf("%s%s\n\n", summary, g.qualityNote(summary, len(issues), len(allComments), FormatMarkdown)))
 				
 				if len(keyActivities) > 0 {
 					report.WriteString("### 🔑 Key Activities\n\n")
 					for _, activity := range keyActivities {
-						report.WriteString(fmt.Sprintf("- %s\n", activity))
+						report.WriteString(fmt.Sprintf("- %s%s\n", activity, g.confidenceNote(activity, FormatMarkdown)))
 					}
 					report.WriteString("\n")
 				}
@@ -1096,10 +1397,12 @@ func (g *Generator) generateMarkdownWithEnhancedContext(issues []jira.Issue, com
 				}
 			} else {
 				// Fallback to standard summary generation
-				summary, err := g.summarizer.GenerateStandupSummaryWithComments(issues, allComments, worklogs)
+				summary, err := g.daySummary(issues, allComments, worklogs, FormatMarkdown)
 				if err == nil && summary != "" {
 					report.WriteString("## 🤖 AI Summary of Today's Work\n\n")
-					report.WriteString(fmt.Sprintf("%s\n\n", summary))
+					report.WriteString(fmt.Sprintf("%s%s\n\n", summary, g.qualityNote(summary, len(issues), len(allComments), FormatMarkdown)))
+				} else if err != nil {
+					g.warnLLM("Summarizing your day", err)
 				}
 			}
 		} else if len(allComments) > 0 {
@@ -1111,32 +1414,36 @@ func (g *Generator) generateMarkdownWithEnhancedContext(issues []jira.Issue, com
 	}
 
 	// Summary with enhanced metrics
-	report.WriteString("## Summary\n\n")
-	report.WriteString(fmt.Sprintf("- **Issues with comments today**: %d\n", len(issues)))
-	
-	totalComments := 0
-	for _, comments := range commentsMap {
-		totalComments += len(comments)
-	}
-	report.WriteString(fmt.Sprintf("- **Total comments added**: %d\n", totalComments))
-	report.WriteString(fmt.Sprintf("- **Worklog entries**: %d\n", len(worklogs)))
+	if !g.config.Hide.Summary {
+		report.WriteString("## Summary\n\n")
+		report.WriteString(fmt.Sprintf("- **Issues with comments %s**: %d\n", g.reportPeriod(), len(issues)))
 	
-	// Add technical context summary if available
-	if g.config.LLMEnabled {
-		allComments := []jira.Comment{}
+		totalComments := 0
 		for _, comments := range commentsMap {
-			allComments = append(allComments, comments...)
+			totalComments += len(comments)
 		}
+		report.WriteString(fmt.Sprintf("- **Total comments added**: %d\n", totalComments))
+		report.WriteString(fmt.Sprintf("- **Worklog entries**: %d\n", len(worklogs)))
+		report.WriteString(g.formatTimeLogged("- **Time logged**: %s\n", worklogs))
+		report.WriteString(g.formatMeetingsSummary("- "))
+	
+		// Add technical context summary if available
+		if g.config.LLMEnabled {
+			allComments := []jira.Comment{}
+			for _, comments := range commentsMap {
+				allComments = append(allComments, comments...)
+			}
 		
-		processor := llm.NewEnhancedDataProcessor(g.config.Debug)
-		if processedData, err := processor.ProcessIssuesWithComments(issues, allComments); err == nil && processedData != nil {
-			if processedData.TechnicalContext != nil && len(processedData.TechnicalContext.Technologies) > 0 {
-				report.WriteString(fmt.Sprintf("- **Technologies involved**: %s\n", 
-					strings.Join(processedData.TechnicalContext.Technologies[:min(5, len(processedData.TechnicalContext.Technologies))], ", ")))
+			processor := llm.NewEnhancedDataProcessor(g.config.Debug)
+			if processedData, err := processor.ProcessIssuesWithComments(issues, allComments); err == nil && processedData != nil {
+				if processedData.TechnicalContext != nil && len(processedData.TechnicalContext.Technologies) > 0 {
+					report.WriteString(fmt.Sprintf("- **Technologies involved**: %s\n", 
+						strings.Join(processedData.TechnicalContext.Technologies[:min(5, len(processedData.TechnicalContext.Technologies))], ", ")))
+				}
 			}
 		}
+		report.WriteString("\n")
 	}
-	report.WriteString("\n")
 
 	// Group issues by status
 	statusGroups := groupIssuesByStatus(issues)
@@ -1160,7 +1467,7 @@ func (g *Generator) generateMarkdownWithEnhancedContext(issues []jira.Issue, com
 	}
 
 	// To Do section
-	if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 {
+	if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 && !g.config.Hide.ToDo {
 		report.WriteString("## 📋 To Do\n\n")
 		for _, issue := range todo {
 			report.WriteString(g.formatIssueMarkdownWithComments(issue, commentsMap[issue.Key]))
@@ -1168,18 +1475,14 @@ func (g *Generator) generateMarkdownWithEnhancedContext(issues []jira.Issue, com
 		report.WriteString("\n")
 	}
 
-	// Worklog section
-	if len(worklogs) > 0 {
-		report.WriteString("## ⏰ Work Log\n\n")
-		for _, worklog := range worklogs {
-			report.WriteString(g.formatWorklogMarkdown(worklog))
-		}
-		report.WriteString("\n")
-	}
+	// Estimates, code activity, work log and other integration sections
+	report.WriteString(g.renderSections(SectionModel{TargetDate: targetDate, Issues: issues, Worklogs: worklogs, Comments: commentsMap}, FormatMarkdown))
 
 	// Footer
-	report.WriteString("---\n")
-	report.WriteString("*Generated by my-day CLI (Enhanced Mode)*\n")
+	if !g.config.Hide.Footer {
+		report.WriteString("---\n")
+		report.WriteString("*Generated by my-day CLI (Enhanced Mode)*\n")
+	}
 
 	return report.String(), nil
 }
@@ -1273,9 +1576,19 @@ func (g *Generator) generateDebugInformation() (string, error) {
 	return debugOutput.String(), nil
 }
 
+// DebugReport returns the LLM processing report of the last report generated with Debug set,
+// or nil when the summarizer does not keep one
+func (g *Generator) DebugReport() (*llm.DebugReport, error) {
+	debuggable, ok := g.summarizer.(interface{ GetDebugReport() (*llm.DebugReport, error) })
+	if !ok {
+		return nil, nil
+	}
+	return debuggable.GetDebugReport()
+}
+
 // generateSummaryQualityIndicators creates quality metrics for the generated summary
 func (g *Generator) generateSummaryQualityIndicators(summary string, issueCount int, commentCount int) string {
-	if !g.config.ShowQuality {
+	if !g.config.ShowQuality || g.config.Hide.Quality {
 		return ""
 	}
 
@@ -1284,56 +1597,7 @@ func (g *Generator) generateSummaryQualityIndicators(summary string, issueCount
 	quality.WriteString("\n📊 SUMMARY QUALITY INDICATORS\n")
 	quality.WriteString(strings.Repeat("-", 30) + "\n")
 
-	// Calculate basic quality metrics
-	summaryLength := len(summary)
-	_ = len(strings.Fields(summary))
-	
-	// Quality scoring (simple heuristic)
-	var qualityScore float64 = 0
-	var qualityFactors []string
-
-	// Length appropriateness (50-300 characters is good)
-	if summaryLength >= 50 && summaryLength <= 300 {
-		qualityScore += 25
-		qualityFactors = append(qualityFactors, "✓ Appropriate length")
-	} else if summaryLength < 50 {
-		qualityFactors = append(qualityFactors, "⚠ Summary might be too brief")
-	} else {
-		qualityFactors = append(qualityFactors, "⚠ Summary might be too verbose")
-	}
-
-	// Content richness (more than just counts)
-	if !strings.Contains(summary, "issues") || !strings.Contains(summary, "comments") {
-		qualityScore += 25
-		qualityFactors = append(qualityFactors, "✓ Contains meaningful content")
-	} else {
-		qualityFactors = append(qualityFactors, "⚠ May be too generic")
-	}
-
-	// Technical context (contains technical terms)
-	technicalTerms := []string{"deploy", "config", "test", "fix", "update", "implement", "review"}
-	technicalCount := 0
-	for _, term := range technicalTerms {
-		if strings.Contains(strings.ToLower(summary), term) {
-			technicalCount++
-		}
-	}
-	
-	if technicalCount > 0 {
-		qualityScore += 25
-		qualityFactors = append(qualityFactors, fmt.Sprintf("✓ Contains %d technical terms", technicalCount))
-	} else {
-		qualityFactors = append(qualityFactors, "⚠ Limited technical context")
-	}
-	
-	// Data completeness (has both issues and comments)
-	if issueCount > 0 && commentCount > 0 {
-		qualityScore += 25
-		qualityFactors = append(qualityFactors, "✓ Complete data available")
-	} else {
-		qualityFactors = append(qualityFactors, "⚠ Limited data available")
-	}
-
+	qualityScore, qualityFactors := llm.SummaryQuality(summary, issueCount, commentCount)
 	quality.WriteString(fmt.Sprintf("Overall Quality Score: %.0f/100\n", qualityScore))
 	quality.WriteString("\nQuality Factors:\n")
 	for _, factor := range qualityFactors {
@@ -1372,33 +1636,77 @@ func removeDuplicates(slice []string) []string {
 	return result
 }
 
+// ExportFilePath returns the path of the Obsidian export of a date, expanding a ~ folder path
+func ExportFilePath(folderPath, fileDate string, date time.Time) (string, error) {
+	folderPath, err := expandHome(folderPath)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(folderPath, date.Format(fileDate)+".md"), nil
+}
+
+// expandHome expands a path starting with ~/ to the home directory
+func expandHome(path string) (string, error) {
+	if !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, path[2:]), nil
+}
+
 // ExportToObsidian exports the report content to Obsidian-compatible markdown
 func (g *Generator) ExportToObsidian(reportContent string, targetDate time.Time) error {
 	if !g.config.ExportEnabled {
 		return nil
 	}
 
-	// Expand tilde in folder path
-	folderPath := g.config.ExportFolderPath
-	if strings.HasPrefix(folderPath, "~/") {
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			return fmt.Errorf("failed to get home directory: %w", err)
-		}
-		folderPath = filepath.Join(homeDir, folderPath[2:])
+	if g.config.Format == "html" {
+		return fmt.Errorf("Obsidian export requires console or markdown format, not html")
+	}
+
+	filePath, err := g.ObsidianExportPath(targetDate)
+	if err != nil {
+		return err
 	}
 
 	// Create folder if it doesn't exist
-	if err := os.MkdirAll(folderPath, 0755); err != nil {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
 		return fmt.Errorf("failed to create export folder: %w", err)
 	}
 
-	// Generate filename with date
-	filename := targetDate.Format(g.config.ExportFileDate) + ".md"
-	filePath := filepath.Join(folderPath, filename)
+	// Link the issues of the report to their notes
+	mentioned := g.mentionedIssues(reportContent)
+	if g.config.ExportWikiLinks {
+		reportContent = wikiLinkIssueKeys(reportContent, mentioned)
+	}
+	if g.config.IssueNotes != nil {
+		if err := writeIssueNotes(g.config.IssueNotes, mentioned); err != nil {
+			return err
+		}
+	}
+
+	// Create Obsidian-compatible content with frontmatter, unless the report goes into the
+	// daily note, which has its own
+	obsidianContent := reportContent
+	if g.config.DailyNote == nil {
+		obsidianContent = g.generateObsidianMarkdown(reportContent, targetDate)
+	}
+
+	// Repair structure broken by comment content (unclosed code fences, stray headings)
+	obsidianContent, problems := RepairMarkdown(obsidianContent)
+	if len(problems) > 0 {
+		slog.Warn("Repaired markdown problems before export", "problems", len(problems))
+		for _, problem := range problems {
+			slog.Debug("Repaired markdown problem", "problem", problem.String())
+		}
+	}
 
-	// Create Obsidian-compatible content with frontmatter
-	obsidianContent := g.generateObsidianMarkdown(reportContent, targetDate)
+	if g.config.DailyNote != nil {
+		return writeDailyNote(filePath, g.config.DailyNote.Heading, obsidianContent)
+	}
 
 	// Write to file
 	if err := os.WriteFile(filePath, []byte(obsidianContent), 0644); err != nil {
@@ -1415,9 +1723,17 @@ func (g *Generator) generateObsidianMarkdown(reportContent string, targetDate ti
 	// Add YAML frontmatter
 	content.WriteString("---\n")
 	content.WriteString(fmt.Sprintf("date: %s\n", targetDate.Format("2006-01-02")))
-	content.WriteString(fmt.Sprintf("title: Daily Standup Report - %s\n", targetDate.Format("January 2, 2006")))
+	content.WriteString(fmt.Sprintf("title: %s\n", g.reportTitle(targetDate)))
 	content.WriteString("type: daily-report\n")
 	
+	// Add numeric metrics for Dataview queries
+	if g.exportMetrics != nil {
+		content.WriteString(fmt.Sprintf("issues_done: %d\n", g.exportMetrics.IssuesDone))
+		content.WriteString(fmt.Sprintf("comments: %d\n", g.exportMetrics.Comments))
+		content.WriteString(fmt.Sprintf("hours_logged: %s\n", strconv.FormatFloat(g.exportMetrics.HoursLogged, 'f', -1, 64)))
+		content.WriteString(fmt.Sprintf("meetings_hours: %s\n", strconv.FormatFloat(g.exportMetrics.MeetingsHours, 'f', -1, 64)))
+	}
+	
 	// Add tags from config plus the date tag
 	allTags := append(g.config.ExportTags, targetDate.Format("2006-01-02"))
 	content.WriteString("tags:\n")
@@ -1457,12 +1773,15 @@ func (g *Generator) generateObsidianMarkdown(reportContent string, targetDate ti
 
 // generateFieldGroupedReport creates a report grouped by the specified custom field
 func (g *Generator) generateFieldGroupedReport(issues []jira.Issue, commentsMap map[string][]jira.Comment, worklogs []jira.WorklogEntry, targetDate time.Time, fieldName string) (string, error) {
-	// Group issues by the specified field value
-	fieldGroups := g.groupIssuesByField(issues, fieldName)
+	// Group issues by the value of the first field; the others nest within each group
+	fields := groupFields(fieldName)
+	fieldGroups := g.groupIssuesByField(issues, fields[0])
 	
 	switch g.config.Format {
 	case "markdown":
 		return g.generateMarkdownFieldGrouped(fieldGroups, commentsMap, worklogs, targetDate, fieldName)
+	case "html":
+		return g.generateHTML(issues, commentsMap, worklogs, targetDate, fields[0])
 	default:
 		return g.generateConsoleFieldGrouped(fieldGroups, commentsMap, worklogs, targetDate, fieldName)
 	}
@@ -1472,75 +1791,157 @@ func (g *Generator) generateFieldGroupedReport(issues []jira.Issue, commentsMap
 func (g *Generator) groupIssuesByField(issues []jira.Issue, fieldName string) map[string][]jira.Issue {
 	groups := make(map[string][]jira.Issue)
 	
+	// An issue with several values, e.g. two components, is listed under each
 	for _, issue := range issues {
-		fieldValue := g.getFieldValueByName(issue, fieldName)
-		if fieldValue == "" {
-			fieldValue = "Unassigned"
+		fieldValues := g.getFieldValuesByName(issue, fieldName)
+		if len(fieldValues) == 0 {
+			fieldValues = []string{"Unassigned"}
+		}
+		for _, fieldValue := range fieldValues {
+			groups[fieldValue] = append(groups[fieldValue], issue)
 		}
-		groups[fieldValue] = append(groups[fieldValue], issue)
 	}
 	
-	return groups
+	return g.parentGroups(groups, fieldName)
 }
 
-// getFieldValueByName gets the value of a field by its configured name
-func (g *Generator) getFieldValueByName(issue jira.Issue, fieldName string) string {
-	// Try to find the field ID from configuration
-	// For now, we'll use a simple heuristic to find common field mappings
-	fieldMapping := map[string]string{
-		"squad":     "customfield_12944", // Common Squad field
-		"team":      "customfield_12945", // Common Team field
-		"component": "customfield_12946", // Common Component field
-		"epic":      "customfield_10014", // Common Epic Link field
-		"sprint":    "customfield_10007", // Common Sprint field
+// sortedGroupNames returns group names sorted by name, or in board order when grouping by
+// column so the report mirrors the board. Groups not on the board come last.
+func (g *Generator) sortedGroupNames(fieldGroups map[string][]jira.Issue, fieldName string) []string {
+	var groupNames []string
+	for groupName := range fieldGroups {
+		groupNames = append(groupNames, groupName)
 	}
-	
-	if fieldID, exists := fieldMapping[strings.ToLower(fieldName)]; exists {
-		return issue.Fields.GetCustomFieldValue(fieldID)
+	sort.Strings(groupNames)
+
+	if strings.ToLower(fieldName) != "column" {
+		return groupNames
 	}
-	
-	// If no mapping found, try the field name as-is (might be a field ID)
-	if strings.HasPrefix(fieldName, "customfield_") {
-		return issue.Fields.GetCustomFieldValue(fieldName)
+
+	position := make(map[string]int)
+	for i, column := range g.config.BoardColumns.ColumnNames() {
+		position[column] = i + 1
+	}
+	sort.SliceStable(groupNames, func(i, j int) bool {
+		pi, pj := position[groupNames[i]], position[groupNames[j]]
+		if pi == 0 || pj == 0 {
+			return pi != 0 && pj == 0
+		}
+		return pi < pj
+	})
+	return groupNames
+}
+
+// getFieldValueByName gets the value of a field by its configured name, with the values of a
+// multi-value field joined
+func (g *Generator) getFieldValueByName(issue jira.Issue, fieldName string) string {
+	return strings.Join(g.getFieldValuesByName(issue, fieldName), ", ")
+}
+
+// getFieldValuesByName gets the values of a field by its name in jira.custom_fields, its
+// custom field ID or its standard name
+func (g *Generator) getFieldValuesByName(issue jira.Issue, fieldName string) []string {
+	if fieldID := customFieldID(g.config.CustomFields, fieldName); fieldID != "" {
+		values := issue.Fields.GetCustomFieldValues(fieldID)
+		if len(values) == 0 && strings.EqualFold(fieldName, "epic") {
+			return epicParent(issue)
+		}
+		return values
 	}
 	
 	// Try as a standard field
+	var value string
 	switch strings.ToLower(fieldName) {
 	case "project":
-		return issue.Fields.Project.Name
+		value = issue.Fields.Project.Name
 	case "priority":
-		return issue.Fields.Priority.Name
+		value = issue.Fields.Priority.Name
 	case "status":
-		return issue.Fields.Status.Name
+		value = issue.Fields.Status.Name
 	case "issuetype", "issue_type":
-		return issue.Fields.IssueType.Name
+		value = issue.Fields.IssueType.Name
 	case "assignee":
+		value = "Unassigned"
 		if issue.Fields.Assignee != nil {
-			return issue.Fields.Assignee.DisplayName
+			value = issue.Fields.Assignee.DisplayName
 		}
-		return "Unassigned"
 	case "reporter":
-		return issue.Fields.Reporter.DisplayName
+		value = issue.Fields.Reporter.DisplayName
+	case "column":
+		value = g.config.BoardColumns.Column(issue.Fields.Status)
+	case "parent":
+		if issue.Fields.Parent != nil {
+			value = issue.Fields.Parent.Key + " " + issue.Fields.Parent.Fields.Summary
+		}
+	case "epic":
+		return epicParent(issue)
+	}
+	if value == "" {
+		return nil
+	}
+	return []string{value}
+}
+
+// epicParent returns the epic of an issue in a team-managed project, which links to its epic as
+// its parent
+func epicParent(issue jira.Issue) []string {
+	if parent := issue.Fields.Parent; parent != nil && strings.EqualFold(parent.Fields.IssueType.Name, "Epic") {
+		return []string{parent.Key}
+	}
+	return nil
+}
+
+// customFieldID returns the ID of the custom field a --field name maps to in jira.custom_fields,
+// matched case-insensitively since config keys are read lowercased, or the name itself when it
+// is a custom field ID
+func customFieldID(customFields map[string]string, fieldName string) string {
+	if strings.HasPrefix(fieldName, "customfield_") {
+		return fieldName
+	}
+	for name, fieldID := range customFields {
+		if strings.EqualFold(name, fieldName) {
+			return fieldID
+		}
 	}
-	
 	return ""
 }
 
+// standardGroupFields are the fields issues can be grouped by without jira.custom_fields
+var standardGroupFields = []string{"project", "priority", "status", "issuetype", "issue_type", "assignee", "reporter", "column", "parent", "epic"}
+
+// CheckGroupField returns an error when issues cannot be grouped by fieldName: it is neither a
+// standard field, a custom field ID nor a name mapped in jira.custom_fields
+func CheckGroupField(fieldName string, customFields map[string]string) error {
+	if customFieldID(customFields, fieldName) != "" {
+		return nil
+	}
+	for _, standard := range standardGroupFields {
+		if strings.EqualFold(standard, fieldName) {
+			return nil
+		}
+	}
+	return fmt.Errorf("field %q is not configured. Add it to jira.custom_fields with its field_id, pass a custom field ID such as customfield_10020, or use one of: %s",
+		fieldName, strings.Join(standardGroupFields, ", "))
+}
+
 // generateConsoleFieldGrouped generates console output grouped by field
 func (g *Generator) generateConsoleFieldGrouped(fieldGroups map[string][]jira.Issue, commentsMap map[string][]jira.Comment, worklogs []jira.WorklogEntry, targetDate time.Time, fieldName string) (string, error) {
 	var report strings.Builder
+	fields := groupFields(fieldName)
+	topField := fields[0]
 	
 	// Header
-	report.WriteString(fmt.Sprintf("🚀 Daily Standup Report - %s\n", targetDate.Format("January 2, 2006")))
+	report.WriteString(fmt.Sprintf("🚀 %s\n", g.reportTitle(targetDate)))
 	report.WriteString(strings.Repeat("=", 50) + "\n")
-	report.WriteString(fmt.Sprintf("📝 Issues grouped by %s\n\n", strings.Title(fieldName)))
+	report.WriteString(fmt.Sprintf("📝 Issues grouped by %s\n\n", strings.Title(strings.Join(fields, " → "))))
 
 	// AI Summary if enabled
-	if g.config.LLMEnabled {
+	if g.config.LLMEnabled && !g.config.Hide.AISummary {
 		allComments := []jira.Comment{}
 		for _, comments := range commentsMap {
 			allComments = append(allComments, comments...)
 		}
+		allComments = g.withCodeActivityComments(allComments, targetDate)
 		
 		var allIssues []jira.Issue
 		for _, groupIssues := range fieldGroups {
@@ -1548,10 +1949,12 @@ func (g *Generator) generateConsoleFieldGrouped(fieldGroups map[string][]jira.Is
 		}
 		
 		if hasMeaningfulComments(allComments) {
-			summary, err := g.summarizer.GenerateStandupSummaryWithComments(allIssues, allComments, worklogs)
+			summary, err := g.daySummary(allIssues, allComments, worklogs, FormatConsole)
 			if err == nil && summary != "" {
 				report.WriteString("🤖 AI SUMMARY OF TODAY'S WORK\n")
 				report.WriteString(fmt.Sprintf("%s\n\n", summary))
+			} else if err != nil {
+				g.warnLLM("Summarizing your day", err)
 			}
 		} else if len(allComments) > 0 {
 			// Show warning when there are comments but they're not meaningful enough for AI summary
@@ -1562,34 +1965,29 @@ func (g *Generator) generateConsoleFieldGrouped(fieldGroups map[string][]jira.Is
 	}
 
 	// Summary
-	totalIssues := 0
-	for _, groupIssues := range fieldGroups {
-		totalIssues += len(groupIssues)
-	}
-	
-	totalComments := 0
-	for _, comments := range commentsMap {
-		totalComments += len(comments)
+	if !g.config.Hide.Summary {
+		report.WriteString("📊 SUMMARY\n")
+		report.WriteString(g.formatSummaryTable(issuesInGroups(fieldGroups, g.sortedGroupNames(fieldGroups, topField)), commentsMap, worklogs))
+		report.WriteString(fmt.Sprintf("  Groups by %s: %d\n", topField, len(fieldGroups)))
+		report.WriteString("\n")
 	}
-	
-	report.WriteString("📊 SUMMARY\n")
-	report.WriteString(fmt.Sprintf("• Total issues: %d\n", totalIssues))
-	report.WriteString(fmt.Sprintf("• Groups by %s: %d\n", fieldName, len(fieldGroups)))
-	report.WriteString(fmt.Sprintf("• Total comments added: %d\n", totalComments))
-	report.WriteString(fmt.Sprintf("• Worklog entries: %d\n\n", len(worklogs)))
 
-	// Sort groups by name for consistent output
-	var groupNames []string
-	for groupName := range fieldGroups {
-		groupNames = append(groupNames, groupName)
-	}
-	sort.Strings(groupNames)
+	// Sort groups for consistent output (board order when grouping by column)
+	groupNames := g.sortedGroupNames(fieldGroups, topField)
 
 	// Generate each group section
 	for _, groupName := range groupNames {
 		groupIssues := fieldGroups[groupName]
 		report.WriteString(fmt.Sprintf("🏷️  %s (%d issues)\n", strings.ToUpper(groupName), len(groupIssues)))
 		report.WriteString(strings.Repeat("-", 30) + "\n")
+		report.WriteString(g.formatGroupSummary(groupIssues, commentsMap, FormatConsole))
+		
+		// Nested fields group the issues further instead of by status
+		if len(fields) > 1 {
+			report.WriteString(g.formatNestedGroups(groupIssues, fields[1:], topField, commentsMap, FormatConsole, 1))
+			report.WriteString("\n")
+			continue
+		}
 		
 		// Group issues within each field group by status
 		statusGroups := groupIssuesByStatus(groupIssues)
@@ -1597,42 +1995,38 @@ func (g *Generator) generateConsoleFieldGrouped(fieldGroups map[string][]jira.Is
 		// In Progress section
 		if inProgress, exists := statusGroups["In Progress"]; exists && len(inProgress) > 0 {
 			report.WriteString("🔄 Currently Working On:\n")
-			for _, issue := range inProgress {
-				report.WriteString(g.formatIssueConsoleWithComments(issue, commentsMap[issue.Key]))
-			}
+			report.WriteString(g.formatRollup(inProgress, groupIssues, topField, func(issue jira.Issue) string {
+				return g.formatIssueConsoleWithComments(issue, commentsMap[issue.Key])
+			}, "    "))
 		}
 
 		// Recently completed section
 		if done, exists := statusGroups["Done"]; exists && len(done) > 0 {
 			report.WriteString("✅ Recently Completed:\n")
-			for _, issue := range done {
-				report.WriteString(g.formatIssueConsoleWithComments(issue, commentsMap[issue.Key]))
-			}
+			report.WriteString(g.formatRollup(done, groupIssues, topField, func(issue jira.Issue) string {
+				return g.formatIssueConsoleWithComments(issue, commentsMap[issue.Key])
+			}, "    "))
 		}
 
 		// To Do section
-		if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 {
+		if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 && !g.config.Hide.ToDo {
 			report.WriteString("📋 To Do:\n")
-			for _, issue := range todo {
-				report.WriteString(g.formatIssueConsoleWithComments(issue, commentsMap[issue.Key]))
-			}
+			report.WriteString(g.formatRollup(todo, groupIssues, topField, func(issue jira.Issue) string {
+				return g.formatIssueConsoleWithComments(issue, commentsMap[issue.Key])
+			}, "    "))
 		}
 		
 		report.WriteString("\n")
 	}
 
-	// Worklog section
-	if len(worklogs) > 0 {
-		report.WriteString("⏰ WORK LOG\n")
-		for _, worklog := range worklogs {
-			report.WriteString(g.formatWorklogConsole(worklog))
-		}
-		report.WriteString("\n")
-	}
+	// Estimates, code activity, work log and other integration sections
+	report.WriteString(g.renderSections(SectionModel{TargetDate: targetDate, Issues: issuesInGroups(fieldGroups, groupNames), Worklogs: worklogs, Comments: commentsMap}, FormatConsole))
 
 	// Footer
-	report.WriteString("---\n")
-	report.WriteString("Generated by my-day CLI 🤖\n")
+	if !g.config.Hide.Footer {
+		report.WriteString("---\n")
+		report.WriteString("Generated by my-day CLI 🤖\n")
+	}
 
 	return report.String(), nil
 }
@@ -1640,17 +2034,20 @@ func (g *Generator) generateConsoleFieldGrouped(fieldGroups map[string][]jira.Is
 // generateMarkdownFieldGrouped generates markdown output grouped by field
 func (g *Generator) generateMarkdownFieldGrouped(fieldGroups map[string][]jira.Issue, commentsMap map[string][]jira.Comment, worklogs []jira.WorklogEntry, targetDate time.Time, fieldName string) (string, error) {
 	var report strings.Builder
+	fields := groupFields(fieldName)
+	topField := fields[0]
 	
 	// Header
-	report.WriteString(fmt.Sprintf("# Daily Standup Report - %s\n\n", targetDate.Format("January 2, 2006")))
-	report.WriteString(fmt.Sprintf("*Issues grouped by %s*\n\n", strings.Title(fieldName)))
+	report.WriteString(fmt.Sprintf("# %s\n\n", g.reportTitle(targetDate)))
+	report.WriteString(fmt.Sprintf("*Issues grouped by %s*\n\n", strings.Title(strings.Join(fields, " → "))))
 
 	// AI Summary if enabled
-	if g.config.LLMEnabled {
+	if g.config.LLMEnabled && !g.config.Hide.AISummary {
 		allComments := []jira.Comment{}
 		for _, comments := range commentsMap {
 			allComments = append(allComments, comments...)
 		}
+		allComments = g.withCodeActivityComments(allComments, targetDate)
 		
 		var allIssues []jira.Issue
 		for _, groupIssues := range fieldGroups {
@@ -1658,10 +2055,12 @@ func (g *Generator) generateMarkdownFieldGrouped(fieldGroups map[string][]jira.I
 		}
 		
 		if hasMeaningfulComments(allComments) {
-			summary, err := g.summarizer.GenerateStandupSummaryWithComments(allIssues, allComments, worklogs)
+			summary, err := g.daySummary(allIssues, allComments, worklogs, FormatMarkdown)
 			if err == nil && summary != "" {
 				report.WriteString("## 🤖 AI Summary of Today's Work\n\n")
 				report.WriteString(fmt.Sprintf("%s\n\n", summary))
+			} else if err != nil {
+				g.warnLLM("Summarizing your day", err)
 			}
 		} else if len(allComments) > 0 {
 			// Show warning when there are comments but they're not meaningful enough for AI summary
@@ -1672,33 +2071,41 @@ func (g *Generator) generateMarkdownFieldGrouped(fieldGroups map[string][]jira.I
 	}
 
 	// Summary
-	totalIssues := 0
-	for _, groupIssues := range fieldGroups {
-		totalIssues += len(groupIssues)
-	}
+	if !g.config.Hide.Summary {
+		totalIssues := 0
+		for _, groupIssues := range fieldGroups {
+			totalIssues += len(groupIssues)
+		}
 	
-	totalComments := 0
-	for _, comments := range commentsMap {
-		totalComments += len(comments)
-	}
+		totalComments := 0
+		for _, comments := range commentsMap {
+			totalComments += len(comments)
+		}
 	
-	report.WriteString("## Summary\n\n")
-	report.WriteString(fmt.Sprintf("- **Total issues**: %d\n", totalIssues))
-	report.WriteString(fmt.Sprintf("- **Groups by %s**: %d\n", fieldName, len(fieldGroups)))
-	report.WriteString(fmt.Sprintf("- **Total comments added**: %d\n", totalComments))
-	report.WriteString(fmt.Sprintf("- **Worklog entries**: %d\n\n", len(worklogs)))
-
-	// Sort groups by name for consistent output
-	var groupNames []string
-	for groupName := range fieldGroups {
-		groupNames = append(groupNames, groupName)
+		report.WriteString("## Summary\n\n")
+		report.WriteString(fmt.Sprintf("- **Total issues**: %d\n", totalIssues))
+		report.WriteString(fmt.Sprintf("- **Groups by %s**: %d\n", topField, len(fieldGroups)))
+		report.WriteString(fmt.Sprintf("- **Total comments added**: %d\n", totalComments))
+		report.WriteString(fmt.Sprintf("- **Worklog entries**: %d\n", len(worklogs)))
+		report.WriteString(g.formatTimeLogged("- **Time logged**: %s\n", worklogs))
+		report.WriteString(g.formatMeetingsSummary("- "))
+		report.WriteString("\n")
 	}
-	sort.Strings(groupNames)
+
+	// Sort groups for consistent output (board order when grouping by column)
+	groupNames := g.sortedGroupNames(fieldGroups, topField)
 
 	// Generate each group section
 	for _, groupName := range groupNames {
 		groupIssues := fieldGroups[groupName]
 		report.WriteString(fmt.Sprintf("## 🏷️ %s (%d issues)\n\n", strings.Title(groupName), len(groupIssues)))
+		report.WriteString(g.formatGroupSummary(groupIssues, commentsMap, FormatMarkdown))
+		
+		// Nested fields group the issues further instead of by status
+		if len(fields) > 1 {
+			report.WriteString(g.formatNestedGroups(groupIssues, fields[1:], topField, commentsMap, FormatMarkdown, 1))
+			continue
+		}
 		
 		// Group issues within each field group by status
 		statusGroups := groupIssuesByStatus(groupIssues)
@@ -1706,43 +2113,39 @@ func (g *Generator) generateMarkdownFieldGrouped(fieldGroups map[string][]jira.I
 		// In Progress section
 		if inProgress, exists := statusGroups["In Progress"]; exists && len(inProgress) > 0 {
 			report.WriteString("### 🔄 Currently Working On\n\n")
-			for _, issue := range inProgress {
-				report.WriteString(g.formatIssueMarkdownWithComments(issue, commentsMap[issue.Key]))
-			}
+			report.WriteString(g.formatRollup(inProgress, groupIssues, topField, func(issue jira.Issue) string {
+				return g.formatIssueMarkdownWithComments(issue, commentsMap[issue.Key])
+			}, "  "))
 			report.WriteString("\n")
 		}
 
 		// Recently completed section
 		if done, exists := statusGroups["Done"]; exists && len(done) > 0 {
 			report.WriteString("### ✅ Recently Completed\n\n")
-			for _, issue := range done {
-				report.WriteString(g.formatIssueMarkdownWithComments(issue, commentsMap[issue.Key]))
-			}
+			report.WriteString(g.formatRollup(done, groupIssues, topField, func(issue jira.Issue) string {
+				return g.formatIssueMarkdownWithComments(issue, commentsMap[issue.Key])
+			}, "  "))
 			report.WriteString("\n")
 		}
 
 		// To Do section
-		if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 {
+		if todo, exists := statusGroups["To Do"]; exists && len(todo) > 0 && !g.config.Hide.ToDo {
 			report.WriteString("### 📋 To Do\n\n")
-			for _, issue := range todo {
-				report.WriteString(g.formatIssueMarkdownWithComments(issue, commentsMap[issue.Key]))
-			}
+			report.WriteString(g.formatRollup(todo, groupIssues, topField, func(issue jira.Issue) string {
+				return g.formatIssueMarkdownWithComments(issue, commentsMap[issue.Key])
+			}, "  "))
 			report.WriteString("\n")
 		}
 	}
 
-	// Worklog section
-	if len(worklogs) > 0 {
-		report.WriteString("## ⏰ Work Log\n\n")
-		for _, worklog := range worklogs {
-			report.WriteString(g.formatWorklogMarkdown(worklog))
-		}
-		report.WriteString("\n")
-	}
+	// Estimates, code activity, work log and other integration sections
+	report.WriteString(g.renderSections(SectionModel{TargetDate: targetDate, Issues: issuesInGroups(fieldGroups, groupNames), Worklogs: worklogs, Comments: commentsMap}, FormatMarkdown))
 
 	// Footer
-	report.WriteString("---\n")
-	report.WriteString("*Generated by my-day CLI*\n")
+	if !g.config.Hide.Footer {
+		report.WriteString("---\n")
+		report.WriteString("*Generated by my-day CLI*\n")
+	}
 
 	return report.String(), nil
 }
@@ -1777,8 +2180,11 @@ func (g *Generator) GenerateWithCommentsAndCache(issuesWithComments []IssueWithC
 		cachedReport, err := g.cacheManager.FindReport(g.config, issues, commentsMap, worklogs, targetDate)
 		if err == nil && cachedReport != nil {
 			// Cache hit - return cached content
-			if g.config.Debug || g.config.Verbose {
-				fmt.Printf("📋 Using cached report (generated %v ago)\n", time.Since(cachedReport.GeneratedAt).Round(time.Second))
+			slog.Debug("Using cached report", "age", time.Since(cachedReport.GeneratedAt).Round(time.Second))
+			g.warnings = cachedReport.Warnings
+			g.fallbacksAtStart = g.llmFallbacks()
+			if err := g.strictLLMError(); err != nil {
+				return "", err
 			}
 			return cachedReport.Content, nil
 		}
@@ -1804,6 +2210,9 @@ func (g *Generator) GenerateWithCommentsAndCache(issuesWithComments []IssueWithC
 	if err != nil {
 		return "", err
 	}
+	if err := g.strictLLMError(); err != nil {
+		return "", err
+	}
 	
 	// Save to cache if enabled
 	if useCache && g.cacheManager != nil {
@@ -1818,11 +2227,11 @@ func (g *Generator) GenerateWithCommentsAndCache(issuesWithComments []IssueWithC
 		}
 		
 		saveErr := g.cacheManager.SaveReport(reportID, g.config, reportContent, targetDate, 
-			len(issues), totalComments, len(worklogs), generationTime, inputHash)
-		if saveErr != nil && (g.config.Debug || g.config.Verbose) {
-			fmt.Printf("Warning: Failed to save report to cache: %v\n", saveErr)
-		} else if g.config.Debug || g.config.Verbose {
-			fmt.Printf("✓ Report cached with ID: %s\n", reportID)
+			len(issues), totalComments, len(worklogs), generationTime, inputHash, g.Warnings())
+		if saveErr != nil {
+			slog.Warn("Failed to save report to cache", "error", saveErr)
+		} else {
+			slog.Debug("Report cached", "id", reportID)
 		}
 	}
 	
@@ -164,4 +164,94 @@ func TestGenerateConsoleWithCommentsGeneratesAI(t *testing.T) {
 	if !strings.Contains(reportContent, "Recent activity:") {
 		t.Error("Expected some form of AI summary to be generated for meaningful comments")
 	}
-}
\ No newline at end of file
+}
+
+func TestCommentExcerpt(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		maxRunes int
+		want     string
+	}{
+		{name: "no limit", text: "short comment", maxRunes: 0, want: "short comment"},
+		{name: "under limit", text: "  short comment  ", maxRunes: 50, want: "short comment"},
+		{name: "breaks at word boundary", text: "deployed the runner chart", maxRunes: 15, want: "deployed the…"},
+		{name: "rune aware", text: "ñandú ñandú ñandú", maxRunes: 8, want: "ñandú…"},
+		{name: "counts hidden lines", text: "first line\nsecond line\nthird line", maxRunes: 12, want: "first line… (+2 more lines)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := commentExcerpt(tt.text, tt.maxRunes); got != tt.want {
+				t.Errorf("commentExcerpt() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortedGroupNamesByColumn(t *testing.T) {
+	columns := &jira.BoardColumnMap{Columns: []jira.BoardColumn{
+		{Name: "To Do", StatusIDs: []string{"1"}},
+		{Name: "Doing", StatusIDs: []string{"2"}},
+		{Name: "Done", StatusIDs: []string{"3"}},
+	}}
+	generator := &Generator{config: &Config{BoardColumns: columns}}
+
+	issue := func(key, statusID string) jira.Issue {
+		return jira.Issue{Key: key, Fields: jira.Fields{Status: jira.Status{ID: statusID}}}
+	}
+	groups := generator.groupIssuesByField([]jira.Issue{
+		issue("OPS-1", "3"),
+		issue("OPS-2", "99"), // status not mapped to any column
+		issue("OPS-3", "1"),
+		issue("OPS-4", "2"),
+	}, "column")
+
+	got := strings.Join(generator.sortedGroupNames(groups, "column"), ",")
+	if expected := "To Do,Doing,Done,Unassigned"; got != expected {
+		t.Errorf("expected groups in board order %q, got %q", expected, got)
+	}
+
+	got = strings.Join(generator.sortedGroupNames(groups, "status"), ",")
+	if expected := "Doing,Done,To Do,Unassigned"; got != expected {
+		t.Errorf("expected alphabetical groups %q, got %q", expected, got)
+	}
+}
+
+func TestGroupIssuesByConfiguredField(t *testing.T) {
+	generator := &Generator{config: &Config{CustomFields: map[string]string{"components": "customfield_12946"}}}
+
+	issue := func(key string, components ...interface{}) jira.Issue {
+		issue := jira.Issue{Key: key}
+		issue.Fields.CustomFields = map[string]*jira.CustomField{
+			"customfield_12946": {ID: "customfield_12946", Value: components},
+		}
+		return issue
+	}
+	groups := generator.groupIssuesByField([]jira.Issue{
+		issue("OPS-1", map[string]interface{}{"value": "API"}, map[string]interface{}{"value": "Web"}),
+		issue("OPS-2", map[string]interface{}{"value": "Web"}),
+		issue("OPS-3"),
+	}, "Components")
+
+	got := strings.Join(generator.sortedGroupNames(groups, "components"), ",")
+	if expected := "API,Unassigned,Web"; got != expected {
+		t.Errorf("expected a group per component %q, got %q", expected, got)
+	}
+	if len(groups["Web"]) != 2 || len(groups["API"]) != 1 {
+		t.Errorf("expected an issue with two components under both, got %v", groups)
+	}
+}
+
+func TestCheckGroupField(t *testing.T) {
+	customFields := map[string]string{"squad": "customfield_12944"}
+	for _, fieldName := range []string{"squad", "Squad", "customfield_10020", "priority", "column", "parent"} {
+		if err := CheckGroupField(fieldName, customFields); err != nil {
+			t.Errorf("CheckGroupField(%q) error = %v", fieldName, err)
+		}
+	}
+	err := CheckGroupField("team", customFields)
+	if err == nil || !strings.Contains(err.Error(), "jira.custom_fields") {
+		t.Errorf("expected an error naming jira.custom_fields for an unconfigured field, got %v", err)
+	}
+}
@@ -0,0 +1,431 @@
+package report
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"my-day/internal/asana"
+	"my-day/internal/github"
+	"my-day/internal/gitlab"
+	"my-day/internal/jira"
+	"my-day/internal/trello"
+)
+
+// Run `go test ./internal/report -run TestGoldenReports -update` after an
+// intentional output change to rewrite the golden files.
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+var goldenTargetDate = time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC)
+
+// obsidianCreatedPattern matches the wall-clock creation timestamp in Obsidian frontmatter
+var obsidianCreatedPattern = regexp.MustCompile(`(?m)^created: .*$`)
+
+// goldenFixture returns a fixed dataset covering every status group, comments,
+// worklogs and a custom field used for grouping
+func goldenFixture() ([]IssueWithComments, []jira.WorklogEntry) {
+	me := jira.User{AccountID: "me", DisplayName: "Alex Dev"}
+	at := func(hour int) jira.JiraTime {
+		return jira.JiraTime{Time: goldenTargetDate.Truncate(24 * time.Hour).Add(time.Duration(hour) * time.Hour)}
+	}
+	squad := func(name string) map[string]*jira.CustomField {
+		return map[string]*jira.CustomField{
+			"customfield_12944": {ID: "customfield_12944", Value: name},
+		}
+	}
+
+	issues := []IssueWithComments{
+		{
+			Issue: jira.Issue{
+				Key: "OPS-101",
+				Fields: jira.Fields{
+					Summary:      "Migrate CI runners to Kubernetes",
+					Status:       jira.Status{ID: "3", Name: "In Progress", Category: jira.StatusCategory{Key: "indeterminate"}},
+					Priority:     jira.Priority{Name: "High"},
+					IssueType:    jira.IssueType{Name: "Story"},
+					Project:      jira.Project{Key: "OPS", Name: "Operations"},
+					Assignee:     &me,
+					Updated:      at(10),
+					CustomFields: squad("Platform"),
+				},
+			},
+			Comments: []jira.Comment{
+				{ID: "1", Author: me, Body: jira.JiraDescription{Text: "Deployed the runner helm chart to staging and verified autoscaling"}, Created: at(9)},
+			},
+		},
+		{
+			Issue: jira.Issue{
+				Key: "OPS-102",
+				Fields: jira.Fields{
+					Summary:      "Rotate Terraform state bucket credentials",
+					Status:       jira.Status{ID: "10001", Name: "Done", Category: jira.StatusCategory{Key: "done"}},
+					Priority:     jira.Priority{Name: "Medium"},
+					IssueType:    jira.IssueType{Name: "Task"},
+					Project:      jira.Project{Key: "OPS", Name: "Operations"},
+					Assignee:     &me,
+					Updated:      at(-4),
+					CustomFields: squad("Security"),
+				},
+			},
+			Comments: []jira.Comment{
+				{ID: "2", Author: me, Body: jira.JiraDescription{Text: "Rotated keys and updated the pipeline secrets"}, Created: at(-5)},
+			},
+		},
+		{
+			Issue: jira.Issue{
+				Key: "OPS-103",
+				Fields: jira.Fields{
+					Summary:   "Write runbook for database failover",
+					Status:    jira.Status{ID: "10000", Name: "To Do", Category: jira.StatusCategory{Key: "new"}},
+					Priority:  jira.Priority{Name: "Low"},
+					IssueType: jira.IssueType{Name: "Task"},
+					Project:   jira.Project{Key: "OPS", Name: "Operations"},
+					Updated:   at(8),
+				},
+			},
+		},
+		{
+			Issue: jira.Issue{
+				Key: "OPS-090",
+				Fields: jira.Fields{
+					Summary:   "Stale ticket that must not appear",
+					Status:    jira.Status{ID: "10001", Name: "Done", Category: jira.StatusCategory{Key: "done"}},
+					Priority:  jira.Priority{Name: "Low"},
+					IssueType: jira.IssueType{Name: "Bug"},
+					Updated:   at(-24 * 10),
+				},
+			},
+		},
+	}
+
+	worklogs := []jira.WorklogEntry{
+		{ID: "w1", Author: me, Comment: "Pairing on runner migration", Started: at(9), TimeSpentSeconds: 5400, IssueID: "OPS-101"},
+	}
+
+	return issues, worklogs
+}
+
+// goldenEstimatedIssues adds time tracking to the fixture: OPS-101 is well over its estimate,
+// OPS-102 finished under it and OPS-103 has time logged without an estimate
+func goldenEstimatedIssues(issues []IssueWithComments) []IssueWithComments {
+	tracking := map[string][2]int{
+		"OPS-101": {4 * 3600, 6*3600 + 30*60},
+		"OPS-102": {2 * 3600, 90 * 60},
+		"OPS-103": {0, 45 * 60},
+	}
+
+	estimated := make([]IssueWithComments, len(issues))
+	for i, iwc := range issues {
+		if times, ok := tracking[iwc.Issue.Key]; ok {
+			iwc.Issue.Fields.TimeOriginalEstimate = times[0]
+			iwc.Issue.Fields.TimeSpent = times[1]
+		}
+		estimated[i] = iwc
+	}
+	return estimated
+}
+
+// goldenBoardColumns returns a Kanban board whose column order differs from alphabetical order
+func goldenBoardColumns() *jira.BoardColumnMap {
+	return &jira.BoardColumnMap{
+		BoardID:   42,
+		BoardName: "OPS board",
+		Columns: []jira.BoardColumn{
+			{Name: "Ready", StatusIDs: []string{"10000"}},
+			{Name: "In Progress", StatusIDs: []string{"3"}},
+			{Name: "Review", StatusIDs: []string{"10002"}},
+			{Name: "Done", StatusIDs: []string{"10001"}},
+		},
+	}
+}
+
+// goldenGitHubActivity returns a commit, a merged pull request and a review on the target date,
+// plus an old commit that must not appear
+func goldenGitHubActivity() []github.Activity {
+	at := func(hour int) time.Time {
+		return goldenTargetDate.Truncate(24 * time.Hour).Add(time.Duration(hour) * time.Hour)
+	}
+
+	return []github.Activity{
+		{Type: "commit", ID: "abc123", Title: "Add runner autoscaling config", State: "committed", Repository: "acme/infra", URL: "https://github.com/acme/infra/commit/abc123", CreatedAt: at(11), UpdatedAt: at(11), JiraTickets: []string{"OPS-101"}},
+		{Type: "pull_request", ID: "42", Title: "Rotate state bucket credentials", State: "merged", Repository: "acme/infra", URL: "https://github.com/acme/infra/pull/42", CreatedAt: at(-30), UpdatedAt: at(14), Metadata: map[string]interface{}{"number": 42, "merged_at": at(13)}},
+		{Type: "review", ID: "7", Title: "Bump base image", State: "approved", Repository: "acme/app", URL: "https://github.com/acme/app/pull/9#pullrequestreview-7", CreatedAt: at(10), UpdatedAt: at(10), Metadata: map[string]interface{}{"number": 9}},
+		{Type: "commit", ID: "old1", Title: "Old commit", State: "committed", Repository: "acme/infra", CreatedAt: at(-24 * 5), UpdatedAt: at(-24 * 5)},
+	}
+}
+
+// goldenGitLabActivity returns a merged merge request, a failed pipeline and a commit on the target date
+func goldenGitLabActivity() []gitlab.Activity {
+	at := func(hour int) time.Time {
+		return goldenTargetDate.Truncate(24 * time.Hour).Add(time.Duration(hour) * time.Hour)
+	}
+
+	return []gitlab.Activity{
+		{Type: "merge_request", ID: "301", Title: "OPS-101 Runner autoscaling", State: "merged", Project: "acme/platform/ci", URL: "https://gitlab.example.com/acme/platform/ci/-/merge_requests/12", CreatedAt: at(-20), UpdatedAt: at(16), JiraTickets: []string{"OPS-101"}, Metadata: map[string]interface{}{"iid": 12, "merged_at": "2024-07-15T15:00:00Z"}},
+		{Type: "pipeline", ID: "9001", Title: "Pipeline on main", State: "failed", Project: "acme/platform/ci", URL: "https://gitlab.example.com/acme/platform/ci/-/pipelines/9001", CreatedAt: at(15), UpdatedAt: at(15), Metadata: map[string]interface{}{"ref": "main"}},
+		{Type: "commit", ID: "f00d", Title: "Fix runner cache path", State: "committed", Project: "acme/platform/ci", CreatedAt: at(12), UpdatedAt: at(12)},
+	}
+}
+
+// goldenTaskBoardActivity returns a moved and a commented Trello card and a completed Asana task on the target date
+func goldenTaskBoardActivity() ([]trello.Activity, []asana.Activity) {
+	at := func(hour int) time.Time {
+		return goldenTargetDate.Truncate(24 * time.Hour).Add(time.Duration(hour) * time.Hour)
+	}
+
+	return []trello.Activity{
+		{Type: "moved", ID: "a1", Title: "Vendor contract renewal", Board: "Side work", FromList: "Doing", List: "Done", URL: "https://trello.com/c/abc", CreatedAt: at(10)},
+		{Type: "commented", ID: "a2", Title: "Conference talk", Board: "Side work", Description: "Submitted the abstract\nWaiting for feedback", URL: "https://trello.com/c/def", CreatedAt: at(11)},
+	}, []asana.Activity{
+		{Type: "completed", ID: "t1", Title: "Plan team offsite", Project: "Team ops", URL: "https://app.asana.com/0/1/t1", CreatedAt: at(16)},
+	}
+}
+
+// goldenConfig returns a deterministic report configuration (no LLM, no export side effects)
+func goldenConfig(format string) *Config {
+	return &Config{
+		Format:            format,
+		IncludeToday:      true,
+		IncludeYesterday:  true,
+		IncludeInProgress: true,
+		ExportFileDate:    "2006-01-02",
+		ExportTags:        []string{"daily-report", "work"},
+		WorkdayHours:      8,
+		CustomFields:      map[string]string{"squad": "customfield_12944"},
+	}
+}
+
+func TestGoldenReports(t *testing.T) {
+	issues, worklogs := goldenFixture()
+
+	var plainIssues []jira.Issue
+	for _, iwc := range issues {
+		plainIssues = append(plainIssues, iwc.Issue)
+	}
+
+	// Add new output formats here so refactors can't silently change what users see
+	tests := []struct {
+		name   string
+		render func() (string, error)
+	}{
+		{
+			name: "console",
+			render: func() (string, error) {
+				return NewGenerator(goldenConfig("console")).Generate(plainIssues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "markdown",
+			render: func() (string, error) {
+				return NewGenerator(goldenConfig("markdown")).Generate(plainIssues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "console_comments",
+			render: func() (string, error) {
+				return NewGenerator(goldenConfig("console")).GenerateWithComments(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "markdown_comments",
+			render: func() (string, error) {
+				return NewGenerator(goldenConfig("markdown")).GenerateWithComments(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "console_enhanced",
+			render: func() (string, error) {
+				return NewGenerator(goldenConfig("console")).GenerateWithEnhancedContext(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "markdown_enhanced",
+			render: func() (string, error) {
+				return NewGenerator(goldenConfig("markdown")).GenerateWithEnhancedContext(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "console_grouped_squad",
+			render: func() (string, error) {
+				config := goldenConfig("console")
+				config.GroupByField = "squad"
+				return NewGenerator(config).GenerateWithComments(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "markdown_grouped_squad",
+			render: func() (string, error) {
+				config := goldenConfig("markdown")
+				config.GroupByField = "squad"
+				return NewGenerator(config).GenerateWithComments(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "markdown_grouped_column",
+			render: func() (string, error) {
+				config := goldenConfig("markdown")
+				config.GroupByField = "column"
+				config.BoardColumns = goldenBoardColumns()
+				return NewGenerator(config).GenerateWithComments(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "console_github",
+			render: func() (string, error) {
+				config := goldenConfig("console")
+				config.GitHubActivity = goldenGitHubActivity()
+				return NewGenerator(config).GenerateWithComments(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "markdown_github",
+			render: func() (string, error) {
+				config := goldenConfig("markdown")
+				config.GitHubActivity = goldenGitHubActivity()
+				return NewGenerator(config).GenerateWithComments(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "markdown_gitlab",
+			render: func() (string, error) {
+				config := goldenConfig("markdown")
+				config.GitLabActivity = goldenGitLabActivity()
+				return NewGenerator(config).GenerateWithComments(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "markdown_task_boards",
+			render: func() (string, error) {
+				config := goldenConfig("markdown")
+				config.TrelloActivity, config.AsanaActivity = goldenTaskBoardActivity()
+				return NewGenerator(config).GenerateWithComments(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "markdown_detailed_estimates",
+			render: func() (string, error) {
+				config := goldenConfig("markdown")
+				config.Detailed = true
+				config.VarianceThreshold = 20
+				return NewGenerator(config).GenerateWithComments(goldenEstimatedIssues(issues), worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "html",
+			render: func() (string, error) {
+				return NewGenerator(goldenConfig("html")).GenerateWithComments(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "html_grouped_squad",
+			render: func() (string, error) {
+				config := goldenConfig("html")
+				config.GroupByField = "squad"
+				return NewGenerator(config).GenerateWithComments(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "obsidian",
+			render: func() (string, error) {
+				generator := NewGenerator(goldenConfig("markdown"))
+				content, err := generator.GenerateWithComments(issues, worklogs, goldenTargetDate)
+				if err != nil {
+					return "", err
+				}
+				obsidian := generator.generateObsidianMarkdown(content, goldenTargetDate)
+				return obsidianCreatedPattern.ReplaceAllString(obsidian, "created: <timestamp>"), nil
+			},
+		},
+		{
+			name: "obsidian_metrics",
+			render: func() (string, error) {
+				config := goldenConfig("markdown")
+				config.MeetingsSummary = "1.5h in meetings (1 recurring, 1 incident review)"
+				generator := NewGenerator(config)
+				metrics := ComputeExportMetrics(issues, worklogs, goldenTargetDate)
+				metrics.MeetingsHours = 1.5
+				generator.SetExportMetrics(metrics)
+				content, err := generator.GenerateWithComments(issues, worklogs, goldenTargetDate)
+				if err != nil {
+					return "", err
+				}
+				obsidian := generator.generateObsidianMarkdown(content, goldenTargetDate)
+				return obsidianCreatedPattern.ReplaceAllString(obsidian, "created: <timestamp>"), nil
+			},
+		},
+		{
+			name: "weekly_console",
+			render: func() (string, error) {
+				return NewGenerator(goldenConfig("console")).GenerateWeekly(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "weekly_markdown",
+			render: func() (string, error) {
+				return NewGenerator(goldenConfig("markdown")).GenerateWeekly(issues, worklogs, goldenTargetDate)
+			},
+		},
+		{
+			name: "digest_markdown",
+			render: func() (string, error) {
+				return BuildDigest(issues, worklogs, goldenTargetDate).Markdown(), nil
+			},
+		},
+		{
+			name: "digest_html",
+			render: func() (string, error) {
+				return BuildDigest(issues, worklogs, goldenTargetDate).HTML(), nil
+			},
+		},
+		{
+			name: "explain",
+			render: func() (string, error) {
+				generator := NewGenerator(goldenConfig("console"))
+				var explanations []IssueExplanation
+				for _, iwc := range issues {
+					explanations = append(explanations, generator.ExplainIssue(iwc.Issue, iwc.Comments, goldenTargetDate, "me"))
+				}
+				return FormatExplanation(explanations, []string{"updated today", "updated yesterday", "in progress"}), nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := tt.render()
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			assertGolden(t, tt.name, output)
+		})
+	}
+}
+
+// assertGolden compares output against testdata/golden/<name>.golden, rewriting it when -update is set
+func assertGolden(t *testing.T, name, output string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", "golden", name+".golden")
+
+	if *updateGolden {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create golden directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(output), 0644); err != nil {
+			t.Fatalf("failed to write golden file: %v", err)
+		}
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file %s (run with -update to create it): %v", path, err)
+	}
+
+	if string(expected) != output {
+		t.Errorf("output does not match %s (run with -update if the change is intentional)\n--- expected ---\n%s\n--- actual ---\n%s", path, expected, output)
+	}
+}
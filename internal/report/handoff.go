@@ -0,0 +1,188 @@
+package report
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+// maxNextStep is the maximum runes of the next step taken from a comment
+const maxNextStep = 200
+
+// Handoff is the end-of-day "state of play" for a team picking up the work in another timezone
+type Handoff struct {
+	Date     time.Time
+	From     string // Who hands the work off
+	JiraURL  string // Issue keys link to Jira when set
+	InFlight []HandoffItem
+	Blocked  []HandoffItem
+	Done     []HandoffItem // Completed on the handoff day
+}
+
+// HandoffItem is an issue of the handoff with what to do next and where to look
+type HandoffItem struct {
+	Issue    jira.Issue
+	Note     string   // What blocks the issue, for blocked issues
+	NextStep string   // From the latest comment, without credentials
+	Links    []string // URLs mentioned in the comments, without credentials
+}
+
+var (
+	// nextStepPattern matches a line of a comment stating the next step, e.g. "Next: rerun the migration"
+	nextStepPattern = regexp.MustCompile(`(?i)^\W*(next steps?|next|todo|to do|tomorrow|handoff)\s*[:\-–—]\s*(.+)$`)
+
+	// urlPattern matches the URLs mentioned in comments
+	urlPattern = regexp.MustCompile(`https?://[^\s<>"'\)\]]+`)
+)
+
+// BuildHandoff builds the handoff of date from the synced issues and your comments on them
+func BuildHandoff(issuesWithComments []IssueWithComments, date time.Time, jiraURL string) *Handoff {
+	handoff := &Handoff{Date: date, JiraURL: strings.TrimSuffix(jiraURL, "/")}
+	day := date.Truncate(24 * time.Hour)
+
+	for _, iwc := range issuesWithComments {
+		issue := iwc.Issue
+
+		// Comments written after the handoff day are left out, so a past handoff reads as it did
+		var comments []jira.Comment
+		for _, comment := range iwc.Comments {
+			if comment.Created.Time.Before(day.Add(24 * time.Hour)) {
+				comments = append(comments, comment)
+			}
+		}
+		item := HandoffItem{Issue: issue, NextStep: nextStep(comments), Links: commentLinks(comments)}
+
+		switch {
+		case getStatusCategory(issue) == 3:
+			if issue.Fields.Updated.Time.Truncate(24 * time.Hour).Equal(day) {
+				handoff.Done = append(handoff.Done, item)
+			}
+		case isBlocked(issue):
+			if len(issue.BlockedBy()) > 0 {
+				item.Note = blockerText(issue)
+			}
+			handoff.Blocked = append(handoff.Blocked, item)
+		case isInProgress(issue):
+			handoff.InFlight = append(handoff.InFlight, item)
+		}
+	}
+
+	return handoff
+}
+
+// nextStep returns the next step stated in the latest comment, or the latest comment itself
+// when none is stated, without credentials
+func nextStep(comments []jira.Comment) string {
+	var latest *jira.Comment
+	for i := range comments {
+		if latest == nil || comments[i].Created.Time.After(latest.Created.Time) {
+			latest = &comments[i]
+		}
+	}
+	if latest == nil {
+		return ""
+	}
+
+	text := scrubCredentials(latest.Body.Text)
+	for _, line := range strings.Split(text, "\n") {
+		if match := nextStepPattern.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			return commentExcerpt(match[2], maxNextStep)
+		}
+	}
+	return commentExcerpt(strings.Join(strings.Fields(text), " "), maxNextStep)
+}
+
+// commentLinks returns the URLs mentioned in the comments, oldest first and without duplicates.
+// URLs carrying credentials are left out.
+func commentLinks(comments []jira.Comment) []string {
+	var links []string
+	seen := make(map[string]bool)
+	for _, comment := range comments {
+		for _, link := range urlPattern.FindAllString(comment.Body.Text, -1) {
+			link = strings.TrimRight(link, ".,;:")
+			if seen[link] || scrubCredentials(link) != link {
+				continue
+			}
+			seen[link] = true
+			links = append(links, link)
+		}
+	}
+	return links
+}
+
+// scrubCredentials replaces passwords, tokens and keys in text with [redacted]
+func scrubCredentials(text string) string {
+	return llm.RedactSecrets(text)
+}
+
+// Title returns the handoff title
+func (h *Handoff) Title() string {
+	return "Handoff - " + h.Date.Format("January 2, 2006")
+}
+
+// handoffSection pairs a section heading with its items and empty-state text
+type handoffSection struct {
+	heading string
+	items   []HandoffItem
+	empty   string
+}
+
+func (h *Handoff) sections() []handoffSection {
+	return []handoffSection{
+		{"🔄 In Flight", h.InFlight, "Nothing in progress."},
+		{"🚧 Blocked", h.Blocked, "Nothing blocked."},
+		{"✅ Done Today", h.Done, "Nothing completed today."},
+	}
+}
+
+// issueURL returns the Jira link of an issue, or "" without a Jira URL
+func (h *Handoff) issueURL(issue jira.Issue) string {
+	if h.JiraURL == "" {
+		return ""
+	}
+	return h.JiraURL + "/browse/" + issue.Key
+}
+
+// Markdown renders the handoff as a markdown document
+func (h *Handoff) Markdown() string {
+	var result strings.Builder
+
+	result.WriteString(fmt.Sprintf("# %s\n\n", h.Title()))
+	if h.From != "" {
+		result.WriteString(fmt.Sprintf("*From %s* · ", h.From))
+	}
+	result.WriteString(fmt.Sprintf("**%d** in flight · **%d** blocked · **%d** done today\n\n", len(h.InFlight), len(h.Blocked), len(h.Done)))
+
+	for _, section := range h.sections() {
+		result.WriteString(fmt.Sprintf("## %s\n\n", section.heading))
+		if len(section.items) == 0 {
+			result.WriteString(fmt.Sprintf("_%s_\n\n", section.empty))
+			continue
+		}
+		for _, item := range section.items {
+			key := item.Issue.Key
+			if url := h.issueURL(item.Issue); url != "" {
+				key = fmt.Sprintf("[%s](%s)", key, url)
+			}
+			result.WriteString(fmt.Sprintf("- **%s** %s _(%s)_", key, item.Issue.Fields.Summary, item.Issue.Fields.Status.Name))
+			if item.Note != "" {
+				result.WriteString(fmt.Sprintf(" — %s", item.Note))
+			}
+			result.WriteString("\n")
+			if item.NextStep != "" {
+				result.WriteString(fmt.Sprintf("  - Next: %s\n", indentContinuation(item.NextStep, "    ")))
+			}
+			for _, link := range item.Links {
+				result.WriteString(fmt.Sprintf("  - %s\n", link))
+			}
+		}
+		result.WriteString("\n")
+	}
+
+	result.WriteString("---\n*Generated by my-day CLI*\n")
+	return result.String()
+}
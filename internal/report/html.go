@@ -0,0 +1,211 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// htmlStyles is embedded in every HTML report so the page is self-contained
+const htmlStyles = `
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Roboto, Helvetica, Arial, sans-serif; background: #f6f8fa; color: #24292f; margin: 0; padding: 24px; }
+main { max-width: 860px; margin: 0 auto; background: #fff; border: 1px solid #d0d7de; border-radius: 8px; padding: 24px 32px; }
+h1 { margin: 0 0 4px; font-size: 24px; }
+h2 { margin: 28px 0 12px; font-size: 18px; border-bottom: 1px solid #d0d7de; padding-bottom: 6px; }
+h3 { margin: 20px 0 8px; font-size: 15px; color: #57606a; }
+.date { margin: 0; color: #57606a; }
+.ai-summary { background: #f0f6ff; border-left: 4px solid #0969da; padding: 12px 16px; border-radius: 4px; white-space: pre-wrap; }
+.warning { background: #fff8c5; border-left: 4px solid #bf8700; padding: 12px 16px; border-radius: 4px; }
+.notes { list-style: none; padding-left: 0; color: #57606a; font-size: 14px; }
+.stats { display: flex; gap: 12px; flex-wrap: wrap; }
+.stat { flex: 1; min-width: 140px; border: 1px solid #d0d7de; border-radius: 6px; padding: 12px; }
+.stat-value { font-size: 22px; font-weight: 600; }
+.stat-label { color: #57606a; font-size: 13px; }
+details.issue { border: 1px solid #d0d7de; border-radius: 6px; margin: 8px 0; padding: 8px 12px; }
+details.issue summary { cursor: pointer; }
+details.issue[open] summary { margin-bottom: 8px; }
+.key { font-family: ui-monospace, SFMono-Regular, Menlo, monospace; font-weight: 600; }
+.badge { display: inline-block; font-size: 12px; font-weight: 600; padding: 2px 8px; border-radius: 12px; margin-right: 6px; }
+.badge-in-progress { background: #ddf4ff; color: #0969da; }
+.badge-done { background: #dafbe1; color: #1a7f37; }
+.badge-todo { background: #eaeef2; color: #57606a; }
+.meta { color: #57606a; font-size: 13px; margin: 4px 0; }
+.comment { border-left: 3px solid #d0d7de; padding: 4px 10px; margin: 6px 0; white-space: pre-wrap; }
+.comment-time { color: #57606a; font-size: 12px; }
+.over-estimate { color: #cf222e; font-weight: 600; }
+.risk { display: inline-block; min-width: 2em; font-weight: 600; color: #cf222e; }
+.duplicate { color: #9a6700; }
+table { border-collapse: collapse; width: 100%; }
+th, td { text-align: left; padding: 6px 8px; border-bottom: 1px solid #d0d7de; vertical-align: top; }
+footer { margin-top: 32px; color: #57606a; font-size: 12px; text-align: center; }
+`
+
+// htmlStatusSections defines the order, headings and badge style of status groups
+var htmlStatusSections = []struct {
+	group   string
+	heading string
+	badge   string
+}{
+	{"In Progress", "🔄 Currently Working On", "badge-in-progress"},
+	{"Done", "✅ Recently Completed", "badge-done"},
+	{"To Do", "📋 To Do", "badge-todo"},
+}
+
+// generateHTML renders a self-contained HTML page. When fieldName is set, issues are
+// grouped by that field first and by status within each group.
+func (g *Generator) generateHTML(issues []jira.Issue, commentsMap map[string][]jira.Comment, worklogs []jira.WorklogEntry, targetDate time.Time, fieldName string) (string, error) {
+	var report strings.Builder
+
+	title := g.reportTitle(targetDate)
+
+	report.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n")
+	report.WriteString("<meta charset=\"utf-8\">\n")
+	report.WriteString("<meta name=\"viewport\" content=\"width=device-width, initial-scale=1\">\n")
+	report.WriteString(fmt.Sprintf("<title>%s</title>\n", html.EscapeString(title)))
+	report.WriteString("<style>" + htmlStyles + "</style>\n")
+	report.WriteString("</head>\n<body>\n<main>\n")
+
+	// Header
+	if g.isMultiDay() {
+		report.WriteString("<h1>🚀 Standup Report</h1>\n")
+		report.WriteString(fmt.Sprintf("<p class=\"date\">%s</p>\n", formatDateRange(g.config.From, g.config.To)))
+	} else {
+		report.WriteString("<h1>🚀 Daily Standup Report</h1>\n")
+		report.WriteString(fmt.Sprintf("<p class=\"date\">%s</p>\n", targetDate.Format("Monday, January 2, 2006")))
+	}
+	if freshness := g.dataFreshness(); freshness != "" {
+		report.WriteString(fmt.Sprintf("<p class=\"meta\">📴 %s</p>\n", freshness))
+	}
+
+	allComments := []jira.Comment{}
+	for _, issue := range issues {
+		allComments = append(allComments, commentsMap[issue.Key]...)
+	}
+
+	// AI Summary if enabled - based on comments
+	if g.config.LLMEnabled && !g.config.Hide.AISummary {
+		llmComments := g.withCodeActivityComments(allComments, targetDate)
+		if hasMeaningfulComments(llmComments) {
+			summary, err := g.daySummary(issues, llmComments, worklogs, FormatHTML)
+			if err == nil && summary != "" {
+				report.WriteString("<h2>🤖 AI Summary of Today's Work</h2>\n")
+				report.WriteString(fmt.Sprintf("<div class=\"ai-summary\">%s</div>\n", summary))
+			} else if err != nil {
+				g.warnLLM("Summarizing your day", err)
+			}
+		} else if len(llmComments) > 0 {
+			report.WriteString("<h2>⚠️ AI Summary Skipped</h2>\n")
+			report.WriteString("<p class=\"warning\">No meaningful comment content found for AI summarization. ")
+			report.WriteString("Consider adding more detailed comments to your Jira tickets for better AI insights.</p>\n")
+		}
+	}
+
+	// Summary
+	if !g.config.Hide.Summary {
+		report.WriteString("<h2>📊 Summary</h2>\n<div class=\"stats\">\n")
+		report.WriteString(htmlStat(len(issues), "Issues"))
+		report.WriteString(htmlStat(len(allComments), "Comments added"))
+		report.WriteString(htmlStat(len(worklogs), "Worklog entries"))
+		report.WriteString("</div>\n")
+		if g.config.MeetingsSummary != "" {
+			report.WriteString(fmt.Sprintf("<p>%s</p>\n", html.EscapeString(g.config.MeetingsSummary)))
+		}
+		report.WriteString(g.formatTimeLogged("<p>Time logged: %s</p>\n", worklogs))
+	}
+
+	if fieldName != "" {
+		fieldGroups := g.groupIssuesByField(issues, fieldName)
+
+		for _, groupName := range g.sortedGroupNames(fieldGroups, fieldName) {
+			report.WriteString(fmt.Sprintf("<h2>🏷️ %s: %s (%d)</h2>\n",
+				html.EscapeString(strings.Title(fieldName)), html.EscapeString(groupName), len(fieldGroups[groupName])))
+			report.WriteString(g.formatHTMLStatusSections(fieldGroups[groupName], commentsMap, "h3"))
+		}
+	} else {
+		report.WriteString(g.formatHTMLStatusSections(issues, commentsMap, "h2"))
+	}
+
+	// Estimates, code activity, work log and other integration sections
+	report.WriteString(g.renderSections(SectionModel{TargetDate: targetDate, Issues: issues, Worklogs: worklogs, Comments: commentsMap}, FormatHTML))
+
+	// Footer
+	if !g.config.Hide.Footer {
+		report.WriteString("<footer>Generated by my-day CLI</footer>\n")
+	}
+	report.WriteString("</main>\n</body>\n</html>\n")
+
+	return report.String(), nil
+}
+
+// formatHTMLStatusSections renders the In Progress / Done / To Do sections for a set of issues
+func (g *Generator) formatHTMLStatusSections(issues []jira.Issue, commentsMap map[string][]jira.Comment, headingTag string) string {
+	var result strings.Builder
+
+	statusGroups := groupIssuesByStatus(issues)
+	for _, section := range htmlStatusSections {
+		sectionIssues := statusGroups[section.group]
+		if len(sectionIssues) == 0 || (section.group == "To Do" && g.config.Hide.ToDo) {
+			continue
+		}
+
+		result.WriteString(fmt.Sprintf("<%s>%s</%s>\n", headingTag, section.heading, headingTag))
+		for _, issue := range sectionIssues {
+			result.WriteString(g.formatIssueHTML(issue, commentsMap[issue.Key], section.badge))
+		}
+	}
+
+	return result.String()
+}
+
+// formatIssueHTML renders one issue as a collapsible section with a status badge
+func (g *Generator) formatIssueHTML(issue jira.Issue, comments []jira.Comment, badgeClass string) string {
+	var result strings.Builder
+
+	result.WriteString("<details class=\"issue\">\n<summary>")
+	result.WriteString(fmt.Sprintf("<span class=\"badge %s\">%s</span>", badgeClass, html.EscapeString(g.StatusLabel(issue.Fields.Status.Name))))
+	result.WriteString(fmt.Sprintf("<span class=\"key\">%s</span> %s", html.EscapeString(issue.Key), html.EscapeString(issue.Fields.Summary)))
+	result.WriteString("</summary>\n")
+
+	// Add comment summary if enabled
+	summarize, detailed, _ := g.nextIssueDetail()
+	if summarize && len(comments) > 0 {
+		if summary, err := g.summarizeComments(comments); err == nil && summary != "" {
+			result.WriteString(fmt.Sprintf("<p>💬 <strong>Today's work:</strong> %s</p>\n", html.EscapeString(summary)))
+		} else if err != nil {
+			g.warnLLM("Summarizing issues", err)
+		}
+	}
+	if changes := g.statusChanges(issue); changes != "" {
+		result.WriteString(fmt.Sprintf("<p>🔀 <strong>Status changes %s:</strong> %s</p>\n", g.reportPeriod(), html.EscapeString(changes)))
+	}
+
+	result.WriteString(fmt.Sprintf("<p class=\"meta\">%s Priority: %s · Project: %s · Updated: %s</p>\n",
+		getPriorityIcon(issue.Fields.Priority.Name),
+		html.EscapeString(issue.Fields.Priority.Name),
+		html.EscapeString(issue.Fields.Project.Key),
+		issue.Fields.Updated.Time.Format("Jan 2, 15:04")))
+
+	if detailed && issue.Fields.Description.Text != "" {
+		result.WriteString(fmt.Sprintf("<p>%s</p>\n", html.EscapeString(issue.Fields.Description.Text)))
+	}
+
+	if duplicate := g.duplicates[issue.Key]; duplicate != "" {
+		result.WriteString(fmt.Sprintf("<p class=\"duplicate\">🔁 Possible duplicate of <span class=\"key\">%s</span></p>\n", html.EscapeString(duplicate)))
+	}
+
+	for _, comment := range comments {
+		result.WriteString(fmt.Sprintf("<div class=\"comment\"><span class=\"comment-time\">%s</span>\n%s</div>\n",
+			comment.Created.Time.Format("Jan 2, 15:04"),
+			html.EscapeString(comment.Body.Text)))
+	}
+
+	result.WriteString("</details>\n")
+	return result.String()
+}
+
+func htmlStat(value int, label string) string {
+	return fmt.Sprintf("<div class=\"stat\"><div class=\"stat-value\">%d</div><div class=\"stat-label\">%s</div></div>\n", value, label)
+}
@@ -0,0 +1,42 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func TestGenerateHTMLEscapesContent(t *testing.T) {
+	generator := &Generator{config: &Config{Format: "html"}}
+	targetDate := time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC)
+
+	issues := []jira.Issue{
+		{
+			Key: "TEST-1",
+			Fields: jira.Fields{
+				Summary: `<script>alert("x")</script>`,
+				Status:  jira.Status{Name: "In Progress", Category: jira.StatusCategory{Key: "indeterminate"}},
+			},
+		},
+	}
+	comments := map[string][]jira.Comment{
+		"TEST-1": {{Body: jira.JiraDescription{Text: "a < b && c > d"}}},
+	}
+
+	output, err := generator.generateHTML(issues, comments, nil, targetDate, "")
+	if err != nil {
+		t.Fatalf("generateHTML() error = %v", err)
+	}
+
+	if strings.Contains(output, "<script>") {
+		t.Error("Issue summary was not HTML-escaped")
+	}
+	if !strings.Contains(output, "a &lt; b &amp;&amp; c &gt; d") {
+		t.Error("Comment text was not HTML-escaped")
+	}
+	if !strings.Contains(output, `<details class="issue">`) || !strings.Contains(output, "badge-in-progress") {
+		t.Error("Expected collapsible issue section with status badge")
+	}
+}
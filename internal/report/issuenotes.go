@@ -0,0 +1,165 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"my-day/internal/jira"
+)
+
+// IssueNotesTarget is the folder of the Obsidian vault where a note per issue is kept, so the
+// graph connects daily reports to the issues they mention
+type IssueNotesTarget struct {
+	Folder  string // Folder of the issue notes
+	JiraURL string // Issue notes link to Jira when set
+}
+
+// SetExportIssues sets the issues whose keys are linked to their notes in exported reports
+func (g *Generator) SetExportIssues(issues []jira.Issue) {
+	g.exportIssues = issues
+}
+
+// mentionedIssues returns the export issues whose keys appear in content, once each, by key
+func (g *Generator) mentionedIssues(content string) []jira.Issue {
+	pattern := exportKeyPattern(g.exportIssues)
+	if pattern == nil {
+		return nil
+	}
+	found := make(map[string]bool)
+	for _, match := range pattern.FindAllStringSubmatch(content, -1) {
+		found[matchedKey(match)] = true
+	}
+
+	var mentioned []jira.Issue
+	for _, issue := range g.exportIssues {
+		if found[issue.Key] {
+			delete(found, issue.Key)
+			mentioned = append(mentioned, issue)
+		}
+	}
+	sort.Slice(mentioned, func(i, j int) bool { return mentioned[i].Key < mentioned[j].Key })
+	return mentioned
+}
+
+// exportKeyPattern matches the keys of issues as wiki-links, as bracketed keys or markdown links
+// such as **[OPS-1]** or [OPS-1](url), and as bare keys. It is nil without issues.
+func exportKeyPattern(issues []jira.Issue) *regexp.Regexp {
+	var keys []string
+	for _, issue := range issues {
+		if issue.Key != "" {
+			keys = append(keys, regexp.QuoteMeta(issue.Key))
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	// Longer keys first, so OPS-10 is not matched within OPS-101
+	sort.Slice(keys, func(i, j int) bool { return len(keys[i]) > len(keys[j]) })
+	alternatives := strings.Join(keys, "|")
+	return regexp.MustCompile(`\[\[(` + alternatives + `)\]\]|\[(` + alternatives + `)\](?:\([^)\s]*\))?|\b(` + alternatives + `)\b`)
+}
+
+// matchedKey returns the issue key of a match of exportKeyPattern
+func matchedKey(match []string) string {
+	for _, key := range match[1:] {
+		if key != "" {
+			return key
+		}
+	}
+	return ""
+}
+
+// wikiLinkIssueKeys renders the keys of issues in markdown as Obsidian wiki-links, e.g. [[OPS-1]]
+func wikiLinkIssueKeys(markdown string, issues []jira.Issue) string {
+	pattern := exportKeyPattern(issues)
+	if pattern == nil {
+		return markdown
+	}
+	return pattern.ReplaceAllStringFunc(markdown, func(match string) string {
+		return "[[" + matchedKey(pattern.FindStringSubmatch(match)) + "]]"
+	})
+}
+
+// writeIssueNotes creates the note of each issue in the issue notes folder, or refreshes the
+// frontmatter of an existing note, keeping what was written below it
+func writeIssueNotes(target *IssueNotesTarget, issues []jira.Issue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+	folder, err := expandHome(target.Folder)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(folder, 0755); err != nil {
+		return fmt.Errorf("failed to create issue notes folder: %w", err)
+	}
+	for _, issue := range issues {
+		path := filepath.Join(folder, issue.Key+".md")
+		frontmatter := target.frontmatter(issue)
+
+		existing, err := os.ReadFile(path)
+		var note string
+		switch {
+		case err == nil:
+			note = frontmatter + stripFrontmatter(string(existing))
+		case os.IsNotExist(err):
+			note = frontmatter + target.body(issue)
+		default:
+			return fmt.Errorf("failed to read issue note %s: %w", issue.Key, err)
+		}
+		if err := os.WriteFile(path, []byte(note), 0644); err != nil {
+			return fmt.Errorf("failed to write issue note %s: %w", issue.Key, err)
+		}
+	}
+	return nil
+}
+
+// issueURL returns the Jira link of an issue, or "" without a Jira URL
+func (t *IssueNotesTarget) issueURL(issue jira.Issue) string {
+	if t.JiraURL == "" {
+		return ""
+	}
+	return strings.TrimSuffix(t.JiraURL, "/") + "/browse/" + issue.Key
+}
+
+// frontmatter returns the properties of the note of an issue
+func (t *IssueNotesTarget) frontmatter(issue jira.Issue) string {
+	var content strings.Builder
+	content.WriteString("---\n")
+	content.WriteString(fmt.Sprintf("key: %s\n", issue.Key))
+	content.WriteString(fmt.Sprintf("summary: %q\n", issue.Fields.Summary))
+	content.WriteString(fmt.Sprintf("status: %q\n", issue.Fields.Status.Name))
+	content.WriteString(fmt.Sprintf("project: %s\n", issue.Fields.Project.Key))
+	if url := t.issueURL(issue); url != "" {
+		content.WriteString(fmt.Sprintf("url: %s\n", url))
+	}
+	content.WriteString("type: jira-issue\n")
+	content.WriteString("tags:\n  - jira\n")
+	content.WriteString("---\n")
+	return content.String()
+}
+
+// body returns the body of a new note of an issue
+func (t *IssueNotesTarget) body(issue jira.Issue) string {
+	body := fmt.Sprintf("\n# %s %s\n", issue.Key, issue.Fields.Summary)
+	if url := t.issueURL(issue); url != "" {
+		body += fmt.Sprintf("\n[Open in Jira](%s)\n", url)
+	}
+	return body
+}
+
+// stripFrontmatter returns a note without its leading YAML frontmatter
+func stripFrontmatter(note string) string {
+	if !strings.HasPrefix(note, "---\n") {
+		return "\n" + note
+	}
+	end := strings.Index(note[4:], "\n---\n")
+	if end < 0 {
+		return "\n" + note
+	}
+	return note[4+end+len("\n---\n"):]
+}
@@ -0,0 +1,105 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"my-day/internal/jira"
+)
+
+func noteIssue(key, summary, status string) jira.Issue {
+	return jira.Issue{Key: key, Fields: jira.Fields{
+		Summary: summary,
+		Status:  jira.Status{Name: status},
+		Project: jira.Project{Key: strings.SplitN(key, "-", 2)[0]},
+	}}
+}
+
+func TestWikiLinkIssueKeys(t *testing.T) {
+	issues := []jira.Issue{noteIssue("OPS-10", "Ten", "To Do"), noteIssue("OPS-101", "Hundred and one", "Done")}
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{"bold bracketed key", "- **[OPS-101]** Hundred and one", "- **[[OPS-101]]** Hundred and one"},
+		{"bare key", "Blocked by OPS-10.", "Blocked by [[OPS-10]]."},
+		{"markdown link", "See [OPS-10](https://jira.example.com/browse/OPS-10)", "See [[OPS-10]]"},
+		{"existing wiki-link", "See [[OPS-101]]", "See [[OPS-101]]"},
+		{"longer key", "OPS-1010 and OPS-101", "OPS-1010 and [[OPS-101]]"},
+		{"unknown key", "OPS-2 is elsewhere", "OPS-2 is elsewhere"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wikiLinkIssueKeys(tt.markdown, issues); got != tt.want {
+				t.Errorf("wikiLinkIssueKeys() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteIssueNotes(t *testing.T) {
+	target := &IssueNotesTarget{Folder: t.TempDir(), JiraURL: "https://jira.example.com/"}
+	existing := "---\nkey: OPS-2\nstatus: \"To Do\"\n---\n\n# OPS-2 Old summary\n\nMy own notes\n"
+	if err := os.WriteFile(filepath.Join(target.Folder, "OPS-2.md"), []byte(existing), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	issues := []jira.Issue{noteIssue("OPS-1", "Migrate runners", "In Progress"), noteIssue("OPS-2", "Rotate keys", "Done")}
+	if err := writeIssueNotes(target, issues); err != nil {
+		t.Fatalf("writeIssueNotes() error = %v", err)
+	}
+
+	created, err := os.ReadFile(filepath.Join(target.Folder, "OPS-1.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"key: OPS-1\n", "status: \"In Progress\"\n", "project: OPS\n",
+		"url: https://jira.example.com/browse/OPS-1\n", "# OPS-1 Migrate runners\n", "[Open in Jira](https://jira.example.com/browse/OPS-1)"} {
+		if !strings.Contains(string(created), want) {
+			t.Errorf("expected %q in the new note, got:\n%s", want, created)
+		}
+	}
+
+	refreshed, err := os.ReadFile(filepath.Join(target.Folder, "OPS-2.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(refreshed), "status: \"Done\"\n") || strings.Contains(string(refreshed), "To Do") {
+		t.Errorf("expected the status to be refreshed, got:\n%s", refreshed)
+	}
+	if !strings.HasSuffix(string(refreshed), "---\n\n# OPS-2 Old summary\n\nMy own notes\n") {
+		t.Errorf("expected the body of the note to be kept, got:\n%s", refreshed)
+	}
+}
+
+func TestExportToObsidianLinksIssues(t *testing.T) {
+	folder := t.TempDir()
+	config := goldenConfig(FormatMarkdown)
+	config.ExportEnabled = true
+	config.ExportFolderPath = folder
+	config.ExportFileDate = "2006-01-02"
+	config.ExportWikiLinks = true
+	config.IssueNotes = &IssueNotesTarget{Folder: filepath.Join(folder, "issues")}
+	g := NewGenerator(config)
+	g.SetExportIssues([]jira.Issue{noteIssue("OPS-1", "Migrate runners", "In Progress"), noteIssue("OPS-3", "Not in the report", "To Do")})
+
+	if err := g.ExportToObsidian("# Daily Standup Report\n\n- **[OPS-1]** Migrate runners\n", goldenTargetDate); err != nil {
+		t.Fatalf("ExportToObsidian() error = %v", err)
+	}
+	content, err := os.ReadFile(filepath.Join(folder, "2024-07-15.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "- **[[OPS-1]]** Migrate runners") {
+		t.Errorf("expected a wiki-link to OPS-1, got:\n%s", content)
+	}
+	if _, err := os.Stat(filepath.Join(folder, "issues", "OPS-1.md")); err != nil {
+		t.Errorf("expected a note for OPS-1: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(folder, "issues", "OPS-3.md")); !os.IsNotExist(err) {
+		t.Errorf("expected no note for an issue the report does not mention, got %v", err)
+	}
+}
@@ -0,0 +1,243 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// Layouts of the report (report.layout, --layout)
+const (
+	LayoutDefault = "default" // Issues grouped by status
+	LayoutStandup = "standup" // Yesterday, Today and Blockers
+)
+
+// standupParts are the issues of the three parts of the standup layout
+type standupParts struct {
+	Yesterday []jira.Issue // Worked on in the report window: commented, logged, updated or completed
+	Today     []jira.Issue // In progress, and assigned but not started yet
+	Blockers  []jira.Issue // Blocked by an unresolved issue or in a blocked status
+}
+
+// isStandupLayout reports whether the report uses the classic standup layout. Custom templates
+// replace every built-in layout, and the HTML report keeps the default one.
+func (g *Generator) isStandupLayout() bool {
+	return g.config.Layout == LayoutStandup && g.config.TemplatePath == "" && g.config.Format != FormatHTML
+}
+
+// splitStandup sorts the issues into the parts of the standup layout. issues are the issues of
+// the report window; allIssues are every issue synced, so work that is assigned but has not
+// been touched lately still shows up under Today.
+func (g *Generator) splitStandup(issues, allIssues []jira.Issue, commentsMap map[string][]jira.Comment, worklogs []jira.WorklogEntry, targetDate time.Time) standupParts {
+	var parts standupParts
+
+	logged := make(map[string]bool)
+	for _, worklog := range worklogs {
+		logged[worklog.IssueID] = true
+	}
+	for _, issue := range issues {
+		if getStatusCategory(issue) == 2 {
+			continue
+		}
+		if len(commentsMap[issue.Key]) > 0 || logged[issue.ID] || logged[issue.Key] || g.updatedInWindow(issue, targetDate) {
+			parts.Yesterday = append(parts.Yesterday, issue)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, issue := range append(append([]jira.Issue{}, issues...), allIssues...) {
+		if seen[issue.Key] || getStatusCategory(issue) == 3 {
+			continue
+		}
+		seen[issue.Key] = true
+
+		switch {
+		case isBlocked(issue):
+			parts.Blockers = append(parts.Blockers, issue)
+		case isInProgress(issue):
+			parts.Today = append(parts.Today, issue)
+		case !g.config.Hide.ToDo && g.isAssignedToMe(issue, issues):
+			parts.Today = append(parts.Today, issue)
+		}
+	}
+	sort.SliceStable(parts.Today, func(i, j int) bool {
+		return getStatusCategory(parts.Today[i]) < getStatusCategory(parts.Today[j])
+	})
+
+	return parts
+}
+
+// updatedInWindow reports whether an issue was updated in the report window, rather than only
+// included for being in progress
+func (g *Generator) updatedInWindow(issue jira.Issue, targetDate time.Time) bool {
+	for _, reason := range g.inclusionReasons(issue, targetDate) {
+		if reason != "in progress" {
+			return true
+		}
+	}
+	return false
+}
+
+// isAssignedToMe reports whether an unstarted issue is assigned to the user of the report.
+// Without the user's account, the unstarted issues of the report window are taken as theirs.
+func (g *Generator) isAssignedToMe(issue jira.Issue, issues []jira.Issue) bool {
+	if g.config.AccountID == "" {
+		for _, windowIssue := range issues {
+			if windowIssue.Key == issue.Key {
+				return true
+			}
+		}
+		return false
+	}
+	return issue.Fields.Assignee != nil && issue.Fields.Assignee.AccountID == g.config.AccountID
+}
+
+// isBlocked reports whether an issue is blocked by an unresolved issue, or in a status such as "Blocked"
+func isBlocked(issue jira.Issue) bool {
+	return len(issue.BlockedBy()) > 0 || strings.Contains(strings.ToLower(issue.Fields.Status.Name), "block")
+}
+
+// blockerText explains what blocks an issue, e.g. "blocked by OPS-9 (Provision replica)"
+func blockerText(issue jira.Issue) string {
+	var blockers []string
+	for _, blocker := range issue.BlockedBy() {
+		blockers = append(blockers, fmt.Sprintf("%s (%s)", blocker.Key, blocker.Fields.Summary))
+	}
+	if len(blockers) == 0 {
+		return issue.Fields.Status.Name
+	}
+	return "blocked by " + strings.Join(blockers, ", ")
+}
+
+// issueLogged returns the time logged on an issue, e.g. "2h 30m", or "" when none was
+func issueLogged(issue jira.Issue, worklogs []jira.WorklogEntry) string {
+	seconds := 0
+	for _, worklog := range worklogs {
+		if worklog.IssueID == issue.ID || worklog.IssueID == issue.Key {
+			seconds += worklog.TimeSpentSeconds
+		}
+	}
+	if seconds == 0 {
+		return ""
+	}
+	return formatTrackedTime(time.Duration(seconds) * time.Second)
+}
+
+// generateStandup renders the report in the classic standup layout: what was done in the
+// report window, what is on today and what is blocked, followed by the usual sections
+func (g *Generator) generateStandup(issues, allIssues []jira.Issue, commentsMap map[string][]jira.Comment, worklogs []jira.WorklogEntry, targetDate time.Time) (string, error) {
+	parts := g.splitStandup(issues, allIssues, commentsMap, worklogs, targetDate)
+	markdown := g.config.Format == FormatMarkdown
+	format := FormatConsole
+	if markdown {
+		format = FormatMarkdown
+	}
+
+	var report strings.Builder
+	if markdown {
+		report.WriteString(fmt.Sprintf("# %s\n\n", g.reportTitle(targetDate)))
+	} else {
+		report.WriteString(fmt.Sprintf("🚀 %s\n", g.reportTitle(targetDate)))
+		report.WriteString(strings.Repeat("=", 50) + "\n\n")
+	}
+
+	// AI Summary if enabled - based on comments
+	if g.config.LLMEnabled && !g.config.Hide.AISummary {
+		allComments := []jira.Comment{}
+		for _, comments := range commentsMap {
+			allComments = append(allComments, comments...)
+		}
+		allComments = g.withCodeActivityComments(allComments, targetDate)
+
+		if hasMeaningfulComments(allComments) {
+			summary, err := g.daySummary(issues, allComments, worklogs, format)
+			if err == nil && summary != "" {
+				if markdown {
+					report.WriteString("## 🤖 AI Summary\n\n")
+				} else {
+					report.WriteString("🤖 AI SUMMARY\n")
+				}
+				report.WriteString(fmt.Sprintf("%s\n\n", summary))
+			} else if err != nil {
+				g.warnLLM("Summarizing your day", err)
+			}
+		}
+	}
+
+	yesterday := "Yesterday"
+	if g.isMultiDay() {
+		yesterday = "Done " + g.reportPeriod()
+	}
+	g.writeStandupPart(&report, "⏪", yesterday, parts.Yesterday, "Nothing logged", func(issue jira.Issue) []string {
+		var details []string
+		if comments := commentsMap[issue.Key]; len(comments) > 0 {
+			details = append(details, "💬 "+commentExcerpt(comments[len(comments)-1].Body.Text, 120))
+		}
+		if logged := issueLogged(issue, worklogs); logged != "" {
+			details = append(details, "⏱️ "+logged+" logged")
+		}
+		return details
+	})
+	g.writeStandupPart(&report, "▶️", "Today", parts.Today, "Nothing planned", nil)
+	g.writeStandupPart(&report, "🚧", "Blockers", parts.Blockers, "No blockers", func(issue jira.Issue) []string {
+		return []string{blockerText(issue)}
+	})
+
+	// Estimates, code activity, work log and other integration sections
+	report.WriteString(g.renderSections(SectionModel{TargetDate: targetDate, Issues: issues, Worklogs: worklogs, Comments: commentsMap}, format))
+
+	// Footer
+	if !g.config.Hide.Footer {
+		report.WriteString("---\n")
+		if markdown {
+			report.WriteString("*Generated by my-day CLI*\n")
+		} else {
+			report.WriteString("Generated by my-day CLI 🤖\n")
+		}
+	}
+
+	return report.String(), nil
+}
+
+// writeStandupPart writes one part of the standup layout, each issue with the detail lines
+// returned by detail, or the empty text when the part has no issues
+func (g *Generator) writeStandupPart(report *strings.Builder, icon, title string, issues []jira.Issue, empty string, detail func(jira.Issue) []string) {
+	markdown := g.config.Format == FormatMarkdown
+	if markdown {
+		report.WriteString(fmt.Sprintf("## %s %s\n\n", icon, title))
+	} else {
+		report.WriteString(fmt.Sprintf("%s %s\n", icon, strings.ToUpper(title)))
+	}
+
+	if len(issues) == 0 {
+		if markdown {
+			report.WriteString(fmt.Sprintf("- %s\n\n", empty))
+		} else {
+			report.WriteString(fmt.Sprintf("  %s\n\n", empty))
+		}
+		return
+	}
+
+	for _, issue := range issues {
+		statusIcon := g.statusIcon(issue.Fields.Status.Name)
+		if markdown {
+			report.WriteString(fmt.Sprintf("- %s **[%s]** %s\n", statusIcon, issue.Key, issue.Fields.Summary))
+		} else {
+			report.WriteString(fmt.Sprintf("  %s %s: %s\n", statusIcon, issue.Key, issue.Fields.Summary))
+		}
+		if detail == nil {
+			continue
+		}
+		for _, line := range detail(issue) {
+			if markdown {
+				report.WriteString(fmt.Sprintf("  - %s\n", indentContinuation(line, "    ")))
+			} else {
+				report.WriteString(fmt.Sprintf("     %s\n", indentContinuation(line, "       ")))
+			}
+		}
+	}
+	report.WriteString("\n")
+}
@@ -0,0 +1,80 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+func TestStandupLayout(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 9, 0, 0, 0, time.UTC)
+	yesterday := targetDate.Add(-20 * time.Hour)
+	lastWeek := targetDate.Add(-7 * 24 * time.Hour)
+	issue := func(key, summary, category, status string, updated time.Time, assignee string) jira.Issue {
+		issue := jira.Issue{ID: key, Key: key, Fields: jira.Fields{
+			Summary: summary,
+			Status:  jira.Status{Name: status, Category: jira.StatusCategory{Key: category}},
+			Updated: jira.JiraTime{Time: updated},
+		}}
+		if assignee != "" {
+			issue.Fields.Assignee = &jira.User{AccountID: assignee}
+		}
+		return issue
+	}
+
+	blocked := issue("OPS-3", "Migrate database", "indeterminate", "In Progress", yesterday, "me")
+	blocked.Fields.IssueLinks = []jira.IssueLink{{
+		Type:        jira.IssueLinkType{Name: "Blocks"},
+		InwardIssue: &jira.LinkedIssue{Key: "OPS-9"},
+	}}
+	blocked.Fields.IssueLinks[0].InwardIssue.Fields.Summary = "Provision replica"
+
+	issues := []IssueWithComments{
+		{Issue: issue("OPS-1", "Rotate certificates", "done", "Done", yesterday, "me"), Comments: []jira.Comment{
+			{Body: jira.JiraDescription{Text: "Rotated the staging certificates"}, Created: jira.JiraTime{Time: yesterday}},
+		}},
+		{Issue: issue("OPS-2", "Upgrade ingress controller", "indeterminate", "In Progress", lastWeek, "me")},
+		{Issue: blocked},
+		{Issue: issue("OPS-5", "Write runbook", "new", "To Do", lastWeek, "me")},
+		{Issue: issue("OPS-6", "Review budget", "new", "To Do", lastWeek, "someone-else")},
+	}
+	worklogs := []jira.WorklogEntry{
+		{IssueID: "OPS-2", TimeSpentSeconds: 7200, Started: jira.JiraTime{Time: yesterday}},
+	}
+
+	g := &Generator{config: &Config{Format: "markdown", IncludeToday: true, IncludeYesterday: true, IncludeInProgress: true,
+		Layout: LayoutStandup, AccountID: "me", Hide: HiddenSections{Footer: true}}, summarizer: llm.NewDisabledSummarizer()}
+	content, err := g.GenerateWithComments(issues, worklogs, targetDate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "## ⏪ Yesterday\n\n" +
+		"- 🔄 **[OPS-3]** Migrate database\n" +
+		"- 🔄 **[OPS-2]** Upgrade ingress controller\n  - ⏱️ 2h logged\n" +
+		"- ✅ **[OPS-1]** Rotate certificates\n  - 💬 Rotated the staging certificates\n\n" +
+		"## ▶️ Today\n\n" +
+		"- 🔄 **[OPS-2]** Upgrade ingress controller\n" +
+		"- 📋 **[OPS-5]** Write runbook\n\n" +
+		"## 🚧 Blockers\n\n" +
+		"- 🔄 **[OPS-3]** Migrate database\n  - blocked by OPS-9 (Provision replica)\n\n"
+	if !strings.Contains(content, want) {
+		t.Errorf("unexpected standup layout, expected:\n%s\ngot:\n%s", want, content)
+	}
+	if strings.Contains(content, "OPS-6") {
+		t.Errorf("expected OPS-6, assigned to someone else, to be left out:\n%s", content)
+	}
+
+	g.config.Format = "console"
+	g.config.Hide.ToDo = true
+	content, err = g.GenerateWithComments(issues[:2], nil, targetDate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(content, "▶️ TODAY\n  🔄 OPS-2: Upgrade ingress controller\n\n🚧 BLOCKERS\n  No blockers\n") {
+		t.Errorf("unexpected console standup layout:\n%s", content)
+	}
+}
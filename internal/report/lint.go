@@ -0,0 +1,191 @@
+package report
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// markdownHeadingPattern matches ATX headings; "#tag" lines without a space are Obsidian tags, not headings
+	markdownHeadingPattern = regexp.MustCompile(`^(#{1,6})\s`)
+
+	// markdownListPattern matches bullet and ordered list items with their indentation
+	markdownListPattern = regexp.MustCompile(`^( *)([-*+]|\d+[.)])\s`)
+
+	// markdownFencePattern matches the opening or closing line of a fenced code block
+	markdownFencePattern = regexp.MustCompile("^ {0,3}(```+|~~~+)")
+)
+
+// MarkdownProblem describes a structural problem found in generated markdown
+type MarkdownProblem struct {
+	Line    int    // 1-based line number in the checked content
+	Rule    string // heading-increment, list-indent or unclosed-fence
+	Message string
+}
+
+func (p MarkdownProblem) String() string {
+	return fmt.Sprintf("line %d: %s (%s)", p.Line, p.Message, p.Rule)
+}
+
+// LintMarkdown checks heading hierarchy, list indentation and code fences without changing the content
+func LintMarkdown(content string) []MarkdownProblem {
+	_, problems := lintMarkdown(content)
+	return problems
+}
+
+// RepairMarkdown fixes the problems LintMarkdown reports and returns the repaired content
+// together with the problems that were fixed. Export paths run it so Obsidian and other
+// markdown consumers never receive broken structure from free-form comment text.
+func RepairMarkdown(content string) (string, []MarkdownProblem) {
+	return lintMarkdown(content)
+}
+
+// listLevel is the indentation of a list item and of its content
+type listLevel struct {
+	indent  int
+	content int
+}
+
+func lintMarkdown(content string) (string, []MarkdownProblem) {
+	lines := strings.Split(content, "\n")
+	var problems []MarkdownProblem
+	var output []string
+
+	start := 0
+	// Skip YAML frontmatter
+	if len(lines) > 0 && lines[0] == "---" {
+		for i := 1; i < len(lines); i++ {
+			if lines[i] == "---" {
+				start = i + 1
+				break
+			}
+		}
+	}
+	output = append(output, lines[:start]...)
+
+	lastHeading := 0
+	var listLevels []listLevel // enclosing list items, outermost first
+	fence := ""                // marker of the open code fence, if any
+	fenceLine := 0
+	fenceUnclosed := false
+
+	for i := start; i < len(lines); i++ {
+		line := lines[i]
+		lineNumber := i + 1
+
+		if fence != "" {
+			if closesFence(line, fence) {
+				fence = ""
+				output = append(output, line)
+				continue
+			}
+			// A fence that is never closed most likely came from comment text; end it before the next heading
+			if fenceUnclosed && markdownHeadingPattern.MatchString(line) {
+				problems = append(problems, MarkdownProblem{Line: fenceLine, Rule: "unclosed-fence", Message: "code fence is never closed"})
+				output = append(output, fence)
+				fence = ""
+			} else {
+				output = append(output, line)
+				continue
+			}
+		}
+
+		if match := markdownFencePattern.FindStringSubmatch(line); match != nil {
+			fence = match[1]
+			fenceLine = lineNumber
+			fenceUnclosed = true
+			for _, next := range lines[i+1:] {
+				if closesFence(next, fence) {
+					fenceUnclosed = false
+					break
+				}
+			}
+			output = append(output, line)
+			continue
+		}
+
+		if match := markdownHeadingPattern.FindStringSubmatch(line); match != nil {
+			level := len(match[1])
+			if lastHeading > 0 && level > lastHeading+1 {
+				problems = append(problems, MarkdownProblem{
+					Line:    lineNumber,
+					Rule:    "heading-increment",
+					Message: fmt.Sprintf("heading level jumps from %d to %d", lastHeading, level),
+				})
+				level = lastHeading + 1
+				line = strings.Repeat("#", level) + line[len(match[1]):]
+			}
+			lastHeading = level
+			listLevels = nil
+			output = append(output, line)
+			continue
+		}
+
+		if match := markdownListPattern.FindStringSubmatch(line); match != nil {
+			indent := len(match[1])
+
+			// Drop list levels this item is not nested in
+			for len(listLevels) > 0 && indent < listLevels[len(listLevels)-1].indent {
+				listLevels = listLevels[:len(listLevels)-1]
+			}
+
+			// Nested items start where the parent item's content starts
+			expected := 0
+			if len(listLevels) > 0 {
+				parent := listLevels[len(listLevels)-1]
+				expected = parent.indent
+				if indent > parent.indent {
+					expected = parent.content
+				}
+			}
+
+			if indent != expected {
+				problems = append(problems, MarkdownProblem{
+					Line:    lineNumber,
+					Rule:    "list-indent",
+					Message: fmt.Sprintf("list item indented %d spaces, expected %d", indent, expected),
+				})
+				line = strings.Repeat(" ", expected) + line[indent:]
+				indent = expected
+			}
+
+			level := listLevel{indent: indent, content: indent + len(match[2]) + 1}
+			if len(listLevels) > 0 && listLevels[len(listLevels)-1].indent == indent {
+				listLevels[len(listLevels)-1] = level
+			} else {
+				listLevels = append(listLevels, level)
+			}
+			output = append(output, line)
+			continue
+		}
+
+		if strings.TrimSpace(line) == "" {
+			output = append(output, line)
+			continue
+		}
+
+		// Any other unindented text ends the list
+		if !strings.HasPrefix(line, " ") {
+			listLevels = nil
+		}
+		output = append(output, line)
+	}
+
+	if fence != "" {
+		problems = append(problems, MarkdownProblem{Line: fenceLine, Rule: "unclosed-fence", Message: "code fence is never closed"})
+		if len(output) > 0 && output[len(output)-1] == "" {
+			output = append(output[:len(output)-1], fence, "")
+		} else {
+			output = append(output, fence)
+		}
+	}
+
+	return strings.Join(output, "\n"), problems
+}
+
+// closesFence reports whether line closes a code block opened with the given fence marker
+func closesFence(line, fence string) bool {
+	match := markdownFencePattern.FindStringSubmatch(line)
+	return match != nil && match[1][0] == fence[0] && len(match[1]) >= len(fence) && strings.TrimSpace(line) == match[1]
+}
@@ -0,0 +1,89 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRepairMarkdown(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      string
+		wantRules []string
+	}{
+		{
+			name:  "valid markdown is unchanged",
+			input: "---\ntags: [a]\n---\n# Title\n## Section\n- item\n  - nested\n1. first\n   - nested\n#tag\n",
+			want:  "---\ntags: [a]\n---\n# Title\n## Section\n- item\n  - nested\n1. first\n   - nested\n#tag\n",
+		},
+		{
+			name:      "heading jump is demoted",
+			input:     "# Title\n#### Deep\n##### Deeper\n",
+			want:      "# Title\n## Deep\n### Deeper\n",
+			wantRules: []string{"heading-increment", "heading-increment"},
+		},
+		{
+			name:      "over-indented list item",
+			input:     "- item\n      - nested\n",
+			want:      "- item\n  - nested\n",
+			wantRules: []string{"list-indent"},
+		},
+		{
+			name:      "indented item without parent",
+			input:     "Text\n   - item\n",
+			want:      "Text\n- item\n",
+			wantRules: []string{"list-indent"},
+		},
+		{
+			name:      "unclosed fence at end",
+			input:     "# Title\n```go\nfmt.Println()\n",
+			want:      "# Title\n```go\nfmt.Println()\n```\n",
+			wantRules: []string{"unclosed-fence"},
+		},
+		{
+			name:      "unclosed fence from comment closed before next heading",
+			input:     "## Issue\n  ```\n  broken comment\n## Next\n- item\n",
+			want:      "## Issue\n  ```\n  broken comment\n```\n## Next\n- item\n",
+			wantRules: []string{"unclosed-fence"},
+		},
+		{
+			name:  "headings inside fences are ignored",
+			input: "# Title\n```\n#### not a heading\n```\n",
+			want:  "# Title\n```\n#### not a heading\n```\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, problems := RepairMarkdown(tt.input)
+			if got != tt.want {
+				t.Errorf("RepairMarkdown() =\n%q\nwant\n%q", got, tt.want)
+			}
+			if len(problems) != len(tt.wantRules) {
+				t.Fatalf("expected %d problems, got %v", len(tt.wantRules), problems)
+			}
+			for i, problem := range problems {
+				if problem.Rule != tt.wantRules[i] {
+					t.Errorf("problem %d rule = %s, want %s", i, problem.Rule, tt.wantRules[i])
+				}
+			}
+			if remaining := LintMarkdown(got); len(remaining) > 0 {
+				t.Errorf("repaired markdown still has problems: %v", remaining)
+			}
+		})
+	}
+}
+
+func TestGoldenMarkdownIsLintClean(t *testing.T) {
+	for _, name := range []string{"markdown", "markdown_comments", "markdown_github", "markdown_gitlab", "markdown_detailed_estimates", "markdown_enhanced", "markdown_grouped_squad", "markdown_grouped_column", "obsidian", "digest_markdown", "weekly_markdown"} {
+		content, err := os.ReadFile(filepath.Join("testdata", "golden", name+".golden"))
+		if err != nil {
+			t.Fatalf("failed to read golden file: %v", err)
+		}
+		if problems := LintMarkdown(string(content)); len(problems) > 0 {
+			t.Errorf("%s has markdown problems: %v", name, problems)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package report
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+// SummaryCache keeps LLM summaries between reports, keyed by a hash of the prompt inputs, so
+// regenerating a report doesn't ask the LLM again about unchanged issues
+type SummaryCache interface {
+	Summary(key string) (string, bool)
+	SaveSummary(key, summary string)
+}
+
+// fallbackCounter is implemented by summarizers that answer with the embedded LLM when their
+// backend cannot, whose answers are not cached
+type fallbackCounter interface {
+	Fallbacks() int
+}
+
+// summarizeIssue summarizes an issue, reusing the summary prefetched for the report or the
+// cached summary of the same inputs
+func (g *Generator) summarizeIssue(issue jira.Issue) (string, error) {
+	if summary, found := g.issueSummaries[issue.Key]; found {
+		return summary, nil
+	}
+	return g.cachedSummary("issue", issueInputs(issue), func() (string, error) {
+		return g.summarizer.SummarizeIssue(issue)
+	})
+}
+
+// issueInputs returns the fields of an issue its summary prompt is built from
+func issueInputs(issue jira.Issue) interface{} {
+	return struct {
+		Key, Project, Type, Status, Priority, Summary, Description string
+	}{
+		issue.Key, issue.Fields.Project.Key, issue.Fields.IssueType.Name, issue.Fields.Status.Name,
+		issue.Fields.Priority.Name, issue.Fields.Summary, issue.Fields.Description.Text,
+	}
+}
+
+// prefetchIssueSummaries summarizes the issues the report will show with an AI summary all at
+// once, llm.concurrency at a time, instead of one by one while rendering. Issues whose summary
+// is cached are left to the cache.
+func (g *Generator) prefetchIssueSummaries(issues []jira.Issue) {
+	if !g.config.LLMEnabled || (g.plan == nil && !g.config.Detailed) || g.config.Format == FormatHTML {
+		return
+	}
+
+	// The issues in the order they are rendered, as far as the time budget details them
+	groups := groupIssuesByStatus(issues)
+	shown := append(groups["In Progress"], groups["Done"]...)
+	if !g.config.Hide.ToDo {
+		shown = append(shown, groups["To Do"]...)
+	}
+	if g.plan != nil {
+		shown = shown[:min(len(shown), g.plan.detailedIssues)]
+	}
+
+	var pending []jira.Issue
+	keys := make(map[string]string)
+	for _, issue := range shown {
+		if g.summaryCacheEnabled() {
+			key, err := g.summaryCacheKey("issue", issueInputs(issue))
+			if err != nil {
+				continue
+			}
+			if _, found := g.config.LLMCache.Summary(key); found {
+				continue
+			}
+			keys[issue.Key] = key
+		}
+		pending = append(pending, issue)
+	}
+	// A single issue gains nothing from being summarized ahead
+	if len(pending) < 2 {
+		return
+	}
+
+	counter, counts := g.summarizer.(fallbackCounter)
+	fallbacks := 0
+	if counts {
+		fallbacks = counter.Fallbacks()
+	}
+	summaries, err := g.summarizer.SummarizeIssues(pending)
+	if err != nil {
+		return
+	}
+	g.issueSummaries = summaries
+	if counts && counter.Fallbacks() != fallbacks {
+		return
+	}
+	for issueKey, key := range keys {
+		if summary := summaries[issueKey]; summary != "" {
+			g.config.LLMCache.SaveSummary(key, summary)
+		}
+	}
+}
+
+// summarizeComments summarizes the comments of an issue, reusing the cached summary of the same
+// comments
+func (g *Generator) summarizeComments(comments []jira.Comment) (string, error) {
+	type commentInput struct {
+		Created string
+		Text    string
+	}
+	inputs := make([]commentInput, 0, len(comments))
+	for _, comment := range comments {
+		inputs = append(inputs, commentInput{comment.Created.Time.UTC().Format("2006-01-02T15:04:05Z"), comment.Body.Text})
+	}
+	return g.cachedSummary("comments", inputs, func() (string, error) {
+		return g.summarizer.SummarizeComments(comments)
+	})
+}
+
+// cachedSummary returns the cached summary of kind for inputs, or generates and caches it. The
+// embedded LLM is fast enough not to be cached, and answers of the embedded fallback are not
+// cached so the LLM is asked again once it is back.
+func (g *Generator) cachedSummary(kind string, inputs interface{}, generate func() (string, error)) (string, error) {
+	if !g.summaryCacheEnabled() {
+		return generate()
+	}
+
+	key, err := g.summaryCacheKey(kind, inputs)
+	if err != nil {
+		return generate()
+	}
+	if summary, found := g.config.LLMCache.Summary(key); found {
+		return summary, nil
+	}
+
+	counter, counts := g.summarizer.(fallbackCounter)
+	fallbacks := 0
+	if counts {
+		fallbacks = counter.Fallbacks()
+	}
+	summary, err := generate()
+	if err != nil || summary == "" || (counts && counter.Fallbacks() != fallbacks) {
+		return summary, err
+	}
+	g.config.LLMCache.SaveSummary(key, summary)
+	return summary, nil
+}
+
+// summaryCacheEnabled returns whether LLM summaries are cached. The embedded LLM standing in
+// for a backend that wasn't ready is not cached either.
+func (g *Generator) summaryCacheEnabled() bool {
+	_, embedded := g.summarizer.(*llm.EmbeddedLLM)
+	return g.config.LLMCache != nil && g.config.LLMEnabled && g.config.LLMMode != "embedded" && !embedded
+}
+
+// summaryCacheKey hashes the inputs of a summary with the summarizer settings that shape its
+// prompt, so changing the model, style or language asks the LLM again
+func (g *Generator) summaryCacheKey(kind string, inputs interface{}) (string, error) {
+	llmConfig := newLLMConfig(g.config, "technical")
+	data, err := json.Marshal(struct {
+		Kind                                               string
+		Mode, Model, OllamaModel, OpenAIModel, Style, Lang string
+		LocalOpenAIModel, Period                           string
+		MaxLength                                          int
+		Privacy                                            llm.PrivacyConfig
+		Projects                                           map[string]llm.ProjectPrompt
+		Inputs                                             interface{}
+	}{
+		kind, llmConfig.Mode, llmConfig.Model, llmConfig.OllamaModel, llmConfig.OpenAIModel, llmConfig.SummaryStyle,
+		llmConfig.Language, llmConfig.LocalOpenAIModel, llmConfig.Period, llmConfig.MaxSummaryLength, llmConfig.Privacy,
+		llmConfig.Projects, inputs,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
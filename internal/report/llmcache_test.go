@@ -0,0 +1,136 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+// countingSummarizer summarizes issues by key, counting the calls and answering with the
+// embedded fallback while fallingBack is set
+type countingSummarizer struct {
+	*llm.DisabledSummarizer
+	calls       int
+	batches     int
+	fallbacks   int
+	fallingBack bool
+}
+
+func (s *countingSummarizer) SummarizeIssue(issue jira.Issue) (string, error) {
+	s.calls++
+	if s.fallingBack {
+		s.fallbacks++
+	}
+	return "Summary of " + issue.Key + ": " + issue.Fields.Summary, nil
+}
+
+func (s *countingSummarizer) SummarizeIssues(issues []jira.Issue) (map[string]string, error) {
+	s.batches++
+	summaries := make(map[string]string)
+	for _, issue := range issues {
+		summaries[issue.Key], _ = s.SummarizeIssue(issue)
+	}
+	return summaries, nil
+}
+
+func (s *countingSummarizer) Fallbacks() int {
+	return s.fallbacks
+}
+
+// mapSummaryCache is a SummaryCache in memory
+type mapSummaryCache map[string]string
+
+func (c mapSummaryCache) Summary(key string) (string, bool) {
+	summary, found := c[key]
+	return summary, found
+}
+
+func (c mapSummaryCache) SaveSummary(key, summary string) {
+	c[key] = summary
+}
+
+func TestSummarizeIssueIsCachedByInputs(t *testing.T) {
+	summarizer := &countingSummarizer{}
+	cache := mapSummaryCache{}
+	g := &Generator{config: &Config{LLMEnabled: true, LLMMode: "ollama", OllamaModel: "llama3.2", LLMCache: cache}, summarizer: summarizer}
+	issue := jira.Issue{Key: "OPS-1", Fields: jira.Fields{Summary: "Upgrade ingress"}}
+
+	for i := 0; i < 2; i++ {
+		if summary, err := g.summarizeIssue(issue); err != nil || summary != "Summary of OPS-1: Upgrade ingress" {
+			t.Fatalf("unexpected summary %q (err=%v)", summary, err)
+		}
+	}
+	if summarizer.calls != 1 {
+		t.Errorf("expected the unchanged issue to be summarized once, got %d calls", summarizer.calls)
+	}
+
+	// A changed issue or another model asks the LLM again
+	issue.Fields.Summary = "Upgrade ingress in prod"
+	if summary, _ := g.summarizeIssue(issue); summary != "Summary of OPS-1: Upgrade ingress in prod" {
+		t.Errorf("expected the summary of the changed issue, got %q", summary)
+	}
+	g.config.OllamaModel = "mistral"
+	g.summarizeIssue(issue)
+	if summarizer.calls != 3 {
+		t.Errorf("expected changes to miss the cache, got %d calls", summarizer.calls)
+	}
+
+	// Answers of the embedded fallback are not kept
+	g.config.OllamaModel = "qwen2.5"
+	summarizer.fallingBack = true
+	g.summarizeIssue(issue)
+	summarizer.fallingBack = false
+	g.summarizeIssue(issue)
+	if summarizer.calls != 5 || len(cache) != 4 {
+		t.Errorf("expected the fallback summary not to be cached, got %d calls and %d cached", summarizer.calls, len(cache))
+	}
+
+	// Without a cache every summary asks the LLM
+	g.config.LLMCache = nil
+	g.summarizeIssue(issue)
+	if summarizer.calls != 6 {
+		t.Errorf("expected no caching without a cache, got %d calls", summarizer.calls)
+	}
+}
+
+func TestDetailedReportSummarizesIssuesAhead(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 9, 0, 0, 0, time.UTC)
+	issue := func(key, summary, category string) jira.Issue {
+		return jira.Issue{Key: key, Fields: jira.Fields{
+			Summary: summary,
+			Status:  jira.Status{Name: key, Category: jira.StatusCategory{Key: category}},
+			Updated: jira.JiraTime{Time: targetDate},
+		}}
+	}
+	issues := []jira.Issue{
+		issue("OPS-1", "Upgrade ingress", "indeterminate"),
+		issue("OPS-2", "Rotate certificates", "done"),
+		issue("OPS-3", "Write runbook", "new"),
+	}
+
+	summarizer := &countingSummarizer{}
+	cache := mapSummaryCache{}
+	g := &Generator{config: &Config{Format: "markdown", LLMEnabled: true, LLMMode: "ollama", Detailed: true,
+		IncludeToday: true, IncludeInProgress: true, LLMCache: cache, Hide: HiddenSections{AISummary: true, ToDo: true}}, summarizer: summarizer}
+	content, err := g.Generate(issues, nil, targetDate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summarizer.batches != 1 || summarizer.calls != 2 || len(cache) != 2 {
+		t.Errorf("expected the 2 shown issues to be summarized in one batch and cached, got %d batches, %d calls and %d cached", summarizer.batches, summarizer.calls, len(cache))
+	}
+	if !strings.Contains(content, "Summary of OPS-1: Upgrade ingress") || !strings.Contains(content, "Summary of OPS-2: Rotate certificates") {
+		t.Errorf("expected the prefetched summaries in the report:\n%s", content)
+	}
+
+	// Regenerating the report reads them from the cache
+	if _, err := g.Generate(issues, nil, targetDate); err != nil {
+		t.Fatal(err)
+	}
+	if summarizer.batches != 1 || summarizer.calls != 2 {
+		t.Errorf("expected no new summaries, got %d batches and %d calls", summarizer.batches, summarizer.calls)
+	}
+}
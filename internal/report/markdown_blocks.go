@@ -0,0 +1,173 @@
+package report
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// markdownTableSeparatorPattern matches the |---|---| row under a table header
+	markdownTableSeparatorPattern = regexp.MustCompile(`^\|[\s:|-]+\|$`)
+
+	// markdownInlinePattern matches the inline markup used in reports: code spans, links,
+	// bold and italic text, in that order of precedence
+	markdownInlinePattern = regexp.MustCompile("`([^`]+)`" + `|\[([^\]]+)\]\(([^)\s]+)\)|\*\*([^*]+)\*\*|\*([^*\s][^*]*)\*`)
+)
+
+// markdownBlock is a block-level element of report markdown, used by export targets that
+// need structured content rather than markdown text
+type markdownBlock struct {
+	Kind     string           // heading, paragraph, list_item, table, code, rule or quote
+	Level    int              // Heading level
+	Ordered  bool             // Numbered list item
+	Text     string           // Inline markdown, or the code of a code block
+	Rows     [][]string       // Table cells; the first row is the header
+	Children []*markdownBlock // Nested list items
+}
+
+// markdownSpan is a run of inline text with uniform formatting
+type markdownSpan struct {
+	Text   string
+	Bold   bool
+	Italic bool
+	Code   bool
+	Link   string
+}
+
+// parseMarkdownBlocks parses the markdown the report generators produce: headings, nested
+// lists, tables, code fences, rules, quotes and paragraphs
+func parseMarkdownBlocks(markdown string) []*markdownBlock {
+	var blocks []*markdownBlock
+	var paragraph []string
+	var table *markdownBlock
+
+	// Open list items from the outermost in, with their indentation
+	type openItem struct {
+		indent int
+		block  *markdownBlock
+	}
+	var listStack []openItem
+
+	flush := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, &markdownBlock{Kind: "paragraph", Text: strings.Join(paragraph, " ")})
+			paragraph = nil
+		}
+		table = nil
+		listStack = nil
+	}
+
+	lines := strings.Split(markdown, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " \t")
+		trimmed := strings.TrimSpace(line)
+
+		// Fenced code block
+		if match := markdownFencePattern.FindStringSubmatch(line); match != nil {
+			flush()
+			var code []string
+			for i++; i < len(lines) && !closesFence(lines[i], match[1]); i++ {
+				code = append(code, lines[i])
+			}
+			blocks = append(blocks, &markdownBlock{Kind: "code", Text: strings.Join(code, "\n")})
+			continue
+		}
+
+		switch {
+		case trimmed == "":
+			flush()
+
+		case strings.HasPrefix(trimmed, "|") && strings.HasSuffix(trimmed, "|"):
+			if table == nil {
+				flush()
+				table = &markdownBlock{Kind: "table"}
+				blocks = append(blocks, table)
+			}
+			if markdownTableSeparatorPattern.MatchString(trimmed) && len(table.Rows) == 1 {
+				continue
+			}
+			var row []string
+			for _, value := range strings.Split(strings.Trim(trimmed, "|"), "|") {
+				row = append(row, strings.TrimSpace(value))
+			}
+			table.Rows = append(table.Rows, row)
+
+		case markdownHeadingPattern.MatchString(line):
+			flush()
+			level := len(markdownHeadingPattern.FindStringSubmatch(line)[1])
+			blocks = append(blocks, &markdownBlock{Kind: "heading", Level: level, Text: strings.TrimSpace(line[level:])})
+
+		case trimmed == "---" || trimmed == "***":
+			flush()
+			blocks = append(blocks, &markdownBlock{Kind: "rule"})
+
+		case markdownListPattern.MatchString(line):
+			if len(paragraph) > 0 || table != nil {
+				flush()
+			}
+			match := markdownListPattern.FindStringSubmatch(line)
+			indent := len(match[1])
+			item := &markdownBlock{
+				Kind:    "list_item",
+				Ordered: match[2][0] >= '0' && match[2][0] <= '9',
+				Text:    line[len(match[0]):],
+			}
+
+			for len(listStack) > 0 && listStack[len(listStack)-1].indent >= indent {
+				listStack = listStack[:len(listStack)-1]
+			}
+			if len(listStack) == 0 {
+				blocks = append(blocks, item)
+			} else {
+				parent := listStack[len(listStack)-1].block
+				parent.Children = append(parent.Children, item)
+			}
+			listStack = append(listStack, openItem{indent: indent, block: item})
+
+		case strings.HasPrefix(trimmed, ">"):
+			flush()
+			blocks = append(blocks, &markdownBlock{Kind: "quote", Text: strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))})
+
+		default:
+			if len(listStack) > 0 {
+				// Continuation text of the current list item
+				item := listStack[len(listStack)-1].block
+				item.Text += " " + trimmed
+				continue
+			}
+			if table != nil {
+				flush()
+			}
+			paragraph = append(paragraph, trimmed)
+		}
+	}
+	flush()
+
+	return blocks
+}
+
+// parseMarkdownInline splits inline markdown into formatted spans
+func parseMarkdownInline(text string) []markdownSpan {
+	var spans []markdownSpan
+	last := 0
+	for _, match := range markdownInlinePattern.FindAllStringSubmatchIndex(text, -1) {
+		if match[0] > last {
+			spans = append(spans, markdownSpan{Text: text[last:match[0]]})
+		}
+		switch {
+		case match[2] >= 0:
+			spans = append(spans, markdownSpan{Text: text[match[2]:match[3]], Code: true})
+		case match[4] >= 0:
+			spans = append(spans, markdownSpan{Text: text[match[4]:match[5]], Link: text[match[6]:match[7]]})
+		case match[8] >= 0:
+			spans = append(spans, markdownSpan{Text: text[match[8]:match[9]], Bold: true})
+		default:
+			spans = append(spans, markdownSpan{Text: text[match[10]:match[11]], Italic: true})
+		}
+		last = match[1]
+	}
+	if last < len(text) {
+		spans = append(spans, markdownSpan{Text: text[last:]})
+	}
+	return spans
+}
@@ -0,0 +1,60 @@
+package report
+
+import (
+	"math"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// ExportMetrics are numeric daily metrics written as Obsidian properties so Dataview
+// queries can chart them across the vault
+type ExportMetrics struct {
+	IssuesDone    int
+	Comments      int
+	HoursLogged   float64
+	MeetingsHours float64
+}
+
+// ComputeExportMetrics counts the issues completed, comments added and time logged on the target date.
+// MeetingsHours is left for the caller, which knows about the calendar.
+func ComputeExportMetrics(issuesWithComments []IssueWithComments, worklogs []jira.WorklogEntry, targetDate time.Time) ExportMetrics {
+	var metrics ExportMetrics
+
+	onTargetDate := func(t time.Time) bool {
+		y, m, d := t.In(targetDate.Location()).Date()
+		ty, tm, td := targetDate.Date()
+		return y == ty && m == tm && d == td
+	}
+
+	for _, iwc := range issuesWithComments {
+		if getStatusCategory(iwc.Issue) == 3 && onTargetDate(iwc.Issue.Fields.Updated.Time) {
+			metrics.IssuesDone++
+		}
+		for _, comment := range iwc.Comments {
+			if onTargetDate(comment.Created.Time) {
+				metrics.Comments++
+			}
+		}
+	}
+
+	seconds := 0
+	for _, worklog := range worklogs {
+		if onTargetDate(worklog.Started.Time) {
+			seconds += worklog.TimeSpentSeconds
+		}
+	}
+	metrics.HoursLogged = roundHours(time.Duration(seconds) * time.Second)
+
+	return metrics
+}
+
+// roundHours converts a duration to hours rounded to two decimals
+func roundHours(d time.Duration) float64 {
+	return math.Round(d.Hours()*100) / 100
+}
+
+// SetExportMetrics sets the metrics written to the frontmatter of exported notes
+func (g *Generator) SetExportMetrics(metrics ExportMetrics) {
+	g.exportMetrics = &metrics
+}
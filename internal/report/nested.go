@@ -0,0 +1,105 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+
+	"my-day/internal/jira"
+)
+
+// groupFields splits a --group-by value such as "squad,status" into the fields issues are
+// grouped by, outermost first
+func groupFields(groupBy string) []string {
+	var fields []string
+	for _, field := range strings.Split(groupBy, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 {
+		return []string{groupBy}
+	}
+	return fields
+}
+
+// formatNestedGroups renders issues grouped by the first of fields, each group grouped by the
+// next field in turn. Issues of the innermost groups are listed by status, with subtasks rolled
+// up when the outermost field rolls them up.
+func (g *Generator) formatNestedGroups(issues []jira.Issue, fields []string, rollupField string, commentsMap map[string][]jira.Comment, format string, depth int) string {
+	groups := g.groupIssuesByField(issues, fields[0])
+
+	var result strings.Builder
+	for _, name := range g.sortedGroupNames(groups, fields[0]) {
+		groupIssues := groups[name]
+		if format == FormatMarkdown {
+			result.WriteString(fmt.Sprintf("%s %s (%d issues)\n\n", strings.Repeat("#", depth+2), name, len(groupIssues)))
+		} else {
+			result.WriteString(fmt.Sprintf("%s▸ %s (%d issues)\n", strings.Repeat("  ", depth-1), name, len(groupIssues)))
+		}
+		result.WriteString(indentLines(g.formatGroupSummary(groupIssues, commentsMap, format), consoleIndent(format, depth-1)))
+
+		if len(fields) > 1 {
+			result.WriteString(g.formatNestedGroups(groupIssues, fields[1:], rollupField, commentsMap, format, depth+1))
+			continue
+		}
+
+		subtaskIndent := "    "
+		if format == FormatMarkdown {
+			subtaskIndent = "  "
+		}
+		statusGroups := groupIssuesByStatus(groupIssues)
+		statuses := []string{"In Progress", "Done"}
+		if !g.config.Hide.ToDo {
+			statuses = append(statuses, "To Do")
+		}
+		for _, status := range statuses {
+			result.WriteString(indentLines(g.formatRollup(statusGroups[status], groupIssues, rollupField, func(issue jira.Issue) string {
+				if format == FormatMarkdown {
+					return g.formatIssueMarkdownWithComments(issue, commentsMap[issue.Key])
+				}
+				return g.formatIssueConsoleWithComments(issue, commentsMap[issue.Key])
+			}, subtaskIndent), consoleIndent(format, depth-1)))
+		}
+		if format == FormatMarkdown {
+			result.WriteString("\n")
+		}
+	}
+	return result.String()
+}
+
+// consoleIndent returns the indentation of a nesting level in the console; markdown nests with
+// headings instead
+func consoleIndent(format string, depth int) string {
+	if format == FormatMarkdown {
+		return ""
+	}
+	return strings.Repeat("  ", depth)
+}
+
+// formatGroupSummary renders the AI mini-summary of the comments on the issues of a group, when
+// group summaries are turned on and the group has comments worth summarizing
+func (g *Generator) formatGroupSummary(issues []jira.Issue, commentsMap map[string][]jira.Comment, format string) string {
+	if !g.config.GroupSummaries || !g.config.LLMEnabled || g.config.Hide.AISummary {
+		return ""
+	}
+	var comments []jira.Comment
+	for _, issue := range issues {
+		comments = append(comments, commentsMap[issue.Key]...)
+	}
+	if !hasMeaningfulComments(comments) {
+		return ""
+	}
+
+	summary, err := g.summarizeComments(comments)
+	if err != nil {
+		g.warnLLM("Summarizing groups", err)
+		return ""
+	}
+	if summary == "" {
+		return ""
+	}
+	if format == FormatMarkdown {
+		return fmt.Sprintf("> 🤖 %s\n\n", indentContinuation(summary, "> "))
+	}
+	return fmt.Sprintf("🤖 %s\n", summary)
+}
@@ -0,0 +1,68 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+// groupSummarizer summarizes comments by counting them
+type groupSummarizer struct {
+	*llm.DisabledSummarizer
+}
+
+func (groupSummarizer) SummarizeComments(comments []jira.Comment) (string, error) {
+	return strings.Repeat("+", len(comments)), nil
+}
+
+func TestNestedGrouping(t *testing.T) {
+	issue := func(key, project, status, category string) jira.Issue {
+		issue := jira.Issue{Key: key}
+		issue.Fields.Summary = "Work on " + key
+		issue.Fields.Project = jira.Project{Key: project, Name: project}
+		issue.Fields.Status = jira.Status{Name: status, Category: jira.StatusCategory{Key: category}}
+		return issue
+	}
+	issues := []jira.Issue{
+		issue("OPS-1", "Ops", "In Review", "indeterminate"),
+		issue("OPS-2", "Ops", "Done", "done"),
+		issue("OPS-3", "Ops", "In Review", "indeterminate"),
+		issue("WEB-1", "Web", "Done", "done"),
+	}
+	comments := map[string][]jira.Comment{
+		"OPS-1": {{Body: jira.JiraDescription{Text: "Reviewed the ingress controller upgrade and the rollback plan"}, Created: jira.JiraTime{Time: time.Now()}}},
+		"OPS-3": {{Body: jira.JiraDescription{Text: "Paired on the certificate rotation runbook for staging"}, Created: jira.JiraTime{Time: time.Now()}}},
+	}
+	g := &Generator{config: &Config{LLMEnabled: true, GroupSummaries: true}, summarizer: groupSummarizer{}}
+	targetDate := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+
+	console, err := g.generateConsoleFieldGrouped(g.groupIssuesByField(issues, "project"), comments, nil, targetDate, "project, status")
+	if err != nil {
+		t.Fatalf("generateConsoleFieldGrouped() error = %v", err)
+	}
+	for _, want := range []string{
+		"📝 Issues grouped by Project → Status\n",
+		"🏷️  OPS (3 issues)\n------------------------------\n🤖 ++\n▸ Done (1 issues)\n",
+		"▸ In Review (2 issues)\n🤖 ++\n  📝 OPS-1 [Ops] Work on OPS-1\n",
+		"🏷️  WEB (1 issues)\n------------------------------\n▸ Done (1 issues)\n",
+	} {
+		if !strings.Contains(console, want) {
+			t.Errorf("expected %q in the console report, got:\n%s", want, console)
+		}
+	}
+
+	markdown, err := g.generateMarkdownFieldGrouped(g.groupIssuesByField(issues, "project"), comments, nil, targetDate, "project,status")
+	if err != nil {
+		t.Fatalf("generateMarkdownFieldGrouped() error = %v", err)
+	}
+	if !strings.Contains(markdown, "## 🏷️ Ops (3 issues)\n\n> 🤖 ++\n\n### Done (1 issues)\n\n- ") {
+		t.Errorf("expected nested headings in the markdown report, got:\n%s", markdown)
+	}
+
+	if fields := groupFields(" squad , status,"); len(fields) != 2 || fields[0] != "squad" || fields[1] != "status" {
+		t.Errorf("unexpected fields %q", fields)
+	}
+}
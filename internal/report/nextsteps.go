@@ -0,0 +1,63 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"my-day/internal/jira"
+)
+
+func init() {
+	RegisterSection(NewSection("🧭 Next Steps", PriorityNextSteps, func(model SectionModel, format string) string {
+		return model.generator.formatNextSteps(model.Issues, model.Comments, format)
+	}))
+}
+
+// formatNextSteps renders the next steps for tomorrow the LLM proposes from the in-progress
+// issues and today's comments on them, with report.next_steps
+func (g *Generator) formatNextSteps(issues []jira.Issue, comments map[string][]jira.Comment, format string) string {
+	if !g.config.NextSteps || !g.config.LLMEnabled {
+		return ""
+	}
+
+	var inProgress []jira.Issue
+	var inProgressComments []jira.Comment
+	for _, issue := range issues {
+		if isInProgress(issue) {
+			inProgress = append(inProgress, issue)
+			inProgressComments = append(inProgressComments, comments[issue.Key]...)
+		}
+	}
+	if len(inProgress) == 0 {
+		return ""
+	}
+
+	steps, err := g.summarizer.GenerateNextSteps(inProgress, inProgressComments)
+	if err != nil {
+		g.warnings.Add(SeverityInfo, "LLM", "Suggesting next steps failed, the report leaves them out: %v", err)
+		return ""
+	}
+	if len(steps) == 0 {
+		return ""
+	}
+
+	var result strings.Builder
+	if format == FormatHTML {
+		result.WriteString("<ol>\n")
+	}
+	for i, step := range steps {
+		switch format {
+		case FormatHTML:
+			result.WriteString(fmt.Sprintf("<li>%s</li>\n", html.EscapeString(step)))
+		case FormatMarkdown:
+			result.WriteString(fmt.Sprintf("%d. %s\n", i+1, step))
+		default:
+			result.WriteString(fmt.Sprintf("  %d. %s\n", i+1, step))
+		}
+	}
+	if format == FormatHTML {
+		result.WriteString("</ol>\n")
+	}
+	return result.String()
+}
@@ -0,0 +1,61 @@
+package report
+
+import (
+	"errors"
+	"testing"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+// plannerSummarizer is an LLM that proposes fixed next steps, or fails to when err is set
+type plannerSummarizer struct {
+	*llm.DisabledSummarizer
+	err      error
+	received []jira.Issue
+}
+
+func (s *plannerSummarizer) GenerateNextSteps(issues []jira.Issue, comments []jira.Comment) ([]string, error) {
+	s.received = issues
+	if s.err != nil {
+		return nil, s.err
+	}
+	return []string{"Roll out the <ingress> upgrade (OPS-1)", "Rerun the migration test (OPS-1)"}, nil
+}
+
+func TestFormatNextSteps(t *testing.T) {
+	issues := []jira.Issue{
+		{Key: "OPS-1", Fields: jira.Fields{Status: jira.Status{Category: jira.StatusCategory{Key: "indeterminate"}}}},
+		{Key: "OPS-2", Fields: jira.Fields{Status: jira.Status{Category: jira.StatusCategory{Key: "done"}}}},
+	}
+	summarizer := &plannerSummarizer{}
+	g := &Generator{config: &Config{LLMEnabled: true, NextSteps: true}, summarizer: summarizer}
+
+	tests := map[string]string{
+		FormatConsole:  "  1. Roll out the <ingress> upgrade (OPS-1)\n  2. Rerun the migration test (OPS-1)\n",
+		FormatMarkdown: "1. Roll out the <ingress> upgrade (OPS-1)\n2. Rerun the migration test (OPS-1)\n",
+		FormatHTML:     "<ol>\n<li>Roll out the &lt;ingress&gt; upgrade (OPS-1)</li>\n<li>Rerun the migration test (OPS-1)</li>\n</ol>\n",
+	}
+	for format, want := range tests {
+		if got := g.formatNextSteps(issues, nil, format); got != want {
+			t.Errorf("%s: unexpected next steps %q, expected %q", format, got, want)
+		}
+	}
+	if len(summarizer.received) != 1 || summarizer.received[0].Key != "OPS-1" {
+		t.Errorf("expected only the in-progress issue to be planned, got %v", summarizer.received)
+	}
+
+	g.config.NextSteps = false
+	if got := g.formatNextSteps(issues, nil, FormatMarkdown); got != "" {
+		t.Errorf("expected no next steps unless report.next_steps is set, got %q", got)
+	}
+
+	g.config.NextSteps = true
+	summarizer.err = errors.New("model not found")
+	if got := g.formatNextSteps(issues, nil, FormatMarkdown); got != "" {
+		t.Errorf("expected no next steps when the LLM fails, got %q", got)
+	}
+	if warnings := g.Warnings(); len(warnings) != 1 || warnings[0].Severity != SeverityInfo {
+		t.Errorf("expected an info note about the failure, got %+v", warnings)
+	}
+}
@@ -0,0 +1,350 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	notionAPIURL  = "https://api.notion.com/v1"
+	notionVersion = "2022-06-28"
+
+	// Notion rejects rich text longer than 2000 characters and more than 100 blocks per request
+	notionMaxTextLength = 2000
+	notionMaxBlocks     = 100
+)
+
+// NotionTarget describes the Notion database the Notion export writes report pages to.
+// The database needs a Name (title), Date (date), Issues (number) and Tags (multi-select) property.
+type NotionTarget struct {
+	BaseURL    string // Notion API URL (defaults to https://api.notion.com/v1)
+	Token      string `json:"-"` // Internal integration secret
+	DatabaseID string
+}
+
+// notionBlock is a Notion block object. Only the field matching Type is set.
+type notionBlock struct {
+	Object           string          `json:"object"`
+	Type             string          `json:"type"`
+	Heading1         *notionText     `json:"heading_1,omitempty"`
+	Heading2         *notionText     `json:"heading_2,omitempty"`
+	Heading3         *notionText     `json:"heading_3,omitempty"`
+	Paragraph        *notionText     `json:"paragraph,omitempty"`
+	BulletedListItem *notionText     `json:"bulleted_list_item,omitempty"`
+	NumberedListItem *notionText     `json:"numbered_list_item,omitempty"`
+	Quote            *notionText     `json:"quote,omitempty"`
+	Code             *notionText     `json:"code,omitempty"`
+	Divider          *struct{}       `json:"divider,omitempty"`
+	Table            *notionTable    `json:"table,omitempty"`
+	TableRow         *notionTableRow `json:"table_row,omitempty"`
+}
+
+type notionText struct {
+	RichText []notionRichText `json:"rich_text"`
+	Language string           `json:"language,omitempty"` // Code blocks only
+	Children []notionBlock    `json:"children,omitempty"` // Nested list items
+}
+
+type notionTable struct {
+	TableWidth      int           `json:"table_width"`
+	HasColumnHeader bool          `json:"has_column_header"`
+	Children        []notionBlock `json:"children"`
+}
+
+type notionTableRow struct {
+	Cells [][]notionRichText `json:"cells"`
+}
+
+type notionRichText struct {
+	Type        string            `json:"type"`
+	Text        notionTextContent `json:"text"`
+	Annotations *notionAnnotation `json:"annotations,omitempty"`
+}
+
+type notionTextContent struct {
+	Content string      `json:"content"`
+	Link    *notionLink `json:"link,omitempty"`
+}
+
+type notionLink struct {
+	URL string `json:"url"`
+}
+
+type notionAnnotation struct {
+	Bold   bool `json:"bold,omitempty"`
+	Italic bool `json:"italic,omitempty"`
+	Code   bool `json:"code,omitempty"`
+}
+
+// notionPage is the subset of a Notion page object used by the export
+type notionPage struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
+
+// ExportToNotion writes the report as a page in the configured Notion database, with the
+// date, issue count and export tags as page properties. Notion pages can't be rewritten in
+// place, so a page already exported for the same day is archived and replaced.
+// It returns the URL of the new page.
+func (g *Generator) ExportToNotion(ctx context.Context, reportContent string, issueCount int, targetDate time.Time) (string, error) {
+	if !g.config.ExportEnabled {
+		return "", nil
+	}
+
+	target := g.config.Notion
+	if target == nil || target.DatabaseID == "" {
+		return "", fmt.Errorf("Notion export requires report.export.notion.database_id")
+	}
+	if target.Token == "" {
+		return "", fmt.Errorf("Notion export requires an integration token in report.export.notion.token")
+	}
+	if g.config.Format == "html" {
+		return "", fmt.Errorf("Notion export requires console or markdown format, not html")
+	}
+
+	var blocks []notionBlock
+	if g.config.Format == "markdown" {
+		repaired, problems := RepairMarkdown(reportContent)
+		if len(problems) > 0 {
+			slog.Warn("Repaired markdown problems before export", "problems", len(problems))
+		}
+		blocks = markdownToNotion(repaired)
+	} else {
+		blocks = []notionBlock{{Object: "block", Type: "code", Code: &notionText{RichText: notionPlainText(reportContent), Language: "plain text"}}}
+	}
+
+	baseURL := target.BaseURL
+	if baseURL == "" {
+		baseURL = notionAPIURL
+	}
+	client := &notionClient{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      target.Token,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+
+	date := targetDate.Format("2006-01-02")
+	existing, err := client.findPages(ctx, target.DatabaseID, date)
+	if err != nil {
+		return "", err
+	}
+	for _, page := range existing {
+		if err := client.do(ctx, "PATCH", "/pages/"+page.ID, map[string]interface{}{"archived": true}, nil); err != nil {
+			return "", fmt.Errorf("failed to archive previous Notion page: %w", err)
+		}
+	}
+
+	var tags []map[string]string
+	for _, tag := range append(append([]string{}, g.config.ExportTags...), date) {
+		// Notion doesn't allow commas in multi-select options
+		tags = append(tags, map[string]string{"name": strings.ReplaceAll(tag, ",", " ")})
+	}
+
+	first := blocks
+	if len(first) > notionMaxBlocks {
+		first = first[:notionMaxBlocks]
+	}
+	request := map[string]interface{}{
+		"parent": map[string]string{"database_id": target.DatabaseID},
+		"properties": map[string]interface{}{
+			"Name":   map[string]interface{}{"title": notionPlainText(g.NotionPageTitle(targetDate))},
+			"Date":   map[string]interface{}{"date": map[string]string{"start": date}},
+			"Issues": map[string]interface{}{"number": issueCount},
+			"Tags":   map[string]interface{}{"multi_select": tags},
+		},
+		"children": first,
+	}
+
+	var page notionPage
+	if err := client.do(ctx, "POST", "/pages", request, &page); err != nil {
+		return "", fmt.Errorf("failed to create Notion page: %w", err)
+	}
+
+	// Append the blocks that didn't fit in the create request
+	for start := notionMaxBlocks; start < len(blocks); start += notionMaxBlocks {
+		end := start + notionMaxBlocks
+		if end > len(blocks) {
+			end = len(blocks)
+		}
+		payload := map[string]interface{}{"children": blocks[start:end]}
+		if err := client.do(ctx, "PATCH", "/blocks/"+page.ID+"/children", payload, nil); err != nil {
+			return "", fmt.Errorf("failed to append Notion page content: %w", err)
+		}
+	}
+
+	return page.URL, nil
+}
+
+// notionClient is a minimal Notion API client authenticated with an integration token
+type notionClient struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// findPages returns the pages of the database whose Date property is the given day
+func (c *notionClient) findPages(ctx context.Context, databaseID, date string) ([]notionPage, error) {
+	query := map[string]interface{}{
+		"filter": map[string]interface{}{
+			"property": "Date",
+			"date":     map[string]string{"equals": date},
+		},
+	}
+
+	var result struct {
+		Results []notionPage `json:"results"`
+	}
+	if err := c.do(ctx, "POST", "/databases/"+databaseID+"/query", query, &result); err != nil {
+		return nil, fmt.Errorf("failed to query Notion database: %w", err)
+	}
+	return result.Results, nil
+}
+
+func (c *notionClient) do(ctx context.Context, method, endpoint string, payload, result interface{}) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(payload); err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+endpoint, &body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Notion-Version", notionVersion)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp struct {
+			Message string `json:"message"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Message != "" {
+			return fmt.Errorf("Notion API error: %s", errResp.Message)
+		}
+		return fmt.Errorf("Notion API error: status %d", resp.StatusCode)
+	}
+
+	if result == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// markdownToNotion converts report markdown to Notion blocks
+func markdownToNotion(markdown string) []notionBlock {
+	return notionBlocks(parseMarkdownBlocks(markdown))
+}
+
+func notionBlocks(blocks []*markdownBlock) []notionBlock {
+	var result []notionBlock
+	for _, block := range blocks {
+		notion := notionBlock{Object: "block"}
+		switch block.Kind {
+		case "heading":
+			// Notion only has three heading levels
+			text := &notionText{RichText: notionRichTextFor(block.Text)}
+			switch block.Level {
+			case 1:
+				notion.Type, notion.Heading1 = "heading_1", text
+			case 2:
+				notion.Type, notion.Heading2 = "heading_2", text
+			default:
+				notion.Type, notion.Heading3 = "heading_3", text
+			}
+		case "paragraph":
+			notion.Type, notion.Paragraph = "paragraph", &notionText{RichText: notionRichTextFor(block.Text)}
+		case "quote":
+			notion.Type, notion.Quote = "quote", &notionText{RichText: notionRichTextFor(block.Text)}
+		case "rule":
+			notion.Type, notion.Divider = "divider", &struct{}{}
+		case "code":
+			notion.Type, notion.Code = "code", &notionText{RichText: notionPlainText(block.Text), Language: "plain text"}
+		case "table":
+			table := &notionTable{HasColumnHeader: true}
+			for _, row := range block.Rows {
+				if len(row) > table.TableWidth {
+					table.TableWidth = len(row)
+				}
+			}
+			for _, row := range block.Rows {
+				cells := make([][]notionRichText, table.TableWidth)
+				for i := range cells {
+					cells[i] = []notionRichText{}
+					if i < len(row) {
+						cells[i] = notionRichTextFor(row[i])
+					}
+				}
+				table.Children = append(table.Children, notionBlock{Object: "block", Type: "table_row", TableRow: &notionTableRow{Cells: cells}})
+			}
+			notion.Type, notion.Table = "table", table
+		case "list_item":
+			text := &notionText{RichText: notionRichTextFor(block.Text), Children: notionBlocks(block.Children)}
+			if block.Ordered {
+				notion.Type, notion.NumberedListItem = "numbered_list_item", text
+			} else {
+				notion.Type, notion.BulletedListItem = "bulleted_list_item", text
+			}
+		default:
+			continue
+		}
+		result = append(result, notion)
+	}
+	return result
+}
+
+// notionRichTextFor converts inline markdown to Notion rich text
+func notionRichTextFor(text string) []notionRichText {
+	richText := []notionRichText{}
+	for _, span := range parseMarkdownInline(text) {
+		var annotations *notionAnnotation
+		if span.Bold || span.Italic || span.Code {
+			annotations = &notionAnnotation{Bold: span.Bold, Italic: span.Italic, Code: span.Code}
+		}
+		for _, chunk := range splitNotionText(span.Text) {
+			item := notionRichText{Type: "text", Text: notionTextContent{Content: chunk}, Annotations: annotations}
+			if span.Link != "" {
+				item.Text.Link = &notionLink{URL: span.Link}
+			}
+			richText = append(richText, item)
+		}
+	}
+	return richText
+}
+
+// notionPlainText returns unformatted rich text
+func notionPlainText(text string) []notionRichText {
+	richText := []notionRichText{}
+	for _, chunk := range splitNotionText(text) {
+		richText = append(richText, notionRichText{Type: "text", Text: notionTextContent{Content: chunk}})
+	}
+	return richText
+}
+
+// splitNotionText splits text into chunks within Notion's rich text length limit
+func splitNotionText(text string) []string {
+	runes := []rune(text)
+	var chunks []string
+	for len(runes) > notionMaxTextLength {
+		chunks = append(chunks, string(runes[:notionMaxTextLength]))
+		runes = runes[notionMaxTextLength:]
+	}
+	if len(runes) > 0 {
+		chunks = append(chunks, string(runes))
+	}
+	return chunks
+}
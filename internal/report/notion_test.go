@@ -0,0 +1,140 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarkdownToNotion(t *testing.T) {
+	markdown := strings.Join([]string{
+		"# Daily Standup Report",
+		"",
+		"- 🔄 **[OPS-101]** Migrate runners",
+		"  - Status: `In Progress`",
+		"1. See [PR](https://github.com/acme/infra/pull/42)",
+		"",
+		"| Issue | Spent |",
+		"|-------|-------|",
+		"| OPS-101 | 4h |",
+		"",
+		"---",
+	}, "\n")
+
+	blocks := markdownToNotion(markdown)
+
+	var types []string
+	for _, block := range blocks {
+		types = append(types, block.Type)
+	}
+	expected := "heading_1 bulleted_list_item numbered_list_item table divider"
+	if got := strings.Join(types, " "); got != expected {
+		t.Fatalf("expected blocks %q, got %q", expected, got)
+	}
+
+	item := blocks[1].BulletedListItem
+	if len(item.RichText) != 3 || !item.RichText[1].Annotations.Bold || item.RichText[1].Text.Content != "[OPS-101]" {
+		t.Errorf("unexpected list item rich text: %+v", item.RichText)
+	}
+	if len(item.Children) != 1 || item.Children[0].BulletedListItem.RichText[1].Annotations == nil || !item.Children[0].BulletedListItem.RichText[1].Annotations.Code {
+		t.Errorf("expected nested item with a code span, got %+v", item.Children)
+	}
+
+	link := blocks[2].NumberedListItem.RichText[1]
+	if link.Text.Link == nil || link.Text.Link.URL != "https://github.com/acme/infra/pull/42" {
+		t.Errorf("expected link rich text, got %+v", link)
+	}
+
+	table := blocks[3].Table
+	if table.TableWidth != 2 || !table.HasColumnHeader || len(table.Children) != 2 {
+		t.Errorf("unexpected table: %+v", table)
+	}
+}
+
+func TestSplitNotionText(t *testing.T) {
+	chunks := splitNotionText(strings.Repeat("é", notionMaxTextLength+1))
+	if len(chunks) != 2 || len([]rune(chunks[0])) != notionMaxTextLength || chunks[1] != "é" {
+		t.Errorf("unexpected chunks: %d", len(chunks))
+	}
+}
+
+func TestExportToNotion(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC)
+
+	var requests []string
+	var created map[string]interface{}
+	var appended int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("Notion-Version") != notionVersion {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message": "API token is invalid."}`))
+			return
+		}
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.URL.Path == "/databases/db1/query":
+			w.Write([]byte(`{"results": [{"id": "old"}]}`))
+		case r.URL.Path == "/pages" && r.Method == "POST":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Fatalf("failed to decode page: %v", err)
+			}
+			w.Write([]byte(`{"id": "new", "url": "https://www.notion.so/new"}`))
+		case r.URL.Path == "/blocks/new/children":
+			var payload struct {
+				Children []notionBlock `json:"children"`
+			}
+			json.NewDecoder(r.Body).Decode(&payload)
+			appended += len(payload.Children)
+			w.Write([]byte(`{}`))
+		default:
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	config := goldenConfig("markdown")
+	config.ExportEnabled = true
+	config.ExportTags = []string{"standup"}
+	config.Notion = &NotionTarget{BaseURL: server.URL, Token: "secret", DatabaseID: "db1"}
+
+	// More paragraphs than fit in a single request
+	var report strings.Builder
+	for i := 0; i < notionMaxBlocks+20; i++ {
+		report.WriteString(fmt.Sprintf("Paragraph %d\n\n", i))
+	}
+
+	pageURL, err := NewGenerator(config).ExportToNotion(context.Background(), report.String(), 3, targetDate)
+	if err != nil {
+		t.Fatalf("ExportToNotion() error = %v", err)
+	}
+	if pageURL != "https://www.notion.so/new" {
+		t.Errorf("unexpected page URL %s", pageURL)
+	}
+
+	expected := "POST /databases/db1/query, PATCH /pages/old, POST /pages, PATCH /blocks/new/children"
+	if got := strings.Join(requests, ", "); got != expected {
+		t.Errorf("expected requests %s, got %s", expected, got)
+	}
+	if appended != 20 {
+		t.Errorf("expected 20 appended blocks, got %d", appended)
+	}
+
+	properties, _ := json.Marshal(created["properties"])
+	for _, want := range []string{`"number":3`, `"start":"2024-07-15"`, `{"name":"standup"}`, `{"name":"2024-07-15"}`} {
+		if !strings.Contains(string(properties), want) {
+			t.Errorf("expected properties to contain %s, got %s", want, properties)
+		}
+	}
+
+	config.Notion.Token = "wrong"
+	if _, err := NewGenerator(config).ExportToNotion(context.Background(), "# Report\n", 0, targetDate); err == nil || !strings.Contains(err.Error(), "API token is invalid") {
+		t.Errorf("expected Notion API error, got %v", err)
+	}
+}
@@ -0,0 +1,35 @@
+package report
+
+import (
+	"fmt"
+
+	"my-day/internal/llm"
+)
+
+// qualityNote returns the pattern confidence and quality score of an AI-generated block, built
+// from issueCount issues and commentCount comments, annotated for format. It returns "" unless
+// --show-quality is set.
+func (g *Generator) qualityNote(summary string, issueCount int, commentCount int, format string) string {
+	if !g.config.ShowQuality || g.config.Hide.Quality {
+		return ""
+	}
+	score, _ := llm.SummaryQuality(summary, issueCount, commentCount)
+	return annotate(fmt.Sprintf("confidence %.0f%% · quality %.0f/100", llm.SummaryConfidence(summary)*100, score), format)
+}
+
+// confidenceNote returns the pattern confidence of a single AI-generated bullet, annotated for
+// format, or "" unless --show-quality is set
+func (g *Generator) confidenceNote(text string, format string) string {
+	if !g.config.ShowQuality || g.config.Hide.Quality {
+		return ""
+	}
+	return annotate(fmt.Sprintf("confidence %.0f%%", llm.SummaryConfidence(text)*100), format)
+}
+
+// annotate formats a note to follow a block of the report
+func annotate(note string, format string) string {
+	if format == FormatMarkdown {
+		return " *(" + note + ")*"
+	}
+	return " [" + note + "]"
+}
@@ -0,0 +1,35 @@
+package report
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestQualityNote(t *testing.T) {
+	summary := "Fixed the Terraform config for the staging cluster and deployed the Helm chart after review."
+
+	g := &Generator{config: &Config{}}
+	if note := g.qualityNote(summary, 1, 2, FormatConsole); note != "" {
+		t.Errorf("expected no note without --show-quality, got %q", note)
+	}
+
+	g.config.ShowQuality = true
+	note := g.qualityNote(summary, 1, 2, FormatConsole)
+	if !strings.HasPrefix(note, " [confidence ") || !strings.HasSuffix(note, "· quality 100/100]") {
+		t.Errorf("unexpected console note %q", note)
+	}
+	if strings.HasPrefix(note, " [confidence 0%") {
+		t.Errorf("expected the pattern matcher to recognize Terraform, got %q", note)
+	}
+	if note := g.qualityNote("Worked on 2 issues with 3 comments", 1, 0, FormatMarkdown); !strings.HasSuffix(note, "· quality 0/100)*") {
+		t.Errorf("unexpected markdown note %q", note)
+	}
+	if note := g.confidenceNote("Terraform", FormatMarkdown); !strings.HasPrefix(note, " *(confidence ") {
+		t.Errorf("unexpected bullet note %q", note)
+	}
+
+	g.config.Hide.Quality = true
+	if note := g.qualityNote(summary, 1, 2, FormatConsole); note != "" {
+		t.Errorf("expected --no-quality to drop the note, got %q", note)
+	}
+}
@@ -0,0 +1,160 @@
+package report
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"my-day/internal/jira"
+)
+
+// releaseNoteSections groups issue types into release notes sections, in the order they are listed.
+// Issues of other types, such as tasks, are listed under "Other Changes".
+var releaseNoteSections = []struct {
+	heading string
+	types   []string
+}{
+	{"✨ New Features", []string{"story", "feature", "new feature", "epic"}},
+	{"🚀 Improvements", []string{"improvement", "enhancement"}},
+	{"🐛 Bug Fixes", []string{"bug", "defect"}},
+	{"🔧 Other Changes", nil},
+}
+
+// releaseSection is a release notes section with its issues
+type releaseSection struct {
+	heading string
+	issues  []jira.Issue
+}
+
+// ReleaseIssues returns the completed issues fixed in the given version, ordered by key
+func ReleaseIssues(issues []jira.Issue, version string) []jira.Issue {
+	var release []jira.Issue
+	seen := make(map[string]bool)
+	for _, issue := range issues {
+		if seen[issue.Key] || !strings.EqualFold(issue.Fields.Status.Category.Key, "done") {
+			continue
+		}
+		for _, fixVersion := range issue.Fields.FixVersions {
+			if strings.EqualFold(fixVersion.Name, version) {
+				release = append(release, issue)
+				seen[issue.Key] = true
+				break
+			}
+		}
+	}
+
+	sort.Slice(release, func(i, j int) bool {
+		return release[i].Key < release[j].Key
+	})
+	return release
+}
+
+// groupReleaseIssues splits issues into the release notes sections, leaving out empty ones
+func groupReleaseIssues(issues []jira.Issue) []releaseSection {
+	sections := make([]releaseSection, len(releaseNoteSections))
+	for i, section := range releaseNoteSections {
+		sections[i].heading = section.heading
+	}
+
+	for _, issue := range issues {
+		issueType := strings.ToLower(issue.Fields.IssueType.Name)
+		index := len(releaseNoteSections) - 1
+		for i, section := range releaseNoteSections {
+			if slices.Contains(section.types, issueType) {
+				index = i
+				break
+			}
+		}
+		sections[index].issues = append(sections[index].issues, issue)
+	}
+
+	var nonEmpty []releaseSection
+	for _, section := range sections {
+		if len(section.issues) > 0 {
+			nonEmpty = append(nonEmpty, section)
+		}
+	}
+	return nonEmpty
+}
+
+// GenerateReleaseNotes drafts release notes for the completed issues of a version: an AI draft
+// written for users when the LLM is enabled, followed by the issues grouped by type
+func (g *Generator) GenerateReleaseNotes(version string, issues []jira.Issue) (string, error) {
+	var draft string
+	if g.config.LLMEnabled && len(issues) > 0 {
+		if notes, err := g.summarizer.GenerateReleaseNotes(version, issues); err == nil {
+			draft = strings.TrimSpace(notes)
+		}
+	}
+
+	sections := groupReleaseIssues(issues)
+
+	switch g.config.Format {
+	case "markdown":
+		return g.generateReleaseNotesMarkdown(version, issues, sections, draft), nil
+	case "html":
+		return "", fmt.Errorf("release notes support console and markdown formats, not html")
+	default:
+		return g.generateReleaseNotesConsole(version, issues, sections, draft), nil
+	}
+}
+
+func (g *Generator) generateReleaseNotesConsole(version string, issues []jira.Issue, sections []releaseSection, draft string) string {
+	var report strings.Builder
+
+	report.WriteString(fmt.Sprintf("📦 Release Notes - %s\n", version))
+	report.WriteString(strings.Repeat("=", 50) + "\n\n")
+
+	if len(issues) == 0 {
+		report.WriteString("No completed issues found for this version.\n")
+		return report.String()
+	}
+
+	if draft != "" {
+		report.WriteString("🤖 AI Draft:\n")
+		for _, line := range strings.Split(draft, "\n") {
+			report.WriteString(fmt.Sprintf("  %s\n", line))
+		}
+		report.WriteString("\n")
+	}
+
+	report.WriteString(fmt.Sprintf("📋 Completed Issues (%d):\n\n", len(issues)))
+	for _, section := range sections {
+		report.WriteString(fmt.Sprintf("%s:\n", section.heading))
+		for _, issue := range section.issues {
+			report.WriteString(fmt.Sprintf("  • %s %s\n", issue.Key, issue.Fields.Summary))
+		}
+		report.WriteString("\n")
+	}
+
+	return report.String()
+}
+
+func (g *Generator) generateReleaseNotesMarkdown(version string, issues []jira.Issue, sections []releaseSection, draft string) string {
+	var report strings.Builder
+
+	report.WriteString(fmt.Sprintf("# Release Notes - %s\n\n", version))
+
+	if len(issues) == 0 {
+		report.WriteString("_No completed issues found for this version._\n")
+		return report.String()
+	}
+
+	if draft != "" {
+		report.WriteString("## 🤖 AI Draft\n\n")
+		report.WriteString(fmt.Sprintf("%s\n\n", draft))
+	}
+
+	report.WriteString(fmt.Sprintf("## Completed Issues (%d)\n\n", len(issues)))
+	for _, section := range sections {
+		report.WriteString(fmt.Sprintf("### %s\n\n", section.heading))
+		for _, issue := range section.issues {
+			report.WriteString(fmt.Sprintf("- **[%s]** %s\n", issue.Key, issue.Fields.Summary))
+		}
+		report.WriteString("\n")
+	}
+
+	report.WriteString("---\n*Generated by my-day CLI*\n")
+	return report.String()
+}
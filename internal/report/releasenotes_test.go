@@ -0,0 +1,85 @@
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"my-day/internal/jira"
+)
+
+func releaseIssue(key, issueType, category string, versions ...string) jira.Issue {
+	issue := jira.Issue{Key: key, Fields: jira.Fields{
+		Summary:   key + " summary",
+		IssueType: jira.IssueType{Name: issueType},
+		Status:    jira.Status{Name: category, Category: jira.StatusCategory{Key: category}},
+	}}
+	for _, version := range versions {
+		issue.Fields.FixVersions = append(issue.Fields.FixVersions, jira.Version{Name: version})
+	}
+	return issue
+}
+
+func TestReleaseIssues(t *testing.T) {
+	issues := []jira.Issue{
+		releaseIssue("OPS-3", "Bug", "done", "2.14"),
+		releaseIssue("OPS-1", "Story", "done", "2.13", "2.14"),
+		releaseIssue("OPS-2", "Story", "indeterminate", "2.14"),
+		releaseIssue("OPS-4", "Task", "done", "2.15"),
+		releaseIssue("OPS-3", "Bug", "done", "2.14"),
+	}
+
+	var keys []string
+	for _, issue := range ReleaseIssues(issues, "2.14") {
+		keys = append(keys, issue.Key)
+	}
+	if strings.Join(keys, ",") != "OPS-1,OPS-3" {
+		t.Errorf("expected the completed 2.14 issues once each, got %v", keys)
+	}
+}
+
+func TestGenerateReleaseNotesGroupsByType(t *testing.T) {
+	issues := []jira.Issue{
+		releaseIssue("OPS-1", "Story", "done", "2.14"),
+		releaseIssue("OPS-2", "Bug", "done", "2.14"),
+		releaseIssue("OPS-3", "Task", "done", "2.14"),
+		releaseIssue("OPS-4", "Improvement", "done", "2.14"),
+	}
+
+	output, err := NewGenerator(goldenConfig("markdown")).GenerateReleaseNotes("2.14", issues)
+	if err != nil {
+		t.Fatalf("GenerateReleaseNotes() error = %v", err)
+	}
+
+	want := []string{
+		"# Release Notes - 2.14",
+		"## Completed Issues (4)",
+		"### ✨ New Features\n\n- **[OPS-1]** OPS-1 summary",
+		"### 🚀 Improvements\n\n- **[OPS-4]** OPS-4 summary",
+		"### 🐛 Bug Fixes\n\n- **[OPS-2]** OPS-2 summary",
+		"### 🔧 Other Changes\n\n- **[OPS-3]** OPS-3 summary",
+	}
+	last := -1
+	for _, fragment := range want {
+		index := strings.Index(output, fragment)
+		if index < 0 {
+			t.Fatalf("expected %q in output:\n%s", fragment, output)
+		}
+		if index < last {
+			t.Errorf("expected %q after the previous section", fragment)
+		}
+		last = index
+	}
+	if strings.Contains(output, "AI Draft") {
+		t.Error("expected no AI draft with the LLM disabled")
+	}
+}
+
+func TestGenerateReleaseNotesWithoutIssues(t *testing.T) {
+	output, err := NewGenerator(goldenConfig("console")).GenerateReleaseNotes("2.14", nil)
+	if err != nil {
+		t.Fatalf("GenerateReleaseNotes() error = %v", err)
+	}
+	if !strings.Contains(output, "No completed issues found for this version") {
+		t.Errorf("expected an empty release message, got:\n%s", output)
+	}
+}
@@ -0,0 +1,237 @@
+package report
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"my-day/internal/llm"
+)
+
+// retroThemeNames names the comment work types blockers and concerns are clustered by
+var retroThemeNames = map[string]string{
+	"database":    "Database",
+	"deployment":  "Deployments and releases",
+	"testing":     "Testing",
+	"code_review": "Code review",
+	"bug_fix":     "Bugs and errors",
+	"security":    "Security and access",
+	"general":     "General",
+}
+
+// blockedStatusTheme clusters issues sitting in a blocked or on-hold status
+const blockedStatusTheme = "Blocked in Jira"
+
+// winImportance is the importance from which a positive comment counts as a notable win
+const winImportance = 70
+
+// Retro gathers retrospective input for a sprint: recurring blockers, clusters of
+// negative comments and notable wins
+type Retro struct {
+	Name         string // Sprint name, or empty for a plain date range
+	Start        time.Time
+	End          time.Time
+	Blockers     []RetroTheme // Most mentioned first
+	Concerns     []RetroTheme // Negative comments by theme, most mentioned first
+	Wins         []DigestItem
+	IssueCount   int // Issues with activity in the sprint
+	CommentCount int
+}
+
+// RetroTheme is a cluster of comments or issues about the same kind of work
+type RetroTheme struct {
+	Name     string
+	Mentions int
+	Issues   []string // Issue keys, in order of first mention
+	Examples []string // Comment excerpts prefixed with their issue key
+}
+
+// Recurring reports whether the theme came up more than once
+func (t RetroTheme) Recurring() bool {
+	return t.Mentions > 1
+}
+
+// retroExamples is how many comment excerpts are kept per theme
+const retroExamples = 3
+
+// BuildRetro builds the retrospective input from the issues and comments of the sprint
+// running from start until end
+func BuildRetro(name string, issuesWithComments []IssueWithComments, start, end time.Time) *Retro {
+	retro := &Retro{Name: name, Start: start, End: end}
+	processor := llm.NewEnhancedDataProcessor(false)
+
+	inSprint := func(t time.Time) bool {
+		return !t.Before(start) && t.Before(end)
+	}
+
+	blockers := map[string]*RetroTheme{}
+	concerns := map[string]*RetroTheme{}
+	won := map[string]bool{}
+
+	for _, iwc := range issuesWithComments {
+		issue := iwc.Issue
+		active := false
+
+		for _, comment := range iwc.Comments {
+			if !inSprint(comment.Created.Time) {
+				continue
+			}
+			active = true
+			retro.CommentCount++
+
+			analysis, err := processor.AnalyzeComment(comment)
+			if err != nil {
+				continue
+			}
+			theme := retroThemeNames[analysis.WorkType]
+			if theme == "" {
+				theme = retroThemeNames["general"]
+			}
+			excerpt := fmt.Sprintf("%s: %s", issue.Key, retroExcerpt(comment.Body.Text))
+
+			if analysis.ActivityType == "blocker" {
+				addRetroMention(blockers, theme, issue.Key, excerpt)
+			}
+			if analysis.Sentiment == "negative" {
+				addRetroMention(concerns, theme, issue.Key, excerpt)
+			}
+			if analysis.Sentiment == "positive" && analysis.Importance >= winImportance && getStatusCategory(issue) != 3 && !won[issue.Key] {
+				retro.Wins = append(retro.Wins, DigestItem{Issue: issue, Note: retroExcerpt(comment.Body.Text)})
+				won[issue.Key] = true
+			}
+		}
+
+		updated := issue.Fields.Updated.Time
+		if inSprint(updated) {
+			active = true
+			if getStatusCategory(issue) == 3 && !won[issue.Key] {
+				retro.Wins = append(retro.Wins, DigestItem{Issue: issue, Note: "completed"})
+				won[issue.Key] = true
+			}
+		}
+
+		status := strings.ToLower(issue.Fields.Status.Name)
+		if active && getStatusCategory(issue) != 3 && (strings.Contains(status, "block") || strings.Contains(status, "hold")) {
+			addRetroMention(blockers, blockedStatusTheme, issue.Key, fmt.Sprintf("%s: %s (%s)", issue.Key, issue.Fields.Summary, issue.Fields.Status.Name))
+		}
+
+		if active {
+			retro.IssueCount++
+		}
+	}
+
+	retro.Blockers = sortedRetroThemes(blockers)
+	retro.Concerns = sortedRetroThemes(concerns)
+	sort.SliceStable(retro.Wins, func(i, j int) bool {
+		return retro.Wins[i].Issue.Key < retro.Wins[j].Issue.Key
+	})
+	return retro
+}
+
+// addRetroMention counts a mention of a theme on an issue
+func addRetroMention(themes map[string]*RetroTheme, name, issueKey, excerpt string) {
+	theme, ok := themes[name]
+	if !ok {
+		theme = &RetroTheme{Name: name}
+		themes[name] = theme
+	}
+	theme.Mentions++
+	if !slices.Contains(theme.Issues, issueKey) {
+		theme.Issues = append(theme.Issues, issueKey)
+	}
+	if len(theme.Examples) < retroExamples {
+		theme.Examples = append(theme.Examples, excerpt)
+	}
+}
+
+// retroExcerpt shortens a comment to one line for quoting in the retro
+func retroExcerpt(text string) string {
+	return commentExcerpt(strings.Join(strings.Fields(text), " "), 120)
+}
+
+// sortedRetroThemes orders themes by mentions, then by name
+func sortedRetroThemes(themes map[string]*RetroTheme) []RetroTheme {
+	sorted := make([]RetroTheme, 0, len(themes))
+	for _, theme := range themes {
+		sorted = append(sorted, *theme)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Mentions != sorted[j].Mentions {
+			return sorted[i].Mentions > sorted[j].Mentions
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
+
+// Title returns the retro title
+func (r *Retro) Title() string {
+	dates := fmt.Sprintf("%s - %s", r.Start.Format("Jan 2"), r.End.Add(-time.Nanosecond).Format("Jan 2, 2006"))
+	if r.Name != "" {
+		return fmt.Sprintf("Retrospective: %s (%s)", r.Name, dates)
+	}
+	return fmt.Sprintf("Retrospective: %s", dates)
+}
+
+// Markdown renders the retro as a markdown document
+func (r *Retro) Markdown() string {
+	var result strings.Builder
+
+	result.WriteString(fmt.Sprintf("# %s\n\n", r.Title()))
+	result.WriteString(fmt.Sprintf("%d issues · %d comments · **%d** wins · **%d** blocker themes · **%d** concern themes\n\n",
+		r.IssueCount, r.CommentCount, len(r.Wins), len(r.Blockers), len(r.Concerns)))
+
+	var recurring, oneOff []RetroTheme
+	for _, theme := range r.Blockers {
+		if theme.Recurring() {
+			recurring = append(recurring, theme)
+		} else {
+			oneOff = append(oneOff, theme)
+		}
+	}
+
+	result.WriteString("## 🚧 Recurring Blockers\n\n")
+	if len(recurring) == 0 {
+		result.WriteString("_No blocker came up more than once._\n\n")
+	}
+	writeRetroThemes(&result, recurring)
+	if len(oneOff) > 0 {
+		var mentions []string
+		for _, theme := range oneOff {
+			mentions = append(mentions, fmt.Sprintf("%s (%s)", theme.Name, strings.Join(theme.Issues, ", ")))
+		}
+		result.WriteString(fmt.Sprintf("One-off blockers: %s\n\n", strings.Join(mentions, "; ")))
+	}
+
+	result.WriteString("## 😟 Concerns\n\n")
+	if len(r.Concerns) == 0 {
+		result.WriteString("_No negative comments this sprint._\n\n")
+	}
+	writeRetroThemes(&result, r.Concerns)
+
+	result.WriteString("## 🎉 Wins\n\n")
+	if len(r.Wins) == 0 {
+		result.WriteString("_No completed issues or notable wins recorded._\n\n")
+	} else {
+		for _, win := range r.Wins {
+			result.WriteString(fmt.Sprintf("- **%s** %s — %s\n", win.Issue.Key, win.Issue.Fields.Summary, win.Note))
+		}
+		result.WriteString("\n")
+	}
+
+	result.WriteString("---\n*Generated by my-day CLI from synced comments. Blockers and sentiment are detected from keywords; review before sharing.*\n")
+	return result.String()
+}
+
+// writeRetroThemes writes each theme with its issues and example comments
+func writeRetroThemes(result *strings.Builder, themes []RetroTheme) {
+	for _, theme := range themes {
+		result.WriteString(fmt.Sprintf("### %s — %d mentions on %s\n\n", theme.Name, theme.Mentions, strings.Join(theme.Issues, ", ")))
+		for _, example := range theme.Examples {
+			result.WriteString(fmt.Sprintf("- %s\n", example))
+		}
+		result.WriteString("\n")
+	}
+}
@@ -0,0 +1,74 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func retroComment(id, text string, created time.Time) jira.Comment {
+	return jira.Comment{ID: id, Body: jira.JiraDescription{Text: text}, Created: jira.JiraTime{Time: created}}
+}
+
+func TestBuildRetro(t *testing.T) {
+	start := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 14)
+	day := func(n int) time.Time { return start.AddDate(0, 0, n) }
+
+	issue := func(key, status, category string, updated time.Time) jira.Issue {
+		return jira.Issue{Key: key, Fields: jira.Fields{
+			Summary: key + " summary",
+			Status:  jira.Status{Name: status, Category: jira.StatusCategory{Key: category}},
+			Updated: jira.JiraTime{Time: updated},
+		}}
+	}
+
+	retro := BuildRetro("Sprint 12", []IssueWithComments{
+		{Issue: issue("OPS-1", "In Progress", "indeterminate", day(3)), Comments: []jira.Comment{
+			retroComment("1", "Blocked waiting on the deploy pipeline", day(1)),
+			retroComment("2", "Still stuck waiting for the release window", day(3)),
+			retroComment("old", "Blocked on deploy again", day(-5)),
+		}},
+		{Issue: issue("OPS-2", "Done", "done", day(5)), Comments: []jira.Comment{
+			retroComment("3", "Tests failed with a broken fixture", day(4)),
+		}},
+		{Issue: issue("OPS-3", "On Hold", "indeterminate", day(6))},
+		{Issue: issue("OPS-4", "Done", "done", day(-10))},
+	}, start, end)
+
+	if retro.CommentCount != 3 || retro.IssueCount != 3 {
+		t.Errorf("expected 3 comments on 3 issues in the sprint, got %d on %d", retro.CommentCount, retro.IssueCount)
+	}
+	if len(retro.Blockers) != 2 || retro.Blockers[0].Name != "Deployments and releases" || retro.Blockers[0].Mentions != 2 || !retro.Blockers[0].Recurring() {
+		t.Fatalf("expected the deployment blocker to recur, got %+v", retro.Blockers)
+	}
+	if retro.Blockers[1].Name != blockedStatusTheme || retro.Blockers[1].Issues[0] != "OPS-3" {
+		t.Errorf("expected the on-hold issue as a blocker, got %+v", retro.Blockers[1])
+	}
+	if len(retro.Wins) != 1 || retro.Wins[0].Issue.Key != "OPS-2" {
+		t.Errorf("expected only the issue completed in the sprint as a win, got %+v", retro.Wins)
+	}
+
+	var concerns []string
+	for _, theme := range retro.Concerns {
+		concerns = append(concerns, theme.Name)
+	}
+	if !strings.Contains(strings.Join(concerns, ","), "Testing") {
+		t.Errorf("expected a testing concern, got %v", concerns)
+	}
+
+	markdown := retro.Markdown()
+	for _, want := range []string{
+		"# Retrospective: Sprint 12 (Jul 1 - Jul 14, 2024)",
+		"### Deployments and releases — 2 mentions on OPS-1",
+		"- OPS-1: Blocked waiting on the deploy pipeline",
+		"One-off blockers: Blocked in Jira (OPS-3)",
+		"- **OPS-2** OPS-2 summary — completed",
+	} {
+		if !strings.Contains(markdown, want) {
+			t.Errorf("expected %q in:\n%s", want, markdown)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+// riskStaleDays is the time in one status at which an issue gets the full days-in-status score
+const riskStaleDays = 14
+
+// RiskWeights weighs the factors of an issue's risk score against each other. A factor with a
+// zero weight is ignored; with every weight zero, the at-risk list is left out.
+type RiskWeights struct {
+	Priority     int
+	DaysInStatus int
+	Blockers     int
+	Sentiment    int
+}
+
+func (w RiskWeights) total() int {
+	return w.Priority + w.DaysInStatus + w.Blockers + w.Sentiment
+}
+
+// issueRisk is the risk score of an in-progress issue with the factors behind it
+type issueRisk struct {
+	Issue        jira.Issue
+	Score        int // 0 to 100
+	DaysInStatus int
+	BlockedBy    []string
+	Negative     int // Negative comments
+	Comments     int
+}
+
+// reasons describes the factors that raised the score, e.g. "12d in In Progress", with the
+// status shown by label
+func (r issueRisk) reasons(weights RiskWeights, label func(string) string) []string {
+	var reasons []string
+	if weights.Priority > 0 && priorityRisk(r.Issue.Fields.Priority.Name) >= 0.75 {
+		reasons = append(reasons, r.Issue.Fields.Priority.Name+" priority")
+	}
+	if weights.DaysInStatus > 0 && r.DaysInStatus > 0 {
+		reasons = append(reasons, fmt.Sprintf("%dd in %s", r.DaysInStatus, label(r.Issue.Fields.Status.Name)))
+	}
+	if weights.Blockers > 0 && len(r.BlockedBy) > 0 {
+		reasons = append(reasons, "blocked by "+strings.Join(r.BlockedBy, ", "))
+	}
+	if weights.Sentiment > 0 && r.Negative > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d of %d comments negative", r.Negative, r.Comments))
+	}
+	return reasons
+}
+
+// priorityRisk returns the share of the priority weight an issue's priority scores
+func priorityRisk(priority string) float64 {
+	switch strings.ToLower(priority) {
+	case "highest", "blocker", "critical":
+		return 1
+	case "high", "major":
+		return 0.75
+	case "medium":
+		return 0.5
+	case "low", "minor":
+		return 0.25
+	case "lowest", "trivial", "":
+		return 0
+	default:
+		return 0.5
+	}
+}
+
+// issueRisks scores the in-progress issues of the report, highest risk first, keeping those
+// scoring at least the configured minimum
+func (g *Generator) issueRisks(issues []jira.Issue, comments map[string][]jira.Comment, targetDate time.Time) []issueRisk {
+	weights := g.config.RiskWeights
+	if weights.total() <= 0 {
+		return nil
+	}
+
+	// Days in status count up to the end of the report date, or now for today's report
+	reference := time.Date(targetDate.Year(), targetDate.Month(), targetDate.Day(), 0, 0, 0, 0, time.Local).AddDate(0, 0, 1)
+	if now := time.Now(); now.Before(reference) {
+		reference = now
+	}
+
+	processor := llm.NewEnhancedDataProcessor(false)
+	var risks []issueRisk
+	seen := make(map[string]bool)
+	for _, issue := range issues {
+		if seen[issue.Key] || !strings.EqualFold(issue.Fields.Status.Category.Key, "indeterminate") {
+			continue
+		}
+		seen[issue.Key] = true
+
+		risk := issueRisk{Issue: issue}
+
+		// Syncs record when the status changed; the last update is the closest known otherwise
+		since, ok := g.config.StatusSince[issue.Key]
+		if !ok {
+			since = issue.Fields.Updated.Time
+		}
+		if !since.IsZero() && reference.After(since) {
+			risk.DaysInStatus = int(reference.Sub(since).Hours() / 24)
+		}
+
+		for _, blocker := range issue.BlockedBy() {
+			risk.BlockedBy = append(risk.BlockedBy, blocker.Key)
+		}
+
+		for _, comment := range comments[issue.Key] {
+			analysis, err := processor.AnalyzeComment(comment)
+			if err != nil {
+				continue
+			}
+			risk.Comments++
+			if analysis.Sentiment == "negative" {
+				risk.Negative++
+			}
+		}
+
+		score := float64(weights.Priority) * priorityRisk(issue.Fields.Priority.Name)
+		score += float64(weights.DaysInStatus) * math.Min(float64(risk.DaysInStatus)/riskStaleDays, 1)
+		if len(risk.BlockedBy) > 0 {
+			score += float64(weights.Blockers)
+		}
+		if risk.Comments > 0 {
+			score += float64(weights.Sentiment) * float64(risk.Negative) / float64(risk.Comments)
+		}
+		risk.Score = int(math.Round(score * 100 / float64(weights.total())))
+
+		if risk.Score >= g.config.RiskMinScore && risk.Score > 0 {
+			risks = append(risks, risk)
+		}
+	}
+
+	sort.SliceStable(risks, func(i, j int) bool {
+		return risks[i].Score > risks[j].Score
+	})
+	return risks
+}
+
+func init() {
+	RegisterSection(NewSection("🔥 At risk", PriorityRisk, func(model SectionModel, format string) string {
+		return model.generator.formatRisks(model.Issues, model.Comments, model.TargetDate, format)
+	}))
+}
+
+// formatRisks renders the in-progress issues at risk, highest score first
+func (g *Generator) formatRisks(issues []jira.Issue, comments map[string][]jira.Comment, targetDate time.Time, format string) string {
+	risks := g.issueRisks(issues, comments, targetDate)
+	if len(risks) == 0 {
+		return ""
+	}
+
+	var result strings.Builder
+	if format == FormatHTML {
+		result.WriteString("<ul>\n")
+	}
+	for _, risk := range risks {
+		reasons := strings.Join(risk.reasons(g.config.RiskWeights, g.StatusLabel), ", ")
+		switch format {
+		case FormatHTML:
+			result.WriteString(fmt.Sprintf("<li><span class=\"risk\">%d</span> <span class=\"key\">%s</span> %s <span class=\"meta\">— %s</span></li>\n",
+				risk.Score, html.EscapeString(risk.Issue.Key), html.EscapeString(risk.Issue.Fields.Summary), html.EscapeString(reasons)))
+		case FormatMarkdown:
+			result.WriteString(fmt.Sprintf("- **%d** **[%s]** %s — %s\n", risk.Score, risk.Issue.Key, risk.Issue.Fields.Summary, reasons))
+		default:
+			result.WriteString(fmt.Sprintf("  %3d  %s %s\n       %s\n", risk.Score, risk.Issue.Key, risk.Issue.Fields.Summary, reasons))
+		}
+	}
+	if format == FormatHTML {
+		result.WriteString("</ul>\n")
+	}
+	return result.String()
+}
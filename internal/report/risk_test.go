@@ -0,0 +1,69 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func TestIssueRisks(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+	issue := func(key, priority, category string, updated time.Time) jira.Issue {
+		return jira.Issue{Key: key, Fields: jira.Fields{
+			Summary:  "Issue " + key,
+			Priority: jira.Priority{Name: priority},
+			Status:   jira.Status{Name: "In Progress", Category: jira.StatusCategory{Key: category}},
+			Updated:  jira.JiraTime{Time: updated},
+		}}
+	}
+
+	blocked := issue("OPS-1", "High", "indeterminate", targetDate.Add(9*time.Hour))
+	blocker := &jira.LinkedIssue{Key: "OPS-9"}
+	blocker.Fields.Status.Category.Key = "indeterminate"
+	blocked.Fields.IssueLinks = []jira.IssueLink{{Type: jira.IssueLinkType{Name: "Blocks"}, InwardIssue: blocker}}
+
+	issues := []jira.Issue{
+		blocked,
+		issue("OPS-2", "Medium", "indeterminate", targetDate.AddDate(0, 0, -1)),
+		issue("OPS-3", "Low", "indeterminate", targetDate.Add(10*time.Hour)),
+		issue("OPS-4", "Highest", "done", targetDate),
+	}
+	comments := map[string][]jira.Comment{
+		"OPS-2": {
+			{ID: "1", Body: jira.JiraDescription{Text: "Still stuck, the migration failed again"}},
+			{ID: "2", Body: jira.JiraDescription{Text: "Retrying tomorrow"}},
+		},
+	}
+
+	g := &Generator{config: &Config{
+		RiskWeights:  RiskWeights{Priority: 30, DaysInStatus: 30, Blockers: 25, Sentiment: 15},
+		RiskMinScore: 20,
+		// OPS-2 has been in progress for four weeks, longer than its last update shows
+		StatusSince: map[string]time.Time{"OPS-2": targetDate.AddDate(0, 0, -28)},
+	}}
+	risks := g.issueRisks(issues, comments, targetDate)
+
+	if len(risks) != 2 || risks[0].Issue.Key != "OPS-2" || risks[1].Issue.Key != "OPS-1" {
+		t.Fatalf("expected OPS-2 then OPS-1 at risk, got %+v", risks)
+	}
+	// Medium priority (0.5 of 30), 29 days in status (30) and half the comments negative (0.5 of 15)
+	if risks[0].Score != 53 || risks[0].DaysInStatus != 29 || risks[0].Negative != 1 {
+		t.Errorf("expected OPS-2 to score 53 after 29 days, got %+v", risks[0])
+	}
+	// High priority (0.75 of 30) and blocked (25) out of 100
+	if risks[1].Score != 48 || len(risks[1].BlockedBy) != 1 {
+		t.Errorf("expected OPS-1 to score 48 and be blocked, got %+v", risks[1])
+	}
+
+	content := g.formatRisks(issues, comments, targetDate, FormatMarkdown)
+	if !strings.Contains(content, "- **48** **[OPS-1]** Issue OPS-1 — High priority, blocked by OPS-9") {
+		t.Errorf("unexpected at-risk list:\n%s", content)
+	}
+
+	g.config.RiskWeights = RiskWeights{}
+	if content := g.formatRisks(issues, comments, targetDate, FormatMarkdown); content != "" {
+		t.Errorf("expected no list without weights, got:\n%s", content)
+	}
+}
@@ -0,0 +1,132 @@
+package report
+
+import (
+	"strings"
+
+	"my-day/internal/jira"
+)
+
+// rollsUp reports whether grouping by fieldName rolls subtasks up under their parent issue
+func rollsUp(fieldName string) bool {
+	switch strings.ToLower(fieldName) {
+	case "epic", "parent":
+		return true
+	}
+	return false
+}
+
+// rollupParent returns the key of the issue an issue is rolled up under when grouping by
+// fieldName: its epic when grouping by epic, or its parent otherwise
+func (g *Generator) rollupParent(issue jira.Issue, fieldName string) string {
+	if strings.EqualFold(fieldName, "epic") {
+		if epics := g.getFieldValuesByName(issue, "epic"); len(epics) > 0 {
+			return epics[0]
+		}
+	}
+	if issue.Fields.Parent != nil {
+		return issue.Fields.Parent.Key
+	}
+	return ""
+}
+
+// parentGroups puts the parents of a report in the group of their subtasks when grouping by
+// epic or parent, since a parent has no epic or parent of its own to be grouped by
+func (g *Generator) parentGroups(groups map[string][]jira.Issue, fieldName string) map[string][]jira.Issue {
+	if !rollsUp(fieldName) {
+		return groups
+	}
+	childGroup := make(map[string]string)
+	for groupName, issues := range groups {
+		for _, issue := range issues {
+			if parent := g.rollupParent(issue, fieldName); parent != "" {
+				childGroup[parent] = groupName
+			}
+		}
+	}
+
+	var unassigned []jira.Issue
+	for _, issue := range groups["Unassigned"] {
+		if groupName, found := childGroup[issue.Key]; found && groupName != "Unassigned" {
+			groups[groupName] = append([]jira.Issue{issue}, groups[groupName]...)
+			continue
+		}
+		unassigned = append(unassigned, issue)
+	}
+	if len(unassigned) > 0 {
+		groups["Unassigned"] = unassigned
+	} else {
+		delete(groups, "Unassigned")
+	}
+	return groups
+}
+
+// formatRollup renders the issues of one status of a group, each followed by its subtasks in
+// the group indented beneath it whatever their status. Subtasks whose parent is shown are left
+// out of their own status. Without a rollup, the issues are rendered one after another.
+func (g *Generator) formatRollup(issues, group []jira.Issue, fieldName string, format func(jira.Issue) string, indent string) string {
+	children := make(map[string][]jira.Issue)
+	if rollsUp(fieldName) {
+		shown := make(map[string]bool)
+		for _, issue := range group {
+			shown[issue.Key] = g.statusShown(issue)
+		}
+		for _, issue := range group {
+			if parent := g.rollupParent(issue, fieldName); parent != issue.Key && shown[parent] {
+				children[parent] = append(children[parent], issue)
+			}
+		}
+	}
+	rolledUp := make(map[string]bool)
+	for _, subtasks := range children {
+		for _, subtask := range subtasks {
+			rolledUp[subtask.Key] = true
+		}
+	}
+
+	var result strings.Builder
+	seen := make(map[string]bool)
+	var write func(issue jira.Issue, depth int)
+	write = func(issue jira.Issue, depth int) {
+		if seen[issue.Key] {
+			return
+		}
+		seen[issue.Key] = true
+		result.WriteString(indentLines(format(issue), strings.Repeat(indent, depth)))
+		for _, subtask := range children[issue.Key] {
+			if g.statusShown(subtask) {
+				write(subtask, depth+1)
+			}
+		}
+	}
+	for _, issue := range issues {
+		if !rolledUp[issue.Key] {
+			write(issue, 0)
+		}
+	}
+	return result.String()
+}
+
+// statusShown reports whether the status of an issue has a section in grouped reports
+func (g *Generator) statusShown(issue jira.Issue) bool {
+	switch strings.ToLower(issue.Fields.Status.Category.Key) {
+	case "indeterminate", "done":
+		return true
+	case "new":
+		return !g.config.Hide.ToDo
+	}
+	return false
+}
+
+// indentLines indents every non-empty line of text
+func indentLines(text, indent string) string {
+	if indent == "" {
+		return text
+	}
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = indent + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
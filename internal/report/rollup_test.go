@@ -0,0 +1,72 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// hierarchyIssue returns an issue in a status category, as a subtask of parent when given
+func hierarchyIssue(key, category string, parent *jira.Issue) jira.Issue {
+	issue := jira.Issue{Key: key}
+	issue.Fields.Summary = "Work on " + key
+	issue.Fields.Status = jira.Status{Name: category, Category: jira.StatusCategory{Key: category}}
+	if parent != nil {
+		issue.Fields.Parent = &jira.LinkedIssue{Key: parent.Key}
+		issue.Fields.Parent.Fields.Summary = parent.Fields.Summary
+		issue.Fields.Parent.Fields.IssueType.Name = "Epic"
+	}
+	return issue
+}
+
+func TestSubtasksRollUpUnderTheirParent(t *testing.T) {
+	epic := hierarchyIssue("WEB-1", "indeterminate", nil)
+	issues := []jira.Issue{
+		hierarchyIssue("WEB-2", "done", &epic),
+		epic,
+		hierarchyIssue("WEB-3", "indeterminate", &epic),
+		hierarchyIssue("OPS-9", "indeterminate", nil),
+	}
+	g := NewGenerator(&Config{})
+
+	groups := g.groupIssuesByField(issues, "epic")
+	if got := len(groups["WEB-1"]); got != 3 {
+		t.Fatalf("expected the epic in the group of its subtasks, got %v", groups)
+	}
+	if got := len(groups["Unassigned"]); got != 1 {
+		t.Errorf("expected only OPS-9 unassigned, got %v", groups["Unassigned"])
+	}
+
+	group := groups["WEB-1"]
+	statuses := groupIssuesByStatus(group)
+	format := func(issue jira.Issue) string { return "- " + issue.Key + "\n" }
+	if got := g.formatRollup(statuses["In Progress"], group, "epic", format, "  "); got != "- WEB-1\n  - WEB-2\n  - WEB-3\n" {
+		t.Errorf("expected the subtasks indented under the epic, got:\n%s", got)
+	}
+	if got := g.formatRollup(statuses["Done"], group, "epic", format, "  "); got != "" {
+		t.Errorf("expected the done subtask rolled up under its parent, got:\n%s", got)
+	}
+	if got := g.formatRollup(statuses["Done"], group, "squad", format, "  "); got != "- WEB-2\n" {
+		t.Errorf("expected no rollup when grouping by squad, got:\n%s", got)
+	}
+
+	if got := g.getFieldValueByName(issues[0], "parent"); got != "WEB-1 Work on WEB-1" {
+		t.Errorf("unexpected parent group %q", got)
+	}
+}
+
+func TestRollupInMarkdownReport(t *testing.T) {
+	epic := hierarchyIssue("WEB-1", "indeterminate", nil)
+	issues := []jira.Issue{epic, hierarchyIssue("WEB-2", "indeterminate", &epic)}
+	g := NewGenerator(&Config{Format: FormatMarkdown, Hide: HiddenSections{Footer: true}})
+
+	report, err := g.generateMarkdownFieldGrouped(g.groupIssuesByField(issues, "parent"), nil, nil, time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local), "parent")
+	if err != nil {
+		t.Fatalf("generateMarkdownFieldGrouped() error = %v", err)
+	}
+	if !strings.Contains(report, "**[WEB-1]** Work on WEB-1\n\n  - ") || !strings.Contains(report, "  - 📝 **[WEB-2]** Work on WEB-2\n") {
+		t.Errorf("expected WEB-2 nested under WEB-1, got:\n%s", report)
+	}
+}
@@ -0,0 +1,119 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// Report formats a section is rendered in
+const (
+	FormatConsole  = "console"
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+)
+
+// Priorities of the built-in sections. Sections are shown after the issues, lowest priority first.
+const (
+	PriorityAttention = 40
+	PriorityRisk      = 50
+	PriorityNextSteps = 60
+	PriorityEstimates = 100
+	PriorityActivity  = 200 // GitHub, GitLab, Trello and Asana, in that order
+	PriorityWorklog   = 900
+)
+
+// HiddenSections lists the built-in parts of the report to leave out. Everything is shown by default.
+type HiddenSections struct {
+	AISummary bool // AI summary of the day
+	Summary   bool // Issue, comment and worklog counts
+	Worklog   bool // Work Log section
+	ToDo      bool // Issues still to do
+	Quality   bool // Summary quality indicators of --show-quality
+	Footer    bool
+}
+
+// SectionModel is the report data sections are rendered from
+type SectionModel struct {
+	TargetDate time.Time
+	Issues     []jira.Issue              // Issues in the report
+	Worklogs   []jira.WorklogEntry       // Worklogs in the report window
+	Comments   map[string][]jira.Comment // Comments of the report's issues by issue key, nil for reports without comments
+	Config     *Config
+
+	generator *Generator // Gives the built-in sections the generator's helpers
+}
+
+// Section is a part of the report contributed by an integration, such as GitHub activity or
+// the work log. The generator writes the title as a heading in each format, so Render only
+// returns the body.
+type Section interface {
+	// Title is the section heading, e.g. "🐙 GitHub Activity"
+	Title() string
+	// Priority orders the sections, lowest first
+	Priority() int
+	// Render returns the section body in the given format, or "" to leave the section out
+	Render(model SectionModel, format string) string
+}
+
+// NewSection returns a Section rendered by a function
+func NewSection(title string, priority int, render func(model SectionModel, format string) string) Section {
+	return &funcSection{title: title, priority: priority, render: render}
+}
+
+type funcSection struct {
+	title    string
+	priority int
+	render   func(model SectionModel, format string) string
+}
+
+func (s *funcSection) Title() string { return s.title }
+func (s *funcSection) Priority() int { return s.priority }
+func (s *funcSection) Render(model SectionModel, format string) string {
+	return s.render(model, format)
+}
+
+// sectionRegistry holds the registered sections in registration order
+var sectionRegistry []Section
+
+// RegisterSection adds a section to every report. Sections with the same priority keep the
+// order they were registered in.
+func RegisterSection(section Section) {
+	sectionRegistry = append(sectionRegistry, section)
+}
+
+// Sections returns the registered sections in report order
+func Sections() []Section {
+	sections := append([]Section{}, sectionRegistry...)
+	sort.SliceStable(sections, func(i, j int) bool {
+		return sections[i].Priority() < sections[j].Priority()
+	})
+	return sections
+}
+
+// renderSections renders every registered section with content, each under its heading
+func (g *Generator) renderSections(model SectionModel, format string) string {
+	model.Config = g.config
+	model.generator = g
+
+	var result strings.Builder
+	for _, section := range Sections() {
+		body := section.Render(model, format)
+		if body == "" {
+			continue
+		}
+		switch format {
+		case FormatHTML:
+			result.WriteString(fmt.Sprintf("<h2>%s</h2>\n%s", html.EscapeString(section.Title()), body))
+		case FormatMarkdown:
+			result.WriteString(fmt.Sprintf("## %s\n\n%s\n", section.Title(), body))
+		default:
+			result.WriteString(fmt.Sprintf("%s\n%s\n", strings.ToUpper(section.Title()), body))
+		}
+	}
+	return result.String()
+}
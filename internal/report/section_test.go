@@ -0,0 +1,81 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func TestRegisteredSectionsRenderInPriorityOrder(t *testing.T) {
+	registered := sectionRegistry
+	defer func() { sectionRegistry = registered }()
+
+	RegisterSection(NewSection("🚨 Incidents", PriorityActivity-1, func(model SectionModel, format string) string {
+		if format == FormatHTML {
+			return "<p>INC-7 resolved</p>\n"
+		}
+		return "  • INC-7 resolved\n"
+	}))
+	RegisterSection(NewSection("Empty", PriorityActivity-1, func(model SectionModel, format string) string {
+		return ""
+	}))
+
+	var titles []string
+	for _, section := range Sections() {
+		titles = append(titles, section.Title())
+	}
+	if titles[0] != "🔔 Needs my attention" || titles[1] != "🔥 At risk" || titles[2] != "🧭 Next Steps" || titles[3] != "📐 Estimate vs Actual" || titles[4] != "🚨 Incidents" || titles[len(titles)-2] != "⏰ Work Log" || titles[len(titles)-1] != "⚠️ Notes about this report" {
+		t.Errorf("unexpected section order %v", titles)
+	}
+
+	g := &Generator{config: &Config{IncludeToday: true}}
+	model := SectionModel{
+		TargetDate: time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local),
+		Worklogs:   []jira.WorklogEntry{{IssueID: "OPS-1", Started: jira.JiraTime{Time: time.Date(2024, 7, 15, 9, 0, 0, 0, time.Local)}, TimeSpentSeconds: 1800}},
+	}
+
+	console := g.renderSections(model, FormatConsole)
+	if !strings.HasPrefix(console, "🚨 INCIDENTS\n  • INC-7 resolved\n\n⏰ WORK LOG\n") {
+		t.Errorf("unexpected console sections:\n%s", console)
+	}
+	if strings.Contains(console, "EMPTY") {
+		t.Errorf("expected sections without content to be left out:\n%s", console)
+	}
+	if markdown := g.renderSections(model, FormatMarkdown); !strings.HasPrefix(markdown, "## 🚨 Incidents\n\n  • INC-7 resolved\n\n## ⏰ Work Log\n\n- ⏱️ **[OPS-1]**") {
+		t.Errorf("unexpected markdown sections:\n%s", markdown)
+	}
+	if html := g.renderSections(model, FormatHTML); !strings.HasPrefix(html, "<h2>🚨 Incidents</h2>\n<p>INC-7 resolved</p>\n<h2>⏰ Work Log</h2>\n<table>") {
+		t.Errorf("unexpected HTML sections:\n%s", html)
+	}
+}
+
+func TestHiddenSectionsAreLeftOut(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+	issue := func(key, category string) IssueWithComments {
+		return IssueWithComments{Issue: jira.Issue{Key: key, Fields: jira.Fields{
+			Summary: "Issue " + key,
+			Status:  jira.Status{Category: jira.StatusCategory{Key: category}},
+			Updated: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)},
+		}}}
+	}
+	issues := []IssueWithComments{issue("OPS-1", "indeterminate"), issue("OPS-2", "new")}
+	worklogs := []jira.WorklogEntry{{IssueID: "OPS-1", Started: jira.JiraTime{Time: targetDate.Add(9 * time.Hour)}, TimeSpentSeconds: 1800}}
+
+	for _, format := range []string{"console", "markdown", "html"} {
+		g := &Generator{config: &Config{Format: format, IncludeToday: true, Hide: HiddenSections{Summary: true, Worklog: true, ToDo: true, Footer: true}}}
+		content, err := g.GenerateWithComments(issues, worklogs, targetDate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(content, "OPS-1") {
+			t.Errorf("%s: expected the in-progress issue, got:\n%s", format, content)
+		}
+		for _, hidden := range []string{"OPS-2", "Summary", "SUMMARY", "Work Log", "WORK LOG", "Generated by my-day"} {
+			if strings.Contains(content, hidden) {
+				t.Errorf("%s: expected %q to be left out, got:\n%s", format, hidden, content)
+			}
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package report
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+// spokenIssues is how many issues per status are read out when no AI summary is available
+const spokenIssues = 3
+
+// SpokenSummary returns a short standup update for the report date, written to be read aloud
+// as a voice note: the brief-style AI summary when the LLM is enabled, otherwise the issues
+// completed, in progress and up next
+func (g *Generator) SpokenSummary(issuesWithComments []IssueWithComments, worklogs []jira.WorklogEntry, targetDate time.Time) string {
+	var issues []jira.Issue
+	commentsMap := make(map[string][]jira.Comment)
+	for _, iwc := range issuesWithComments {
+		issues = append(issues, iwc.Issue)
+		commentsMap[iwc.Issue.Key] = iwc.Comments
+	}
+	filteredIssues := g.filterIssues(issues, targetDate)
+
+	if g.config.LLMEnabled {
+		var allComments []jira.Comment
+		for _, issue := range filteredIssues {
+			allComments = append(allComments, commentsMap[issue.Key]...)
+		}
+		if hasMeaningfulComments(allComments) {
+			summarizer, err := llm.NewSummarizer(newLLMConfig(g.config, "brief"))
+			if err == nil {
+				trackComments(summarizer, issuesWithComments)
+				summary, err := summarizer.GenerateStandupSummaryWithComments(filteredIssues, allComments, g.filterWorklogs(worklogs, targetDate))
+				if err == nil && strings.TrimSpace(summary) != "" {
+					return SpeechText(summary)
+				}
+			}
+		}
+	}
+
+	return spokenStatusSummary(filteredIssues)
+}
+
+// spokenStatusSummary reads out the issues by status
+func spokenStatusSummary(issues []jira.Issue) string {
+	if len(issues) == 0 {
+		return "No Jira activity to report today."
+	}
+
+	groups := groupIssuesByStatus(issues)
+	var sentences []string
+	for _, group := range []struct{ name, intro string }{
+		{"Done", "Completed"},
+		{"In Progress", "In progress"},
+		{"To Do", "Up next"},
+	} {
+		if sentence := spokenIssueList(group.intro, groups[group.name]); sentence != "" {
+			sentences = append(sentences, sentence)
+		}
+	}
+	return SpeechText(strings.Join(sentences, " "))
+}
+
+// spokenIssueList lists up to spokenIssues issues by summary, mentioning how many more there are
+func spokenIssueList(intro string, issues []jira.Issue) string {
+	if len(issues) == 0 {
+		return ""
+	}
+
+	var summaries []string
+	for i, issue := range issues {
+		if i == spokenIssues {
+			summaries = append(summaries, fmt.Sprintf("and %d more", len(issues)-spokenIssues))
+			break
+		}
+		summaries = append(summaries, strings.TrimSuffix(strings.TrimSpace(issue.Fields.Summary), "."))
+	}
+	return fmt.Sprintf("%s: %s.", intro, strings.Join(summaries, "; "))
+}
+
+var (
+	speechLink     = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+	speechURL      = regexp.MustCompile(`https?://\S+`)
+	speechHeading  = regexp.MustCompile(`(?m)^\s*#+\s*`)
+	speechBullet   = regexp.MustCompile(`(?m)^\s*(?:[-*+•]|\d+[.)])\s+`)
+	speechMarkup   = regexp.MustCompile("[*_`~>|]+")
+	speechSymbols  = regexp.MustCompile(`[\p{So}\p{Sk}\x{FE0F}\x{200D}]`)
+	speechSentence = regexp.MustCompile(`([^.!?:;,])\n+`)
+	speechSpaces   = regexp.MustCompile(`\s+`)
+)
+
+// SpeechText strips markdown, links and emoji from a summary so a speech engine reads only
+// the words, and ends lines as sentences so they are read with a pause
+func SpeechText(text string) string {
+	text = speechLink.ReplaceAllString(text, "$1")
+	text = speechURL.ReplaceAllString(text, "")
+	text = speechHeading.ReplaceAllString(text, "")
+	text = speechBullet.ReplaceAllString(text, "")
+	text = speechMarkup.ReplaceAllString(text, "")
+	text = speechSymbols.ReplaceAllString(text, "")
+	text = speechSentence.ReplaceAllString(strings.TrimSpace(text), "$1. ")
+	return strings.TrimSpace(speechSpaces.ReplaceAllString(text, " "))
+}
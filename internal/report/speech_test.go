@@ -0,0 +1,65 @@
+package report
+
+import (
+	"testing"
+
+	"my-day/internal/jira"
+)
+
+func TestSpeechText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "markdown",
+			text: "## Summary\n- **Fixed** the `deploy` pipeline\n- Reviewed [PR 12](https://github.com/org/repo/pull/12)",
+			want: "Summary. Fixed the deploy pipeline. Reviewed PR 12",
+		},
+		{
+			name: "emoji and urls",
+			text: "🚀 Released 1.2 ✅ see https://example.com/notes",
+			want: "Released 1.2 see",
+		},
+		{
+			name: "existing punctuation is kept",
+			text: "Done with DEVOPS-1.\nStarting DEVOPS-2:\nthe migration",
+			want: "Done with DEVOPS-1. Starting DEVOPS-2: the migration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SpeechText(tt.text); got != tt.want {
+				t.Errorf("SpeechText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpokenStatusSummary(t *testing.T) {
+	issue := func(key, summary, category string) jira.Issue {
+		var i jira.Issue
+		i.Key = key
+		i.Fields.Summary = summary
+		i.Fields.Status.Category.Key = category
+		return i
+	}
+
+	issues := []jira.Issue{
+		issue("A-1", "Upgrade Postgres.", "done"),
+		issue("A-2", "Rotate certificates", "indeterminate"),
+		issue("A-3", "Fix flaky test", "indeterminate"),
+		issue("A-4", "Tune alerts", "indeterminate"),
+		issue("A-5", "Clean up dashboards", "indeterminate"),
+	}
+
+	want := "Completed: Upgrade Postgres. In progress: Rotate certificates; Fix flaky test; Tune alerts; and 1 more."
+	if got := spokenStatusSummary(issues); got != want {
+		t.Errorf("spokenStatusSummary() = %q, want %q", got, want)
+	}
+	if got := spokenStatusSummary(nil); got != "No Jira activity to report today." {
+		t.Errorf("spokenStatusSummary(nil) = %q", got)
+	}
+}
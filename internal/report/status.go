@@ -0,0 +1,39 @@
+package report
+
+import "strings"
+
+// StatusStyle is how a Jira status is shown in reports, e.g. "En curso" as 🔄 In Progress.
+// Empty fields keep the built-in icon and the status name.
+type StatusStyle struct {
+	Icon  string
+	Label string
+}
+
+// statusStyle returns the configured style of a status, matching its name case-insensitively
+func (g *Generator) statusStyle(status string) StatusStyle {
+	if style, ok := g.config.StatusStyles[status]; ok {
+		return style
+	}
+	for name, style := range g.config.StatusStyles {
+		if strings.EqualFold(name, status) {
+			return style
+		}
+	}
+	return StatusStyle{}
+}
+
+// statusIcon returns the icon of a status, from the configuration or the built-in list
+func (g *Generator) statusIcon(status string) string {
+	if icon := g.statusStyle(status).Icon; icon != "" {
+		return icon
+	}
+	return getStatusIcon(status)
+}
+
+// StatusLabel returns the word a status is shown as, from the configuration or its Jira name
+func (g *Generator) StatusLabel(status string) string {
+	if label := g.statusStyle(status).Label; label != "" {
+		return label
+	}
+	return status
+}
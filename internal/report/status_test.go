@@ -0,0 +1,50 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+func TestConfiguredStatusStyles(t *testing.T) {
+	g := &Generator{config: &Config{StatusStyles: map[string]StatusStyle{
+		"en curso":  {Icon: "🔄", Label: "In Progress"},
+		"Bloqueado": {Label: "Blocked"},
+	}}}
+
+	cases := []struct {
+		status, icon, label string
+	}{
+		{"En curso", "🔄", "In Progress"},
+		{"Bloqueado", "📝", "Blocked"}, // No icon configured, so the built-in fallback
+		{"Done", "✅", "Done"},
+	}
+	for _, c := range cases {
+		if icon := g.statusIcon(c.status); icon != c.icon {
+			t.Errorf("%s: expected icon %s, got %s", c.status, c.icon, icon)
+		}
+		if label := g.StatusLabel(c.status); label != c.label {
+			t.Errorf("%s: expected label %q, got %q", c.status, c.label, label)
+		}
+	}
+
+	targetDate := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+	g.config.Format = "markdown"
+	g.config.IncludeToday = true
+	g.config.Detailed = true
+	g.summarizer = llm.NewDisabledSummarizer()
+	content, err := g.GenerateWithComments([]IssueWithComments{{Issue: jira.Issue{Key: "OPS-1", Fields: jira.Fields{
+		Summary: "Rotar certificados",
+		Status:  jira.Status{Name: "En curso", Category: jira.StatusCategory{Key: "indeterminate"}},
+		Updated: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)},
+	}}}}, nil, targetDate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(content, "- 🔄 **[OPS-1]** Rotar certificados") || !strings.Contains(content, "  - Status: In Progress\n") {
+		t.Errorf("expected the configured icon and label, got:\n%s", content)
+	}
+}
@@ -0,0 +1,55 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"my-day/internal/llm"
+)
+
+// structuredPart is a part of the structured summary of the day with its heading
+type structuredPart struct {
+	heading string
+	items   []string
+}
+
+// formatStructuredSummary renders the parts of the structured summary of the day that have
+// items, each under its heading, in the standup order
+func formatStructuredSummary(summary *llm.StructuredSummary, format string) string {
+	parts := []structuredPart{
+		{"✅ Accomplishments", summary.Accomplishments},
+		{"🔄 In progress", summary.InProgress},
+		{"⛔ Blockers", summary.Blockers},
+		{"➡️ Next steps", summary.NextSteps},
+	}
+
+	var result strings.Builder
+	for _, part := range parts {
+		if len(part.items) == 0 {
+			continue
+		}
+		switch format {
+		case FormatHTML:
+			result.WriteString(fmt.Sprintf("<p><strong>%s</strong></p>\n<ul>\n", html.EscapeString(part.heading)))
+			for _, item := range part.items {
+				result.WriteString(fmt.Sprintf("<li>%s</li>\n", html.EscapeString(item)))
+			}
+			result.WriteString("</ul>\n")
+		case FormatMarkdown:
+			if result.Len() > 0 {
+				result.WriteString("\n")
+			}
+			result.WriteString(fmt.Sprintf("**%s**\n\n", part.heading))
+			for _, item := range part.items {
+				result.WriteString(fmt.Sprintf("- %s\n", item))
+			}
+		default:
+			result.WriteString(part.heading + "\n")
+			for _, item := range part.items {
+				result.WriteString(fmt.Sprintf("  • %s\n", item))
+			}
+		}
+	}
+	return strings.TrimSuffix(result.String(), "\n")
+}
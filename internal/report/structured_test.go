@@ -0,0 +1,52 @@
+package report
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+// structuredSummarizer is an LLM that answers a fixed structured summary, or an invalid one
+// when err is set
+type structuredSummarizer struct {
+	themedSummarizer
+}
+
+func (s structuredSummarizer) GenerateStructuredSummary(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (*llm.StructuredSummary, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &llm.StructuredSummary{
+		Accomplishments: []string{"I rolled out the <ingress> upgrade (OPS-1)"},
+		Blockers:        []string{"Waiting on the DBA (OPS-2)", "No staging credentials (OPS-3)"},
+	}, nil
+}
+
+func TestDaySummaryStructured(t *testing.T) {
+	g := &Generator{config: &Config{Structured: true}, summarizer: structuredSummarizer{}}
+
+	tests := map[string]string{
+		FormatConsole:  "✅ Accomplishments\n  • I rolled out the <ingress> upgrade (OPS-1)\n⛔ Blockers\n  • Waiting on the DBA (OPS-2)\n  • No staging credentials (OPS-3)",
+		FormatMarkdown: "**✅ Accomplishments**\n\n- I rolled out the <ingress> upgrade (OPS-1)\n\n**⛔ Blockers**\n\n- Waiting on the DBA (OPS-2)\n- No staging credentials (OPS-3)",
+		FormatHTML:     "<p><strong>✅ Accomplishments</strong></p>\n<ul>\n<li>I rolled out the &lt;ingress&gt; upgrade (OPS-1)</li>\n</ul>\n<p><strong>⛔ Blockers</strong></p>\n<ul>\n<li>Waiting on the DBA (OPS-2)</li>\n<li>No staging credentials (OPS-3)</li>\n</ul>",
+	}
+	for format, expected := range tests {
+		summary, err := g.daySummary(nil, nil, nil, format)
+		if err != nil || summary != expected {
+			t.Errorf("%s: expected %q, got %q (%v)", format, expected, summary, err)
+		}
+	}
+
+	// An answer that fails validation falls back to the free-text summary with a note
+	g.summarizer = structuredSummarizer{themedSummarizer{err: errors.New(`field "blockers" missing in the LLM response`)}}
+	summary, err := g.daySummary(nil, nil, nil, FormatConsole)
+	if err != nil || summary != "I worked on certificates & releases." {
+		t.Errorf("expected the free-text summary, got %q (%v)", summary, err)
+	}
+	if len(g.warnings) != 1 || !strings.Contains(g.warnings[0].Message, "the AI summary is free text") {
+		t.Errorf("expected a note about the structured summary, got %v", g.warnings)
+	}
+}
@@ -0,0 +1,160 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"my-day/internal/jira"
+)
+
+// summaryRow is a row of the console summary table: the issues of a status or project with
+// their comments and the time logged on them
+type summaryRow struct {
+	label    string
+	issues   int
+	comments int
+	seconds  int
+}
+
+// formatSummaryTable renders the console summary as aligned tables of issues, comments and time
+// logged by status and by project, followed by the worklog and meeting totals
+func (g *Generator) formatSummaryTable(issues []jira.Issue, commentsMap map[string][]jira.Comment, worklogs []jira.WorklogEntry) string {
+	keys := make(map[string]string, len(issues))
+	for _, issue := range issues {
+		keys[issue.ID] = issue.Key
+	}
+	issueSeconds := make(map[string]int)
+	for _, worklog := range worklogs {
+		key := worklog.IssueID
+		if k, ok := keys[key]; ok {
+			key = k
+		}
+		issueSeconds[key] += worklog.TimeSpentSeconds
+	}
+
+	statusRows := make(map[string]*summaryRow)
+	projectRows := make(map[string]*summaryRow)
+	for group, groupIssues := range groupIssuesByStatus(issues) {
+		row := &summaryRow{label: group}
+		for _, issue := range groupIssues {
+			row.issues++
+			row.comments += len(commentsMap[issue.Key])
+			row.seconds += issueSeconds[issue.Key]
+
+			project := issue.Fields.Project.Key
+			if project == "" {
+				project = "Other"
+				if match := issueKeyPattern.FindStringSubmatch(issue.Key); match != nil {
+					project = match[1]
+				}
+			}
+			if projectRows[project] == nil {
+				projectRows[project] = &summaryRow{label: project}
+			}
+			projectRows[project].issues++
+			projectRows[project].comments += len(commentsMap[issue.Key])
+		}
+		statusRows[group] = row
+	}
+
+	// Time logged on issues outside the report, e.g. time tracker projects, still counts per project
+	_, byProject, byDay := worklogTotals(worklogs, issues)
+	totalSeconds := 0
+	for _, project := range byProject {
+		if projectRows[project.Label] == nil {
+			projectRows[project.Label] = &summaryRow{label: project.Label}
+		}
+		projectRows[project.Label].seconds = project.Seconds
+		totalSeconds += project.Seconds
+	}
+
+	var byStatus []summaryRow
+	total := summaryRow{label: "Total"}
+	for _, group := range []string{"In Progress", "Done", "To Do", "Other"} {
+		if row := statusRows[group]; row != nil {
+			byStatus = append(byStatus, *row)
+			total.issues += row.issues
+			total.comments += row.comments
+		}
+	}
+
+	var byProjectRows []summaryRow
+	for _, row := range projectRows {
+		byProjectRows = append(byProjectRows, *row)
+	}
+	sort.Slice(byProjectRows, func(i, j int) bool {
+		if byProjectRows[i].issues != byProjectRows[j].issues {
+			return byProjectRows[i].issues > byProjectRows[j].issues
+		}
+		return byProjectRows[i].label < byProjectRows[j].label
+	})
+
+	cells := func(row summaryRow) []string {
+		return []string{row.label, strconv.Itoa(row.issues), strconv.Itoa(row.comments), summaryTime(row.seconds)}
+	}
+	var rows [][]string
+	for _, row := range byStatus {
+		rows = append(rows, cells(row))
+	}
+	totalTime := "-"
+	if totalSeconds > 0 {
+		totalTime = g.formatLogged(totalSeconds, len(byDay))
+	}
+	rows = append(rows, []string{total.label, strconv.Itoa(total.issues), strconv.Itoa(total.comments), totalTime})
+
+	if len(byProjectRows) > 0 {
+		// A row of empty cells keeps both tables in the same columns
+		rows = append(rows, []string{"", "", "", ""}, []string{"PROJECT", "ISSUES", "COMMENTS", "TIME"})
+		for _, row := range byProjectRows {
+			rows = append(rows, cells(row))
+		}
+	}
+
+	// lipgloss measures cells in terminal columns, so emoji and wide runes in project names
+	// keep the columns aligned
+	cellStyle := lipgloss.NewStyle().PaddingRight(2)
+	rendered := table.New().
+		Border(lipgloss.HiddenBorder()).
+		BorderTop(false).BorderBottom(false).BorderLeft(false).BorderRight(false).
+		BorderHeader(false).BorderColumn(false).
+		StyleFunc(func(_, _ int) lipgloss.Style { return cellStyle }).
+		Headers("STATUS", "ISSUES", "COMMENTS", "TIME").
+		Rows(rows...).
+		Render()
+
+	var result strings.Builder
+	for _, line := range strings.Split(rendered, "\n") {
+		if line = strings.TrimRight(line, " "); line != "" {
+			line = "  " + line
+		}
+		result.WriteString(line + "\n")
+	}
+	result.WriteString(fmt.Sprintf("  Worklog entries: %d\n", len(worklogs)))
+	result.WriteString(g.formatMeetingsSummary("  "))
+	return result.String()
+}
+
+// summaryTime returns time logged for the summary table, "-" for none
+func summaryTime(seconds int) string {
+	if seconds <= 0 {
+		return "-"
+	}
+	return formatTrackedTime(time.Duration(seconds) * time.Second)
+}
+
+// SummaryTable returns the console summary table of the report for the issues and worklogs,
+// for a quick overview without the rest of the report
+func (g *Generator) SummaryTable(issuesWithComments []IssueWithComments, worklogs []jira.WorklogEntry, targetDate time.Time) string {
+	var issues []jira.Issue
+	commentsMap := make(map[string][]jira.Comment)
+	for _, iwc := range issuesWithComments {
+		issues = append(issues, iwc.Issue)
+		commentsMap[iwc.Issue.Key] = iwc.Comments
+	}
+	return g.formatSummaryTable(g.filterIssues(issues, targetDate), commentsMap, g.filterWorklogs(worklogs, targetDate))
+}
@@ -0,0 +1,68 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func TestSummaryTable(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+	issue := func(id, key, category string) IssueWithComments {
+		return IssueWithComments{Issue: jira.Issue{ID: id, Key: key, Fields: jira.Fields{
+			Summary: "Issue " + key,
+			Status:  jira.Status{Category: jira.StatusCategory{Key: category}},
+			Updated: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)},
+		}}}
+	}
+	inProgress := issue("10001", "OPS-1", "indeterminate")
+	inProgress.Comments = []jira.Comment{{ID: "1"}, {ID: "2"}}
+	issues := []IssueWithComments{inProgress, issue("10002", "OPS-2", "done"), issue("10003", "INT-1", "new")}
+	worklogs := []jira.WorklogEntry{
+		{IssueID: "10001", Started: jira.JiraTime{Time: targetDate.Add(9 * time.Hour)}, TimeSpentSeconds: 5400},
+		{IssueID: "INTERNAL", Started: jira.JiraTime{Time: targetDate.Add(14 * time.Hour)}, TimeSpentSeconds: 1800}, // Time tracker project
+	}
+
+	g := &Generator{config: &Config{IncludeToday: true, WorkdayHours: 8}}
+	expected := `  STATUS       ISSUES  COMMENTS  TIME
+  In Progress  1       2         1h 30m
+  Done         1       0         -
+  To Do        1       0         -
+  Total        3       2         2h (25% of 8h)
+
+  PROJECT      ISSUES  COMMENTS  TIME
+  OPS          2       2         1h 30m
+  INT          1       0         -
+  INTERNAL     0       0         30m
+  Worklog entries: 2
+`
+	if got := g.SummaryTable(issues, worklogs, targetDate); got != expected {
+		t.Errorf("unexpected summary table:\n%s\nexpected:\n%s", got, expected)
+	}
+
+	if got := g.SummaryTable(nil, nil, targetDate); got != "  STATUS  ISSUES  COMMENTS  TIME\n  Total   0       0         -\n  Worklog entries: 0\n" {
+		t.Errorf("unexpected empty summary table:\n%s", got)
+	}
+}
+
+func TestSummaryTableAlignsWideRunes(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+	worklogs := []jira.WorklogEntry{ // Time tracker projects
+		{IssueID: "🚀 Launch", Started: jira.JiraTime{Time: targetDate.Add(9 * time.Hour)}, TimeSpentSeconds: 3600},
+		{IssueID: "開発", Started: jira.JiraTime{Time: targetDate.Add(11 * time.Hour)}, TimeSpentSeconds: 1800},
+	}
+
+	g := &Generator{config: &Config{IncludeToday: true, WorkdayHours: 8}}
+	expected := `  STATUS     ISSUES  COMMENTS  TIME
+  Total      0       0         1h 30m (19% of 8h)
+
+  PROJECT    ISSUES  COMMENTS  TIME
+  開発       0       0         30m
+  🚀 Launch  0       0         1h
+  Worklog entries: 2
+`
+	if got := g.SummaryTable(nil, worklogs, targetDate); got != expected {
+		t.Errorf("unexpected summary table:\n%s\nexpected:\n%s", got, expected)
+	}
+}
@@ -0,0 +1,177 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// TeamMember is a member of the team and the issues assigned to them, with their comments
+type TeamMember struct {
+	User   jira.User
+	Issues []IssueWithComments
+}
+
+// name returns how the report refers to a team member
+func (m TeamMember) name() string {
+	if m.User.DisplayName != "" {
+		return m.User.DisplayName
+	}
+	return jira.TeamMemberID(m.User)
+}
+
+// teamMemberReport is the activity of a team member as rendered in the team report
+type teamMemberReport struct {
+	name          string
+	statusGroups  map[string][]jira.Issue
+	issueCount    int
+	commentCounts map[string]int // issue key -> comments the member made
+	summary       string
+}
+
+// GenerateTeam creates a report of the activity of each team member since the given date, with
+// an optional LLM summary per person
+func (g *Generator) GenerateTeam(members []TeamMember, targetDate time.Time) (string, error) {
+	reports := make([]teamMemberReport, 0, len(members))
+	totalIssues, totalComments := 0, 0
+	for _, member := range members {
+		trackComments(g.summarizer, member.Issues)
+		memberReport := teamMemberReport{name: member.name(), commentCounts: make(map[string]int)}
+
+		var issues []jira.Issue
+		var comments []jira.Comment
+		for _, iwc := range member.Issues {
+			issues = append(issues, iwc.Issue)
+			comments = append(comments, iwc.Comments...)
+			memberReport.commentCounts[iwc.Issue.Key] = len(iwc.Comments)
+		}
+		memberReport.statusGroups = groupIssuesByStatus(issues)
+		memberReport.issueCount = len(issues)
+		totalIssues += len(issues)
+		totalComments += len(comments)
+
+		if g.config.LLMEnabled && len(issues) > 0 {
+			summary, err := g.summarizer.GenerateStandupSummaryWithComments(issues, comments, nil)
+			if err != nil {
+				g.warnLLM(fmt.Sprintf("Summarizing the work of %s", memberReport.name), err)
+			} else {
+				memberReport.summary = summary
+			}
+		}
+		reports = append(reports, memberReport)
+	}
+
+	switch g.config.Format {
+	case FormatMarkdown:
+		return g.generateTeamMarkdown(reports, targetDate, totalIssues, totalComments), nil
+	case FormatHTML:
+		return "", fmt.Errorf("team report supports console and markdown formats, not html")
+	default:
+		return g.generateTeamConsole(reports, targetDate, totalIssues, totalComments), nil
+	}
+}
+
+// teamStatusSections are the status groups of a team member shown in the team report, in order
+func (g *Generator) teamStatusSections() []struct{ group, heading string } {
+	sections := []struct{ group, heading string }{
+		{"In Progress", "🔄 In Progress"},
+		{"Done", "✅ Done"},
+	}
+	if !g.config.Hide.ToDo {
+		sections = append(sections, struct{ group, heading string }{"To Do", "📋 To Do"})
+	}
+	return append(sections, struct{ group, heading string }{"Other", "📌 Other"})
+}
+
+func teamTitle(targetDate time.Time) string {
+	return fmt.Sprintf("Team Report - %s", targetDate.Format("January 2, 2006"))
+}
+
+func (g *Generator) generateTeamConsole(reports []teamMemberReport, targetDate time.Time, issueCount, commentCount int) string {
+	var report strings.Builder
+
+	report.WriteString(fmt.Sprintf("👥 %s\n", teamTitle(targetDate)))
+	report.WriteString(strings.Repeat("=", 50) + "\n\n")
+
+	report.WriteString("📊 Summary:\n")
+	report.WriteString(fmt.Sprintf("  Team members: %d\n", len(reports)))
+	report.WriteString(fmt.Sprintf("  Issues worked on: %d\n", issueCount))
+	report.WriteString(fmt.Sprintf("  Comments added: %d\n\n", commentCount))
+
+	for _, member := range reports {
+		report.WriteString(fmt.Sprintf("👤 %s (%d issues)\n", member.name, member.issueCount))
+		if member.issueCount == 0 {
+			report.WriteString("  No activity\n\n")
+			continue
+		}
+		if member.summary != "" {
+			report.WriteString(fmt.Sprintf("  🤖 %s\n", indentContinuation(member.summary, "     ")))
+		}
+		for _, section := range g.teamStatusSections() {
+			issues := member.statusGroups[section.group]
+			if len(issues) == 0 {
+				continue
+			}
+			report.WriteString(fmt.Sprintf("  %s:\n", section.heading))
+			for _, issue := range issues {
+				report.WriteString(fmt.Sprintf("    • %s %s", issue.Key, issue.Fields.Summary))
+				if count := member.commentCounts[issue.Key]; count > 0 {
+					report.WriteString(fmt.Sprintf(" (%d comments)", count))
+				}
+				report.WriteString("\n")
+			}
+		}
+		report.WriteString("\n")
+	}
+
+	if !g.config.Hide.Footer {
+		report.WriteString("---\n")
+		report.WriteString("Generated by my-day CLI 🤖\n")
+	}
+	return report.String()
+}
+
+func (g *Generator) generateTeamMarkdown(reports []teamMemberReport, targetDate time.Time, issueCount, commentCount int) string {
+	var report strings.Builder
+
+	report.WriteString(fmt.Sprintf("# %s\n\n", teamTitle(targetDate)))
+
+	report.WriteString("## Summary\n\n")
+	report.WriteString(fmt.Sprintf("- **Team members**: %d\n", len(reports)))
+	report.WriteString(fmt.Sprintf("- **Issues worked on**: %d\n", issueCount))
+	report.WriteString(fmt.Sprintf("- **Comments added**: %d\n\n", commentCount))
+
+	for _, member := range reports {
+		report.WriteString(fmt.Sprintf("## 👤 %s (%d issues)\n\n", member.name, member.issueCount))
+		if member.issueCount == 0 {
+			report.WriteString("_No activity_\n\n")
+			continue
+		}
+		if member.summary != "" {
+			report.WriteString(fmt.Sprintf("> 🤖 %s\n\n", strings.ReplaceAll(member.summary, "\n", "\n> ")))
+		}
+		for _, section := range g.teamStatusSections() {
+			issues := member.statusGroups[section.group]
+			if len(issues) == 0 {
+				continue
+			}
+			report.WriteString(fmt.Sprintf("### %s\n\n", section.heading))
+			for _, issue := range issues {
+				report.WriteString(fmt.Sprintf("- **[%s]** %s", issue.Key, issue.Fields.Summary))
+				if count := member.commentCounts[issue.Key]; count > 0 {
+					report.WriteString(fmt.Sprintf(" (%d comments)", count))
+				}
+				report.WriteString("\n")
+			}
+			report.WriteString("\n")
+		}
+	}
+
+	if !g.config.Hide.Footer {
+		report.WriteString("---\n")
+		report.WriteString("*Generated by my-day CLI*\n")
+	}
+	return report.String()
+}
@@ -0,0 +1,84 @@
+package report
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// teamSummarizer is an LLM that summarizes a person's work by their issues, or fails to when err
+// is set
+type teamSummarizer struct {
+	themedSummarizer
+}
+
+func (s teamSummarizer) GenerateStandupSummaryWithComments(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	return "Worked on " + issues[0].Key + ".", nil
+}
+
+// teamIssue returns an issue in a status category with its comments
+func teamIssue(key, summary, category string, comments int) IssueWithComments {
+	issue := jira.Issue{Key: key}
+	issue.Fields.Summary = summary
+	issue.Fields.Status.Category.Key = category
+	return IssueWithComments{Issue: issue, Comments: make([]jira.Comment, comments)}
+}
+
+func TestGenerateTeam(t *testing.T) {
+	members := []TeamMember{
+		{
+			User: jira.User{AccountID: "a1", DisplayName: "Ana"},
+			Issues: []IssueWithComments{
+				teamIssue("WEB-1", "Checkout page", "indeterminate", 2),
+				teamIssue("WEB-2", "Login fix", "done", 0),
+			},
+		},
+		{User: jira.User{AccountID: "b2"}},
+	}
+	date := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+
+	config := goldenConfig(FormatMarkdown)
+	config.LLMEnabled = true
+	g := NewGenerator(config)
+	g.summarizer = teamSummarizer{}
+
+	output, err := g.GenerateTeam(members, date)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"# Team Report - June 3, 2024",
+		"- **Team members**: 2\n- **Issues worked on**: 2\n- **Comments added**: 2",
+		"## 👤 Ana (2 issues)\n\n> 🤖 Worked on WEB-1.\n\n### 🔄 In Progress\n\n- **[WEB-1]** Checkout page (2 comments)\n\n### ✅ Done\n\n- **[WEB-2]** Login fix\n",
+		"## 👤 b2 (0 issues)\n\n_No activity_",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in:\n%s", want, output)
+		}
+	}
+
+	// A failed summary is reported and the person's issues are still listed
+	g = NewGenerator(goldenConfig(FormatConsole))
+	g.config.LLMEnabled = true
+	g.summarizer = teamSummarizer{themedSummarizer{err: errors.New("model not found")}}
+	output, err = g.GenerateTeam(members, date)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "👤 Ana (2 issues)\n  🔄 In Progress:\n    • WEB-1 Checkout page (2 comments)") {
+		t.Errorf("expected Ana's issues without a summary, got:\n%s", output)
+	}
+	if warnings := g.Warnings(); len(warnings) != 1 || !strings.Contains(warnings[0].Message, "Summarizing the work of Ana") {
+		t.Errorf("expected a warning about Ana's summary, got %v", warnings)
+	}
+
+	if _, err := NewGenerator(goldenConfig(FormatHTML)).GenerateTeam(members, date); err == nil {
+		t.Error("expected an error for the html format")
+	}
+}
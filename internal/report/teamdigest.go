@@ -0,0 +1,150 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+)
+
+// TeamDigest is a weekly summary of the completed work, blockers and risks of each team member
+type TeamDigest struct {
+	WeekStart time.Time
+	WeekEnd   time.Time
+	Members   []MemberDigest
+}
+
+// MemberDigest is the part of the team digest about one team member
+type MemberDigest struct {
+	Name      string
+	Completed []DigestItem
+	Blockers  []DigestItem
+	Risks     []DigestItem // Risks other than blockers
+}
+
+// BuildTeamDigest builds the team digest for the seven days ending on weekEnd from the stored
+// issues of each team member
+func BuildTeamDigest(members []TeamMember, weekEnd time.Time) *TeamDigest {
+	teamDigest := &TeamDigest{}
+	for _, member := range members {
+		digest := BuildDigest(member.Issues, nil, weekEnd)
+		teamDigest.WeekStart, teamDigest.WeekEnd = digest.WeekStart, digest.WeekEnd
+
+		memberDigest := MemberDigest{Name: member.name(), Completed: digest.Accomplishments}
+		for _, item := range digest.Risks {
+			if digestBlocked(item.Issue) {
+				memberDigest.Blockers = append(memberDigest.Blockers, item)
+			} else {
+				memberDigest.Risks = append(memberDigest.Risks, item)
+			}
+		}
+		teamDigest.Members = append(teamDigest.Members, memberDigest)
+	}
+	return teamDigest
+}
+
+// Title returns the team digest title
+func (d *TeamDigest) Title() string {
+	return fmt.Sprintf("Team Digest: %s - %s", d.WeekStart.Format("Jan 2"), d.WeekEnd.Format("Jan 2, 2006"))
+}
+
+// sections returns the sections of a team member's part of the digest
+func (m MemberDigest) sections() []digestSection {
+	return []digestSection{
+		{"Completed", m.Completed, "No issues completed this week."},
+		{"Blockers", m.Blockers, "No blockers."},
+		{"Risks", m.Risks, "No risks identified."},
+	}
+}
+
+// totals returns the completed issues, blockers and risks of the whole team
+func (d *TeamDigest) totals() (completed, blockers, risks int) {
+	for _, member := range d.Members {
+		completed += len(member.Completed)
+		blockers += len(member.Blockers)
+		risks += len(member.Risks)
+	}
+	return completed, blockers, risks
+}
+
+// Markdown renders the team digest as a markdown document
+func (d *TeamDigest) Markdown() string {
+	var result strings.Builder
+
+	completed, blockers, risks := d.totals()
+	result.WriteString(fmt.Sprintf("# %s\n\n", d.Title()))
+	result.WriteString(fmt.Sprintf("**%d** team members · **%d** completed · **%d** blockers · **%d** risks\n\n",
+		len(d.Members), completed, blockers, risks))
+
+	for _, member := range d.Members {
+		result.WriteString(fmt.Sprintf("## %s\n\n", member.Name))
+		for _, section := range member.sections() {
+			result.WriteString(fmt.Sprintf("### %s\n\n", section.heading))
+			if len(section.items) == 0 {
+				result.WriteString(fmt.Sprintf("_%s_\n\n", section.empty))
+				continue
+			}
+			for _, item := range section.items {
+				result.WriteString(fmt.Sprintf("- **%s** %s", item.Issue.Key, item.Issue.Fields.Summary))
+				if item.Note != "" {
+					result.WriteString(fmt.Sprintf(" — %s", item.Note))
+				}
+				result.WriteString("\n")
+			}
+			result.WriteString("\n")
+		}
+	}
+
+	result.WriteString("---\n*Generated by my-day CLI*\n")
+	return result.String()
+}
+
+// HTML renders the team digest as a self-contained HTML page
+func (d *TeamDigest) HTML() string {
+	var result strings.Builder
+
+	result.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n")
+	result.WriteString("<meta charset=\"utf-8\">\n")
+	result.WriteString(fmt.Sprintf("<title>%s</title>\n", html.EscapeString(d.Title())))
+	result.WriteString("<style>" + htmlStyles + "</style>\n")
+	result.WriteString("</head>\n<body>\n<main>\n")
+
+	completed, blockers, risks := d.totals()
+	result.WriteString(fmt.Sprintf("<h1>%s</h1>\n", html.EscapeString(d.Title())))
+	result.WriteString("<div class=\"stats\">\n")
+	result.WriteString(htmlStat(completed, "Completed"))
+	result.WriteString(htmlStat(blockers, "Blockers"))
+	result.WriteString(htmlStat(risks, "Risks"))
+	result.WriteString("</div>\n")
+
+	for _, member := range d.Members {
+		result.WriteString(fmt.Sprintf("<h2>%s</h2>\n", html.EscapeString(member.Name)))
+		for _, section := range member.sections() {
+			result.WriteString(fmt.Sprintf("<h3>%s</h3>\n", section.heading))
+			if len(section.items) == 0 {
+				result.WriteString(fmt.Sprintf("<p class=\"meta\">%s</p>\n", section.empty))
+				continue
+			}
+			result.WriteString("<ul>\n")
+			for _, item := range section.items {
+				result.WriteString(fmt.Sprintf("<li><span class=\"key\">%s</span> %s",
+					html.EscapeString(item.Issue.Key), html.EscapeString(item.Issue.Fields.Summary)))
+				if item.Note != "" {
+					result.WriteString(fmt.Sprintf(" <span class=\"meta\">— %s</span>", html.EscapeString(item.Note)))
+				}
+				result.WriteString("</li>\n")
+			}
+			result.WriteString("</ul>\n")
+		}
+	}
+
+	result.WriteString("<footer>Generated by my-day CLI</footer>\n")
+	result.WriteString("</main>\n</body>\n</html>\n")
+	return result.String()
+}
+
+// Email renders the team digest as an RFC 5322 message (.eml) with plain text and HTML
+// alternatives
+func (d *TeamDigest) Email(from string, to []string) string {
+	return emailMessage(from, to, d.Title(), d.Markdown(), d.HTML())
+}
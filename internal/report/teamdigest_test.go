@@ -0,0 +1,61 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func TestBuildTeamDigest(t *testing.T) {
+	weekEnd := time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)
+	issue := func(key, status, category string, updated time.Time) IssueWithComments {
+		return IssueWithComments{Issue: jira.Issue{Key: key, Fields: jira.Fields{
+			Summary: "Work on " + key,
+			Status:  jira.Status{Name: status, Category: jira.StatusCategory{Key: category}},
+			Updated: jira.JiraTime{Time: updated},
+		}}}
+	}
+	members := []TeamMember{
+		{
+			User: jira.User{AccountID: "a1", DisplayName: "Ana"},
+			Issues: []IssueWithComments{
+				issue("WEB-1", "Done", "done", weekEnd.Add(-24*time.Hour)),
+				issue("WEB-2", "Blocked", "indeterminate", weekEnd),
+				issue("WEB-3", "In Progress", "indeterminate", weekEnd.Add(-6*24*time.Hour)),
+			},
+		},
+		{User: jira.User{AccountID: "b2", DisplayName: "Ben"}},
+	}
+
+	digest := BuildTeamDigest(members, weekEnd)
+	if len(digest.Members) != 2 {
+		t.Fatalf("expected a digest per member, got %+v", digest.Members)
+	}
+	ana := digest.Members[0]
+	if len(ana.Completed) != 1 || len(ana.Blockers) != 1 || ana.Blockers[0].Issue.Key != "WEB-2" ||
+		len(ana.Risks) != 1 || ana.Risks[0].Issue.Key != "WEB-3" {
+		t.Errorf("expected WEB-1 completed, WEB-2 blocked and WEB-3 at risk, got %+v", ana)
+	}
+
+	markdown := digest.Markdown()
+	for _, want := range []string{
+		"# Team Digest: Jul 9 - Jul 15, 2024",
+		"**2** team members · **1** completed · **1** blockers · **1** risks",
+		"## Ana\n\n### Completed\n\n- **WEB-1** Work on WEB-1\n",
+		"### Blockers\n\n- **WEB-2** Work on WEB-2 — status is Blocked\n",
+		"## Ben\n\n### Completed\n\n_No issues completed this week._",
+	} {
+		if !strings.Contains(markdown, want) {
+			t.Errorf("expected %q in:\n%s", want, markdown)
+		}
+	}
+
+	email := digest.Email("me@example.com", []string{"boss@example.com"})
+	for _, want := range []string{"Subject: Team Digest: Jul 9 - Jul 15, 2024\r\n", "<h2>Ana</h2>", "<h3>Blockers</h3>"} {
+		if !strings.Contains(email, want) {
+			t.Errorf("email missing %q", want)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// TemplateData is the data a custom report template (report.template_path) is rendered with
+type TemplateData struct {
+	Date         time.Time
+	Issues       []TemplateIssue // Every issue in the report, in report order
+	Groups       []TemplateGroup // Issues by status (In Progress, Done, To Do), or by the --field/--group-by value
+	Worklogs     []jira.WorklogEntry
+	CommentCount int
+	TimeLogged   string // e.g. "6h 30m", empty without worklogs
+	Meetings     string // Time in attended meetings, empty without a calendar
+	Summary      string // AI summary of the day, empty without the LLM
+	Sections     string // Estimates, code activity, work log and the other registered sections, already rendered
+}
+
+// TemplateIssue is an issue in a custom report template. The Jira issue is embedded, so its
+// fields read as {{.Key}} and {{.Fields.Summary}}.
+type TemplateIssue struct {
+	jira.Issue
+	Comments []jira.Comment // Comments in the report window, oldest first
+}
+
+// TemplateGroup is a group of issues in a custom report template
+type TemplateGroup struct {
+	Name   string
+	Issues []TemplateIssue
+}
+
+// templateFuncs returns the functions available to custom report templates besides the built-in ones
+func (g *Generator) templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"statusIcon":   g.statusIcon,
+		"statusLabel":  g.StatusLabel,
+		"priorityIcon": getPriorityIcon,
+		"excerpt":      commentExcerpt,
+		"duration":     worklogDuration,
+		"upper":        strings.ToUpper,
+		"lower":        strings.ToLower,
+		"join":         strings.Join,
+		"indent": func(spaces int, text string) string {
+			return indentContinuation(text, strings.Repeat(" ", spaces))
+		},
+	}
+}
+
+// readReportTemplate reads the custom report template at path, expanding a leading ~
+func readReportTemplate(path string) ([]byte, error) {
+	if strings.HasPrefix(path, "~/") {
+		if homeDir, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(homeDir, path[2:])
+		}
+	}
+	return os.ReadFile(path)
+}
+
+// loadReportTemplate parses the custom report template at path
+func (g *Generator) loadReportTemplate(path string) (*template.Template, error) {
+	content, err := readReportTemplate(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read report template: %w", err)
+	}
+	tmpl, err := template.New(filepath.Base(path)).Funcs(g.templateFuncs()).Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse report template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// generateFromTemplate renders the report with the custom template in the configuration
+// instead of the built-in layout of the format
+func (g *Generator) generateFromTemplate(issues []jira.Issue, commentsMap map[string][]jira.Comment, worklogs []jira.WorklogEntry, targetDate time.Time) (string, error) {
+	tmpl, err := g.loadReportTemplate(g.config.TemplatePath)
+	if err != nil {
+		return "", err
+	}
+
+	data := TemplateData{
+		Date:     targetDate,
+		Worklogs: worklogs,
+		Meetings: g.config.MeetingsSummary,
+	}
+
+	byKey := make(map[string]TemplateIssue)
+	for _, issue := range issues {
+		templateIssue := TemplateIssue{Issue: issue, Comments: commentsMap[issue.Key]}
+		byKey[issue.Key] = templateIssue
+		data.Issues = append(data.Issues, templateIssue)
+		data.CommentCount += len(templateIssue.Comments)
+	}
+
+	if g.config.GroupByField != "" {
+		field := groupFields(g.config.GroupByField)[0]
+		fieldGroups := g.groupIssuesByField(issues, field)
+		for _, name := range g.sortedGroupNames(fieldGroups, field) {
+			data.Groups = append(data.Groups, templateGroup(name, fieldGroups[name], byKey))
+		}
+	} else {
+		statusGroups := groupIssuesByStatus(issues)
+		for _, name := range []string{"In Progress", "Done", "To Do"} {
+			if len(statusGroups[name]) > 0 {
+				data.Groups = append(data.Groups, templateGroup(name, statusGroups[name], byKey))
+			}
+		}
+	}
+
+	data.TimeLogged = strings.TrimSpace(g.formatTimeLogged("%s", worklogs))
+	data.Summary = g.templateSummary(issues, commentsMap, worklogs, targetDate)
+
+	format := FormatConsole
+	if g.config.Format == "markdown" {
+		format = FormatMarkdown
+	}
+	data.Sections = g.renderSections(SectionModel{TargetDate: targetDate, Issues: issues, Worklogs: worklogs, Comments: commentsMap}, format)
+
+	var report strings.Builder
+	if err := tmpl.Execute(&report, data); err != nil {
+		return "", fmt.Errorf("failed to render report template: %w", err)
+	}
+	return report.String(), nil
+}
+
+// templateGroup returns the group of issues with their comments
+func templateGroup(name string, issues []jira.Issue, byKey map[string]TemplateIssue) TemplateGroup {
+	group := TemplateGroup{Name: name}
+	for _, issue := range issues {
+		group.Issues = append(group.Issues, byKey[issue.Key])
+	}
+	return group
+}
+
+// templateSummary returns the AI summary of the day for templates, or "" without the LLM
+func (g *Generator) templateSummary(issues []jira.Issue, commentsMap map[string][]jira.Comment, worklogs []jira.WorklogEntry, targetDate time.Time) string {
+	if !g.config.LLMEnabled || g.config.Hide.AISummary {
+		return ""
+	}
+
+	var allComments []jira.Comment
+	for _, issue := range issues {
+		allComments = append(allComments, commentsMap[issue.Key]...)
+	}
+	allComments = g.withCodeActivityComments(allComments, targetDate)
+
+	var summary string
+	var err error
+	if hasMeaningfulComments(allComments) {
+		format := FormatConsole
+		if g.config.Format == "markdown" {
+			format = FormatMarkdown
+		}
+		summary, err = g.daySummary(issues, allComments, worklogs, format)
+	} else {
+		summary, err = g.summarizer.GenerateStandupSummary(issues, worklogs)
+	}
+	if err != nil {
+		g.warnLLM("Summarizing your day", err)
+		return ""
+	}
+	return summary
+}
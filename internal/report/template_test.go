@@ -0,0 +1,65 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+func TestGenerateWithCustomTemplate(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+	issue := func(key, summary, category string) jira.Issue {
+		return jira.Issue{Key: key, Fields: jira.Fields{
+			Summary: summary,
+			Status:  jira.Status{Name: map[string]string{"indeterminate": "In Progress", "done": "Done"}[category], Category: jira.StatusCategory{Key: category}},
+			Updated: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)},
+		}}
+	}
+	issues := []IssueWithComments{
+		{Issue: issue("OPS-1", "Rotate certificates", "indeterminate"), Comments: []jira.Comment{{
+			ID:      "1",
+			Body:    jira.JiraDescription{Text: "Rotated the staging certificates"},
+			Created: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)},
+		}}},
+		{Issue: issue("OPS-2", "Upgrade ingress controller", "done")},
+	}
+
+	path := filepath.Join(t.TempDir(), "standup.tmpl")
+	tmpl := `Standup {{.Date.Format "2006-01-02"}} ({{len .Issues}} issues, {{.CommentCount}} comments)
+{{range .Groups}}{{upper .Name}}
+{{range .Issues}}- {{statusIcon .Fields.Status.Name}} {{.Key}} {{.Fields.Summary}}
+{{range .Comments}}  > {{excerpt .Body.Text 15}}
+{{end}}{{end}}{{end}}`
+	if err := os.WriteFile(path, []byte(tmpl), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	g := &Generator{config: &Config{IncludeToday: true, TemplatePath: path}, summarizer: llm.NewDisabledSummarizer()}
+	content, err := g.GenerateWithComments(issues, nil, targetDate)
+	if err != nil {
+		t.Fatalf("failed to render template: %v", err)
+	}
+
+	expected := `Standup 2024-07-15 (2 issues, 1 comments)
+IN PROGRESS
+- 🔄 OPS-1 Rotate certificates
+  > Rotated the…
+DONE
+- ✅ OPS-2 Upgrade ingress controller
+`
+	if content != expected {
+		t.Errorf("unexpected report:\n%s\nexpected:\n%s", content, expected)
+	}
+
+	if err := os.WriteFile(path, []byte("{{range .Issues}}{{.Missing}}{{end}}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.GenerateWithComments(issues, nil, targetDate); err == nil || !strings.Contains(err.Error(), "failed to render report template") {
+		t.Errorf("expected a render error for an unknown field, got %v", err)
+	}
+}
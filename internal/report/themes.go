@@ -0,0 +1,78 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+// daySummary returns the AI summary of the day rendered for the format. With report.structured
+// the LLM answers in JSON and the summary is rendered part by part; with report.themes the LLM
+// first groups the day's work into 2-4 themes and the summary is structured around them. When
+// it can't, the report falls back to the plain summary.
+func (g *Generator) daySummary(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry, format string) (string, error) {
+	if g.config.Structured {
+		structured, err := g.summarizer.GenerateStructuredSummary(issues, comments, worklogs)
+		if err == nil && structured != nil {
+			return formatStructuredSummary(structured, format), nil
+		}
+		if err != nil {
+			g.warnings.Add(SeverityInfo, "LLM", "The LLM's structured summary was not valid, the AI summary is free text: %v", err)
+		}
+	}
+	if g.config.Themes {
+		themes, err := g.summarizer.GenerateThemes(issues, comments, worklogs)
+		if err == nil && len(themes) > 0 {
+			return formatThemes(themes, format), nil
+		}
+		if err != nil {
+			g.warnings.Add(SeverityInfo, "LLM", "Grouping your day into themes failed, the AI summary is not grouped: %v", err)
+		}
+	}
+
+	summary, err := g.summarizer.GenerateStandupSummaryWithComments(issues, comments, worklogs)
+	if format == FormatHTML {
+		summary = html.EscapeString(summary)
+	}
+	return summary, err
+}
+
+// formatThemes renders the themes of the day, each with its issues and summary
+func formatThemes(themes []llm.Theme, format string) string {
+	var result strings.Builder
+	switch format {
+	case FormatHTML:
+		result.WriteString("<ul class=\"themes\">\n")
+		for _, theme := range themes {
+			result.WriteString(fmt.Sprintf("<li><strong>%s</strong>%s: %s</li>\n",
+				html.EscapeString(theme.Name), html.EscapeString(themeIssues(theme)), html.EscapeString(theme.Summary)))
+		}
+		result.WriteString("</ul>")
+	case FormatMarkdown:
+		for i, theme := range themes {
+			if i > 0 {
+				result.WriteString("\n")
+			}
+			result.WriteString(fmt.Sprintf("- **%s**%s: %s", theme.Name, themeIssues(theme), theme.Summary))
+		}
+	default:
+		for i, theme := range themes {
+			if i > 0 {
+				result.WriteString("\n")
+			}
+			result.WriteString(fmt.Sprintf("▸ %s%s\n  %s", theme.Name, themeIssues(theme), theme.Summary))
+		}
+	}
+	return result.String()
+}
+
+// themeIssues returns the issue keys of a theme in parentheses, or "" when it lists none
+func themeIssues(theme llm.Theme) string {
+	if len(theme.Issues) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(theme.Issues, ", ") + ")"
+}
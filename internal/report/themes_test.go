@@ -0,0 +1,83 @@
+package report
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+// themedSummarizer is an LLM that groups the day into fixed themes, or fails to when err is set
+type themedSummarizer struct {
+	*llm.DisabledSummarizer
+	err error
+}
+
+func (s themedSummarizer) GenerateThemes(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) ([]llm.Theme, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return []llm.Theme{
+		{Name: "IAM cleanup", Issues: []string{"OPS-1"}, Summary: "I removed unused service accounts."},
+		{Name: "Release <2.14>", Summary: "I cut the release branch."},
+	}, nil
+}
+
+func (themedSummarizer) GenerateStandupSummaryWithComments(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (string, error) {
+	return "I worked on certificates & releases.", nil
+}
+
+func TestDaySummaryThemes(t *testing.T) {
+	g := &Generator{config: &Config{Themes: true}, summarizer: themedSummarizer{}}
+
+	tests := map[string]string{
+		FormatConsole:  "▸ IAM cleanup (OPS-1)\n  I removed unused service accounts.\n▸ Release <2.14>\n  I cut the release branch.",
+		FormatMarkdown: "- **IAM cleanup** (OPS-1): I removed unused service accounts.\n- **Release <2.14>**: I cut the release branch.",
+		FormatHTML:     "<ul class=\"themes\">\n<li><strong>IAM cleanup</strong> (OPS-1): I removed unused service accounts.</li>\n<li><strong>Release &lt;2.14&gt;</strong>: I cut the release branch.</li>\n</ul>",
+	}
+	for format, expected := range tests {
+		summary, err := g.daySummary(nil, nil, nil, format)
+		if err != nil || summary != expected {
+			t.Errorf("%s: expected %q, got %q (%v)", format, expected, summary, err)
+		}
+	}
+
+	// Without themes, or when the LLM can't group the day, the plain summary is used
+	g.summarizer = themedSummarizer{err: errors.New("no themes found in the LLM response")}
+	summary, err := g.daySummary(nil, nil, nil, FormatHTML)
+	if err != nil || summary != "I worked on certificates &amp; releases." {
+		t.Errorf("expected the plain summary, got %q (%v)", summary, err)
+	}
+	if len(g.warnings) != 1 || !strings.Contains(g.warnings[0].Message, "the AI summary is not grouped") {
+		t.Errorf("expected a note about the themes, got %v", g.warnings)
+	}
+
+	g.config = &Config{LLMEnabled: true, StrictLLM: true}
+	if err := g.strictLLMError(); err != nil {
+		t.Errorf("a summary without themes should not fail strict mode: %v", err)
+	}
+}
+
+func TestReportWithThemes(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+	issues := []IssueWithComments{{
+		Issue: jira.Issue{Key: "OPS-1", Fields: jira.Fields{Summary: "Remove unused service accounts", Status: jira.Status{Name: "In Progress", Category: jira.StatusCategory{Key: "indeterminate"}}, Updated: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)}}},
+		Comments: []jira.Comment{{
+			ID:      "1",
+			Body:    jira.JiraDescription{Text: "Removed the unused service accounts from the staging and production projects"},
+			Created: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)},
+		}},
+	}}
+
+	g := &Generator{config: &Config{Format: "markdown", LLMEnabled: true, IncludeToday: true, Themes: true}, summarizer: themedSummarizer{}}
+	content, err := g.GenerateWithComments(issues, nil, targetDate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(content, "## 🤖 AI Summary of Today's Work\n\n- **IAM cleanup** (OPS-1): I removed unused service accounts.\n") {
+		t.Errorf("expected the themed summary in the report, got:\n%s", content)
+	}
+}
@@ -0,0 +1,21 @@
+package report
+
+import (
+	"strings"
+
+	"my-day/internal/jira"
+)
+
+// statusChanges lists the status changes of an issue in the report, e.g. "In Review → Done at
+// 15:20", with the day in front for a multi-day report. It returns "" for an issue without any.
+func (g *Generator) statusChanges(issue jira.Issue) string {
+	layout := "15:04"
+	if g.isMultiDay() {
+		layout = "Jan 2 15:04"
+	}
+	changes := make([]string, 0, len(issue.Transitions))
+	for _, transition := range issue.Transitions {
+		changes = append(changes, transition.Format(layout))
+	}
+	return strings.Join(changes, ", ")
+}
@@ -0,0 +1,32 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func TestStatusChangesInIssueLines(t *testing.T) {
+	issue := jira.Issue{Key: "OPS-1", Transitions: []jira.StatusTransition{
+		{From: "In Progress", To: "In Review", At: jira.JiraTime{Time: time.Date(2024, 6, 3, 11, 0, 0, 0, time.UTC)}},
+		{From: "In Review", To: "Done", At: jira.JiraTime{Time: time.Date(2024, 6, 3, 15, 20, 0, 0, time.UTC)}},
+	}}
+	issue.Fields.Summary = "Upgrade the ingress controller"
+
+	g := NewGenerator(&Config{})
+	console := g.formatIssueConsoleWithComments(issue, nil)
+	if !strings.Contains(console, "🔀 Status changes today: In Progress → In Review at 11:00, In Review → Done at 15:20\n") {
+		t.Errorf("expected the status changes in the console line, got:\n%s", console)
+	}
+	markdown := g.formatIssueMarkdownWithComments(issue, nil)
+	if !strings.Contains(markdown, "- 🔀 **Status changes today**: In Progress → In Review at 11:00") {
+		t.Errorf("expected the status changes in the markdown line, got:\n%s", markdown)
+	}
+
+	issue.Transitions = nil
+	if console := g.formatIssueConsoleWithComments(issue, nil); strings.Contains(console, "Status changes") {
+		t.Errorf("expected no status changes line without transitions, got:\n%s", console)
+	}
+}
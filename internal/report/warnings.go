@@ -0,0 +1,153 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// PriorityNotes puts the notes about the report after every other section
+const PriorityNotes = 1000
+
+// Severity is how much a warning affects the report
+type Severity string
+
+const (
+	SeverityInfo    Severity = "info"    // Nothing is missing, e.g. skipped restricted issues
+	SeverityWarning Severity = "warning" // Part of the report is missing or degraded, e.g. a failed sync
+	SeverityError   Severity = "error"   // The report is likely wrong or incomplete
+)
+
+// severityIcons are the icons the notes are listed with
+var severityIcons = map[Severity]string{
+	SeverityInfo:    "ℹ️",
+	SeverityWarning: "⚠️",
+	SeverityError:   "❌",
+}
+
+// Warning is a non-fatal problem found while syncing or generating a report, such as a partial
+// sync or an LLM summary that fell back to the raw comments
+type Warning struct {
+	Severity Severity `json:"severity"`
+	Source   string   `json:"source"` // Subsystem the warning comes from, e.g. "GitHub" or "LLM"
+	Message  string   `json:"message"`
+}
+
+// String returns the warning as a single line, e.g. "⚠️  GitHub: failed to fetch activity"
+func (w Warning) String() string {
+	return fmt.Sprintf("%s  %s: %s", w.Severity.icon(), w.Source, w.Message)
+}
+
+func (s Severity) icon() string {
+	if icon, ok := severityIcons[s]; ok {
+		return icon
+	}
+	return severityIcons[SeverityWarning]
+}
+
+// Warnings collects the warnings of a sync or report
+type Warnings []Warning
+
+// Add records a warning. A warning identical to one already recorded is dropped, so a
+// failure repeated for every issue is only listed once.
+func (w *Warnings) Add(severity Severity, source, format string, args ...interface{}) {
+	w.add(Warning{Severity: severity, Source: source, Message: fmt.Sprintf(format, args...)})
+}
+
+// Merge records the warnings of other that are not already recorded
+func (w *Warnings) Merge(other Warnings) {
+	for _, warning := range other {
+		w.add(warning)
+	}
+}
+
+func (w *Warnings) add(warning Warning) {
+	for _, existing := range *w {
+		if existing == warning {
+			return
+		}
+	}
+	*w = append(*w, warning)
+}
+
+func init() {
+	RegisterSection(NewSection("⚠️ Notes about this report", PriorityNotes, func(model SectionModel, format string) string {
+		return formatWarnings(model.generator.Warnings(), format)
+	}))
+}
+
+// Warnings returns the warnings of the last report generated: those passed in the
+// configuration, such as from the sync, followed by those found while generating it, including
+// the summaries the embedded fallback wrote because the LLM backend did not answer
+func (g *Generator) Warnings() Warnings {
+	var warnings Warnings
+	for _, warning := range g.config.Warnings {
+		warnings.add(warning)
+	}
+	for _, warning := range g.warnings {
+		warnings.add(warning)
+	}
+	if fallbacks := g.llmFallbacks() - g.fallbacksAtStart; g.config.LLMEnabled && fallbacks > 0 {
+		warnings.add(Warning{Severity: SeverityWarning, Source: "LLM",
+			Message: fmt.Sprintf("The %s backend did not answer, so the embedded model wrote %d of the AI summaries", g.config.LLMMode, fallbacks)})
+	}
+	return warnings
+}
+
+// warnLLM records that the LLM failed to write a summary and the report fell back without it
+func (g *Generator) warnLLM(what string, err error) {
+	g.warnings.Add(SeverityWarning, "LLM", "%s failed, the report falls back to your comments: %v", what, err)
+}
+
+// LLMFallback returns the warning of the LLM failure the last report fell back from without an
+// AI summary, and false when the LLM is disabled or wrote the summary
+func (g *Generator) LLMFallback() (Warning, bool) {
+	if !g.config.LLMEnabled {
+		return Warning{}, false
+	}
+	for _, warning := range g.Warnings() {
+		if warning.Source == "LLM" && warning.Severity != SeverityInfo {
+			return warning, true
+		}
+	}
+	return Warning{}, false
+}
+
+// strictLLMError returns an error when the last report fell back without an AI summary and the
+// configuration asks for strict mode, so that a degraded report is not exported or posted
+func (g *Generator) strictLLMError() error {
+	if !g.config.StrictLLM {
+		return nil
+	}
+	if warning, ok := g.LLMFallback(); ok {
+		return fmt.Errorf("the AI summary is unavailable and llm.fallback_strategy is strict: %s", warning.Message)
+	}
+	return nil
+}
+
+// formatWarnings renders the warnings as a compact list
+func formatWarnings(warnings Warnings, format string) string {
+	if len(warnings) == 0 {
+		return ""
+	}
+
+	var result strings.Builder
+	switch format {
+	case FormatHTML:
+		result.WriteString("<ul class=\"notes\">\n")
+		for _, warning := range warnings {
+			result.WriteString(fmt.Sprintf("<li class=\"%s\">%s <strong>%s:</strong> %s</li>\n",
+				warning.Severity, warning.Severity.icon(), html.EscapeString(warning.Source), html.EscapeString(warning.Message)))
+		}
+		result.WriteString("</ul>\n")
+	case FormatMarkdown:
+		for _, warning := range warnings {
+			result.WriteString(fmt.Sprintf("- %s **%s:** %s\n", warning.Severity.icon(), warning.Source, warning.Message))
+		}
+	default:
+		for _, warning := range warnings {
+			result.WriteString(fmt.Sprintf("  %s\n", warning))
+		}
+	}
+	return result.String()
+}
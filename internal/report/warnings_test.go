@@ -0,0 +1,195 @@
+package report
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+	"my-day/internal/llm"
+)
+
+// failingSummarizer is an LLM that is down
+type failingSummarizer struct {
+	*llm.DisabledSummarizer
+}
+
+func (failingSummarizer) SummarizeComments(comments []jira.Comment) (string, error) {
+	return "", errors.New("connection refused")
+}
+
+func (failingSummarizer) GenerateStandupSummaryWithComments(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (string, error) {
+	return "", errors.New("connection refused")
+}
+
+func TestWarningsAddSkipsDuplicates(t *testing.T) {
+	var warnings Warnings
+	warnings.Add(SeverityWarning, "Jira", "Failed to fetch comments: %s", "timeout")
+	warnings.Add(SeverityWarning, "Jira", "Failed to fetch comments: %s", "timeout")
+	warnings.Add(SeverityInfo, "Jira", "Skipped 1 restricted issues you can no longer view: SEC-1")
+
+	if len(warnings) != 2 {
+		t.Fatalf("expected 2 warnings, got %v", warnings)
+	}
+	if got := warnings[1].String(); got != "ℹ️  Jira: Skipped 1 restricted issues you can no longer view: SEC-1" {
+		t.Errorf("unexpected warning line %q", got)
+	}
+}
+
+func TestReportNotesListSyncAndLLMWarnings(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+	issues := []IssueWithComments{{
+		Issue: jira.Issue{Key: "OPS-1", Fields: jira.Fields{Summary: "Rotate certificates", Status: jira.Status{Name: "In Progress", Category: jira.StatusCategory{Key: "indeterminate"}}, Updated: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)}}},
+		Comments: []jira.Comment{{
+			ID:      "1",
+			Body:    jira.JiraDescription{Text: "Rotated the staging certificates and updated the load balancer listeners"},
+			Created: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)},
+		}},
+	}}
+
+	config := &Config{
+		Format:       "markdown",
+		LLMEnabled:   true,
+		IncludeToday: true,
+		Warnings:     Warnings{{Severity: SeverityWarning, Source: "GitHub", Message: "Failed to fetch GitHub activity: 401"}},
+	}
+	g := &Generator{config: config, summarizer: failingSummarizer{}}
+
+	content, err := g.GenerateWithComments(issues, nil, targetDate)
+	if err != nil {
+		t.Fatalf("GenerateWithComments() error = %v", err)
+	}
+	notes := "## ⚠️ Notes about this report\n\n" +
+		"- ⚠️ **GitHub:** Failed to fetch GitHub activity: 401\n" +
+		"- ⚠️ **LLM:** Summarizing your day failed, the report falls back to your comments: connection refused\n" +
+		"- ⚠️ **LLM:** Summarizing issues failed, the report falls back to your comments: connection refused\n"
+	if !strings.Contains(content, notes) {
+		t.Errorf("expected the notes in the report, got:\n%s", content)
+	}
+	if len(g.Warnings()) != 3 {
+		t.Errorf("expected 3 warnings, got %v", g.Warnings())
+	}
+
+	// Warnings from generating a report are not carried over to the next one
+	config.LLMEnabled = false
+	config.Warnings = nil
+	if content, _ := g.GenerateWithComments(issues, nil, targetDate); strings.Contains(content, "Notes about this report") {
+		t.Errorf("expected no notes without warnings, got:\n%s", content)
+	}
+}
+
+func TestStrictLLMFailsDegradedReport(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+	issues := []IssueWithComments{{
+		Issue: jira.Issue{Key: "OPS-1", Fields: jira.Fields{Summary: "Rotate certificates", Status: jira.Status{Name: "In Progress", Category: jira.StatusCategory{Key: "indeterminate"}}, Updated: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)}}},
+		Comments: []jira.Comment{{
+			ID:      "1",
+			Body:    jira.JiraDescription{Text: "Rotated the staging certificates and updated the load balancer listeners"},
+			Created: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)},
+		}},
+	}}
+
+	config := &Config{Format: "markdown", LLMEnabled: true, StrictLLM: true, IncludeToday: true}
+	g := &Generator{config: config, summarizer: failingSummarizer{}}
+	content, err := g.GenerateWithCommentsAndCache(issues, nil, targetDate, false)
+	if err == nil || !strings.Contains(err.Error(), "llm.fallback_strategy is strict: Summarizing your day failed") {
+		t.Fatalf("expected a strict mode error, got %v", err)
+	}
+	if content != "" {
+		t.Errorf("expected no report in strict mode, got:\n%s", content)
+	}
+
+	// Without the LLM there is no summary to miss
+	config.LLMEnabled = false
+	if _, err := g.GenerateWithCommentsAndCache(issues, nil, targetDate, false); err != nil {
+		t.Errorf("expected strict mode to allow reports without the LLM, got %v", err)
+	}
+}
+
+// fallingBackSummarizer answers every summary with the embedded fallback, as a backend that is
+// down does
+type fallingBackSummarizer struct {
+	*llm.DisabledSummarizer
+	fallbacks int
+}
+
+func (f *fallingBackSummarizer) GenerateStandupSummaryWithComments(issues []jira.Issue, comments []jira.Comment, worklogs []jira.WorklogEntry) (string, error) {
+	f.fallbacks++
+	return "Rotated the staging certificates.", nil
+}
+
+func (f *fallingBackSummarizer) Fallbacks() int { return f.fallbacks }
+
+func TestStrictLLMRefusesUnreachableBackend(t *testing.T) {
+	targetDate := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+	issues := []IssueWithComments{{
+		Issue: jira.Issue{Key: "OPS-1", Fields: jira.Fields{Summary: "Rotate certificates", Status: jira.Status{Name: "In Progress", Category: jira.StatusCategory{Key: "indeterminate"}}, Updated: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)}}},
+		Comments: []jira.Comment{{
+			ID:      "1",
+			Body:    jira.JiraDescription{Text: "Rotated the staging certificates and updated the load balancer listeners"},
+			Created: jira.JiraTime{Time: targetDate.Add(10 * time.Hour)},
+		}},
+	}}
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	config := &Config{Format: "markdown", LLMEnabled: true, LLMMode: "ollama", OllamaURL: server.URL, OllamaModel: "qwen2.5:3b", StrictLLM: true, IncludeToday: true}
+	if got := newLLMConfig(config, "technical").FallbackStrategy; got != "strict" {
+		t.Errorf("expected the strict fallback strategy to reach the summarizer, got %q", got)
+	}
+
+	// The embedded LLM stands in for Ollama, which is down
+	g := &Generator{config: config, summarizer: llm.NewOllamaSummarizer(newLLMConfig(config, "technical"))}
+	_, err := g.GenerateWithCommentsAndCache(issues, nil, targetDate, false)
+	if err == nil || !strings.Contains(err.Error(), "The ollama backend is unavailable") {
+		t.Errorf("expected strict mode to refuse the stand-in, got %v", err)
+	}
+
+	// Ollama fails after the report started, and the embedded LLM answers instead
+	g = &Generator{config: config, summarizer: &fallingBackSummarizer{}}
+	_, err = g.GenerateWithCommentsAndCache(issues, nil, targetDate, false)
+	if err == nil || !strings.Contains(err.Error(), "the embedded model wrote 1 of the AI summaries") {
+		t.Errorf("expected strict mode to refuse the fallback summaries, got %v", err)
+	}
+
+	config.StrictLLM = false
+	content, err := g.GenerateWithCommentsAndCache(issues, nil, targetDate, false)
+	if err != nil || !strings.Contains(content, "did not answer, so the embedded model wrote") {
+		t.Errorf("expected the fallback in the report notes, got %v:\n%s", err, content)
+	}
+	if _, ok := g.LLMFallback(); !ok {
+		t.Error("expected the fallback to be reported")
+	}
+}
+
+func TestLLMFallback(t *testing.T) {
+	config := &Config{LLMEnabled: true, Warnings: Warnings{{Severity: SeverityInfo, Source: "LLM", Message: "summary trimmed"}}}
+	g := &Generator{config: config}
+	if _, ok := g.LLMFallback(); ok {
+		t.Error("expected no fallback for an informational LLM note")
+	}
+
+	g.warnLLM("Summarizing your day", errors.New("connection refused"))
+	warning, ok := g.LLMFallback()
+	if !ok || !strings.Contains(warning.Message, "connection refused") {
+		t.Errorf("expected the LLM failure, got %+v, %v", warning, ok)
+	}
+
+	config.LLMEnabled = false
+	if _, ok := g.LLMFallback(); ok {
+		t.Error("expected no fallback with the LLM disabled")
+	}
+}
+
+func TestFormatWarningsHTMLEscapes(t *testing.T) {
+	warnings := Warnings{{Severity: SeverityError, Source: "Jira", Message: "status <500>"}}
+	if got := formatWarnings(warnings, FormatHTML); got != "<ul class=\"notes\">\n<li class=\"error\">❌ <strong>Jira:</strong> status &lt;500&gt;</li>\n</ul>\n" {
+		t.Errorf("unexpected HTML %q", got)
+	}
+	if got := formatWarnings(nil, FormatConsole); got != "" {
+		t.Errorf("expected nothing without warnings, got %q", got)
+	}
+}
@@ -0,0 +1,223 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// WeekStart returns midnight on the Monday of the week containing date
+func WeekStart(date time.Time) time.Time {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	offset := (int(day.Weekday()) + 6) % 7 // Days since Monday
+	return day.AddDate(0, 0, -offset)
+}
+
+// weeklyDay is the activity of one working day in a weekly rollup
+type weeklyDay struct {
+	date     time.Time
+	issues   []jira.Issue
+	comments map[string][]jira.Comment // issue key -> comments made that day
+	worklogs []jira.WorklogEntry
+}
+
+// GenerateWeekly creates a Monday–Friday rollup report for the week starting at weekStart,
+// with activity grouped by day and an optional LLM narrative of the whole week
+func (g *Generator) GenerateWeekly(issuesWithComments []IssueWithComments, worklogs []jira.WorklogEntry, weekStart time.Time) (string, error) {
+	weekStart = WeekStart(weekStart)
+	trackComments(g.summarizer, issuesWithComments)
+	weekEnd := weekStart.AddDate(0, 0, 5) // Saturday 00:00, exclusive
+
+	days := make([]*weeklyDay, 5)
+	for i := range days {
+		days[i] = &weeklyDay{date: weekStart.AddDate(0, 0, i), comments: make(map[string][]jira.Comment)}
+	}
+	dayIndex := func(t time.Time) int {
+		if t.Before(weekStart) || !t.Before(weekEnd) {
+			return -1
+		}
+		local := t.In(weekStart.Location())
+		for i, day := range days {
+			if local.Year() == day.date.Year() && local.YearDay() == day.date.YearDay() {
+				return i
+			}
+		}
+		return -1
+	}
+
+	var weekIssues []jira.Issue
+	var weekComments []jira.Comment
+	for _, iwc := range issuesWithComments {
+		active := make(map[int]bool)
+		for _, comment := range iwc.Comments {
+			if i := dayIndex(comment.Created.Time); i >= 0 {
+				days[i].comments[iwc.Issue.Key] = append(days[i].comments[iwc.Issue.Key], comment)
+				weekComments = append(weekComments, comment)
+				active[i] = true
+			}
+		}
+		if i := dayIndex(iwc.Issue.Fields.Updated.Time); i >= 0 {
+			active[i] = true
+		}
+		if len(active) == 0 {
+			continue
+		}
+
+		weekIssues = append(weekIssues, iwc.Issue)
+		for i := range days {
+			if active[i] {
+				days[i].issues = append(days[i].issues, iwc.Issue)
+			}
+		}
+	}
+
+	var weekWorklogs []jira.WorklogEntry
+	for _, worklog := range worklogs {
+		if i := dayIndex(worklog.Started.Time); i >= 0 {
+			days[i].worklogs = append(days[i].worklogs, worklog)
+			weekWorklogs = append(weekWorklogs, worklog)
+		}
+	}
+	for _, day := range days {
+		sort.Slice(day.worklogs, func(i, j int) bool {
+			return day.worklogs[i].Started.Time.Before(day.worklogs[j].Started.Time)
+		})
+	}
+
+	var narrative string
+	if g.config.LLMEnabled && (len(weekComments) > 0 || len(weekWorklogs) > 0) {
+		if summary, err := g.summarizer.GenerateWeeklySummary(weekIssues, weekComments, weekWorklogs); err == nil {
+			narrative = summary
+		}
+	}
+
+	switch g.config.Format {
+	case "markdown":
+		return g.generateWeeklyMarkdown(days, weekIssues, len(weekComments), len(weekWorklogs), narrative), nil
+	case "html":
+		return "", fmt.Errorf("weekly report supports console and markdown formats, not html")
+	default:
+		return g.generateWeeklyConsole(days, weekIssues, len(weekComments), len(weekWorklogs), narrative), nil
+	}
+}
+
+func weeklyTitle(days []*weeklyDay) string {
+	return fmt.Sprintf("Weekly Report - %s to %s", days[0].date.Format("Jan 2"), days[len(days)-1].date.Format("Jan 2, 2006"))
+}
+
+func (g *Generator) generateWeeklyConsole(days []*weeklyDay, weekIssues []jira.Issue, commentCount, worklogCount int, narrative string) string {
+	var report strings.Builder
+
+	report.WriteString(fmt.Sprintf("📅 %s\n", weeklyTitle(days)))
+	report.WriteString(strings.Repeat("=", 50) + "\n\n")
+
+	if narrative != "" {
+		report.WriteString("🤖 AI Summary of the Week:\n")
+		report.WriteString(fmt.Sprintf("  %s\n\n", narrative))
+	}
+
+	report.WriteString("📊 Summary:\n")
+	report.WriteString(fmt.Sprintf("  Issues worked on: %d\n", len(weekIssues)))
+	report.WriteString(fmt.Sprintf("  Comments added: %d\n", commentCount))
+	report.WriteString(fmt.Sprintf("  Worklog entries: %d\n\n", worklogCount))
+
+	for _, day := range days {
+		report.WriteString(fmt.Sprintf("🗓️  %s\n", day.date.Format("Monday, January 2")))
+		if len(day.issues) == 0 && len(day.worklogs) == 0 {
+			report.WriteString("  No activity\n\n")
+			continue
+		}
+		for _, issue := range day.issues {
+			report.WriteString(fmt.Sprintf("  %s %s %s", g.statusIcon(issue.Fields.Status.Name), issue.Key, issue.Fields.Summary))
+			if count := len(day.comments[issue.Key]); count > 0 {
+				report.WriteString(fmt.Sprintf(" (%d comments)", count))
+			}
+			report.WriteString("\n")
+		}
+		for _, worklog := range day.worklogs {
+			report.WriteString(fmt.Sprintf("  ⏱️  %s %s", worklog.IssueID, worklog.Started.Time.Format("15:04")))
+			if worklog.Comment != "" {
+				report.WriteString(fmt.Sprintf(" - %s", worklog.Comment))
+			}
+			report.WriteString("\n")
+		}
+		report.WriteString("\n")
+	}
+
+	statusGroups := groupIssuesByStatus(weekIssues)
+	for _, section := range []struct{ group, heading string }{
+		{"Done", "✅ Completed This Week"},
+		{"In Progress", "🔄 Still In Progress"},
+	} {
+		if len(statusGroups[section.group]) == 0 {
+			continue
+		}
+		report.WriteString(fmt.Sprintf("%s:\n", section.heading))
+		for _, issue := range statusGroups[section.group] {
+			report.WriteString(fmt.Sprintf("  • %s %s\n", issue.Key, issue.Fields.Summary))
+		}
+		report.WriteString("\n")
+	}
+
+	return report.String()
+}
+
+func (g *Generator) generateWeeklyMarkdown(days []*weeklyDay, weekIssues []jira.Issue, commentCount, worklogCount int, narrative string) string {
+	var report strings.Builder
+
+	report.WriteString(fmt.Sprintf("# %s\n\n", weeklyTitle(days)))
+
+	if narrative != "" {
+		report.WriteString("## 🤖 AI Summary of the Week\n\n")
+		report.WriteString(fmt.Sprintf("%s\n\n", narrative))
+	}
+
+	report.WriteString("## Summary\n\n")
+	report.WriteString(fmt.Sprintf("- **Issues worked on**: %d\n", len(weekIssues)))
+	report.WriteString(fmt.Sprintf("- **Comments added**: %d\n", commentCount))
+	report.WriteString(fmt.Sprintf("- **Worklog entries**: %d\n\n", worklogCount))
+
+	for _, day := range days {
+		report.WriteString(fmt.Sprintf("## 🗓️ %s\n\n", day.date.Format("Monday, January 2")))
+		if len(day.issues) == 0 && len(day.worklogs) == 0 {
+			report.WriteString("_No activity_\n\n")
+			continue
+		}
+		for _, issue := range day.issues {
+			report.WriteString(fmt.Sprintf("- %s **[%s]** %s", g.statusIcon(issue.Fields.Status.Name), issue.Key, issue.Fields.Summary))
+			if count := len(day.comments[issue.Key]); count > 0 {
+				report.WriteString(fmt.Sprintf(" (%d comments)", count))
+			}
+			report.WriteString("\n")
+		}
+		for _, worklog := range day.worklogs {
+			report.WriteString(fmt.Sprintf("- ⏱️ **[%s]** %s", worklog.IssueID, worklog.Started.Time.Format("15:04")))
+			if worklog.Comment != "" {
+				report.WriteString(fmt.Sprintf(" - %s", worklog.Comment))
+			}
+			report.WriteString("\n")
+		}
+		report.WriteString("\n")
+	}
+
+	statusGroups := groupIssuesByStatus(weekIssues)
+	for _, section := range []struct{ group, heading string }{
+		{"Done", "✅ Completed This Week"},
+		{"In Progress", "🔄 Still In Progress"},
+	} {
+		if len(statusGroups[section.group]) == 0 {
+			continue
+		}
+		report.WriteString(fmt.Sprintf("## %s\n\n", section.heading))
+		for _, issue := range statusGroups[section.group] {
+			report.WriteString(fmt.Sprintf("- **[%s]** %s\n", issue.Key, issue.Fields.Summary))
+		}
+		report.WriteString("\n")
+	}
+
+	report.WriteString("---\n*Generated by my-day CLI*\n")
+	return report.String()
+}
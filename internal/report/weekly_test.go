@@ -0,0 +1,70 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func TestWeekStart(t *testing.T) {
+	monday := time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		date time.Time
+	}{
+		{"monday", time.Date(2024, 7, 15, 9, 30, 0, 0, time.UTC)},
+		{"wednesday", time.Date(2024, 7, 17, 23, 59, 0, 0, time.UTC)},
+		{"friday", time.Date(2024, 7, 19, 12, 0, 0, 0, time.UTC)},
+		{"sunday", time.Date(2024, 7, 21, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WeekStart(tt.date); !got.Equal(monday) {
+				t.Errorf("WeekStart(%s) = %s, want %s", tt.date, got, monday)
+			}
+		})
+	}
+}
+
+func TestGenerateWeeklyGroupsByDay(t *testing.T) {
+	at := func(day, hour int) jira.JiraTime {
+		return jira.JiraTime{Time: time.Date(2024, 7, day, hour, 0, 0, 0, time.UTC)}
+	}
+
+	issues := []IssueWithComments{
+		{
+			Issue: jira.Issue{Key: "OPS-1", Fields: jira.Fields{Summary: "Tuesday and Thursday work", Updated: at(18, 10)}},
+			Comments: []jira.Comment{
+				{Body: jira.JiraDescription{Text: "started"}, Created: at(16, 9)},
+				{Body: jira.JiraDescription{Text: "finished"}, Created: at(18, 10)},
+			},
+		},
+		{
+			Issue: jira.Issue{Key: "OPS-2", Fields: jira.Fields{Summary: "Weekend only", Updated: at(20, 10)}},
+		},
+	}
+
+	output, err := NewGenerator(goldenConfig("markdown")).GenerateWeekly(issues, nil, time.Date(2024, 7, 17, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"# Weekly Report - Jul 15 to Jul 19, 2024",
+		"- **Issues worked on**: 1",
+		"## 🗓️ Tuesday, July 16\n\n- 📝 **[OPS-1]** Tuesday and Thursday work (1 comments)",
+		"## 🗓️ Thursday, July 18\n\n- 📝 **[OPS-1]** Tuesday and Thursday work (1 comments)",
+		"## 🗓️ Monday, July 15\n\n_No activity_",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("weekly report missing %q\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "OPS-2") {
+		t.Error("weekend-only issue should not appear in the weekly report")
+	}
+}
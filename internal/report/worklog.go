@@ -0,0 +1,194 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func init() {
+	RegisterSection(NewSection("⏰ Work Log", PriorityWorklog, func(model SectionModel, format string) string {
+		if model.Config.Hide.Worklog {
+			return ""
+		}
+		return model.generator.formatWorklogs(model.Worklogs, model.Issues, format)
+	}))
+}
+
+// formatWorklogs renders the worklog entries followed by the time logged per issue, project and day
+func (g *Generator) formatWorklogs(worklogs []jira.WorklogEntry, issues []jira.Issue, format string) string {
+	if len(worklogs) == 0 {
+		return ""
+	}
+
+	var result strings.Builder
+	switch format {
+	case FormatHTML:
+		result.WriteString("<table>\n<tr><th>Issue</th><th>Started</th><th>Comment</th></tr>\n")
+		for _, worklog := range worklogs {
+			result.WriteString(fmt.Sprintf("<tr><td class=\"key\">%s</td><td>%s%s</td><td>%s</td></tr>\n",
+				html.EscapeString(worklog.IssueID),
+				worklog.Started.Time.Format("Jan 2, 15:04"),
+				worklogDuration(worklog),
+				html.EscapeString(worklog.Comment)))
+		}
+		result.WriteString("</table>\n")
+	case FormatMarkdown:
+		for _, worklog := range worklogs {
+			result.WriteString(g.formatWorklogMarkdown(worklog))
+		}
+	default:
+		for _, worklog := range worklogs {
+			result.WriteString(g.formatWorklogConsole(worklog))
+		}
+	}
+	result.WriteString(g.formatWorklogTotals(worklogs, issues, format))
+	return result.String()
+}
+
+// worklogTotal is the time logged on one issue, project or day
+type worklogTotal struct {
+	Label   string
+	Seconds int
+}
+
+// issueKeyPattern matches issue keys such as OPS-101, whose project is the part before the dash
+var issueKeyPattern = regexp.MustCompile(`^([A-Z][A-Z0-9_]*)-\d+$`)
+
+// worklogTotals returns the time logged per issue and per project, most time first, and per
+// day, oldest first. Worklogs refer to Jira issue IDs, which are shown as the keys of the given
+// issues; time tracker entries logged against a tracker project count towards that project.
+func worklogTotals(worklogs []jira.WorklogEntry, issues []jira.Issue) (byIssue, byProject, byDay []worklogTotal) {
+	keys := make(map[string]string, len(issues))
+	for _, issue := range issues {
+		keys[issue.ID] = issue.Key
+	}
+
+	issueSeconds := make(map[string]int)
+	projectSeconds := make(map[string]int)
+	daySeconds := make(map[string]int)
+	for _, worklog := range worklogs {
+		if worklog.TimeSpentSeconds <= 0 {
+			continue
+		}
+		issue := worklog.IssueID
+		if key, ok := keys[issue]; ok {
+			issue = key
+		}
+		project := "Other"
+		if match := issueKeyPattern.FindStringSubmatch(issue); match != nil {
+			project = match[1]
+		} else if _, err := strconv.Atoi(issue); err != nil {
+			project = issue
+		}
+
+		issueSeconds[issue] += worklog.TimeSpentSeconds
+		projectSeconds[project] += worklog.TimeSpentSeconds
+		daySeconds[worklog.Started.Time.Format("2006-01-02")] += worklog.TimeSpentSeconds
+	}
+
+	var dates []string
+	for date := range daySeconds {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+	for _, date := range dates {
+		day, _ := time.Parse("2006-01-02", date)
+		byDay = append(byDay, worklogTotal{Label: day.Format("Mon Jan 2"), Seconds: daySeconds[date]})
+	}
+	return sortedTotals(issueSeconds), sortedTotals(projectSeconds), byDay
+}
+
+// sortedTotals returns the totals with the most time first
+func sortedTotals(seconds map[string]int) []worklogTotal {
+	var totals []worklogTotal
+	for label, total := range seconds {
+		totals = append(totals, worklogTotal{Label: label, Seconds: total})
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		if totals[i].Seconds != totals[j].Seconds {
+			return totals[i].Seconds > totals[j].Seconds
+		}
+		return totals[i].Label < totals[j].Label
+	})
+	return totals
+}
+
+// formatTotals joins totals as "LABEL 1h 30m · LABEL 45m"
+func formatTotals(totals []worklogTotal, format func(string) string) string {
+	var parts []string
+	for _, total := range totals {
+		parts = append(parts, fmt.Sprintf("%s %s", format(total.Label), formatTrackedTime(time.Duration(total.Seconds)*time.Second)))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// formatLogged returns the time logged over a number of days, with the share of the workdays
+// it fills when a workday length is configured, e.g. "6h 30m (81% of 8h)"
+func (g *Generator) formatLogged(seconds, days int) string {
+	logged := formatTrackedTime(time.Duration(seconds) * time.Second)
+	if g.config.WorkdayHours <= 0 || days <= 0 {
+		return logged
+	}
+
+	workday := time.Duration(g.config.WorkdayHours * float64(time.Hour))
+	utilization := float64(seconds) / (float64(days) * workday.Seconds()) * 100
+	if days == 1 {
+		return fmt.Sprintf("%s (%.0f%% of %s)", logged, utilization, formatTrackedTime(workday))
+	}
+	return fmt.Sprintf("%s (%.0f%% of %d × %s)", logged, utilization, days, formatTrackedTime(workday))
+}
+
+// formatWorklogTotals returns the time logged per issue, per project and per day that ends the Work Log section
+func (g *Generator) formatWorklogTotals(worklogs []jira.WorklogEntry, issues []jira.Issue, format string) string {
+	byIssue, byProject, byDay := worklogTotals(worklogs, issues)
+	if len(byIssue) == 0 {
+		return ""
+	}
+
+	lines := []struct{ label, value string }{
+		{"Per issue", formatTotals(byIssue, func(label string) string { return label })},
+		{"Per project", formatTotals(byProject, func(label string) string { return label })},
+	}
+	if format == FormatHTML {
+		lines[0].value = formatTotals(byIssue, html.EscapeString)
+		lines[1].value = formatTotals(byProject, html.EscapeString)
+	}
+	for _, day := range byDay {
+		lines = append(lines, struct{ label, value string }{"Total " + day.Label, g.formatLogged(day.Seconds, 1)})
+	}
+
+	var result strings.Builder
+	for _, line := range lines {
+		switch format {
+		case FormatHTML:
+			result.WriteString(fmt.Sprintf("<p><strong>%s:</strong> %s</p>\n", line.label, line.value))
+		case FormatMarkdown:
+			result.WriteString(fmt.Sprintf("**%s:** %s\n\n", line.label, line.value))
+		default:
+			result.WriteString(fmt.Sprintf("  %s: %s\n", line.label, line.value))
+		}
+	}
+	return result.String()
+}
+
+// formatTimeLogged returns the summary line with the time logged in the report window, or ""
+// without worklogs. line is a format string taking the time, such as "• Time logged: %s\n".
+func (g *Generator) formatTimeLogged(line string, worklogs []jira.WorklogEntry) string {
+	_, _, byDay := worklogTotals(worklogs, nil)
+	if len(byDay) == 0 {
+		return ""
+	}
+
+	seconds := 0
+	for _, day := range byDay {
+		seconds += day.Seconds
+	}
+	return fmt.Sprintf(line, g.formatLogged(seconds, len(byDay)))
+}
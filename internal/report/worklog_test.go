@@ -0,0 +1,65 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func TestWorklogTotals(t *testing.T) {
+	at := func(day, hour int) jira.JiraTime {
+		return jira.JiraTime{Time: time.Date(2024, 7, day, hour, 0, 0, 0, time.Local)}
+	}
+	worklogs := []jira.WorklogEntry{
+		{IssueID: "10001", Started: at(16, 9), TimeSpentSeconds: 3600},
+		{IssueID: "SEC-2", Started: at(15, 9), TimeSpentSeconds: 7200},
+		{IssueID: "OPS-1", Started: at(15, 14), TimeSpentSeconds: 5400},
+		{IssueID: "Internal", Started: at(15, 15), TimeSpentSeconds: 900}, // Time tracker project
+		{IssueID: "OPS-3", Started: at(15, 16)},                           // No time spent
+	}
+	issues := []jira.Issue{{ID: "10001", Key: "OPS-1"}}
+
+	byIssue, byProject, byDay := worklogTotals(worklogs, issues)
+	if len(byIssue) != 3 || byIssue[0] != (worklogTotal{"OPS-1", 9000}) || byIssue[1] != (worklogTotal{"SEC-2", 7200}) {
+		t.Errorf("unexpected issue totals %+v", byIssue)
+	}
+	if len(byProject) != 3 || byProject[0] != (worklogTotal{"OPS", 9000}) || byProject[2] != (worklogTotal{"Internal", 900}) {
+		t.Errorf("unexpected project totals %+v", byProject)
+	}
+	if len(byDay) != 2 || byDay[0] != (worklogTotal{"Mon Jul 15", 13500}) || byDay[1] != (worklogTotal{"Tue Jul 16", 3600}) {
+		t.Errorf("unexpected daily totals %+v", byDay)
+	}
+
+	g := &Generator{config: &Config{WorkdayHours: 8}}
+	want := "  Per issue: OPS-1 2h 30m · SEC-2 2h · Internal 15m\n" +
+		"  Per project: OPS 2h 30m · SEC 2h · Internal 15m\n" +
+		"  Total Mon Jul 15: 3h 45m (47% of 8h)\n" +
+		"  Total Tue Jul 16: 1h (12% of 8h)\n"
+	if got := g.formatWorklogTotals(worklogs, issues, FormatConsole); got != want {
+		t.Errorf("formatWorklogTotals() = %q, want %q", got, want)
+	}
+	if got := g.formatWorklogTotals(nil, nil, FormatMarkdown); got != "" {
+		t.Errorf("expected no totals without worklogs, got %q", got)
+	}
+}
+
+func TestFormatTimeLogged(t *testing.T) {
+	worklogs := []jira.WorklogEntry{
+		{Started: jira.JiraTime{Time: time.Date(2024, 7, 15, 9, 0, 0, 0, time.Local)}, TimeSpentSeconds: 6 * 3600},
+		{Started: jira.JiraTime{Time: time.Date(2024, 7, 16, 9, 0, 0, 0, time.Local)}, TimeSpentSeconds: 6 * 3600},
+	}
+
+	g := &Generator{config: &Config{WorkdayHours: 7.5}}
+	if got, want := g.formatTimeLogged("• Time logged: %s\n", worklogs), "• Time logged: 12h (80% of 2 × 7h 30m)\n"; got != want {
+		t.Errorf("formatTimeLogged() = %q, want %q", got, want)
+	}
+
+	g.config.WorkdayHours = 0
+	if got, want := g.formatTimeLogged("%s", worklogs), "12h"; got != want {
+		t.Errorf("expected no utilization without a workday length, got %q", got)
+	}
+	if got := g.formatTimeLogged("%s", nil); got != "" {
+		t.Errorf("expected no line without worklogs, got %q", got)
+	}
+}
@@ -0,0 +1,67 @@
+// Package secrets keeps credentials such as the Jira token and LLM API keys in the
+// operating system's credential store instead of plaintext files or environment variables:
+// the macOS Keychain, the Windows Credential Manager, or the Secret Service (GNOME Keyring,
+// KWallet) through libsecret on Linux.
+package secrets
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Service names the my-day entries in the credential store
+const Service = "my-day"
+
+// Accounts of the secrets my-day keeps in the credential store
+const (
+	JiraToken = "jira-token"
+	JiraOAuth = "jira-oauth"
+)
+
+// ErrNotFound is returned when the credential store has no entry for an account
+var ErrNotFound = errors.New("secret not found in the credential store")
+
+// Store is a credential store holding one secret per account
+type Store interface {
+	// Available reports whether the store can be used on this system
+	Available() bool
+	Set(account, secret string) error
+	Get(account string) (string, error)
+	Delete(account string) error
+}
+
+// Default returns the operating system's credential store. Its Available method reports
+// false on platforms without one, or when the command line tool it relies on is missing.
+func Default() Store {
+	return platformStore{}
+}
+
+// commandAvailable reports whether a command line tool is installed
+func commandAvailable(name string) bool {
+	_, err := exec.LookPath(name)
+	return err == nil
+}
+
+// runCommand runs a credential store tool and returns its output, including its error
+// output in the returned error
+func runCommand(cmd *exec.Cmd) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("%s: %s: %w", cmd.Args[0], message, err)
+		}
+		return "", fmt.Errorf("%s: %w", cmd.Args[0], err)
+	}
+	return stdout.String(), nil
+}
+
+// isExitStatus reports whether a command failed with the given exit status
+func isExitStatus(err error, code int) bool {
+	var exitErr *exec.ExitError
+	return errors.As(err, &exitErr) && exitErr.ExitCode() == code
+}
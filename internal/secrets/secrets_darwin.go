@@ -0,0 +1,44 @@
+package secrets
+
+import (
+	"os/exec"
+	"strings"
+)
+
+// platformStore uses the macOS Keychain through the security tool
+type platformStore struct{}
+
+func (platformStore) Available() bool {
+	return commandAvailable("security")
+}
+
+func (platformStore) Set(account, secret string) error {
+	// -U updates an existing entry instead of failing. -w without a value, last, makes security
+	// prompt for the secret and its confirmation on stdin, keeping it out of the process list.
+	cmd := exec.Command("security", "add-generic-password", "-U", "-s", Service, "-a", account, "-w")
+	cmd.Stdin = strings.NewReader(secret + "\n" + secret + "\n")
+	_, err := runCommand(cmd)
+	return err
+}
+
+func (platformStore) Get(account string) (string, error) {
+	output, err := runCommand(exec.Command("security", "find-generic-password", "-s", Service, "-a", account, "-w"))
+	if err != nil {
+		if isExitStatus(err, itemNotFound) {
+			return "", ErrNotFound
+		}
+		return "", err
+	}
+	return strings.TrimRight(output, "\n"), nil
+}
+
+func (platformStore) Delete(account string) error {
+	_, err := runCommand(exec.Command("security", "delete-generic-password", "-s", Service, "-a", account))
+	if err != nil && isExitStatus(err, itemNotFound) {
+		return nil
+	}
+	return err
+}
+
+// itemNotFound is the exit status of security when the entry does not exist
+const itemNotFound = 44
@@ -0,0 +1,42 @@
+package secrets
+
+import (
+	"os/exec"
+	"strings"
+)
+
+// platformStore uses the Secret Service (GNOME Keyring, KWallet) through libsecret's secret-tool
+type platformStore struct{}
+
+func (platformStore) Available() bool {
+	return commandAvailable("secret-tool")
+}
+
+func (platformStore) Set(account, secret string) error {
+	// secret-tool reads the secret from stdin, keeping it out of the process list
+	cmd := exec.Command("secret-tool", "store", "--label", Service+" "+account, "service", Service, "account", account)
+	cmd.Stdin = strings.NewReader(secret)
+	_, err := runCommand(cmd)
+	return err
+}
+
+func (platformStore) Get(account string) (string, error) {
+	output, err := runCommand(exec.Command("secret-tool", "lookup", "service", Service, "account", account))
+	if err != nil {
+		// secret-tool exits with status 1 when nothing matches
+		if isExitStatus(err, 1) {
+			return "", ErrNotFound
+		}
+		return "", err
+	}
+	secret := strings.TrimRight(output, "\n")
+	if secret == "" {
+		return "", ErrNotFound
+	}
+	return secret, nil
+}
+
+func (platformStore) Delete(account string) error {
+	_, err := runCommand(exec.Command("secret-tool", "clear", "service", Service, "account", account))
+	return err
+}
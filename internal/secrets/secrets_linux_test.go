@@ -0,0 +1,63 @@
+package secrets
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// fakeSecretTool installs a secret-tool stand-in that keeps secrets in files, one per account
+const fakeSecretTool = `#!/bin/sh
+dir="$FAKE_SECRETS_DIR"
+command="$1"
+shift
+while [ $# -gt 0 ]; do
+	case "$1" in
+		account) account="$2"; shift ;;
+	esac
+	shift
+done
+case "$command" in
+	store) cat > "$dir/$account" ;;
+	lookup) [ -f "$dir/$account" ] || exit 1; cat "$dir/$account" ;;
+	clear) rm -f "$dir/$account" ;;
+esac
+`
+
+func TestLinuxStore(t *testing.T) {
+	bin := t.TempDir()
+	if err := os.WriteFile(filepath.Join(bin, "secret-tool"), []byte(fakeSecretTool), 0755); err != nil {
+		t.Fatalf("failed to install fake secret-tool: %v", err)
+	}
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("FAKE_SECRETS_DIR", t.TempDir())
+
+	store := Default()
+	if !store.Available() {
+		t.Fatal("expected the store to be available with secret-tool installed")
+	}
+
+	if _, err := store.Get(JiraToken); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound before storing, got %v", err)
+	}
+	if err := store.Set(JiraToken, "s3cret token"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if secret, err := store.Get(JiraToken); err != nil || secret != "s3cret token" {
+		t.Errorf("Get() = %q, %v", secret, err)
+	}
+	if err := store.Delete(JiraToken); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := store.Get(JiraToken); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound after deleting, got %v", err)
+	}
+}
+
+func TestLinuxStoreUnavailableWithoutSecretTool(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	if Default().Available() {
+		t.Error("expected the store to be unavailable without secret-tool")
+	}
+}
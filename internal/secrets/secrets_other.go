@@ -0,0 +1,27 @@
+//go:build !darwin && !linux && !windows
+
+package secrets
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// platformStore is unavailable on platforms without a supported credential store
+type platformStore struct{}
+
+func (platformStore) Available() bool {
+	return false
+}
+
+func (platformStore) Set(account, secret string) error {
+	return fmt.Errorf("no credential store available on %s", runtime.GOOS)
+}
+
+func (platformStore) Get(account string) (string, error) {
+	return "", fmt.Errorf("no credential store available on %s", runtime.GOOS)
+}
+
+func (platformStore) Delete(account string) error {
+	return nil
+}
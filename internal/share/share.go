@@ -0,0 +1,124 @@
+// Package share serves a report on the local network behind a short-lived, unguessable link,
+// so it can be opened on a phone by scanning a QR code in the terminal.
+package share
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultTTL is how long a share link stays valid by default
+const DefaultTTL = 15 * time.Minute
+
+// Server serves one page until its link expires
+type Server struct {
+	URL     string    // Link to the page, including the secret token
+	Expires time.Time // When the link stops working
+
+	listener net.Listener
+	server   *http.Server
+}
+
+// Serve starts serving content as an HTML page on the given port (0 picks a free one) for ttl.
+// host is the address put in the link; when empty the machine's LAN address is used.
+func Serve(content []byte, host string, port int, ttl time.Duration) (*Server, error) {
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if host == "" {
+		host, err = LANAddress()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort("", strconv.Itoa(port)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on port %d: %w", port, err)
+	}
+	port = listener.Addr().(*net.TCPAddr).Port
+
+	s := &Server{
+		URL:      fmt.Sprintf("http://%s/r/%s", net.JoinHostPort(host, strconv.Itoa(port)), token),
+		Expires:  time.Now().Add(ttl),
+		listener: listener,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/r/"+token, func(w http.ResponseWriter, r *http.Request) {
+		if time.Now().After(s.Expires) {
+			http.Error(w, "This link has expired", http.StatusGone)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("X-Robots-Tag", "noindex")
+		w.Write(content)
+	})
+	s.server = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	go s.server.Serve(listener)
+	return s, nil
+}
+
+// Wait blocks until the link expires or the context is done, then stops the server
+func (s *Server) Wait(ctx context.Context) error {
+	timer := time.NewTimer(time.Until(s.Expires))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+	return s.Close()
+}
+
+// Close stops the server
+func (s *Server) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to stop share server: %w", err)
+	}
+	return nil
+}
+
+// LANAddress returns the address other devices on the local network can reach this machine on
+func LANAddress() (string, error) {
+	// Connecting a UDP socket sends nothing; it only picks the outgoing interface
+	if conn, err := net.Dial("udp", "192.0.2.1:9"); err == nil {
+		defer conn.Close()
+		if addr, ok := conn.LocalAddr().(*net.UDPAddr); ok && !addr.IP.IsLoopback() {
+			return addr.IP.String(), nil
+		}
+	}
+
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return "", fmt.Errorf("failed to list network addresses: %w", err)
+	}
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok && ipNet.IP.To4() != nil && !ipNet.IP.IsLoopback() {
+			return ipNet.IP.String(), nil
+		}
+	}
+	return "", fmt.Errorf("no local network address found; connect to a network or pass --serve-host")
+}
+
+// newToken returns a random token that makes the link hard to guess
+func newToken() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate share token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,72 @@
+package share
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServe(t *testing.T) {
+	s, err := Serve([]byte("<h1>Standup</h1>"), "127.0.0.1", 0, time.Minute)
+	if err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+	defer s.Close()
+
+	resp, err := http.Get(s.URL)
+	if err != nil {
+		t.Fatalf("GET share link error = %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || string(body) != "<h1>Standup</h1>" {
+		t.Errorf("expected the report, got %d %q", resp.StatusCode, body)
+	}
+	if resp.Header.Get("Cache-Control") != "no-store" {
+		t.Errorf("expected the page not to be cached")
+	}
+
+	// Only the tokenized path serves the report
+	base := s.URL[:strings.LastIndex(s.URL, "/")+1]
+	resp, err = http.Get(base + "guess")
+	if err != nil {
+		t.Fatalf("GET guessed link error = %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected 404 for a guessed link, got %d", resp.StatusCode)
+	}
+}
+
+func TestServeExpires(t *testing.T) {
+	s, err := Serve([]byte("report"), "127.0.0.1", 0, time.Minute)
+	if err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+	defer s.Close()
+
+	s.Expires = time.Now().Add(-time.Second)
+	resp, err := http.Get(s.URL)
+	if err != nil {
+		t.Fatalf("GET share link error = %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusGone {
+		t.Errorf("expected 410 for an expired link, got %d", resp.StatusCode)
+	}
+
+	// Wait returns immediately once the link has expired
+	done := make(chan error, 1)
+	go func() { done <- s.Wait(context.Background()) }()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Wait() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Wait() did not return after the link expired")
+	}
+}
@@ -0,0 +1,697 @@
+// Package store is the local SQLite database that keeps synced Jira data and report
+// history between runs, so syncs can be incremental and history can be queried offline.
+package store
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"my-day/internal/jira"
+
+	_ "modernc.org/sqlite" // Pure Go SQLite driver, no cgo required
+)
+
+// FileName is the name of the database in the my-day state directory
+const FileName = "my-day.db"
+
+// schema creates the tables on first use. Records are stored as JSON next to the columns
+// used for lookups, so new Jira fields don't need migrations.
+const schema = `
+CREATE TABLE IF NOT EXISTS issues (
+	key     TEXT PRIMARY KEY,
+	updated TEXT NOT NULL,
+	data    TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS team_issues (
+	key     TEXT PRIMARY KEY,
+	updated TEXT NOT NULL,
+	data    TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS comments (
+	id        TEXT PRIMARY KEY,
+	issue_key TEXT NOT NULL,
+	created   TEXT NOT NULL,
+	data      TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS comments_created ON comments (created);
+CREATE TABLE IF NOT EXISTS worklogs (
+	id       TEXT PRIMARY KEY,
+	issue_id TEXT NOT NULL,
+	started  TEXT NOT NULL,
+	seconds  INTEGER NOT NULL,
+	data     TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS worklogs_started ON worklogs (started);
+CREATE TABLE IF NOT EXISTS reports (
+	date          TEXT NOT NULL,
+	format        TEXT NOT NULL,
+	generated_at  TEXT NOT NULL,
+	issue_count   INTEGER NOT NULL,
+	comment_count INTEGER NOT NULL,
+	worklog_count INTEGER NOT NULL,
+	content       TEXT NOT NULL,
+	PRIMARY KEY (date, format)
+);
+CREATE TABLE IF NOT EXISTS status_changes (
+	issue_key TEXT NOT NULL,
+	status    TEXT NOT NULL,
+	category  TEXT NOT NULL,
+	changed   TEXT NOT NULL,
+	PRIMARY KEY (issue_key, changed)
+);
+CREATE TABLE IF NOT EXISTS transitions (
+	id        TEXT PRIMARY KEY,
+	issue_key TEXT NOT NULL,
+	changed   TEXT NOT NULL,
+	data      TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS state (
+	key   TEXT PRIMARY KEY,
+	value TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS llm_summaries (
+	key     TEXT PRIMARY KEY,
+	created TEXT NOT NULL,
+	summary TEXT NOT NULL
+);
+`
+
+// timeLayout stores times in UTC with a fixed width so they sort and compare as text
+const timeLayout = "2006-01-02T15:04:05.000Z"
+
+// Store is the local database of synced data and report history
+type Store struct {
+	db *sql.DB
+}
+
+// ReportRecord is a generated report kept in the report history
+type ReportRecord struct {
+	Date         string // YYYY-MM-DD
+	Format       string
+	GeneratedAt  time.Time
+	IssueCount   int
+	CommentCount int
+	WorklogCount int
+	Content      string
+	Issues       []ReportIssue // Issues the report covered, nil for reports recorded before issues were kept
+}
+
+// ReportIssue is an issue covered by a stored report
+type ReportIssue struct {
+	Key     string `json:"key"`
+	Summary string `json:"summary"`
+	Status  string `json:"status"`
+	Done    bool   `json:"done"` // The status is in the done category
+}
+
+// NewlyCompleted returns the issues done in a report that were not done, or not covered, in an
+// earlier report
+func NewlyCompleted(earlier, later ReportRecord) []ReportIssue {
+	doneBefore := make(map[string]bool)
+	for _, issue := range earlier.Issues {
+		if issue.Done {
+			doneBefore[issue.Key] = true
+		}
+	}
+	var completed []ReportIssue
+	for _, issue := range later.Issues {
+		if issue.Done && !doneBefore[issue.Key] {
+			completed = append(completed, issue)
+		}
+	}
+	return completed
+}
+
+// StatusTime is the time issues spent in a status
+type StatusTime struct {
+	Status string
+	Total  time.Duration
+	Issues int // Issues that spent time in the status
+}
+
+// DayActivity summarizes the stored activity of one day
+type DayActivity struct {
+	Date        string // YYYY-MM-DD, local time
+	Comments    int
+	Issues      int // Distinct issues commented on
+	Worklogs    int
+	HoursLogged float64
+	Reported    bool // A report was generated for the day
+}
+
+// DefaultPath returns the database location in the my-day state directory
+func DefaultPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".my-day", FileName), nil
+}
+
+// ProfilePath returns the database location of a named Jira profile, kept apart from the
+// default database so issues of different Jira instances do not mix
+func ProfilePath(name string) (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".my-day", "profiles", name, FileName), nil
+}
+
+// Open opens the database at path, creating it and its tables if needed
+func Open(path string) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create store directory: %w", err)
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open store: %w", err)
+	}
+	// A single connection avoids SQLITE_BUSY between our own statements
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize store: %w", err)
+	}
+	if err := addColumn(db, "reports", "issues", "TEXT"); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize store: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close closes the database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// SaveIssues inserts or replaces issues, recording a status change when an issue's status
+// differs from the one it had in the store. Their transitions are saved with SaveTransitions.
+func (s *Store) SaveIssues(issues []jira.Issue) error {
+	return s.inTx(func(tx *sql.Tx) error {
+		for _, issue := range issues {
+			issue.Transitions = nil
+			data, err := json.Marshal(issue)
+			if err != nil {
+				return fmt.Errorf("failed to encode issue %s: %w", issue.Key, err)
+			}
+			if _, err := tx.Exec(`INSERT OR REPLACE INTO issues (key, updated, data) VALUES (?, ?, ?)`,
+				issue.Key, formatTime(issue.Fields.Updated.Time), string(data)); err != nil {
+				return fmt.Errorf("failed to save issue %s: %w", issue.Key, err)
+			}
+			if err := recordStatusChange(tx, issue); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// recordStatusChange records the status of an issue when it differs from the last one recorded.
+// Syncs only see the issue as of its last update, so that is when the status is taken to change.
+func recordStatusChange(tx *sql.Tx, issue jira.Issue) error {
+	if issue.Fields.Status.Name == "" {
+		return nil
+	}
+	var status string
+	err := tx.QueryRow(`SELECT status FROM status_changes WHERE issue_key = ? ORDER BY changed DESC LIMIT 1`, issue.Key).Scan(&status)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to read status of %s: %w", issue.Key, err)
+	}
+	if status == issue.Fields.Status.Name {
+		return nil
+	}
+	if _, err := tx.Exec(`INSERT OR REPLACE INTO status_changes (issue_key, status, category, changed) VALUES (?, ?, ?, ?)`,
+		issue.Key, issue.Fields.Status.Name, issue.Fields.Status.Category.Key, formatTime(issue.Fields.Updated.Time)); err != nil {
+		return fmt.Errorf("failed to record status of %s: %w", issue.Key, err)
+	}
+	return nil
+}
+
+// Issues returns the stored issues updated after since (all issues for a zero time),
+// most recently updated first
+func (s *Store) Issues(since time.Time) ([]jira.Issue, error) {
+	rows, err := s.db.Query(`SELECT data FROM issues WHERE updated > ? ORDER BY updated DESC`, formatTime(since))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query issues: %w", err)
+	}
+	return scanAll[jira.Issue](rows)
+}
+
+// SaveTeamIssues inserts or replaces issues of the team fetched by 'my-day report team', kept
+// apart from your own issues so they don't show in your reports
+func (s *Store) SaveTeamIssues(issues []jira.Issue) error {
+	return s.inTx(func(tx *sql.Tx) error {
+		for _, issue := range issues {
+			data, err := json.Marshal(issue)
+			if err != nil {
+				return fmt.Errorf("failed to encode issue %s: %w", issue.Key, err)
+			}
+			if _, err := tx.Exec(`INSERT OR REPLACE INTO team_issues (key, updated, data) VALUES (?, ?, ?)`,
+				issue.Key, formatTime(issue.Fields.Updated.Time), string(data)); err != nil {
+				return fmt.Errorf("failed to save team issue %s: %w", issue.Key, err)
+			}
+		}
+		return nil
+	})
+}
+
+// TeamIssues returns the stored issues of the team updated after since, most recently updated
+// first
+func (s *Store) TeamIssues(since time.Time) ([]jira.Issue, error) {
+	rows, err := s.db.Query(`SELECT data FROM team_issues WHERE updated > ? ORDER BY updated DESC`, formatTime(since))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query team issues: %w", err)
+	}
+	return scanAll[jira.Issue](rows)
+}
+
+// SaveComments inserts or replaces comments of an issue
+func (s *Store) SaveComments(issueKey string, comments []jira.Comment) error {
+	return s.inTx(func(tx *sql.Tx) error {
+		for _, comment := range comments {
+			data, err := json.Marshal(comment)
+			if err != nil {
+				return fmt.Errorf("failed to encode comment %s: %w", comment.ID, err)
+			}
+			if _, err := tx.Exec(`INSERT OR REPLACE INTO comments (id, issue_key, created, data) VALUES (?, ?, ?, ?)`,
+				comment.ID, issueKey, formatTime(comment.Created.Time), string(data)); err != nil {
+				return fmt.Errorf("failed to save comment %s: %w", comment.ID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Comments returns the stored comments created after since, by issue key, oldest first
+func (s *Store) Comments(since time.Time) (map[string][]jira.Comment, error) {
+	rows, err := s.db.Query(`SELECT issue_key, data FROM comments WHERE created > ? ORDER BY created`, formatTime(since))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query comments: %w", err)
+	}
+	defer rows.Close()
+
+	comments := make(map[string][]jira.Comment)
+	for rows.Next() {
+		var issueKey, data string
+		if err := rows.Scan(&issueKey, &data); err != nil {
+			return nil, fmt.Errorf("failed to read comment: %w", err)
+		}
+		var comment jira.Comment
+		if err := json.Unmarshal([]byte(data), &comment); err != nil {
+			return nil, fmt.Errorf("failed to decode comment: %w", err)
+		}
+		comments[issueKey] = append(comments[issueKey], comment)
+	}
+	return comments, rows.Err()
+}
+
+// SaveTransitions inserts or replaces status transitions of an issue
+func (s *Store) SaveTransitions(issueKey string, transitions []jira.StatusTransition) error {
+	return s.inTx(func(tx *sql.Tx) error {
+		for _, transition := range transitions {
+			data, err := json.Marshal(transition)
+			if err != nil {
+				return fmt.Errorf("failed to encode transition %s: %w", transition.ID, err)
+			}
+			if _, err := tx.Exec(`INSERT OR REPLACE INTO transitions (id, issue_key, changed, data) VALUES (?, ?, ?, ?)`,
+				transition.ID, issueKey, formatTime(transition.At.Time), string(data)); err != nil {
+				return fmt.Errorf("failed to save transition %s: %w", transition.ID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Transitions returns the stored status transitions made after since, by issue key, oldest first
+func (s *Store) Transitions(since time.Time) (map[string][]jira.StatusTransition, error) {
+	rows, err := s.db.Query(`SELECT issue_key, data FROM transitions WHERE changed > ? ORDER BY changed`, formatTime(since))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transitions: %w", err)
+	}
+	defer rows.Close()
+
+	transitions := make(map[string][]jira.StatusTransition)
+	for rows.Next() {
+		var issueKey, data string
+		if err := rows.Scan(&issueKey, &data); err != nil {
+			return nil, fmt.Errorf("failed to read transition: %w", err)
+		}
+		var transition jira.StatusTransition
+		if err := json.Unmarshal([]byte(data), &transition); err != nil {
+			return nil, fmt.Errorf("failed to decode transition: %w", err)
+		}
+		transitions[issueKey] = append(transitions[issueKey], transition)
+	}
+	return transitions, rows.Err()
+}
+
+// SaveWorklogs inserts or replaces worklog entries
+func (s *Store) SaveWorklogs(worklogs []jira.WorklogEntry) error {
+	return s.inTx(func(tx *sql.Tx) error {
+		for _, worklog := range worklogs {
+			data, err := json.Marshal(worklog)
+			if err != nil {
+				return fmt.Errorf("failed to encode worklog %s: %w", worklog.ID, err)
+			}
+			if _, err := tx.Exec(`INSERT OR REPLACE INTO worklogs (id, issue_id, started, seconds, data) VALUES (?, ?, ?, ?, ?)`,
+				worklog.ID, worklog.IssueID, formatTime(worklog.Started.Time), worklog.TimeSpentSeconds, string(data)); err != nil {
+				return fmt.Errorf("failed to save worklog %s: %w", worklog.ID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Worklogs returns the stored worklog entries started after since, oldest first
+func (s *Store) Worklogs(since time.Time) ([]jira.WorklogEntry, error) {
+	rows, err := s.db.Query(`SELECT data FROM worklogs WHERE started > ? ORDER BY started`, formatTime(since))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query worklogs: %w", err)
+	}
+	return scanAll[jira.WorklogEntry](rows)
+}
+
+// SetState stores a JSON-encoded value under key, such as the last sync time or cached metadata
+func (s *Store) SetState(key string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+	if _, err := s.db.Exec(`INSERT OR REPLACE INTO state (key, value) VALUES (?, ?)`, key, string(data)); err != nil {
+		return fmt.Errorf("failed to save %s: %w", key, err)
+	}
+	return nil
+}
+
+// State decodes the value stored under key into value. It reports whether the key exists.
+func (s *Store) State(key string, value interface{}) (bool, error) {
+	var data string
+	err := s.db.QueryRow(`SELECT value FROM state WHERE key = ?`, key).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	if err := json.Unmarshal([]byte(data), value); err != nil {
+		return false, fmt.Errorf("failed to decode %s: %w", key, err)
+	}
+	return true, nil
+}
+
+// LLMSummary returns the LLM summary stored under key, a hash of the prompt inputs. It reports
+// whether the key exists.
+func (s *Store) LLMSummary(key string) (string, bool, error) {
+	var summary string
+	err := s.db.QueryRow(`SELECT summary FROM llm_summaries WHERE key = ?`, key).Scan(&summary)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read LLM summary: %w", err)
+	}
+	return summary, true, nil
+}
+
+// SaveLLMSummary stores an LLM summary under key, a hash of the prompt inputs
+func (s *Store) SaveLLMSummary(key, summary string) error {
+	_, err := s.db.Exec(`INSERT OR REPLACE INTO llm_summaries (key, created, summary) VALUES (?, ?, ?)`,
+		key, formatTime(time.Now()), summary)
+	if err != nil {
+		return fmt.Errorf("failed to save LLM summary: %w", err)
+	}
+	return nil
+}
+
+// SaveReport records a generated report, replacing an earlier report of the same day and format
+func (s *Store) SaveReport(record ReportRecord) error {
+	var issues interface{}
+	if record.Issues != nil {
+		data, err := json.Marshal(record.Issues)
+		if err != nil {
+			return fmt.Errorf("failed to encode report issues: %w", err)
+		}
+		issues = string(data)
+	}
+	_, err := s.db.Exec(`INSERT OR REPLACE INTO reports (date, format, generated_at, issue_count, comment_count, worklog_count, content, issues)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		record.Date, record.Format, formatTime(record.GeneratedAt),
+		record.IssueCount, record.CommentCount, record.WorklogCount, record.Content, issues)
+	if err != nil {
+		return fmt.Errorf("failed to save report: %w", err)
+	}
+	return nil
+}
+
+// Reports returns the report history between two dates (YYYY-MM-DD, inclusive), oldest first
+func (s *Store) Reports(from, to string) ([]ReportRecord, error) {
+	rows, err := s.db.Query(`SELECT date, format, generated_at, issue_count, comment_count, worklog_count, content, issues
+		FROM reports WHERE date >= ? AND date <= ? ORDER BY date, format`, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reports: %w", err)
+	}
+	defer rows.Close()
+
+	var records []ReportRecord
+	for rows.Next() {
+		var record ReportRecord
+		var generatedAt string
+		var issues sql.NullString
+		if err := rows.Scan(&record.Date, &record.Format, &generatedAt, &record.IssueCount,
+			&record.CommentCount, &record.WorklogCount, &record.Content, &issues); err != nil {
+			return nil, fmt.Errorf("failed to read report: %w", err)
+		}
+		record.GeneratedAt, _ = time.Parse(timeLayout, generatedAt)
+		if issues.Valid {
+			if err := json.Unmarshal([]byte(issues.String), &record.Issues); err != nil {
+				return nil, fmt.Errorf("failed to decode report issues: %w", err)
+			}
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+// DailyActivity returns per-day comment, worklog and report totals for the days between
+// from and to (inclusive, local time), including days without activity
+func (s *Store) DailyActivity(from, to time.Time) ([]DayActivity, error) {
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.Local)
+	end := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.Local).AddDate(0, 0, 1)
+
+	days := make(map[string]*DayActivity)
+	var order []string
+	for day := from; day.Before(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		days[date] = &DayActivity{Date: date}
+		order = append(order, date)
+	}
+	if len(order) == 0 {
+		return nil, nil
+	}
+
+	comments, err := s.Comments(from.Add(-time.Nanosecond))
+	if err != nil {
+		return nil, err
+	}
+	issuesByDay := make(map[string]map[string]bool)
+	for issueKey, issueComments := range comments {
+		for _, comment := range issueComments {
+			date := comment.Created.Time.Local().Format("2006-01-02")
+			day, ok := days[date]
+			if !ok {
+				continue
+			}
+			day.Comments++
+			if issuesByDay[date] == nil {
+				issuesByDay[date] = make(map[string]bool)
+			}
+			issuesByDay[date][issueKey] = true
+		}
+	}
+	for date, issues := range issuesByDay {
+		days[date].Issues = len(issues)
+	}
+
+	worklogs, err := s.Worklogs(from.Add(-time.Nanosecond))
+	if err != nil {
+		return nil, err
+	}
+	for _, worklog := range worklogs {
+		if day, ok := days[worklog.Started.Time.Local().Format("2006-01-02")]; ok {
+			day.Worklogs++
+			day.HoursLogged += float64(worklog.TimeSpentSeconds) / 3600
+		}
+	}
+
+	reports, err := s.Reports(order[0], order[len(order)-1])
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range reports {
+		if day, ok := days[record.Date]; ok {
+			day.Reported = true
+		}
+	}
+
+	activity := make([]DayActivity, 0, len(order))
+	for _, date := range order {
+		activity = append(activity, *days[date])
+	}
+	return activity, nil
+}
+
+// StatusSince returns when each issue entered its current status, as recorded by syncs, by issue key
+func (s *Store) StatusSince() (map[string]time.Time, error) {
+	rows, err := s.db.Query(`SELECT issue_key, MAX(changed) FROM status_changes GROUP BY issue_key`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query status changes: %w", err)
+	}
+	defer rows.Close()
+
+	since := make(map[string]time.Time)
+	for rows.Next() {
+		var issueKey, changed string
+		if err := rows.Scan(&issueKey, &changed); err != nil {
+			return nil, fmt.Errorf("failed to read status change: %w", err)
+		}
+		since[issueKey], _ = time.Parse(timeLayout, changed)
+	}
+	return since, rows.Err()
+}
+
+// TimeInStatus returns the time issues spent in each status between from and to, most time
+// first, from the status changes recorded by syncs. Statuses in the done category are left out.
+func (s *Store) TimeInStatus(from, to time.Time) ([]StatusTime, error) {
+	rows, err := s.db.Query(`SELECT issue_key, status, category, changed FROM status_changes ORDER BY issue_key, changed`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query status changes: %w", err)
+	}
+	defer rows.Close()
+
+	type change struct {
+		issueKey, status, category string
+		changed                    time.Time
+	}
+	var changes []change
+	for rows.Next() {
+		var c change
+		var changed string
+		if err := rows.Scan(&c.issueKey, &c.status, &c.category, &changed); err != nil {
+			return nil, fmt.Errorf("failed to read status change: %w", err)
+		}
+		c.changed, _ = time.Parse(timeLayout, changed)
+		changes = append(changes, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	totals := make(map[string]time.Duration)
+	issues := make(map[string]map[string]bool)
+	for i, c := range changes {
+		if c.category == "done" {
+			continue
+		}
+		end := to
+		if i+1 < len(changes) && changes[i+1].issueKey == c.issueKey {
+			end = changes[i+1].changed
+		}
+		start := c.changed
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if !end.After(start) {
+			continue
+		}
+		totals[c.status] += end.Sub(start)
+		if issues[c.status] == nil {
+			issues[c.status] = make(map[string]bool)
+		}
+		issues[c.status][c.issueKey] = true
+	}
+
+	var times []StatusTime
+	for status, total := range totals {
+		times = append(times, StatusTime{Status: status, Total: total, Issues: len(issues[status])})
+	}
+	sort.Slice(times, func(i, j int) bool {
+		if times[i].Total != times[j].Total {
+			return times[i].Total > times[j].Total
+		}
+		return times[i].Status < times[j].Status
+	})
+	return times, nil
+}
+
+// addColumn adds a column to a table of a database created before the column existed
+func addColumn(db *sql.DB, table, column, definition string) error {
+	rows, err := db.Query(fmt.Sprintf("SELECT name FROM pragma_table_info('%s')", table))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return err
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	_, err = db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
+	return err
+}
+
+func (s *Store) inTx(fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// scanAll decodes the JSON data column of every row
+func scanAll[T any](rows *sql.Rows) ([]T, error) {
+	defer rows.Close()
+
+	var items []T
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("failed to read row: %w", err)
+		}
+		var item T
+		if err := json.Unmarshal([]byte(data), &item); err != nil {
+			return nil, fmt.Errorf("failed to decode row: %w", err)
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
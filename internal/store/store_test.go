@@ -0,0 +1,289 @@
+package store
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func openTestStore(t *testing.T) *Store {
+	t.Helper()
+	s, err := Open(filepath.Join(t.TempDir(), FileName))
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func jiraTime(value string) jira.JiraTime {
+	t, _ := time.Parse(time.RFC3339, value)
+	return jira.JiraTime{Time: t}
+}
+
+func TestIssuesAndCommentsMergeAcrossSaves(t *testing.T) {
+	s := openTestStore(t)
+
+	first := jira.Issue{Key: "OPS-1", Fields: jira.Fields{Summary: "Old summary", Updated: jiraTime("2024-07-14T09:00:00Z")}}
+	second := jira.Issue{Key: "OPS-2", Fields: jira.Fields{Summary: "Runner migration", Updated: jiraTime("2024-07-15T09:00:00Z")}}
+	if err := s.SaveIssues([]jira.Issue{first, second}); err != nil {
+		t.Fatalf("SaveIssues() error = %v", err)
+	}
+
+	// An incremental sync replaces changed issues and keeps the rest
+	first.Fields.Summary = "New summary"
+	first.Fields.Updated = jiraTime("2024-07-16T09:00:00Z")
+	if err := s.SaveIssues([]jira.Issue{first}); err != nil {
+		t.Fatalf("SaveIssues() error = %v", err)
+	}
+
+	issues, err := s.Issues(time.Time{})
+	if err != nil {
+		t.Fatalf("Issues() error = %v", err)
+	}
+	if len(issues) != 2 || issues[0].Key != "OPS-1" || issues[0].Fields.Summary != "New summary" {
+		t.Errorf("expected merged issues with OPS-1 first, got %+v", issues)
+	}
+
+	recent, err := s.Issues(jiraTime("2024-07-15T12:00:00Z").Time)
+	if err != nil {
+		t.Fatalf("Issues() error = %v", err)
+	}
+	if len(recent) != 1 || recent[0].Key != "OPS-1" {
+		t.Errorf("expected only OPS-1 updated after the cutoff, got %+v", recent)
+	}
+
+	comments := []jira.Comment{
+		{ID: "10", Body: jira.JiraDescription{Text: "Started"}, Created: jiraTime("2024-07-15T09:00:00Z")},
+		{ID: "11", Body: jira.JiraDescription{Text: "Done"}, Created: jiraTime("2024-07-15T17:00:00Z")},
+	}
+	if err := s.SaveComments("OPS-2", comments); err != nil {
+		t.Fatalf("SaveComments() error = %v", err)
+	}
+	if err := s.SaveComments("OPS-2", comments[1:]); err != nil {
+		t.Fatalf("SaveComments() error = %v", err)
+	}
+
+	byIssue, err := s.Comments(time.Time{})
+	if err != nil {
+		t.Fatalf("Comments() error = %v", err)
+	}
+	if got := byIssue["OPS-2"]; len(got) != 2 || got[0].Body.Text != "Started" {
+		t.Errorf("expected two comments oldest first, got %+v", got)
+	}
+}
+
+func TestTeamIssuesKeptApart(t *testing.T) {
+	s := openTestStore(t)
+
+	issue := jira.Issue{Key: "WEB-7", Fields: jira.Fields{Summary: "Checkout page", Updated: jiraTime("2024-07-15T09:00:00Z")}}
+	issue.Fields.Assignee = &jira.User{AccountID: "a1", DisplayName: "Ana"}
+	if err := s.SaveTeamIssues([]jira.Issue{issue}); err != nil {
+		t.Fatalf("SaveTeamIssues() error = %v", err)
+	}
+
+	team, err := s.TeamIssues(jiraTime("2024-07-14T00:00:00Z").Time)
+	if err != nil {
+		t.Fatalf("TeamIssues() error = %v", err)
+	}
+	if len(team) != 1 || team[0].Fields.Assignee == nil || team[0].Fields.Assignee.DisplayName != "Ana" {
+		t.Errorf("expected the team issue with its assignee, got %+v", team)
+	}
+	if own, err := s.Issues(time.Time{}); err != nil || len(own) != 0 {
+		t.Errorf("expected team issues not to be among your own issues, got %+v (%v)", own, err)
+	}
+}
+
+func TestState(t *testing.T) {
+	s := openTestStore(t)
+
+	var lastSync time.Time
+	if found, err := s.State("last_sync", &lastSync); err != nil || found {
+		t.Fatalf("expected no state, got found=%t err=%v", found, err)
+	}
+
+	now := time.Date(2024, 7, 15, 10, 0, 0, 0, time.UTC)
+	if err := s.SetState("last_sync", now); err != nil {
+		t.Fatalf("SetState() error = %v", err)
+	}
+	if found, err := s.State("last_sync", &lastSync); err != nil || !found || !lastSync.Equal(now) {
+		t.Errorf("expected %v, got %v (found=%t err=%v)", now, lastSync, found, err)
+	}
+}
+
+func TestLLMSummaries(t *testing.T) {
+	s := openTestStore(t)
+
+	if _, found, err := s.LLMSummary("abc"); err != nil || found {
+		t.Fatalf("expected no summary, got found=%t err=%v", found, err)
+	}
+	if err := s.SaveLLMSummary("abc", "Upgraded the ingress controller"); err != nil {
+		t.Fatalf("SaveLLMSummary() error = %v", err)
+	}
+	if err := s.SaveLLMSummary("abc", "Upgraded the ingress controller in staging"); err != nil {
+		t.Fatalf("SaveLLMSummary() error = %v", err)
+	}
+	if summary, found, err := s.LLMSummary("abc"); err != nil || !found || summary != "Upgraded the ingress controller in staging" {
+		t.Errorf("expected the latest summary, got %q (found=%t err=%v)", summary, found, err)
+	}
+}
+
+func TestDailyActivity(t *testing.T) {
+	s := openTestStore(t)
+
+	day := func(d, hour int) jira.JiraTime {
+		return jira.JiraTime{Time: time.Date(2024, 7, d, hour, 0, 0, 0, time.Local)}
+	}
+	s.SaveComments("OPS-1", []jira.Comment{{ID: "1", Created: day(15, 9)}, {ID: "2", Created: day(15, 11)}})
+	s.SaveComments("OPS-2", []jira.Comment{{ID: "3", Created: day(15, 14)}, {ID: "4", Created: day(17, 10)}})
+	s.SaveWorklogs([]jira.WorklogEntry{
+		{ID: "w1", Started: day(15, 9), TimeSpentSeconds: 5400},
+		{ID: "w2", Started: day(15, 13), TimeSpentSeconds: 3600},
+	})
+	s.SaveReport(ReportRecord{Date: "2024-07-15", Format: "markdown", GeneratedAt: time.Now(), Content: "# Report"})
+
+	activity, err := s.DailyActivity(time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local), time.Date(2024, 7, 17, 0, 0, 0, 0, time.Local))
+	if err != nil {
+		t.Fatalf("DailyActivity() error = %v", err)
+	}
+
+	expected := []DayActivity{
+		{Date: "2024-07-15", Comments: 3, Issues: 2, Worklogs: 2, HoursLogged: 2.5, Reported: true},
+		{Date: "2024-07-16"},
+		{Date: "2024-07-17", Comments: 1, Issues: 1},
+	}
+	if len(activity) != len(expected) {
+		t.Fatalf("expected %d days, got %+v", len(expected), activity)
+	}
+	for i := range expected {
+		if activity[i] != expected[i] {
+			t.Errorf("day %d: expected %+v, got %+v", i, expected[i], activity[i])
+		}
+	}
+
+	reports, err := s.Reports("2024-07-01", "2024-07-31")
+	if err != nil || len(reports) != 1 || reports[0].Content != "# Report" {
+		t.Errorf("expected one stored report, got %+v (err=%v)", reports, err)
+	}
+}
+
+func TestReportIssuesAndNewlyCompleted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+
+	// A database created before reports kept their issues
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	if _, err := db.Exec(`CREATE TABLE reports (date TEXT NOT NULL, format TEXT NOT NULL, generated_at TEXT NOT NULL,
+		issue_count INTEGER NOT NULL, comment_count INTEGER NOT NULL, worklog_count INTEGER NOT NULL, content TEXT NOT NULL,
+		PRIMARY KEY (date, format));
+		INSERT INTO reports VALUES ('2024-07-12', 'markdown', '2024-07-12T08:00:00.000Z', 1, 0, 0, '# Old report')`); err != nil {
+		t.Fatalf("failed to create old schema: %v", err)
+	}
+	db.Close()
+
+	s, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer s.Close()
+
+	s.SaveReport(ReportRecord{Date: "2024-07-15", Format: "markdown", GeneratedAt: time.Now(), Content: "# Monday", Issues: []ReportIssue{
+		{Key: "OPS-1", Summary: "Rotate certificates", Status: "Done", Done: true},
+		{Key: "OPS-2", Summary: "Upgrade cluster", Status: "In Progress"},
+	}})
+	s.SaveReport(ReportRecord{Date: "2024-07-16", Format: "markdown", GeneratedAt: time.Now(), Content: "# Tuesday", Issues: []ReportIssue{
+		{Key: "OPS-1", Summary: "Rotate certificates", Status: "Done", Done: true},
+		{Key: "OPS-2", Summary: "Upgrade cluster", Status: "Done", Done: true},
+		{Key: "OPS-3", Summary: "Fix alert", Status: "Closed", Done: true},
+	}})
+
+	reports, err := s.Reports("2024-07-01", "2024-07-31")
+	if err != nil || len(reports) != 3 {
+		t.Fatalf("expected three reports, got %+v (err=%v)", reports, err)
+	}
+	if reports[0].Issues != nil || reports[0].Content != "# Old report" {
+		t.Errorf("expected the old report without issues, got %+v", reports[0])
+	}
+
+	completed := NewlyCompleted(reports[1], reports[2])
+	if len(completed) != 2 || completed[0].Key != "OPS-2" || completed[1].Key != "OPS-3" {
+		t.Errorf("expected OPS-2 and OPS-3 newly completed, got %+v", completed)
+	}
+}
+
+func TestTimeInStatus(t *testing.T) {
+	s := openTestStore(t)
+
+	at := func(day, hour int) jira.JiraTime {
+		return jira.JiraTime{Time: time.Date(2024, 7, day, hour, 0, 0, 0, time.UTC)}
+	}
+	issue := func(key, status, category string, updated jira.JiraTime) jira.Issue {
+		return jira.Issue{Key: key, Fields: jira.Fields{
+			Status:  jira.Status{Name: status, Category: jira.StatusCategory{Key: category}},
+			Updated: updated,
+		}}
+	}
+
+	// OPS-1 moves from To Do to In Progress to Done; OPS-2 stays In Progress and is synced twice
+	s.SaveIssues([]jira.Issue{issue("OPS-1", "To Do", "new", at(15, 9)), issue("OPS-2", "In Progress", "indeterminate", at(15, 12))})
+	s.SaveIssues([]jira.Issue{issue("OPS-1", "In Progress", "indeterminate", at(15, 13)), issue("OPS-2", "In Progress", "indeterminate", at(16, 8))})
+	s.SaveIssues([]jira.Issue{issue("OPS-1", "Done", "done", at(16, 13))})
+
+	times, err := s.TimeInStatus(at(15, 0).Time, at(17, 0).Time)
+	if err != nil {
+		t.Fatalf("TimeInStatus() error = %v", err)
+	}
+	expected := []StatusTime{
+		{Status: "In Progress", Total: 24*time.Hour + 36*time.Hour, Issues: 2},
+		{Status: "To Do", Total: 4 * time.Hour, Issues: 1},
+	}
+	if len(times) != len(expected) {
+		t.Fatalf("expected %+v, got %+v", expected, times)
+	}
+	for i := range expected {
+		if times[i] != expected[i] {
+			t.Errorf("status %d: expected %+v, got %+v", i, expected[i], times[i])
+		}
+	}
+
+	since, err := s.StatusSince()
+	if err != nil || !since["OPS-1"].Equal(at(16, 13).Time) || !since["OPS-2"].Equal(at(15, 12).Time) {
+		t.Errorf("expected OPS-1 done since the 16th and OPS-2 in progress since the 15th, got %v (err=%v)", since, err)
+	}
+}
+
+func TestTransitionsMergeAcrossSaves(t *testing.T) {
+	s := openTestStore(t)
+
+	review := jira.StatusTransition{ID: "100", From: "In Progress", To: "In Review", At: jiraTime("2024-07-15T11:00:00Z")}
+	done := jira.StatusTransition{ID: "101", From: "In Review", To: "Done", At: jiraTime("2024-07-15T15:20:00Z")}
+	issue := jira.Issue{Key: "OPS-1", Fields: jira.Fields{Updated: jiraTime("2024-07-15T15:20:00Z")}, Transitions: []jira.StatusTransition{review}}
+	if err := s.SaveIssues([]jira.Issue{issue}); err != nil {
+		t.Fatalf("SaveIssues() error = %v", err)
+	}
+	if err := s.SaveTransitions("OPS-1", []jira.StatusTransition{review}); err != nil {
+		t.Fatalf("SaveTransitions() error = %v", err)
+	}
+	// An incremental sync only sees the later transition
+	if err := s.SaveTransitions("OPS-1", []jira.StatusTransition{done}); err != nil {
+		t.Fatalf("SaveTransitions() error = %v", err)
+	}
+
+	transitions, err := s.Transitions(jiraTime("2024-07-15T00:00:00Z").Time)
+	if err != nil {
+		t.Fatalf("Transitions() error = %v", err)
+	}
+	if got := transitions["OPS-1"]; len(got) != 2 || got[0].To != "In Review" || got[1].To != "Done" {
+		t.Errorf("expected both transitions oldest first, got %+v", got)
+	}
+
+	issues, _ := s.Issues(time.Time{})
+	if len(issues) != 1 || issues[0].Transitions != nil {
+		t.Errorf("expected transitions to be kept out of the issue record, got %+v", issues)
+	}
+}
@@ -0,0 +1,331 @@
+package syncstate
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// StateFileName is the name of the encrypted state object in every backend
+const StateFileName = "my-day-state.enc"
+
+// Config represents sync-state backend configuration
+type Config struct {
+	Backend   string // dir, webdav, git, s3
+	Path      string // dir: target directory; git: local checkout directory
+	URL       string // webdav: collection URL; git: repository URL
+	Username  string // webdav basic auth
+	Password  string // webdav basic auth
+	Bucket    string // s3
+	Region    string // s3
+	Endpoint  string // s3-compatible endpoint (MinIO, R2); defaults to AWS
+	AccessKey string // s3
+	SecretKey string // s3
+	Prefix    string // s3 key prefix
+}
+
+// Backend stores and retrieves the encrypted state blob
+type Backend interface {
+	Name() string
+	Put(data []byte) error
+	Get() ([]byte, error)
+}
+
+// NewBackend creates the backend selected in the configuration
+func NewBackend(config Config) (Backend, error) {
+	switch config.Backend {
+	case "dir":
+		if config.Path == "" {
+			return nil, fmt.Errorf("sync_state.path is required for the dir backend")
+		}
+		return &dirBackend{dir: expandHome(config.Path)}, nil
+	case "webdav":
+		if config.URL == "" {
+			return nil, fmt.Errorf("sync_state.url is required for the webdav backend")
+		}
+		return &webdavBackend{config: config, client: &http.Client{Timeout: 60 * time.Second}}, nil
+	case "git":
+		if config.URL == "" {
+			return nil, fmt.Errorf("sync_state.url is required for the git backend")
+		}
+		checkout := config.Path
+		if checkout == "" {
+			homeDir, err := os.UserHomeDir()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get home directory: %w", err)
+			}
+			checkout = filepath.Join(homeDir, ".my-day", "sync-state-repo")
+		}
+		return &gitBackend{url: config.URL, dir: expandHome(checkout)}, nil
+	case "s3":
+		if config.Bucket == "" || config.AccessKey == "" || config.SecretKey == "" {
+			return nil, fmt.Errorf("sync_state.bucket, access_key_id and secret_access_key are required for the s3 backend")
+		}
+		if config.Region == "" {
+			config.Region = "us-east-1"
+		}
+		return &s3Backend{config: config, client: &http.Client{Timeout: 60 * time.Second}}, nil
+	case "":
+		return nil, fmt.Errorf("sync_state.backend not configured (dir, webdav, git, s3)")
+	default:
+		return nil, fmt.Errorf("unsupported sync_state backend: %s (supported: dir, webdav, git, s3)", config.Backend)
+	}
+}
+
+// dirBackend stores state in a local directory, e.g. a Dropbox or Syncthing folder
+type dirBackend struct {
+	dir string
+}
+
+func (b *dirBackend) Name() string { return "dir:" + b.dir }
+
+func (b *dirBackend) Put(data []byte) error {
+	if err := os.MkdirAll(b.dir, 0700); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	return os.WriteFile(filepath.Join(b.dir, StateFileName), data, 0600)
+}
+
+func (b *dirBackend) Get() ([]byte, error) {
+	return os.ReadFile(filepath.Join(b.dir, StateFileName))
+}
+
+// webdavBackend stores state on a WebDAV server (Nextcloud, ownCloud, etc.)
+type webdavBackend struct {
+	config Config
+	client *http.Client
+}
+
+func (b *webdavBackend) Name() string { return "webdav:" + b.config.URL }
+
+func (b *webdavBackend) objectURL() string {
+	return strings.TrimSuffix(b.config.URL, "/") + "/" + StateFileName
+}
+
+func (b *webdavBackend) Put(data []byte) error {
+	req, err := http.NewRequest("PUT", b.objectURL(), bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if b.config.Username != "" {
+		req.SetBasicAuth(b.config.Username, b.config.Password)
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload state: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to upload state: status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (b *webdavBackend) Get() ([]byte, error) {
+	req, err := http.NewRequest("GET", b.objectURL(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if b.config.Username != "" {
+		req.SetBasicAuth(b.config.Username, b.config.Password)
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download state: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, os.ErrNotExist
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download state: status %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// gitBackend stores state in a git repository using the local git CLI and its credentials
+type gitBackend struct {
+	url string
+	dir string
+}
+
+func (b *gitBackend) Name() string { return "git:" + b.url }
+
+func (b *gitBackend) git(args ...string) error {
+	cmd := exec.Command("git", append([]string{"-C", b.dir}, args...)...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git %s failed: %w: %s", args[0], err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// checkout clones the repository on first use and fast-forwards it afterwards
+func (b *gitBackend) checkout() error {
+	if _, err := os.Stat(filepath.Join(b.dir, ".git")); os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(b.dir), 0700); err != nil {
+			return fmt.Errorf("failed to create checkout directory: %w", err)
+		}
+		output, err := exec.Command("git", "clone", b.url, b.dir).CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("git clone failed: %w: %s", err, strings.TrimSpace(string(output)))
+		}
+		return nil
+	}
+
+	// Pulling an empty repository fails; that is fine for the first push
+	_ = b.git("pull", "--ff-only")
+	return nil
+}
+
+func (b *gitBackend) Put(data []byte) error {
+	if err := b.checkout(); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(b.dir, StateFileName), data, 0600); err != nil {
+		return fmt.Errorf("failed to write state: %w", err)
+	}
+	if err := b.git("add", StateFileName); err != nil {
+		return err
+	}
+	// git commit fails when the state did not change, so only commit a changed state
+	if err := b.git("diff", "--cached", "--quiet"); err != nil {
+		hostname, _ := os.Hostname()
+		if err := b.git("commit", "-m", fmt.Sprintf("my-day state from %s", hostname)); err != nil {
+			return err
+		}
+	}
+	return b.git("push", "origin", "HEAD")
+}
+
+func (b *gitBackend) Get() ([]byte, error) {
+	if err := b.checkout(); err != nil {
+		return nil, err
+	}
+	return os.ReadFile(filepath.Join(b.dir, StateFileName))
+}
+
+// s3Backend stores state in an S3-compatible bucket using path-style requests signed with SigV4
+type s3Backend struct {
+	config Config
+	client *http.Client
+}
+
+func (b *s3Backend) Name() string { return "s3://" + b.config.Bucket + "/" + b.key() }
+
+func (b *s3Backend) key() string {
+	prefix := strings.Trim(b.config.Prefix, "/")
+	if prefix == "" {
+		return StateFileName
+	}
+	return prefix + "/" + StateFileName
+}
+
+func (b *s3Backend) objectURL() (string, string) {
+	endpoint := b.config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", b.config.Region)
+	}
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	path := "/" + b.config.Bucket + "/" + b.key()
+	return endpoint + path, path
+}
+
+func (b *s3Backend) Put(data []byte) error {
+	resp, err := b.do("PUT", data)
+	if err != nil {
+		return fmt.Errorf("failed to upload state: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to upload state: status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (b *s3Backend) Get() ([]byte, error) {
+	resp, err := b.do("GET", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download state: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, os.ErrNotExist
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download state: status %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// do sends a request signed with AWS Signature Version 4
+func (b *s3Backend) do(method string, body []byte) (*http.Response, error) {
+	url, path := b.objectURL()
+
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	dateStamp := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	canonicalHeaders := fmt.Sprintf("host:%s\nx-amz-content-sha256:%s\nx-amz-date:%s\n", req.URL.Host, payloadHash, amzDate)
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{method, path, "", canonicalHeaders, signedHeaders, payloadHash}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", dateStamp, b.config.Region)
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+b.config.SecretKey), dateStamp)
+	signingKey = hmacSHA256(signingKey, b.config.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		b.config.AccessKey, scope, signedHeaders, signature))
+
+	return b.client.Do(req)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func expandHome(path string) string {
+	if strings.HasPrefix(path, "~/") {
+		if homeDir, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(homeDir, path[2:])
+		}
+	}
+	return path
+}
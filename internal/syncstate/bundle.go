@@ -0,0 +1,123 @@
+package syncstate
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Paths of the synced files, relative to the ~/.my-day directory.
+// Credentials (auth.json, github-auth.json) and config are never synced.
+const (
+	TicketCacheFile = "cache.json"
+	ReportsDir      = "reports"
+	reportIndexFile = "index.json"
+)
+
+// Bundle packs the ticket cache (JSON exported from the local store) and the cached reports
+// from stateDir into a tar.gz archive. The report index is omitted because it is rebuilt
+// when reports are imported.
+func Bundle(stateDir string, ticketCache []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	if ticketCache != nil {
+		if err := writeArchiveFile(tw, TicketCacheFile, ticketCache); err != nil {
+			return nil, err
+		}
+	}
+
+	var files []string
+	reports, err := filepath.Glob(filepath.Join(stateDir, ReportsDir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cached reports: %w", err)
+	}
+	for _, report := range reports {
+		if filepath.Base(report) == reportIndexFile {
+			continue
+		}
+		files = append(files, path.Join(ReportsDir, filepath.Base(report)))
+	}
+
+	for _, name := range files {
+		data, err := os.ReadFile(filepath.Join(stateDir, filepath.FromSlash(name)))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+
+		if err := writeArchiveFile(tw, name, data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finalize archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeArchiveFile(tw *tar.Writer, name string, data []byte) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write archive header: %w", err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s to archive: %w", name, err)
+	}
+	return nil
+}
+
+// Unbundle extracts an archive created by Bundle into a map of relative path to contents.
+// Entries outside the known layout are ignored.
+func Unbundle(data []byte) (map[string][]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress state: %w", err)
+	}
+	defer gz.Close()
+
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read state archive: %w", err)
+		}
+
+		name := path.Clean(header.Name)
+		if name != TicketCacheFile && !(path.Dir(name) == ReportsDir && strings.HasSuffix(name, ".json")) {
+			continue
+		}
+
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from archive: %w", name, err)
+		}
+		files[name] = content
+	}
+
+	return files, nil
+}
@@ -0,0 +1,146 @@
+package syncstate
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	plaintext := []byte(`{"issues":[]}`)
+
+	encrypted, err := Encrypt(plaintext, "correct horse")
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+	if bytes.Contains(encrypted, plaintext) {
+		t.Error("Encrypted output contains the plaintext")
+	}
+
+	decrypted, err := Decrypt(encrypted, "correct horse")
+	if err != nil {
+		t.Fatalf("Decrypt() error = %v", err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Errorf("Decrypt() = %q, expected %q", decrypted, plaintext)
+	}
+
+	if _, err := Decrypt(encrypted, "wrong"); err == nil {
+		t.Error("Expected error when decrypting with the wrong passphrase")
+	}
+
+	if _, err := Decrypt([]byte("garbage"), "correct horse"); err == nil {
+		t.Error("Expected error when decrypting data that is not a state file")
+	}
+}
+
+func TestBundleRoundTrip(t *testing.T) {
+	stateDir := t.TempDir()
+	reportsDir := filepath.Join(stateDir, ReportsDir)
+	if err := os.MkdirAll(reportsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile := func(path, content string) {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile(filepath.Join(stateDir, "auth.json"), `{"token":"secret"}`)
+	writeFile(filepath.Join(reportsDir, "abc.json"), `{"id":"abc"}`)
+	writeFile(filepath.Join(reportsDir, reportIndexFile), `{"reports":[]}`)
+
+	bundle, err := Bundle(stateDir, []byte(`{"last_sync":"2024-07-15T10:00:00Z"}`))
+	if err != nil {
+		t.Fatalf("Bundle() error = %v", err)
+	}
+
+	files, err := Unbundle(bundle)
+	if err != nil {
+		t.Fatalf("Unbundle() error = %v", err)
+	}
+
+	if len(files) != 2 {
+		t.Errorf("Expected cache and one report, got %d files: %v", len(files), files)
+	}
+	if string(files[TicketCacheFile]) != `{"last_sync":"2024-07-15T10:00:00Z"}` {
+		t.Errorf("Ticket cache content mismatch: %q", files[TicketCacheFile])
+	}
+	if string(files["reports/abc.json"]) != `{"id":"abc"}` {
+		t.Errorf("Report content mismatch: %q", files["reports/abc.json"])
+	}
+	if _, ok := files["auth.json"]; ok {
+		t.Error("Credentials must never be bundled")
+	}
+}
+
+func TestDirBackend(t *testing.T) {
+	backend, err := NewBackend(Config{Backend: "dir", Path: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewBackend() error = %v", err)
+	}
+
+	if _, err := backend.Get(); !os.IsNotExist(err) {
+		t.Errorf("Expected not-exist error before first push, got %v", err)
+	}
+
+	if err := backend.Put([]byte("state")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	data, err := backend.Get()
+	if err != nil || string(data) != "state" {
+		t.Errorf("Get() = %q, %v", data, err)
+	}
+}
+
+func TestGitBackendUnchangedState(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	for _, name := range []string{"GIT_AUTHOR_NAME", "GIT_COMMITTER_NAME"} {
+		t.Setenv(name, "my-day")
+	}
+	for _, name := range []string{"GIT_AUTHOR_EMAIL", "GIT_COMMITTER_EMAIL"} {
+		t.Setenv(name, "my-day@example.com")
+	}
+
+	remote := filepath.Join(t.TempDir(), "state.git")
+	if output, err := exec.Command("git", "init", "--bare", remote).CombinedOutput(); err != nil {
+		t.Fatalf("git init failed: %v: %s", err, output)
+	}
+	backend := &gitBackend{url: remote, dir: filepath.Join(t.TempDir(), "checkout")}
+
+	for i := 0; i < 2; i++ {
+		if err := backend.Put([]byte("state")); err != nil {
+			t.Fatalf("Put() #%d error = %v", i+1, err)
+		}
+	}
+	data, err := backend.Get()
+	if err != nil || string(data) != "state" {
+		t.Errorf("Get() = %q, %v", data, err)
+	}
+}
+
+func TestNewBackendValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+	}{
+		{"Missing backend", Config{}},
+		{"Unknown backend", Config{Backend: "ftp"}},
+		{"Dir without path", Config{Backend: "dir"}},
+		{"WebDAV without URL", Config{Backend: "webdav"}},
+		{"S3 without credentials", Config{Backend: "s3", Bucket: "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewBackend(tt.config); err == nil {
+				t.Error("Expected configuration error")
+			}
+		})
+	}
+}
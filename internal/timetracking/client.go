@@ -0,0 +1,67 @@
+package timetracking
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultTimeout is the default HTTP client timeout
+const DefaultTimeout = 30 * time.Second
+
+// Provider reads the authenticated user's time entries from a time tracker
+type Provider interface {
+	// GetTimeEntries returns the user's time entries that started since the given time
+	GetTimeEntries(ctx context.Context, since time.Time) ([]Entry, error)
+	// TestConnection checks the credentials
+	TestConnection(ctx context.Context) error
+}
+
+// NewProvider creates the client for a time tracker: "toggl" (API token) or "harvest"
+// (personal access token and account ID)
+func NewProvider(provider, token, accountID string) (Provider, error) {
+	if token == "" {
+		return nil, fmt.Errorf("time tracking token not configured. Set time_tracking.token or MY_DAY_TIME_TRACKING_TOKEN")
+	}
+
+	switch strings.ToLower(provider) {
+	case "toggl":
+		return NewTogglClient(token), nil
+	case "harvest":
+		if accountID == "" {
+			return nil, fmt.Errorf("Harvest account ID not configured. Set time_tracking.account_id or MY_DAY_TIME_TRACKING_ACCOUNT_ID")
+		}
+		return NewHarvestClient(token, accountID), nil
+	default:
+		return nil, fmt.Errorf("unsupported time tracker %q (use toggl or harvest)", provider)
+	}
+}
+
+// doJSON sends a request and decodes the JSON response, naming the service in errors
+func doJSON(httpClient *http.Client, req *http.Request, service string, result interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "my-day-cli/1.0")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		if text := strings.TrimSpace(string(message)); text != "" {
+			return fmt.Errorf("%s API error: status %d: %s", service, resp.StatusCode, text)
+		}
+		return fmt.Errorf("%s API error: status %d", service, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
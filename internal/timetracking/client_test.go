@@ -0,0 +1,171 @@
+package timetracking
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+func TestTogglGetTimeEntries(t *testing.T) {
+	since := time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "token" || password != "api_token" {
+			t.Errorf("unexpected basic auth %q/%q", user, password)
+		}
+		if r.URL.Path != "/me/time_entries" || r.URL.Query().Get("start_date") != "2024-07-15T00:00:00Z" {
+			t.Errorf("unexpected request %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.Write([]byte(`[
+			{"id": 1, "description": "OPS-12 runner migration", "start": "2024-07-15T09:00:00Z", "stop": "2024-07-15T10:30:00Z", "duration": 5400, "project_name": "Platform"},
+			{"id": 2, "description": "Standup", "start": "2024-07-15T10:30:00Z", "duration": 900, "tags": ["SEC-7"]}
+		]`))
+	}))
+	defer server.Close()
+
+	entries, err := NewTogglClientWithURL(server.URL, "token").GetTimeEntries(context.Background(), since)
+	if err != nil {
+		t.Fatalf("GetTimeEntries() error = %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %+v", entries)
+	}
+	if entries[0].Project != "Platform" || entries[0].DurationSeconds != 5400 || len(entries[0].IssueKeys) != 1 || entries[0].IssueKeys[0] != "OPS-12" {
+		t.Errorf("unexpected first entry %+v", entries[0])
+	}
+	if len(entries[1].IssueKeys) != 1 || entries[1].IssueKeys[0] != "SEC-7" {
+		t.Errorf("expected issue key from tags, got %+v", entries[1])
+	}
+}
+
+func TestHarvestGetTimeEntries(t *testing.T) {
+	since := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("Harvest-Account-Id") != "42" {
+			t.Errorf("unexpected auth headers %v", r.Header)
+		}
+		switch r.URL.Path {
+		case "/users/me":
+			w.Write([]byte(`{"id": 7}`))
+		case "/time_entries":
+			if r.URL.Query().Get("user_id") != "7" || r.URL.Query().Get("from") != "2024-07-15" {
+				t.Errorf("unexpected time entries query %s", r.URL.RawQuery)
+			}
+			if r.URL.Query().Get("page") == "1" {
+				w.Write([]byte(`{"time_entries": [
+					{"id": 1, "spent_date": "2024-07-15", "started_time": "9:15am", "hours": 1.5, "notes": "Pairing",
+					 "project": {"name": "Platform"}, "external_reference": {"permalink": "https://acme.atlassian.net/browse/OPS-12"}}
+				], "next_page": 2}`))
+				return
+			}
+			w.Write([]byte(`{"time_entries": [
+				{"id": 2, "spent_date": "2024-07-15", "hours": 0.25, "notes": "", "task": {"name": "Meetings"}, "project": {"name": "Internal"},
+				 "created_at": "2024-07-20T10:00:00Z"}
+			], "next_page": null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	entries, err := NewHarvestClientWithURL(server.URL, "token", "42").GetTimeEntries(context.Background(), since)
+	if err != nil {
+		t.Fatalf("GetTimeEntries() error = %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected entries from both pages, got %+v", entries)
+	}
+	if entries[0].DurationSeconds != 5400 || len(entries[0].IssueKeys) != 1 || entries[0].IssueKeys[0] != "OPS-12" {
+		t.Errorf("expected the issue key from the Jira permalink, got %+v", entries[0])
+	}
+	if want := since.Add(9*time.Hour + 15*time.Minute); !entries[0].Start.Equal(want) {
+		t.Errorf("expected start %v, got %v", want, entries[0].Start)
+	}
+	// Added days later: placed at midday of the spent day
+	if want := since.Add(12 * time.Hour); !entries[1].Start.Equal(want) || entries[1].Description != "Meetings" {
+		t.Errorf("unexpected second entry %+v", entries[1])
+	}
+}
+
+func TestMergeWorklogs(t *testing.T) {
+	start := time.Date(2024, 7, 15, 9, 0, 0, 0, time.UTC)
+	worklogs := []jira.WorklogEntry{
+		{ID: "w1", IssueID: "10012", Started: jira.JiraTime{Time: start.Add(2 * time.Minute)}, TimeSpentSeconds: 5400},
+	}
+	entries := []Entry{
+		{Source: "toggl", ID: "1", Description: "OPS-12 runner migration", Start: start, DurationSeconds: 5400, IssueKeys: []string{"OPS-12"}},
+		{Source: "toggl", ID: "2", Description: "OPS-12 load testing", Start: start.Add(3 * time.Hour), DurationSeconds: 1800, IssueKeys: []string{"OPS-12"}},
+		{Source: "harvest", ID: "3", Description: "Standup", Project: "Internal", Start: start.Add(time.Hour), DurationSeconds: 900},
+	}
+
+	merged := MergeWorklogs(worklogs, entries, map[string]string{"10012": "OPS-12"})
+	if len(merged) != 3 {
+		t.Fatalf("expected the duplicate Toggl entry to be left out, got %+v", merged)
+	}
+	if merged[1].IssueID != "OPS-12" || merged[1].Comment != "Toggl: OPS-12 load testing" || merged[1].TimeSpentSeconds != 1800 {
+		t.Errorf("unexpected Toggl worklog %+v", merged[1])
+	}
+	if merged[2].IssueID != "Internal" || merged[2].Comment != "Harvest: Standup" {
+		t.Errorf("expected the project for entries without issue keys, got %+v", merged[2])
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	if _, err := NewProvider("toggl", "", ""); err == nil {
+		t.Error("expected an error without a token")
+	}
+	if _, err := NewProvider("harvest", "token", ""); err == nil {
+		t.Error("expected an error without a Harvest account ID")
+	}
+	if _, err := NewProvider("clockify", "token", ""); err == nil {
+		t.Error("expected an error for an unsupported time tracker")
+	}
+	if provider, err := NewProvider("Toggl", "token", ""); err != nil || provider == nil {
+		t.Errorf("NewProvider(Toggl) = %v, %v", provider, err)
+	}
+}
+
+func TestTempoGetWorklogs(t *testing.T) {
+	since := time.Date(2024, 7, 15, 0, 0, 0, 0, time.Local)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("unexpected auth header %q", r.Header.Get("Authorization"))
+		}
+		if r.URL.Path != "/worklogs/user/abc-123" || r.URL.Query().Get("from") != "2024-07-15" {
+			t.Errorf("unexpected request %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("offset") == "0" {
+			w.Write([]byte(`{"metadata": {"count": 1, "next": "https://api.tempo.io/4/worklogs/user/abc-123?offset=1"}, "results": [
+				{"tempoWorklogId": 11, "issue": {"id": 10001}, "timeSpentSeconds": 5400, "startDate": "2024-07-15", "startTime": "09:30:00", "description": "Runner migration", "author": {"accountId": "abc-123"}}
+			]}`))
+			return
+		}
+		w.Write([]byte(`{"metadata": {"count": 1}, "results": [
+			{"tempoWorklogId": 12, "issue": {"id": 10002}, "timeSpentSeconds": 1800, "startDate": "2024-07-16", "author": {"accountId": "abc-123"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	worklogs, err := NewTempoClientWithURL(server.URL, "token").GetWorklogs(context.Background(), "abc-123", since)
+	if err != nil {
+		t.Fatalf("GetWorklogs() error = %v", err)
+	}
+	if len(worklogs) != 2 {
+		t.Fatalf("expected 2 worklogs, got %+v", worklogs)
+	}
+	if worklogs[0].IssueID != "10001" || worklogs[0].TimeSpentSeconds != 5400 || worklogs[0].Comment != "Runner migration" {
+		t.Errorf("unexpected first worklog %+v", worklogs[0])
+	}
+	if want := time.Date(2024, 7, 15, 9, 30, 0, 0, time.Local); !worklogs[0].Started.Time.Equal(want) {
+		t.Errorf("expected first worklog to start at %v, got %v", want, worklogs[0].Started.Time)
+	}
+	if want := time.Date(2024, 7, 16, 0, 0, 0, 0, time.Local); worklogs[1].IssueID != "10002" || !worklogs[1].Started.Time.Equal(want) {
+		t.Errorf("expected second worklog on 10002 at the start of the day, got %+v", worklogs[1])
+	}
+}
@@ -0,0 +1,136 @@
+package timetracking
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"my-day/internal/issuekeys"
+)
+
+// DefaultHarvestBaseURL is the Harvest v2 API base URL
+const DefaultHarvestBaseURL = "https://api.harvestapp.com/v2"
+
+// HarvestClient is a read-only Harvest client authenticated with a personal access token
+type HarvestClient struct {
+	baseURL    string
+	httpClient *http.Client
+	token      string
+	accountID  string
+}
+
+// NewHarvestClient creates a new Harvest client for the given account
+func NewHarvestClient(token, accountID string) *HarvestClient {
+	return NewHarvestClientWithURL(DefaultHarvestBaseURL, token, accountID)
+}
+
+// NewHarvestClientWithURL creates a new Harvest client with a custom base URL
+func NewHarvestClientWithURL(baseURL, token, accountID string) *HarvestClient {
+	return &HarvestClient{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{Timeout: DefaultTimeout},
+		token:      token,
+		accountID:  accountID,
+	}
+}
+
+// get makes an authenticated GET request to the Harvest API and decodes the JSON response
+func (c *HarvestClient) get(ctx context.Context, endpoint string, params url.Values, result interface{}) error {
+	reqURL := c.baseURL + endpoint
+	if len(params) > 0 {
+		reqURL += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Harvest-Account-Id", c.accountID)
+
+	return doJSON(c.httpClient, req, "Harvest", result)
+}
+
+// GetTimeEntries returns the user's time entries spent since the given date. Harvest tracks
+// days rather than timestamps, so every entry of the since day is included.
+func (c *HarvestClient) GetTimeEntries(ctx context.Context, since time.Time) ([]Entry, error) {
+	// Managers and admins can see everyone's time; limit the entries to the token's user
+	var me harvestUser
+	if err := c.get(ctx, "/users/me", nil, &me); err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	params := url.Values{
+		"user_id":  {strconv.FormatInt(me.ID, 10)},
+		"from":     {since.Format("2006-01-02")},
+		"to":       {time.Now().Format("2006-01-02")},
+		"per_page": {"100"},
+	}
+
+	var entries []Entry
+	for page := 1; ; {
+		params.Set("page", strconv.Itoa(page))
+
+		var result harvestTimeEntriesPage
+		if err := c.get(ctx, "/time_entries", params, &result); err != nil {
+			return nil, fmt.Errorf("failed to get time entries: %w", err)
+		}
+
+		for _, timeEntry := range result.TimeEntries {
+			text := timeEntry.Notes
+			if timeEntry.ExternalReference != nil {
+				text += " " + timeEntry.ExternalReference.Permalink
+			}
+			description := timeEntry.Notes
+			if description == "" {
+				description = timeEntry.Task.Name
+			}
+			entries = append(entries, Entry{
+				Source:          "harvest",
+				ID:              strconv.FormatInt(timeEntry.ID, 10),
+				Description:     description,
+				Project:         timeEntry.Project.Name,
+				Start:           harvestStart(timeEntry),
+				DurationSeconds: int(timeEntry.Hours * 3600),
+				IssueKeys:       issuekeys.Extract(text),
+			})
+		}
+
+		if result.NextPage == nil {
+			break
+		}
+		page = *result.NextPage
+	}
+	return entries, nil
+}
+
+// harvestStart returns when a Harvest entry started. Only accounts with timestamp timers
+// record a start time; otherwise the creation time is used when it falls on the spent day,
+// and midday of the spent day when the entry was added later.
+func harvestStart(timeEntry harvestTimeEntry) time.Time {
+	day, err := time.ParseInLocation("2006-01-02", timeEntry.SpentDate, time.Local)
+	if err != nil {
+		return timeEntry.CreatedAt
+	}
+
+	if started, err := time.Parse("3:04pm", timeEntry.StartedTime); err == nil {
+		return day.Add(time.Duration(started.Hour())*time.Hour + time.Duration(started.Minute())*time.Minute)
+	}
+	if created := timeEntry.CreatedAt.In(time.Local); created.Format("2006-01-02") == timeEntry.SpentDate {
+		return created
+	}
+	return day.Add(12 * time.Hour)
+}
+
+// TestConnection tests the Harvest API connection
+func (c *HarvestClient) TestConnection(ctx context.Context) error {
+	var me harvestUser
+	if err := c.get(ctx, "/users/me", nil, &me); err != nil {
+		return fmt.Errorf("Harvest connection test failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package timetracking
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// DefaultTempoBaseURL is the Tempo Timesheets v4 API base URL
+const DefaultTempoBaseURL = "https://api.tempo.io/4"
+
+// tempoPageSize is how many worklogs are requested per page
+const tempoPageSize = 1000
+
+// TempoClient is a read-only Tempo Timesheets client authenticated with an API token. Unlike
+// the trackers behind Provider, Tempo logs time on Jira issues, so its worklogs replace the
+// native Jira worklogs instead of being merged with them.
+type TempoClient struct {
+	baseURL    string
+	httpClient *http.Client
+	token      string
+}
+
+// NewTempoClient creates a new Tempo client
+func NewTempoClient(token string) *TempoClient {
+	return NewTempoClientWithURL(DefaultTempoBaseURL, token)
+}
+
+// NewTempoClientWithURL creates a new Tempo client with a custom base URL
+func NewTempoClientWithURL(baseURL, token string) *TempoClient {
+	return &TempoClient{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{Timeout: DefaultTimeout},
+		token:      token,
+	}
+}
+
+// get makes an authenticated GET request to the Tempo API and decodes the JSON response
+func (c *TempoClient) get(ctx context.Context, endpoint string, params url.Values, result interface{}) error {
+	reqURL := c.baseURL + endpoint
+	if len(params) > 0 {
+		reqURL += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	return doJSON(c.httpClient, req, "Tempo", result)
+}
+
+// GetWorklogs returns the worklogs the Jira user logged in Tempo since the given date, as Jira
+// worklog entries on the issue IDs. Tempo tracks days, so every worklog of the since day is
+// included.
+func (c *TempoClient) GetWorklogs(ctx context.Context, accountID string, since time.Time) ([]jira.WorklogEntry, error) {
+	params := url.Values{
+		"from":  {since.Format("2006-01-02")},
+		"to":    {time.Now().Format("2006-01-02")},
+		"limit": {strconv.Itoa(tempoPageSize)},
+	}
+
+	var worklogs []jira.WorklogEntry
+	for offset := 0; ; {
+		params.Set("offset", strconv.Itoa(offset))
+
+		var page tempoWorklogsPage
+		if err := c.get(ctx, "/worklogs/user/"+url.PathEscape(accountID), params, &page); err != nil {
+			return nil, fmt.Errorf("failed to get worklogs: %w", err)
+		}
+
+		for _, worklog := range page.Results {
+			started, err := time.ParseInLocation("2006-01-02 15:04:05", worklog.StartDate+" "+worklog.StartTime, time.Local)
+			if err != nil {
+				// The start time is optional; fall back to the start of the day
+				started, err = time.ParseInLocation("2006-01-02", worklog.StartDate, time.Local)
+				if err != nil {
+					continue
+				}
+			}
+			worklogs = append(worklogs, jira.WorklogEntry{
+				ID:               strconv.FormatInt(worklog.TempoWorklogID, 10),
+				Author:           jira.User{AccountID: worklog.Author.AccountID},
+				Comment:          worklog.Description,
+				Started:          jira.JiraTime{Time: started},
+				TimeSpentSeconds: worklog.TimeSpentSeconds,
+				Created:          jira.JiraTime{Time: worklog.CreatedAt},
+				Updated:          jira.JiraTime{Time: worklog.UpdatedAt},
+				IssueID:          strconv.FormatInt(worklog.Issue.ID, 10),
+			})
+		}
+
+		if page.Metadata.Next == "" || len(page.Results) == 0 {
+			break
+		}
+		offset += len(page.Results)
+	}
+	return worklogs, nil
+}
+
+// TestConnection tests the Tempo API connection
+func (c *TempoClient) TestConnection(ctx context.Context) error {
+	params := url.Values{"limit": {"1"}}
+	var page tempoWorklogsPage
+	if err := c.get(ctx, "/worklogs", params, &page); err != nil {
+		return fmt.Errorf("Tempo connection test failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package timetracking
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"my-day/internal/issuekeys"
+)
+
+// DefaultTogglBaseURL is the Toggl Track v9 API base URL
+const DefaultTogglBaseURL = "https://api.track.toggl.com/api/v9"
+
+// TogglClient is a read-only Toggl Track client authenticated with an API token
+type TogglClient struct {
+	baseURL    string
+	httpClient *http.Client
+	token      string
+}
+
+// NewTogglClient creates a new Toggl Track client
+func NewTogglClient(token string) *TogglClient {
+	return NewTogglClientWithURL(DefaultTogglBaseURL, token)
+}
+
+// NewTogglClientWithURL creates a new Toggl Track client with a custom base URL
+func NewTogglClientWithURL(baseURL, token string) *TogglClient {
+	return &TogglClient{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{Timeout: DefaultTimeout},
+		token:      token,
+	}
+}
+
+// get makes an authenticated GET request to the Toggl API and decodes the JSON response
+func (c *TogglClient) get(ctx context.Context, endpoint string, params url.Values, result interface{}) error {
+	reqURL := c.baseURL + endpoint
+	if len(params) > 0 {
+		reqURL += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	// Toggl takes the API token as the basic auth user with the literal password "api_token"
+	req.SetBasicAuth(c.token, "api_token")
+
+	return doJSON(c.httpClient, req, "Toggl", result)
+}
+
+// GetTimeEntries returns the user's time entries that started since the given time,
+// including a running timer
+func (c *TogglClient) GetTimeEntries(ctx context.Context, since time.Time) ([]Entry, error) {
+	now := time.Now()
+	params := url.Values{
+		"start_date": {since.UTC().Format(time.RFC3339)},
+		"end_date":   {now.Add(time.Hour).UTC().Format(time.RFC3339)},
+		"meta":       {"true"}, // Include project names
+	}
+
+	var timeEntries []togglTimeEntry
+	if err := c.get(ctx, "/me/time_entries", params, &timeEntries); err != nil {
+		return nil, fmt.Errorf("failed to get time entries: %w", err)
+	}
+
+	var entries []Entry
+	for _, timeEntry := range timeEntries {
+		duration := timeEntry.Duration
+		if duration < 0 {
+			duration = int64(now.Sub(timeEntry.Start).Seconds())
+		}
+		entries = append(entries, Entry{
+			Source:          "toggl",
+			ID:              strconv.FormatInt(timeEntry.ID, 10),
+			Description:     timeEntry.Description,
+			Project:         timeEntry.ProjectName,
+			Start:           timeEntry.Start,
+			DurationSeconds: int(duration),
+			IssueKeys:       issuekeys.Extract(timeEntry.Description + " " + strings.Join(timeEntry.Tags, " ")),
+		})
+	}
+	return entries, nil
+}
+
+// TestConnection tests the Toggl API connection
+func (c *TogglClient) TestConnection(ctx context.Context) error {
+	var me struct {
+		ID int64 `json:"id"`
+	}
+	if err := c.get(ctx, "/me", nil, &me); err != nil {
+		return fmt.Errorf("Toggl connection test failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package timetracking
+
+import "time"
+
+// Entry is a time entry imported from a time tracker
+type Entry struct {
+	Source          string    `json:"source"`           // toggl or harvest
+	ID              string    `json:"id"`               // Entry ID in the time tracker
+	Description     string    `json:"description"`      // What the time was spent on
+	Project         string    `json:"project"`          // Time tracker project name
+	Start           time.Time `json:"start"`            // When the entry started
+	DurationSeconds int       `json:"duration_seconds"` // Time spent; running timers count up to now
+	IssueKeys       []string  `json:"issue_keys"`       // Issue keys found in the description
+}
+
+// togglTimeEntry is a Toggl Track v9 time entry
+type togglTimeEntry struct {
+	ID          int64      `json:"id"`
+	Description string     `json:"description"`
+	Start       time.Time  `json:"start"`
+	Stop        *time.Time `json:"stop"`
+	Duration    int64      `json:"duration"`     // Negative while the timer is running
+	ProjectName string     `json:"project_name"` // Only set with meta=true
+	Tags        []string   `json:"tags"`
+}
+
+// harvestUser is the Harvest user the token belongs to
+type harvestUser struct {
+	ID int64 `json:"id"`
+}
+
+// harvestTimeEntriesPage is a page of Harvest v2 time entries
+type harvestTimeEntriesPage struct {
+	TimeEntries []harvestTimeEntry `json:"time_entries"`
+	NextPage    *int               `json:"next_page"`
+}
+
+// harvestTimeEntry is a Harvest v2 time entry
+type harvestTimeEntry struct {
+	ID          int64     `json:"id"`
+	SpentDate   string    `json:"spent_date"`   // YYYY-MM-DD
+	StartedTime string    `json:"started_time"` // e.g. "8:00am", only with timestamp timers
+	Hours       float64   `json:"hours"`
+	Notes       string    `json:"notes"`
+	CreatedAt   time.Time `json:"created_at"`
+	Project     struct {
+		Name string `json:"name"`
+	} `json:"project"`
+	Task struct {
+		Name string `json:"name"`
+	} `json:"task"`
+	// Set by the Harvest integrations, e.g. the Jira permalink of the issue the timer was started from
+	ExternalReference *struct {
+		Permalink string `json:"permalink"`
+	} `json:"external_reference"`
+}
+
+// tempoWorklogsPage is a page of Tempo v4 worklogs
+type tempoWorklogsPage struct {
+	Metadata struct {
+		Count int    `json:"count"`
+		Next  string `json:"next"` // URL of the next page, empty on the last page
+	} `json:"metadata"`
+	Results []tempoWorklog `json:"results"`
+}
+
+// tempoWorklog is a Tempo v4 worklog
+type tempoWorklog struct {
+	TempoWorklogID int64 `json:"tempoWorklogId"`
+	Issue          struct {
+		ID int64 `json:"id"`
+	} `json:"issue"`
+	TimeSpentSeconds int       `json:"timeSpentSeconds"`
+	StartDate        string    `json:"startDate"` // YYYY-MM-DD
+	StartTime        string    `json:"startTime"` // HH:MM:SS
+	Description      string    `json:"description"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+	Author           struct {
+		AccountID string `json:"accountId"`
+	} `json:"author"`
+}
@@ -0,0 +1,88 @@
+package timetracking
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"my-day/internal/jira"
+)
+
+// duplicateWindow is how close a Jira worklog must start to a time entry on the same issue
+// for the two to be treated as the same work, as when the tracker is synced into Jira
+const duplicateWindow = 5 * time.Minute
+
+// MergeWorklogs adds time entries to Jira worklogs so they show in the Work Log section and
+// reach the LLM. An entry is logged against the first issue key in its description, or its
+// project when it names no issue. Entries that duplicate a Jira worklog are left out.
+// issueKeys maps Jira issue IDs, which worklogs refer to, to issue keys.
+func MergeWorklogs(worklogs []jira.WorklogEntry, entries []Entry, issueKeys map[string]string) []jira.WorklogEntry {
+	merged := append([]jira.WorklogEntry{}, worklogs...)
+
+	for _, entry := range entries {
+		if isLoggedInJira(entry, worklogs, issueKeys) {
+			continue
+		}
+
+		issue := entry.Project
+		if len(entry.IssueKeys) > 0 {
+			issue = entry.IssueKeys[0]
+		}
+		if issue == "" {
+			issue = sourceName(entry.Source)
+		}
+
+		comment := fmt.Sprintf("%s: %s", sourceName(entry.Source), entry.Description)
+		if entry.Description == "" {
+			comment = sourceName(entry.Source)
+		}
+
+		merged = append(merged, jira.WorklogEntry{
+			ID:               entry.Source + "-" + entry.ID,
+			IssueID:          issue,
+			Comment:          comment,
+			Started:          jira.JiraTime{Time: entry.Start},
+			TimeSpentSeconds: entry.DurationSeconds,
+		})
+	}
+
+	return merged
+}
+
+// isLoggedInJira reports whether a Jira worklog on one of the entry's issues starts close to the entry
+func isLoggedInJira(entry Entry, worklogs []jira.WorklogEntry, issueKeys map[string]string) bool {
+	for _, worklog := range worklogs {
+		key := issueKeys[worklog.IssueID]
+		if key == "" {
+			key = worklog.IssueID
+		}
+		if !containsFold(entry.IssueKeys, key) {
+			continue
+		}
+		if diff := worklog.Started.Time.Sub(entry.Start); diff > -duplicateWindow && diff < duplicateWindow {
+			return true
+		}
+	}
+	return false
+}
+
+// sourceName returns the display name of a time tracker
+func sourceName(source string) string {
+	switch source {
+	case "toggl":
+		return "Toggl"
+	case "harvest":
+		return "Harvest"
+	default:
+		return source
+	}
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+// Package trace tags the requests my-day sends to Jira and the LLM with a request ID made of
+// the run ID of the process and a sequence number, e.g. 3f9a1c2e-17. The ID is sent in the
+// X-Request-ID header, logged at debug level and included in error messages, so an
+// intermittent failure during a sync can be matched with the server-side logs.
+package trace
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"my-day/internal/metrics"
+)
+
+// Header is the request header carrying the request ID
+const Header = "X-Request-ID"
+
+var (
+	runID    = newRunID()
+	sequence atomic.Int64
+
+	mu  sync.Mutex
+	out io.Writer // Debug log of requests, nil when disabled
+)
+
+// newRunID returns a random ID for this run of my-day
+func newRunID() string {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%08x", uint32(time.Now().UnixNano()))
+	}
+	return hex.EncodeToString(b)
+}
+
+// RunID returns the ID shared by every request of this run
+func RunID() string {
+	return runID
+}
+
+// SetOutput enables the debug log of requests to w, or disables it when w is nil
+func SetOutput(w io.Writer) {
+	mu.Lock()
+	defer mu.Unlock()
+	out = w
+}
+
+// Enabled reports whether the debug log of requests is enabled
+func Enabled() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return out != nil
+}
+
+// Logf writes a line to the debug log of requests when it is enabled
+func Logf(format string, args ...interface{}) {
+	mu.Lock()
+	defer mu.Unlock()
+	if out != nil {
+		fmt.Fprintf(out, "[trace] "+format+"\n", args...)
+	}
+}
+
+// NextID returns the ID of the next request of this run
+func NextID() string {
+	return fmt.Sprintf("%s-%d", runID, sequence.Add(1))
+}
+
+// ID returns the request ID of the request a response answers, or "" for an untagged request
+func ID(resp *http.Response) string {
+	if resp == nil || resp.Request == nil {
+		return ""
+	}
+	return resp.Request.Header.Get(Header)
+}
+
+// Errorf formats an error about a response, naming the request ID when the request was tagged
+func Errorf(resp *http.Response, format string, args ...interface{}) error {
+	if id := ID(resp); id != "" {
+		return fmt.Errorf(format+" (request %s)", append(args, id)...)
+	}
+	return fmt.Errorf(format, args...)
+}
+
+// transport tags and logs the requests of a service
+type transport struct {
+	service string
+	base    http.RoundTripper
+}
+
+// Transport returns a round tripper that tags every request of service (e.g. "jira") with a
+// request ID before sending it with base, or with http.DefaultTransport when base is nil.
+// Every attempt of a retried request gets an ID of its own and is counted in the metrics.
+func Transport(service string, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{service: service, base: base}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	id := NextID()
+	req.Header.Set(Header, id)
+	target := req.URL.Host + req.URL.Path
+
+	Logf("%s %s %s %s", id, t.service, req.Method, target)
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	duration := time.Since(start)
+	elapsed := duration.Round(time.Millisecond)
+	if err != nil {
+		metrics.ObserveRequest(t.service, 0, duration)
+		Logf("%s %s %s %s failed after %v: %v", id, t.service, req.Method, target, elapsed, err)
+		return nil, fmt.Errorf("%w (request %s)", err, id)
+	}
+	metrics.ObserveRequest(t.service, resp.StatusCode, duration)
+	Logf("%s %s %s %s -> %d in %v", id, t.service, req.Method, target, resp.StatusCode, elapsed)
+	return resp, nil
+}
@@ -0,0 +1,64 @@
+package trace
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTransportTagsRequests(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(Header))
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	var log bytes.Buffer
+	SetOutput(&log)
+	defer SetOutput(nil)
+
+	client := &http.Client{Transport: Transport("jira", nil)}
+	var errs []error
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL + "/rest/api/3/myself")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		errs = append(errs, Errorf(resp, "failed to get user info: status %d", resp.StatusCode))
+	}
+
+	if len(received) != 2 || received[0] == received[1] {
+		t.Fatalf("expected two distinct request IDs, got %v", received)
+	}
+	for i, id := range received {
+		if !strings.HasPrefix(id, RunID()+"-") {
+			t.Errorf("request ID %q does not start with the run ID %q", id, RunID())
+		}
+		if want := "failed to get user info: status 502 (request " + id + ")"; errs[i].Error() != want {
+			t.Errorf("unexpected error %q, expected %q", errs[i], want)
+		}
+		if !strings.Contains(log.String(), id+" jira GET ") || !strings.Contains(log.String(), "/rest/api/3/myself -> 502 in ") {
+			t.Errorf("request %s missing from the debug log:\n%s", id, log.String())
+		}
+	}
+
+	if err := Errorf(nil, "status %d", 500); err.Error() != "status 500" {
+		t.Errorf("untagged responses should not name a request, got %q", err)
+	}
+}
+
+func TestTransportNamesRequestInConnectionErrors(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	client := &http.Client{Transport: Transport("ollama", nil)}
+	_, err := client.Get(url + "/api/tags")
+	if err == nil || !strings.Contains(err.Error(), "(request "+RunID()+"-") {
+		t.Errorf("expected the request ID in the connection error, got %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+package trello
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"my-day/internal/issuekeys"
+)
+
+const (
+	// DefaultBaseURL is the Trello REST API base URL
+	DefaultBaseURL = "https://api.trello.com/1"
+
+	// DefaultTimeout is the default HTTP client timeout
+	DefaultTimeout = 30 * time.Second
+)
+
+// Client is a read-only Trello API client authenticated with an API key and token
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	apiKey     string
+	token      string
+}
+
+// NewClient creates a new Trello client. Both the API key and the user token are required.
+func NewClient(apiKey, token string) *Client {
+	return NewClientWithURL(DefaultBaseURL, apiKey, token)
+}
+
+// NewClientWithURL creates a new Trello client with a custom base URL
+func NewClientWithURL(baseURL, apiKey, token string) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{Timeout: DefaultTimeout},
+		apiKey:     apiKey,
+		token:      token,
+	}
+}
+
+// get makes an authenticated GET request to the Trello API and decodes the JSON response
+func (c *Client) get(ctx context.Context, endpoint string, params url.Values, result interface{}) error {
+	reqURL := c.baseURL + endpoint
+	if len(params) > 0 {
+		reqURL += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	// The OAuth header keeps the key and token out of request URLs and logs
+	req.Header.Set("Authorization", fmt.Sprintf(`OAuth oauth_consumer_key="%s", oauth_token="%s"`, c.apiKey, c.token))
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "my-day-cli/1.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// Trello returns plain text errors such as "invalid token"
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		if text := strings.TrimSpace(string(message)); text != "" {
+			return fmt.Errorf("Trello API error: %s", text)
+		}
+		return fmt.Errorf("Trello API error: status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// GetCurrentMember returns the member the token belongs to
+func (c *Client) GetCurrentMember(ctx context.Context) (*Member, error) {
+	var member Member
+	if err := c.get(ctx, "/members/me", url.Values{"fields": {"username,fullName"}}, &member); err != nil {
+		return nil, fmt.Errorf("failed to get current member: %w", err)
+	}
+	return &member, nil
+}
+
+// GetMemberActions returns the cards the user created, moved between lists or commented on since the given time
+func (c *Client) GetMemberActions(ctx context.Context, since time.Time) ([]Action, error) {
+	params := url.Values{
+		"filter": {"createCard,commentCard,updateCard:idList"},
+		"limit":  {"1000"},
+	}
+	if !since.IsZero() {
+		params.Set("since", since.UTC().Format(time.RFC3339))
+	}
+
+	var actions []Action
+	if err := c.get(ctx, "/members/me/actions", params, &actions); err != nil {
+		return nil, fmt.Errorf("failed to get actions: %w", err)
+	}
+	return actions, nil
+}
+
+// GetUserActivity returns the cards the user created, moved or commented on since the given time.
+// With boards listed (by ID, short link or name), only activity on those boards is returned.
+func (c *Client) GetUserActivity(ctx context.Context, since time.Time, boards []string) ([]Activity, error) {
+	actions, err := c.GetMemberActions(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	var activities []Activity
+	for _, action := range actions {
+		if len(boards) > 0 && !matchesBoard(action.Data.Board, boards) {
+			continue
+		}
+
+		activity := Activity{
+			ID:          action.ID,
+			Title:       action.Data.Card.Name,
+			Board:       action.Data.Board.Name,
+			CreatedAt:   action.Date,
+			JiraTickets: issuekeys.Extract(action.Data.Card.Name + " " + action.Data.Text),
+		}
+		if action.Data.Card.ShortLink != "" {
+			activity.URL = "https://trello.com/c/" + action.Data.Card.ShortLink
+		}
+		if action.Data.List != nil {
+			activity.List = action.Data.List.Name
+		}
+
+		switch action.Type {
+		case "createCard":
+			activity.Type = "created"
+		case "commentCard":
+			activity.Type = "commented"
+			activity.Description = action.Data.Text
+		case "updateCard":
+			if action.Data.ListAfter == nil {
+				continue
+			}
+			activity.Type = "moved"
+			activity.List = action.Data.ListAfter.Name
+			if action.Data.ListBefore != nil {
+				activity.FromList = action.Data.ListBefore.Name
+			}
+		default:
+			continue
+		}
+		activities = append(activities, activity)
+	}
+	return activities, nil
+}
+
+// matchesBoard reports whether a board is one of the configured boards
+func matchesBoard(board BoardRef, boards []string) bool {
+	for _, configured := range boards {
+		if configured == board.ID || configured == board.ShortLink || strings.EqualFold(configured, board.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// TestConnection tests the Trello API connection
+func (c *Client) TestConnection(ctx context.Context) error {
+	if _, err := c.GetCurrentMember(ctx); err != nil {
+		return fmt.Errorf("Trello connection test failed: %w", err)
+	}
+	return nil
+}
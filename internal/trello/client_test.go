@@ -0,0 +1,61 @@
+package trello
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetUserActivity(t *testing.T) {
+	since := time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Authorization"), `oauth_token="token"`) {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte("invalid token"))
+			return
+		}
+		if r.URL.Path != "/members/me/actions" || r.URL.Query().Get("since") != "2024-07-15T00:00:00Z" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`[
+			{"id": "a1", "type": "updateCard", "date": "2024-07-15T10:00:00Z", "data": {
+				"card": {"name": "OPS-101 Vendor contract", "shortLink": "abc"}, "board": {"id": "b1", "name": "Side work"},
+				"listBefore": {"name": "Doing"}, "listAfter": {"name": "Done"}}},
+			{"id": "a2", "type": "commentCard", "date": "2024-07-15T11:00:00Z", "data": {
+				"text": "Sent to legal", "card": {"name": "Vendor contract", "shortLink": "abc"}, "board": {"id": "b1", "name": "Side work"},
+				"list": {"name": "Done"}}},
+			{"id": "a3", "type": "updateCard", "date": "2024-07-15T12:00:00Z", "data": {
+				"card": {"name": "Renamed card"}, "board": {"id": "b1", "name": "Side work"}}},
+			{"id": "a4", "type": "createCard", "date": "2024-07-15T13:00:00Z", "data": {
+				"card": {"name": "Other board card"}, "board": {"id": "b2", "name": "Personal"}, "list": {"name": "Inbox"}}}
+		]`))
+	}))
+	defer server.Close()
+
+	activities, err := NewClientWithURL(server.URL, "key", "token").GetUserActivity(context.Background(), since, []string{"side work"})
+	if err != nil {
+		t.Fatalf("GetUserActivity() error = %v", err)
+	}
+	if len(activities) != 2 {
+		t.Fatalf("expected a move and a comment on the configured board, got %+v", activities)
+	}
+
+	moved := activities[0]
+	if moved.Type != "moved" || moved.FromList != "Doing" || moved.List != "Done" || moved.URL != "https://trello.com/c/abc" {
+		t.Errorf("unexpected move activity: %+v", moved)
+	}
+	if len(moved.JiraTickets) != 1 || moved.JiraTickets[0] != "OPS-101" {
+		t.Errorf("move Jira tickets = %v, want [OPS-101]", moved.JiraTickets)
+	}
+	if comment := activities[1]; comment.Type != "commented" || comment.Description != "Sent to legal" || comment.List != "Done" {
+		t.Errorf("unexpected comment activity: %+v", comment)
+	}
+
+	if _, err := NewClientWithURL(server.URL, "key", "wrong").GetUserActivity(context.Background(), since, nil); err == nil || !strings.Contains(err.Error(), "invalid token") {
+		t.Errorf("expected Trello API error, got %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package trello
+
+import "time"
+
+// Member represents a Trello member
+type Member struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	FullName string `json:"fullName"`
+}
+
+// Action represents an entry of a member's Trello action history
+type Action struct {
+	ID            string     `json:"id"`
+	Type          string     `json:"type"` // createCard, updateCard, commentCard, ...
+	Date          time.Time  `json:"date"`
+	Data          ActionData `json:"data"`
+	MemberCreator Member     `json:"memberCreator"`
+}
+
+// ActionData holds the objects an action refers to. Only the fields used by my-day are decoded.
+type ActionData struct {
+	Text       string   `json:"text"` // Comment text for commentCard
+	Card       CardRef  `json:"card"`
+	Board      BoardRef `json:"board"`
+	List       *ListRef `json:"list"`
+	ListBefore *ListRef `json:"listBefore"` // Set when a card moved between lists
+	ListAfter  *ListRef `json:"listAfter"`
+}
+
+// CardRef identifies the card an action refers to
+type CardRef struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	ShortLink string `json:"shortLink"`
+}
+
+// BoardRef identifies the board an action happened on
+type BoardRef struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	ShortLink string `json:"shortLink"`
+}
+
+// ListRef identifies a list on a board
+type ListRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Activity represents a card the user created, moved or commented on
+type Activity struct {
+	Type        string    `json:"type"`         // created, moved, commented
+	ID          string    `json:"id"`           // Trello action ID
+	Title       string    `json:"title"`        // Card name
+	Description string    `json:"description"`  // Comment text
+	URL         string    `json:"url"`          // Link to the card
+	Board       string    `json:"board"`        // Board name
+	FromList    string    `json:"from_list"`    // List the card moved from
+	List        string    `json:"list"`         // List the card is in after the action
+	CreatedAt   time.Time `json:"created_at"`   // When the action happened
+	JiraTickets []string  `json:"jira_tickets"` // Linked Jira ticket keys
+}
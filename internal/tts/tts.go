@@ -0,0 +1,317 @@
+// Package tts reads text aloud or saves it as an audio file, for voice standup updates. The
+// local engine uses the speech synthesizer that ships with the operating system (say on macOS,
+// espeak-ng or espeak on Linux, System.Speech on Windows); the openai engine uses an
+// OpenAI-compatible /audio/speech endpoint.
+package tts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultOpenAIModel is the speech model used by the openai engine
+	DefaultOpenAIModel = "tts-1"
+
+	// DefaultOpenAIVoice is the voice used by the openai engine
+	DefaultOpenAIVoice = "alloy"
+)
+
+// Config selects and configures a speech engine
+type Config struct {
+	Engine           string // local or openai
+	Voice            string // Engine voice name, empty for the engine's default
+	Model            string // Speech model of the openai engine
+	OpenAIURL        string
+	OpenAIAPIKey     string
+	OpenAIAPIVersion string // Set for Azure OpenAI deployments
+}
+
+// Engine turns text into speech
+type Engine interface {
+	// Speak reads the text aloud
+	Speak(ctx context.Context, text string) error
+	// Save writes the text as an audio file, in the format given by the file extension
+	Save(ctx context.Context, text, path string) error
+}
+
+// New returns the speech engine selected in the configuration
+func New(cfg Config) (Engine, error) {
+	switch strings.ToLower(cfg.Engine) {
+	case "", "local":
+		return newLocalEngine(cfg.Voice)
+	case "openai":
+		return newOpenAIEngine(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown TTS engine %q (use local or openai)", cfg.Engine)
+	}
+}
+
+// localEngine runs the operating system's speech synthesizer
+type localEngine struct {
+	voice string
+	tool  string // say, espeak-ng, espeak or powershell
+}
+
+func newLocalEngine(voice string) (*localEngine, error) {
+	var candidates []string
+	switch runtime.GOOS {
+	case "darwin":
+		candidates = []string{"say"}
+	case "windows":
+		candidates = []string{"powershell"}
+	default:
+		candidates = []string{"espeak-ng", "espeak"}
+	}
+	for _, tool := range candidates {
+		if _, err := exec.LookPath(tool); err == nil {
+			return &localEngine{voice: voice, tool: tool}, nil
+		}
+	}
+	return nil, fmt.Errorf("no local speech synthesizer found (looked for %s); install one or set tts.engine to openai", strings.Join(candidates, ", "))
+}
+
+// windowsSpeech is the PowerShell script that speaks standard input, or saves it as a WAV
+// file when $out is set
+const windowsSpeech = `Add-Type -AssemblyName System.Speech
+$s = New-Object System.Speech.Synthesis.SpeechSynthesizer
+if ($voice) { $s.SelectVoice($voice) }
+if ($out) { $s.SetOutputToWaveFile($out) }
+$s.Speak([Console]::In.ReadToEnd())
+$s.Dispose()`
+
+// command returns the synthesizer command speaking standard input, or saving it to the
+// file at out in the synthesizer's own format
+func (e *localEngine) command(ctx context.Context, out string) *exec.Cmd {
+	switch e.tool {
+	case "say":
+		args := []string{"-f", "-"}
+		if e.voice != "" {
+			args = append(args, "-v", e.voice)
+		}
+		if out != "" {
+			args = append(args, "-o", out)
+			if strings.EqualFold(filepath.Ext(out), ".wav") {
+				args = append(args, "--data-format=LEI16@22050")
+			}
+		}
+		return exec.CommandContext(ctx, "say", args...)
+	case "powershell":
+		script := fmt.Sprintf("$voice = '%s'; $out = '%s'\n%s", psQuote(e.voice), psQuote(out), windowsSpeech)
+		return exec.CommandContext(ctx, "powershell", "-NoProfile", "-NonInteractive", "-Command", script)
+	default:
+		args := []string{"--stdin"}
+		if e.voice != "" {
+			args = append(args, "-v", e.voice)
+		}
+		if out != "" {
+			args = append(args, "-w", out)
+		}
+		return exec.CommandContext(ctx, e.tool, args...)
+	}
+}
+
+// nativeFormats returns the file extensions the synthesizer writes itself
+func (e *localEngine) nativeFormats() []string {
+	if e.tool == "say" {
+		return []string{".aiff", ".m4a", ".wav", ".caf"}
+	}
+	return []string{".wav"}
+}
+
+func (e *localEngine) Speak(ctx context.Context, text string) error {
+	return runAudioCommand(e.command(ctx, ""), text)
+}
+
+func (e *localEngine) Save(ctx context.Context, text, path string) error {
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, native := range e.nativeFormats() {
+		if ext == native {
+			return runAudioCommand(e.command(ctx, path), text)
+		}
+	}
+
+	// Other formats, such as mp3, are converted with ffmpeg
+	if _, err := exec.LookPath("ffmpeg"); err != nil {
+		return fmt.Errorf("%s cannot write %s files and ffmpeg is not installed to convert them; save as %s instead", e.tool, ext, strings.Join(e.nativeFormats(), ", "))
+	}
+	temp, err := os.MkdirTemp("", "my-day-tts")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(temp)
+
+	native := filepath.Join(temp, "speech"+e.nativeFormats()[0])
+	if err := runAudioCommand(e.command(ctx, native), text); err != nil {
+		return err
+	}
+	return runAudioCommand(exec.CommandContext(ctx, "ffmpeg", "-y", "-loglevel", "error", "-i", native, path), "")
+}
+
+// runAudioCommand runs a speech or audio player command with text on standard input
+func runAudioCommand(cmd *exec.Cmd, text string) error {
+	var stderr bytes.Buffer
+	cmd.Stdin = strings.NewReader(text)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return fmt.Errorf("%s: %s: %w", filepath.Base(cmd.Args[0]), message, err)
+		}
+		return fmt.Errorf("%s: %w", filepath.Base(cmd.Args[0]), err)
+	}
+	return nil
+}
+
+// psQuote escapes a value for a single-quoted PowerShell string
+func psQuote(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
+
+// openAIEngine synthesizes speech with an OpenAI-compatible /audio/speech endpoint
+type openAIEngine struct {
+	baseURL    string
+	apiKey     string
+	apiVersion string
+	model      string
+	voice      string
+	client     *http.Client
+}
+
+func newOpenAIEngine(cfg Config) *openAIEngine {
+	engine := &openAIEngine{
+		baseURL:    strings.TrimSuffix(cfg.OpenAIURL, "/"),
+		apiKey:     cfg.OpenAIAPIKey,
+		apiVersion: cfg.OpenAIAPIVersion,
+		model:      cfg.Model,
+		voice:      cfg.Voice,
+		client:     &http.Client{Timeout: 60 * time.Second},
+	}
+	if engine.baseURL == "" {
+		engine.baseURL = "https://api.openai.com/v1"
+	}
+	if engine.model == "" {
+		engine.model = DefaultOpenAIModel
+	}
+	if engine.voice == "" {
+		engine.voice = DefaultOpenAIVoice
+	}
+	return engine
+}
+
+// speechRequest is an /audio/speech request
+type speechRequest struct {
+	Model          string `json:"model"`
+	Voice          string `json:"voice"`
+	Input          string `json:"input"`
+	ResponseFormat string `json:"response_format"`
+}
+
+// openAIFormats are the audio formats the speech endpoint returns
+var openAIFormats = map[string]bool{"mp3": true, "wav": true, "opus": true, "aac": true, "flac": true}
+
+func (e *openAIEngine) Save(ctx context.Context, text, path string) error {
+	format := strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	if !openAIFormats[format] {
+		return fmt.Errorf("unsupported audio format %q (use .mp3, .wav, .opus, .aac or .flac)", filepath.Ext(path))
+	}
+
+	audio, err := e.synthesize(ctx, text, format)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, audio, 0644); err != nil {
+		return fmt.Errorf("failed to write audio file: %w", err)
+	}
+	return nil
+}
+
+func (e *openAIEngine) Speak(ctx context.Context, text string) error {
+	temp, err := os.MkdirTemp("", "my-day-tts")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(temp)
+
+	path := filepath.Join(temp, "speech.wav")
+	if err := e.Save(ctx, text, path); err != nil {
+		return err
+	}
+	return play(ctx, path)
+}
+
+// synthesize returns the speech audio in the given format
+func (e *openAIEngine) synthesize(ctx context.Context, text, format string) ([]byte, error) {
+	if e.apiKey == "" {
+		return nil, fmt.Errorf("OpenAI API key not configured. Set llm.openai.api_key or MY_DAY_LLM_OPENAI_API_KEY")
+	}
+
+	body, err := json.Marshal(speechRequest{Model: e.model, Voice: e.voice, Input: text, ResponseFormat: format})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal speech request: %w", err)
+	}
+
+	url := e.baseURL + "/audio/speech"
+	if e.apiVersion != "" {
+		url += "?api-version=" + e.apiVersion
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create speech request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.apiVersion != "" {
+		// Azure OpenAI authenticates with an api-key header
+		req.Header.Set("api-key", e.apiKey)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+e.apiKey)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request speech: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("speech request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	audio, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read speech audio: %w", err)
+	}
+	return audio, nil
+}
+
+// play plays a WAV file with the operating system's audio player
+func play(ctx context.Context, path string) error {
+	var candidates [][]string
+	switch runtime.GOOS {
+	case "darwin":
+		candidates = [][]string{{"afplay", path}}
+	case "windows":
+		candidates = [][]string{{"powershell", "-NoProfile", "-NonInteractive", "-Command", fmt.Sprintf("(New-Object Media.SoundPlayer '%s').PlaySync()", psQuote(path))}}
+	default:
+		candidates = [][]string{{"paplay", path}, {"aplay", "-q", path}, {"ffplay", "-nodisp", "-autoexit", "-loglevel", "error", path}}
+	}
+
+	var players []string
+	for _, args := range candidates {
+		if _, err := exec.LookPath(args[0]); err == nil {
+			return runAudioCommand(exec.CommandContext(ctx, args[0], args[1:]...), "")
+		}
+		players = append(players, args[0])
+	}
+	return fmt.Errorf("no audio player found (looked for %s); save the audio with --speak-output instead", strings.Join(players, ", "))
+}
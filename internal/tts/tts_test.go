@@ -0,0 +1,77 @@
+package tts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOpenAIEngineSave(t *testing.T) {
+	var request speechRequest
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/audio/speech" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		auth = r.Header.Get("Authorization")
+		json.NewDecoder(r.Body).Decode(&request)
+		w.Write([]byte("ID3audio"))
+	}))
+	defer server.Close()
+
+	engine, err := New(Config{Engine: "openai", OpenAIURL: server.URL + "/v1/", OpenAIAPIKey: "sk-test"})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "standup.mp3")
+	if err := engine.Save(context.Background(), "Completed the migration.", path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	if auth != "Bearer sk-test" {
+		t.Errorf("expected the API key as bearer token, got %q", auth)
+	}
+	if request.Input != "Completed the migration." || request.ResponseFormat != "mp3" || request.Model != DefaultOpenAIModel || request.Voice != DefaultOpenAIVoice {
+		t.Errorf("unexpected speech request %+v", request)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "ID3audio" {
+		t.Errorf("expected the returned audio in the file, got %q", data)
+	}
+}
+
+func TestOpenAIEngineErrors(t *testing.T) {
+	engine, _ := New(Config{Engine: "openai", OpenAIAPIKey: "sk-test"})
+	if err := engine.Save(context.Background(), "text", "standup.ogg"); err == nil || !strings.Contains(err.Error(), "unsupported audio format") {
+		t.Errorf("expected an unsupported format error, got %v", err)
+	}
+
+	engine, _ = New(Config{Engine: "openai"})
+	if err := engine.Save(context.Background(), "text", filepath.Join(t.TempDir(), "standup.mp3")); err == nil || !strings.Contains(err.Error(), "API key") {
+		t.Errorf("expected a missing API key error, got %v", err)
+	}
+
+	if _, err := New(Config{Engine: "festival"}); err == nil {
+		t.Error("expected an unknown engine error")
+	}
+}
+
+func TestLocalEngineCommand(t *testing.T) {
+	engine := &localEngine{voice: "en-us", tool: "espeak-ng"}
+	cmd := engine.command(context.Background(), "standup.wav")
+	if got := strings.Join(cmd.Args[1:], " "); got != "--stdin -v en-us -w standup.wav" {
+		t.Errorf("unexpected espeak arguments %q", got)
+	}
+
+	engine = &localEngine{tool: "say"}
+	cmd = engine.command(context.Background(), "standup.wav")
+	if got := strings.Join(cmd.Args[1:], " "); got != "-f - -o standup.wav --data-format=LEI16@22050" {
+		t.Errorf("unexpected say arguments %q", got)
+	}
+}
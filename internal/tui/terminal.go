@@ -0,0 +1,77 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/term"
+)
+
+// ErrNotTerminal is returned by Run when standard input or output is not a terminal
+var ErrNotTerminal = errors.New("the dashboard needs an interactive terminal")
+
+// Handlers run the dashboard actions that need the LLM or the report generator
+type Handlers struct {
+	// Summarize returns the AI summary of an issue
+	Summarize func(key string) (string, error)
+	// Report regenerates the report
+	Report func() (string, error)
+	// Export exports the report, returning where it went
+	Export func() (string, error)
+}
+
+// Run shows the dashboard until the user quits. Standard input and output must be a terminal.
+// bubbletea puts the terminal in raw mode on the alternate screen, redraws on resize and
+// restores the terminal when the dashboard exits, fails or panics.
+func Run(model *Model, handlers Handlers) error {
+	if err := CheckTerminal(); err != nil {
+		return err
+	}
+	return run(model, handlers, tea.WithAltScreen())
+}
+
+// run runs the dashboard program with the given options
+func run(model *Model, handlers Handlers, options ...tea.ProgramOption) error {
+	model.handlers = handlers
+	if _, err := tea.NewProgram(model, options...).Run(); err != nil {
+		return fmt.Errorf("dashboard stopped: %w", err)
+	}
+	return nil
+}
+
+// CheckTerminal returns ErrNotTerminal unless standard input and output are both terminals
+func CheckTerminal() error {
+	return requireTerminal(os.Stdin, os.Stdout)
+}
+
+// requireTerminal returns ErrNotTerminal unless in and out are both terminals
+func requireTerminal(in, out *os.File) error {
+	if !term.IsTerminal(in.Fd()) || !term.IsTerminal(out.Fd()) {
+		return ErrNotTerminal
+	}
+	return nil
+}
+
+// run runs an action for the issue with key
+func (h Handlers) run(action Action, key string) (string, error) {
+	switch action {
+	case ActionSummarize:
+		if h.Summarize == nil {
+			return "", fmt.Errorf("AI summaries are not available")
+		}
+		return h.Summarize(key)
+	case ActionReport:
+		if h.Report == nil {
+			return "", fmt.Errorf("report is not available")
+		}
+		return h.Report()
+	case ActionExport:
+		if h.Export == nil {
+			return "", fmt.Errorf("export is not available")
+		}
+		return h.Export()
+	}
+	return "", nil
+}
@@ -0,0 +1,424 @@
+// Package tui is the interactive terminal dashboard of my-day: the day's issues in a list
+// that can be filtered by status, with comment previews, AI summaries on demand and the full
+// report a key press away. The Model is a bubbletea model: key presses update it, slow work
+// such as LLM calls runs as a command whose result comes back as a message, and View draws it
+// for the current terminal size.
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Filters are the status filters, cycled with tab or selected with 1-4
+var Filters = []string{"All", "In Progress", "Done", "To Do"}
+
+// groupIcons are the icons of the status groups, as in the report
+var groupIcons = map[string]string{"In Progress": "🔄", "Done": "✅", "To Do": "📋"}
+
+// Item is an issue shown in the dashboard
+type Item struct {
+	Key      string
+	Summary  string
+	Status   string   // Jira status name
+	Group    string   // Status group: "In Progress", "Done", "To Do" or "Other"
+	Comments []string // Your comments, oldest first
+}
+
+// Action is slow work a key press asks for, run by the Handlers
+type Action int
+
+const (
+	ActionNone      Action = iota
+	ActionSummarize        // AI summary of the selected issue
+	ActionReport           // Regenerate the report
+	ActionExport           // Export the report
+	ActionQuit
+)
+
+// view is what the dashboard shows
+type view int
+
+const (
+	viewList view = iota
+	viewReport
+)
+
+// Model is the dashboard state
+type Model struct {
+	handlers  Handlers
+	width     int
+	height    int
+	working   string // Status of the running action, if any
+	title     string
+	items     []Item
+	filter    int // Index into Filters
+	cursor    int // Index into the filtered items
+	previews  bool
+	details   bool // Show every comment of the selected issue
+	summaries map[string]string
+	view      view
+	report    string
+	scroll    int
+	status    string
+}
+
+// NewModel creates the dashboard for the items
+func NewModel(title string, items []Item) *Model {
+	return &Model{
+		title:     title,
+		items:     items,
+		previews:  true,
+		summaries: make(map[string]string),
+		width:     80,
+		height:    24,
+	}
+}
+
+// actionDone is the message carrying the result of an action
+type actionDone struct {
+	action Action
+	key    string // Issue the action ran for
+	result string
+	err    error
+}
+
+// Init starts the dashboard; it has nothing to load
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update applies a key press, a terminal resize or the result of an action
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+	case actionDone:
+		m.working = ""
+		m.done(msg)
+	case tea.KeyMsg:
+		switch action := m.handleKey(msg.String()); action {
+		case ActionNone:
+		case ActionQuit:
+			return m, tea.Quit
+		default:
+			if m.working != "" {
+				m.status = "⏳ Wait for the current action to finish"
+				return m, nil
+			}
+			m.working = m.Working(action)
+			return m, m.start(action)
+		}
+	}
+	return m, nil
+}
+
+// start returns the command running an action with its handler
+func (m *Model) start(action Action) tea.Cmd {
+	item, _ := m.Selected()
+	return func() tea.Msg {
+		result, err := m.handlers.run(action, item.Key)
+		return actionDone{action: action, key: item.Key, result: result, err: err}
+	}
+}
+
+// Visible returns the items matching the status filter
+func (m *Model) Visible() []Item {
+	if m.filter == 0 {
+		return m.items
+	}
+	var visible []Item
+	for _, item := range m.items {
+		if item.Group == Filters[m.filter] {
+			visible = append(visible, item)
+		}
+	}
+	return visible
+}
+
+// Selected returns the item under the cursor
+func (m *Model) Selected() (Item, bool) {
+	visible := m.Visible()
+	if m.cursor < 0 || m.cursor >= len(visible) {
+		return Item{}, false
+	}
+	return visible[m.cursor], true
+}
+
+// handleKey applies a key press, returning the action it asks for
+func (m *Model) handleKey(key string) Action {
+	m.status = ""
+	if m.view == viewReport {
+		return m.updateReport(key)
+	}
+
+	switch key {
+	case "q", "ctrl+c":
+		return ActionQuit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.Visible())-1 {
+			m.cursor++
+		}
+	case "home", "g":
+		m.cursor = 0
+	case "end", "G":
+		m.cursor = len(m.Visible()) - 1
+	case "tab":
+		m.setFilter((m.filter + 1) % len(Filters))
+	case "shift+tab":
+		m.setFilter((m.filter + len(Filters) - 1) % len(Filters))
+	case "1", "2", "3", "4":
+		m.setFilter(int(key[0] - '1'))
+	case "enter":
+		m.details = !m.details
+	case "p":
+		m.previews = !m.previews
+	case "s":
+		if _, ok := m.Selected(); ok {
+			return ActionSummarize
+		}
+	case "r":
+		return ActionReport
+	case "e":
+		return ActionExport
+	}
+	return ActionNone
+}
+
+func (m *Model) updateReport(key string) Action {
+	switch key {
+	case "q", "esc":
+		m.view = viewList
+	case "ctrl+c":
+		return ActionQuit
+	case "r":
+		return ActionReport
+	case "up", "k":
+		if m.scroll > 0 {
+			m.scroll--
+		}
+	case "down", "j":
+		m.scroll++
+	case "pgup":
+		m.scroll = max(m.scroll-10, 0)
+	case "pgdown", " ":
+		m.scroll += 10
+	case "home", "g":
+		m.scroll = 0
+	case "e":
+		return ActionExport
+	}
+	return ActionNone
+}
+
+func (m *Model) setFilter(filter int) {
+	m.filter = filter
+	m.cursor = 0
+}
+
+// Working returns the status shown while an action runs
+func (m *Model) Working(action Action) string {
+	switch action {
+	case ActionSummarize:
+		item, _ := m.Selected()
+		return fmt.Sprintf("🤖 Summarizing %s...", item.Key)
+	case ActionReport:
+		return "📋 Generating report..."
+	case ActionExport:
+		return "📤 Exporting report..."
+	}
+	return ""
+}
+
+// done records the result of an action: the AI summary, the report text or the export status
+func (m *Model) done(msg actionDone) {
+	if msg.err != nil {
+		m.status = "⚠️  " + msg.err.Error()
+		return
+	}
+	switch msg.action {
+	case ActionSummarize:
+		m.summaries[msg.key] = msg.result
+		if item, ok := m.Selected(); ok && item.Key == msg.key {
+			m.details = true
+		}
+	case ActionReport:
+		m.report = msg.result
+		m.view = viewReport
+		m.scroll = 0
+		m.status = "✓ Report regenerated " + time.Now().Format("15:04:05")
+	case ActionExport:
+		m.status = "✓ " + msg.result
+	}
+}
+
+// View draws the dashboard for the terminal size
+func (m *Model) View() string {
+	var lines []string
+	if m.view == viewReport {
+		lines = m.reportView(m.height)
+	} else {
+		lines = m.listView(m.height)
+	}
+
+	for i, line := range lines {
+		lines[i] = truncate(line, m.width)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// statusLine returns the footer line with the running action or the result of the last one
+func (m *Model) statusLine() string {
+	if m.working != "" {
+		return m.working
+	}
+	return m.status
+}
+
+func (m *Model) listView(height int) []string {
+	var tabs []string
+	for i, filter := range Filters {
+		if i == m.filter {
+			tabs = append(tabs, "["+filter+"]")
+		} else {
+			tabs = append(tabs, " "+filter+" ")
+		}
+	}
+	header := []string{bold(m.title) + "   " + strings.Join(tabs, " "), rule}
+	footer := []string{rule, dim("↑/↓ move · tab/1-4 filter · enter comments · p previews · s AI summary · r report · e export · q quit")}
+	if status := m.statusLine(); status != "" {
+		footer = append(footer, status)
+	}
+
+	visible := m.Visible()
+	var body []string
+	if len(visible) == 0 {
+		filter := ""
+		if m.filter > 0 {
+			filter = strings.ToLower(Filters[m.filter]) + " "
+		}
+		body = append(body, dim(fmt.Sprintf("  No %sissues with your comments in the report window", filter)))
+	}
+	selectedLine := 0
+	for i, item := range visible {
+		icon, ok := groupIcons[item.Group]
+		if !ok {
+			icon = "📝"
+		}
+		line := fmt.Sprintf("%s %-12s %s  %s", icon, item.Key, item.Summary, dim(fmt.Sprintf("(%s, %s)", item.Status, plural(len(item.Comments), "comment"))))
+		if i == m.cursor {
+			selectedLine = len(body)
+			body = append(body, reverse("> "+line))
+		} else {
+			body = append(body, "  "+line)
+		}
+
+		if i == m.cursor && m.details {
+			body = append(body, m.detailLines(item)...)
+		} else if m.previews && len(item.Comments) > 0 {
+			body = append(body, dim("      └ "+firstLine(item.Comments[len(item.Comments)-1])))
+		}
+	}
+
+	// Scroll the list so the selected issue stays on screen
+	room := height - len(header) - len(footer)
+	if room < 1 {
+		room = 1
+	}
+	start := 0
+	if selectedLine >= room {
+		start = selectedLine - room/2
+	}
+	if start+room > len(body) {
+		start = max(len(body)-room, 0)
+	}
+	body = body[start:min(start+room, len(body))]
+
+	lines := append(header, body...)
+	for len(lines) < height-len(footer) {
+		lines = append(lines, "")
+	}
+	return append(lines, footer...)
+}
+
+// detailLines lists every comment of the item and its AI summary
+func (m *Model) detailLines(item Item) []string {
+	var lines []string
+	for _, comment := range item.Comments {
+		for i, line := range strings.Split(strings.TrimSpace(comment), "\n") {
+			prefix := "      │ "
+			if i == 0 {
+				prefix = "      • "
+			}
+			lines = append(lines, prefix+strings.TrimSpace(line))
+		}
+	}
+	if summary, ok := m.summaries[item.Key]; ok {
+		lines = append(lines, "      🤖 AI summary:")
+		for _, line := range strings.Split(strings.TrimSpace(summary), "\n") {
+			lines = append(lines, "        "+line)
+		}
+	}
+	return lines
+}
+
+func (m *Model) reportView(height int) []string {
+	header := []string{bold(m.title + " — report"), rule}
+	footer := []string{rule, dim("↑/↓ scroll · space/pgdn page · r regenerate · e export · esc back")}
+	if status := m.statusLine(); status != "" {
+		footer = append(footer, status)
+	}
+
+	body := strings.Split(strings.TrimRight(m.report, "\n"), "\n")
+	room := max(height-len(header)-len(footer), 1)
+	m.scroll = min(m.scroll, max(len(body)-room, 0))
+	body = body[m.scroll:min(m.scroll+room, len(body))]
+
+	lines := append(header, body...)
+	for len(lines) < height-len(footer) {
+		lines = append(lines, "")
+	}
+	return append(lines, footer...)
+}
+
+const rule = "────────────────────────────────────────────────────────────────────────────────"
+
+// ANSI styles
+func bold(s string) string    { return "\x1b[1m" + s + "\x1b[0m" }
+func dim(s string) string     { return "\x1b[2m" + s + "\x1b[0m" }
+func reverse(s string) string { return "\x1b[7m" + s + "\x1b[0m" }
+
+// truncate cuts a line to the terminal width, measuring display cells so emoji and wide runes
+// count double and ANSI escape sequences not at all
+func truncate(line string, width int) string {
+	if width <= 0 || ansi.StringWidth(line) <= width {
+		return line
+	}
+	return ansi.Truncate(line, width, "…")
+}
+
+// plural returns "1 comment" or "3 comments"
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// firstLine returns the first non-empty line of a comment
+func firstLine(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
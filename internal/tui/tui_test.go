@@ -0,0 +1,177 @@
+package tui
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func testItems() []Item {
+	return []Item{
+		{Key: "OPS-1", Summary: "Migrate runners", Status: "In Progress", Group: "In Progress", Comments: []string{"Started", "Runners migrated\nLoad test next"}},
+		{Key: "OPS-2", Summary: "Rotate credentials", Status: "Done", Group: "Done", Comments: []string{"Rotated"}},
+		{Key: "OPS-3", Summary: "Upgrade cluster", Status: "Review", Group: "In Progress"},
+	}
+}
+
+// specialKeys are the key names of the special keys the dashboard uses
+var specialKeys = map[string]tea.KeyType{
+	"up": tea.KeyUp, "down": tea.KeyDown, "tab": tea.KeyTab, "shift+tab": tea.KeyShiftTab,
+	"enter": tea.KeyEnter, "esc": tea.KeyEsc, "ctrl+c": tea.KeyCtrlC,
+}
+
+// press sends key presses to the model, running the action the last one asks for
+func press(m *Model, keys ...string) {
+	var cmd tea.Cmd
+	for _, key := range keys {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+		if keyType, ok := specialKeys[key]; ok {
+			msg = tea.KeyMsg{Type: keyType}
+		}
+		_, cmd = m.Update(msg)
+	}
+	if cmd != nil {
+		m.Update(cmd())
+	}
+}
+
+func TestModelFiltersAndMoves(t *testing.T) {
+	m := NewModel("my-day", testItems())
+
+	press(m, "down", "down", "down") // Stays on the last issue
+	if item, _ := m.Selected(); item.Key != "OPS-3" {
+		t.Errorf("expected OPS-3 selected, got %s", item.Key)
+	}
+
+	press(m, "tab") // In Progress
+	if visible := m.Visible(); len(visible) != 2 || visible[1].Key != "OPS-3" {
+		t.Errorf("unexpected in-progress issues %+v", visible)
+	}
+	if item, _ := m.Selected(); item.Key != "OPS-1" {
+		t.Errorf("expected the filter to reset the cursor, got %s", item.Key)
+	}
+
+	press(m, "4") // To Do
+	if _, ok := m.Selected(); ok {
+		t.Error("expected nothing selected without to-do issues")
+	}
+	if action := m.handleKey("s"); action != ActionNone {
+		t.Errorf("expected no summary without a selected issue, got %v", action)
+	}
+	if view := m.View(); !strings.Contains(view, "No to do issues") {
+		t.Errorf("expected an empty list message, got:\n%s", view)
+	}
+
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}); cmd == nil || cmd() != tea.Quit() {
+		t.Error("expected q to quit")
+	}
+}
+
+func TestModelActions(t *testing.T) {
+	m := NewModel("my-day", testItems())
+	m.handlers = Handlers{
+		Summarize: func(key string) (string, error) { return key + ": runners are migrated; load testing is next.", nil },
+		Report:    func() (string, error) { return "🚀 Daily Standup Report\nline 2\nline 3", nil },
+		Export:    func() (string, error) { return "", errors.New("Export to Obsidian failed") },
+	}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	if cmd == nil {
+		t.Fatal("expected s to start a summary")
+	}
+	if view := m.View(); !strings.Contains(view, "Summarizing OPS-1") {
+		t.Errorf("expected the working status while the summary runs, got:\n%s", view)
+	}
+	press(m, "down") // The summary still goes to the issue it was asked for
+	m.Update(cmd())
+	press(m, "up", "enter")
+	view := m.View()
+	if !strings.Contains(view, "AI summary") || !strings.Contains(view, "OPS-1: runners are migrated") || !strings.Contains(view, "• Started") {
+		t.Errorf("expected the comments and summary of the selected issue, got:\n%s", view)
+	}
+
+	press(m, "r")
+	if view := m.View(); !strings.Contains(view, "Daily Standup Report") {
+		t.Errorf("expected the report view, got:\n%s", view)
+	}
+	press(m, "esc")
+	if view := m.View(); !strings.Contains(view, "OPS-1") {
+		t.Errorf("expected esc to go back to the list, got:\n%s", view)
+	}
+
+	press(m, "e")
+	if view := m.View(); !strings.Contains(view, "⚠️  Export to Obsidian failed") {
+		t.Errorf("expected the export error in the status line, got:\n%s", view)
+	}
+}
+
+func TestModelViewPreviewsAndResize(t *testing.T) {
+	m := NewModel("my-day", testItems())
+
+	for _, size := range []tea.WindowSizeMsg{{Width: 40, Height: 12}, {Width: 24, Height: 6}} {
+		m.Update(size)
+		lines := strings.Split(m.View(), "\n")
+		if len(lines) != size.Height {
+			t.Errorf("expected the view to fill %d lines, got %d", size.Height, len(lines))
+		}
+		for _, line := range lines {
+			if width := ansi.StringWidth(line); width > size.Width {
+				t.Errorf("line wider than the %d-column terminal (%d): %q", size.Width, width, line)
+			}
+		}
+	}
+
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 12})
+	if view := m.View(); !strings.Contains(view, "└ Runners migrated") {
+		t.Errorf("expected the first line of the latest comment as preview, got:\n%s", view)
+	}
+	press(m, "p")
+	if view := m.View(); strings.Contains(view, "└") {
+		t.Errorf("expected p to hide the previews, got:\n%s", view)
+	}
+}
+
+func TestRequireTerminal(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	if err := requireTerminal(r, w); !errors.Is(err, ErrNotTerminal) {
+		t.Errorf("expected ErrNotTerminal for a pipe, got %v", err)
+	}
+}
+
+func TestRunRestoresTerminalOnPanic(t *testing.T) {
+	input, press := io.Pipe()
+	defer press.Close()
+	var output bytes.Buffer
+	done := make(chan error, 1)
+	go func() {
+		done <- run(NewModel("my-day", testItems()), Handlers{
+			Summarize: func(string) (string, error) { panic("summarizer crashed") },
+		}, tea.WithInput(input), tea.WithOutput(&output), tea.WithAltScreen())
+	}()
+	press.Write([]byte("s"))
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, tea.ErrProgramPanic) {
+			t.Errorf("expected the panic to stop the dashboard, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("dashboard did not stop after the panic")
+	}
+	if !strings.HasSuffix(output.String(), ansi.ResetAltScreenSaveCursorMode+ansi.ShowCursor) {
+		t.Errorf("expected the main screen and the cursor back, got %q", output.String())
+	}
+}
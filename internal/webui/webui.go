@@ -0,0 +1,151 @@
+// Package webui serves reports to a browser: today's and past reports as HTML pages, and the
+// same reports as JSON for scripts and dashboards.
+package webui
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// dateLayout is the format of dates in URLs
+const dateLayout = "2006-01-02"
+
+// Source renders the report of a date
+type Source interface {
+	// HTML returns the report of a date as a standalone HTML page
+	HTML(date time.Time) (string, error)
+	// Report returns the report of a date for the API
+	Report(date time.Time) (*Report, error)
+}
+
+// Report is a report as returned by /api/report
+type Report struct {
+	Date        string    `json:"date"`
+	GeneratedAt time.Time `json:"generated_at"`
+	Issues      []Issue   `json:"issues"`
+	TimeLogged  int       `json:"time_logged_seconds"` // Worklog time on the report date
+	Markdown    string    `json:"markdown"`
+	Notes       []string  `json:"notes,omitempty"` // Warnings about the report, such as sync or LLM failures
+}
+
+// Issue is an issue of a report
+type Issue struct {
+	Key      string `json:"key"`
+	Summary  string `json:"summary"`
+	Status   string `json:"status"`
+	Comments int    `json:"comments"` // Your comments on the report date
+}
+
+// Handler serves the reports of a source
+type Handler struct {
+	source Source
+	now    func() time.Time
+	mux    *http.ServeMux
+
+	// Reports are generated one at a time: generation uses the local store and the LLM
+	mu sync.Mutex
+}
+
+// NewHandler returns a handler serving the reports of source
+func NewHandler(source Source) *Handler {
+	h := &Handler{source: source, now: time.Now, mux: http.NewServeMux()}
+	h.mux.HandleFunc("/", h.serveHTML)
+	h.mux.HandleFunc("/api/report", h.serveReport)
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// serveHTML serves the report of ?date= (today by default) with links to the other days
+func (h *Handler) serveHTML(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	date, err := h.date(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.mu.Lock()
+	page, err := h.source.HTML(date)
+	h.mu.Unlock()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to generate the report: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(withNavigation(page, date, h.today())))
+}
+
+// serveReport serves the report of ?date= (today by default) as JSON
+func (h *Handler) serveReport(w http.ResponseWriter, r *http.Request) {
+	date, err := h.date(r)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+
+	h.mu.Lock()
+	report, err := h.source.Report(date)
+	h.mu.Unlock()
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, report)
+}
+
+// date returns the date of the request, today when it has none
+func (h *Handler) date(r *http.Request) (time.Time, error) {
+	value := r.URL.Query().Get("date")
+	if value == "" {
+		return h.today(), nil
+	}
+	date, err := time.ParseInLocation(dateLayout, value, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, use YYYY-MM-DD", value)
+	}
+	return date, nil
+}
+
+func (h *Handler) today() time.Time {
+	now := h.now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// withNavigation adds links to the previous and next days and a date picker to the top of a page
+func withNavigation(page string, date, today time.Time) string {
+	var nav strings.Builder
+	nav.WriteString(`<nav style="font-family: sans-serif; font-size: 14px; margin-bottom: 16px;">`)
+	nav.WriteString(fmt.Sprintf(`<a href="/?date=%s">← %s</a>`, date.AddDate(0, 0, -1).Format(dateLayout), date.AddDate(0, 0, -1).Format("Mon Jan 2")))
+	if date.Before(today) {
+		nav.WriteString(fmt.Sprintf(` · <a href="/?date=%s">%s →</a> · <a href="/">Today</a>`, date.AddDate(0, 0, 1).Format(dateLayout), date.AddDate(0, 0, 1).Format("Mon Jan 2")))
+	}
+	nav.WriteString(fmt.Sprintf(` <form action="/" style="display: inline; margin-left: 12px;"><input type="date" name="date" value="%s" max="%s" onchange="this.form.submit()"></form>`,
+		html.EscapeString(date.Format(dateLayout)), today.Format(dateLayout)))
+	nav.WriteString("</nav>\n")
+
+	if i := strings.Index(page, "<body>"); i >= 0 {
+		i += len("<body>")
+		return page[:i] + "\n" + nav.String() + page[i:]
+	}
+	return nav.String() + page
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(value)
+}
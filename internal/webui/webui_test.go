@@ -0,0 +1,99 @@
+package webui
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeSource renders a report naming its date, failing for dates in failing
+type fakeSource struct {
+	failing map[string]bool
+}
+
+func (s fakeSource) HTML(date time.Time) (string, error) {
+	if s.failing[date.Format(dateLayout)] {
+		return "", errors.New("no data")
+	}
+	return "<html><body><h1>Report " + date.Format(dateLayout) + "</h1></body></html>", nil
+}
+
+func (s fakeSource) Report(date time.Time) (*Report, error) {
+	if s.failing[date.Format(dateLayout)] {
+		return nil, errors.New("no data")
+	}
+	return &Report{Date: date.Format(dateLayout), Issues: []Issue{{Key: "OPS-1", Summary: "Rotate certificates", Status: "In Progress", Comments: 2}}}, nil
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	handler := NewHandler(fakeSource{failing: map[string]bool{"2024-07-01": true}})
+	handler.now = func() time.Time { return time.Date(2024, 7, 15, 9, 0, 0, 0, time.Local) }
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func get(t *testing.T, url string) (int, string) {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s error = %v", url, err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func TestServeHTML(t *testing.T) {
+	server := newTestServer(t)
+
+	status, body := get(t, server.URL+"/")
+	if status != http.StatusOK || !strings.Contains(body, "Report 2024-07-15") {
+		t.Fatalf("expected today's report, got %d %s", status, body)
+	}
+	if !strings.Contains(body, `href="/?date=2024-07-14"`) || strings.Contains(body, "2024-07-16") {
+		t.Errorf("expected a link to yesterday only:\n%s", body)
+	}
+	if strings.Index(body, "<nav") < strings.Index(body, "<body>") {
+		t.Errorf("expected the navigation inside the body:\n%s", body)
+	}
+
+	status, body = get(t, server.URL+"/?date=2024-07-10")
+	if status != http.StatusOK || !strings.Contains(body, "Report 2024-07-10") || !strings.Contains(body, `href="/?date=2024-07-11"`) {
+		t.Errorf("expected the past report with a link to the next day, got %d %s", status, body)
+	}
+
+	if status, _ := get(t, server.URL+"/?date=yesterday"); status != http.StatusBadRequest {
+		t.Errorf("expected 400 for an invalid date, got %d", status)
+	}
+	if status, _ := get(t, server.URL+"/?date=2024-07-01"); status != http.StatusInternalServerError {
+		t.Errorf("expected 500 when the report fails, got %d", status)
+	}
+	if status, _ := get(t, server.URL+"/favicon.ico"); status != http.StatusNotFound {
+		t.Errorf("expected 404 for other paths, got %d", status)
+	}
+}
+
+func TestServeReportJSON(t *testing.T) {
+	server := newTestServer(t)
+
+	status, body := get(t, server.URL+"/api/report?date=2024-07-10")
+	var report Report
+	if err := json.Unmarshal([]byte(body), &report); err != nil || status != http.StatusOK {
+		t.Fatalf("expected a JSON report, got %d %s", status, body)
+	}
+	if report.Date != "2024-07-10" || len(report.Issues) != 1 || report.Issues[0].Comments != 2 {
+		t.Errorf("unexpected report %+v", report)
+	}
+
+	status, body = get(t, server.URL+"/api/report?date=15/07/2024")
+	if status != http.StatusBadRequest || !strings.Contains(body, `"error"`) {
+		t.Errorf("expected a JSON error for an invalid date, got %d %s", status, body)
+	}
+}